@@ -21,20 +21,30 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/go-logr/logr"
 	"github.com/spf13/cobra"
 	"k8s.io/component-base/logs"
 
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/http/solver"
+	tlsalpnsolver "github.com/cert-manager/cert-manager/pkg/issuer/acme/tlsalpn/solver"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 )
 
+// challengeSolver is a server solving a single ACME challenge.
+type challengeSolver interface {
+	Listen(log logr.Logger) error
+	Shutdown(ctx context.Context) error
+}
+
 func NewACMESolverCommand(_ context.Context) *cobra.Command {
 	s := new(solver.HTTP01Solver)
+	tlsALPNSolver := new(tlsalpnsolver.TLSALPN01Solver)
+	challengeType := "http-01"
 	logOptions := logs.NewOptions()
 
 	cmd := &cobra.Command{
 		Use:   "acmesolver",
-		Short: "HTTP server used to solve ACME challenges.",
+		Short: "Server used to solve ACME HTTP-01 and TLS-ALPN-01 challenges.",
 
 		SilenceErrors: true, // Errors are already logged when calling cmd.Execute()
 		SilenceUsage:  true, // Don't print usage on every error
@@ -44,6 +54,12 @@ func NewACMESolverCommand(_ context.Context) *cobra.Command {
 				return fmt.Errorf("error validating options: %s", err)
 			}
 
+			switch challengeType {
+			case "http-01", "tls-alpn-01":
+			default:
+				return fmt.Errorf("unsupported challenge type %q, must be one of http-01 or tls-alpn-01", challengeType)
+			}
+
 			return nil
 		},
 		// nolint:contextcheck // False positive
@@ -51,6 +67,14 @@ func NewACMESolverCommand(_ context.Context) *cobra.Command {
 			runCtx := cmd.Context()
 			log := logf.FromContext(runCtx)
 
+			var srv challengeSolver = s
+			if challengeType == "tls-alpn-01" {
+				tlsALPNSolver.ListenPort = s.ListenPort
+				tlsALPNSolver.Domain = s.Domain
+				tlsALPNSolver.Key = s.Key
+				srv = tlsALPNSolver
+			}
+
 			completedCh := make(chan struct{})
 			go func() {
 				defer close(completedCh)
@@ -61,12 +85,12 @@ func NewACMESolverCommand(_ context.Context) *cobra.Command {
 				defer cancel()
 
 				// nolint: contextcheck
-				if err := s.Shutdown(shutdownCtx); err != nil {
+				if err := srv.Shutdown(shutdownCtx); err != nil {
 					log.Error(err, "error shutting down acmesolver server")
 				}
 			}()
 
-			if err := s.Listen(log); err != nil {
+			if err := srv.Listen(log); err != nil {
 				return err
 			}
 
@@ -76,9 +100,10 @@ func NewACMESolverCommand(_ context.Context) *cobra.Command {
 		},
 	}
 
+	cmd.Flags().StringVar(&challengeType, "challenge-type", challengeType, "the type of challenge to solve, either http-01 or tls-alpn-01")
 	cmd.Flags().IntVar(&s.ListenPort, "listen-port", 8089, "the port number to listen on for connections")
 	cmd.Flags().StringVar(&s.Domain, "domain", "", "the domain name to verify")
-	cmd.Flags().StringVar(&s.Token, "token", "", "the challenge token to verify against")
+	cmd.Flags().StringVar(&s.Token, "token", "", "the challenge token to verify against (http-01 only)")
 	cmd.Flags().StringVar(&s.Key, "key", "", "the challenge key to respond with")
 
 	// TODO(@inteon): use flags to configure the log configuration (https://github.com/cert-manager/cert-manager/issues/6021)
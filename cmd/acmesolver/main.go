@@ -24,7 +24,7 @@ import (
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 )
 
-// acmesolver solves ACME http-01 challenges. This is intended to run as a pod
+// acmesolver solves ACME http-01 and tls-alpn-01 challenges. This is intended to run as a pod
 // in the target kubernetes cluster in order to solve challenges for
 // cert-manager.
 
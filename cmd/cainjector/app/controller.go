@@ -169,7 +169,10 @@ func Run(opts *config.CAInjectorConfiguration, ctx context.Context) error {
 			cainjector.ValidatingWebhookConfigurationName: opts.EnableInjectableConfig.ValidatingWebhookConfigurations,
 			cainjector.APIServiceName:                     opts.EnableInjectableConfig.APIServices,
 			cainjector.CustomResourceDefinitionName:       opts.EnableInjectableConfig.CustomResourceDefinitions,
+			cainjector.ConfigMapName:                      opts.EnableInjectableConfig.ConfigMaps,
+			cainjector.SecretName:                         opts.EnableInjectableConfig.Secrets,
 		},
+		ResyncInterval: opts.ResyncInterval,
 	}
 
 	err = cainjector.RegisterAllInjectors(ctx, mgr, setupOptions)
@@ -101,6 +101,14 @@ func AddConfigFlags(fs *pflag.FlagSet, c *config.CAInjectorConfiguration) {
 	fs.BoolVar(&c.EnableInjectableConfig.APIServices, "enable-apiservices-injectable", c.EnableInjectableConfig.APIServices, ""+
 		"Inject CA data to annotated APIServices. This functionality is not required if cainjector is "+
 		"only used as cert-manager's internal component and setting it to false might reduce memory consumption")
+	fs.BoolVar(&c.EnableInjectableConfig.ConfigMaps, "enable-configmaps-injectable", c.EnableInjectableConfig.ConfigMaps, ""+
+		"Inject CA data to annotated ConfigMaps, under the ca.crt key. Requires granting cainjector permission to update and patch ConfigMaps.")
+	fs.BoolVar(&c.EnableInjectableConfig.Secrets, "enable-secrets-injectable", c.EnableInjectableConfig.Secrets, ""+
+		"Inject CA data to annotated Secrets, under the ca.crt key. Requires granting cainjector permission to update and patch Secrets.")
+
+	fs.DurationVar(&c.ResyncInterval, "resync-interval", c.ResyncInterval, ""+
+		"How often to re-verify and re-inject the CA data of each injectable, even if no watch event has been received. "+
+		"If zero, injectables are only reconciled in response to watch events.")
 
 	fs.BoolVar(&c.EnablePprof, "enable-profiling", c.EnablePprof, ""+
 		"Enable profiling for controller.")
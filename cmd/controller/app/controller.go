@@ -23,6 +23,7 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -41,6 +42,7 @@ import (
 	config "github.com/cert-manager/cert-manager/internal/apis/config/controller"
 	"github.com/cert-manager/cert-manager/internal/apis/config/shared"
 	"github.com/cert-manager/cert-manager/internal/controller/feature"
+	"github.com/cert-manager/cert-manager/internal/fips"
 	"github.com/cert-manager/cert-manager/pkg/acme/accounts"
 	"github.com/cert-manager/cert-manager/pkg/controller"
 	"github.com/cert-manager/cert-manager/pkg/controller/clusterissuers"
@@ -73,6 +75,10 @@ func Run(rootCtx context.Context, opts *config.ControllerConfiguration) error {
 	log := logf.FromContext(rootCtx)
 	g, rootCtx := errgroup.WithContext(rootCtx)
 
+	if err := fips.AssertEnabled(log, opts.RequireFIPSMode); err != nil {
+		return fmt.Errorf("refusing to start as --require-fips-mode is set: %w", err)
+	}
+
 	ctxFactory, err := buildControllerContextFactory(rootCtx, opts)
 	if err != nil {
 		return err
@@ -172,6 +178,12 @@ func Run(rootCtx context.Context, opts *config.ControllerConfiguration) error {
 		return healthzServer.Start(rootCtx, healthzListener)
 	})
 
+	// The leader election lease is only released once the controllers have
+	// stopped, so that no other instance starts reconciling while the
+	// controllers are still shutting down.
+	leaderElectionCtx, cancelLeaderElection := context.WithCancel(context.WithoutCancel(rootCtx))
+	defer cancelLeaderElection()
+
 	elected := make(chan struct{})
 	if opts.LeaderElectionConfig.Enabled {
 		g.Go(func() error {
@@ -181,7 +193,7 @@ func Run(rootCtx context.Context, opts *config.ControllerConfiguration) error {
 				return err
 			}
 			errorCh := make(chan error, 1)
-			if err := startLeaderElection(rootCtx, opts, ctx.Client, ctx.Recorder, leaderelection.LeaderCallbacks{
+			if err := startLeaderElection(leaderElectionCtx, opts, ctx.Client, ctx.Recorder, leaderelection.LeaderCallbacks{
 				OnStartedLeading: func(_ context.Context) {
 					close(elected)
 				},
@@ -191,7 +203,7 @@ func Run(rootCtx context.Context, opts *config.ControllerConfiguration) error {
 						// context was canceled, just return
 						return
 					default:
-						errorCh <- errors.New("leader election lost")
+						errorCh <- controller.ErrLeaderElectionLost
 					}
 				},
 			}, healthzServer.LeaderHealthzAdaptor); err != nil {
@@ -212,11 +224,13 @@ func Run(rootCtx context.Context, opts *config.ControllerConfiguration) error {
 	select {
 	case <-rootCtx.Done(): // Exit early if we are shutting down or if the errgroup has already exited with an error
 		// Wait for error group to complete and return
+		cancelLeaderElection()
 		return g.Wait()
 	case <-elected: // Don't launch the controllers unless we have been elected leader
 		// Continue with setting up controller
 	}
 
+	var controllersWG sync.WaitGroup
 	for n, fn := range controller.Known() {
 		log := log.WithValues("controller", n)
 
@@ -237,6 +251,7 @@ func Run(rootCtx context.Context, opts *config.ControllerConfiguration) error {
 			err = fmt.Errorf("error starting controller: %v", err)
 
 			cancelContext()
+			cancelLeaderElection()
 			err2 := g.Wait() // Don't process errors, we already have an error
 			if err2 != nil {
 				return utilerrors.NewAggregate([]error{err, err2})
@@ -244,13 +259,22 @@ func Run(rootCtx context.Context, opts *config.ControllerConfiguration) error {
 			return err
 		}
 
+		controllersWG.Add(1)
 		g.Go(func() error {
+			defer controllersWG.Done()
 			log.V(logf.InfoLevel).Info("starting controller")
 
 			return iface.Run(opts.NumberOfConcurrentWorkers, rootCtx)
 		})
 	}
 
+	g.Go(func() error {
+		<-rootCtx.Done()
+		controllersWG.Wait()
+		cancelLeaderElection()
+		return nil
+	})
+
 	log.V(logf.DebugLevel).Info("starting shared informer factories")
 	ctx.SharedInformerFactory.Start(rootCtx.Done())
 	ctx.KubeSharedInformerFactory.Start(rootCtx.Done())
@@ -304,7 +328,7 @@ func buildControllerContextFactory(ctx context.Context, opts *config.ControllerC
 	}
 
 	ACMEHTTP01SolverRunAsNonRoot := opts.ACMEHTTP01Config.SolverRunAsNonRoot
-	acmeAccountRegistry := accounts.NewDefaultRegistry()
+	acmeAccountRegistry := accounts.NewRateLimitedRegistry(opts.ACMEAccountQPS, opts.ACMEAccountBurst)
 
 	ctxFactory, err := controller.NewContextFactory(ctx, controller.ContextOptions{
 		Kubeconfig:         opts.KubeConfig,
@@ -323,19 +347,26 @@ func buildControllerContextFactory(ctx context.Context, opts *config.ControllerC
 			HTTP01SolverResourceLimitsCPU:     http01SolverResourceLimitsCPU,
 			HTTP01SolverResourceLimitsMemory:  http01SolverResourceLimitsMemory,
 			ACMEHTTP01SolverRunAsNonRoot:      ACMEHTTP01SolverRunAsNonRoot,
+			HTTP01SolverSecurityContext:       opts.ACMEHTTP01Config.SolverSecurityContext,
+			HTTP01SolverPodSecurityContext:    opts.ACMEHTTP01Config.SolverPodSecurityContext,
 			HTTP01SolverImage:                 opts.ACMEHTTP01Config.SolverImage,
 			// Allows specifying a list of custom nameservers to perform HTTP01 checks on.
 			HTTP01SolverNameservers: opts.ACMEHTTP01Config.SolverNameservers,
 
-			DNS01Nameservers:        nameservers,
-			DNS01CheckRetryPeriod:   opts.ACMEDNS01Config.CheckRetryPeriod,
-			DNS01CheckAuthoritative: !opts.ACMEDNS01Config.RecursiveNameserversOnly,
+			DNS01Nameservers:                nameservers,
+			DNS01CheckRetryPeriod:           opts.ACMEDNS01Config.CheckRetryPeriod,
+			DNS01CheckAuthoritative:         !opts.ACMEDNS01Config.RecursiveNameserversOnly,
+			DNS01RecursiveNameserversQuorum: opts.ACMEDNS01Config.RecursiveNameserversQuorum,
+			DNS01CheckTimeout:               opts.ACMEDNS01Config.CheckTimeout,
+
+			OrderPollJitter: float64(opts.ACMEOrderPollJitter),
 
 			AccountRegistry: acmeAccountRegistry,
 		},
 
 		SchedulerOptions: controller.SchedulerOptions{
-			MaxConcurrentChallenges: opts.MaxConcurrentChallenges,
+			MaxConcurrentChallenges:                   opts.MaxConcurrentChallenges,
+			MaxConcurrentCertificateRequestsPerIssuer: opts.MaxConcurrentCertificateRequestsPerIssuer,
 		},
 
 		IssuerOptions: controller.IssuerOptions{
@@ -344,6 +375,15 @@ func buildControllerContextFactory(ctx context.Context, opts *config.ControllerC
 			ClusterResourceNamespace:        opts.ClusterResourceNamespace,
 		},
 
+		VenafiOptions: controller.VenafiOptions{
+			MaxSetupRetryInterval: opts.VenafiConfig.MaxSetupRetryInterval,
+		},
+
+		PodIdentityOptions: controller.PodIdentityOptions{
+			AllowedIssuers: opts.PodIdentityConfig.AllowedIssuers,
+			TrustDomain:    opts.PodIdentityConfig.TrustDomain,
+		},
+
 		IngressShimOptions: controller.IngressShimOptions{
 			DefaultIssuerName:                 opts.IngressShimConfig.DefaultIssuerName,
 			DefaultIssuerKind:                 opts.IngressShimConfig.DefaultIssuerKind,
@@ -354,6 +394,7 @@ func buildControllerContextFactory(ctx context.Context, opts *config.ControllerC
 		CertificateOptions: controller.CertificateOptions{
 			EnableOwnerRef:           opts.EnableCertificateOwnerRef,
 			CopiedAnnotationPrefixes: opts.CopiedAnnotationPrefixes,
+			EnableChainVerification:  opts.EnableCertificateChainVerification,
 		},
 
 		ConfigOptions: controller.ConfigOptions{
@@ -394,15 +435,7 @@ func startLeaderElection(ctx context.Context, opts *config.ControllerConfigurati
 	}
 
 	// Try and become the leader and start controller manager loops
-	le, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
-		Lock:            ml,
-		LeaseDuration:   opts.LeaderElectionConfig.LeaseDuration,
-		RenewDeadline:   opts.LeaderElectionConfig.RenewDeadline,
-		RetryPeriod:     opts.LeaderElectionConfig.RetryPeriod,
-		ReleaseOnCancel: true,
-		Callbacks:       callbacks,
-		WatchDog:        healthzAdaptor,
-	})
+	le, err := leaderelection.NewLeaderElector(leaderElectionConfig(opts, ml, callbacks, healthzAdaptor))
 	if err != nil {
 		return err
 	}
@@ -412,6 +445,22 @@ func startLeaderElection(ctx context.Context, opts *config.ControllerConfigurati
 	return nil
 }
 
+// leaderElectionConfig builds the configuration for the leader elector from
+// the leader election timings configured with the
+// --leader-election-lease-duration, --leader-election-renew-deadline and
+// --leader-election-retry-period flags (or their config file equivalents).
+func leaderElectionConfig(opts *config.ControllerConfiguration, lock resourcelock.Interface, callbacks leaderelection.LeaderCallbacks, healthzAdaptor *leaderelection.HealthzAdaptor) leaderelection.LeaderElectionConfig {
+	return leaderelection.LeaderElectionConfig{
+		Lock:            lock,
+		LeaseDuration:   opts.LeaderElectionConfig.LeaseDuration,
+		RenewDeadline:   opts.LeaderElectionConfig.RenewDeadline,
+		RetryPeriod:     opts.LeaderElectionConfig.RetryPeriod,
+		ReleaseOnCancel: true,
+		Callbacks:       callbacks,
+		WatchDog:        healthzAdaptor,
+	}
+}
+
 func buildCertificateSource(log logr.Logger, tlsConfig shared.TLSConfig, restCfg *rest.Config) tls.CertificateSource {
 	switch {
 	case tlsConfig.FilesystemConfigProvided():
@@ -0,0 +1,58 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spf13/pflag"
+	"k8s.io/client-go/tools/leaderelection"
+
+	"github.com/cert-manager/cert-manager/controller-binary/app/options"
+)
+
+func TestLeaderElectionConfigFromFlags(t *testing.T) {
+	opts, err := options.NewControllerConfiguration()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	options.AddConfigFlags(fs, opts)
+	if err := fs.Parse([]string{
+		"--leader-election-lease-duration=2m",
+		"--leader-election-renew-deadline=90s",
+		"--leader-election-retry-period=30s",
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := leaderElectionConfig(opts, nil, leaderelection.LeaderCallbacks{}, nil)
+	if cfg.LeaseDuration != 2*time.Minute {
+		t.Errorf("expected lease duration %s but got %s", 2*time.Minute, cfg.LeaseDuration)
+	}
+	if cfg.RenewDeadline != 90*time.Second {
+		t.Errorf("expected renew deadline %s but got %s", 90*time.Second, cfg.RenewDeadline)
+	}
+	if cfg.RetryPeriod != 30*time.Second {
+		t.Errorf("expected retry period %s but got %s", 30*time.Second, cfg.RetryPeriod)
+	}
+	if !cfg.ReleaseOnCancel {
+		t.Error("expected the lease to be released on cancel")
+	}
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package options
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -30,6 +31,12 @@ import (
 	"github.com/cert-manager/cert-manager/internal/controller/feature"
 	configv1alpha1 "github.com/cert-manager/cert-manager/pkg/apis/config/controller/v1alpha1"
 	shimgatewaycontroller "github.com/cert-manager/cert-manager/pkg/controller/certificate-shim/gateways"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates/podidentity"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates/podreadiness"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates/renewalinfo"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates/revocation"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates/secretmirror"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates/servicednsnames"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 )
@@ -126,6 +133,14 @@ func AddConfigFlags(fs *pflag.FlagSet, c *config.ControllerConfiguration) {
 	fs.BoolVar(&c.ACMEHTTP01Config.SolverRunAsNonRoot, "acme-http01-solver-run-as-non-root", c.ACMEHTTP01Config.SolverRunAsNonRoot, ""+
 		"Defines the ability to run the http01 solver as root for troubleshooting issues")
 
+	fs.Var(newJSONFlag(&c.ACMEHTTP01Config.SolverSecurityContext), "acme-http01-solver-security-context", ""+
+		"JSON encoded container securityContext to apply to ACME HTTP01 challenge solver containers. "+
+		"If not set, a locked-down profile suitable for the 'restricted' Pod Security Standard is used.")
+
+	fs.Var(newJSONFlag(&c.ACMEHTTP01Config.SolverPodSecurityContext), "acme-http01-solver-pod-security-context", ""+
+		"JSON encoded pod securityContext to apply to ACME HTTP01 challenge solver pods. "+
+		"If not set, the RuntimeDefault seccomp profile is used and runAsNonRoot is set according to --acme-http01-solver-run-as-non-root.")
+
 	fs.StringSliceVar(&c.ACMEHTTP01Config.SolverNameservers, "acme-http01-solver-nameservers",
 		c.ACMEHTTP01Config.SolverNameservers, "A list of comma separated dns server endpoints used for "+
 			"ACME HTTP01 check requests. This should be a list containing host and "+
@@ -161,16 +176,47 @@ func AddConfigFlags(fs *pflag.FlagSet, c *config.ControllerConfiguration) {
 			"environments, where access to authoritative nameservers is restricted. "+
 			"Enabling this option could cause the DNS01 self check to take longer "+
 			"due to caching performed by the recursive nameservers.")
+	fs.IntVar(&c.ACMEDNS01Config.RecursiveNameserversQuorum, "dns01-recursive-nameservers-quorum",
+		c.ACMEDNS01Config.RecursiveNameserversQuorum,
+		"The number of nameservers given in `dns01-recursive-nameservers` which must agree that the "+
+			"TXT record is present before the ACME DNS01 self check passes. Only used when "+
+			"`dns01-recursive-nameservers-only` is true. If zero, all of the nameservers must agree.")
 	fs.DurationVar(&c.ACMEDNS01Config.CheckRetryPeriod, "dns01-check-retry-period", c.ACMEDNS01Config.CheckRetryPeriod, ""+
 		"The duration the controller should wait between a propagation check. Despite the name, this flag is used to configure the wait period for both DNS01 and HTTP01 challenge propagation checks. For DNS01 challenges the propagation check verifies that a TXT record with the challenge token has been created. For HTTP01 challenges the propagation check verifies that the challenge token is served at the challenge URL."+
 		"This should be a valid duration string, for example 180s or 1h")
+	fs.DurationVar(&c.ACMEDNS01Config.CheckTimeout, "dns01-check-timeout", c.ACMEDNS01Config.CheckTimeout, ""+
+		"The maximum duration to wait for a DNS01 challenge record to propagate, measured from when it was presented, before the challenge is marked as errored. "+
+		"It can be overridden for each DNS01 solver using the propagationCheckTimeout field. A value of zero disables the timeout.")
+
+	fs.DurationVar(&c.VenafiConfig.MaxSetupRetryInterval, "venafi-max-setup-retry-interval", c.VenafiConfig.MaxSetupRetryInterval, ""+
+		"The maximum duration the controller should wait before retrying the setup of a Venafi issuer which failed to "+
+		"connect to or authenticate with the Venafi server. Failed setups are retried with an exponential backoff which "+
+		"will never exceed this duration. This should be a valid duration string, for example 180s or 1h")
+
+	fs.StringSliceVar(&c.PodIdentityConfig.AllowedIssuers, "pod-identity-allowed-issuers", c.PodIdentityConfig.AllowedIssuers, ""+
+		"The issuers which Pods may request a certificate from with the cert-manager.io/pod-identity-issuer annotations, "+
+		"each in the form <kind>.<group>/<name>, or <kind>/<name> for cert-manager's own issuer kinds, for example "+
+		"ClusterIssuer/pod-identity. Pods referencing any other issuer do not get a certificate. This does not stop "+
+		"Certificates or CertificateRequests created by users from requesting the SPIFFE URI SAN of any ServiceAccount "+
+		"from these issuers, so they should be restricted, for example with an approver policy, to Certificates "+
+		"created for Pods. Only used when the CertificatePodIdentity feature gate is enabled.")
+	fs.StringVar(&c.PodIdentityConfig.TrustDomain, "pod-identity-trust-domain", c.PodIdentityConfig.TrustDomain, ""+
+		"The SPIFFE trust domain of the URI SANs identifying the ServiceAccount of a Pod in certificates issued "+
+		"for Pod identities.")
 
 	fs.BoolVar(&c.EnableCertificateOwnerRef, "enable-certificate-owner-ref", c.EnableCertificateOwnerRef, ""+
 		"Whether to set the certificate resource as an owner of secret where the tls certificate is stored. "+
 		"When this flag is enabled, the secret will be automatically removed when the certificate resource is deleted.")
+	fs.BoolVar(&c.EnableCertificateChainVerification, "enable-certificate-chain-verification", c.EnableCertificateChainVerification, ""+
+		"Whether to verify that the certificate chain returned by an issuer is complete before it is written to the "+
+		"Certificate's Secret. The chain must build to a self-signed certificate, the issuer's CA certificate, or a root "+
+		"in the system trust store. Certificates with an incomplete chain fail issuance with the reason IncompleteChain.")
 	fs.BoolVar(&c.EnableGatewayAPI, "enable-gateway-api", c.EnableGatewayAPI, ""+
 		"Whether gateway API integration is enabled within cert-manager. The ExperimentalGatewayAPISupport "+
 		"feature gate must also be enabled (default as of 1.15).")
+	fs.BoolVar(&c.RequireFIPSMode, "require-fips-mode", c.RequireFIPSMode, ""+
+		"Whether to refuse to start if the cryptographic module is not operating in FIPS 140 mode. "+
+		"If false, only a warning is logged.")
 	fs.StringSliceVar(&c.CopiedAnnotationPrefixes, "copied-annotation-prefixes", c.CopiedAnnotationPrefixes, "Specify which annotations should/shouldn't be copied"+
 		"from Certificate to CertificateRequest and Order, as well as from CertificateSigningRequest to Order, by passing a list of annotation key prefixes."+
 		"A prefix starting with a dash(-) specifies an annotation that shouldn't be copied. Example: '*,-kubectl.kuberenetes.io/'- all annotations"+
@@ -182,6 +228,21 @@ func AddConfigFlags(fs *pflag.FlagSet, c *config.ControllerConfiguration) {
 		"The number of concurrent workers for each controller.")
 	fs.IntVar(&c.MaxConcurrentChallenges, "max-concurrent-challenges", c.MaxConcurrentChallenges, ""+
 		"The maximum number of challenges that can be scheduled as 'processing' at once.")
+	fs.StringToIntVar(&c.MaxConcurrentCertificateRequestsPerIssuer, "max-concurrent-certificate-requests-per-issuer", c.MaxConcurrentCertificateRequestsPerIssuer, ""+
+		"The maximum number of CertificateRequests that can be processed at once for each Issuer or ClusterIssuer, "+
+		"given as issuer type=limit pairs, for example 'vault=10,venafi=5'. Valid issuer types are acme, ca, kubernetescsr, "+
+		"selfsigned, signingwebhook, vault and venafi. A CertificateRequest counts towards the limit until it is issued, "+
+		"failed or denied, so pending ACME and Venafi orders are limited too. Excess CertificateRequests stay queued until "+
+		"capacity is available. Issuer types which are not listed are not limited.")
+	fs.Float32Var(&c.ACMEOrderPollJitter, "acme-order-poll-jitter", c.ACMEOrderPollJitter, ""+
+		"The maximum jitter factor applied to the interval at which pending ACME Orders are polled, "+
+		"used to spread out requests to the ACME server when many Orders are processed at once. The interval starts at "+
+		"5 seconds and doubles each time an Order is found to still be pending, up to 2 minutes. 0 disables jitter.")
+	fs.Float32Var(&c.ACMEAccountQPS, "acme-account-qps", c.ACMEAccountQPS, ""+
+		"The maximum number of new ACME Orders and Authorization requests per second sent for a single ACME account, "+
+		"shared by all Issuers using the account. Used to avoid tripping the ACME server's rate limits. 0 disables client-side rate limiting.")
+	fs.IntVar(&c.ACMEAccountBurst, "acme-account-burst", c.ACMEAccountBurst, ""+
+		"The maximum burst of new ACME Orders and Authorization requests sent for a single ACME account when --acme-account-qps is set.")
 
 	fs.StringVar(&c.MetricsListenAddress, "metrics-listen-address", c.MetricsListenAddress, ""+
 		"The host and port that the metrics endpoint should listen on.")
@@ -255,6 +316,36 @@ func EnabledControllers(o *config.ControllerConfiguration) sets.Set[string] {
 		enabled = enabled.Insert(defaults.ExperimentalCertificateSigningRequestControllers...)
 	}
 
+	if utilfeature.DefaultFeatureGate.Enabled(feature.CertificatePodReadinessGate) {
+		logf.Log.Info("enabling the certificate pod readiness gate controller")
+		enabled = enabled.Insert(podreadiness.ControllerName)
+	}
+
+	if utilfeature.DefaultFeatureGate.Enabled(feature.CertificatePodIdentity) {
+		logf.Log.Info("enabling the certificate pod identity controller")
+		enabled = enabled.Insert(podidentity.ControllerName)
+	}
+
+	if utilfeature.DefaultFeatureGate.Enabled(feature.ACMERenewalInfo) {
+		logf.Log.Info("enabling the certificate ACME renewal information controller")
+		enabled = enabled.Insert(renewalinfo.ControllerName)
+	}
+
+	if utilfeature.DefaultFeatureGate.Enabled(feature.CertificateRevocation) {
+		logf.Log.Info("enabling the certificate revocation controller")
+		enabled = enabled.Insert(revocation.ControllerName)
+	}
+
+	if utilfeature.DefaultFeatureGate.Enabled(feature.CertificateSecretMirror) {
+		logf.Log.Info("enabling the certificate secret mirror controller")
+		enabled = enabled.Insert(secretmirror.ControllerName)
+	}
+
+	if utilfeature.DefaultFeatureGate.Enabled(feature.CertificateServiceDNSNames) {
+		logf.Log.Info("enabling the certificate service DNS names controller")
+		enabled = enabled.Insert(servicednsnames.ControllerName)
+	}
+
 	if utilfeature.DefaultFeatureGate.Enabled(feature.ExperimentalGatewayAPISupport) && o.EnableGatewayAPI {
 		logf.Log.Info("enabling the sig-network Gateway API certificate-shim and HTTP-01 solver")
 		enabled = enabled.Insert(shimgatewaycontroller.ControllerName)
@@ -262,3 +353,37 @@ func EnabledControllers(o *config.ControllerConfiguration) sets.Set[string] {
 
 	return enabled
 }
+
+// jsonFlag is a pflag.Value which decodes a JSON object into the struct
+// pointed to by target.
+type jsonFlag[T any] struct {
+	target **T
+}
+
+func newJSONFlag[T any](target **T) *jsonFlag[T] {
+	return &jsonFlag[T]{target: target}
+}
+
+func (f *jsonFlag[T]) String() string {
+	if f.target == nil || *f.target == nil {
+		return ""
+	}
+	b, err := json.Marshal(*f.target)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+func (f *jsonFlag[T]) Set(value string) error {
+	v := new(T)
+	if err := json.Unmarshal([]byte(value), v); err != nil {
+		return fmt.Errorf("failed to decode JSON: %w", err)
+	}
+	*f.target = v
+	return nil
+}
+
+func (f *jsonFlag[T]) Type() string {
+	return "json"
+}
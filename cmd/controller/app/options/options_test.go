@@ -19,16 +19,32 @@ package options
 import (
 	"testing"
 
+	"github.com/spf13/pflag"
 	"k8s.io/apimachinery/pkg/util/sets"
+	featuregatetesting "k8s.io/component-base/featuregate/testing"
 
 	config "github.com/cert-manager/cert-manager/internal/apis/config/controller"
 	defaults "github.com/cert-manager/cert-manager/internal/apis/config/controller/v1alpha1"
+	"github.com/cert-manager/cert-manager/internal/controller/feature"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates/podidentity"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates/podreadiness"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates/renewalinfo"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates/revocation"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates/secretmirror"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates/servicednsnames"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 )
 
 func TestEnabledControllers(t *testing.T) {
 	tests := map[string]struct {
-		controllers []string
-		expEnabled  sets.Set[string]
+		controllers      []string
+		podReadinessGate bool
+		podIdentity      bool
+		acmeRenewalInfo  bool
+		revocation       bool
+		secretMirror     bool
+		serviceDNSNames  bool
+		expEnabled       sets.Set[string]
 	}{
 		"if no controllers enabled, return empty": {
 			controllers: []string{},
@@ -58,10 +74,47 @@ func TestEnabledControllers(t *testing.T) {
 			controllers: []string{"foo", "-bar"},
 			expEnabled:  sets.New("foo"),
 		},
+		"if the CertificatePodReadinessGate feature is enabled, enable the pod readiness controller": {
+			controllers:      []string{"*"},
+			podReadinessGate: true,
+			expEnabled:       sets.New(defaults.DefaultEnabledControllers...).Insert(podreadiness.ControllerName),
+		},
+		"if the CertificatePodIdentity feature is enabled, enable the pod identity controller": {
+			controllers: []string{"*"},
+			podIdentity: true,
+			expEnabled:  sets.New(defaults.DefaultEnabledControllers...).Insert(podidentity.ControllerName),
+		},
+		"if the ACMERenewalInfo feature is enabled, enable the ACME renewal information controller": {
+			controllers:     []string{"*"},
+			acmeRenewalInfo: true,
+			expEnabled:      sets.New(defaults.DefaultEnabledControllers...).Insert(renewalinfo.ControllerName),
+		},
+		"if the CertificateRevocation feature is enabled, enable the certificate revocation controller": {
+			controllers: []string{"*"},
+			revocation:  true,
+			expEnabled:  sets.New(defaults.DefaultEnabledControllers...).Insert(revocation.ControllerName),
+		},
+		"if the CertificateSecretMirror feature is enabled, enable the certificate secret mirror controller": {
+			controllers:  []string{"*"},
+			secretMirror: true,
+			expEnabled:   sets.New(defaults.DefaultEnabledControllers...).Insert(secretmirror.ControllerName),
+		},
+		"if the CertificateServiceDNSNames feature is enabled, enable the certificate service DNS names controller": {
+			controllers:     []string{"*"},
+			serviceDNSNames: true,
+			expEnabled:      sets.New(defaults.DefaultEnabledControllers...).Insert(servicednsnames.ControllerName),
+		},
 	}
 
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
+			defer featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultFeatureGate, feature.CertificatePodReadinessGate, test.podReadinessGate)()
+			defer featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultFeatureGate, feature.CertificatePodIdentity, test.podIdentity)()
+			defer featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultFeatureGate, feature.ACMERenewalInfo, test.acmeRenewalInfo)()
+			defer featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultFeatureGate, feature.CertificateRevocation, test.revocation)()
+			defer featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultFeatureGate, feature.CertificateSecretMirror, test.secretMirror)()
+			defer featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultFeatureGate, feature.CertificateServiceDNSNames, test.serviceDNSNames)()
+
 			o := config.ControllerConfiguration{
 				Controllers: test.controllers,
 			}
@@ -74,3 +127,34 @@ func TestEnabledControllers(t *testing.T) {
 		})
 	}
 }
+
+func TestACMEHTTP01SolverSecurityContextFlags(t *testing.T) {
+	c, err := NewControllerConfiguration()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	AddConfigFlags(fs, c)
+
+	if err := fs.Parse([]string{
+		`--acme-http01-solver-security-context={"runAsUser":1000,"readOnlyRootFilesystem":true}`,
+		`--acme-http01-solver-pod-security-context={"runAsNonRoot":true,"fsGroup":2000}`,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	sc := c.ACMEHTTP01Config.SolverSecurityContext
+	if sc == nil || sc.RunAsUser == nil || *sc.RunAsUser != 1000 || sc.ReadOnlyRootFilesystem == nil || !*sc.ReadOnlyRootFilesystem {
+		t.Errorf("unexpected solver security context: %+v", sc)
+	}
+
+	psc := c.ACMEHTTP01Config.SolverPodSecurityContext
+	if psc == nil || psc.RunAsNonRoot == nil || !*psc.RunAsNonRoot || psc.FSGroup == nil || *psc.FSGroup != 2000 {
+		t.Errorf("unexpected solver pod security context: %+v", psc)
+	}
+
+	if err := fs.Parse([]string{`--acme-http01-solver-security-context={invalid`}); err == nil {
+		t.Error("expected an error when parsing invalid JSON")
+	}
+}
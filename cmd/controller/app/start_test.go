@@ -24,6 +24,7 @@ import (
 	"path"
 	"reflect"
 	"testing"
+	"time"
 
 	logsapi "k8s.io/component-base/logs/api/v1"
 
@@ -174,6 +175,27 @@ logging:
 				cc.Logging.Format = "text"
 			}),
 		},
+		{
+			yaml: `
+apiVersion: controller.config.cert-manager.io/v1alpha1
+kind: ControllerConfiguration
+leaderElectionConfig:
+    leaseDuration: 90s
+`,
+			args: func(tempFilePath string) []string {
+				return []string{
+					"--config=" + tempFilePath,
+					"--leader-election-lease-duration=120s",
+					"--leader-election-renew-deadline=100s",
+					"--leader-election-retry-period=20s",
+				}
+			},
+			expConfig: configFromDefaults(func(tempDir string, cc *config.ControllerConfiguration) {
+				cc.LeaderElectionConfig.LeaseDuration = 120 * time.Second
+				cc.LeaderElectionConfig.RenewDeadline = 100 * time.Second
+				cc.LeaderElectionConfig.RetryPeriod = 20 * time.Second
+			}),
+		},
 	}
 
 	for i, tc := range tests {
@@ -200,3 +200,28 @@ set .installCRDs and disabled .crds.keep.
     {{- fail "ERROR: .crds.keep is not compatible with .installCRDs, please use .crds.enabled and .crds.keep instead" }}
   {{- end }}
 {{- end -}}
+
+{{/*
+Returns "true" if the given alpha feature gate is enabled on the controller,
+either by the featureGates value or by the featureGates of the controller
+config. A gate which is not set explicitly follows the AllAlpha gate.
+Usage: include "cert-manager.featureGateEnabled" (list . "<feature gate>")
+*/}}
+{{- define "cert-manager.featureGateEnabled" -}}
+{{- $root := index . 0 -}}
+{{- $gate := index . 1 -}}
+{{- $explicit := "" -}}
+{{- $allAlpha := "" -}}
+{{- with (($root.Values.config | default dict).featureGates) -}}
+  {{- if hasKey . $gate -}}{{- $explicit = toString (get . $gate) | lower -}}{{- end -}}
+  {{- if hasKey . "AllAlpha" -}}{{- $allAlpha = toString (get . "AllAlpha") | lower -}}{{- end -}}
+{{- end -}}
+{{- range splitList "," (nospace ($root.Values.featureGates | default "")) -}}
+  {{- $kv := splitList "=" . -}}
+  {{- if eq (len $kv) 2 -}}
+    {{- if eq (first $kv) $gate -}}{{- $explicit = lower (last $kv) -}}{{- end -}}
+    {{- if eq (first $kv) "AllAlpha" -}}{{- $allAlpha = lower (last $kv) -}}{{- end -}}
+  {{- end -}}
+{{- end -}}
+{{- if eq (default $allAlpha $explicit) "true" -}}true{{- end -}}
+{{- end -}}
@@ -62,7 +62,7 @@ type ChallengeSpec struct {
 	Wildcard bool
 
 	// The type of ACME challenge this resource represents.
-	// One of "HTTP-01" or "DNS-01".
+	// One of "HTTP-01", "DNS-01" or "TLS-ALPN-01".
 	Type ACMEChallengeType
 
 	// The ACME challenge token for this challenge.
@@ -90,7 +90,7 @@ type ChallengeSpec struct {
 	IssuerRef cmmeta.ObjectReference
 }
 
-// The type of ACME challenge. Only HTTP-01 and DNS-01 are supported.
+// The type of ACME challenge. Only HTTP-01, DNS-01 and TLS-ALPN-01 are supported.
 type ACMEChallengeType string
 
 const (
@@ -101,6 +101,10 @@ const (
 	// ACMEChallengeTypeDNS01 denotes a Challenge is of type dns-01
 	// More info: https://letsencrypt.org/docs/challenge-types/#dns-01-challenge
 	ACMEChallengeTypeDNS01 ACMEChallengeType = "DNS-01"
+
+	// ACMEChallengeTypeTLSALPN01 denotes a Challenge is of type tls-alpn-01
+	// More info: https://letsencrypt.org/docs/challenge-types/#tls-alpn-01
+	ACMEChallengeTypeTLSALPN01 ACMEChallengeType = "TLS-ALPN-01"
 )
 
 type ChallengeStatus struct {
@@ -121,6 +125,11 @@ type ChallengeStatus struct {
 	// configured).
 	Presented bool
 
+	// PresentedTime is the time at which the challenge values for this
+	// challenge were presented. The DNS01 propagation check timeout is
+	// measured from this time.
+	PresentedTime *metav1.Time
+
 	// Reason contains human readable information on why the Challenge is in the
 	// current state.
 	Reason string
@@ -74,6 +74,17 @@ type OrderSpec struct {
 	// Duration is the duration for the not after date for the requested certificate.
 	// this is set on order creation as pe the ACME spec.
 	Duration *metav1.Duration
+
+	// Profile is the name of the ACME certificate profile requested when
+	// the order is created, as defined in draft-ietf-acme-profiles.
+	// If not set, the ACME server's default profile is used.
+	Profile string
+
+	// Replaces is the ARI identifier of the certificate which this order is
+	// renewing, as defined in RFC 9773. It is sent to ACME servers which
+	// support ACME Renewal Information so that the renewal can be exempted
+	// from rate limits.
+	Replaces string
 }
 
 type OrderStatus struct {
@@ -19,6 +19,7 @@ package v1beta1
 import (
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	gwapi "sigs.k8s.io/gateway-api/apis/v1"
 
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
@@ -63,6 +64,14 @@ type ACMEIssuer struct {
 	// +optional
 	CABundle []byte `json:"caBundle,omitempty"`
 
+	// CABundleSecretRef is a reference to a Secret containing a bundle of PEM-encoded
+	// CAs which can be used to validate the certificate chain presented by the
+	// ACME server.
+	// Mutually exclusive with CABundle and SkipTLSVerify.
+	// If no key for the Secret is specified, cert-manager will default to 'ca.crt'.
+	// +optional
+	CABundleSecretRef *cmmeta.SecretKeySelector `json:"caBundleSecretRef,omitempty"`
+
 	// INSECURE: Enables or disables validation of the ACME server TLS certificate.
 	// If true, requests to the ACME server will not have the TLS certificate chain
 	// validated.
@@ -75,6 +84,27 @@ type ACMEIssuer struct {
 	// +optional
 	SkipTLSVerify bool `json:"skipTLSVerify,omitempty"`
 
+	// HTTPProxy is the URL of the proxy used for plain HTTP requests to the
+	// ACME server, e.g. `http://proxy.example.com:3128`.
+	//
+	// If any of httpProxy, httpsProxy or noProxy are set, they are used for
+	// requests to the ACME server made on behalf of this issuer instead of the
+	// HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables of the
+	// controller.
+	// +optional
+	HTTPProxy string `json:"httpProxy,omitempty"`
+
+	// HTTPSProxy is the URL of the proxy used for HTTPS requests to the ACME
+	// server, e.g. `http://proxy.example.com:3128`.
+	// +optional
+	HTTPSProxy string `json:"httpsProxy,omitempty"`
+
+	// NoProxy is a comma-separated list of hosts, domains and CIDRs for which
+	// requests to the ACME server are not proxied, using the same format as the
+	// NO_PROXY environment variable.
+	// +optional
+	NoProxy string `json:"noProxy,omitempty"`
+
 	// ExternalAccountBinding is a reference to a CA external account of the ACME
 	// server.
 	// If set, upon registration cert-manager will attempt to associate the given
@@ -106,6 +136,33 @@ type ACMEIssuer struct {
 	// +optional
 	DisableAccountKeyGeneration bool `json:"disableAccountKeyGeneration,omitempty"`
 
+	// AccountURI is the URI of an ACME account which has already been
+	// registered with the ACME server using the private key referenced by
+	// `privateKeySecretRef`, for example out-of-band in an air-gapped
+	// environment. If set, cert-manager will not register an account, and
+	// will instead verify that the private key belongs to this account.
+	// May only be set if `disableAccountKeyGeneration` is true.
+	// +optional
+	AccountURI string `json:"accountURI,omitempty"`
+
+	// Profile is the name of the ACME certificate profile to request when
+	// creating orders, as defined in draft-ietf-acme-profiles. If the ACME
+	// server advertises the profiles it supports in its directory, the
+	// profile must be one of them.
+	// If not set, the ACME server's default profile is used.
+	// +optional
+	Profile string `json:"profile,omitempty"`
+
+	// ChallengeTypePreference is the order in which challenge types are
+	// preferred when more than one configured solver can be used for an
+	// ACME authorization. It is only consulted when the selectors of those
+	// solvers match the authorization equally well, in which case the solver
+	// whose challenge type appears first in this list is used.
+	// If not set, the first matching solver in the solvers list is used.
+	// +optional
+	// +listType=atomic
+	ChallengeTypePreference []ACMEChallengeType `json:"challengeTypePreference,omitempty"`
+
 	// Enables requesting a Not After date on certificates that matches the
 	// duration of the certificate. This is not supported by all ACME servers
 	// like Let's Encrypt. If set to true when the ACME server does not support
@@ -113,6 +170,32 @@ type ACMEIssuer struct {
 	// Defaults to false.
 	// +optional
 	EnableDurationFeature bool `json:"enableDurationFeature,omitempty"`
+
+	// RevokeOnDelete configures cert-manager to revoke the certificate issued
+	// for a Certificate with the ACME server when the Certificate is deleted.
+	// A finalizer is added to Certificates using this issuer so that the
+	// certificate is revoked before the Certificate and its Secret are
+	// removed. If the ACME account is not authorized to revoke the
+	// certificate, the Certificate is deleted without revoking it.
+	// Requires the CertificateRevocation feature gate to be enabled on the
+	// cert-manager controller and webhook; the webhook warns if it is
+	// not.
+	// Defaults to false.
+	// +optional
+	RevokeOnDelete bool `json:"revokeOnDelete,omitempty"`
+
+	// DeactivateAccountOnDelete configures cert-manager to deactivate the
+	// ACME account with the ACME server when the issuer is deleted. A
+	// finalizer is added to the issuer so that the account is deactivated
+	// before the finalizer is removed. The account private key Secret is not
+	// held by the finalizer: if it is deleted before the issuer, for example
+	// when the issuer's namespace is deleted, the account cannot be
+	// deactivated and is left active, which is reported with an event.
+	// Deactivation cannot be undone: the account can no longer be used by any
+	// issuer sharing its private key.
+	// Defaults to false.
+	// +optional
+	DeactivateAccountOnDelete bool `json:"deactivateAccountOnDelete,omitempty"`
 }
 
 // ACMEExternalAccountBinding is a reference to a CA external account of the ACME
@@ -169,6 +252,13 @@ type ACMEChallengeSolver struct {
 	// performing the DNS01 challenge flow.
 	// +optional
 	DNS01 *ACMEChallengeSolverDNS01 `json:"dns01,omitempty"`
+
+	// Configures cert-manager to attempt to complete authorizations by
+	// performing the TLS-ALPN-01 challenge flow.
+	// It is not possible to obtain certificates for wildcard domain names
+	// (e.g. `*.example.com`) using the TLS-ALPN-01 challenge mechanism.
+	// +optional
+	TLSALPN01 *ACMEChallengeSolverTLSALPN01 `json:"tlsalpn01,omitempty"`
 }
 
 // CertificateDomainSelector selects certificates using a label selector, and
@@ -178,6 +268,12 @@ type ACMEChallengeSolver struct {
 type CertificateDNSNameSelector struct {
 	// A label selector that is used to refine the set of certificate's that
 	// this challenge solver will apply to.
+	// The labels are matched against those of the Certificate, which are
+	// copied onto its CertificateRequests and Orders.
+	// Matching labels have the lowest precedence: a solver matching dnsNames
+	// or dnsZones is selected over one matching only matchLabels, and the
+	// number of matching labels is only compared between solvers matching
+	// the same dnsNames or equally specific dnsZones.
 	// +optional
 	MatchLabels map[string]string `json:"matchLabels,omitempty"`
 
@@ -223,6 +319,22 @@ type ACMEChallengeSolverHTTP01 struct {
 	// This solver is experimental, and fields / behaviour may change in the future.
 	// +optional
 	GatewayHTTPRoute *ACMEChallengeSolverHTTP01GatewayHTTPRoute `json:"gatewayHTTPRoute,omitempty"`
+
+	// If specified, the challenge solver pods will be managed by a
+	// Deployment instead of being created as bare Pods. This allows the
+	// solver to be rescheduled if its pods are evicted, for example during
+	// node maintenance.
+	// +optional
+	Deployment *ACMEChallengeSolverHTTP01Deployment `json:"deployment,omitempty"`
+}
+
+// ACMEChallengeSolverHTTP01Deployment configures the Deployment used to run
+// HTTP01 challenge solver pods.
+type ACMEChallengeSolverHTTP01Deployment struct {
+	// Number of challenge solver pod replicas to run.
+	// Defaults to 1.
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
 }
 
 type ACMEChallengeSolverHTTP01Ingress struct {
@@ -283,6 +395,23 @@ type ACMEChallengeSolverHTTP01GatewayHTTPRoute struct {
 	ParentRefs []gwapi.ParentReference `json:"parentRefs,omitempty"`
 }
 
+// ACMEChallengeSolverTLSALPN01 contains configuration detailing how to solve
+// TLS-ALPN-01 challenges within a Kubernetes cluster.
+// A solver pod presenting the challenge certificate is created along with a
+// Service selecting it. Routing connections for the challenged domain to that
+// Service, for example by SNI on a layer 4 load balancer, is left to the user.
+type ACMEChallengeSolverTLSALPN01 struct {
+	// Optional service type for Kubernetes solver service. Supported values
+	// are NodePort or ClusterIP. If unset, defaults to NodePort.
+	// +optional
+	ServiceType corev1.ServiceType `json:"serviceType,omitempty"`
+
+	// Optional pod template used to configure the ACME challenge solver pods
+	// used for TLS-ALPN-01 challenges.
+	// +optional
+	PodTemplate *ACMEChallengeSolverHTTP01IngressPodTemplate `json:"podTemplate,omitempty"`
+}
+
 type ACMEChallengeSolverHTTP01IngressPodTemplate struct {
 	// ObjectMeta overrides for the pod used to solve HTTP01 challenges.
 	// Only the 'labels' and 'annotations' fields may be set.
@@ -364,6 +493,15 @@ type ACMEChallengeSolverDNS01 struct {
 	// +optional
 	CNAMEStrategy CNAMEStrategy `json:"cnameStrategy,omitempty"`
 
+	// PropagationCheckTimeout is the maximum time to wait for the DNS01
+	// challenge record to propagate, measured from when
+	// it was presented, before the challenge is marked as errored. It overrides the
+	// global timeout configured on the controller, which allows slowly
+	// propagating DNS providers to be given more time than others.
+	// A value of zero disables the timeout.
+	// +optional
+	PropagationCheckTimeout *metav1.Duration `json:"propagationCheckTimeout,omitempty"`
+
 	// Use the Akamai DNS zone management API to manage DNS01 challenge records.
 	// +optional
 	Akamai *ACMEIssuerDNS01ProviderAkamai `json:"akamai,omitempty"`
@@ -437,18 +575,39 @@ type ACMEIssuerDNS01ProviderAkamai struct {
 type ACMEIssuerDNS01ProviderCloudDNS struct {
 	// +optional
 	ServiceAccount *cmmeta.SecretKeySelector `json:"serviceAccountSecretRef,omitempty"`
-	Project        string                    `json:"project"`
+
+	// Project is the Google Cloud project containing the managed zones. It is
+	// used for all Cloud DNS API calls and may differ from the project of the
+	// service account or workload identity used to authenticate.
+	Project string `json:"project"`
 
 	// HostedZoneName is an optional field that tells cert-manager in which
 	// Cloud DNS zone the challenge record has to be created.
 	// If left empty cert-manager will automatically choose a zone.
 	// +optional
 	HostedZoneName string `json:"hostedZoneName,omitempty"`
+
+	// HostedZoneVisibility restricts the Cloud DNS zones which cert-manager
+	// automatically chooses from to either ``Public`` or ``Private`` managed
+	// zones. If left empty a public zone is preferred, falling back to a
+	// private zone. Ignored if ``hostedZoneName`` is set.
+	// +optional
+	HostedZoneVisibility CloudDNSZoneVisibility `json:"hostedZoneVisibility,omitempty"`
 }
 
+// CloudDNSZoneVisibility is the visibility of a Google Cloud DNS managed zone.
+// +kubebuilder:validation:Enum=Public;Private
+type CloudDNSZoneVisibility string
+
+const (
+	CloudDNSZoneVisibilityPublic  CloudDNSZoneVisibility = "Public"
+	CloudDNSZoneVisibilityPrivate CloudDNSZoneVisibility = "Private"
+)
+
 // ACMEIssuerDNS01ProviderCloudflare is a structure containing the DNS
 // configuration for Cloudflare.
-// One of `apiKeySecretRef` or `apiTokenSecretRef` must be provided.
+// One of `apiKeySecretRef`, `apiTokenSecretRef` or `zoneAPITokenSecretRefs`
+// must be provided.
 type ACMEIssuerDNS01ProviderCloudflare struct {
 	// Email of the account, only required when using API key based authentication.
 	// +optional
@@ -463,6 +622,13 @@ type ACMEIssuerDNS01ProviderCloudflare struct {
 	// API token used to authenticate with Cloudflare.
 	// +optional
 	APIToken *cmmeta.SecretKeySelector `json:"apiTokenSecretRef,omitempty"`
+
+	// ZoneAPITokens maps DNS zone names (e.g. `example.com`) to the API token
+	// used to authenticate with Cloudflare when solving challenges in that zone.
+	// The token is chosen based on the challenge's resolved zone. If no entry
+	// matches, `apiKeySecretRef` or `apiTokenSecretRef` is used instead.
+	// +optional
+	ZoneAPITokens map[string]cmmeta.SecretKeySelector `json:"zoneAPITokenSecretRefs,omitempty"`
 }
 
 // ACMEIssuerDNS01ProviderDigitalOcean is a structure containing the DNS
@@ -498,6 +664,12 @@ type ACMEIssuerDNS01ProviderRoute53 struct {
 	// +optional
 	Role string `json:"role,omitempty"`
 
+	// RoleChain is an ordered list of Role ARNs which the Route53 provider will
+	// assume in turn after Role, each using the credentials obtained from the
+	// previous role. The credentials of the last role are used to manage records.
+	// +optional
+	RoleChain []string `json:"roleChain,omitempty"`
+
 	// If set, the provider will manage only this zone in Route53 and will not do an lookup using the route53:ListHostedZonesByName api call.
 	// +optional
 	HostedZoneID string `json:"hostedZoneID,omitempty"`
@@ -620,7 +792,9 @@ type ACMEIssuerDNS01ProviderRFC2136 struct {
 	// The TSIG Algorithm configured in the DNS supporting RFC2136. Used only
 	// when ``tsigSecretSecretRef`` and ``tsigKeyName`` are defined.
 	// Supported values are (case-insensitive): ``HMACMD5`` (default),
-	// ``HMACSHA1``, ``HMACSHA256`` or ``HMACSHA512``.
+	// ``HMACSHA1``, ``HMACSHA256``, ``HMACSHA384`` or ``HMACSHA512``.
+	// The algorithm names defined in RFC 8945, such as ``hmac-sha512``, are
+	// also accepted.
 	// +optional
 	TSIGAlgorithm string `json:"tsigAlgorithm,omitempty"`
 }
@@ -649,6 +823,12 @@ type ACMEIssuerDNS01ProviderWebhook struct {
 	// implementation's documentation.
 	// +optional
 	Config *apiextensionsv1.JSON `json:"config,omitempty"`
+
+	// Timeout is the maximum time to wait for each call to the webhook
+	// apiserver to complete, after which the call fails and is retried
+	// later. If not set, calls do not time out.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
 }
 
 type ACMEIssuerStatus struct {
@@ -667,4 +847,16 @@ type ACMEIssuerStatus struct {
 	// registered ACME account, in order to track changes made to registered account
 	// associated with the Issuer
 	LastPrivateKeyHash string `json:"lastPrivateKeyHash,omitempty"`
+
+	// LastEABKeyHash is an HMAC of the External Account Binding key ID and key,
+	// keyed with the ACME account private key, recorded when the latest ACME account
+	// was registered or verified, in order to detect rotation of the External Account
+	// Binding key associated with the Issuer. ACME servers return the existing account
+	// when it is registered again with the same account private key, so a rotated key
+	// is bound by registering a new account with a newly generated account private key,
+	// which replaces the key stored in the account private key Secret. If account key
+	// generation is disabled, the account stays bound to the previous key until the
+	// account private key Secret is replaced.
+	// +optional
+	LastEABKeyHash string `json:"lastEABKeyHash,omitempty"`
 }
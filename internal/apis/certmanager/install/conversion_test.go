@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package install
+
+import (
+	"testing"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/diff"
+	"k8s.io/utils/ptr"
+
+	"github.com/cert-manager/cert-manager/internal/apis/certmanager"
+	v1 "github.com/cert-manager/cert-manager/internal/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/internal/apis/certmanager/v1alpha2"
+	"github.com/cert-manager/cert-manager/internal/apis/certmanager/v1alpha3"
+	"github.com/cert-manager/cert-manager/internal/apis/certmanager/v1beta1"
+	cmmeta "github.com/cert-manager/cert-manager/internal/apis/meta"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// TestConvertOptionalFields ensures that recently added optional fields
+// survive a round trip through each of the versions known to the scheme, as
+// the fuzzer used by TestRoundTripTypes may not populate all of them.
+func TestConvertOptionalFields(t *testing.T) {
+	scheme := runtime.NewScheme()
+	Install(scheme)
+	codecs := serializer.NewCodecFactory(scheme)
+
+	versions := []schema.GroupVersion{
+		v1.SchemeGroupVersion,
+		v1beta1.SchemeGroupVersion,
+		v1alpha3.SchemeGroupVersion,
+		v1alpha2.SchemeGroupVersion,
+	}
+
+	objects := map[string]runtime.Object{
+		"Certificate": &certmanager.Certificate{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+			Spec: certmanager.CertificateSpec{
+				CommonName: "example.com",
+				DNSNames:   []string{"example.com"},
+				SecretName: "test",
+				IssuerRef:  cmmeta.ObjectReference{Name: "ca", Kind: "Issuer"},
+				IsCA:       true,
+				Duration:   &metav1.Duration{Duration: cmapi.DefaultCertificateDuration},
+				Subject: &certmanager.X509Subject{
+					OrganizationIdentifiers: []string{"VATGB-123456789"},
+					SerialNumber:            "42",
+				},
+				RenewBeforePercentage: ptr.To(int32(25)),
+				NameConstraints: &certmanager.NameConstraints{
+					Critical: true,
+					Permitted: &certmanager.NameConstraintItem{
+						DNSDomains: []string{"example.com"},
+						IPRanges:   []string{"10.0.0.0/8"},
+						URIDomains: []string{"example.com"},
+					},
+				},
+				AdditionalOutputSecrets: []certmanager.CertificateAdditionalOutputSecret{{Name: "copy"}},
+			},
+		},
+		"Issuer": &certmanager.Issuer{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+			Spec: certmanager.IssuerSpec{
+				IssuerConfig: certmanager.IssuerConfig{
+					CA: &certmanager.CAIssuer{
+						SecretName:             "ca",
+						SignatureAlgorithm:     "SHA384WithRSA",
+						AuthorityKeyIdentifier: "0a:1b:2c:3d",
+					},
+				},
+			},
+		},
+		"ClusterIssuer": &certmanager.ClusterIssuer{
+			ObjectMeta: metav1.ObjectMeta{Name: "test"},
+			Spec: certmanager.IssuerSpec{
+				IssuerConfig: certmanager.IssuerConfig{
+					SelfSigned: &certmanager.SelfSignedIssuer{
+						IssuerDN: "CN=Example Root,O=Example Org",
+					},
+				},
+			},
+		},
+	}
+
+	for kind, obj := range objects {
+		for _, gv := range versions {
+			t.Run(kind+"/"+gv.Version, func(t *testing.T) {
+				data, err := runtime.Encode(codecs.LegacyCodec(gv), obj)
+				if err != nil {
+					t.Fatalf("failed to encode %s as %s: %v", kind, gv, err)
+				}
+
+				decoded, err := runtime.Decode(codecs.UniversalDecoder(), data)
+				if err != nil {
+					t.Fatalf("failed to decode %s from %s: %v", kind, gv, err)
+				}
+
+				// Encoding sets the TypeMeta on the original object, so compare
+				// the objects without it.
+				expected := obj.DeepCopyObject()
+				expected.GetObjectKind().SetGroupVersionKind(schema.GroupVersionKind{})
+				decoded.GetObjectKind().SetGroupVersionKind(schema.GroupVersionKind{})
+				if !apiequality.Semantic.DeepEqual(expected, decoded) {
+					t.Errorf("%s did not round trip through %s: %s", kind, gv, diff.ObjectReflectDiff(expected, decoded))
+				}
+			})
+		}
+	}
+}
@@ -69,6 +69,11 @@ const (
 
 	// Ed25519 private key algorithm.
 	Ed25519KeyAlgorithm PrivateKeyAlgorithm = "Ed25519"
+
+	// ML-DSA (FIPS 204) post-quantum private key algorithm. Experimental and
+	// only supported when the ExperimentalPostQuantumKeys feature gate is
+	// enabled and cert-manager is built with Go 1.27 or later.
+	MLDSAKeyAlgorithm PrivateKeyAlgorithm = "ML-DSA"
 )
 
 type PrivateKeyEncoding string
@@ -123,6 +128,9 @@ type CertificateSpec struct {
 	// More info: https://datatracker.ietf.org/doc/html/rfc5280#section-4.1.2.6
 	// NOTE: TLS clients will ignore this value when any subject alternative name is
 	// set (see https://tools.ietf.org/html/rfc6125#section-6.4.4).
+	// If no subject alternative names are requested, the CSR is generated with
+	// this common name only and without a subject alternative name extension,
+	// which may be needed by legacy clients which only validate the common name.
 	//
 	// Should have a length of 64 characters or fewer to avoid generating invalid CSRs.
 	// Cannot be set if the `literalSubject` field is set.
@@ -174,6 +182,21 @@ type CertificateSpec struct {
 	// Requested DNS subject alternative names.
 	DNSNames []string
 
+	// ServiceRef references a Service in the same namespace whose cluster DNS
+	// names are added to the requested DNS names: `<name>`, `<name>.<namespace>`,
+	// `<name>.<namespace>.svc` and `<name>.<namespace>.svc.<clusterDomain>`. If
+	// the Service is headless, the wildcard name
+	// `*.<name>.<namespace>.svc.<clusterDomain>` is also added, covering the
+	// DNS names of its Pods. The names are resolved by the controller and
+	// recorded in `status.serviceDNSNames`, and the Certificate is re-issued
+	// when they change.
+	// If `includeIPAddresses` is set, the IP addresses of the Service are
+	// also added to the requested IP addresses, and recorded in
+	// `status.serviceIPAddresses`.
+	// Requires the CertificateServiceDNSNames feature gate to be enabled on the
+	// controller.
+	ServiceRef *CertificateServiceReference
+
 	// Requested IP address subject alternative names.
 	IPAddresses []string
 
@@ -204,6 +227,14 @@ type CertificateSpec struct {
 	// cert-manager sets on the Certificate's Secret.
 	SecretTemplate *CertificateSecretTemplate
 
+	// DeleteSecretOnCertificateDeletion configures cert-manager to delete the
+	// Secret named by secretName when this Certificate is deleted, using a
+	// finalizer on the Certificate. The Secret is not deleted if it is
+	// managed by a different Certificate, or if another Certificate in the
+	// same namespace also uses it.
+	// Defaults to false, leaving the Secret in place.
+	DeleteSecretOnCertificateDeletion bool
+
 	// Additional keystore output formats to be stored in the Certificate's Secret.
 	Keystores *CertificateKeystores
 
@@ -224,6 +255,13 @@ type CertificateSpec struct {
 	// of requested `usages`.
 	IsCA bool
 
+	// Requests the OCSP Must-Staple TLS feature (RFC 7633). If true, the
+	// status_request TLS feature extension is added to the CSR of created
+	// CertificateRequest resources, requiring clients to check for a stapled
+	// OCSP response when connecting to servers presenting the certificate.
+	// Note that the issuer may choose to ignore the requested extension.
+	MustStaple bool
+
 	// Requested key usages and extended key usages.
 	// These usages are used to set the `usages` field on the created CertificateRequest
 	// resources. If `encodeUsagesInRequest` is unset or set to `true`, the usages
@@ -261,6 +299,33 @@ type CertificateSpec struct {
 	// the controller and webhook components.
 	AdditionalOutputFormats []CertificateAdditionalOutputFormat
 
+	// ChainOrder controls how the certificate chain is assembled into the
+	// `tls.crt` key of this Certificate's target Secret.
+	// Allowed values are `leaf-only`, `leaf-then-intermediates` and
+	// `full-chain-with-root`.
+	// If unset, the chain is written as returned by the issuer.
+	// Changes to this field take effect on the next issuance.
+	ChainOrder CertificateChainOrder
+
+	// AdditionalOutputSecrets is a list of additional Secrets, in the same
+	// namespace as the Certificate, which are kept in sync with the contents of
+	// the Certificate's target Secret. Secrets which are removed from this list
+	// are no longer updated, but are left in place. An existing Secret which is
+	// not an additional output Secret of this Certificate is never written to.
+	AdditionalOutputSecrets []CertificateAdditionalOutputSecret
+
+	// SecretMirrorNamespaces is a list of other namespaces to which the
+	// Certificate's target Secret is mirrored. Mirrored Secrets have the same
+	// name and contents as the target Secret, are kept in sync when it is
+	// renewed, and are deleted when their namespace is removed from this list
+	// or the Certificate is deleted.
+	// A namespace only receives a mirrored Secret if it is annotated with
+	// `cert-manager.io/allow-secret-mirror-from`, listing the namespace of
+	// this Certificate or `*`.
+	// Requires the CertificateSecretMirror feature gate to be enabled on the
+	// controller.
+	SecretMirrorNamespaces []string
+
 	// x.509 certificate NameConstraint extension which MUST NOT be used in a non-CA certificate.
 	// More Info: https://datatracker.ietf.org/doc/html/rfc5280#section-4.2.1.10
 	//
@@ -296,9 +361,29 @@ type CertificatePrivateKey struct {
 	// to await user intervention.
 	// If set to `Always`, a private key matching the specified requirements
 	// will be generated whenever a re-issuance occurs.
+	// If set to `Scheduled`, the private key will be reused on re-issuance
+	// until it is older than `rotationInterval`, at which point a new private
+	// key is generated and the certificate is re-issued, even if it is not
+	// yet due for renewal.
 	// Default is `Never` for backward compatibility.
 	RotationPolicy PrivateKeyRotationPolicy
 
+	// RotationInterval is the maximum age of the private key when the
+	// RotationPolicy is `Scheduled`. Once the private key is older than this
+	// interval a new one is generated, independently of the certificate's
+	// renewal time. Must be set if, and only if, the RotationPolicy is
+	// `Scheduled`, and must be at least 1 hour.
+	RotationInterval *metav1.Duration
+
+	// ExistingSecretRef references a key in a Secret resource, in the same
+	// namespace as the Certificate, containing a pre-generated PEM encoded
+	// private key. If set, the certificate signing request is built from this
+	// key instead of cert-manager generating one. The key must match
+	// `algorithm` and `size`, and is never rotated by cert-manager, so
+	// `rotationPolicy` must be unset or `Never`.
+	// The `key` defaults to `tls.key` if not specified.
+	ExistingSecretRef *cmmeta.SecretKeySelector
+
 	// The private key cryptography standards (PKCS) encoding for this
 	// certificate's private key to be encoded in.
 	//
@@ -315,6 +400,9 @@ type CertificatePrivateKey struct {
 	// key size of 2048 will be used for `RSA` key algorithm and
 	// key size of 256 will be used for `ECDSA` key algorithm.
 	// key size is ignored when using the `Ed25519` key algorithm.
+	// The experimental `ML-DSA` key algorithm requires the
+	// ExperimentalPostQuantumKeys feature gate and uses a key size of 65 if
+	// `size` is not provided.
 	Algorithm PrivateKeyAlgorithm
 
 	// Size is the key bit size of the corresponding private key for this certificate.
@@ -324,6 +412,8 @@ type CertificatePrivateKey struct {
 	// If `algorithm` is set to `ECDSA`, valid values are `256`, `384` or `521`,
 	// and will default to `256` if not specified.
 	// If `algorithm` is set to `Ed25519`, Size is ignored.
+	// If `algorithm` is set to `ML-DSA`, valid values are `44`, `65` or `87`,
+	// selecting the ML-DSA parameter set, and will default to `65` if not specified.
 	// No other values are allowed.
 	Size int
 }
@@ -342,13 +432,42 @@ var (
 	// RotationPolicyAlways means a private key matching the specified
 	// requirements will be generated whenever a re-issuance occurs.
 	RotationPolicyAlways PrivateKeyRotationPolicy = "Always"
+
+	// RotationPolicyScheduled means the private key will be reused on
+	// re-issuance until it is older than the rotation interval, at which
+	// point a new private key is generated and the certificate re-issued.
+	RotationPolicyScheduled PrivateKeyRotationPolicy = "Scheduled"
+)
+
+// CertificateChainOrder controls which certificates of the signed chain are
+// written to the `tls.crt` key of the Certificate's target Secret, and in
+// which order.
+// Allowed values are `leaf-only`, `leaf-then-intermediates` and
+// `full-chain-with-root`.
+type CertificateChainOrder string
+
+const (
+	// CertificateChainOrderLeafOnly writes only the leaf certificate.
+	CertificateChainOrderLeafOnly CertificateChainOrder = "leaf-only"
+
+	// CertificateChainOrderLeafThenIntermediates writes the leaf certificate
+	// followed by any intermediate certificates, omitting self-signed root
+	// certificates.
+	CertificateChainOrderLeafThenIntermediates CertificateChainOrder = "leaf-then-intermediates"
+
+	// CertificateChainOrderFullChainWithRoot writes the leaf certificate
+	// followed by any intermediate certificates and ends with the root
+	// certificate, which is taken from the CA returned by the issuer if it is
+	// not present in the chain.
+	CertificateChainOrderFullChainWithRoot CertificateChainOrder = "full-chain-with-root"
 )
 
 // CertificateOutputFormatType specifies which additional output formats should
 // be written to the Certificate's target Secret.
 // Allowed values are `DER` or `CombinedPEM`.
-// When Type is set to `DER` an additional entry `key.der` will be written to
-// the Secret, containing the binary format of the private key.
+// When Type is set to `DER` the additional entries `key.der` and `tls.der`
+// will be written to the Secret, containing the binary format of the private
+// key and of the leaf certificate respectively.
 // When Type is set to `CombinedPEM` an additional entry `tls-combined.pem`
 // will be written to the Secret, containing the PEM formatted private key and
 // signed certificate chain (tls.key + tls.crt concatenated).
@@ -356,7 +475,8 @@ type CertificateOutputFormatType string
 
 const (
 	// CertificateOutputFormatDER  writes the Certificate's private key in DER
-	// binary format to the `key.der` target Secret Data key.
+	// binary format to the `key.der` target Secret Data key, and the leaf
+	// certificate in DER binary format to the `tls.der` target Secret Data key.
 	CertificateOutputFormatDER CertificateOutputFormatType = "DER"
 
 	// CertificateOutputFormatCombinedPEM  writes the Certificate's signed
@@ -377,6 +497,31 @@ type CertificateAdditionalOutputFormat struct {
 	Type CertificateOutputFormatType
 }
 
+// CertificateAdditionalOutputSecret references an additional Secret which the
+// contents of the Certificate's target Secret are written to.
+type CertificateAdditionalOutputSecret struct {
+	// Name of the Secret.
+	Name string
+}
+
+// CertificateServiceReference references a Service whose cluster DNS names,
+// and optionally IP addresses, are added to the subject alternative names of
+// a Certificate.
+type CertificateServiceReference struct {
+	// Name of the Service, which must be in the same namespace as the
+	// Certificate.
+	Name string
+
+	// ClusterDomain is the DNS domain of the cluster, used to build the fully
+	// qualified name of the Service. Defaults to `cluster.local`.
+	ClusterDomain string
+
+	// IncludeIPAddresses adds the cluster IPs of the Service, and the ingress
+	// IPs of a LoadBalancer Service once they have been assigned, to the
+	// requested IP addresses.
+	IncludeIPAddresses bool
+}
+
 // X509Subject Full X509 name specification
 type X509Subject struct {
 	// Organizations to be used on the Certificate.
@@ -395,6 +540,9 @@ type X509Subject struct {
 	PostalCodes []string
 	// Serial number to be used on the Certificate.
 	SerialNumber string
+	// Organization identifiers (OID 2.5.4.97) to be used on the Certificate,
+	// e.g. `VATGB-123456789`. See ETSI EN 319 412-1 for the recommended format.
+	OrganizationIdentifiers []string
 }
 
 // CertificateKeystores configures additional keystore output formats to be
@@ -452,7 +600,8 @@ type PKCS12Keystore struct {
 	PasswordSecretRef cmmeta.SecretKeySelector
 
 	// Profile specifies the key and certificate encryption algorithms and the HMAC algorithm
-	// used to create the PKCS12 keystore. Default value is `LegacyRC2` for backward compatibility.
+	// used to create the PKCS12 keystore. Default value is `LegacyRC2` for backward compatibility,
+	// or `Modern2023` if cert-manager is running in FIPS mode.
 	//
 	// If provided, allowed values are:
 	// `LegacyRC2`: Deprecated. Not supported by default in OpenSSL 3 or Java 20.
@@ -532,6 +681,75 @@ type CertificateStatus struct {
 	// delay till the next issuance will be calculated using formula
 	// time.Hour * 2 ^ (failedIssuanceAttempts - 1).
 	FailedIssuanceAttempts *int
+
+	// LastPrivateKeyRotationTime is the time at which a new private key was
+	// last issued for this certificate. It is only tracked when the private
+	// key RotationPolicy is `Scheduled`, and is used to determine when the
+	// private key is next due to be rotated.
+	LastPrivateKeyRotationTime *metav1.Time
+
+	// RenewalInfo is the ACME Renewal Information (ARI) most recently
+	// fetched for the current certificate, if the certificate was issued by
+	// an ACME server which supports it. When set, the certificate is renewed
+	// within the window suggested by the ACME server rather than at the time
+	// derived from `renewBefore`.
+	RenewalInfo *CertificateRenewalInfo
+
+	// LastRevocation records the most recent revocation of a certificate
+	// issued for this Certificate, as requested with the
+	// `cert-manager.io/revoked-serial-number` annotation. If the certificate
+	// stored in the Secret has the recorded serial number, it is re-issued.
+	LastRevocation *CertificateRevocation
+
+	// ServiceDNSNames are the DNS names resolved from the Service referenced
+	// by `spec.serviceRef`, which are requested in addition to `spec.dnsNames`.
+	ServiceDNSNames []string
+
+	// ServiceIPAddresses are the IP addresses resolved from the Service
+	// referenced by `spec.serviceRef` when `includeIPAddresses` is set, which
+	// are requested in addition to `spec.ipAddresses`.
+	ServiceIPAddresses []string
+}
+
+// CertificateRenewalInfo contains the renewal window suggested by an ACME
+// server for a certificate, as described in RFC 9773.
+type CertificateRenewalInfo struct {
+	// CertID is the ARI identifier of the certificate this renewal
+	// information applies to.
+	CertID string
+
+	// SuggestedWindowStart is the start of the window in which the ACME
+	// server suggests the certificate is renewed.
+	SuggestedWindowStart metav1.Time
+
+	// SuggestedWindowEnd is the end of the window in which the ACME server
+	// suggests the certificate is renewed.
+	SuggestedWindowEnd metav1.Time
+
+	// ExplanationURL is a URL provided by the ACME server explaining the
+	// suggested window, for example following a revocation event.
+	ExplanationURL string
+
+	// NextUpdateTime is the time at which the renewal information will
+	// next be fetched, as advised by the ACME server's Retry-After header.
+	NextUpdateTime *metav1.Time
+}
+
+// CertificateRevocation records the revocation of a certificate previously
+// issued for a Certificate.
+type CertificateRevocation struct {
+	// SerialNumber is the serial number of the revoked certificate, encoded
+	// as lowercase hexadecimal.
+	SerialNumber string
+
+	// RevocationTime is the time at which cert-manager recorded the
+	// revocation.
+	RevocationTime metav1.Time
+
+	// RevokedWithIssuer is true if cert-manager revoked the certificate with
+	// the ACME server of the issuer, as requested with the
+	// `acme.cert-manager.io/revoke` annotation.
+	RevokedWithIssuer bool
 }
 
 // CertificateCondition contains condition information for an Certificate.
@@ -591,6 +809,11 @@ const (
 	//
 	// It will be removed by the 'issuing' controller upon completing issuance.
 	CertificateConditionIssuing CertificateConditionType = "Issuing"
+
+	// A condition added to Certificate resources when reconciliation has been
+	// paused using the `cert-manager.io/paused` annotation. It is removed
+	// once the annotation is removed.
+	CertificateConditionPaused CertificateConditionType = "Paused"
 )
 
 // CertificateSecretTemplate defines the default labels and annotations
@@ -603,6 +826,21 @@ type CertificateSecretTemplate struct {
 	// Labels is a key value map to be copied to the target Kubernetes Secret.
 	// +optional
 	Labels map[string]string
+
+	// RenderTemplates enables rendering of Annotations and Labels values as Go
+	// templates against the issued certificate. The fields `.NotBefore`,
+	// `.NotAfter`, `.SerialNumber` and `.Issuer` are available, along with the
+	// functions `rfc3339`, `unix`, `lower`, `upper` and `replace`.
+	// Templated labels must render to a valid label value for an example
+	// certificate issued by "CN=example-issuer", so `.Issuer` can only be used
+	// in a label with `replace` to remove characters such as `=`. A templated
+	// label whose value rendered for the issued certificate is still not a
+	// valid label value, for example because the issuer name contains a space,
+	// is not set on the Secret and a warning event is recorded on the
+	// Certificate.
+	// If false, values are copied as is.
+	// +optional
+	RenderTemplates bool
 }
 
 // NameConstraints is a type to represent x509 NameConstraints
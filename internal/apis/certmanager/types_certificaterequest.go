@@ -172,6 +172,30 @@ type CertificateRequestStatus struct {
 	// FailureTime stores the time that this CertificateRequest failed. This is
 	// used to influence garbage collection and back-off.
 	FailureTime *metav1.Time
+
+	// DeniedBy is the reason of the Denied condition of a denied CertificateRequest,
+	// which by convention identifies the approval controller which denied it,
+	// for example "policy.cert-manager.io". It is only set once the CertificateRequest
+	// has been denied.
+	DeniedBy string
+
+	// DeniedTime is the LastTransitionTime of the Denied condition of a denied
+	// CertificateRequest. It is only set once the CertificateRequest has been denied.
+	DeniedTime *metav1.Time
+
+	// PickupID is the identifier of the request submitted to an external issuer,
+	// such as a Venafi pickup ID. It is used to retrieve the certificate of the
+	// existing request, rather than submitting a new one, when the
+	// CertificateRequest is reconciled again.
+	PickupID string
+
+	// IssuanceFailures records the most recent failures to issue a certificate
+	// for this CertificateRequest, oldest first. Failures are kept after the
+	// certificate is issued, to help diagnose transient errors such as those
+	// of a flapping issuer. At most 5 failures are recorded. Consecutive
+	// failures with the same reason are recorded once, with the time of the
+	// first of them.
+	IssuanceFailures []CertificateRequestIssuanceFailure
 }
 
 // CertificateRequestCondition contains condition information for a CertificateRequest.
@@ -196,6 +220,19 @@ type CertificateRequestCondition struct {
 	Message string
 }
 
+// CertificateRequestIssuanceFailure records a failed attempt to issue a
+// certificate for a CertificateRequest.
+type CertificateRequestIssuanceFailure struct {
+	// Time is the time at which the failure occurred.
+	Time metav1.Time
+
+	// Reason is a brief machine readable explanation of the failure.
+	Reason string
+
+	// Message is a human readable description of the failure.
+	Message string
+}
+
 // CertificateRequestConditionType represents an Certificate condition value.
 type CertificateRequestConditionType string
 
@@ -82,6 +82,28 @@ type IssuerList struct {
 // configuration required for the issuer.
 type IssuerSpec struct {
 	IssuerConfig
+
+	// CertificateDefaults are applied by the webhook to Certificates which
+	// reference this issuer and do not set the corresponding fields. Values
+	// set on the Certificate always take precedence. Defaults are only
+	// applied when a Certificate is created or updated, so changes to them
+	// do not affect existing Certificates until they are next updated.
+	// Requires the IssuerCertificateDefaults feature gate to be enabled on the
+	// webhook.
+	CertificateDefaults *IssuerCertificateDefaults
+}
+
+// IssuerCertificateDefaults are the defaults applied to Certificates which
+// reference an issuer and do not set the corresponding fields themselves.
+type IssuerCertificateDefaults struct {
+	// Duration is the default requested 'duration' (i.e. lifetime) of
+	// Certificates referencing this issuer.
+	Duration *metav1.Duration
+
+	// RenewBefore is the default 'renewBefore' of Certificates referencing
+	// this issuer. It is not applied to Certificates which set
+	// `renewBeforePercentage`, or whose duration is not longer than it.
+	RenewBefore *metav1.Duration
 }
 
 // IssuerConfig is a generic wrapper around custom issuer types
@@ -106,6 +128,18 @@ type IssuerConfig struct {
 	// Venafi configures this issuer to sign certificates using a Venafi TPP
 	// or Venafi Cloud policy zone.
 	Venafi *VenafiIssuer
+
+	// SigningWebhook configures this issuer to delegate the signing of
+	// certificates to an external signing webhook, for example one which is
+	// backed by an offline CA.
+	SigningWebhook *SigningWebhookIssuer
+
+	// KubernetesCSR configures this issuer to sign certificates by creating
+	// Kubernetes CertificateSigningRequests for a signer running in the
+	// cluster.
+	// It is only supported by ClusterIssuers, since the controller creates
+	// the cluster-scoped CertificateSigningRequests with its own identity.
+	KubernetesCSR *KubernetesCSRIssuer
 }
 
 // VenafiIssuer configures an issuer to sign certificates using a Venafi TPP
@@ -114,6 +148,8 @@ type VenafiIssuer struct {
 	// Zone is the Venafi Policy Zone to use for this issuer.
 	// All requests made to the Venafi platform will be restricted by the named
 	// zone policy.
+	// For Venafi Cloud, the zone must be of the form
+	// `<application name>\<issuing template alias>`.
 	// This field is required.
 	Zone string
 
@@ -124,6 +160,13 @@ type VenafiIssuer struct {
 	// Cloud specifies the Venafi cloud configuration settings.
 	// Only one of TPP or Cloud may be specified.
 	Cloud *VenafiCloud
+
+	// CredentialCheckInterval is the interval at which cert-manager will
+	// re-verify the credentials used to connect to the Venafi server, so that
+	// credentials which expire or are revoked are detected and reported in the
+	// issuer's Ready condition. If not set, credentials are only verified when
+	// the issuer is first set up or when it changes.
+	CredentialCheckInterval *metav1.Duration
 }
 
 // VenafiTPP defines connection configuration details for a Venafi TPP instance
@@ -149,6 +192,55 @@ type VenafiTPP struct {
 	// If neither CABundle nor CABundleSecretRef is defined, the certificate bundle in
 	// the cert-manager controller container is used to validate the TLS connection.
 	CABundleSecretRef *cmmeta.SecretKeySelector `json:"caBundleSecretRef,omitempty"`
+
+	// Reference to a Secret containing a PEM-encoded Client Certificate to use when the
+	// TPP server requires mTLS. Only used if using HTTPS; ignored for HTTP.
+	// If no key for the Secret is specified, cert-manager will default to 'tls.crt'.
+	ClientCertSecretRef *cmmeta.SecretKeySelector
+
+	// Reference to a Secret containing a PEM-encoded Client Private Key to use when the
+	// TPP server requires mTLS. Only used if using HTTPS; ignored for HTTP.
+	// If no key for the Secret is specified, cert-manager will default to 'tls.key'.
+	ClientKeySecretRef *cmmeta.SecretKeySelector
+
+	// AllowedZoneOverrides is a list of Venafi zones which CertificateRequests
+	// may select using the "venafi.cert-manager.io/zone" annotation, in place of
+	// the Zone configured on this issuer.
+	// If empty, zone overrides are not permitted.
+	AllowedZoneOverrides []string `json:"allowedZoneOverrides,omitempty"`
+
+	// PickupTimeout is the maximum amount of time cert-manager will wait for
+	// a requested certificate to be issued each time it is retrieved from
+	// the Venafi TPP instance, for example while the request is awaiting
+	// manual approval. If the certificate has not been issued by then, the
+	// CertificateRequest is kept Pending and retrieval is retried later.
+	// Defaults to 60 seconds, and must not be greater than 2 minutes so that
+	// retrieval completes within a single sync of the CertificateRequest.
+	PickupTimeout *metav1.Duration
+
+	// CustomFields is a list of Venafi custom fields which are set on every
+	// certificate requested from the Venafi TPP instance, for example a cost
+	// center or application ID required by the TPP policy. Values set in the
+	// "venafi.cert-manager.io/custom-fields" annotation of a
+	// CertificateRequest take precedence over values set here.
+	CustomFields []VenafiCustomField
+}
+
+// VenafiCustomField is a Venafi custom field which is set on certificates
+// requested from a Venafi TPP instance.
+type VenafiCustomField struct {
+	// Name is the name of the custom field, as configured in Venafi TPP.
+	Name string
+
+	// Value is the default value of the custom field.
+	Value string
+
+	// Required specifies that a value must be set for this custom field,
+	// either in Value or in the "venafi.cert-manager.io/custom-fields"
+	// annotation of the CertificateRequest. CertificateRequests without a
+	// value for a required custom field fail without being submitted to
+	// Venafi TPP.
+	Required bool
 }
 
 // VenafiCloud defines connection configuration details for Venafi Cloud
@@ -168,6 +260,51 @@ type SelfSignedIssuer struct {
 	// the location of the CRL from which the revocation of this certificate can be checked.
 	// If not set certificate will be issued without CDP. Values are strings.
 	CRLDistributionPoints []string
+
+	// IssuerDN is the distinguished name, in RFC 4514 format, that will be set as
+	// the Issuer of certificates signed by this issuer. If not set, the Issuer
+	// will be the same as the certificate's Subject.
+	IssuerDN string
+}
+
+// SigningWebhookIssuer configures an issuer to delegate the signing of
+// certificates to an external signing webhook, so that cert-manager does not
+// need to hold any CA key material. This can be used to issue certificates from
+// an offline CA.
+type SigningWebhookIssuer struct {
+	// URL is the HTTPS endpoint of the signing webhook, e.g:
+	// "https://signer.example.com/sign".
+	// Certificate signing requests are POSTed to this URL, which must respond
+	// with the signed certificate.
+	URL string
+
+	// Base64-encoded bundle of PEM CAs which will be used to validate the certificate
+	// chain presented by the signing webhook.
+	// If undefined, the certificate bundle in the cert-manager controller container
+	// is used to validate the TLS connection.
+	CABundle []byte
+
+	// Reference to a Secret containing a PEM-encoded Client Certificate to use when the
+	// signing webhook requires mTLS.
+	// Must be specified together with ClientKeySecretRef.
+	ClientCertSecretRef *cmmeta.SecretKeySelector
+
+	// Reference to a Secret containing a PEM-encoded Client Private Key to use when the
+	// signing webhook requires mTLS.
+	// Must be specified together with ClientCertSecretRef.
+	ClientKeySecretRef *cmmeta.SecretKeySelector
+}
+
+// KubernetesCSRIssuer configures an issuer to sign certificates by creating
+// Kubernetes CertificateSigningRequest resources for a signer running in the
+// cluster, for example the kube-controller-manager's built-in signers.
+type KubernetesCSRIssuer struct {
+	// SignerName is the name of the Kubernetes signer which should sign the
+	// CertificateSigningRequests created for this issuer, e.g:
+	// "kubernetes.io/kubelet-serving".
+	// The CertificateSigningRequests must be approved before they are signed,
+	// either by an approver for this signer or by a cluster administrator.
+	SignerName string
 }
 
 // VaultIssuer configures an issuer to sign certificates using a HashiCorp Vault
@@ -187,6 +324,12 @@ type VaultIssuer struct {
 	// More about namespaces can be found here https://www.vaultproject.io/docs/enterprise/namespaces
 	Namespace string
 
+	// AllowedNamespaceOverrides is a list of Vault namespaces which
+	// CertificateRequests may select using the "vault.cert-manager.io/namespace"
+	// annotation, in place of the Namespace configured on this issuer.
+	// If empty, namespace overrides are not permitted.
+	AllowedNamespaceOverrides []string
+
 	// Base64-encoded bundle of PEM CAs which will be used to validate the certificate
 	// chain presented by Vault. Only used if using HTTPS to connect to Vault and
 	// ignored for HTTP connections.
@@ -333,6 +476,11 @@ type CAIssuer struct {
 
 	// The CRL distribution points is an X.509 v3 certificate extension which identifies
 	// the location of the CRL from which the revocation of this certificate can be checked.
+	// Each entry may reference the variables ${serial}, the hex encoded serial number of
+	// the certificate being signed, and ${issuerName}, the name of this issuer resource,
+	// which are substituted for every issued certificate.
+	// Any other "$" is copied as is. Every entry must render to an absolute URL
+	// with a host, except ldap URLs which may leave the host empty.
 	// If not set, certificates will be issued without distribution points set.
 	CRLDistributionPoints []string
 
@@ -348,8 +496,88 @@ type CAIssuer struct {
 	// As an example, such a URL might be "http://ca.domain.com/ca.crt".
 	// +optional
 	IssuingCertificateURLs []string `json:"issuingCertificateURLs,omitempty"`
+
+	// ValidateOnly, if true, puts the issuer into a dry-run mode in which the
+	// CA certificate and private key in the Secret are checked to form a valid,
+	// unexpired signing pair with the CA basic constraint and certificate
+	// signing key usage set. The result of these checks is reported on the
+	// Ready condition, but the issuer never becomes Ready and so will not
+	// issue any certificates.
+	ValidateOnly bool
+
+	// CAExpiryPolicy controls how requests for certificates which would
+	// expire after the CA certificate are handled. If set to `Clamp`, the
+	// NotAfter of such certificates is capped to shortly before the expiry of
+	// the CA certificate. If set to `Reject`, such requests are rejected. If
+	// set to `Ignore`, certificates are issued with the requested duration.
+	// Defaults to `Ignore` if not specified.
+	CAExpiryPolicy CAExpiryPolicy
+
+	// AllowedExtraExtensions is a list of X.509 extension OIDs, in dotted
+	// decimal notation, which are copied from the certificate signing request
+	// into the issued certificate. Extensions which cert-manager does not
+	// otherwise understand and which are not listed here are dropped.
+	// Extensions which cert-manager computes itself, such as basicConstraints,
+	// subjectAltName, nameConstraints, extKeyUsage and authorityInfoAccess,
+	// must not be listed.
+	AllowedExtraExtensions []string
+
+	// SerialNumberStrategy controls how serial numbers of issued certificates
+	// are generated. If set to `random`, serial numbers are randomly generated.
+	// If set to `sequential`, serial numbers are allocated from a counter which
+	// is persisted in a Secret named `<secretName>-serial-number` alongside the
+	// CA secret, so that all issuers sharing a CA share the same sequence.
+	// Defaults to `random` if not specified.
+	SerialNumberStrategy CASerialNumberStrategy
+
+	// SignatureAlgorithm overrides the algorithm used to sign certificates
+	// issued by this issuer, for example `SHA384WithRSA` or `ECDSAWithSHA384`.
+	// It must be compatible with the type of the CA's private key. If not set,
+	// the signature algorithm is derived from the CA's private key.
+	// +optional
+	SignatureAlgorithm string
+
+	// AuthorityKeyIdentifier overrides the key identifier in the authority key
+	// identifier extension of certificates issued by this issuer, for example
+	// when cross-signing. It must be hex encoded, optionally with the bytes
+	// separated by colons. If not set, the subject key identifier of the CA
+	// certificate is used.
+	// +optional
+	AuthorityKeyIdentifier string
 }
 
+// CAExpiryPolicy denotes how a CA issuer handles requests for certificates
+// which would expire after the CA certificate.
+type CAExpiryPolicy string
+
+var (
+	// CAExpiryPolicyIgnore means certificates are issued with the requested
+	// duration, even if they expire after the CA certificate.
+	CAExpiryPolicyIgnore CAExpiryPolicy = "Ignore"
+
+	// CAExpiryPolicyClamp means the NotAfter of certificates which would
+	// expire after the CA certificate is capped to shortly before the expiry
+	// of the CA certificate.
+	CAExpiryPolicyClamp CAExpiryPolicy = "Clamp"
+
+	// CAExpiryPolicyReject means requests for certificates which would expire
+	// after the CA certificate are rejected.
+	CAExpiryPolicyReject CAExpiryPolicy = "Reject"
+)
+
+// CASerialNumberStrategy denotes how the serial numbers of certificates issued
+// by a CA issuer are generated.
+type CASerialNumberStrategy string
+
+var (
+	// RandomSerialNumberStrategy means serial numbers are randomly generated.
+	RandomSerialNumberStrategy CASerialNumberStrategy = "random"
+
+	// SequentialSerialNumberStrategy means serial numbers are allocated from
+	// a monotonically increasing counter.
+	SequentialSerialNumberStrategy CASerialNumberStrategy = "sequential"
+)
+
 // IssuerStatus contains status information about an Issuer
 type IssuerStatus struct {
 	// List of status conditions to indicate the status of a CertificateRequest.
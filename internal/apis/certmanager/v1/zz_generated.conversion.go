@@ -74,6 +74,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateAdditionalOutputSecret)(nil), (*certmanager.CertificateAdditionalOutputSecret)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateAdditionalOutputSecret_To_certmanager_CertificateAdditionalOutputSecret(a.(*v1.CertificateAdditionalOutputSecret), b.(*certmanager.CertificateAdditionalOutputSecret), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateAdditionalOutputSecret)(nil), (*v1.CertificateAdditionalOutputSecret)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateAdditionalOutputSecret_To_v1_CertificateAdditionalOutputSecret(a.(*certmanager.CertificateAdditionalOutputSecret), b.(*v1.CertificateAdditionalOutputSecret), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.CertificateCondition)(nil), (*certmanager.CertificateCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_CertificateCondition_To_certmanager_CertificateCondition(a.(*v1.CertificateCondition), b.(*certmanager.CertificateCondition), scope)
 	}); err != nil {
@@ -114,6 +124,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateRenewalInfo)(nil), (*certmanager.CertificateRenewalInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateRenewalInfo_To_certmanager_CertificateRenewalInfo(a.(*v1.CertificateRenewalInfo), b.(*certmanager.CertificateRenewalInfo), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRenewalInfo)(nil), (*v1.CertificateRenewalInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRenewalInfo_To_v1_CertificateRenewalInfo(a.(*certmanager.CertificateRenewalInfo), b.(*v1.CertificateRenewalInfo), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.CertificateRequest)(nil), (*certmanager.CertificateRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_CertificateRequest_To_certmanager_CertificateRequest(a.(*v1.CertificateRequest), b.(*certmanager.CertificateRequest), scope)
 	}); err != nil {
@@ -134,6 +154,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateRequestIssuanceFailure)(nil), (*certmanager.CertificateRequestIssuanceFailure)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateRequestIssuanceFailure_To_certmanager_CertificateRequestIssuanceFailure(a.(*v1.CertificateRequestIssuanceFailure), b.(*certmanager.CertificateRequestIssuanceFailure), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRequestIssuanceFailure)(nil), (*v1.CertificateRequestIssuanceFailure)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRequestIssuanceFailure_To_v1_CertificateRequestIssuanceFailure(a.(*certmanager.CertificateRequestIssuanceFailure), b.(*v1.CertificateRequestIssuanceFailure), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.CertificateRequestList)(nil), (*certmanager.CertificateRequestList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_CertificateRequestList_To_certmanager_CertificateRequestList(a.(*v1.CertificateRequestList), b.(*certmanager.CertificateRequestList), scope)
 	}); err != nil {
@@ -174,6 +204,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateServiceReference)(nil), (*certmanager.CertificateServiceReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateServiceReference_To_certmanager_CertificateServiceReference(a.(*v1.CertificateServiceReference), b.(*certmanager.CertificateServiceReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateServiceReference)(nil), (*v1.CertificateServiceReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateServiceReference_To_v1_CertificateServiceReference(a.(*certmanager.CertificateServiceReference), b.(*v1.CertificateServiceReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateRevocation)(nil), (*certmanager.CertificateRevocation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateRevocation_To_certmanager_CertificateRevocation(a.(*v1.CertificateRevocation), b.(*certmanager.CertificateRevocation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRevocation)(nil), (*v1.CertificateRevocation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRevocation_To_v1_CertificateRevocation(a.(*certmanager.CertificateRevocation), b.(*v1.CertificateRevocation), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.CertificateSpec)(nil), (*certmanager.CertificateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_CertificateSpec_To_certmanager_CertificateSpec(a.(*v1.CertificateSpec), b.(*certmanager.CertificateSpec), scope)
 	}); err != nil {
@@ -224,6 +274,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.IssuerCertificateDefaults)(nil), (*certmanager.IssuerCertificateDefaults)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_IssuerCertificateDefaults_To_certmanager_IssuerCertificateDefaults(a.(*v1.IssuerCertificateDefaults), b.(*certmanager.IssuerCertificateDefaults), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.IssuerCertificateDefaults)(nil), (*v1.IssuerCertificateDefaults)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_IssuerCertificateDefaults_To_v1_IssuerCertificateDefaults(a.(*certmanager.IssuerCertificateDefaults), b.(*v1.IssuerCertificateDefaults), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.IssuerCondition)(nil), (*certmanager.IssuerCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_IssuerCondition_To_certmanager_IssuerCondition(a.(*v1.IssuerCondition), b.(*certmanager.IssuerCondition), scope)
 	}); err != nil {
@@ -284,6 +344,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.KubernetesCSRIssuer)(nil), (*certmanager.KubernetesCSRIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_KubernetesCSRIssuer_To_certmanager_KubernetesCSRIssuer(a.(*v1.KubernetesCSRIssuer), b.(*certmanager.KubernetesCSRIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.KubernetesCSRIssuer)(nil), (*v1.KubernetesCSRIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_KubernetesCSRIssuer_To_v1_KubernetesCSRIssuer(a.(*certmanager.KubernetesCSRIssuer), b.(*v1.KubernetesCSRIssuer), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.NameConstraintItem)(nil), (*certmanager.NameConstraintItem)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_NameConstraintItem_To_certmanager_NameConstraintItem(a.(*v1.NameConstraintItem), b.(*certmanager.NameConstraintItem), scope)
 	}); err != nil {
@@ -344,6 +414,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.SigningWebhookIssuer)(nil), (*certmanager.SigningWebhookIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_SigningWebhookIssuer_To_certmanager_SigningWebhookIssuer(a.(*v1.SigningWebhookIssuer), b.(*certmanager.SigningWebhookIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.SigningWebhookIssuer)(nil), (*v1.SigningWebhookIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_SigningWebhookIssuer_To_v1_SigningWebhookIssuer(a.(*certmanager.SigningWebhookIssuer), b.(*v1.SigningWebhookIssuer), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.VaultAppRole)(nil), (*certmanager.VaultAppRole)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_VaultAppRole_To_certmanager_VaultAppRole(a.(*v1.VaultAppRole), b.(*certmanager.VaultAppRole), scope)
 	}); err != nil {
@@ -404,6 +484,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.VenafiCustomField)(nil), (*certmanager.VenafiCustomField)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_VenafiCustomField_To_certmanager_VenafiCustomField(a.(*v1.VenafiCustomField), b.(*certmanager.VenafiCustomField), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.VenafiCustomField)(nil), (*v1.VenafiCustomField)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_VenafiCustomField_To_v1_VenafiCustomField(a.(*certmanager.VenafiCustomField), b.(*v1.VenafiCustomField), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.VenafiIssuer)(nil), (*certmanager.VenafiIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_VenafiIssuer_To_certmanager_VenafiIssuer(a.(*v1.VenafiIssuer), b.(*certmanager.VenafiIssuer), scope)
 	}); err != nil {
@@ -442,6 +532,12 @@ func autoConvert_v1_CAIssuer_To_certmanager_CAIssuer(in *v1.CAIssuer, out *certm
 	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
 	out.OCSPServers = *(*[]string)(unsafe.Pointer(&in.OCSPServers))
 	out.IssuingCertificateURLs = *(*[]string)(unsafe.Pointer(&in.IssuingCertificateURLs))
+	out.ValidateOnly = in.ValidateOnly
+	out.CAExpiryPolicy = certmanager.CAExpiryPolicy(in.CAExpiryPolicy)
+	out.AllowedExtraExtensions = *(*[]string)(unsafe.Pointer(&in.AllowedExtraExtensions))
+	out.SerialNumberStrategy = certmanager.CASerialNumberStrategy(in.SerialNumberStrategy)
+	out.SignatureAlgorithm = in.SignatureAlgorithm
+	out.AuthorityKeyIdentifier = in.AuthorityKeyIdentifier
 	return nil
 }
 
@@ -455,6 +551,12 @@ func autoConvert_certmanager_CAIssuer_To_v1_CAIssuer(in *certmanager.CAIssuer, o
 	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
 	out.OCSPServers = *(*[]string)(unsafe.Pointer(&in.OCSPServers))
 	out.IssuingCertificateURLs = *(*[]string)(unsafe.Pointer(&in.IssuingCertificateURLs))
+	out.ValidateOnly = in.ValidateOnly
+	out.CAExpiryPolicy = v1.CAExpiryPolicy(in.CAExpiryPolicy)
+	out.AllowedExtraExtensions = *(*[]string)(unsafe.Pointer(&in.AllowedExtraExtensions))
+	out.SerialNumberStrategy = v1.CASerialNumberStrategy(in.SerialNumberStrategy)
+	out.SignatureAlgorithm = in.SignatureAlgorithm
+	out.AuthorityKeyIdentifier = in.AuthorityKeyIdentifier
 	return nil
 }
 
@@ -515,6 +617,26 @@ func Convert_certmanager_CertificateAdditionalOutputFormat_To_v1_CertificateAddi
 	return autoConvert_certmanager_CertificateAdditionalOutputFormat_To_v1_CertificateAdditionalOutputFormat(in, out, s)
 }
 
+func autoConvert_v1_CertificateAdditionalOutputSecret_To_certmanager_CertificateAdditionalOutputSecret(in *v1.CertificateAdditionalOutputSecret, out *certmanager.CertificateAdditionalOutputSecret, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1_CertificateAdditionalOutputSecret_To_certmanager_CertificateAdditionalOutputSecret is an autogenerated conversion function.
+func Convert_v1_CertificateAdditionalOutputSecret_To_certmanager_CertificateAdditionalOutputSecret(in *v1.CertificateAdditionalOutputSecret, out *certmanager.CertificateAdditionalOutputSecret, s conversion.Scope) error {
+	return autoConvert_v1_CertificateAdditionalOutputSecret_To_certmanager_CertificateAdditionalOutputSecret(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateAdditionalOutputSecret_To_v1_CertificateAdditionalOutputSecret(in *certmanager.CertificateAdditionalOutputSecret, out *v1.CertificateAdditionalOutputSecret, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_certmanager_CertificateAdditionalOutputSecret_To_v1_CertificateAdditionalOutputSecret is an autogenerated conversion function.
+func Convert_certmanager_CertificateAdditionalOutputSecret_To_v1_CertificateAdditionalOutputSecret(in *certmanager.CertificateAdditionalOutputSecret, out *v1.CertificateAdditionalOutputSecret, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateAdditionalOutputSecret_To_v1_CertificateAdditionalOutputSecret(in, out, s)
+}
+
 func autoConvert_v1_CertificateCondition_To_certmanager_CertificateCondition(in *v1.CertificateCondition, out *certmanager.CertificateCondition, s conversion.Scope) error {
 	out.Type = certmanager.CertificateConditionType(in.Type)
 	out.Status = meta.ConditionStatus(in.Status)
@@ -643,6 +765,16 @@ func Convert_certmanager_CertificateList_To_v1_CertificateList(in *certmanager.C
 
 func autoConvert_v1_CertificatePrivateKey_To_certmanager_CertificatePrivateKey(in *v1.CertificatePrivateKey, out *certmanager.CertificatePrivateKey, s conversion.Scope) error {
 	out.RotationPolicy = certmanager.PrivateKeyRotationPolicy(in.RotationPolicy)
+	out.RotationInterval = (*metav1.Duration)(unsafe.Pointer(in.RotationInterval))
+	if in.ExistingSecretRef != nil {
+		in, out := &in.ExistingSecretRef, &out.ExistingSecretRef
+		*out = new(meta.SecretKeySelector)
+		if err := internalapismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ExistingSecretRef = nil
+	}
 	out.Encoding = certmanager.PrivateKeyEncoding(in.Encoding)
 	out.Algorithm = certmanager.PrivateKeyAlgorithm(in.Algorithm)
 	out.Size = in.Size
@@ -656,6 +788,16 @@ func Convert_v1_CertificatePrivateKey_To_certmanager_CertificatePrivateKey(in *v
 
 func autoConvert_certmanager_CertificatePrivateKey_To_v1_CertificatePrivateKey(in *certmanager.CertificatePrivateKey, out *v1.CertificatePrivateKey, s conversion.Scope) error {
 	out.RotationPolicy = v1.PrivateKeyRotationPolicy(in.RotationPolicy)
+	out.RotationInterval = (*metav1.Duration)(unsafe.Pointer(in.RotationInterval))
+	if in.ExistingSecretRef != nil {
+		in, out := &in.ExistingSecretRef, &out.ExistingSecretRef
+		*out = new(apismetav1.SecretKeySelector)
+		if err := internalapismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ExistingSecretRef = nil
+	}
 	out.Encoding = v1.PrivateKeyEncoding(in.Encoding)
 	out.Algorithm = v1.PrivateKeyAlgorithm(in.Algorithm)
 	out.Size = in.Size
@@ -667,6 +809,34 @@ func Convert_certmanager_CertificatePrivateKey_To_v1_CertificatePrivateKey(in *c
 	return autoConvert_certmanager_CertificatePrivateKey_To_v1_CertificatePrivateKey(in, out, s)
 }
 
+func autoConvert_v1_CertificateRenewalInfo_To_certmanager_CertificateRenewalInfo(in *v1.CertificateRenewalInfo, out *certmanager.CertificateRenewalInfo, s conversion.Scope) error {
+	out.CertID = in.CertID
+	out.SuggestedWindowStart = in.SuggestedWindowStart
+	out.SuggestedWindowEnd = in.SuggestedWindowEnd
+	out.ExplanationURL = in.ExplanationURL
+	out.NextUpdateTime = (*metav1.Time)(unsafe.Pointer(in.NextUpdateTime))
+	return nil
+}
+
+// Convert_v1_CertificateRenewalInfo_To_certmanager_CertificateRenewalInfo is an autogenerated conversion function.
+func Convert_v1_CertificateRenewalInfo_To_certmanager_CertificateRenewalInfo(in *v1.CertificateRenewalInfo, out *certmanager.CertificateRenewalInfo, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRenewalInfo_To_certmanager_CertificateRenewalInfo(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRenewalInfo_To_v1_CertificateRenewalInfo(in *certmanager.CertificateRenewalInfo, out *v1.CertificateRenewalInfo, s conversion.Scope) error {
+	out.CertID = in.CertID
+	out.SuggestedWindowStart = in.SuggestedWindowStart
+	out.SuggestedWindowEnd = in.SuggestedWindowEnd
+	out.ExplanationURL = in.ExplanationURL
+	out.NextUpdateTime = (*metav1.Time)(unsafe.Pointer(in.NextUpdateTime))
+	return nil
+}
+
+// Convert_certmanager_CertificateRenewalInfo_To_v1_CertificateRenewalInfo is an autogenerated conversion function.
+func Convert_certmanager_CertificateRenewalInfo_To_v1_CertificateRenewalInfo(in *certmanager.CertificateRenewalInfo, out *v1.CertificateRenewalInfo, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRenewalInfo_To_v1_CertificateRenewalInfo(in, out, s)
+}
+
 func autoConvert_v1_CertificateRequest_To_certmanager_CertificateRequest(in *v1.CertificateRequest, out *certmanager.CertificateRequest, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1_CertificateRequestSpec_To_certmanager_CertificateRequestSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -727,6 +897,30 @@ func Convert_certmanager_CertificateRequestCondition_To_v1_CertificateRequestCon
 	return autoConvert_certmanager_CertificateRequestCondition_To_v1_CertificateRequestCondition(in, out, s)
 }
 
+func autoConvert_v1_CertificateRequestIssuanceFailure_To_certmanager_CertificateRequestIssuanceFailure(in *v1.CertificateRequestIssuanceFailure, out *certmanager.CertificateRequestIssuanceFailure, s conversion.Scope) error {
+	out.Time = in.Time
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1_CertificateRequestIssuanceFailure_To_certmanager_CertificateRequestIssuanceFailure is an autogenerated conversion function.
+func Convert_v1_CertificateRequestIssuanceFailure_To_certmanager_CertificateRequestIssuanceFailure(in *v1.CertificateRequestIssuanceFailure, out *certmanager.CertificateRequestIssuanceFailure, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRequestIssuanceFailure_To_certmanager_CertificateRequestIssuanceFailure(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRequestIssuanceFailure_To_v1_CertificateRequestIssuanceFailure(in *certmanager.CertificateRequestIssuanceFailure, out *v1.CertificateRequestIssuanceFailure, s conversion.Scope) error {
+	out.Time = in.Time
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_certmanager_CertificateRequestIssuanceFailure_To_v1_CertificateRequestIssuanceFailure is an autogenerated conversion function.
+func Convert_certmanager_CertificateRequestIssuanceFailure_To_v1_CertificateRequestIssuanceFailure(in *certmanager.CertificateRequestIssuanceFailure, out *v1.CertificateRequestIssuanceFailure, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRequestIssuanceFailure_To_v1_CertificateRequestIssuanceFailure(in, out, s)
+}
+
 func autoConvert_v1_CertificateRequestList_To_certmanager_CertificateRequestList(in *v1.CertificateRequestList, out *certmanager.CertificateRequestList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
@@ -814,6 +1008,10 @@ func autoConvert_v1_CertificateRequestStatus_To_certmanager_CertificateRequestSt
 	out.Certificate = *(*[]byte)(unsafe.Pointer(&in.Certificate))
 	out.CA = *(*[]byte)(unsafe.Pointer(&in.CA))
 	out.FailureTime = (*metav1.Time)(unsafe.Pointer(in.FailureTime))
+	out.DeniedBy = in.DeniedBy
+	out.DeniedTime = (*metav1.Time)(unsafe.Pointer(in.DeniedTime))
+	out.PickupID = in.PickupID
+	out.IssuanceFailures = *(*[]certmanager.CertificateRequestIssuanceFailure)(unsafe.Pointer(&in.IssuanceFailures))
 	return nil
 }
 
@@ -827,6 +1025,10 @@ func autoConvert_certmanager_CertificateRequestStatus_To_v1_CertificateRequestSt
 	out.Certificate = *(*[]byte)(unsafe.Pointer(&in.Certificate))
 	out.CA = *(*[]byte)(unsafe.Pointer(&in.CA))
 	out.FailureTime = (*metav1.Time)(unsafe.Pointer(in.FailureTime))
+	out.DeniedBy = in.DeniedBy
+	out.DeniedTime = (*metav1.Time)(unsafe.Pointer(in.DeniedTime))
+	out.PickupID = in.PickupID
+	out.IssuanceFailures = *(*[]v1.CertificateRequestIssuanceFailure)(unsafe.Pointer(&in.IssuanceFailures))
 	return nil
 }
 
@@ -838,6 +1040,7 @@ func Convert_certmanager_CertificateRequestStatus_To_v1_CertificateRequestStatus
 func autoConvert_v1_CertificateSecretTemplate_To_certmanager_CertificateSecretTemplate(in *v1.CertificateSecretTemplate, out *certmanager.CertificateSecretTemplate, s conversion.Scope) error {
 	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
 	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.RenderTemplates = in.RenderTemplates
 	return nil
 }
 
@@ -849,6 +1052,7 @@ func Convert_v1_CertificateSecretTemplate_To_certmanager_CertificateSecretTempla
 func autoConvert_certmanager_CertificateSecretTemplate_To_v1_CertificateSecretTemplate(in *certmanager.CertificateSecretTemplate, out *v1.CertificateSecretTemplate, s conversion.Scope) error {
 	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
 	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.RenderTemplates = in.RenderTemplates
 	return nil
 }
 
@@ -857,6 +1061,54 @@ func Convert_certmanager_CertificateSecretTemplate_To_v1_CertificateSecretTempla
 	return autoConvert_certmanager_CertificateSecretTemplate_To_v1_CertificateSecretTemplate(in, out, s)
 }
 
+func autoConvert_v1_CertificateRevocation_To_certmanager_CertificateRevocation(in *v1.CertificateRevocation, out *certmanager.CertificateRevocation, s conversion.Scope) error {
+	out.SerialNumber = in.SerialNumber
+	out.RevocationTime = in.RevocationTime
+	out.RevokedWithIssuer = in.RevokedWithIssuer
+	return nil
+}
+
+// Convert_v1_CertificateRevocation_To_certmanager_CertificateRevocation is an autogenerated conversion function.
+func Convert_v1_CertificateRevocation_To_certmanager_CertificateRevocation(in *v1.CertificateRevocation, out *certmanager.CertificateRevocation, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRevocation_To_certmanager_CertificateRevocation(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRevocation_To_v1_CertificateRevocation(in *certmanager.CertificateRevocation, out *v1.CertificateRevocation, s conversion.Scope) error {
+	out.SerialNumber = in.SerialNumber
+	out.RevocationTime = in.RevocationTime
+	out.RevokedWithIssuer = in.RevokedWithIssuer
+	return nil
+}
+
+// Convert_certmanager_CertificateRevocation_To_v1_CertificateRevocation is an autogenerated conversion function.
+func Convert_certmanager_CertificateRevocation_To_v1_CertificateRevocation(in *certmanager.CertificateRevocation, out *v1.CertificateRevocation, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRevocation_To_v1_CertificateRevocation(in, out, s)
+}
+
+func autoConvert_v1_CertificateServiceReference_To_certmanager_CertificateServiceReference(in *v1.CertificateServiceReference, out *certmanager.CertificateServiceReference, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ClusterDomain = in.ClusterDomain
+	out.IncludeIPAddresses = in.IncludeIPAddresses
+	return nil
+}
+
+// Convert_v1_CertificateServiceReference_To_certmanager_CertificateServiceReference is an autogenerated conversion function.
+func Convert_v1_CertificateServiceReference_To_certmanager_CertificateServiceReference(in *v1.CertificateServiceReference, out *certmanager.CertificateServiceReference, s conversion.Scope) error {
+	return autoConvert_v1_CertificateServiceReference_To_certmanager_CertificateServiceReference(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateServiceReference_To_v1_CertificateServiceReference(in *certmanager.CertificateServiceReference, out *v1.CertificateServiceReference, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ClusterDomain = in.ClusterDomain
+	out.IncludeIPAddresses = in.IncludeIPAddresses
+	return nil
+}
+
+// Convert_certmanager_CertificateServiceReference_To_v1_CertificateServiceReference is an autogenerated conversion function.
+func Convert_certmanager_CertificateServiceReference_To_v1_CertificateServiceReference(in *certmanager.CertificateServiceReference, out *v1.CertificateServiceReference, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateServiceReference_To_v1_CertificateServiceReference(in, out, s)
+}
+
 func autoConvert_v1_CertificateSpec_To_certmanager_CertificateSpec(in *v1.CertificateSpec, out *certmanager.CertificateSpec, s conversion.Scope) error {
 	out.Subject = (*certmanager.X509Subject)(unsafe.Pointer(in.Subject))
 	out.LiteralSubject = in.LiteralSubject
@@ -865,12 +1117,14 @@ func autoConvert_v1_CertificateSpec_To_certmanager_CertificateSpec(in *v1.Certif
 	out.RenewBefore = (*metav1.Duration)(unsafe.Pointer(in.RenewBefore))
 	out.RenewBeforePercentage = (*int32)(unsafe.Pointer(in.RenewBeforePercentage))
 	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
+	out.ServiceRef = (*certmanager.CertificateServiceReference)(unsafe.Pointer(in.ServiceRef))
 	out.IPAddresses = *(*[]string)(unsafe.Pointer(&in.IPAddresses))
 	out.URIs = *(*[]string)(unsafe.Pointer(&in.URIs))
 	out.OtherNames = *(*[]certmanager.OtherName)(unsafe.Pointer(&in.OtherNames))
 	out.EmailAddresses = *(*[]string)(unsafe.Pointer(&in.EmailAddresses))
 	out.SecretName = in.SecretName
 	out.SecretTemplate = (*certmanager.CertificateSecretTemplate)(unsafe.Pointer(in.SecretTemplate))
+	out.DeleteSecretOnCertificateDeletion = in.DeleteSecretOnCertificateDeletion
 	if in.Keystores != nil {
 		in, out := &in.Keystores, &out.Keystores
 		*out = new(certmanager.CertificateKeystores)
@@ -884,11 +1138,23 @@ func autoConvert_v1_CertificateSpec_To_certmanager_CertificateSpec(in *v1.Certif
 		return err
 	}
 	out.IsCA = in.IsCA
+	out.MustStaple = in.MustStaple
 	out.Usages = *(*[]certmanager.KeyUsage)(unsafe.Pointer(&in.Usages))
-	out.PrivateKey = (*certmanager.CertificatePrivateKey)(unsafe.Pointer(in.PrivateKey))
+	if in.PrivateKey != nil {
+		in, out := &in.PrivateKey, &out.PrivateKey
+		*out = new(certmanager.CertificatePrivateKey)
+		if err := Convert_v1_CertificatePrivateKey_To_certmanager_CertificatePrivateKey(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PrivateKey = nil
+	}
 	out.EncodeUsagesInRequest = (*bool)(unsafe.Pointer(in.EncodeUsagesInRequest))
 	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
 	out.AdditionalOutputFormats = *(*[]certmanager.CertificateAdditionalOutputFormat)(unsafe.Pointer(&in.AdditionalOutputFormats))
+	out.ChainOrder = certmanager.CertificateChainOrder(in.ChainOrder)
+	out.AdditionalOutputSecrets = *(*[]certmanager.CertificateAdditionalOutputSecret)(unsafe.Pointer(&in.AdditionalOutputSecrets))
+	out.SecretMirrorNamespaces = *(*[]string)(unsafe.Pointer(&in.SecretMirrorNamespaces))
 	out.NameConstraints = (*certmanager.NameConstraints)(unsafe.Pointer(in.NameConstraints))
 	return nil
 }
@@ -906,12 +1172,14 @@ func autoConvert_certmanager_CertificateSpec_To_v1_CertificateSpec(in *certmanag
 	out.RenewBefore = (*metav1.Duration)(unsafe.Pointer(in.RenewBefore))
 	out.RenewBeforePercentage = (*int32)(unsafe.Pointer(in.RenewBeforePercentage))
 	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
+	out.ServiceRef = (*v1.CertificateServiceReference)(unsafe.Pointer(in.ServiceRef))
 	out.IPAddresses = *(*[]string)(unsafe.Pointer(&in.IPAddresses))
 	out.URIs = *(*[]string)(unsafe.Pointer(&in.URIs))
 	out.EmailAddresses = *(*[]string)(unsafe.Pointer(&in.EmailAddresses))
 	out.OtherNames = *(*[]v1.OtherName)(unsafe.Pointer(&in.OtherNames))
 	out.SecretName = in.SecretName
 	out.SecretTemplate = (*v1.CertificateSecretTemplate)(unsafe.Pointer(in.SecretTemplate))
+	out.DeleteSecretOnCertificateDeletion = in.DeleteSecretOnCertificateDeletion
 	if in.Keystores != nil {
 		in, out := &in.Keystores, &out.Keystores
 		*out = new(v1.CertificateKeystores)
@@ -925,11 +1193,23 @@ func autoConvert_certmanager_CertificateSpec_To_v1_CertificateSpec(in *certmanag
 		return err
 	}
 	out.IsCA = in.IsCA
+	out.MustStaple = in.MustStaple
 	out.Usages = *(*[]v1.KeyUsage)(unsafe.Pointer(&in.Usages))
-	out.PrivateKey = (*v1.CertificatePrivateKey)(unsafe.Pointer(in.PrivateKey))
+	if in.PrivateKey != nil {
+		in, out := &in.PrivateKey, &out.PrivateKey
+		*out = new(v1.CertificatePrivateKey)
+		if err := Convert_certmanager_CertificatePrivateKey_To_v1_CertificatePrivateKey(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PrivateKey = nil
+	}
 	out.EncodeUsagesInRequest = (*bool)(unsafe.Pointer(in.EncodeUsagesInRequest))
 	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
 	out.AdditionalOutputFormats = *(*[]v1.CertificateAdditionalOutputFormat)(unsafe.Pointer(&in.AdditionalOutputFormats))
+	out.ChainOrder = v1.CertificateChainOrder(in.ChainOrder)
+	out.AdditionalOutputSecrets = *(*[]v1.CertificateAdditionalOutputSecret)(unsafe.Pointer(&in.AdditionalOutputSecrets))
+	out.SecretMirrorNamespaces = *(*[]string)(unsafe.Pointer(&in.SecretMirrorNamespaces))
 	out.NameConstraints = (*v1.NameConstraints)(unsafe.Pointer(in.NameConstraints))
 	return nil
 }
@@ -948,6 +1228,11 @@ func autoConvert_v1_CertificateStatus_To_certmanager_CertificateStatus(in *v1.Ce
 	out.Revision = (*int)(unsafe.Pointer(in.Revision))
 	out.NextPrivateKeySecretName = (*string)(unsafe.Pointer(in.NextPrivateKeySecretName))
 	out.FailedIssuanceAttempts = (*int)(unsafe.Pointer(in.FailedIssuanceAttempts))
+	out.LastPrivateKeyRotationTime = (*metav1.Time)(unsafe.Pointer(in.LastPrivateKeyRotationTime))
+	out.RenewalInfo = (*certmanager.CertificateRenewalInfo)(unsafe.Pointer(in.RenewalInfo))
+	out.LastRevocation = (*certmanager.CertificateRevocation)(unsafe.Pointer(in.LastRevocation))
+	out.ServiceDNSNames = *(*[]string)(unsafe.Pointer(&in.ServiceDNSNames))
+	out.ServiceIPAddresses = *(*[]string)(unsafe.Pointer(&in.ServiceIPAddresses))
 	return nil
 }
 
@@ -965,6 +1250,11 @@ func autoConvert_certmanager_CertificateStatus_To_v1_CertificateStatus(in *certm
 	out.Revision = (*int)(unsafe.Pointer(in.Revision))
 	out.NextPrivateKeySecretName = (*string)(unsafe.Pointer(in.NextPrivateKeySecretName))
 	out.FailedIssuanceAttempts = (*int)(unsafe.Pointer(in.FailedIssuanceAttempts))
+	out.LastPrivateKeyRotationTime = (*metav1.Time)(unsafe.Pointer(in.LastPrivateKeyRotationTime))
+	out.RenewalInfo = (*v1.CertificateRenewalInfo)(unsafe.Pointer(in.RenewalInfo))
+	out.LastRevocation = (*v1.CertificateRevocation)(unsafe.Pointer(in.LastRevocation))
+	out.ServiceDNSNames = *(*[]string)(unsafe.Pointer(&in.ServiceDNSNames))
+	out.ServiceIPAddresses = *(*[]string)(unsafe.Pointer(&in.ServiceIPAddresses))
 	return nil
 }
 
@@ -1079,6 +1369,28 @@ func Convert_certmanager_Issuer_To_v1_Issuer(in *certmanager.Issuer, out *v1.Iss
 	return autoConvert_certmanager_Issuer_To_v1_Issuer(in, out, s)
 }
 
+func autoConvert_v1_IssuerCertificateDefaults_To_certmanager_IssuerCertificateDefaults(in *v1.IssuerCertificateDefaults, out *certmanager.IssuerCertificateDefaults, s conversion.Scope) error {
+	out.Duration = (*metav1.Duration)(unsafe.Pointer(in.Duration))
+	out.RenewBefore = (*metav1.Duration)(unsafe.Pointer(in.RenewBefore))
+	return nil
+}
+
+// Convert_v1_IssuerCertificateDefaults_To_certmanager_IssuerCertificateDefaults is an autogenerated conversion function.
+func Convert_v1_IssuerCertificateDefaults_To_certmanager_IssuerCertificateDefaults(in *v1.IssuerCertificateDefaults, out *certmanager.IssuerCertificateDefaults, s conversion.Scope) error {
+	return autoConvert_v1_IssuerCertificateDefaults_To_certmanager_IssuerCertificateDefaults(in, out, s)
+}
+
+func autoConvert_certmanager_IssuerCertificateDefaults_To_v1_IssuerCertificateDefaults(in *certmanager.IssuerCertificateDefaults, out *v1.IssuerCertificateDefaults, s conversion.Scope) error {
+	out.Duration = (*metav1.Duration)(unsafe.Pointer(in.Duration))
+	out.RenewBefore = (*metav1.Duration)(unsafe.Pointer(in.RenewBefore))
+	return nil
+}
+
+// Convert_certmanager_IssuerCertificateDefaults_To_v1_IssuerCertificateDefaults is an autogenerated conversion function.
+func Convert_certmanager_IssuerCertificateDefaults_To_v1_IssuerCertificateDefaults(in *certmanager.IssuerCertificateDefaults, out *v1.IssuerCertificateDefaults, s conversion.Scope) error {
+	return autoConvert_certmanager_IssuerCertificateDefaults_To_v1_IssuerCertificateDefaults(in, out, s)
+}
+
 func autoConvert_v1_IssuerCondition_To_certmanager_IssuerCondition(in *v1.IssuerCondition, out *certmanager.IssuerCondition, s conversion.Scope) error {
 	out.Type = certmanager.IssuerConditionType(in.Type)
 	out.Status = meta.ConditionStatus(in.Status)
@@ -1139,6 +1451,16 @@ func autoConvert_v1_IssuerConfig_To_certmanager_IssuerConfig(in *v1.IssuerConfig
 	} else {
 		out.Venafi = nil
 	}
+	if in.SigningWebhook != nil {
+		in, out := &in.SigningWebhook, &out.SigningWebhook
+		*out = new(certmanager.SigningWebhookIssuer)
+		if err := Convert_v1_SigningWebhookIssuer_To_certmanager_SigningWebhookIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SigningWebhook = nil
+	}
+	out.KubernetesCSR = (*certmanager.KubernetesCSRIssuer)(unsafe.Pointer(in.KubernetesCSR))
 	return nil
 }
 
@@ -1177,6 +1499,16 @@ func autoConvert_certmanager_IssuerConfig_To_v1_IssuerConfig(in *certmanager.Iss
 	} else {
 		out.Venafi = nil
 	}
+	if in.SigningWebhook != nil {
+		in, out := &in.SigningWebhook, &out.SigningWebhook
+		*out = new(v1.SigningWebhookIssuer)
+		if err := Convert_certmanager_SigningWebhookIssuer_To_v1_SigningWebhookIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SigningWebhook = nil
+	}
+	out.KubernetesCSR = (*v1.KubernetesCSRIssuer)(unsafe.Pointer(in.KubernetesCSR))
 	return nil
 }
 
@@ -1231,6 +1563,7 @@ func autoConvert_v1_IssuerSpec_To_certmanager_IssuerSpec(in *v1.IssuerSpec, out
 	if err := Convert_v1_IssuerConfig_To_certmanager_IssuerConfig(&in.IssuerConfig, &out.IssuerConfig, s); err != nil {
 		return err
 	}
+	out.CertificateDefaults = (*certmanager.IssuerCertificateDefaults)(unsafe.Pointer(in.CertificateDefaults))
 	return nil
 }
 
@@ -1243,6 +1576,7 @@ func autoConvert_certmanager_IssuerSpec_To_v1_IssuerSpec(in *certmanager.IssuerS
 	if err := Convert_certmanager_IssuerConfig_To_v1_IssuerConfig(&in.IssuerConfig, &out.IssuerConfig, s); err != nil {
 		return err
 	}
+	out.CertificateDefaults = (*v1.IssuerCertificateDefaults)(unsafe.Pointer(in.CertificateDefaults))
 	return nil
 }
 
@@ -1301,6 +1635,26 @@ func Convert_certmanager_JKSKeystore_To_v1_JKSKeystore(in *certmanager.JKSKeysto
 	return autoConvert_certmanager_JKSKeystore_To_v1_JKSKeystore(in, out, s)
 }
 
+func autoConvert_v1_KubernetesCSRIssuer_To_certmanager_KubernetesCSRIssuer(in *v1.KubernetesCSRIssuer, out *certmanager.KubernetesCSRIssuer, s conversion.Scope) error {
+	out.SignerName = in.SignerName
+	return nil
+}
+
+// Convert_v1_KubernetesCSRIssuer_To_certmanager_KubernetesCSRIssuer is an autogenerated conversion function.
+func Convert_v1_KubernetesCSRIssuer_To_certmanager_KubernetesCSRIssuer(in *v1.KubernetesCSRIssuer, out *certmanager.KubernetesCSRIssuer, s conversion.Scope) error {
+	return autoConvert_v1_KubernetesCSRIssuer_To_certmanager_KubernetesCSRIssuer(in, out, s)
+}
+
+func autoConvert_certmanager_KubernetesCSRIssuer_To_v1_KubernetesCSRIssuer(in *certmanager.KubernetesCSRIssuer, out *v1.KubernetesCSRIssuer, s conversion.Scope) error {
+	out.SignerName = in.SignerName
+	return nil
+}
+
+// Convert_certmanager_KubernetesCSRIssuer_To_v1_KubernetesCSRIssuer is an autogenerated conversion function.
+func Convert_certmanager_KubernetesCSRIssuer_To_v1_KubernetesCSRIssuer(in *certmanager.KubernetesCSRIssuer, out *v1.KubernetesCSRIssuer, s conversion.Scope) error {
+	return autoConvert_certmanager_KubernetesCSRIssuer_To_v1_KubernetesCSRIssuer(in, out, s)
+}
+
 func autoConvert_v1_NameConstraintItem_To_certmanager_NameConstraintItem(in *v1.NameConstraintItem, out *certmanager.NameConstraintItem, s conversion.Scope) error {
 	out.DNSDomains = *(*[]string)(unsafe.Pointer(&in.DNSDomains))
 	out.IPRanges = *(*[]string)(unsafe.Pointer(&in.IPRanges))
@@ -1403,6 +1757,7 @@ func Convert_certmanager_PKCS12Keystore_To_v1_PKCS12Keystore(in *certmanager.PKC
 
 func autoConvert_v1_SelfSignedIssuer_To_certmanager_SelfSignedIssuer(in *v1.SelfSignedIssuer, out *certmanager.SelfSignedIssuer, s conversion.Scope) error {
 	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
+	out.IssuerDN = in.IssuerDN
 	return nil
 }
 
@@ -1413,6 +1768,7 @@ func Convert_v1_SelfSignedIssuer_To_certmanager_SelfSignedIssuer(in *v1.SelfSign
 
 func autoConvert_certmanager_SelfSignedIssuer_To_v1_SelfSignedIssuer(in *certmanager.SelfSignedIssuer, out *v1.SelfSignedIssuer, s conversion.Scope) error {
 	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
+	out.IssuerDN = in.IssuerDN
 	return nil
 }
 
@@ -1443,6 +1799,64 @@ func Convert_certmanager_ServiceAccountRef_To_v1_ServiceAccountRef(in *certmanag
 	return autoConvert_certmanager_ServiceAccountRef_To_v1_ServiceAccountRef(in, out, s)
 }
 
+func autoConvert_v1_SigningWebhookIssuer_To_certmanager_SigningWebhookIssuer(in *v1.SigningWebhookIssuer, out *certmanager.SigningWebhookIssuer, s conversion.Scope) error {
+	out.URL = in.URL
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	if in.ClientCertSecretRef != nil {
+		in, out := &in.ClientCertSecretRef, &out.ClientCertSecretRef
+		*out = new(meta.SecretKeySelector)
+		if err := internalapismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ClientCertSecretRef = nil
+	}
+	if in.ClientKeySecretRef != nil {
+		in, out := &in.ClientKeySecretRef, &out.ClientKeySecretRef
+		*out = new(meta.SecretKeySelector)
+		if err := internalapismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ClientKeySecretRef = nil
+	}
+	return nil
+}
+
+// Convert_v1_SigningWebhookIssuer_To_certmanager_SigningWebhookIssuer is an autogenerated conversion function.
+func Convert_v1_SigningWebhookIssuer_To_certmanager_SigningWebhookIssuer(in *v1.SigningWebhookIssuer, out *certmanager.SigningWebhookIssuer, s conversion.Scope) error {
+	return autoConvert_v1_SigningWebhookIssuer_To_certmanager_SigningWebhookIssuer(in, out, s)
+}
+
+func autoConvert_certmanager_SigningWebhookIssuer_To_v1_SigningWebhookIssuer(in *certmanager.SigningWebhookIssuer, out *v1.SigningWebhookIssuer, s conversion.Scope) error {
+	out.URL = in.URL
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	if in.ClientCertSecretRef != nil {
+		in, out := &in.ClientCertSecretRef, &out.ClientCertSecretRef
+		*out = new(apismetav1.SecretKeySelector)
+		if err := internalapismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ClientCertSecretRef = nil
+	}
+	if in.ClientKeySecretRef != nil {
+		in, out := &in.ClientKeySecretRef, &out.ClientKeySecretRef
+		*out = new(apismetav1.SecretKeySelector)
+		if err := internalapismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ClientKeySecretRef = nil
+	}
+	return nil
+}
+
+// Convert_certmanager_SigningWebhookIssuer_To_v1_SigningWebhookIssuer is an autogenerated conversion function.
+func Convert_certmanager_SigningWebhookIssuer_To_v1_SigningWebhookIssuer(in *certmanager.SigningWebhookIssuer, out *v1.SigningWebhookIssuer, s conversion.Scope) error {
+	return autoConvert_certmanager_SigningWebhookIssuer_To_v1_SigningWebhookIssuer(in, out, s)
+}
+
 func autoConvert_v1_VaultAppRole_To_certmanager_VaultAppRole(in *v1.VaultAppRole, out *certmanager.VaultAppRole, s conversion.Scope) error {
 	out.Path = in.Path
 	out.RoleId = in.RoleId
@@ -1576,6 +1990,7 @@ func autoConvert_v1_VaultIssuer_To_certmanager_VaultIssuer(in *v1.VaultIssuer, o
 	out.Server = in.Server
 	out.Path = in.Path
 	out.Namespace = in.Namespace
+	out.AllowedNamespaceOverrides = *(*[]string)(unsafe.Pointer(&in.AllowedNamespaceOverrides))
 	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
 	if in.CABundleSecretRef != nil {
 		in, out := &in.CABundleSecretRef, &out.CABundleSecretRef
@@ -1619,6 +2034,7 @@ func autoConvert_certmanager_VaultIssuer_To_v1_VaultIssuer(in *certmanager.Vault
 	out.Server = in.Server
 	out.Path = in.Path
 	out.Namespace = in.Namespace
+	out.AllowedNamespaceOverrides = *(*[]string)(unsafe.Pointer(&in.AllowedNamespaceOverrides))
 	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
 	if in.CABundleSecretRef != nil {
 		in, out := &in.CABundleSecretRef, &out.CABundleSecretRef
@@ -1711,6 +2127,30 @@ func Convert_certmanager_VenafiCloud_To_v1_VenafiCloud(in *certmanager.VenafiClo
 	return autoConvert_certmanager_VenafiCloud_To_v1_VenafiCloud(in, out, s)
 }
 
+func autoConvert_v1_VenafiCustomField_To_certmanager_VenafiCustomField(in *v1.VenafiCustomField, out *certmanager.VenafiCustomField, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	out.Required = in.Required
+	return nil
+}
+
+// Convert_v1_VenafiCustomField_To_certmanager_VenafiCustomField is an autogenerated conversion function.
+func Convert_v1_VenafiCustomField_To_certmanager_VenafiCustomField(in *v1.VenafiCustomField, out *certmanager.VenafiCustomField, s conversion.Scope) error {
+	return autoConvert_v1_VenafiCustomField_To_certmanager_VenafiCustomField(in, out, s)
+}
+
+func autoConvert_certmanager_VenafiCustomField_To_v1_VenafiCustomField(in *certmanager.VenafiCustomField, out *v1.VenafiCustomField, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	out.Required = in.Required
+	return nil
+}
+
+// Convert_certmanager_VenafiCustomField_To_v1_VenafiCustomField is an autogenerated conversion function.
+func Convert_certmanager_VenafiCustomField_To_v1_VenafiCustomField(in *certmanager.VenafiCustomField, out *v1.VenafiCustomField, s conversion.Scope) error {
+	return autoConvert_certmanager_VenafiCustomField_To_v1_VenafiCustomField(in, out, s)
+}
+
 func autoConvert_v1_VenafiIssuer_To_certmanager_VenafiIssuer(in *v1.VenafiIssuer, out *certmanager.VenafiIssuer, s conversion.Scope) error {
 	out.Zone = in.Zone
 	if in.TPP != nil {
@@ -1731,6 +2171,7 @@ func autoConvert_v1_VenafiIssuer_To_certmanager_VenafiIssuer(in *v1.VenafiIssuer
 	} else {
 		out.Cloud = nil
 	}
+	out.CredentialCheckInterval = (*metav1.Duration)(unsafe.Pointer(in.CredentialCheckInterval))
 	return nil
 }
 
@@ -1759,6 +2200,7 @@ func autoConvert_certmanager_VenafiIssuer_To_v1_VenafiIssuer(in *certmanager.Ven
 	} else {
 		out.Cloud = nil
 	}
+	out.CredentialCheckInterval = (*metav1.Duration)(unsafe.Pointer(in.CredentialCheckInterval))
 	return nil
 }
 
@@ -1782,6 +2224,27 @@ func autoConvert_v1_VenafiTPP_To_certmanager_VenafiTPP(in *v1.VenafiTPP, out *ce
 	} else {
 		out.CABundleSecretRef = nil
 	}
+	if in.ClientCertSecretRef != nil {
+		in, out := &in.ClientCertSecretRef, &out.ClientCertSecretRef
+		*out = new(meta.SecretKeySelector)
+		if err := internalapismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ClientCertSecretRef = nil
+	}
+	if in.ClientKeySecretRef != nil {
+		in, out := &in.ClientKeySecretRef, &out.ClientKeySecretRef
+		*out = new(meta.SecretKeySelector)
+		if err := internalapismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ClientKeySecretRef = nil
+	}
+	out.AllowedZoneOverrides = *(*[]string)(unsafe.Pointer(&in.AllowedZoneOverrides))
+	out.PickupTimeout = (*metav1.Duration)(unsafe.Pointer(in.PickupTimeout))
+	out.CustomFields = *(*[]certmanager.VenafiCustomField)(unsafe.Pointer(&in.CustomFields))
 	return nil
 }
 
@@ -1805,6 +2268,27 @@ func autoConvert_certmanager_VenafiTPP_To_v1_VenafiTPP(in *certmanager.VenafiTPP
 	} else {
 		out.CABundleSecretRef = nil
 	}
+	if in.ClientCertSecretRef != nil {
+		in, out := &in.ClientCertSecretRef, &out.ClientCertSecretRef
+		*out = new(apismetav1.SecretKeySelector)
+		if err := internalapismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ClientCertSecretRef = nil
+	}
+	if in.ClientKeySecretRef != nil {
+		in, out := &in.ClientKeySecretRef, &out.ClientKeySecretRef
+		*out = new(apismetav1.SecretKeySelector)
+		if err := internalapismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ClientKeySecretRef = nil
+	}
+	out.AllowedZoneOverrides = *(*[]string)(unsafe.Pointer(&in.AllowedZoneOverrides))
+	out.PickupTimeout = (*metav1.Duration)(unsafe.Pointer(in.PickupTimeout))
+	out.CustomFields = *(*[]v1.VenafiCustomField)(unsafe.Pointer(&in.CustomFields))
 	return nil
 }
 
@@ -1822,6 +2306,7 @@ func autoConvert_v1_X509Subject_To_certmanager_X509Subject(in *v1.X509Subject, o
 	out.StreetAddresses = *(*[]string)(unsafe.Pointer(&in.StreetAddresses))
 	out.PostalCodes = *(*[]string)(unsafe.Pointer(&in.PostalCodes))
 	out.SerialNumber = in.SerialNumber
+	out.OrganizationIdentifiers = *(*[]string)(unsafe.Pointer(&in.OrganizationIdentifiers))
 	return nil
 }
 
@@ -1839,6 +2324,7 @@ func autoConvert_certmanager_X509Subject_To_v1_X509Subject(in *certmanager.X509S
 	out.StreetAddresses = *(*[]string)(unsafe.Pointer(&in.StreetAddresses))
 	out.PostalCodes = *(*[]string)(unsafe.Pointer(&in.PostalCodes))
 	out.SerialNumber = in.SerialNumber
+	out.OrganizationIdentifiers = *(*[]string)(unsafe.Pointer(&in.OrganizationIdentifiers))
 	return nil
 }
 
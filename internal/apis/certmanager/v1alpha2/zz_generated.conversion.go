@@ -72,6 +72,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CertificateAdditionalOutputSecret)(nil), (*certmanager.CertificateAdditionalOutputSecret)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_CertificateAdditionalOutputSecret_To_certmanager_CertificateAdditionalOutputSecret(a.(*CertificateAdditionalOutputSecret), b.(*certmanager.CertificateAdditionalOutputSecret), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateAdditionalOutputSecret)(nil), (*CertificateAdditionalOutputSecret)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateAdditionalOutputSecret_To_v1alpha2_CertificateAdditionalOutputSecret(a.(*certmanager.CertificateAdditionalOutputSecret), b.(*CertificateAdditionalOutputSecret), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CertificateCondition)(nil), (*certmanager.CertificateCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_CertificateCondition_To_certmanager_CertificateCondition(a.(*CertificateCondition), b.(*certmanager.CertificateCondition), scope)
 	}); err != nil {
@@ -107,6 +117,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CertificateRenewalInfo)(nil), (*certmanager.CertificateRenewalInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_CertificateRenewalInfo_To_certmanager_CertificateRenewalInfo(a.(*CertificateRenewalInfo), b.(*certmanager.CertificateRenewalInfo), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRenewalInfo)(nil), (*CertificateRenewalInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRenewalInfo_To_v1alpha2_CertificateRenewalInfo(a.(*certmanager.CertificateRenewalInfo), b.(*CertificateRenewalInfo), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CertificateRequest)(nil), (*certmanager.CertificateRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_CertificateRequest_To_certmanager_CertificateRequest(a.(*CertificateRequest), b.(*certmanager.CertificateRequest), scope)
 	}); err != nil {
@@ -127,6 +147,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CertificateRequestIssuanceFailure)(nil), (*certmanager.CertificateRequestIssuanceFailure)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_CertificateRequestIssuanceFailure_To_certmanager_CertificateRequestIssuanceFailure(a.(*CertificateRequestIssuanceFailure), b.(*certmanager.CertificateRequestIssuanceFailure), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRequestIssuanceFailure)(nil), (*CertificateRequestIssuanceFailure)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRequestIssuanceFailure_To_v1alpha2_CertificateRequestIssuanceFailure(a.(*certmanager.CertificateRequestIssuanceFailure), b.(*CertificateRequestIssuanceFailure), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CertificateRequestList)(nil), (*certmanager.CertificateRequestList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_CertificateRequestList_To_certmanager_CertificateRequestList(a.(*CertificateRequestList), b.(*certmanager.CertificateRequestList), scope)
 	}); err != nil {
@@ -147,6 +177,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CertificateRevocation)(nil), (*certmanager.CertificateRevocation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_CertificateRevocation_To_certmanager_CertificateRevocation(a.(*CertificateRevocation), b.(*certmanager.CertificateRevocation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRevocation)(nil), (*CertificateRevocation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRevocation_To_v1alpha2_CertificateRevocation(a.(*certmanager.CertificateRevocation), b.(*CertificateRevocation), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CertificateSecretTemplate)(nil), (*certmanager.CertificateSecretTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_CertificateSecretTemplate_To_certmanager_CertificateSecretTemplate(a.(*CertificateSecretTemplate), b.(*certmanager.CertificateSecretTemplate), scope)
 	}); err != nil {
@@ -157,6 +197,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CertificateServiceReference)(nil), (*certmanager.CertificateServiceReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_CertificateServiceReference_To_certmanager_CertificateServiceReference(a.(*CertificateServiceReference), b.(*certmanager.CertificateServiceReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateServiceReference)(nil), (*CertificateServiceReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateServiceReference_To_v1alpha2_CertificateServiceReference(a.(*certmanager.CertificateServiceReference), b.(*CertificateServiceReference), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CertificateStatus)(nil), (*certmanager.CertificateStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_CertificateStatus_To_certmanager_CertificateStatus(a.(*CertificateStatus), b.(*certmanager.CertificateStatus), scope)
 	}); err != nil {
@@ -197,6 +247,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*IssuerCertificateDefaults)(nil), (*certmanager.IssuerCertificateDefaults)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_IssuerCertificateDefaults_To_certmanager_IssuerCertificateDefaults(a.(*IssuerCertificateDefaults), b.(*certmanager.IssuerCertificateDefaults), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.IssuerCertificateDefaults)(nil), (*IssuerCertificateDefaults)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_IssuerCertificateDefaults_To_v1alpha2_IssuerCertificateDefaults(a.(*certmanager.IssuerCertificateDefaults), b.(*IssuerCertificateDefaults), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*IssuerCondition)(nil), (*certmanager.IssuerCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_IssuerCondition_To_certmanager_IssuerCondition(a.(*IssuerCondition), b.(*certmanager.IssuerCondition), scope)
 	}); err != nil {
@@ -257,6 +317,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*KubernetesCSRIssuer)(nil), (*certmanager.KubernetesCSRIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_KubernetesCSRIssuer_To_certmanager_KubernetesCSRIssuer(a.(*KubernetesCSRIssuer), b.(*certmanager.KubernetesCSRIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.KubernetesCSRIssuer)(nil), (*KubernetesCSRIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_KubernetesCSRIssuer_To_v1alpha2_KubernetesCSRIssuer(a.(*certmanager.KubernetesCSRIssuer), b.(*KubernetesCSRIssuer), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NameConstraintItem)(nil), (*certmanager.NameConstraintItem)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_NameConstraintItem_To_certmanager_NameConstraintItem(a.(*NameConstraintItem), b.(*certmanager.NameConstraintItem), scope)
 	}); err != nil {
@@ -317,6 +387,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SigningWebhookIssuer)(nil), (*certmanager.SigningWebhookIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_SigningWebhookIssuer_To_certmanager_SigningWebhookIssuer(a.(*SigningWebhookIssuer), b.(*certmanager.SigningWebhookIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.SigningWebhookIssuer)(nil), (*SigningWebhookIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_SigningWebhookIssuer_To_v1alpha2_SigningWebhookIssuer(a.(*certmanager.SigningWebhookIssuer), b.(*SigningWebhookIssuer), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*VaultAppRole)(nil), (*certmanager.VaultAppRole)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_VaultAppRole_To_certmanager_VaultAppRole(a.(*VaultAppRole), b.(*certmanager.VaultAppRole), scope)
 	}); err != nil {
@@ -372,6 +452,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*VenafiCustomField)(nil), (*certmanager.VenafiCustomField)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_VenafiCustomField_To_certmanager_VenafiCustomField(a.(*VenafiCustomField), b.(*certmanager.VenafiCustomField), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.VenafiCustomField)(nil), (*VenafiCustomField)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_VenafiCustomField_To_v1alpha2_VenafiCustomField(a.(*certmanager.VenafiCustomField), b.(*VenafiCustomField), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*VenafiIssuer)(nil), (*certmanager.VenafiIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_VenafiIssuer_To_certmanager_VenafiIssuer(a.(*VenafiIssuer), b.(*certmanager.VenafiIssuer), scope)
 	}); err != nil {
@@ -440,6 +530,12 @@ func autoConvert_v1alpha2_CAIssuer_To_certmanager_CAIssuer(in *CAIssuer, out *ce
 	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
 	out.OCSPServers = *(*[]string)(unsafe.Pointer(&in.OCSPServers))
 	out.IssuingCertificateURLs = *(*[]string)(unsafe.Pointer(&in.IssuingCertificateURLs))
+	out.ValidateOnly = in.ValidateOnly
+	out.CAExpiryPolicy = certmanager.CAExpiryPolicy(in.CAExpiryPolicy)
+	out.AllowedExtraExtensions = *(*[]string)(unsafe.Pointer(&in.AllowedExtraExtensions))
+	out.SerialNumberStrategy = certmanager.CASerialNumberStrategy(in.SerialNumberStrategy)
+	out.SignatureAlgorithm = in.SignatureAlgorithm
+	out.AuthorityKeyIdentifier = in.AuthorityKeyIdentifier
 	return nil
 }
 
@@ -453,6 +549,12 @@ func autoConvert_certmanager_CAIssuer_To_v1alpha2_CAIssuer(in *certmanager.CAIss
 	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
 	out.OCSPServers = *(*[]string)(unsafe.Pointer(&in.OCSPServers))
 	out.IssuingCertificateURLs = *(*[]string)(unsafe.Pointer(&in.IssuingCertificateURLs))
+	out.ValidateOnly = in.ValidateOnly
+	out.CAExpiryPolicy = CAExpiryPolicy(in.CAExpiryPolicy)
+	out.AllowedExtraExtensions = *(*[]string)(unsafe.Pointer(&in.AllowedExtraExtensions))
+	out.SerialNumberStrategy = CASerialNumberStrategy(in.SerialNumberStrategy)
+	out.SignatureAlgorithm = in.SignatureAlgorithm
+	out.AuthorityKeyIdentifier = in.AuthorityKeyIdentifier
 	return nil
 }
 
@@ -513,6 +615,26 @@ func Convert_certmanager_CertificateAdditionalOutputFormat_To_v1alpha2_Certifica
 	return autoConvert_certmanager_CertificateAdditionalOutputFormat_To_v1alpha2_CertificateAdditionalOutputFormat(in, out, s)
 }
 
+func autoConvert_v1alpha2_CertificateAdditionalOutputSecret_To_certmanager_CertificateAdditionalOutputSecret(in *CertificateAdditionalOutputSecret, out *certmanager.CertificateAdditionalOutputSecret, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1alpha2_CertificateAdditionalOutputSecret_To_certmanager_CertificateAdditionalOutputSecret is an autogenerated conversion function.
+func Convert_v1alpha2_CertificateAdditionalOutputSecret_To_certmanager_CertificateAdditionalOutputSecret(in *CertificateAdditionalOutputSecret, out *certmanager.CertificateAdditionalOutputSecret, s conversion.Scope) error {
+	return autoConvert_v1alpha2_CertificateAdditionalOutputSecret_To_certmanager_CertificateAdditionalOutputSecret(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateAdditionalOutputSecret_To_v1alpha2_CertificateAdditionalOutputSecret(in *certmanager.CertificateAdditionalOutputSecret, out *CertificateAdditionalOutputSecret, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_certmanager_CertificateAdditionalOutputSecret_To_v1alpha2_CertificateAdditionalOutputSecret is an autogenerated conversion function.
+func Convert_certmanager_CertificateAdditionalOutputSecret_To_v1alpha2_CertificateAdditionalOutputSecret(in *certmanager.CertificateAdditionalOutputSecret, out *CertificateAdditionalOutputSecret, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateAdditionalOutputSecret_To_v1alpha2_CertificateAdditionalOutputSecret(in, out, s)
+}
+
 func autoConvert_v1alpha2_CertificateCondition_To_certmanager_CertificateCondition(in *CertificateCondition, out *certmanager.CertificateCondition, s conversion.Scope) error {
 	out.Type = certmanager.CertificateConditionType(in.Type)
 	out.Status = meta.ConditionStatus(in.Status)
@@ -641,6 +763,16 @@ func Convert_certmanager_CertificateList_To_v1alpha2_CertificateList(in *certman
 
 func autoConvert_v1alpha2_CertificatePrivateKey_To_certmanager_CertificatePrivateKey(in *CertificatePrivateKey, out *certmanager.CertificatePrivateKey, s conversion.Scope) error {
 	out.RotationPolicy = certmanager.PrivateKeyRotationPolicy(in.RotationPolicy)
+	out.RotationInterval = (*v1.Duration)(unsafe.Pointer(in.RotationInterval))
+	if in.ExistingSecretRef != nil {
+		in, out := &in.ExistingSecretRef, &out.ExistingSecretRef
+		*out = new(meta.SecretKeySelector)
+		if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ExistingSecretRef = nil
+	}
 	return nil
 }
 
@@ -651,12 +783,50 @@ func Convert_v1alpha2_CertificatePrivateKey_To_certmanager_CertificatePrivateKey
 
 func autoConvert_certmanager_CertificatePrivateKey_To_v1alpha2_CertificatePrivateKey(in *certmanager.CertificatePrivateKey, out *CertificatePrivateKey, s conversion.Scope) error {
 	out.RotationPolicy = PrivateKeyRotationPolicy(in.RotationPolicy)
+	out.RotationInterval = (*v1.Duration)(unsafe.Pointer(in.RotationInterval))
+	if in.ExistingSecretRef != nil {
+		in, out := &in.ExistingSecretRef, &out.ExistingSecretRef
+		*out = new(metav1.SecretKeySelector)
+		if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ExistingSecretRef = nil
+	}
 	// WARNING: in.Encoding requires manual conversion: does not exist in peer-type
 	// WARNING: in.Algorithm requires manual conversion: does not exist in peer-type
 	// WARNING: in.Size requires manual conversion: does not exist in peer-type
 	return nil
 }
 
+func autoConvert_v1alpha2_CertificateRenewalInfo_To_certmanager_CertificateRenewalInfo(in *CertificateRenewalInfo, out *certmanager.CertificateRenewalInfo, s conversion.Scope) error {
+	out.CertID = in.CertID
+	out.SuggestedWindowStart = in.SuggestedWindowStart
+	out.SuggestedWindowEnd = in.SuggestedWindowEnd
+	out.ExplanationURL = in.ExplanationURL
+	out.NextUpdateTime = (*v1.Time)(unsafe.Pointer(in.NextUpdateTime))
+	return nil
+}
+
+// Convert_v1alpha2_CertificateRenewalInfo_To_certmanager_CertificateRenewalInfo is an autogenerated conversion function.
+func Convert_v1alpha2_CertificateRenewalInfo_To_certmanager_CertificateRenewalInfo(in *CertificateRenewalInfo, out *certmanager.CertificateRenewalInfo, s conversion.Scope) error {
+	return autoConvert_v1alpha2_CertificateRenewalInfo_To_certmanager_CertificateRenewalInfo(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRenewalInfo_To_v1alpha2_CertificateRenewalInfo(in *certmanager.CertificateRenewalInfo, out *CertificateRenewalInfo, s conversion.Scope) error {
+	out.CertID = in.CertID
+	out.SuggestedWindowStart = in.SuggestedWindowStart
+	out.SuggestedWindowEnd = in.SuggestedWindowEnd
+	out.ExplanationURL = in.ExplanationURL
+	out.NextUpdateTime = (*v1.Time)(unsafe.Pointer(in.NextUpdateTime))
+	return nil
+}
+
+// Convert_certmanager_CertificateRenewalInfo_To_v1alpha2_CertificateRenewalInfo is an autogenerated conversion function.
+func Convert_certmanager_CertificateRenewalInfo_To_v1alpha2_CertificateRenewalInfo(in *certmanager.CertificateRenewalInfo, out *CertificateRenewalInfo, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRenewalInfo_To_v1alpha2_CertificateRenewalInfo(in, out, s)
+}
+
 func autoConvert_v1alpha2_CertificateRequest_To_certmanager_CertificateRequest(in *CertificateRequest, out *certmanager.CertificateRequest, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha2_CertificateRequestSpec_To_certmanager_CertificateRequestSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -717,6 +887,30 @@ func Convert_certmanager_CertificateRequestCondition_To_v1alpha2_CertificateRequ
 	return autoConvert_certmanager_CertificateRequestCondition_To_v1alpha2_CertificateRequestCondition(in, out, s)
 }
 
+func autoConvert_v1alpha2_CertificateRequestIssuanceFailure_To_certmanager_CertificateRequestIssuanceFailure(in *CertificateRequestIssuanceFailure, out *certmanager.CertificateRequestIssuanceFailure, s conversion.Scope) error {
+	out.Time = in.Time
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1alpha2_CertificateRequestIssuanceFailure_To_certmanager_CertificateRequestIssuanceFailure is an autogenerated conversion function.
+func Convert_v1alpha2_CertificateRequestIssuanceFailure_To_certmanager_CertificateRequestIssuanceFailure(in *CertificateRequestIssuanceFailure, out *certmanager.CertificateRequestIssuanceFailure, s conversion.Scope) error {
+	return autoConvert_v1alpha2_CertificateRequestIssuanceFailure_To_certmanager_CertificateRequestIssuanceFailure(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRequestIssuanceFailure_To_v1alpha2_CertificateRequestIssuanceFailure(in *certmanager.CertificateRequestIssuanceFailure, out *CertificateRequestIssuanceFailure, s conversion.Scope) error {
+	out.Time = in.Time
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_certmanager_CertificateRequestIssuanceFailure_To_v1alpha2_CertificateRequestIssuanceFailure is an autogenerated conversion function.
+func Convert_certmanager_CertificateRequestIssuanceFailure_To_v1alpha2_CertificateRequestIssuanceFailure(in *certmanager.CertificateRequestIssuanceFailure, out *CertificateRequestIssuanceFailure, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRequestIssuanceFailure_To_v1alpha2_CertificateRequestIssuanceFailure(in, out, s)
+}
+
 func autoConvert_v1alpha2_CertificateRequestList_To_certmanager_CertificateRequestList(in *CertificateRequestList, out *certmanager.CertificateRequestList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
@@ -794,6 +988,10 @@ func autoConvert_v1alpha2_CertificateRequestStatus_To_certmanager_CertificateReq
 	out.Certificate = *(*[]byte)(unsafe.Pointer(&in.Certificate))
 	out.CA = *(*[]byte)(unsafe.Pointer(&in.CA))
 	out.FailureTime = (*v1.Time)(unsafe.Pointer(in.FailureTime))
+	out.DeniedBy = in.DeniedBy
+	out.DeniedTime = (*v1.Time)(unsafe.Pointer(in.DeniedTime))
+	out.PickupID = in.PickupID
+	out.IssuanceFailures = *(*[]certmanager.CertificateRequestIssuanceFailure)(unsafe.Pointer(&in.IssuanceFailures))
 	return nil
 }
 
@@ -807,6 +1005,10 @@ func autoConvert_certmanager_CertificateRequestStatus_To_v1alpha2_CertificateReq
 	out.Certificate = *(*[]byte)(unsafe.Pointer(&in.Certificate))
 	out.CA = *(*[]byte)(unsafe.Pointer(&in.CA))
 	out.FailureTime = (*v1.Time)(unsafe.Pointer(in.FailureTime))
+	out.DeniedBy = in.DeniedBy
+	out.DeniedTime = (*v1.Time)(unsafe.Pointer(in.DeniedTime))
+	out.PickupID = in.PickupID
+	out.IssuanceFailures = *(*[]CertificateRequestIssuanceFailure)(unsafe.Pointer(&in.IssuanceFailures))
 	return nil
 }
 
@@ -815,9 +1017,34 @@ func Convert_certmanager_CertificateRequestStatus_To_v1alpha2_CertificateRequest
 	return autoConvert_certmanager_CertificateRequestStatus_To_v1alpha2_CertificateRequestStatus(in, out, s)
 }
 
+func autoConvert_v1alpha2_CertificateRevocation_To_certmanager_CertificateRevocation(in *CertificateRevocation, out *certmanager.CertificateRevocation, s conversion.Scope) error {
+	out.SerialNumber = in.SerialNumber
+	out.RevocationTime = in.RevocationTime
+	out.RevokedWithIssuer = in.RevokedWithIssuer
+	return nil
+}
+
+// Convert_v1alpha2_CertificateRevocation_To_certmanager_CertificateRevocation is an autogenerated conversion function.
+func Convert_v1alpha2_CertificateRevocation_To_certmanager_CertificateRevocation(in *CertificateRevocation, out *certmanager.CertificateRevocation, s conversion.Scope) error {
+	return autoConvert_v1alpha2_CertificateRevocation_To_certmanager_CertificateRevocation(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRevocation_To_v1alpha2_CertificateRevocation(in *certmanager.CertificateRevocation, out *CertificateRevocation, s conversion.Scope) error {
+	out.SerialNumber = in.SerialNumber
+	out.RevocationTime = in.RevocationTime
+	out.RevokedWithIssuer = in.RevokedWithIssuer
+	return nil
+}
+
+// Convert_certmanager_CertificateRevocation_To_v1alpha2_CertificateRevocation is an autogenerated conversion function.
+func Convert_certmanager_CertificateRevocation_To_v1alpha2_CertificateRevocation(in *certmanager.CertificateRevocation, out *CertificateRevocation, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRevocation_To_v1alpha2_CertificateRevocation(in, out, s)
+}
+
 func autoConvert_v1alpha2_CertificateSecretTemplate_To_certmanager_CertificateSecretTemplate(in *CertificateSecretTemplate, out *certmanager.CertificateSecretTemplate, s conversion.Scope) error {
 	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
 	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.RenderTemplates = in.RenderTemplates
 	return nil
 }
 
@@ -829,6 +1056,7 @@ func Convert_v1alpha2_CertificateSecretTemplate_To_certmanager_CertificateSecret
 func autoConvert_certmanager_CertificateSecretTemplate_To_v1alpha2_CertificateSecretTemplate(in *certmanager.CertificateSecretTemplate, out *CertificateSecretTemplate, s conversion.Scope) error {
 	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
 	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.RenderTemplates = in.RenderTemplates
 	return nil
 }
 
@@ -837,6 +1065,30 @@ func Convert_certmanager_CertificateSecretTemplate_To_v1alpha2_CertificateSecret
 	return autoConvert_certmanager_CertificateSecretTemplate_To_v1alpha2_CertificateSecretTemplate(in, out, s)
 }
 
+func autoConvert_v1alpha2_CertificateServiceReference_To_certmanager_CertificateServiceReference(in *CertificateServiceReference, out *certmanager.CertificateServiceReference, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ClusterDomain = in.ClusterDomain
+	out.IncludeIPAddresses = in.IncludeIPAddresses
+	return nil
+}
+
+// Convert_v1alpha2_CertificateServiceReference_To_certmanager_CertificateServiceReference is an autogenerated conversion function.
+func Convert_v1alpha2_CertificateServiceReference_To_certmanager_CertificateServiceReference(in *CertificateServiceReference, out *certmanager.CertificateServiceReference, s conversion.Scope) error {
+	return autoConvert_v1alpha2_CertificateServiceReference_To_certmanager_CertificateServiceReference(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateServiceReference_To_v1alpha2_CertificateServiceReference(in *certmanager.CertificateServiceReference, out *CertificateServiceReference, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ClusterDomain = in.ClusterDomain
+	out.IncludeIPAddresses = in.IncludeIPAddresses
+	return nil
+}
+
+// Convert_certmanager_CertificateServiceReference_To_v1alpha2_CertificateServiceReference is an autogenerated conversion function.
+func Convert_certmanager_CertificateServiceReference_To_v1alpha2_CertificateServiceReference(in *certmanager.CertificateServiceReference, out *CertificateServiceReference, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateServiceReference_To_v1alpha2_CertificateServiceReference(in, out, s)
+}
+
 func autoConvert_v1alpha2_CertificateSpec_To_certmanager_CertificateSpec(in *CertificateSpec, out *certmanager.CertificateSpec, s conversion.Scope) error {
 	if in.Subject != nil {
 		in, out := &in.Subject, &out.Subject
@@ -854,12 +1106,14 @@ func autoConvert_v1alpha2_CertificateSpec_To_certmanager_CertificateSpec(in *Cer
 	out.RenewBefore = (*v1.Duration)(unsafe.Pointer(in.RenewBefore))
 	out.RenewBeforePercentage = (*int32)(unsafe.Pointer(in.RenewBeforePercentage))
 	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
+	out.ServiceRef = (*certmanager.CertificateServiceReference)(unsafe.Pointer(in.ServiceRef))
 	out.IPAddresses = *(*[]string)(unsafe.Pointer(&in.IPAddresses))
 	// WARNING: in.URISANs requires manual conversion: does not exist in peer-type
 	// WARNING: in.EmailSANs requires manual conversion: does not exist in peer-type
 	out.OtherNames = *(*[]certmanager.OtherName)(unsafe.Pointer(&in.OtherNames))
 	out.SecretName = in.SecretName
 	out.SecretTemplate = (*certmanager.CertificateSecretTemplate)(unsafe.Pointer(in.SecretTemplate))
+	out.DeleteSecretOnCertificateDeletion = in.DeleteSecretOnCertificateDeletion
 	if in.Keystores != nil {
 		in, out := &in.Keystores, &out.Keystores
 		*out = new(certmanager.CertificateKeystores)
@@ -873,6 +1127,7 @@ func autoConvert_v1alpha2_CertificateSpec_To_certmanager_CertificateSpec(in *Cer
 		return err
 	}
 	out.IsCA = in.IsCA
+	out.MustStaple = in.MustStaple
 	out.Usages = *(*[]certmanager.KeyUsage)(unsafe.Pointer(&in.Usages))
 	// WARNING: in.KeySize requires manual conversion: does not exist in peer-type
 	// WARNING: in.KeyAlgorithm requires manual conversion: does not exist in peer-type
@@ -889,6 +1144,9 @@ func autoConvert_v1alpha2_CertificateSpec_To_certmanager_CertificateSpec(in *Cer
 	out.EncodeUsagesInRequest = (*bool)(unsafe.Pointer(in.EncodeUsagesInRequest))
 	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
 	out.AdditionalOutputFormats = *(*[]certmanager.CertificateAdditionalOutputFormat)(unsafe.Pointer(&in.AdditionalOutputFormats))
+	out.ChainOrder = certmanager.CertificateChainOrder(in.ChainOrder)
+	out.AdditionalOutputSecrets = *(*[]certmanager.CertificateAdditionalOutputSecret)(unsafe.Pointer(&in.AdditionalOutputSecrets))
+	out.SecretMirrorNamespaces = *(*[]string)(unsafe.Pointer(&in.SecretMirrorNamespaces))
 	out.NameConstraints = (*certmanager.NameConstraints)(unsafe.Pointer(in.NameConstraints))
 	return nil
 }
@@ -909,12 +1167,14 @@ func autoConvert_certmanager_CertificateSpec_To_v1alpha2_CertificateSpec(in *cer
 	out.RenewBefore = (*v1.Duration)(unsafe.Pointer(in.RenewBefore))
 	out.RenewBeforePercentage = (*int32)(unsafe.Pointer(in.RenewBeforePercentage))
 	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
+	out.ServiceRef = (*CertificateServiceReference)(unsafe.Pointer(in.ServiceRef))
 	out.IPAddresses = *(*[]string)(unsafe.Pointer(&in.IPAddresses))
 	// WARNING: in.URIs requires manual conversion: does not exist in peer-type
 	// WARNING: in.EmailAddresses requires manual conversion: does not exist in peer-type
 	out.OtherNames = *(*[]OtherName)(unsafe.Pointer(&in.OtherNames))
 	out.SecretName = in.SecretName
 	out.SecretTemplate = (*CertificateSecretTemplate)(unsafe.Pointer(in.SecretTemplate))
+	out.DeleteSecretOnCertificateDeletion = in.DeleteSecretOnCertificateDeletion
 	if in.Keystores != nil {
 		in, out := &in.Keystores, &out.Keystores
 		*out = new(CertificateKeystores)
@@ -928,6 +1188,7 @@ func autoConvert_certmanager_CertificateSpec_To_v1alpha2_CertificateSpec(in *cer
 		return err
 	}
 	out.IsCA = in.IsCA
+	out.MustStaple = in.MustStaple
 	out.Usages = *(*[]KeyUsage)(unsafe.Pointer(&in.Usages))
 	if in.PrivateKey != nil {
 		in, out := &in.PrivateKey, &out.PrivateKey
@@ -941,6 +1202,9 @@ func autoConvert_certmanager_CertificateSpec_To_v1alpha2_CertificateSpec(in *cer
 	out.EncodeUsagesInRequest = (*bool)(unsafe.Pointer(in.EncodeUsagesInRequest))
 	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
 	out.AdditionalOutputFormats = *(*[]CertificateAdditionalOutputFormat)(unsafe.Pointer(&in.AdditionalOutputFormats))
+	out.ChainOrder = CertificateChainOrder(in.ChainOrder)
+	out.AdditionalOutputSecrets = *(*[]CertificateAdditionalOutputSecret)(unsafe.Pointer(&in.AdditionalOutputSecrets))
+	out.SecretMirrorNamespaces = *(*[]string)(unsafe.Pointer(&in.SecretMirrorNamespaces))
 	out.NameConstraints = (*NameConstraints)(unsafe.Pointer(in.NameConstraints))
 	return nil
 }
@@ -954,6 +1218,11 @@ func autoConvert_v1alpha2_CertificateStatus_To_certmanager_CertificateStatus(in
 	out.Revision = (*int)(unsafe.Pointer(in.Revision))
 	out.NextPrivateKeySecretName = (*string)(unsafe.Pointer(in.NextPrivateKeySecretName))
 	out.FailedIssuanceAttempts = (*int)(unsafe.Pointer(in.FailedIssuanceAttempts))
+	out.LastPrivateKeyRotationTime = (*v1.Time)(unsafe.Pointer(in.LastPrivateKeyRotationTime))
+	out.RenewalInfo = (*certmanager.CertificateRenewalInfo)(unsafe.Pointer(in.RenewalInfo))
+	out.LastRevocation = (*certmanager.CertificateRevocation)(unsafe.Pointer(in.LastRevocation))
+	out.ServiceDNSNames = *(*[]string)(unsafe.Pointer(&in.ServiceDNSNames))
+	out.ServiceIPAddresses = *(*[]string)(unsafe.Pointer(&in.ServiceIPAddresses))
 	return nil
 }
 
@@ -971,6 +1240,11 @@ func autoConvert_certmanager_CertificateStatus_To_v1alpha2_CertificateStatus(in
 	out.Revision = (*int)(unsafe.Pointer(in.Revision))
 	out.NextPrivateKeySecretName = (*string)(unsafe.Pointer(in.NextPrivateKeySecretName))
 	out.FailedIssuanceAttempts = (*int)(unsafe.Pointer(in.FailedIssuanceAttempts))
+	out.LastPrivateKeyRotationTime = (*v1.Time)(unsafe.Pointer(in.LastPrivateKeyRotationTime))
+	out.RenewalInfo = (*CertificateRenewalInfo)(unsafe.Pointer(in.RenewalInfo))
+	out.LastRevocation = (*CertificateRevocation)(unsafe.Pointer(in.LastRevocation))
+	out.ServiceDNSNames = *(*[]string)(unsafe.Pointer(&in.ServiceDNSNames))
+	out.ServiceIPAddresses = *(*[]string)(unsafe.Pointer(&in.ServiceIPAddresses))
 	return nil
 }
 
@@ -1085,6 +1359,28 @@ func Convert_certmanager_Issuer_To_v1alpha2_Issuer(in *certmanager.Issuer, out *
 	return autoConvert_certmanager_Issuer_To_v1alpha2_Issuer(in, out, s)
 }
 
+func autoConvert_v1alpha2_IssuerCertificateDefaults_To_certmanager_IssuerCertificateDefaults(in *IssuerCertificateDefaults, out *certmanager.IssuerCertificateDefaults, s conversion.Scope) error {
+	out.Duration = (*v1.Duration)(unsafe.Pointer(in.Duration))
+	out.RenewBefore = (*v1.Duration)(unsafe.Pointer(in.RenewBefore))
+	return nil
+}
+
+// Convert_v1alpha2_IssuerCertificateDefaults_To_certmanager_IssuerCertificateDefaults is an autogenerated conversion function.
+func Convert_v1alpha2_IssuerCertificateDefaults_To_certmanager_IssuerCertificateDefaults(in *IssuerCertificateDefaults, out *certmanager.IssuerCertificateDefaults, s conversion.Scope) error {
+	return autoConvert_v1alpha2_IssuerCertificateDefaults_To_certmanager_IssuerCertificateDefaults(in, out, s)
+}
+
+func autoConvert_certmanager_IssuerCertificateDefaults_To_v1alpha2_IssuerCertificateDefaults(in *certmanager.IssuerCertificateDefaults, out *IssuerCertificateDefaults, s conversion.Scope) error {
+	out.Duration = (*v1.Duration)(unsafe.Pointer(in.Duration))
+	out.RenewBefore = (*v1.Duration)(unsafe.Pointer(in.RenewBefore))
+	return nil
+}
+
+// Convert_certmanager_IssuerCertificateDefaults_To_v1alpha2_IssuerCertificateDefaults is an autogenerated conversion function.
+func Convert_certmanager_IssuerCertificateDefaults_To_v1alpha2_IssuerCertificateDefaults(in *certmanager.IssuerCertificateDefaults, out *IssuerCertificateDefaults, s conversion.Scope) error {
+	return autoConvert_certmanager_IssuerCertificateDefaults_To_v1alpha2_IssuerCertificateDefaults(in, out, s)
+}
+
 func autoConvert_v1alpha2_IssuerCondition_To_certmanager_IssuerCondition(in *IssuerCondition, out *certmanager.IssuerCondition, s conversion.Scope) error {
 	out.Type = certmanager.IssuerConditionType(in.Type)
 	out.Status = meta.ConditionStatus(in.Status)
@@ -1145,6 +1441,16 @@ func autoConvert_v1alpha2_IssuerConfig_To_certmanager_IssuerConfig(in *IssuerCon
 	} else {
 		out.Venafi = nil
 	}
+	if in.SigningWebhook != nil {
+		in, out := &in.SigningWebhook, &out.SigningWebhook
+		*out = new(certmanager.SigningWebhookIssuer)
+		if err := Convert_v1alpha2_SigningWebhookIssuer_To_certmanager_SigningWebhookIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SigningWebhook = nil
+	}
+	out.KubernetesCSR = (*certmanager.KubernetesCSRIssuer)(unsafe.Pointer(in.KubernetesCSR))
 	return nil
 }
 
@@ -1183,6 +1489,16 @@ func autoConvert_certmanager_IssuerConfig_To_v1alpha2_IssuerConfig(in *certmanag
 	} else {
 		out.Venafi = nil
 	}
+	if in.SigningWebhook != nil {
+		in, out := &in.SigningWebhook, &out.SigningWebhook
+		*out = new(SigningWebhookIssuer)
+		if err := Convert_certmanager_SigningWebhookIssuer_To_v1alpha2_SigningWebhookIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SigningWebhook = nil
+	}
+	out.KubernetesCSR = (*KubernetesCSRIssuer)(unsafe.Pointer(in.KubernetesCSR))
 	return nil
 }
 
@@ -1237,6 +1553,7 @@ func autoConvert_v1alpha2_IssuerSpec_To_certmanager_IssuerSpec(in *IssuerSpec, o
 	if err := Convert_v1alpha2_IssuerConfig_To_certmanager_IssuerConfig(&in.IssuerConfig, &out.IssuerConfig, s); err != nil {
 		return err
 	}
+	out.CertificateDefaults = (*certmanager.IssuerCertificateDefaults)(unsafe.Pointer(in.CertificateDefaults))
 	return nil
 }
 
@@ -1249,6 +1566,7 @@ func autoConvert_certmanager_IssuerSpec_To_v1alpha2_IssuerSpec(in *certmanager.I
 	if err := Convert_certmanager_IssuerConfig_To_v1alpha2_IssuerConfig(&in.IssuerConfig, &out.IssuerConfig, s); err != nil {
 		return err
 	}
+	out.CertificateDefaults = (*IssuerCertificateDefaults)(unsafe.Pointer(in.CertificateDefaults))
 	return nil
 }
 
@@ -1307,6 +1625,26 @@ func Convert_certmanager_JKSKeystore_To_v1alpha2_JKSKeystore(in *certmanager.JKS
 	return autoConvert_certmanager_JKSKeystore_To_v1alpha2_JKSKeystore(in, out, s)
 }
 
+func autoConvert_v1alpha2_KubernetesCSRIssuer_To_certmanager_KubernetesCSRIssuer(in *KubernetesCSRIssuer, out *certmanager.KubernetesCSRIssuer, s conversion.Scope) error {
+	out.SignerName = in.SignerName
+	return nil
+}
+
+// Convert_v1alpha2_KubernetesCSRIssuer_To_certmanager_KubernetesCSRIssuer is an autogenerated conversion function.
+func Convert_v1alpha2_KubernetesCSRIssuer_To_certmanager_KubernetesCSRIssuer(in *KubernetesCSRIssuer, out *certmanager.KubernetesCSRIssuer, s conversion.Scope) error {
+	return autoConvert_v1alpha2_KubernetesCSRIssuer_To_certmanager_KubernetesCSRIssuer(in, out, s)
+}
+
+func autoConvert_certmanager_KubernetesCSRIssuer_To_v1alpha2_KubernetesCSRIssuer(in *certmanager.KubernetesCSRIssuer, out *KubernetesCSRIssuer, s conversion.Scope) error {
+	out.SignerName = in.SignerName
+	return nil
+}
+
+// Convert_certmanager_KubernetesCSRIssuer_To_v1alpha2_KubernetesCSRIssuer is an autogenerated conversion function.
+func Convert_certmanager_KubernetesCSRIssuer_To_v1alpha2_KubernetesCSRIssuer(in *certmanager.KubernetesCSRIssuer, out *KubernetesCSRIssuer, s conversion.Scope) error {
+	return autoConvert_certmanager_KubernetesCSRIssuer_To_v1alpha2_KubernetesCSRIssuer(in, out, s)
+}
+
 func autoConvert_v1alpha2_NameConstraintItem_To_certmanager_NameConstraintItem(in *NameConstraintItem, out *certmanager.NameConstraintItem, s conversion.Scope) error {
 	out.DNSDomains = *(*[]string)(unsafe.Pointer(&in.DNSDomains))
 	out.IPRanges = *(*[]string)(unsafe.Pointer(&in.IPRanges))
@@ -1409,6 +1747,7 @@ func Convert_certmanager_PKCS12Keystore_To_v1alpha2_PKCS12Keystore(in *certmanag
 
 func autoConvert_v1alpha2_SelfSignedIssuer_To_certmanager_SelfSignedIssuer(in *SelfSignedIssuer, out *certmanager.SelfSignedIssuer, s conversion.Scope) error {
 	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
+	out.IssuerDN = in.IssuerDN
 	return nil
 }
 
@@ -1419,6 +1758,7 @@ func Convert_v1alpha2_SelfSignedIssuer_To_certmanager_SelfSignedIssuer(in *SelfS
 
 func autoConvert_certmanager_SelfSignedIssuer_To_v1alpha2_SelfSignedIssuer(in *certmanager.SelfSignedIssuer, out *SelfSignedIssuer, s conversion.Scope) error {
 	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
+	out.IssuerDN = in.IssuerDN
 	return nil
 }
 
@@ -1449,6 +1789,64 @@ func Convert_certmanager_ServiceAccountRef_To_v1alpha2_ServiceAccountRef(in *cer
 	return autoConvert_certmanager_ServiceAccountRef_To_v1alpha2_ServiceAccountRef(in, out, s)
 }
 
+func autoConvert_v1alpha2_SigningWebhookIssuer_To_certmanager_SigningWebhookIssuer(in *SigningWebhookIssuer, out *certmanager.SigningWebhookIssuer, s conversion.Scope) error {
+	out.URL = in.URL
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	if in.ClientCertSecretRef != nil {
+		in, out := &in.ClientCertSecretRef, &out.ClientCertSecretRef
+		*out = new(meta.SecretKeySelector)
+		if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ClientCertSecretRef = nil
+	}
+	if in.ClientKeySecretRef != nil {
+		in, out := &in.ClientKeySecretRef, &out.ClientKeySecretRef
+		*out = new(meta.SecretKeySelector)
+		if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ClientKeySecretRef = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha2_SigningWebhookIssuer_To_certmanager_SigningWebhookIssuer is an autogenerated conversion function.
+func Convert_v1alpha2_SigningWebhookIssuer_To_certmanager_SigningWebhookIssuer(in *SigningWebhookIssuer, out *certmanager.SigningWebhookIssuer, s conversion.Scope) error {
+	return autoConvert_v1alpha2_SigningWebhookIssuer_To_certmanager_SigningWebhookIssuer(in, out, s)
+}
+
+func autoConvert_certmanager_SigningWebhookIssuer_To_v1alpha2_SigningWebhookIssuer(in *certmanager.SigningWebhookIssuer, out *SigningWebhookIssuer, s conversion.Scope) error {
+	out.URL = in.URL
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	if in.ClientCertSecretRef != nil {
+		in, out := &in.ClientCertSecretRef, &out.ClientCertSecretRef
+		*out = new(metav1.SecretKeySelector)
+		if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ClientCertSecretRef = nil
+	}
+	if in.ClientKeySecretRef != nil {
+		in, out := &in.ClientKeySecretRef, &out.ClientKeySecretRef
+		*out = new(metav1.SecretKeySelector)
+		if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ClientKeySecretRef = nil
+	}
+	return nil
+}
+
+// Convert_certmanager_SigningWebhookIssuer_To_v1alpha2_SigningWebhookIssuer is an autogenerated conversion function.
+func Convert_certmanager_SigningWebhookIssuer_To_v1alpha2_SigningWebhookIssuer(in *certmanager.SigningWebhookIssuer, out *SigningWebhookIssuer, s conversion.Scope) error {
+	return autoConvert_certmanager_SigningWebhookIssuer_To_v1alpha2_SigningWebhookIssuer(in, out, s)
+}
+
 func autoConvert_v1alpha2_VaultAppRole_To_certmanager_VaultAppRole(in *VaultAppRole, out *certmanager.VaultAppRole, s conversion.Scope) error {
 	out.Path = in.Path
 	out.RoleId = in.RoleId
@@ -1582,6 +1980,7 @@ func autoConvert_v1alpha2_VaultIssuer_To_certmanager_VaultIssuer(in *VaultIssuer
 	out.Server = in.Server
 	out.Path = in.Path
 	out.Namespace = in.Namespace
+	out.AllowedNamespaceOverrides = *(*[]string)(unsafe.Pointer(&in.AllowedNamespaceOverrides))
 	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
 	if in.CABundleSecretRef != nil {
 		in, out := &in.CABundleSecretRef, &out.CABundleSecretRef
@@ -1625,6 +2024,7 @@ func autoConvert_certmanager_VaultIssuer_To_v1alpha2_VaultIssuer(in *certmanager
 	out.Server = in.Server
 	out.Path = in.Path
 	out.Namespace = in.Namespace
+	out.AllowedNamespaceOverrides = *(*[]string)(unsafe.Pointer(&in.AllowedNamespaceOverrides))
 	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
 	if in.CABundleSecretRef != nil {
 		in, out := &in.CABundleSecretRef, &out.CABundleSecretRef
@@ -1712,6 +2112,30 @@ func Convert_certmanager_VenafiCloud_To_v1alpha2_VenafiCloud(in *certmanager.Ven
 	return autoConvert_certmanager_VenafiCloud_To_v1alpha2_VenafiCloud(in, out, s)
 }
 
+func autoConvert_v1alpha2_VenafiCustomField_To_certmanager_VenafiCustomField(in *VenafiCustomField, out *certmanager.VenafiCustomField, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	out.Required = in.Required
+	return nil
+}
+
+// Convert_v1alpha2_VenafiCustomField_To_certmanager_VenafiCustomField is an autogenerated conversion function.
+func Convert_v1alpha2_VenafiCustomField_To_certmanager_VenafiCustomField(in *VenafiCustomField, out *certmanager.VenafiCustomField, s conversion.Scope) error {
+	return autoConvert_v1alpha2_VenafiCustomField_To_certmanager_VenafiCustomField(in, out, s)
+}
+
+func autoConvert_certmanager_VenafiCustomField_To_v1alpha2_VenafiCustomField(in *certmanager.VenafiCustomField, out *VenafiCustomField, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	out.Required = in.Required
+	return nil
+}
+
+// Convert_certmanager_VenafiCustomField_To_v1alpha2_VenafiCustomField is an autogenerated conversion function.
+func Convert_certmanager_VenafiCustomField_To_v1alpha2_VenafiCustomField(in *certmanager.VenafiCustomField, out *VenafiCustomField, s conversion.Scope) error {
+	return autoConvert_certmanager_VenafiCustomField_To_v1alpha2_VenafiCustomField(in, out, s)
+}
+
 func autoConvert_v1alpha2_VenafiIssuer_To_certmanager_VenafiIssuer(in *VenafiIssuer, out *certmanager.VenafiIssuer, s conversion.Scope) error {
 	out.Zone = in.Zone
 	if in.TPP != nil {
@@ -1732,6 +2156,7 @@ func autoConvert_v1alpha2_VenafiIssuer_To_certmanager_VenafiIssuer(in *VenafiIss
 	} else {
 		out.Cloud = nil
 	}
+	out.CredentialCheckInterval = (*v1.Duration)(unsafe.Pointer(in.CredentialCheckInterval))
 	return nil
 }
 
@@ -1760,6 +2185,7 @@ func autoConvert_certmanager_VenafiIssuer_To_v1alpha2_VenafiIssuer(in *certmanag
 	} else {
 		out.Cloud = nil
 	}
+	out.CredentialCheckInterval = (*v1.Duration)(unsafe.Pointer(in.CredentialCheckInterval))
 	return nil
 }
 
@@ -1783,6 +2209,27 @@ func autoConvert_v1alpha2_VenafiTPP_To_certmanager_VenafiTPP(in *VenafiTPP, out
 	} else {
 		out.CABundleSecretRef = nil
 	}
+	if in.ClientCertSecretRef != nil {
+		in, out := &in.ClientCertSecretRef, &out.ClientCertSecretRef
+		*out = new(meta.SecretKeySelector)
+		if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ClientCertSecretRef = nil
+	}
+	if in.ClientKeySecretRef != nil {
+		in, out := &in.ClientKeySecretRef, &out.ClientKeySecretRef
+		*out = new(meta.SecretKeySelector)
+		if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ClientKeySecretRef = nil
+	}
+	out.AllowedZoneOverrides = *(*[]string)(unsafe.Pointer(&in.AllowedZoneOverrides))
+	out.PickupTimeout = (*v1.Duration)(unsafe.Pointer(in.PickupTimeout))
+	out.CustomFields = *(*[]certmanager.VenafiCustomField)(unsafe.Pointer(&in.CustomFields))
 	return nil
 }
 
@@ -1806,6 +2253,27 @@ func autoConvert_certmanager_VenafiTPP_To_v1alpha2_VenafiTPP(in *certmanager.Ven
 	} else {
 		out.CABundleSecretRef = nil
 	}
+	if in.ClientCertSecretRef != nil {
+		in, out := &in.ClientCertSecretRef, &out.ClientCertSecretRef
+		*out = new(metav1.SecretKeySelector)
+		if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ClientCertSecretRef = nil
+	}
+	if in.ClientKeySecretRef != nil {
+		in, out := &in.ClientKeySecretRef, &out.ClientKeySecretRef
+		*out = new(metav1.SecretKeySelector)
+		if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ClientKeySecretRef = nil
+	}
+	out.AllowedZoneOverrides = *(*[]string)(unsafe.Pointer(&in.AllowedZoneOverrides))
+	out.PickupTimeout = (*v1.Duration)(unsafe.Pointer(in.PickupTimeout))
+	out.CustomFields = *(*[]VenafiCustomField)(unsafe.Pointer(&in.CustomFields))
 	return nil
 }
 
@@ -1822,6 +2290,7 @@ func autoConvert_v1alpha2_X509Subject_To_certmanager_X509Subject(in *X509Subject
 	out.StreetAddresses = *(*[]string)(unsafe.Pointer(&in.StreetAddresses))
 	out.PostalCodes = *(*[]string)(unsafe.Pointer(&in.PostalCodes))
 	out.SerialNumber = in.SerialNumber
+	out.OrganizationIdentifiers = *(*[]string)(unsafe.Pointer(&in.OrganizationIdentifiers))
 	return nil
 }
 
@@ -1839,5 +2308,6 @@ func autoConvert_certmanager_X509Subject_To_v1alpha2_X509Subject(in *certmanager
 	out.StreetAddresses = *(*[]string)(unsafe.Pointer(&in.StreetAddresses))
 	out.PostalCodes = *(*[]string)(unsafe.Pointer(&in.PostalCodes))
 	out.SerialNumber = in.SerialNumber
+	out.OrganizationIdentifiers = *(*[]string)(unsafe.Pointer(&in.OrganizationIdentifiers))
 	return nil
 }
@@ -138,6 +138,22 @@ type CertificateSpec struct {
 	// +optional
 	DNSNames []string `json:"dnsNames,omitempty"`
 
+	// ServiceRef references a Service in the same namespace whose cluster DNS
+	// names are added to the requested DNS names: `<name>`, `<name>.<namespace>`,
+	// `<name>.<namespace>.svc` and `<name>.<namespace>.svc.<clusterDomain>`. If
+	// the Service is headless, the wildcard name
+	// `*.<name>.<namespace>.svc.<clusterDomain>` is also added, covering the
+	// DNS names of its Pods. The names are resolved by the controller and
+	// recorded in `status.serviceDNSNames`, and the Certificate is re-issued
+	// when they change.
+	// If `includeIPAddresses` is set, the IP addresses of the Service are
+	// also added to the requested IP addresses, and recorded in
+	// `status.serviceIPAddresses`.
+	// Requires the CertificateServiceDNSNames feature gate to be enabled on the
+	// controller.
+	// +optional
+	ServiceRef *CertificateServiceReference `json:"serviceRef,omitempty"`
+
 	// IPAddresses is a list of IP address subjectAltNames to be set on the Certificate.
 	// +optional
 	IPAddresses []string `json:"ipAddresses,omitempty"`
@@ -171,6 +187,15 @@ type CertificateSpec struct {
 	// +optional
 	SecretTemplate *CertificateSecretTemplate `json:"secretTemplate,omitempty"`
 
+	// DeleteSecretOnCertificateDeletion configures cert-manager to delete the
+	// Secret named by secretName when this Certificate is deleted, using a
+	// finalizer on the Certificate. The Secret is not deleted if it is
+	// managed by a different Certificate, or if another Certificate in the
+	// same namespace also uses it.
+	// Defaults to false, leaving the Secret in place.
+	// +optional
+	DeleteSecretOnCertificateDeletion bool `json:"deleteSecretOnCertificateDeletion,omitempty"`
+
 	// Keystores configures additional keystore output formats stored in the
 	// `secretName` Secret resource.
 	// +optional
@@ -189,6 +214,14 @@ type CertificateSpec struct {
 	// +optional
 	IsCA bool `json:"isCA,omitempty"`
 
+	// Requests the OCSP Must-Staple TLS feature (RFC 7633). If true, the
+	// status_request TLS feature extension is added to the CSR of created
+	// CertificateRequest resources, requiring clients to check for a stapled
+	// OCSP response when connecting to servers presenting the certificate.
+	// Note that the issuer may choose to ignore the requested extension.
+	// +optional
+	MustStaple bool `json:"mustStaple,omitempty"`
+
 	// Usages is the set of x509 usages that are requested for the certificate.
 	// Defaults to `digital signature` and `key encipherment` if not specified.
 	// +optional
@@ -246,6 +279,39 @@ type CertificateSpec struct {
 	// +optional
 	AdditionalOutputFormats []CertificateAdditionalOutputFormat `json:"additionalOutputFormats,omitempty"`
 
+	// ChainOrder controls how the certificate chain is assembled into the
+	// `tls.crt` key of this Certificate's target Secret.
+	// Allowed values are `leaf-only`, `leaf-then-intermediates` and
+	// `full-chain-with-root`.
+	// If unset, the chain is written as returned by the issuer.
+	// Changes to this field take effect on the next issuance.
+	// +optional
+	ChainOrder CertificateChainOrder `json:"chainOrder,omitempty"`
+
+	// AdditionalOutputSecrets is a list of additional Secrets, in the same
+	// namespace as the Certificate, which are kept in sync with the contents of
+	// the Certificate's target Secret. Secrets which are removed from this list
+	// are no longer updated, but are left in place. An existing Secret which is
+	// not an additional output Secret of this Certificate is never written to.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	AdditionalOutputSecrets []CertificateAdditionalOutputSecret `json:"additionalOutputSecrets,omitempty"`
+
+	// SecretMirrorNamespaces is a list of other namespaces to which the
+	// Certificate's target Secret is mirrored. Mirrored Secrets have the same
+	// name and contents as the target Secret, are kept in sync when it is
+	// renewed, and are deleted when their namespace is removed from this list
+	// or the Certificate is deleted.
+	// A namespace only receives a mirrored Secret if it is annotated with
+	// `cert-manager.io/allow-secret-mirror-from`, listing the namespace of
+	// this Certificate or `*`.
+	// Requires the CertificateSecretMirror feature gate to be enabled on the
+	// controller.
+	// +optional
+	// +listType=set
+	SecretMirrorNamespaces []string `json:"secretMirrorNamespaces,omitempty"`
+
 	// x.509 certificate NameConstraint extension which MUST NOT be used in a non-CA certificate.
 	// More Info: https://datatracker.ietf.org/doc/html/rfc5280#section-4.2.1.10
 	//
@@ -279,9 +345,31 @@ type CertificatePrivateKey struct {
 	// to await user intervention.
 	// If set to Always, a private key matching the specified requirements
 	// will be generated whenever a re-issuance occurs.
+	// If set to Scheduled, the private key will be reused on re-issuance
+	// until it is older than `rotationInterval`, at which point a new private
+	// key is generated and the certificate is re-issued, even if it is not
+	// yet due for renewal.
 	// Default is 'Never' for backward compatibility.
 	// +optional
 	RotationPolicy PrivateKeyRotationPolicy `json:"rotationPolicy,omitempty"`
+
+	// RotationInterval is the maximum age of the private key when the
+	// RotationPolicy is `Scheduled`. Once the private key is older than this
+	// interval a new one is generated, independently of the certificate's
+	// renewal time. Must be set if, and only if, the RotationPolicy is
+	// `Scheduled`, and must be at least 1 hour.
+	// +optional
+	RotationInterval *metav1.Duration `json:"rotationInterval,omitempty"`
+
+	// ExistingSecretRef references a key in a Secret resource, in the same
+	// namespace as the Certificate, containing a pre-generated PEM encoded
+	// private key. If set, the certificate signing request is built from this
+	// key instead of cert-manager generating one. The key must match
+	// `algorithm` and `size`, and is never rotated by cert-manager, so
+	// `rotationPolicy` must be unset or `Never`.
+	// The `key` defaults to `tls.key` if not specified.
+	// +optional
+	ExistingSecretRef *cmmeta.SecretKeySelector `json:"existingSecretRef,omitempty"`
 }
 
 // Denotes how private keys should be generated or sourced when a Certificate
@@ -298,6 +386,11 @@ var (
 	// RotationPolicyAlways means a private key matching the specified
 	// requirements will be generated whenever a re-issuance occurs.
 	RotationPolicyAlways PrivateKeyRotationPolicy = "Always"
+
+	// RotationPolicyScheduled means the private key will be reused on
+	// re-issuance until it is older than the rotation interval, at which
+	// point a new private key is generated and the certificate re-issued.
+	RotationPolicyScheduled PrivateKeyRotationPolicy = "Scheduled"
 )
 
 // X509Subject Full X509 name specification
@@ -326,6 +419,10 @@ type X509Subject struct {
 	// Serial number to be used on the Certificate.
 	// +optional
 	SerialNumber string `json:"serialNumber,omitempty"`
+	// Organization identifiers (OID 2.5.4.97) to be used on the Certificate,
+	// e.g. `VATGB-123456789`. See ETSI EN 319 412-1 for the recommended format.
+	// +optional
+	OrganizationIdentifiers []string `json:"organizationIdentifiers,omitempty"`
 }
 
 // CertificateKeystores configures additional keystore output formats to be
@@ -382,7 +479,8 @@ type PKCS12Keystore struct {
 	PasswordSecretRef cmmeta.SecretKeySelector `json:"passwordSecretRef"`
 
 	// Profile specifies the key and certificate encryption algorithms and the HMAC algorithm
-	// used to create the PKCS12 keystore. Default value is `LegacyRC2` for backward compatibility.
+	// used to create the PKCS12 keystore. Default value is `LegacyRC2` for backward compatibility,
+	// or `Modern2023` if cert-manager is running in FIPS mode.
 	//
 	// If provided, allowed values are:
 	// `LegacyRC2`: Deprecated. Not supported by default in OpenSSL 3 or Java 20.
@@ -474,6 +572,85 @@ type CertificateStatus struct {
 	// time.Hour * 2 ^ (failedIssuanceAttempts - 1).
 	// +optional
 	FailedIssuanceAttempts *int `json:"failedIssuanceAttempts,omitempty"`
+
+	// LastPrivateKeyRotationTime is the time at which a new private key was
+	// last issued for this certificate. It is only tracked when the private
+	// key RotationPolicy is `Scheduled`, and is used to determine when the
+	// private key is next due to be rotated.
+	// +optional
+	LastPrivateKeyRotationTime *metav1.Time `json:"lastPrivateKeyRotationTime,omitempty"`
+
+	// RenewalInfo is the ACME Renewal Information (ARI) most recently
+	// fetched for the current certificate, if the certificate was issued by
+	// an ACME server which supports it. When set, the certificate is renewed
+	// within the window suggested by the ACME server rather than at the time
+	// derived from `renewBefore`.
+	// +optional
+	RenewalInfo *CertificateRenewalInfo `json:"renewalInfo,omitempty"`
+
+	// LastRevocation records the most recent revocation of a certificate
+	// issued for this Certificate, as requested with the
+	// `cert-manager.io/revoked-serial-number` annotation. If the certificate
+	// stored in the Secret has the recorded serial number, it is re-issued.
+	// +optional
+	LastRevocation *CertificateRevocation `json:"lastRevocation,omitempty"`
+
+	// ServiceDNSNames are the DNS names resolved from the Service referenced
+	// by `spec.serviceRef`, which are requested in addition to `spec.dnsNames`.
+	// +optional
+	// +listType=atomic
+	ServiceDNSNames []string `json:"serviceDNSNames,omitempty"`
+
+	// ServiceIPAddresses are the IP addresses resolved from the Service
+	// referenced by `spec.serviceRef` when `includeIPAddresses` is set, which
+	// are requested in addition to `spec.ipAddresses`.
+	// +optional
+	// +listType=atomic
+	ServiceIPAddresses []string `json:"serviceIPAddresses,omitempty"`
+}
+
+// CertificateRenewalInfo contains the renewal window suggested by an ACME
+// server for a certificate, as described in RFC 9773.
+type CertificateRenewalInfo struct {
+	// CertID is the ARI identifier of the certificate this renewal
+	// information applies to.
+	CertID string `json:"certID"`
+
+	// SuggestedWindowStart is the start of the window in which the ACME
+	// server suggests the certificate is renewed.
+	SuggestedWindowStart metav1.Time `json:"suggestedWindowStart"`
+
+	// SuggestedWindowEnd is the end of the window in which the ACME server
+	// suggests the certificate is renewed.
+	SuggestedWindowEnd metav1.Time `json:"suggestedWindowEnd"`
+
+	// ExplanationURL is a URL provided by the ACME server explaining the
+	// suggested window, for example following a revocation event.
+	// +optional
+	ExplanationURL string `json:"explanationURL,omitempty"`
+
+	// NextUpdateTime is the time at which the renewal information will
+	// next be fetched, as advised by the ACME server's Retry-After header.
+	// +optional
+	NextUpdateTime *metav1.Time `json:"nextUpdateTime,omitempty"`
+}
+
+// CertificateRevocation records the revocation of a certificate previously
+// issued for a Certificate.
+type CertificateRevocation struct {
+	// SerialNumber is the serial number of the revoked certificate, encoded
+	// as lowercase hexadecimal.
+	SerialNumber string `json:"serialNumber"`
+
+	// RevocationTime is the time at which cert-manager recorded the
+	// revocation.
+	RevocationTime metav1.Time `json:"revocationTime"`
+
+	// RevokedWithIssuer is true if cert-manager revoked the certificate with
+	// the ACME server of the issuer, as requested with the
+	// `acme.cert-manager.io/revoke` annotation.
+	// +optional
+	RevokedWithIssuer bool `json:"revokedWithIssuer,omitempty"`
 }
 
 // CertificateCondition contains condition information for an Certificate.
@@ -549,13 +726,53 @@ type CertificateSecretTemplate struct {
 	// Labels is a key value map to be copied to the target Kubernetes Secret.
 	// +optional
 	Labels map[string]string `json:"labels,omitempty"`
+
+	// RenderTemplates enables rendering of Annotations and Labels values as Go
+	// templates against the issued certificate. The fields `.NotBefore`,
+	// `.NotAfter`, `.SerialNumber` and `.Issuer` are available, along with the
+	// functions `rfc3339`, `unix`, `lower`, `upper` and `replace`.
+	// Templated labels must render to a valid label value for an example
+	// certificate issued by "CN=example-issuer", so `.Issuer` can only be used
+	// in a label with `replace` to remove characters such as `=`. A templated
+	// label whose value rendered for the issued certificate is still not a
+	// valid label value, for example because the issuer name contains a space,
+	// is not set on the Secret and a warning event is recorded on the
+	// Certificate.
+	// If false, values are copied as is.
+	// +optional
+	RenderTemplates bool `json:"renderTemplates,omitempty"`
 }
 
+// CertificateChainOrder controls which certificates of the signed chain are
+// written to the `tls.crt` key of the Certificate's target Secret, and in
+// which order.
+// Allowed values are `leaf-only`, `leaf-then-intermediates` and
+// `full-chain-with-root`.
+// +kubebuilder:validation:Enum=leaf-only;leaf-then-intermediates;full-chain-with-root
+type CertificateChainOrder string
+
+const (
+	// CertificateChainOrderLeafOnly writes only the leaf certificate.
+	CertificateChainOrderLeafOnly CertificateChainOrder = "leaf-only"
+
+	// CertificateChainOrderLeafThenIntermediates writes the leaf certificate
+	// followed by any intermediate certificates, omitting self-signed root
+	// certificates.
+	CertificateChainOrderLeafThenIntermediates CertificateChainOrder = "leaf-then-intermediates"
+
+	// CertificateChainOrderFullChainWithRoot writes the leaf certificate
+	// followed by any intermediate certificates and ends with the root
+	// certificate, which is taken from the CA returned by the issuer if it is
+	// not present in the chain.
+	CertificateChainOrderFullChainWithRoot CertificateChainOrder = "full-chain-with-root"
+)
+
 // CertificateOutputFormatType specifies which additional output formats should
 // be written to the Certificate's target Secret.
 // Allowed values are `DER` or `CombinedPEM`.
-// When Type is set to `DER` an additional entry `key.der` will be written to
-// the Secret, containing the binary format of the private key.
+// When Type is set to `DER` the additional entries `key.der` and `tls.der`
+// will be written to the Secret, containing the binary format of the private
+// key and of the leaf certificate respectively.
 // When Type is set to `CombinedPEM` an additional entry `tls-combined.pem`
 // will be written to the Secret, containing the PEM formatted private key and
 // signed certificate chain (tls.key + tls.crt concatenated).
@@ -564,7 +781,8 @@ type CertificateOutputFormatType string
 
 const (
 	// CertificateOutputFormatDER  writes the Certificate's private key in DER
-	// binary format to the `key.der` target Secret Data key.
+	// binary format to the `key.der` target Secret Data key, and the leaf
+	// certificate in DER binary format to the `tls.der` target Secret Data key.
 	CertificateOutputFormatDER CertificateOutputFormatType = "DER"
 
 	// CertificateOutputFormatCombinedPEM  writes the Certificate's signed
@@ -585,6 +803,33 @@ type CertificateAdditionalOutputFormat struct {
 	Type CertificateOutputFormatType `json:"type"`
 }
 
+// CertificateAdditionalOutputSecret references an additional Secret which the
+// contents of the Certificate's target Secret are written to.
+type CertificateAdditionalOutputSecret struct {
+	// Name of the Secret.
+	Name string `json:"name"`
+}
+
+// CertificateServiceReference references a Service whose cluster DNS names,
+// and optionally IP addresses, are added to the subject alternative names of
+// a Certificate.
+type CertificateServiceReference struct {
+	// Name of the Service, which must be in the same namespace as the
+	// Certificate.
+	Name string `json:"name"`
+
+	// ClusterDomain is the DNS domain of the cluster, used to build the fully
+	// qualified name of the Service. Defaults to `cluster.local`.
+	// +optional
+	ClusterDomain string `json:"clusterDomain,omitempty"`
+
+	// IncludeIPAddresses adds the cluster IPs of the Service, and the ingress
+	// IPs of a LoadBalancer Service once they have been assigned, to the
+	// requested IP addresses.
+	// +optional
+	IncludeIPAddresses bool `json:"includeIPAddresses,omitempty"`
+}
+
 // NameConstraints is a type to represent x509 NameConstraints
 type NameConstraints struct {
 	// if true then the name constraints are marked critical.
@@ -46,6 +46,11 @@ func (in *CAIssuer) DeepCopyInto(out *CAIssuer) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AllowedExtraExtensions != nil {
+		in, out := &in.AllowedExtraExtensions, &out.AllowedExtraExtensions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -103,6 +108,22 @@ func (in *CertificateAdditionalOutputFormat) DeepCopy() *CertificateAdditionalOu
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateAdditionalOutputSecret) DeepCopyInto(out *CertificateAdditionalOutputSecret) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateAdditionalOutputSecret.
+func (in *CertificateAdditionalOutputSecret) DeepCopy() *CertificateAdditionalOutputSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateAdditionalOutputSecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertificateCondition) DeepCopyInto(out *CertificateCondition) {
 	*out = *in
@@ -185,6 +206,16 @@ func (in *CertificateList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertificatePrivateKey) DeepCopyInto(out *CertificatePrivateKey) {
 	*out = *in
+	if in.RotationInterval != nil {
+		in, out := &in.RotationInterval, &out.RotationInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ExistingSecretRef != nil {
+		in, out := &in.ExistingSecretRef, &out.ExistingSecretRef
+		*out = new(metav1.SecretKeySelector)
+		**out = **in
+	}
 	return
 }
 
@@ -198,6 +229,28 @@ func (in *CertificatePrivateKey) DeepCopy() *CertificatePrivateKey {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRenewalInfo) DeepCopyInto(out *CertificateRenewalInfo) {
+	*out = *in
+	in.SuggestedWindowStart.DeepCopyInto(&out.SuggestedWindowStart)
+	in.SuggestedWindowEnd.DeepCopyInto(&out.SuggestedWindowEnd)
+	if in.NextUpdateTime != nil {
+		in, out := &in.NextUpdateTime, &out.NextUpdateTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRenewalInfo.
+func (in *CertificateRenewalInfo) DeepCopy() *CertificateRenewalInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRenewalInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertificateRequest) DeepCopyInto(out *CertificateRequest) {
 	*out = *in
@@ -246,6 +299,23 @@ func (in *CertificateRequestCondition) DeepCopy() *CertificateRequestCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRequestIssuanceFailure) DeepCopyInto(out *CertificateRequestIssuanceFailure) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestIssuanceFailure.
+func (in *CertificateRequestIssuanceFailure) DeepCopy() *CertificateRequestIssuanceFailure {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRequestIssuanceFailure)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertificateRequestList) DeepCopyInto(out *CertificateRequestList) {
 	*out = *in
@@ -355,6 +425,17 @@ func (in *CertificateRequestStatus) DeepCopyInto(out *CertificateRequestStatus)
 		in, out := &in.FailureTime, &out.FailureTime
 		*out = (*in).DeepCopy()
 	}
+	if in.DeniedTime != nil {
+		in, out := &in.DeniedTime, &out.DeniedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.IssuanceFailures != nil {
+		in, out := &in.IssuanceFailures, &out.IssuanceFailures
+		*out = make([]CertificateRequestIssuanceFailure, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -398,6 +479,39 @@ func (in *CertificateSecretTemplate) DeepCopy() *CertificateSecretTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRevocation) DeepCopyInto(out *CertificateRevocation) {
+	*out = *in
+	in.RevocationTime.DeepCopyInto(&out.RevocationTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRevocation.
+func (in *CertificateRevocation) DeepCopy() *CertificateRevocation {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRevocation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateServiceReference) DeepCopyInto(out *CertificateServiceReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateServiceReference.
+func (in *CertificateServiceReference) DeepCopy() *CertificateServiceReference {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateServiceReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertificateSpec) DeepCopyInto(out *CertificateSpec) {
 	*out = *in
@@ -426,6 +540,11 @@ func (in *CertificateSpec) DeepCopyInto(out *CertificateSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ServiceRef != nil {
+		in, out := &in.ServiceRef, &out.ServiceRef
+		*out = new(CertificateServiceReference)
+		**out = **in
+	}
 	if in.IPAddresses != nil {
 		in, out := &in.IPAddresses, &out.IPAddresses
 		*out = make([]string, len(*in))
@@ -465,7 +584,7 @@ func (in *CertificateSpec) DeepCopyInto(out *CertificateSpec) {
 	if in.PrivateKey != nil {
 		in, out := &in.PrivateKey, &out.PrivateKey
 		*out = new(CertificatePrivateKey)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.EncodeUsagesInRequest != nil {
 		in, out := &in.EncodeUsagesInRequest, &out.EncodeUsagesInRequest
@@ -482,6 +601,16 @@ func (in *CertificateSpec) DeepCopyInto(out *CertificateSpec) {
 		*out = make([]CertificateAdditionalOutputFormat, len(*in))
 		copy(*out, *in)
 	}
+	if in.AdditionalOutputSecrets != nil {
+		in, out := &in.AdditionalOutputSecrets, &out.AdditionalOutputSecrets
+		*out = make([]CertificateAdditionalOutputSecret, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecretMirrorNamespaces != nil {
+		in, out := &in.SecretMirrorNamespaces, &out.SecretMirrorNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.NameConstraints != nil {
 		in, out := &in.NameConstraints, &out.NameConstraints
 		*out = new(NameConstraints)
@@ -541,6 +670,30 @@ func (in *CertificateStatus) DeepCopyInto(out *CertificateStatus) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.LastPrivateKeyRotationTime != nil {
+		in, out := &in.LastPrivateKeyRotationTime, &out.LastPrivateKeyRotationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.RenewalInfo != nil {
+		in, out := &in.RenewalInfo, &out.RenewalInfo
+		*out = new(CertificateRenewalInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastRevocation != nil {
+		in, out := &in.LastRevocation, &out.LastRevocation
+		*out = new(CertificateRevocation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceDNSNames != nil {
+		in, out := &in.ServiceDNSNames, &out.ServiceDNSNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ServiceIPAddresses != nil {
+		in, out := &in.ServiceIPAddresses, &out.ServiceIPAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -643,6 +796,32 @@ func (in *Issuer) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IssuerCertificateDefaults) DeepCopyInto(out *IssuerCertificateDefaults) {
+	*out = *in
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RenewBefore != nil {
+		in, out := &in.RenewBefore, &out.RenewBefore
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssuerCertificateDefaults.
+func (in *IssuerCertificateDefaults) DeepCopy() *IssuerCertificateDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(IssuerCertificateDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IssuerCondition) DeepCopyInto(out *IssuerCondition) {
 	*out = *in
@@ -691,6 +870,16 @@ func (in *IssuerConfig) DeepCopyInto(out *IssuerConfig) {
 		*out = new(VenafiIssuer)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SigningWebhook != nil {
+		in, out := &in.SigningWebhook, &out.SigningWebhook
+		*out = new(SigningWebhookIssuer)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubernetesCSR != nil {
+		in, out := &in.KubernetesCSR, &out.KubernetesCSR
+		*out = new(KubernetesCSRIssuer)
+		**out = **in
+	}
 	return
 }
 
@@ -741,6 +930,11 @@ func (in *IssuerList) DeepCopyObject() runtime.Object {
 func (in *IssuerSpec) DeepCopyInto(out *IssuerSpec) {
 	*out = *in
 	in.IssuerConfig.DeepCopyInto(&out.IssuerConfig)
+	if in.CertificateDefaults != nil {
+		in, out := &in.CertificateDefaults, &out.CertificateDefaults
+		*out = new(IssuerCertificateDefaults)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -804,6 +998,22 @@ func (in *JKSKeystore) DeepCopy() *JKSKeystore {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesCSRIssuer) DeepCopyInto(out *KubernetesCSRIssuer) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesCSRIssuer.
+func (in *KubernetesCSRIssuer) DeepCopy() *KubernetesCSRIssuer {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesCSRIssuer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NameConstraintItem) DeepCopyInto(out *NameConstraintItem) {
 	*out = *in
@@ -941,6 +1151,37 @@ func (in *ServiceAccountRef) DeepCopy() *ServiceAccountRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SigningWebhookIssuer) DeepCopyInto(out *SigningWebhookIssuer) {
+	*out = *in
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClientCertSecretRef != nil {
+		in, out := &in.ClientCertSecretRef, &out.ClientCertSecretRef
+		*out = new(metav1.SecretKeySelector)
+		**out = **in
+	}
+	if in.ClientKeySecretRef != nil {
+		in, out := &in.ClientKeySecretRef, &out.ClientKeySecretRef
+		*out = new(metav1.SecretKeySelector)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SigningWebhookIssuer.
+func (in *SigningWebhookIssuer) DeepCopy() *SigningWebhookIssuer {
+	if in == nil {
+		return nil
+	}
+	out := new(SigningWebhookIssuer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VaultAppRole) DeepCopyInto(out *VaultAppRole) {
 	*out = *in
@@ -1014,6 +1255,11 @@ func (in *VaultClientCertificateAuth) DeepCopy() *VaultClientCertificateAuth {
 func (in *VaultIssuer) DeepCopyInto(out *VaultIssuer) {
 	*out = *in
 	in.Auth.DeepCopyInto(&out.Auth)
+	if in.AllowedNamespaceOverrides != nil {
+		in, out := &in.AllowedNamespaceOverrides, &out.AllowedNamespaceOverrides
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.CABundle != nil {
 		in, out := &in.CABundle, &out.CABundle
 		*out = make([]byte, len(*in))
@@ -1086,6 +1332,22 @@ func (in *VenafiCloud) DeepCopy() *VenafiCloud {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VenafiCustomField) DeepCopyInto(out *VenafiCustomField) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VenafiCustomField.
+func (in *VenafiCustomField) DeepCopy() *VenafiCustomField {
+	if in == nil {
+		return nil
+	}
+	out := new(VenafiCustomField)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VenafiIssuer) DeepCopyInto(out *VenafiIssuer) {
 	*out = *in
@@ -1099,6 +1361,11 @@ func (in *VenafiIssuer) DeepCopyInto(out *VenafiIssuer) {
 		*out = new(VenafiCloud)
 		**out = **in
 	}
+	if in.CredentialCheckInterval != nil {
+		in, out := &in.CredentialCheckInterval, &out.CredentialCheckInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -1126,6 +1393,31 @@ func (in *VenafiTPP) DeepCopyInto(out *VenafiTPP) {
 		*out = new(metav1.SecretKeySelector)
 		**out = **in
 	}
+	if in.ClientCertSecretRef != nil {
+		in, out := &in.ClientCertSecretRef, &out.ClientCertSecretRef
+		*out = new(metav1.SecretKeySelector)
+		**out = **in
+	}
+	if in.ClientKeySecretRef != nil {
+		in, out := &in.ClientKeySecretRef, &out.ClientKeySecretRef
+		*out = new(metav1.SecretKeySelector)
+		**out = **in
+	}
+	if in.AllowedZoneOverrides != nil {
+		in, out := &in.AllowedZoneOverrides, &out.AllowedZoneOverrides
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PickupTimeout != nil {
+		in, out := &in.PickupTimeout, &out.PickupTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.CustomFields != nil {
+		in, out := &in.CustomFields, &out.CustomFields
+		*out = make([]VenafiCustomField, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1177,6 +1469,11 @@ func (in *X509Subject) DeepCopyInto(out *X509Subject) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.OrganizationIdentifiers != nil {
+		in, out := &in.OrganizationIdentifiers, &out.OrganizationIdentifiers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -154,6 +154,35 @@ type CertificateRequestStatus struct {
 	// used to influence garbage collection and back-off.
 	// +optional
 	FailureTime *metav1.Time `json:"failureTime,omitempty"`
+
+	// DeniedBy is the reason of the Denied condition of a denied CertificateRequest,
+	// which by convention identifies the approval controller which denied it,
+	// for example "policy.cert-manager.io". It is only set once the CertificateRequest
+	// has been denied.
+	// +optional
+	DeniedBy string `json:"deniedBy,omitempty"`
+
+	// DeniedTime is the LastTransitionTime of the Denied condition of a denied
+	// CertificateRequest. It is only set once the CertificateRequest has been denied.
+	// +optional
+	DeniedTime *metav1.Time `json:"deniedTime,omitempty"`
+
+	// PickupID is the identifier of the request submitted to an external issuer,
+	// such as a Venafi pickup ID. It is used to retrieve the certificate of the
+	// existing request, rather than submitting a new one, when the
+	// CertificateRequest is reconciled again.
+	// +optional
+	PickupID string `json:"pickupID,omitempty"`
+
+	// IssuanceFailures records the most recent failures to issue a certificate
+	// for this CertificateRequest, oldest first. Failures are kept after the
+	// certificate is issued, to help diagnose transient errors such as those
+	// of a flapping issuer. At most 5 failures are recorded. Consecutive
+	// failures with the same reason are recorded once, with the time of the
+	// first of them.
+	// +listType=atomic
+	// +optional
+	IssuanceFailures []CertificateRequestIssuanceFailure `json:"issuanceFailures,omitempty"`
 }
 
 // CertificateRequestCondition contains condition information for a CertificateRequest.
@@ -181,6 +210,20 @@ type CertificateRequestCondition struct {
 	Message string `json:"message,omitempty"`
 }
 
+// CertificateRequestIssuanceFailure records a failed attempt to issue a
+// certificate for a CertificateRequest.
+type CertificateRequestIssuanceFailure struct {
+	// Time is the time at which the failure occurred.
+	Time metav1.Time `json:"time"`
+
+	// Reason is a brief machine readable explanation of the failure.
+	Reason string `json:"reason"`
+
+	// Message is a human readable description of the failure.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
 // CertificateRequestConditionType represents an Certificate condition value.
 type CertificateRequestConditionType string
 
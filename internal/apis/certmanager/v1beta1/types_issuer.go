@@ -89,6 +89,31 @@ type IssuerList struct {
 // configuration required for the issuer.
 type IssuerSpec struct {
 	IssuerConfig `json:",inline"`
+
+	// CertificateDefaults are applied by the webhook to Certificates which
+	// reference this issuer and do not set the corresponding fields. Values
+	// set on the Certificate always take precedence. Defaults are only
+	// applied when a Certificate is created or updated, so changes to them
+	// do not affect existing Certificates until they are next updated.
+	// Requires the IssuerCertificateDefaults feature gate to be enabled on the
+	// webhook.
+	// +optional
+	CertificateDefaults *IssuerCertificateDefaults `json:"certificateDefaults,omitempty"`
+}
+
+// IssuerCertificateDefaults are the defaults applied to Certificates which
+// reference an issuer and do not set the corresponding fields themselves.
+type IssuerCertificateDefaults struct {
+	// Duration is the default requested 'duration' (i.e. lifetime) of
+	// Certificates referencing this issuer.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// RenewBefore is the default 'renewBefore' of Certificates referencing
+	// this issuer. It is not applied to Certificates which set
+	// `renewBeforePercentage`, or whose duration is not longer than it.
+	// +optional
+	RenewBefore *metav1.Duration `json:"renewBefore,omitempty"`
 }
 
 // The configuration for the issuer.
@@ -119,6 +144,20 @@ type IssuerConfig struct {
 	// or Venafi Cloud policy zone.
 	// +optional
 	Venafi *VenafiIssuer `json:"venafi,omitempty"`
+
+	// SigningWebhook configures this issuer to delegate the signing of
+	// certificates to an external signing webhook, for example one which is
+	// backed by an offline CA.
+	// +optional
+	SigningWebhook *SigningWebhookIssuer `json:"signingWebhook,omitempty"`
+
+	// KubernetesCSR configures this issuer to sign certificates by creating
+	// Kubernetes CertificateSigningRequests for a signer running in the
+	// cluster.
+	// It is only supported by ClusterIssuers, since the controller creates
+	// the cluster-scoped CertificateSigningRequests with its own identity.
+	// +optional
+	KubernetesCSR *KubernetesCSRIssuer `json:"kubernetesCSR,omitempty"`
 }
 
 // Configures an issuer to sign certificates using a Venafi TPP
@@ -127,6 +166,8 @@ type VenafiIssuer struct {
 	// Zone is the Venafi Policy Zone to use for this issuer.
 	// All requests made to the Venafi platform will be restricted by the named
 	// zone policy.
+	// For Venafi Cloud, the zone must be of the form
+	// `<application name>\<issuing template alias>`.
 	// This field is required.
 	Zone string `json:"zone"`
 
@@ -139,6 +180,14 @@ type VenafiIssuer struct {
 	// Only one of TPP or Cloud may be specified.
 	// +optional
 	Cloud *VenafiCloud `json:"cloud,omitempty"`
+
+	// CredentialCheckInterval is the interval at which cert-manager will
+	// re-verify the credentials used to connect to the Venafi server, so that
+	// credentials which expire or are revoked are detected and reported in the
+	// issuer's Ready condition. If not set, credentials are only verified when
+	// the issuer is first set up or when it changes.
+	// +optional
+	CredentialCheckInterval *metav1.Duration `json:"credentialCheckInterval,omitempty"`
 }
 
 // VenafiTPP defines connection configuration details for a Venafi TPP instance
@@ -166,6 +215,62 @@ type VenafiTPP struct {
 	// the cert-manager controller container is used to validate the TLS connection.
 	// +optional
 	CABundleSecretRef *cmmeta.SecretKeySelector `json:"caBundleSecretRef,omitempty"`
+
+	// Reference to a Secret containing a PEM-encoded Client Certificate to use when the
+	// TPP server requires mTLS. Only used if using HTTPS; ignored for HTTP.
+	// If no key for the Secret is specified, cert-manager will default to 'tls.crt'.
+	// +optional
+	ClientCertSecretRef *cmmeta.SecretKeySelector `json:"clientCertSecretRef,omitempty"`
+
+	// Reference to a Secret containing a PEM-encoded Client Private Key to use when the
+	// TPP server requires mTLS. Only used if using HTTPS; ignored for HTTP.
+	// If no key for the Secret is specified, cert-manager will default to 'tls.key'.
+	// +optional
+	ClientKeySecretRef *cmmeta.SecretKeySelector `json:"clientKeySecretRef,omitempty"`
+
+	// AllowedZoneOverrides is a list of Venafi zones which CertificateRequests
+	// may select using the "venafi.cert-manager.io/zone" annotation, in place of
+	// the Zone configured on this issuer.
+	// If empty, zone overrides are not permitted.
+	// +optional
+	AllowedZoneOverrides []string `json:"allowedZoneOverrides,omitempty"`
+
+	// PickupTimeout is the maximum amount of time cert-manager will wait for
+	// a requested certificate to be issued each time it is retrieved from
+	// the Venafi TPP instance, for example while the request is awaiting
+	// manual approval. If the certificate has not been issued by then, the
+	// CertificateRequest is kept Pending and retrieval is retried later.
+	// Defaults to 60 seconds, and must not be greater than 2 minutes so that
+	// retrieval completes within a single sync of the CertificateRequest.
+	// +optional
+	PickupTimeout *metav1.Duration `json:"pickupTimeout,omitempty"`
+
+	// CustomFields is a list of Venafi custom fields which are set on every
+	// certificate requested from the Venafi TPP instance, for example a cost
+	// center or application ID required by the TPP policy. Values set in the
+	// "venafi.cert-manager.io/custom-fields" annotation of a
+	// CertificateRequest take precedence over values set here.
+	// +optional
+	CustomFields []VenafiCustomField `json:"customFields,omitempty"`
+}
+
+// VenafiCustomField is a Venafi custom field which is set on certificates
+// requested from a Venafi TPP instance.
+type VenafiCustomField struct {
+	// Name is the name of the custom field, as configured in Venafi TPP.
+	Name string `json:"name"`
+
+	// Value is the default value of the custom field.
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// Required specifies that a value must be set for this custom field,
+	// either in Value or in the "venafi.cert-manager.io/custom-fields"
+	// annotation of the CertificateRequest. CertificateRequests without a
+	// value for a required custom field fail without being submitted to
+	// Venafi TPP.
+	// +optional
+	Required bool `json:"required,omitempty"`
 }
 
 // VenafiCloud defines connection configuration details for Venafi Cloud
@@ -187,6 +292,54 @@ type SelfSignedIssuer struct {
 	// If not set certificate will be issued without CDP. Values are strings.
 	// +optional
 	CRLDistributionPoints []string `json:"crlDistributionPoints,omitempty"`
+
+	// IssuerDN is the distinguished name, in RFC 4514 format, that will be set as
+	// the Issuer of certificates signed by this issuer. If not set, the Issuer
+	// will be the same as the certificate's Subject.
+	// +optional
+	IssuerDN string `json:"issuerDN,omitempty"`
+}
+
+// Configures an issuer to delegate the signing of certificates to an external
+// signing webhook, so that cert-manager does not need to hold any CA key
+// material. This can be used to issue certificates from an offline CA.
+type SigningWebhookIssuer struct {
+	// URL is the HTTPS endpoint of the signing webhook, e.g:
+	// "https://signer.example.com/sign".
+	// Certificate signing requests are POSTed to this URL, which must respond
+	// with the signed certificate.
+	URL string `json:"url"`
+
+	// Base64-encoded bundle of PEM CAs which will be used to validate the certificate
+	// chain presented by the signing webhook.
+	// If undefined, the certificate bundle in the cert-manager controller container
+	// is used to validate the TLS connection.
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+
+	// Reference to a Secret containing a PEM-encoded Client Certificate to use when the
+	// signing webhook requires mTLS.
+	// Must be specified together with ClientKeySecretRef.
+	// +optional
+	ClientCertSecretRef *cmmeta.SecretKeySelector `json:"clientCertSecretRef,omitempty"`
+
+	// Reference to a Secret containing a PEM-encoded Client Private Key to use when the
+	// signing webhook requires mTLS.
+	// Must be specified together with ClientCertSecretRef.
+	// +optional
+	ClientKeySecretRef *cmmeta.SecretKeySelector `json:"clientKeySecretRef,omitempty"`
+}
+
+// Configures an issuer to sign certificates by creating Kubernetes
+// CertificateSigningRequest resources for a signer running in the cluster,
+// for example the kube-controller-manager's built-in signers.
+type KubernetesCSRIssuer struct {
+	// SignerName is the name of the Kubernetes signer which should sign the
+	// CertificateSigningRequests created for this issuer, e.g:
+	// "kubernetes.io/kubelet-serving".
+	// The CertificateSigningRequests must be approved before they are signed,
+	// either by an approver for this signer or by a cluster administrator.
+	SignerName string `json:"signerName"`
 }
 
 // Configures an issuer to sign certificates using a HashiCorp Vault
@@ -207,6 +360,13 @@ type VaultIssuer struct {
 	// +optional
 	Namespace string `json:"namespace,omitempty"`
 
+	// AllowedNamespaceOverrides is a list of Vault namespaces which
+	// CertificateRequests may select using the "vault.cert-manager.io/namespace"
+	// annotation, in place of the Namespace configured on this issuer.
+	// If empty, namespace overrides are not permitted.
+	// +optional
+	AllowedNamespaceOverrides []string `json:"allowedNamespaceOverrides,omitempty"`
+
 	// Base64-encoded bundle of PEM CAs which will be used to validate the certificate
 	// chain presented by Vault. Only used if using HTTPS to connect to Vault and
 	// ignored for HTTP connections.
@@ -351,6 +511,11 @@ type CAIssuer struct {
 
 	// The CRL distribution points is an X.509 v3 certificate extension which identifies
 	// the location of the CRL from which the revocation of this certificate can be checked.
+	// Each entry may reference the variables ${serial}, the hex encoded serial number of
+	// the certificate being signed, and ${issuerName}, the name of this issuer resource,
+	// which are substituted for every issued certificate.
+	// Any other "$" is copied as is. Every entry must render to an absolute URL
+	// with a host, except ldap URLs which may leave the host empty.
 	// If not set, certificates will be issued without distribution points set.
 	// +optional
 	CRLDistributionPoints []string `json:"crlDistributionPoints,omitempty"`
@@ -368,8 +533,93 @@ type CAIssuer struct {
 	// As an example, such a URL might be "http://ca.domain.com/ca.crt".
 	// +optional
 	IssuingCertificateURLs []string `json:"issuingCertificateURLs,omitempty"`
+
+	// ValidateOnly, if true, puts the issuer into a dry-run mode in which the
+	// CA certificate and private key in the Secret are checked to form a valid,
+	// unexpired signing pair with the CA basic constraint and certificate
+	// signing key usage set. The result of these checks is reported on the
+	// Ready condition, but the issuer never becomes Ready and so will not
+	// issue any certificates.
+	// +optional
+	ValidateOnly bool `json:"validateOnly,omitempty"`
+
+	// CAExpiryPolicy controls how requests for certificates which would
+	// expire after the CA certificate are handled. If set to `Clamp`, the
+	// NotAfter of such certificates is capped to shortly before the expiry of
+	// the CA certificate. If set to `Reject`, such requests are rejected. If
+	// set to `Ignore`, certificates are issued with the requested duration.
+	// Defaults to `Ignore` if not specified.
+	// +optional
+	CAExpiryPolicy CAExpiryPolicy `json:"caExpiryPolicy,omitempty"`
+
+	// AllowedExtraExtensions is a list of X.509 extension OIDs, in dotted
+	// decimal notation, which are copied from the certificate signing request
+	// into the issued certificate. Extensions which cert-manager does not
+	// otherwise understand and which are not listed here are dropped.
+	// Extensions which cert-manager computes itself, such as basicConstraints,
+	// subjectAltName, nameConstraints, extKeyUsage and authorityInfoAccess,
+	// must not be listed.
+	// +optional
+	AllowedExtraExtensions []string `json:"allowedExtraExtensions,omitempty"`
+
+	// SerialNumberStrategy controls how serial numbers of issued certificates
+	// are generated. If set to `random`, serial numbers are randomly generated.
+	// If set to `sequential`, serial numbers are allocated from a counter which
+	// is persisted in a Secret named `<secretName>-serial-number` alongside the
+	// CA secret, so that all issuers sharing a CA share the same sequence.
+	// Defaults to `random` if not specified.
+	// +optional
+	SerialNumberStrategy CASerialNumberStrategy `json:"serialNumberStrategy,omitempty"`
+
+	// SignatureAlgorithm overrides the algorithm used to sign certificates
+	// issued by this issuer, for example `SHA384WithRSA` or `ECDSAWithSHA384`.
+	// It must be compatible with the type of the CA's private key. If not set,
+	// the signature algorithm is derived from the CA's private key.
+	// +optional
+	SignatureAlgorithm string `json:"signatureAlgorithm,omitempty"`
+
+	// AuthorityKeyIdentifier overrides the key identifier in the authority key
+	// identifier extension of certificates issued by this issuer, for example
+	// when cross-signing. It must be hex encoded, optionally with the bytes
+	// separated by colons. If not set, the subject key identifier of the CA
+	// certificate is used.
+	// +optional
+	AuthorityKeyIdentifier string `json:"authorityKeyIdentifier,omitempty"`
 }
 
+// CAExpiryPolicy denotes how a CA issuer handles requests for certificates
+// which would expire after the CA certificate.
+// +kubebuilder:validation:Enum=Ignore;Clamp;Reject
+type CAExpiryPolicy string
+
+var (
+	// CAExpiryPolicyIgnore means certificates are issued with the requested
+	// duration, even if they expire after the CA certificate.
+	CAExpiryPolicyIgnore CAExpiryPolicy = "Ignore"
+
+	// CAExpiryPolicyClamp means the NotAfter of certificates which would
+	// expire after the CA certificate is capped to shortly before the expiry
+	// of the CA certificate.
+	CAExpiryPolicyClamp CAExpiryPolicy = "Clamp"
+
+	// CAExpiryPolicyReject means requests for certificates which would expire
+	// after the CA certificate are rejected.
+	CAExpiryPolicyReject CAExpiryPolicy = "Reject"
+)
+
+// CASerialNumberStrategy denotes how the serial numbers of certificates issued
+// by a CA issuer are generated.
+type CASerialNumberStrategy string
+
+var (
+	// RandomSerialNumberStrategy means serial numbers are randomly generated.
+	RandomSerialNumberStrategy CASerialNumberStrategy = "random"
+
+	// SequentialSerialNumberStrategy means serial numbers are allocated from
+	// a monotonically increasing counter.
+	SequentialSerialNumberStrategy CASerialNumberStrategy = "sequential"
+)
+
 // IssuerStatus contains status information about an Issuer
 type IssuerStatus struct {
 	// List of status conditions to indicate the status of a CertificateRequest.
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"net"
 	"net/mail"
+	"slices"
 	"strings"
 	"time"
 	"unicode/utf8"
@@ -29,10 +30,12 @@ import (
 	metavalidation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	internalcmapi "github.com/cert-manager/cert-manager/internal/apis/certmanager"
 	cmmeta "github.com/cert-manager/cert-manager/internal/apis/meta"
+	"github.com/cert-manager/cert-manager/internal/fips"
 	"github.com/cert-manager/cert-manager/internal/webhook/feature"
 	"github.com/cert-manager/cert-manager/pkg/api/util"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
@@ -71,7 +74,8 @@ func ValidateCertificateSpec(crt *internalcmapi.CertificateSpec, fldPath *field.
 			len(crt.Subject.Provinces) > 0 ||
 			len(crt.Subject.StreetAddresses) > 0 ||
 			len(crt.Subject.PostalCodes) > 0 ||
-			len(crt.Subject.SerialNumber) > 0) {
+			len(crt.Subject.SerialNumber) > 0 ||
+			len(crt.Subject.OrganizationIdentifiers) > 0) {
 			el = append(el, field.Invalid(fldPath.Child("subject"), crt.Subject, "When providing a `LiteralSubject` no `Subject` properties may be provided."))
 		}
 
@@ -108,8 +112,9 @@ func ValidateCertificateSpec(crt *internalcmapi.CertificateSpec, fldPath *field.
 		len(crt.URIs) == 0 &&
 		len(crt.EmailAddresses) == 0 &&
 		len(crt.IPAddresses) == 0 &&
-		len(crt.OtherNames) == 0 {
-		el = append(el, field.Invalid(fldPath, "", "at least one of commonName (from the commonName field or from a literalSubject), dnsNames, uriSANs, ipAddresses, emailSANs or otherNames must be set"))
+		len(crt.OtherNames) == 0 &&
+		crt.ServiceRef == nil {
+		el = append(el, field.Invalid(fldPath, "", "at least one of commonName (from the commonName field or from a literalSubject), dnsNames, serviceRef, uriSANs, ipAddresses, emailSANs or otherNames must be set"))
 	}
 
 	// if a common name has been specified, ensure it is no longer than 64 chars
@@ -156,10 +161,42 @@ func ValidateCertificateSpec(crt *internalcmapi.CertificateSpec, fldPath *field.
 				el = append(el, field.NotSupported(fldPath.Child("privateKey", "size"), crt.PrivateKey.Size, []string{"256", "384", "521"}))
 			}
 		case internalcmapi.Ed25519KeyAlgorithm:
-			break
+			if fips.Build {
+				el = append(el, field.Forbidden(fldPath.Child("privateKey", "algorithm"), "the Ed25519 key algorithm is not allowed in FIPS builds of cert-manager"))
+			}
+		case internalcmapi.MLDSAKeyAlgorithm:
+			if !utilfeature.DefaultFeatureGate.Enabled(feature.ExperimentalPostQuantumKeys) {
+				el = append(el, field.Forbidden(fldPath.Child("privateKey", "algorithm"), "Feature gate ExperimentalPostQuantumKeys must be enabled on both webhook and controller to use the experimental ML-DSA key algorithm"))
+			} else if crt.PrivateKey.Size > 0 && crt.PrivateKey.Size != 44 && crt.PrivateKey.Size != 65 && crt.PrivateKey.Size != 87 {
+				el = append(el, field.NotSupported(fldPath.Child("privateKey", "size"), crt.PrivateKey.Size, []string{"44", "65", "87"}))
+			}
 		default:
-			el = append(el, field.Invalid(fldPath.Child("privateKey", "algorithm"), crt.PrivateKey.Algorithm, "must be either empty or one of rsa, ecdsa or ed25519"))
+			el = append(el, field.Invalid(fldPath.Child("privateKey", "algorithm"), crt.PrivateKey.Algorithm, "must be either empty or one of rsa, ecdsa, ed25519 or ml-dsa"))
+		}
+
+		switch {
+		case crt.PrivateKey.RotationPolicy == internalcmapi.RotationPolicyScheduled && crt.PrivateKey.RotationInterval == nil:
+			el = append(el, field.Required(fldPath.Child("privateKey", "rotationInterval"), "must be set when rotationPolicy is Scheduled"))
+		case crt.PrivateKey.RotationPolicy != internalcmapi.RotationPolicyScheduled && crt.PrivateKey.RotationInterval != nil:
+			el = append(el, field.Forbidden(fldPath.Child("privateKey", "rotationInterval"), "may only be set when rotationPolicy is Scheduled"))
+		case crt.PrivateKey.RotationInterval != nil && crt.PrivateKey.RotationInterval.Duration < cmapi.MinimumPrivateKeyRotationInterval:
+			el = append(el, field.Invalid(fldPath.Child("privateKey", "rotationInterval"), crt.PrivateKey.RotationInterval.Duration, fmt.Sprintf("private key rotation interval must be at least %s", cmapi.MinimumPrivateKeyRotationInterval)))
 		}
+
+		if ref := crt.PrivateKey.ExistingSecretRef; ref != nil {
+			if ref.Name == "" {
+				el = append(el, field.Required(fldPath.Child("privateKey", "existingSecretRef", "name"), "must be specified"))
+			} else {
+				el = append(el, validateSecretRefName(ref.Name, fldPath.Child("privateKey", "existingSecretRef", "name"))...)
+			}
+			if crt.PrivateKey.RotationPolicy != "" && crt.PrivateKey.RotationPolicy != internalcmapi.RotationPolicyNever {
+				el = append(el, field.Forbidden(fldPath.Child("privateKey", "rotationPolicy"), "must be unset or Never when existingSecretRef is set, as an existing private key is never rotated"))
+			}
+		}
+	}
+
+	if crt.Keystores != nil {
+		el = append(el, validateKeystores(crt.Keystores, fldPath.Child("keystores"))...)
 	}
 
 	if crt.Duration != nil || crt.RenewBefore != nil {
@@ -168,6 +205,21 @@ func ValidateCertificateSpec(crt *internalcmapi.CertificateSpec, fldPath *field.
 	if len(crt.Usages) > 0 {
 		el = append(el, validateUsages(crt, fldPath)...)
 	}
+	if crt.MustStaple && len(crt.Usages) > 0 && !slices.Contains(crt.Usages, internalcmapi.UsageServerAuth) {
+		el = append(el, field.Invalid(fldPath.Child("mustStaple"), crt.MustStaple, "requires the 'server auth' usage when usages are specified"))
+	}
+	if crt.IsCA && slices.Contains(crt.Usages, internalcmapi.UsageOCSPSigning) {
+		el = append(el, field.Invalid(fldPath.Child("usages"), crt.Usages, "the 'ocsp signing' usage is only allowed for delegated OCSP responder certificates, which must not be a CA"))
+	}
+	switch crt.ChainOrder {
+	case "", internalcmapi.CertificateChainOrderLeafOnly, internalcmapi.CertificateChainOrderLeafThenIntermediates, internalcmapi.CertificateChainOrderFullChainWithRoot:
+	default:
+		el = append(el, field.NotSupported(fldPath.Child("chainOrder"), crt.ChainOrder, []string{
+			string(internalcmapi.CertificateChainOrderLeafOnly),
+			string(internalcmapi.CertificateChainOrderLeafThenIntermediates),
+			string(internalcmapi.CertificateChainOrderFullChainWithRoot),
+		}))
+	}
 	if crt.RevisionHistoryLimit != nil && *crt.RevisionHistoryLimit < 1 {
 		el = append(el, field.Invalid(fldPath.Child("revisionHistoryLimit"), *crt.RevisionHistoryLimit, "must not be less than 1"))
 	}
@@ -181,6 +233,14 @@ func ValidateCertificateSpec(crt *internalcmapi.CertificateSpec, fldPath *field.
 		}
 	}
 
+	if crt.Subject != nil {
+		for i, organizationIdentifier := range crt.Subject.OrganizationIdentifiers {
+			if strings.TrimSpace(organizationIdentifier) == "" {
+				el = append(el, field.Invalid(fldPath.Child("subject", "organizationIdentifiers").Index(i), organizationIdentifier, "must not be empty"))
+			}
+		}
+	}
+
 	if crt.NameConstraints != nil {
 		if !utilfeature.DefaultFeatureGate.Enabled(feature.NameConstraints) {
 			el = append(el, field.Forbidden(fldPath.Child("nameConstraints"), "feature gate NameConstraints must be enabled"))
@@ -192,24 +252,70 @@ func ValidateCertificateSpec(crt *internalcmapi.CertificateSpec, fldPath *field.
 			if crt.NameConstraints.Permitted == nil && crt.NameConstraints.Excluded == nil {
 				el = append(el, field.Invalid(fldPath.Child("nameConstraints"), crt.NameConstraints, "either permitted or excluded must be set"))
 			}
+
+			el = append(el, validateNameConstraintItem(crt.NameConstraints.Permitted, fldPath.Child("nameConstraints", "permitted"))...)
+			el = append(el, validateNameConstraintItem(crt.NameConstraints.Excluded, fldPath.Child("nameConstraints", "excluded"))...)
 		}
 	}
 
 	el = append(el, validateAdditionalOutputFormats(crt, fldPath)...)
+	el = append(el, validateAdditionalOutputSecrets(crt, fldPath)...)
+	el = append(el, validateSecretMirrorNamespaces(crt, fldPath)...)
+	el = append(el, validateServiceRef(crt, fldPath)...)
+
+	return el
+}
+
+func validateNameConstraintItem(item *internalcmapi.NameConstraintItem, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	if item == nil {
+		return el
+	}
+
+	for i, ipRange := range item.IPRanges {
+		if _, _, err := net.ParseCIDR(ipRange); err != nil {
+			el = append(el, field.Invalid(fldPath.Child("ipRanges").Index(i), ipRange, "must be a valid CIDR"))
+		}
+	}
 
 	return el
 }
 
+// certificateSpecWarnings returns any warnings for a CertificateSpec which is
+// valid but likely to be misconfigured.
+func certificateSpecWarnings(crt *internalcmapi.CertificateSpec) []string {
+	var warnings []string
+	if crt.NameConstraints != nil && !crt.NameConstraints.Critical {
+		warnings = append(warnings, nonCriticalNameConstraints)
+	}
+	return warnings
+}
+
 func ValidateCertificate(a *admissionv1.AdmissionRequest, obj runtime.Object) (field.ErrorList, []string) {
 	crt := obj.(*internalcmapi.Certificate)
 	allErrs := ValidateCertificateSpec(&crt.Spec, field.NewPath("spec"))
-	return allErrs, nil
+	allErrs = append(allErrs, validateDuplicateSANs(&crt.Spec, field.NewPath("spec"))...)
+	return allErrs, certificateSpecWarnings(&crt.Spec)
 }
 
 func ValidateUpdateCertificate(a *admissionv1.AdmissionRequest, oldObj, obj runtime.Object) (field.ErrorList, []string) {
-	crt := obj.(*internalcmapi.Certificate)
+	oldCrt, crt := oldObj.(*internalcmapi.Certificate), obj.(*internalcmapi.Certificate)
 	allErrs := ValidateCertificateSpec(&crt.Spec, field.NewPath("spec"))
-	return allErrs, nil
+	// Duplicate SANs are only rejected if the SANs are changed, so that
+	// Certificates created before they were rejected can still be updated.
+	if sansChanged(&oldCrt.Spec, &crt.Spec) {
+		allErrs = append(allErrs, validateDuplicateSANs(&crt.Spec, field.NewPath("spec"))...)
+	}
+	return allErrs, certificateSpecWarnings(&crt.Spec)
+}
+
+// sansChanged returns true if any of the dnsNames, ipAddresses, uris or
+// emailAddresses of the Certificate have changed.
+func sansChanged(oldSpec, spec *internalcmapi.CertificateSpec) bool {
+	return !slices.Equal(oldSpec.DNSNames, spec.DNSNames) ||
+		!slices.Equal(oldSpec.IPAddresses, spec.IPAddresses) ||
+		!slices.Equal(oldSpec.URIs, spec.URIs) ||
+		!slices.Equal(oldSpec.EmailAddresses, spec.EmailAddresses)
 }
 
 func validateIssuerRef(issuerRef cmmeta.ObjectReference, fldPath *field.Path) field.ErrorList {
@@ -286,6 +392,38 @@ func validateEmailAddresses(a *internalcmapi.CertificateSpec, fldPath *field.Pat
 	return el
 }
 
+// validateDuplicateSANs returns an error for every entry of dnsNames,
+// ipAddresses, uris and emailAddresses which duplicates an earlier entry of
+// the same field. DNS names are compared case-insensitively and IP addresses
+// are compared by value.
+func validateDuplicateSANs(a *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	el = append(el, validateNoDuplicates(a.DNSNames, strings.ToLower, fldPath.Child("dnsNames"))...)
+	el = append(el, validateNoDuplicates(a.IPAddresses, func(s string) string {
+		if ip := net.ParseIP(s); ip != nil {
+			return ip.String()
+		}
+		return s
+	}, fldPath.Child("ipAddresses"))...)
+	el = append(el, validateNoDuplicates(a.URIs, func(s string) string { return s }, fldPath.Child("uris"))...)
+	el = append(el, validateNoDuplicates(a.EmailAddresses, func(s string) string { return s }, fldPath.Child("emailAddresses"))...)
+	return el
+}
+
+func validateNoDuplicates(values []string, normalize func(string) string, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	seen := sets.New[string]()
+	for i, v := range values {
+		key := normalize(v)
+		if seen.Has(key) {
+			el = append(el, field.Duplicate(fldPath.Index(i), v))
+			continue
+		}
+		seen.Insert(key)
+	}
+	return el
+}
+
 func validateUsages(a *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
 	el := field.ErrorList{}
 	for i, u := range a.Usages {
@@ -299,7 +437,31 @@ func validateUsages(a *internalcmapi.CertificateSpec, fldPath *field.Path) field
 }
 
 func validateSecretTemplateLabels(crt *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
-	return metavalidation.ValidateLabels(crt.SecretTemplate.Labels, fldPath.Child("secretTemplate", "labels"))
+	secretTemplateLabelsPath := fldPath.Child("secretTemplate", "labels")
+	if !crt.SecretTemplate.RenderTemplates {
+		return metavalidation.ValidateLabels(crt.SecretTemplate.Labels, secretTemplateLabelsPath)
+	}
+
+	// Templated label values must still be valid once rendered.
+	labels, el := renderExampleSecretTemplate(crt.SecretTemplate.Labels, secretTemplateLabelsPath)
+	return append(el, metavalidation.ValidateLabels(labels, secretTemplateLabelsPath)...)
+}
+
+// renderExampleSecretTemplate renders the given secretTemplate values with
+// example certificate data, returning the rendered values and an error for
+// each value which is not a valid template.
+func renderExampleSecretTemplate(values map[string]string, fldPath *field.Path) (map[string]string, field.ErrorList) {
+	el := field.ErrorList{}
+	rendered := make(map[string]string, len(values))
+	for _, k := range sets.List(sets.KeySet(values)) {
+		v, err := util.RenderSecretTemplateValue(values[k], util.ExampleSecretTemplateData)
+		if err != nil {
+			el = append(el, field.Invalid(fldPath.Key(k), values[k], fmt.Sprintf("invalid template: %v", err)))
+			continue
+		}
+		rendered[k] = v
+	}
+	return rendered, el
 }
 
 func validateSecretTemplateAnnotations(crt *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
@@ -312,6 +474,11 @@ func validateSecretTemplateAnnotations(crt *internalcmapi.CertificateSpec, fldPa
 		}
 	}
 
+	if crt.SecretTemplate.RenderTemplates {
+		_, templateErrs := renderExampleSecretTemplate(crt.SecretTemplate.Annotations, secretTemplateAnnotationsPath)
+		el = append(el, templateErrs...)
+	}
+
 	el = append(el, apivalidation.ValidateAnnotations(crt.SecretTemplate.Annotations, secretTemplateAnnotationsPath)...)
 	return el
 }
@@ -342,7 +509,7 @@ func ValidateDuration(crt *internalcmapi.CertificateSpec, fldPath *field.Path) f
 	// If spec.renewBeforePercentage is set, check that it's within the allowed
 	// range.
 	if crt.RenewBeforePercentage != nil {
-		renewBefore := duration * time.Duration(100-*crt.RenewBeforePercentage) / 100
+		renewBefore := duration * time.Duration(*crt.RenewBeforePercentage) / 100
 		if renewBefore < cmapi.MinimumRenewBefore {
 			el = append(el, field.Invalid(fldPath.Child("renewBeforePercentage"), *crt.RenewBeforePercentage, fmt.Sprintf("certificate renewBeforePercentage must result in a renewBefore greater than %s", cmapi.MinimumRenewBefore)))
 		}
@@ -354,6 +521,87 @@ func ValidateDuration(crt *internalcmapi.CertificateSpec, fldPath *field.Path) f
 	return el
 }
 
+// validateKeystores validates the references to the Secrets containing the
+// keystore passwords. These must be in the same namespace as the Certificate.
+func validateKeystores(keystores *internalcmapi.CertificateKeystores, fldPath *field.Path) field.ErrorList {
+	var el field.ErrorList
+
+	if jks := keystores.JKS; jks != nil {
+		el = append(el, validateKeystorePasswordSecretRef(jks.Create, jks.PasswordSecretRef, fldPath.Child("jks", "passwordSecretRef"))...)
+	}
+	if pkcs12 := keystores.PKCS12; pkcs12 != nil {
+		el = append(el, validateKeystorePasswordSecretRef(pkcs12.Create, pkcs12.PasswordSecretRef, fldPath.Child("pkcs12", "passwordSecretRef"))...)
+	}
+
+	return el
+}
+
+func validateKeystorePasswordSecretRef(create bool, ref cmmeta.SecretKeySelector, fldPath *field.Path) field.ErrorList {
+	if create && ref.Name == "" {
+		return field.ErrorList{field.Required(fldPath.Child("name"), "must be specified when the keystore is created")}
+	}
+	return validateSecretRefName(ref.Name, fldPath.Child("name"))
+}
+
+func validateAdditionalOutputSecrets(crt *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
+	var el field.ErrorList
+
+	names := sets.NewString()
+	for i, val := range crt.AdditionalOutputSecrets {
+		namePath := fldPath.Child("additionalOutputSecrets").Index(i).Child("name")
+		for _, msg := range apivalidation.NameIsDNSSubdomain(val.Name, false) {
+			el = append(el, field.Invalid(namePath, val.Name, msg))
+		}
+		if val.Name == crt.SecretName {
+			el = append(el, field.Invalid(namePath, val.Name, "must not be the same as spec.secretName"))
+		}
+		if names.Has(val.Name) {
+			el = append(el, field.Duplicate(namePath, val.Name))
+		}
+		names.Insert(val.Name)
+	}
+
+	return el
+}
+
+func validateSecretMirrorNamespaces(crt *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
+	var el field.ErrorList
+
+	namespaces := sets.New[string]()
+	for i, ns := range crt.SecretMirrorNamespaces {
+		nsPath := fldPath.Child("secretMirrorNamespaces").Index(i)
+		for _, msg := range apivalidation.ValidateNamespaceName(ns, false) {
+			el = append(el, field.Invalid(nsPath, ns, msg))
+		}
+		if namespaces.Has(ns) {
+			el = append(el, field.Duplicate(nsPath, ns))
+		}
+		namespaces.Insert(ns)
+	}
+
+	return el
+}
+
+func validateServiceRef(crt *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
+	var el field.ErrorList
+
+	if crt.ServiceRef == nil {
+		return el
+	}
+
+	refPath := fldPath.Child("serviceRef")
+	for _, msg := range validation.IsDNS1035Label(crt.ServiceRef.Name) {
+		el = append(el, field.Invalid(refPath.Child("name"), crt.ServiceRef.Name, msg))
+	}
+	if len(crt.ServiceRef.ClusterDomain) > 0 {
+		for _, msg := range validation.IsDNS1123Subdomain(crt.ServiceRef.ClusterDomain) {
+			el = append(el, field.Invalid(refPath.Child("clusterDomain"), crt.ServiceRef.ClusterDomain, msg))
+		}
+	}
+
+	return el
+}
+
 func validateAdditionalOutputFormats(crt *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
 	var el field.ErrorList
 
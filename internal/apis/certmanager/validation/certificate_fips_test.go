@@ -0,0 +1,75 @@
+//go:build boringcrypto
+
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	internalcmapi "github.com/cert-manager/cert-manager/internal/apis/certmanager"
+)
+
+func TestValidateCertificateFIPSBuild(t *testing.T) {
+	fldPath := field.NewPath("spec")
+	tests := map[string]struct {
+		privateKey *internalcmapi.CertificatePrivateKey
+		errs       []*field.Error
+	}{
+		"Ed25519 is rejected": {
+			privateKey: &internalcmapi.CertificatePrivateKey{
+				Algorithm: internalcmapi.Ed25519KeyAlgorithm,
+			},
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("privateKey", "algorithm"), "the Ed25519 key algorithm is not allowed in FIPS builds of cert-manager"),
+			},
+		},
+		"RSA below 2048 is rejected": {
+			privateKey: &internalcmapi.CertificatePrivateKey{
+				Algorithm: internalcmapi.RSAKeyAlgorithm,
+				Size:      1024,
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("privateKey", "size"), 1024, "must be between 2048 & 8192 for rsa keyAlgorithm"),
+			},
+		},
+		"ECDSA is allowed": {
+			privateKey: &internalcmapi.CertificatePrivateKey{
+				Algorithm: internalcmapi.ECDSAKeyAlgorithm,
+				Size:      256,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			crt := &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKey: test.privateKey,
+				},
+			}
+			errs, _ := ValidateCertificate(someAdmissionRequest, crt)
+			assert.ElementsMatch(t, errs, test.errs)
+		})
+	}
+}
@@ -186,7 +186,7 @@ func TestValidateCertificate(t *testing.T) {
 			},
 			a: someAdmissionRequest,
 			errs: []*field.Error{
-				field.Invalid(fldPath, "", "at least one of commonName (from the commonName field or from a literalSubject), dnsNames, uriSANs, ipAddresses, emailSANs or otherNames must be set"),
+				field.Invalid(fldPath, "", "at least one of commonName (from the commonName field or from a literalSubject), dnsNames, serviceRef, uriSANs, ipAddresses, emailSANs or otherNames must be set"),
 			},
 		},
 		"invalid with no issuerRef": {
@@ -412,7 +412,7 @@ func TestValidateCertificate(t *testing.T) {
 			},
 			a: someAdmissionRequest,
 			errs: []*field.Error{
-				field.Invalid(fldPath.Child("privateKey", "algorithm"), internalcmapi.PrivateKeyAlgorithm("blah"), "must be either empty or one of rsa, ecdsa or ed25519"),
+				field.Invalid(fldPath.Child("privateKey", "algorithm"), internalcmapi.PrivateKeyAlgorithm("blah"), "must be either empty or one of rsa, ecdsa, ed25519 or ml-dsa"),
 			},
 		},
 		"valid certificate with ipAddresses": {
@@ -527,6 +527,353 @@ func TestValidateCertificate(t *testing.T) {
 				field.Invalid(fldPath.Child("usages").Index(0), internalcmapi.KeyUsage("nonexistent"), "unknown keyusage"),
 			},
 		},
+		"invalid certificate with duplicate dnsNames": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					DNSNames:   []string{"example.com", "www.example.com", "example.com", "Example.COM"},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Duplicate(fldPath.Child("dnsNames").Index(2), "example.com"),
+				field.Duplicate(fldPath.Child("dnsNames").Index(3), "Example.COM"),
+			},
+		},
+		"invalid certificate with duplicate ipAddresses": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					SecretName:  "abc",
+					IssuerRef:   validIssuerRef,
+					IPAddresses: []string{"10.0.0.1", "2001:db8::1", "2001:0db8:0:0:0:0:0:1"},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Duplicate(fldPath.Child("ipAddresses").Index(2), "2001:0db8:0:0:0:0:0:1"),
+			},
+		},
+		"invalid certificate with duplicate uris": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					URIs:       []string{"spiffe://example.com/a", "spiffe://example.com/b", "spiffe://example.com/a"},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Duplicate(fldPath.Child("uris").Index(2), "spiffe://example.com/a"),
+			},
+		},
+		"invalid certificate with duplicate emailAddresses": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					SecretName:     "abc",
+					IssuerRef:      validIssuerRef,
+					EmailAddresses: []string{"alice@example.com", "alice@example.com"},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Duplicate(fldPath.Child("emailAddresses").Index(1), "alice@example.com"),
+			},
+		},
+		"valid certificate with mustStaple": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					MustStaple: true,
+					Usages:     []internalcmapi.KeyUsage{"digital signature", "server auth"},
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid certificate with mustStaple and without the server auth usage": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					MustStaple: true,
+					Usages:     []internalcmapi.KeyUsage{"client auth"},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("mustStaple"), true, "requires the 'server auth' usage when usages are specified"),
+			},
+		},
+		"valid certificate with the ocsp signing usage": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					Usages:     []internalcmapi.KeyUsage{"digital signature", "ocsp signing"},
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid CA certificate with the ocsp signing usage": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					IsCA:       true,
+					Usages:     []internalcmapi.KeyUsage{"cert sign", "ocsp signing"},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("usages"), []internalcmapi.KeyUsage{"cert sign", "ocsp signing"}, "the 'ocsp signing' usage is only allowed for delegated OCSP responder certificates, which must not be a CA"),
+			},
+		},
+		"valid certificate with chainOrder": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					ChainOrder: internalcmapi.CertificateChainOrderFullChainWithRoot,
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid certificate with unknown chainOrder": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					ChainOrder: "root-first",
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.NotSupported(fldPath.Child("chainOrder"), internalcmapi.CertificateChainOrder("root-first"), []string{"leaf-only", "leaf-then-intermediates", "full-chain-with-root"}),
+			},
+		},
+		"valid certificate with scheduled private key rotation": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKey: &internalcmapi.CertificatePrivateKey{
+						RotationPolicy:   internalcmapi.RotationPolicyScheduled,
+						RotationInterval: &metav1.Duration{Duration: 90 * 24 * time.Hour},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid certificate with scheduled private key rotation and no rotationInterval": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKey: &internalcmapi.CertificatePrivateKey{
+						RotationPolicy: internalcmapi.RotationPolicyScheduled,
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Required(fldPath.Child("privateKey", "rotationInterval"), "must be set when rotationPolicy is Scheduled"),
+			},
+		},
+		"invalid certificate with rotationInterval and Always private key rotation": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKey: &internalcmapi.CertificatePrivateKey{
+						RotationPolicy:   internalcmapi.RotationPolicyAlways,
+						RotationInterval: &metav1.Duration{Duration: 90 * 24 * time.Hour},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("privateKey", "rotationInterval"), "may only be set when rotationPolicy is Scheduled"),
+			},
+		},
+		"invalid certificate with a rotationInterval which is too short": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKey: &internalcmapi.CertificatePrivateKey{
+						RotationPolicy:   internalcmapi.RotationPolicyScheduled,
+						RotationInterval: &metav1.Duration{Duration: time.Minute},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("privateKey", "rotationInterval"), time.Minute, "private key rotation interval must be at least 1h0m0s"),
+			},
+		},
+		"valid certificate with the minimum rotationInterval": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKey: &internalcmapi.CertificatePrivateKey{
+						RotationPolicy:   internalcmapi.RotationPolicyScheduled,
+						RotationInterval: &metav1.Duration{Duration: time.Hour},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"valid certificate with an existing private key": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKey: &internalcmapi.CertificatePrivateKey{
+						ExistingSecretRef: &cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "existing-key"}},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid certificate with an existing private key and no secret name": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKey: &internalcmapi.CertificatePrivateKey{
+						ExistingSecretRef: &cmmeta.SecretKeySelector{Key: "key.pem"},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Required(fldPath.Child("privateKey", "existingSecretRef", "name"), "must be specified"),
+			},
+		},
+		"invalid certificate with an existing private key and Always private key rotation": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKey: &internalcmapi.CertificatePrivateKey{
+						RotationPolicy:    internalcmapi.RotationPolicyAlways,
+						ExistingSecretRef: &cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "existing-key"}},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("privateKey", "rotationPolicy"), "must be unset or Never when existingSecretRef is set, as an existing private key is never rotated"),
+			},
+		},
+		"valid certificate with keystore password secrets": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					Keystores: &internalcmapi.CertificateKeystores{
+						JKS: &internalcmapi.JKSKeystore{
+							Create:            true,
+							PasswordSecretRef: cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "jks-password"}, Key: "password"},
+						},
+						PKCS12: &internalcmapi.PKCS12Keystore{
+							Create:            true,
+							PasswordSecretRef: cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "pkcs12-password"}, Key: "password"},
+						},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid certificate with a JKS keystore password secret in another namespace": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					Keystores: &internalcmapi.CertificateKeystores{
+						JKS: &internalcmapi.JKSKeystore{
+							Create:            true,
+							PasswordSecretRef: cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "other-namespace/jks-password"}, Key: "password"},
+						},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("keystores", "jks", "passwordSecretRef", "name"), "other-namespace/jks-password", "must be the name of a Secret in the same namespace, references to Secrets in other namespaces are not supported"),
+			},
+		},
+		"invalid certificate with a PKCS12 keystore password secret which is not a valid Secret name": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					Keystores: &internalcmapi.CertificateKeystores{
+						PKCS12: &internalcmapi.PKCS12Keystore{
+							Create:            true,
+							PasswordSecretRef: cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "Invalid_Name"}, Key: "password"},
+						},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("keystores", "pkcs12", "passwordSecretRef", "name"), "Invalid_Name", "a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')"),
+			},
+		},
+		"invalid certificate with a created keystore and no password secret": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					Keystores: &internalcmapi.CertificateKeystores{
+						PKCS12: &internalcmapi.PKCS12Keystore{
+							Create: true,
+						},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Required(fldPath.Child("keystores", "pkcs12", "passwordSecretRef", "name"), "must be specified when the keystore is created"),
+			},
+		},
+		"invalid certificate with an existing private key in another namespace": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKey: &internalcmapi.CertificatePrivateKey{
+						ExistingSecretRef: &cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "other-namespace/existing-key"}},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("privateKey", "existingSecretRef", "name"), "other-namespace/existing-key", "must be the name of a Secret in the same namespace, references to Secrets in other namespaces are not supported"),
+			},
+		},
 		"valid certificate with only URI SAN name": {
 			cfg: &internalcmapi.Certificate{
 				Spec: internalcmapi.CertificateSpec{
@@ -610,71 +957,274 @@ func TestValidateCertificate(t *testing.T) {
 			},
 			a: someAdmissionRequest,
 			errs: []*field.Error{
-				field.Invalid(fldPath.Child("revisionHistoryLimit"), int32(0), "must not be less than 1"),
+				field.Invalid(fldPath.Child("revisionHistoryLimit"), int32(0), "must not be less than 1"),
+			},
+		},
+		"valid with empty secretTemplate": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					SecretTemplate: &internalcmapi.CertificateSecretTemplate{
+						Annotations: map[string]string{},
+						Labels:      map[string]string{},
+					},
+					IssuerRef: validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"valid with 'CertificateSecretTemplate' labels and annotations": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					SecretTemplate: &internalcmapi.CertificateSecretTemplate{
+						Annotations: map[string]string{
+							"my-annotation.com/foo": "app=bar",
+						},
+						Labels: map[string]string{
+							"my-label.com/foo": "evn-production",
+						},
+					},
+					IssuerRef: validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid with disallowed 'CertificateSecretTemplate' annotations": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					SecretTemplate: &internalcmapi.CertificateSecretTemplate{
+						Annotations: map[string]string{
+							"app.com/valid":                          "valid",
+							"cert-manager.io/alt-names":              "example.com",
+							"cert-manager.io/certificate-name":       "selfsigned-cert",
+							"cert-manager.io/allow-direct-injection": "true",
+						},
+					},
+					IssuerRef: validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("secretTemplate", "annotations"), "cert-manager.io/alt-names", "cert-manager.io/* annotations are not allowed"),
+				field.Invalid(fldPath.Child("secretTemplate", "annotations"), "cert-manager.io/certificate-name", "cert-manager.io/* annotations are not allowed"),
+			},
+		},
+		"invalid due to too long 'CertificateSecretTemplate' annotations": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					SecretTemplate: &internalcmapi.CertificateSecretTemplate{
+						Annotations: map[string]string{
+							"app.com/invalid": strings.Repeat("0", maxSecretTemplateAnnotationsBytesLimit),
+						},
+					},
+					IssuerRef: validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.TooLong(fldPath.Child("secretTemplate", "annotations"), "", maxSecretTemplateAnnotationsBytesLimit),
+			},
+		},
+		"invalid due to not allowed 'CertificateSecretTemplate' labels": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					SecretTemplate: &internalcmapi.CertificateSecretTemplate{
+						Labels: map[string]string{
+							"app.com/invalid-chars": "invalid=chars",
+						},
+					},
+					IssuerRef: validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(
+					fldPath.Child("secretTemplate", "labels"),
+					"invalid=chars", "a valid label must be an empty string or consist of alphanumeric characters, '-', '_' or '.', and must start and end with an "+
+						"alphanumeric character (e.g. 'MyValue',  or 'my_value',  or '12345', regex used for validation is '(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])?')"),
+			},
+		},
+		"valid with templated 'CertificateSecretTemplate' labels and annotations": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					SecretTemplate: &internalcmapi.CertificateSecretTemplate{
+						Annotations: map[string]string{
+							"app.com/expiry": "{{ rfc3339 .NotAfter }}",
+							"app.com/issuer": "{{ .Issuer }}",
+						},
+						Labels: map[string]string{
+							"app.com/serial": "{{ .SerialNumber }}",
+						},
+						RenderTemplates: true,
+					},
+					IssuerRef: validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"valid with literal template-like 'CertificateSecretTemplate' annotations when RenderTemplates is not set": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					SecretTemplate: &internalcmapi.CertificateSecretTemplate{
+						Annotations: map[string]string{
+							"app.com/helm-values": "{{ .Unknown",
+						},
+					},
+					IssuerRef: validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid due to bad 'CertificateSecretTemplate' annotation templates": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					SecretTemplate: &internalcmapi.CertificateSecretTemplate{
+						Annotations: map[string]string{
+							"app.com/unclosed": "{{ .NotAfter",
+							"app.com/unknown":  "{{ .Unknown }}",
+						},
+						RenderTemplates: true,
+					},
+					IssuerRef: validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("secretTemplate", "annotations").Key("app.com/unclosed"), "{{ .NotAfter",
+					"invalid template: template: :1: unclosed action"),
+				field.Invalid(fldPath.Child("secretTemplate", "annotations").Key("app.com/unknown"), "{{ .Unknown }}",
+					"invalid template: template: :1:3: executing \"\" at <.Unknown>: can't evaluate field Unknown in type util.SecretTemplateData"),
+			},
+		},
+		"invalid due to templated 'CertificateSecretTemplate' label rendering to an invalid label value": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					SecretTemplate: &internalcmapi.CertificateSecretTemplate{
+						Labels: map[string]string{
+							"app.com/expiry": "{{ rfc3339 .NotAfter }}",
+						},
+						RenderTemplates: true,
+					},
+					IssuerRef: validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(
+					fldPath.Child("secretTemplate", "labels"),
+					"2000-04-01T00:00:00Z", "a valid label must be an empty string or consist of alphanumeric characters, '-', '_' or '.', and must start and end with an "+
+						"alphanumeric character (e.g. 'MyValue',  or 'my_value',  or '12345', regex used for validation is '(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])?')"),
 			},
 		},
-		"valid with empty secretTemplate": {
+		"invalid due to templated 'CertificateSecretTemplate' label containing the issuer": {
 			cfg: &internalcmapi.Certificate{
 				Spec: internalcmapi.CertificateSpec{
 					CommonName: "testcn",
 					SecretName: "abc",
 					SecretTemplate: &internalcmapi.CertificateSecretTemplate{
-						Annotations: map[string]string{},
-						Labels:      map[string]string{},
+						Labels: map[string]string{
+							"app.com/issuer": "{{ .Issuer }}",
+						},
+						RenderTemplates: true,
 					},
 					IssuerRef: validIssuerRef,
 				},
 			},
 			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(
+					fldPath.Child("secretTemplate", "labels"),
+					"CN=example-issuer", "a valid label must be an empty string or consist of alphanumeric characters, '-', '_' or '.', and must start and end with an "+
+						"alphanumeric character (e.g. 'MyValue',  or 'my_value',  or '12345', regex used for validation is '(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])?')"),
+			},
 		},
-		"valid with 'CertificateSecretTemplate' labels and annotations": {
+		"valid with templated 'CertificateSecretTemplate' label replacing characters of the issuer": {
 			cfg: &internalcmapi.Certificate{
 				Spec: internalcmapi.CertificateSpec{
 					CommonName: "testcn",
 					SecretName: "abc",
 					SecretTemplate: &internalcmapi.CertificateSecretTemplate{
-						Annotations: map[string]string{
-							"my-annotation.com/foo": "app=bar",
-						},
 						Labels: map[string]string{
-							"my-label.com/foo": "evn-production",
+							"app.com/issuer": `{{ replace "=" "-" .Issuer }}`,
 						},
+						RenderTemplates: true,
 					},
 					IssuerRef: validIssuerRef,
 				},
 			},
 			a: someAdmissionRequest,
 		},
-		"invalid with disallowed 'CertificateSecretTemplate' annotations": {
+		"valid with name constraints": {
 			cfg: &internalcmapi.Certificate{
 				Spec: internalcmapi.CertificateSpec{
 					CommonName: "testcn",
 					SecretName: "abc",
-					SecretTemplate: &internalcmapi.CertificateSecretTemplate{
-						Annotations: map[string]string{
-							"app.com/valid":                          "valid",
-							"cert-manager.io/alt-names":              "example.com",
-							"cert-manager.io/certificate-name":       "selfsigned-cert",
-							"cert-manager.io/allow-direct-injection": "true",
+					IsCA:       true,
+					NameConstraints: &internalcmapi.NameConstraints{
+						Permitted: &internalcmapi.NameConstraintItem{
+							DNSDomains: []string{"example.com"},
 						},
 					},
 					IssuerRef: validIssuerRef,
 				},
 			},
-			a: someAdmissionRequest,
-			errs: []*field.Error{
-				field.Invalid(fldPath.Child("secretTemplate", "annotations"), "cert-manager.io/alt-names", "cert-manager.io/* annotations are not allowed"),
-				field.Invalid(fldPath.Child("secretTemplate", "annotations"), "cert-manager.io/certificate-name", "cert-manager.io/* annotations are not allowed"),
+			a:                             someAdmissionRequest,
+			warnings:                      []string{nonCriticalNameConstraints},
+			nameConstraintsFeatureEnabled: true,
+		},
+		"valid with critical name constraints": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IsCA:       true,
+					NameConstraints: &internalcmapi.NameConstraints{
+						Critical: true,
+						Permitted: &internalcmapi.NameConstraintItem{
+							DNSDomains: []string{"example.com"},
+							IPRanges:   []string{"10.0.0.0/8"},
+						},
+						Excluded: &internalcmapi.NameConstraintItem{
+							IPRanges:   []string{"10.10.0.0/16"},
+							URIDomains: []string{"excluded.example.com"},
+						},
+					},
+					IssuerRef: validIssuerRef,
+				},
 			},
+			a:                             someAdmissionRequest,
+			nameConstraintsFeatureEnabled: true,
 		},
-		"invalid due to too long 'CertificateSecretTemplate' annotations": {
+		"invalid name constraints IP ranges": {
 			cfg: &internalcmapi.Certificate{
 				Spec: internalcmapi.CertificateSpec{
 					CommonName: "testcn",
 					SecretName: "abc",
-					SecretTemplate: &internalcmapi.CertificateSecretTemplate{
-						Annotations: map[string]string{
-							"app.com/invalid": strings.Repeat("0", maxSecretTemplateAnnotationsBytesLimit),
+					IsCA:       true,
+					NameConstraints: &internalcmapi.NameConstraints{
+						Critical: true,
+						Permitted: &internalcmapi.NameConstraintItem{
+							IPRanges: []string{"10.0.0.0/8", "10.0.0.1"},
+						},
+						Excluded: &internalcmapi.NameConstraintItem{
+							IPRanges: []string{"not-a-cidr"},
 						},
 					},
 					IssuerRef: validIssuerRef,
@@ -682,46 +1232,40 @@ func TestValidateCertificate(t *testing.T) {
 			},
 			a: someAdmissionRequest,
 			errs: []*field.Error{
-				field.TooLong(fldPath.Child("secretTemplate", "annotations"), "", maxSecretTemplateAnnotationsBytesLimit),
+				field.Invalid(fldPath.Child("nameConstraints", "permitted", "ipRanges").Index(1), "10.0.0.1", "must be a valid CIDR"),
+				field.Invalid(fldPath.Child("nameConstraints", "excluded", "ipRanges").Index(0), "not-a-cidr", "must be a valid CIDR"),
 			},
+			nameConstraintsFeatureEnabled: true,
 		},
-		"invalid due to not allowed 'CertificateSecretTemplate' labels": {
+		"valid with organizationIdentifiers": {
 			cfg: &internalcmapi.Certificate{
 				Spec: internalcmapi.CertificateSpec{
 					CommonName: "testcn",
 					SecretName: "abc",
-					SecretTemplate: &internalcmapi.CertificateSecretTemplate{
-						Labels: map[string]string{
-							"app.com/invalid-chars": "invalid=chars",
-						},
+					Subject: &internalcmapi.X509Subject{
+						OrganizationIdentifiers: []string{"VATGB-123456789"},
+						SerialNumber:            "42",
 					},
 					IssuerRef: validIssuerRef,
 				},
 			},
 			a: someAdmissionRequest,
-			errs: []*field.Error{
-				field.Invalid(
-					fldPath.Child("secretTemplate", "labels"),
-					"invalid=chars", "a valid label must be an empty string or consist of alphanumeric characters, '-', '_' or '.', and must start and end with an "+
-						"alphanumeric character (e.g. 'MyValue',  or 'my_value',  or '12345', regex used for validation is '(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])?')"),
-			},
 		},
-		"valid with name constraints": {
+		"invalid with an empty organizationIdentifier": {
 			cfg: &internalcmapi.Certificate{
 				Spec: internalcmapi.CertificateSpec{
 					CommonName: "testcn",
 					SecretName: "abc",
-					IsCA:       true,
-					NameConstraints: &internalcmapi.NameConstraints{
-						Permitted: &internalcmapi.NameConstraintItem{
-							DNSDomains: []string{"example.com"},
-						},
+					Subject: &internalcmapi.X509Subject{
+						OrganizationIdentifiers: []string{"VATGB-123456789", " "},
 					},
 					IssuerRef: validIssuerRef,
 				},
 			},
-			a:                             someAdmissionRequest,
-			nameConstraintsFeatureEnabled: true,
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("subject", "organizationIdentifiers").Index(1), " ", "must not be empty"),
+			},
 		},
 		"invalid with name constraints": {
 			cfg: &internalcmapi.Certificate{
@@ -738,6 +1282,7 @@ func TestValidateCertificate(t *testing.T) {
 				field.Invalid(
 					fldPath.Child("nameConstraints"), &internalcmapi.NameConstraints{}, "either permitted or excluded must be set"),
 			},
+			warnings:                      []string{nonCriticalNameConstraints},
 			nameConstraintsFeatureEnabled: true,
 		},
 		"valid name constraints with feature gate disabled": {
@@ -759,6 +1304,7 @@ func TestValidateCertificate(t *testing.T) {
 				field.Forbidden(
 					fldPath.Child("nameConstraints"), "feature gate NameConstraints must be enabled"),
 			},
+			warnings: []string{nonCriticalNameConstraints},
 		},
 	}
 	for n, s := range scenarios {
@@ -771,6 +1317,62 @@ func TestValidateCertificate(t *testing.T) {
 	}
 }
 
+func TestValidateUpdateCertificate(t *testing.T) {
+	fldPath := field.NewPath("spec")
+
+	withDuplicateDNSNames := &internalcmapi.Certificate{
+		Spec: internalcmapi.CertificateSpec{
+			SecretName: "abc",
+			IssuerRef:  validIssuerRef,
+			DNSNames:   []string{"example.com", "example.com"},
+		},
+	}
+
+	scenarios := map[string]struct {
+		old, new *internalcmapi.Certificate
+		errs     []*field.Error
+	}{
+		"allow updating a certificate with unchanged duplicate SANs": {
+			old: withDuplicateDNSNames,
+			new: func() *internalcmapi.Certificate {
+				crt := withDuplicateDNSNames.DeepCopy()
+				crt.Spec.SecretName = "def"
+				return crt
+			}(),
+		},
+		"reject changing the SANs of a certificate to contain duplicates": {
+			old: withDuplicateDNSNames,
+			new: func() *internalcmapi.Certificate {
+				crt := withDuplicateDNSNames.DeepCopy()
+				crt.Spec.DNSNames = append(crt.Spec.DNSNames, "www.example.com")
+				return crt
+			}(),
+			errs: []*field.Error{
+				field.Duplicate(fldPath.Child("dnsNames").Index(1), "example.com"),
+			},
+		},
+		"reject adding duplicate SANs to a certificate": {
+			old: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					DNSNames:   []string{"example.com"},
+				},
+			},
+			new: withDuplicateDNSNames,
+			errs: []*field.Error{
+				field.Duplicate(fldPath.Child("dnsNames").Index(1), "example.com"),
+			},
+		},
+	}
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			errs, _ := ValidateUpdateCertificate(someAdmissionRequest, s.old, s.new)
+			assert.ElementsMatch(t, errs, s.errs)
+		})
+	}
+}
+
 func TestValidateDuration(t *testing.T) {
 	usefulDurations := map[string]*metav1.Duration{
 		"one second":  {Duration: time.Second},
@@ -901,24 +1503,36 @@ func TestValidateDuration(t *testing.T) {
 		"renewBeforePercentage is equal to duration": {
 			cfg: &internalcmapi.Certificate{
 				Spec: internalcmapi.CertificateSpec{
-					RenewBeforePercentage: ptr.To(int32(0)),
+					RenewBeforePercentage: ptr.To(int32(100)),
 					CommonName:            "testcn",
 					SecretName:            "abc",
 					IssuerRef:             validIssuerRef,
 				},
 			},
-			errs: []*field.Error{field.Invalid(fldPath.Child("renewBeforePercentage"), int32(0), "certificate renewBeforePercentage must result in a renewBefore less than duration")},
+			errs: []*field.Error{field.Invalid(fldPath.Child("renewBeforePercentage"), int32(100), "certificate renewBeforePercentage must result in a renewBefore less than duration")},
 		},
 		"renewBeforePercentage results in less than the minimum permitted value": {
 			cfg: &internalcmapi.Certificate{
 				Spec: internalcmapi.CertificateSpec{
-					RenewBeforePercentage: ptr.To(int32(100)),
+					RenewBeforePercentage: ptr.To(int32(0)),
+					CommonName:            "testcn",
+					SecretName:            "abc",
+					IssuerRef:             validIssuerRef,
+				},
+			},
+			errs: []*field.Error{field.Invalid(fldPath.Child("renewBeforePercentage"), int32(0), fmt.Sprintf("certificate renewBeforePercentage must result in a renewBefore greater than %s", cmapi.MinimumRenewBefore))},
+		},
+		"renewBeforePercentage results in less than the minimum permitted value for the duration": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					Duration:              usefulDurations["one hour"],
+					RenewBeforePercentage: ptr.To(int32(5)),
 					CommonName:            "testcn",
 					SecretName:            "abc",
 					IssuerRef:             validIssuerRef,
 				},
 			},
-			errs: []*field.Error{field.Invalid(fldPath.Child("renewBeforePercentage"), int32(100), fmt.Sprintf("certificate renewBeforePercentage must result in a renewBefore greater than %s", cmapi.MinimumRenewBefore))},
+			errs: []*field.Error{field.Invalid(fldPath.Child("renewBeforePercentage"), int32(5), fmt.Sprintf("certificate renewBeforePercentage must result in a renewBefore greater than %s", cmapi.MinimumRenewBefore))},
 		},
 		"duration is less than the minimum permitted value": {
 			cfg: &internalcmapi.Certificate{
@@ -942,6 +1556,142 @@ func TestValidateDuration(t *testing.T) {
 	}
 }
 
+func Test_validateAdditionalOutputSecrets(t *testing.T) {
+	fldPath := field.NewPath("spec", "additionalOutputSecrets")
+	tests := map[string]struct {
+		spec   *internalcmapi.CertificateSpec
+		expErr field.ErrorList
+	}{
+		"if no additional output secrets are defined, expect no error": {
+			spec:   &internalcmapi.CertificateSpec{SecretName: "primary"},
+			expErr: nil,
+		},
+		"if multiple unique additional output secrets are defined, expect no error": {
+			spec: &internalcmapi.CertificateSpec{
+				SecretName: "primary",
+				AdditionalOutputSecrets: []internalcmapi.CertificateAdditionalOutputSecret{
+					{Name: "foo"},
+					{Name: "bar"},
+				},
+			},
+			expErr: nil,
+		},
+		"if an additional output secret has an invalid name, expect error": {
+			spec: &internalcmapi.CertificateSpec{
+				SecretName: "primary",
+				AdditionalOutputSecrets: []internalcmapi.CertificateAdditionalOutputSecret{
+					{Name: "Foo_Bar"},
+				},
+			},
+			expErr: field.ErrorList{
+				field.Invalid(fldPath.Index(0).Child("name"), "Foo_Bar", "a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')"),
+			},
+		},
+		"if an additional output secret is the same as spec.secretName, expect error": {
+			spec: &internalcmapi.CertificateSpec{
+				SecretName: "primary",
+				AdditionalOutputSecrets: []internalcmapi.CertificateAdditionalOutputSecret{
+					{Name: "foo"},
+					{Name: "primary"},
+				},
+			},
+			expErr: field.ErrorList{
+				field.Invalid(fldPath.Index(1).Child("name"), "primary", "must not be the same as spec.secretName"),
+			},
+		},
+		"if an additional output secret is listed twice, expect error": {
+			spec: &internalcmapi.CertificateSpec{
+				SecretName: "primary",
+				AdditionalOutputSecrets: []internalcmapi.CertificateAdditionalOutputSecret{
+					{Name: "foo"},
+					{Name: "bar"},
+					{Name: "foo"},
+				},
+			},
+			expErr: field.ErrorList{
+				field.Duplicate(fldPath.Index(2).Child("name"), "foo"),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotErr := validateAdditionalOutputSecrets(test.spec, field.NewPath("spec"))
+			assert.ElementsMatch(t, test.expErr, gotErr)
+		})
+	}
+}
+
+func Test_validateSecretMirrorNamespaces(t *testing.T) {
+	fldPath := field.NewPath("spec", "secretMirrorNamespaces")
+	tests := map[string]struct {
+		spec   *internalcmapi.CertificateSpec
+		expErr field.ErrorList
+	}{
+		"if no secret mirror namespaces are defined, expect no error": {
+			spec:   &internalcmapi.CertificateSpec{},
+			expErr: nil,
+		},
+		"if multiple unique secret mirror namespaces are defined, expect no error": {
+			spec:   &internalcmapi.CertificateSpec{SecretMirrorNamespaces: []string{"foo", "bar"}},
+			expErr: nil,
+		},
+		"if a secret mirror namespace has an invalid name, expect error": {
+			spec: &internalcmapi.CertificateSpec{SecretMirrorNamespaces: []string{"foo.bar"}},
+			expErr: field.ErrorList{
+				field.Invalid(fldPath.Index(0), "foo.bar", "must not contain dots"),
+			},
+		},
+		"if a secret mirror namespace is listed twice, expect error": {
+			spec: &internalcmapi.CertificateSpec{SecretMirrorNamespaces: []string{"foo", "bar", "foo"}},
+			expErr: field.ErrorList{
+				field.Duplicate(fldPath.Index(2), "foo"),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotErr := validateSecretMirrorNamespaces(test.spec, field.NewPath("spec"))
+			assert.ElementsMatch(t, test.expErr, gotErr)
+		})
+	}
+}
+
+func Test_validateServiceRef(t *testing.T) {
+	fldPath := field.NewPath("spec", "serviceRef")
+	tests := map[string]struct {
+		spec      *internalcmapi.CertificateSpec
+		expFields []string
+	}{
+		"if no service reference is defined, expect no error": {
+			spec: &internalcmapi.CertificateSpec{},
+		},
+		"if a valid service reference is defined, expect no error": {
+			spec: &internalcmapi.CertificateSpec{ServiceRef: &internalcmapi.CertificateServiceReference{Name: "web", ClusterDomain: "example.internal"}},
+		},
+		"if the service name is invalid, expect error": {
+			spec:      &internalcmapi.CertificateSpec{ServiceRef: &internalcmapi.CertificateServiceReference{Name: "web.example"}},
+			expFields: []string{fldPath.Child("name").String()},
+		},
+		"if the cluster domain is invalid, expect error": {
+			spec:      &internalcmapi.CertificateSpec{ServiceRef: &internalcmapi.CertificateServiceReference{Name: "web", ClusterDomain: "Example_Internal"}},
+			expFields: []string{fldPath.Child("clusterDomain").String()},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var gotFields []string
+			for _, err := range validateServiceRef(test.spec, field.NewPath("spec")) {
+				assert.Equal(t, field.ErrorTypeInvalid, err.Type)
+				gotFields = append(gotFields, err.Field)
+			}
+			assert.Equal(t, test.expFields, gotFields)
+		})
+	}
+}
+
 func Test_validateAdditionalOutputFormats(t *testing.T) {
 	tests := map[string]struct {
 		featureEnabled bool
@@ -1114,6 +1864,34 @@ func Test_validateLiteralSubject(t *testing.T) {
 			},
 			a: someAdmissionRequest,
 		},
+		"valid with a `literalSubject` containing organizationIdentifier and serialNumber": {
+			featureEnabled: true,
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					LiteralSubject: "CN=testcn,ORGANIZATIONIDENTIFIER=VATGB-123456789,SERIALNUMBER=42",
+					SecretName:     "abc",
+					IssuerRef:      validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid with a `literalSubject` and `Subject.OrganizationIdentifiers`": {
+			featureEnabled: true,
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					Subject:        &internalcmapi.X509Subject{OrganizationIdentifiers: []string{"VATGB-123456789"}},
+					LiteralSubject: "CN=testcn",
+					SecretName:     "abc",
+					IssuerRef:      validIssuerRef,
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(
+					fldPath.Child("subject"),
+					&internalcmapi.X509Subject{OrganizationIdentifiers: []string{"VATGB-123456789"}}, "When providing a `LiteralSubject` no `Subject` properties may be provided."),
+			},
+			a: someAdmissionRequest,
+		},
 		"invalid with a `literalSubject` without CN and no dnsNames, ipAddresses, or emailAddress": {
 			featureEnabled: true,
 			cfg: &internalcmapi.Certificate{
@@ -1125,7 +1903,7 @@ func Test_validateLiteralSubject(t *testing.T) {
 			},
 			a: someAdmissionRequest,
 			errs: []*field.Error{
-				field.Invalid(fldPath, "", "at least one of commonName (from the commonName field or from a literalSubject), dnsNames, uriSANs, ipAddresses, emailSANs or otherNames must be set"),
+				field.Invalid(fldPath, "", "at least one of commonName (from the commonName field or from a literalSubject), dnsNames, serviceRef, uriSANs, ipAddresses, emailSANs or otherNames must be set"),
 			},
 		},
 		"invalid with a `literalSubject` and any `Subject` other than serialNumber": {
@@ -1189,3 +1967,53 @@ func Test_validateLiteralSubject(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateMLDSAPrivateKey(t *testing.T) {
+	fldPath := field.NewPath("spec")
+	tests := map[string]struct {
+		featureEnabled bool
+		size           int
+		errs           []*field.Error
+	}{
+		"featureGate should be enabled to use the ML-DSA key algorithm": {
+			featureEnabled: false,
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("privateKey", "algorithm"), "Feature gate ExperimentalPostQuantumKeys must be enabled on both webhook and controller to use the experimental ML-DSA key algorithm"),
+			},
+		},
+		"valid with the default size": {
+			featureEnabled: true,
+		},
+		"valid with size 87": {
+			featureEnabled: true,
+			size:           87,
+		},
+		"invalid with an unsupported size": {
+			featureEnabled: true,
+			size:           256,
+			errs: []*field.Error{
+				field.NotSupported(fldPath.Child("privateKey", "size"), 256, []string{"44", "65", "87"}),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultMutableFeatureGate, feature.ExperimentalPostQuantumKeys, test.featureEnabled)()
+			crt := &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKey: &internalcmapi.CertificatePrivateKey{
+						Algorithm: internalcmapi.MLDSAKeyAlgorithm,
+						Size:      test.size,
+					},
+				},
+			}
+			errs, warnings := ValidateCertificate(someAdmissionRequest, crt)
+			assert.ElementsMatch(t, errs, test.errs)
+			assert.ElementsMatch(t, warnings, []string{})
+		})
+	}
+}
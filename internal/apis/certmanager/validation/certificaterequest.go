@@ -17,8 +17,10 @@ limitations under the License.
 package validation
 
 import (
+	"crypto/x509"
 	"fmt"
 	"reflect"
+	"slices"
 	"strings"
 
 	admissionv1 "k8s.io/api/admission/v1"
@@ -107,7 +109,7 @@ func validateCertificateRequestSpecRequest(crSpec *cmapi.CertificateRequestSpec,
 		return el
 	}
 
-	_, err = pki.CertificateTemplateFromCSRPEM(
+	template, err := pki.CertificateTemplateFromCSRPEM(
 		crSpec.Request,
 		pki.CertificateTemplateValidateAndOverrideBasicConstraints(crSpec.IsCA, nil),
 		pki.CertificateTemplateValidateAndOverrideKeyUsages(keyUsage, extKeyUsage),
@@ -117,6 +119,10 @@ func validateCertificateRequestSpecRequest(crSpec *cmapi.CertificateRequestSpec,
 		return el
 	}
 
+	if crSpec.IsCA && slices.Contains(template.ExtKeyUsage, x509.ExtKeyUsageOCSPSigning) {
+		el = append(el, field.Invalid(fldPath.Child("usages"), crSpec.Usages, "the 'ocsp signing' usage is only allowed for delegated OCSP responder certificates, which must not be a CA"))
+	}
+
 	return el
 }
 
@@ -17,6 +17,10 @@ limitations under the License.
 package validation
 
 import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/asn1"
@@ -517,6 +521,41 @@ func TestValidateCertificateRequest(t *testing.T) {
 			a:     someAdmissionRequest,
 			wantE: []*field.Error{},
 		},
+		"Test csr with a P-521 ecdsa key": {
+			cr: &cminternal.CertificateRequest{
+				Spec: cminternal.CertificateRequestSpec{
+					Request:   mustGenerateCSRWithSigner(t, mustGenerateECKey(t, elliptic.P521()), gen.SetCSRDNSNames("example.com")),
+					IssuerRef: validIssuerRef,
+				},
+			},
+			a:     someAdmissionRequest,
+			wantE: []*field.Error{},
+		},
+		"Test csr with the ocsp signing usage": {
+			cr: &cminternal.CertificateRequest{
+				Spec: cminternal.CertificateRequestSpec{
+					Request:   mustGenerateCSR(t, gen.Certificate("test", gen.SetCertificateDNSNames("example.com"), gen.SetCertificateKeyUsages(cmapi.UsageDigitalSignature, cmapi.UsageOCSPSigning))),
+					IssuerRef: validIssuerRef,
+					Usages:    []cminternal.KeyUsage{cminternal.UsageDigitalSignature, cminternal.UsageOCSPSigning},
+				},
+			},
+			a:     someAdmissionRequest,
+			wantE: []*field.Error{},
+		},
+		"Test csr that is CA with the ocsp signing usage": {
+			cr: &cminternal.CertificateRequest{
+				Spec: cminternal.CertificateRequestSpec{
+					Request:   mustGenerateCSR(t, gen.Certificate("test", gen.SetCertificateDNSNames("example.com"), gen.SetCertificateKeyUsages(cmapi.UsageDigitalSignature, cmapi.UsageCertSign, cmapi.UsageOCSPSigning), gen.SetCertificateIsCA(true))),
+					IssuerRef: validIssuerRef,
+					IsCA:      true,
+					Usages:    []cminternal.KeyUsage{cminternal.UsageDigitalSignature, cminternal.UsageCertSign, cminternal.UsageOCSPSigning},
+				},
+			},
+			a: someAdmissionRequest,
+			wantE: []*field.Error{
+				field.Invalid(fldPath.Child("usages"), nil, "the 'ocsp signing' usage is only allowed for delegated OCSP responder certificates, which must not be a CA"),
+			},
+		},
 		"Test csr that is CA with usages set": {
 			cr: &cminternal.CertificateRequest{
 				Spec: cminternal.CertificateRequestSpec{
@@ -885,3 +924,19 @@ func mustGenerateCSR(t *testing.T, crt *cmapi.Certificate, modifiers ...gen.CSRM
 	}
 	return csrPEM
 }
+
+func mustGenerateCSRWithSigner(t *testing.T, sk crypto.Signer, modifiers ...gen.CSRModifier) []byte {
+	csrPEM, err := gen.CSRWithSigner(sk, modifiers...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return csrPEM
+}
+
+func mustGenerateECKey(t *testing.T, curve elliptic.Curve) crypto.Signer {
+	sk, err := ecdsa.GenerateKey(curve, rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sk
+}
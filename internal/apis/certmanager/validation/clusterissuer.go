@@ -29,11 +29,14 @@ import (
 func ValidateClusterIssuer(a *admissionv1.AdmissionRequest, obj runtime.Object) (field.ErrorList, []string) {
 	iss := obj.(*cmapi.ClusterIssuer)
 	allErrs, warnings := ValidateIssuerSpec(&iss.Spec, field.NewPath("spec"))
+	allErrs = append(allErrs, validateIssuerSpecChanges(nil, &iss.Spec, field.NewPath("spec"))...)
 	return allErrs, warnings
 }
 
 func ValidateUpdateClusterIssuer(a *admissionv1.AdmissionRequest, oldObj, obj runtime.Object) (field.ErrorList, []string) {
 	iss := obj.(*cmapi.ClusterIssuer)
+	oldIss := oldObj.(*cmapi.ClusterIssuer)
 	allErrs, warnings := ValidateIssuerSpec(&iss.Spec, field.NewPath("spec"))
+	allErrs = append(allErrs, validateIssuerSpecChanges(&oldIss.Spec, &iss.Spec, field.NewPath("spec"))...)
 	return allErrs, warnings
 }
@@ -19,18 +19,29 @@ package validation
 import (
 	"crypto/x509"
 	"fmt"
+	"net/url"
+	"slices"
 	"strings"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	corev1 "k8s.io/api/core/v1"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metavalidation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	cmacme "github.com/cert-manager/cert-manager/internal/apis/acme"
 	"github.com/cert-manager/cert-manager/internal/apis/certmanager"
 	"github.com/cert-manager/cert-manager/internal/apis/certmanager/validation/util"
 	cmmeta "github.com/cert-manager/cert-manager/internal/apis/meta"
+	"github.com/cert-manager/cert-manager/internal/webhook/feature"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/controller/globals"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
 )
 
 // Validation functions for cert-manager Issuer types.
@@ -38,18 +49,129 @@ import (
 func ValidateIssuer(a *admissionv1.AdmissionRequest, obj runtime.Object) (field.ErrorList, []string) {
 	iss := obj.(*certmanager.Issuer)
 	allErrs, warnings := ValidateIssuerSpec(&iss.Spec, field.NewPath("spec"))
+	allErrs = append(allErrs, validateNamespacedIssuerConfig(&iss.Spec.IssuerConfig, field.NewPath("spec"))...)
+	allErrs = append(allErrs, validateIssuerSpecChanges(nil, &iss.Spec, field.NewPath("spec"))...)
 	return allErrs, warnings
 }
 
 func ValidateUpdateIssuer(a *admissionv1.AdmissionRequest, oldObj, obj runtime.Object) (field.ErrorList, []string) {
 	iss := obj.(*certmanager.Issuer)
+	oldIss := oldObj.(*certmanager.Issuer)
 	allErrs, warnings := ValidateIssuerSpec(&iss.Spec, field.NewPath("spec"))
+	allErrs = append(allErrs, validateNamespacedIssuerConfig(&iss.Spec.IssuerConfig, field.NewPath("spec"))...)
+	allErrs = append(allErrs, validateIssuerSpecChanges(&oldIss.Spec, &iss.Spec, field.NewPath("spec"))...)
 	// Admission request should never be nil
 	return allErrs, warnings
 }
 
+// validateNamespacedIssuerConfig rejects issuer types which are only
+// supported by ClusterIssuers.
+func validateNamespacedIssuerConfig(iss *certmanager.IssuerConfig, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	if iss.KubernetesCSR != nil {
+		// Any user able to create Issuers in their namespace would otherwise
+		// be able to have the controller request certificates from any
+		// signer in the cluster.
+		el = append(el, field.Forbidden(fldPath.Child("kubernetesCSR"), "kubernetesCSR is only supported by ClusterIssuers"))
+	}
+	return el
+}
+
+// validateIssuerSpecChanges runs the checks which were added after the fields
+// they validate were introduced. Existing issuers may hold values which these
+// checks reject, so they are only run when an issuer is created (oldSpec is
+// nil) or when the validated field is changed, so that such issuers can still
+// be updated.
+func validateIssuerSpecChanges(oldSpec, spec *certmanager.IssuerSpec, fldPath *field.Path) field.ErrorList {
+	var el field.ErrorList
+	if spec.CA != nil {
+		var oldCRLDistributionPoints []string
+		if oldSpec != nil && oldSpec.CA != nil {
+			oldCRLDistributionPoints = oldSpec.CA.CRLDistributionPoints
+		}
+		if oldSpec == nil || !slices.Equal(oldCRLDistributionPoints, spec.CA.CRLDistributionPoints) {
+			el = append(el, validateCAIssuerCRLDistributionPoints(spec.CA.CRLDistributionPoints, fldPath.Child("ca", "crlDistributionPoints"))...)
+		}
+	}
+	if spec.ACME != nil {
+		// Solvers are matched by index, so reordering solvers causes them
+		// to be validated again.
+		for i, sol := range spec.ACME.Solvers {
+			if sol.HTTP01 == nil || sol.HTTP01.Ingress == nil {
+				continue
+			}
+			var oldIngress *cmacme.ACMEChallengeSolverHTTP01Ingress
+			if oldSpec != nil && oldSpec.ACME != nil && i < len(oldSpec.ACME.Solvers) && oldSpec.ACME.Solvers[i].HTTP01 != nil {
+				oldIngress = oldSpec.ACME.Solvers[i].HTTP01.Ingress
+			}
+			if oldSpec == nil || !http01IngressClassEqual(oldIngress, sol.HTTP01.Ingress) {
+				el = append(el, validateHTTP01IngressClass(sol.HTTP01.Ingress, fldPath.Child("acme", "solvers").Index(i).Child("http01", "ingress"))...)
+			}
+		}
+	}
+	return el
+}
+
+// validateHTTP01IngressClass rejects HTTP01 ingress solvers which set more
+// than one of 'ingressClassName', 'name' and 'class'. Setting all three is
+// always rejected by ValidateACMEIssuerChallengeSolverHTTP01IngressConfig.
+func validateHTTP01IngressClass(ingress *cmacme.ACMEChallengeSolverHTTP01Ingress, fldPath *field.Path) field.ErrorList {
+	numFieldsSet := 0
+	if ingress.Class != nil {
+		numFieldsSet++
+	}
+	if ingress.IngressClassName != nil {
+		numFieldsSet++
+	}
+	if len(ingress.Name) > 0 {
+		numFieldsSet++
+	}
+	if numFieldsSet != 2 {
+		return nil
+	}
+	return field.ErrorList{field.Forbidden(fldPath, "only one of 'ingressClassName', 'name' or 'class' should be specified")}
+}
+
+// http01IngressClassEqual returns true if the given HTTP01 ingress solvers
+// select the same ingress class or ingress.
+func http01IngressClassEqual(a, b *cmacme.ACMEChallengeSolverHTTP01Ingress) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return ptr.Equal(a.Class, b.Class) && ptr.Equal(a.IngressClassName, b.IngressClassName) && a.Name == b.Name
+}
+
 func ValidateIssuerSpec(iss *certmanager.IssuerSpec, fldPath *field.Path) (field.ErrorList, []string) {
-	return ValidateIssuerConfig(&iss.IssuerConfig, fldPath)
+	el, warnings := ValidateIssuerConfig(&iss.IssuerConfig, fldPath)
+	el = append(el, validateIssuerCertificateDefaults(iss.CertificateDefaults, fldPath.Child("certificateDefaults"))...)
+	return el, warnings
+}
+
+// validateIssuerCertificateDefaults validates the defaults applied to
+// Certificates referencing an issuer, using the same bounds as are enforced
+// on the Certificate fields themselves.
+func validateIssuerCertificateDefaults(defaults *certmanager.IssuerCertificateDefaults, fldPath *field.Path) field.ErrorList {
+	var el field.ErrorList
+	if defaults == nil {
+		return el
+	}
+
+	if !utilfeature.DefaultFeatureGate.Enabled(feature.IssuerCertificateDefaults) {
+		el = append(el, field.Forbidden(fldPath, "feature gate IssuerCertificateDefaults must be enabled"))
+		return el
+	}
+
+	if defaults.Duration != nil && defaults.Duration.Duration < cmapi.MinimumCertificateDuration {
+		el = append(el, field.Invalid(fldPath.Child("duration"), defaults.Duration.Duration, fmt.Sprintf("certificate duration must be greater than %s", cmapi.MinimumCertificateDuration)))
+	}
+	if defaults.RenewBefore != nil && defaults.RenewBefore.Duration < cmapi.MinimumRenewBefore {
+		el = append(el, field.Invalid(fldPath.Child("renewBefore"), defaults.RenewBefore.Duration, fmt.Sprintf("certificate renewBefore must be greater than %s", cmapi.MinimumRenewBefore)))
+	}
+	if defaults.Duration != nil && defaults.RenewBefore != nil && defaults.RenewBefore.Duration >= defaults.Duration.Duration {
+		el = append(el, field.Invalid(fldPath.Child("renewBefore"), defaults.RenewBefore.Duration, fmt.Sprintf("certificate duration %s must be greater than renewBefore %s", defaults.Duration.Duration, defaults.RenewBefore.Duration)))
+	}
+
+	return el
 }
 
 func ValidateIssuerConfig(iss *certmanager.IssuerConfig, fldPath *field.Path) (field.ErrorList, []string) {
@@ -97,6 +219,22 @@ func ValidateIssuerConfig(iss *certmanager.IssuerConfig, fldPath *field.Path) (f
 			el = append(el, ValidateVenafiIssuerConfig(iss.Venafi, fldPath.Child("venafi"))...)
 		}
 	}
+	if iss.SigningWebhook != nil {
+		if numConfigs > 0 {
+			el = append(el, field.Forbidden(fldPath.Child("signingWebhook"), "may not specify more than one issuer type"))
+		} else {
+			numConfigs++
+			el = append(el, ValidateSigningWebhookIssuerConfig(iss.SigningWebhook, fldPath.Child("signingWebhook"))...)
+		}
+	}
+	if iss.KubernetesCSR != nil {
+		if numConfigs > 0 {
+			el = append(el, field.Forbidden(fldPath.Child("kubernetesCSR"), "may not specify more than one issuer type"))
+		} else {
+			numConfigs++
+			el = append(el, ValidateKubernetesCSRIssuerConfig(iss.KubernetesCSR, fldPath.Child("kubernetesCSR"))...)
+		}
+	}
 	if numConfigs == 0 {
 		el = append(el, field.Required(fldPath, "at least one issuer must be configured"))
 	}
@@ -104,6 +242,25 @@ func ValidateIssuerConfig(iss *certmanager.IssuerConfig, fldPath *field.Path) (f
 	return el, warnings
 }
 
+// validateACMEProxyURL validates the URL of a proxy used for requests to the
+// ACME server, if set.
+func validateACMEProxyURL(proxyURL string, fldPath *field.Path) field.ErrorList {
+	if len(proxyURL) == 0 {
+		return nil
+	}
+
+	u, err := url.Parse(proxyURL)
+	if err != nil || u.Host == "" {
+		return field.ErrorList{field.Invalid(fldPath, proxyURL, "must be an absolute URL")}
+	}
+	switch u.Scheme {
+	case "http", "https", "socks5":
+		return nil
+	default:
+		return field.ErrorList{field.NotSupported(fldPath, u.Scheme, []string{"http", "https", "socks5"})}
+	}
+}
+
 func ValidateACMEIssuerConfig(iss *cmacme.ACMEIssuer, fldPath *field.Path) (field.ErrorList, []string) {
 	var warnings []string
 
@@ -120,14 +277,38 @@ func ValidateACMEIssuerConfig(iss *cmacme.ACMEIssuer, fldPath *field.Path) (fiel
 		}
 	}
 
+	if iss.CABundleSecretRef != nil {
+		if len(iss.CABundle) > 0 {
+			el = append(el, field.Invalid(fldPath.Child("caBundleSecretRef"), iss.CABundleSecretRef.Name, "specified caBundleSecretRef and caBundle cannot be used together"))
+		}
+		if iss.SkipTLSVerify {
+			el = append(el, field.Invalid(fldPath.Child("caBundleSecretRef"), iss.CABundleSecretRef.Name, "caBundleSecretRef and skipTLSVerify are mutually exclusive and cannot both be set"))
+		}
+	}
+	el = append(el, validateOptionalSecretKeySelectorName(iss.CABundleSecretRef, fldPath.Child("caBundleSecretRef"))...)
+
 	if len(iss.PrivateKey.Name) == 0 {
 		el = append(el, field.Required(fldPath.Child("privateKeySecretRef", "name"), "private key secret name is a required field"))
+	} else {
+		el = append(el, validateSecretRefName(iss.PrivateKey.Name, fldPath.Child("privateKeySecretRef", "name"))...)
 	}
 
 	if len(iss.Server) == 0 {
 		el = append(el, field.Required(fldPath.Child("server"), "acme server URL is a required field"))
 	}
 
+	el = append(el, validateACMEProxyURL(iss.HTTPProxy, fldPath.Child("httpProxy"))...)
+	el = append(el, validateACMEProxyURL(iss.HTTPSProxy, fldPath.Child("httpsProxy"))...)
+
+	if len(iss.AccountURI) > 0 {
+		if !iss.DisableAccountKeyGeneration {
+			el = append(el, field.Forbidden(fldPath.Child("accountURI"), "may only be set when disableAccountKeyGeneration is true"))
+		}
+		if u, err := url.Parse(iss.AccountURI); err != nil || !u.IsAbs() || u.Host == "" {
+			el = append(el, field.Invalid(fldPath.Child("accountURI"), iss.AccountURI, "must be an absolute URL"))
+		}
+	}
+
 	if eab := iss.ExternalAccountBinding; eab != nil {
 		eabFldPath := fldPath.Child("externalAccountBinding")
 		if len(eab.KeyID) == 0 {
@@ -142,13 +323,94 @@ func ValidateACMEIssuerConfig(iss *cmacme.ACMEIssuer, fldPath *field.Path) (fiel
 		}
 	}
 
+	if iss.RevokeOnDelete && !utilfeature.DefaultFeatureGate.Enabled(feature.CertificateRevocation) {
+		warnings = append(warnings, revokeOnDeleteWithoutCertificateRevocation)
+	}
+
+	seenChallengeTypes := make(map[cmacme.ACMEChallengeType]bool)
+	for i, t := range iss.ChallengeTypePreference {
+		fld := fldPath.Child("challengeTypePreference").Index(i)
+		switch {
+		case t != cmacme.ACMEChallengeTypeHTTP01 && t != cmacme.ACMEChallengeTypeDNS01 && t != cmacme.ACMEChallengeTypeTLSALPN01:
+			el = append(el, field.NotSupported(fld, t, []string{string(cmacme.ACMEChallengeTypeHTTP01), string(cmacme.ACMEChallengeTypeDNS01), string(cmacme.ACMEChallengeTypeTLSALPN01)}))
+		case seenChallengeTypes[t]:
+			el = append(el, field.Duplicate(fld, t))
+		}
+		seenChallengeTypes[t] = true
+	}
+
 	for i, sol := range iss.Solvers {
 		el = append(el, ValidateACMEIssuerChallengeSolverConfig(&sol, fldPath.Child("solvers").Index(i))...) // #nosec G601 -- False positive. See https://github.com/golang/go/discussions/56010
 	}
+	warnings = append(warnings, ambiguousACMESolverDNSZones(iss.Solvers, fldPath.Child("solvers"))...)
 
 	return el, warnings
 }
 
+// ambiguousACMESolverDNSZones returns a warning for every DNS zone which is
+// listed by more than one solver of the same type where nothing else in the
+// solvers' selectors distinguishes between them. Only the first of those
+// solvers will ever be selected for names in that zone, so the others are
+// most likely a configuration mistake.
+// This is a warning rather than an error so that existing Issuers with such
+// configuration can still be updated.
+func ambiguousACMESolverDNSZones(solvers []cmacme.ACMEChallengeSolver, fldPath *field.Path) []string {
+	var warnings []string
+	for i := range solvers {
+		if solvers[i].Selector == nil {
+			continue
+		}
+		for j := 0; j < i; j++ {
+			if !acmeSolversIndistinguishable(&solvers[j], &solvers[i]) {
+				continue
+			}
+			earlierZones := normalizedDomains(solvers[j].Selector.DNSZones)
+			for k, zone := range solvers[i].Selector.DNSZones {
+				if !earlierZones.Has(normalizeDomain(zone)) {
+					continue
+				}
+				warnings = append(warnings, fmt.Sprintf(ambiguousACMESolverDNSZone,
+					zone, fldPath.Index(i).Child("selector", "dnsZones").Index(k), fldPath.Index(j)))
+			}
+		}
+	}
+	return warnings
+}
+
+// acmeSolversIndistinguishable returns true if both solvers use the same
+// challenge type and have selectors with identical matchLabels and dnsNames.
+func acmeSolversIndistinguishable(a, b *cmacme.ACMEChallengeSolver) bool {
+	if a.Selector == nil || b.Selector == nil {
+		return false
+	}
+	if (a.HTTP01 != nil) != (b.HTTP01 != nil) ||
+		(a.DNS01 != nil) != (b.DNS01 != nil) ||
+		(a.TLSALPN01 != nil) != (b.TLSALPN01 != nil) {
+		return false
+	}
+	if len(a.Selector.MatchLabels) != len(b.Selector.MatchLabels) {
+		return false
+	}
+	for k, v := range a.Selector.MatchLabels {
+		if bv, ok := b.Selector.MatchLabels[k]; !ok || bv != v {
+			return false
+		}
+	}
+	return normalizedDomains(a.Selector.DNSNames).Equal(normalizedDomains(b.Selector.DNSNames))
+}
+
+func normalizedDomains(zones []string) sets.Set[string] {
+	s := sets.New[string]()
+	for _, z := range zones {
+		s.Insert(normalizeDomain(z))
+	}
+	return s
+}
+
+func normalizeDomain(zone string) string {
+	return strings.ToLower(strings.TrimSuffix(zone, "."))
+}
+
 func ValidateACMEIssuerChallengeSolverConfig(sol *cmacme.ACMEChallengeSolver, fldPath *field.Path) field.ErrorList {
 	el := field.ErrorList{}
 
@@ -165,6 +427,14 @@ func ValidateACMEIssuerChallengeSolverConfig(sol *cmacme.ACMEChallengeSolver, fl
 			el = append(el, ValidateACMEChallengeSolverDNS01(sol.DNS01, fldPath.Child("dns01"))...)
 		}
 	}
+	if sol.TLSALPN01 != nil {
+		if numProviders > 0 {
+			el = append(el, field.Forbidden(fldPath, "may not specify more than one solver type in a single solver"))
+		} else {
+			numProviders++
+			el = append(el, ValidateACMEIssuerChallengeSolverTLSALPN01Config(sol.TLSALPN01, fldPath.Child("tlsalpn01"))...)
+		}
+	}
 	if numProviders == 0 {
 		el = append(el, field.Required(fldPath, "no solver type configured"))
 	}
@@ -190,6 +460,9 @@ func ValidateACMEIssuerChallengeSolverHTTP01Config(http01 *cmacme.ACMEChallengeS
 	if numDefined > 1 {
 		el = append(el, field.Required(fldPath, "only 1 HTTP01 solver type may be configured"))
 	}
+	if http01.Deployment != nil && http01.Deployment.Replicas != nil && *http01.Deployment.Replicas < 1 {
+		el = append(el, field.Invalid(fldPath.Child("deployment", "replicas"), *http01.Deployment.Replicas, "must be at least 1"))
+	}
 
 	return el
 }
@@ -218,6 +491,12 @@ func ValidateACMEIssuerChallengeSolverHTTP01IngressConfig(ingress *cmacme.ACMECh
 		el = append(el, field.Invalid(fldPath.Child("serviceType"), ingress.ServiceType, `must be empty, "ClusterIP" or "NodePort"`))
 	}
 
+	if ingress.IngressTemplate != nil {
+		templateMetaPath := fldPath.Child("ingressTemplate", "metadata")
+		el = append(el, metavalidation.ValidateLabels(ingress.IngressTemplate.Labels, templateMetaPath.Child("labels"))...)
+		el = append(el, apivalidation.ValidateAnnotations(ingress.IngressTemplate.Annotations, templateMetaPath.Child("annotations"))...)
+	}
+
 	return el
 }
 
@@ -235,10 +514,24 @@ func ValidateACMEIssuerChallengeSolverHTTP01GatewayConfig(gateway *cmacme.ACMECh
 	return el
 }
 
+func ValidateACMEIssuerChallengeSolverTLSALPN01Config(tlsalpn01 *cmacme.ACMEChallengeSolverTLSALPN01, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	switch tlsalpn01.ServiceType {
+	case "", corev1.ServiceTypeClusterIP, corev1.ServiceTypeNodePort:
+	default:
+		el = append(el, field.Invalid(fldPath.Child("serviceType"), tlsalpn01.ServiceType, `must be empty, "ClusterIP" or "NodePort"`))
+	}
+
+	return el
+}
+
 func ValidateCAIssuerConfig(iss *certmanager.CAIssuer, fldPath *field.Path) field.ErrorList {
 	el := field.ErrorList{}
 	if len(iss.SecretName) == 0 {
 		el = append(el, field.Required(fldPath.Child("secretName"), ""))
+	} else {
+		el = append(el, validateSecretRefName(iss.SecretName, fldPath.Child("secretName"))...)
 	}
 	for i, ocspURL := range iss.OCSPServers {
 		if ocspURL == "" {
@@ -250,11 +543,75 @@ func ValidateCAIssuerConfig(iss *certmanager.CAIssuer, fldPath *field.Path) fiel
 			el = append(el, field.Invalid(fldPath.Child("issuingCertificateURLs").Index(i), issuerURL, "must be a valid URL"))
 		}
 	}
+	switch iss.CAExpiryPolicy {
+	case "", certmanager.CAExpiryPolicyIgnore, certmanager.CAExpiryPolicyClamp, certmanager.CAExpiryPolicyReject:
+	default:
+		el = append(el, field.NotSupported(fldPath.Child("caExpiryPolicy"), iss.CAExpiryPolicy, []string{
+			string(certmanager.CAExpiryPolicyIgnore), string(certmanager.CAExpiryPolicyClamp), string(certmanager.CAExpiryPolicyReject),
+		}))
+	}
+	switch iss.SerialNumberStrategy {
+	case "", certmanager.RandomSerialNumberStrategy, certmanager.SequentialSerialNumberStrategy:
+	default:
+		el = append(el, field.NotSupported(fldPath.Child("serialNumberStrategy"), iss.SerialNumberStrategy, []string{
+			string(certmanager.RandomSerialNumberStrategy), string(certmanager.SequentialSerialNumberStrategy),
+		}))
+	}
+	if iss.SignatureAlgorithm != "" {
+		if _, _, ok := pki.SignatureAlgorithmByName(iss.SignatureAlgorithm); !ok {
+			el = append(el, field.NotSupported(fldPath.Child("signatureAlgorithm"), iss.SignatureAlgorithm, pki.SignatureAlgorithmNames()))
+		}
+	}
+	if iss.AuthorityKeyIdentifier != "" {
+		if _, err := pki.ParseKeyIdentifier(iss.AuthorityKeyIdentifier); err != nil {
+			el = append(el, field.Invalid(fldPath.Child("authorityKeyIdentifier"), iss.AuthorityKeyIdentifier, err.Error()))
+		}
+	}
+	for i, oid := range iss.AllowedExtraExtensions {
+		parsed, err := pki.ParseObjectIdentifier(oid)
+		if err != nil {
+			el = append(el, field.Invalid(fldPath.Child("allowedExtraExtensions").Index(i), oid, "oid syntax invalid"))
+		} else if pki.IsManagedExtension(parsed) {
+			el = append(el, field.Invalid(fldPath.Child("allowedExtraExtensions").Index(i), oid, "extension is managed by cert-manager and cannot be copied from the certificate request"))
+		}
+	}
+	return el
+}
+
+// validateCAIssuerCRLDistributionPoints validates the CRL distribution point
+// URL templates of a CA issuer.
+func validateCAIssuerCRLDistributionPoints(crlURLs []string, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	for i, crlURL := range crlURLs {
+		if crlURL == "" {
+			el = append(el, field.Invalid(fldPath.Index(i), crlURL, "must be a valid URL"))
+		} else if err := pki.ValidateCRLDistributionPointTemplate(crlURL); err != nil {
+			el = append(el, field.Invalid(fldPath.Index(i), crlURL, err.Error()))
+		}
+	}
 	return el
 }
 
 func ValidateSelfSignedIssuerConfig(iss *certmanager.SelfSignedIssuer, fldPath *field.Path) field.ErrorList {
-	return nil
+	el := field.ErrorList{}
+	if iss.IssuerDN != "" {
+		sequence, err := pki.UnmarshalSubjectStringToRDNSequence(iss.IssuerDN)
+		if err != nil {
+			el = append(el, field.Invalid(fldPath.Child("issuerDN"), iss.IssuerDN, err.Error()))
+		} else if len(sequence) == 0 {
+			el = append(el, field.Invalid(fldPath.Child("issuerDN"), iss.IssuerDN, "must contain at least one relative distinguished name"))
+		}
+
+		// Should not contain unrecognized OIDs
+		for _, rdns := range sequence {
+			for _, atv := range rdns {
+				if atv.Type.Equal(nil) {
+					el = append(el, field.Invalid(fldPath.Child("issuerDN"), iss.IssuerDN, fmt.Sprintf("issuerDN contains unrecognized key with value [%s]", atv.Value)))
+				}
+			}
+		}
+	}
+	return el
 }
 
 func ValidateVaultIssuerConfig(iss *certmanager.VaultIssuer, fldPath *field.Path) field.ErrorList {
@@ -287,16 +644,78 @@ func ValidateVaultIssuerConfig(iss *certmanager.VaultIssuer, fldPath *field.Path
 		el = append(el, field.Invalid(fldPath.Child("clientCertSecretRef"), "<snip>", "clientCertSecretRef must be provided when defining the clientKeySecretRef"))
 	}
 
+	el = append(el, validateOptionalSecretKeySelectorName(iss.CABundleSecretRef, fldPath.Child("caBundleSecretRef"))...)
+	el = append(el, validateOptionalSecretKeySelectorName(iss.ClientCertSecretRef, fldPath.Child("clientCertSecretRef"))...)
+	el = append(el, validateOptionalSecretKeySelectorName(iss.ClientKeySecretRef, fldPath.Child("clientKeySecretRef"))...)
+
 	el = append(el, ValidateVaultIssuerAuth(&iss.Auth, fldPath.Child("auth"))...)
 
 	return el
 }
 
+func ValidateSigningWebhookIssuerConfig(iss *certmanager.SigningWebhookIssuer, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	if len(iss.URL) == 0 {
+		el = append(el, field.Required(fldPath.Child("url"), ""))
+	} else if u, err := url.Parse(iss.URL); err != nil {
+		el = append(el, field.Invalid(fldPath.Child("url"), iss.URL, err.Error()))
+	} else if u.Scheme != "https" || u.Host == "" {
+		el = append(el, field.Invalid(fldPath.Child("url"), iss.URL, "must be an absolute https URL"))
+	}
+
+	if len(iss.CABundle) > 0 {
+		if err := validateCABundleNotEmpty(iss.CABundle); err != nil {
+			el = append(el, field.Invalid(fldPath.Child("caBundle"), "<snip>", err.Error()))
+		}
+	}
+
+	if iss.ClientCertSecretRef != nil && iss.ClientKeySecretRef == nil {
+		el = append(el, field.Invalid(fldPath.Child("clientKeySecretRef"), "<snip>", "clientKeySecretRef must be provided when defining the clientCertSecretRef"))
+	} else if iss.ClientCertSecretRef == nil && iss.ClientKeySecretRef != nil {
+		el = append(el, field.Invalid(fldPath.Child("clientCertSecretRef"), "<snip>", "clientCertSecretRef must be provided when defining the clientKeySecretRef"))
+	}
+
+	el = append(el, validateOptionalSecretKeySelectorName(iss.ClientCertSecretRef, fldPath.Child("clientCertSecretRef"))...)
+	el = append(el, validateOptionalSecretKeySelectorName(iss.ClientKeySecretRef, fldPath.Child("clientKeySecretRef"))...)
+
+	return el
+}
+
+func ValidateKubernetesCSRIssuerConfig(iss *certmanager.KubernetesCSRIssuer, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	if len(iss.SignerName) == 0 {
+		el = append(el, field.Required(fldPath.Child("signerName"), ""))
+		return el
+	}
+
+	// Signer names must be of the form "<domain>/<path>", mirroring the
+	// validation applied by the Kubernetes API server.
+	domain, path, ok := strings.Cut(iss.SignerName, "/")
+	if !ok || len(path) == 0 {
+		el = append(el, field.Invalid(fldPath.Child("signerName"), iss.SignerName, "must be a fully qualified domain and path of the form 'example.com/signer-name'"))
+		return el
+	}
+	for _, msg := range validation.IsDNS1123Subdomain(domain) {
+		el = append(el, field.Invalid(fldPath.Child("signerName"), iss.SignerName, msg))
+	}
+
+	// CertificateSigningRequests for cert-manager's own signers are handled
+	// by cert-manager itself, which would lead to a signing loop.
+	if domain == "issuers.cert-manager.io" || domain == "clusterissuers.cert-manager.io" {
+		el = append(el, field.Invalid(fldPath.Child("signerName"), iss.SignerName, "must not reference a cert-manager signer"))
+	}
+
+	return el
+}
+
 func ValidateVaultIssuerAuth(auth *certmanager.VaultAuth, fldPath *field.Path) field.ErrorList {
 	el := field.ErrorList{}
 
 	unionCount := 0
 	if auth.TokenSecretRef != nil {
+		el = append(el, validateOptionalSecretKeySelectorName(auth.TokenSecretRef, fldPath.Child("tokenSecretRef"))...)
 		unionCount++
 	}
 
@@ -307,6 +726,8 @@ func ValidateVaultIssuerAuth(auth *certmanager.VaultAuth, fldPath *field.Path) f
 
 		if auth.AppRole.SecretRef.Name == "" {
 			el = append(el, field.Required(fldPath.Child("appRole", "secretRef", "name"), ""))
+		} else {
+			el = append(el, validateSecretRefName(auth.AppRole.SecretRef.Name, fldPath.Child("appRole", "secretRef", "name"))...)
 		}
 		unionCount++
 	}
@@ -324,6 +745,7 @@ func ValidateVaultIssuerAuth(auth *certmanager.VaultAuth, fldPath *field.Path) f
 
 		kubeCount := 0
 		if len(auth.Kubernetes.SecretRef.Name) > 0 {
+			el = append(el, validateSecretRefName(auth.Kubernetes.SecretRef.Name, fldPath.Child("kubernetes", "secretRef", "name"))...)
 			kubeCount++
 		}
 
@@ -361,6 +783,39 @@ func ValidateVenafiTPP(tpp *certmanager.VenafiTPP, fldPath *field.Path) (el fiel
 		el = append(el, field.Required(fldPath.Child("url"), ""))
 	}
 
+	el = append(el, validateSecretRefName(tpp.CredentialsRef.Name, fldPath.Child("credentialsRef", "name"))...)
+	el = append(el, validateOptionalSecretKeySelectorName(tpp.CABundleSecretRef, fldPath.Child("caBundleSecretRef"))...)
+
+	if tpp.ClientCertSecretRef != nil && tpp.ClientKeySecretRef == nil {
+		el = append(el, field.Invalid(fldPath.Child("clientKeySecretRef"), "<snip>", "clientKeySecretRef must be provided when defining the clientCertSecretRef"))
+	} else if tpp.ClientCertSecretRef == nil && tpp.ClientKeySecretRef != nil {
+		el = append(el, field.Invalid(fldPath.Child("clientCertSecretRef"), "<snip>", "clientCertSecretRef must be provided when defining the clientKeySecretRef"))
+	}
+	el = append(el, validateOptionalSecretKeySelectorName(tpp.ClientCertSecretRef, fldPath.Child("clientCertSecretRef"))...)
+	el = append(el, validateOptionalSecretKeySelectorName(tpp.ClientKeySecretRef, fldPath.Child("clientKeySecretRef"))...)
+
+	if tpp.PickupTimeout != nil {
+		switch {
+		case tpp.PickupTimeout.Duration <= 0:
+			el = append(el, field.Invalid(fldPath.Child("pickupTimeout"), tpp.PickupTimeout.Duration, "must be greater than zero"))
+		case tpp.PickupTimeout.Duration > globals.DefaultControllerContextTimeout:
+			el = append(el, field.Invalid(fldPath.Child("pickupTimeout"), tpp.PickupTimeout.Duration, fmt.Sprintf("must not be greater than %s", globals.DefaultControllerContextTimeout)))
+		}
+	}
+
+	customFieldNames := sets.New[string]()
+	for i, customField := range tpp.CustomFields {
+		fldPath := fldPath.Child("customFields").Index(i)
+		if customField.Name == "" {
+			el = append(el, field.Required(fldPath.Child("name"), ""))
+			continue
+		}
+		if customFieldNames.Has(customField.Name) {
+			el = append(el, field.Duplicate(fldPath.Child("name"), customField.Name))
+		}
+		customFieldNames.Insert(customField.Name)
+	}
+
 	// TODO: validate CABundle using validateCABundleNotEmpty
 
 	// Validate only one of CABundle/CABundleSecretRef is passed
@@ -386,6 +841,7 @@ func validateVenafiTPPCABundleUnique(tpp *certmanager.VenafiTPP, fldPath *field.
 }
 
 func ValidateVenafiCloud(c *certmanager.VenafiCloud, fldPath *field.Path) (el field.ErrorList) {
+	el = append(el, validateSecretRefName(c.APITokenSecretRef.Name, fldPath.Child("apiTokenSecretRef", "name"))...)
 	return el
 }
 
@@ -410,6 +866,10 @@ func ValidateVenafiIssuerConfig(iss *certmanager.VenafiIssuer, fldPath *field.Pa
 		el = append(el, field.Forbidden(fldPath, "please supply one of: tpp, cloud"))
 	}
 
+	if iss.CredentialCheckInterval != nil && iss.CredentialCheckInterval.Duration <= 0 {
+		el = append(el, field.Invalid(fldPath.Child("credentialCheckInterval"), iss.CredentialCheckInterval.Duration, "must be greater than zero"))
+	}
+
 	return el
 }
 
@@ -418,6 +878,7 @@ var supportedTSIGAlgorithms = []string{
 	"HMACMD5",
 	"HMACSHA1",
 	"HMACSHA256",
+	"HMACSHA384",
 	"HMACSHA512",
 }
 
@@ -434,6 +895,9 @@ func ValidateACMEChallengeSolverDNS01(p *cmacme.ACMEChallengeSolverDNS01, fldPat
 			el = append(el, field.Invalid(fldPath.Child("cnameStrategy"), p.CNAMEStrategy, fmt.Sprintf("must be one of %q or %q", cmacme.NoneStrategy, cmacme.FollowStrategy)))
 		}
 	}
+	if p.PropagationCheckTimeout != nil && p.PropagationCheckTimeout.Duration < 0 {
+		el = append(el, field.Invalid(fldPath.Child("propagationCheckTimeout"), p.PropagationCheckTimeout.Duration, "must not be negative"))
+	}
 	numProviders := 0
 	if p.Akamai != nil {
 		numProviders++
@@ -499,6 +963,12 @@ func ValidateACMEChallengeSolverDNS01(p *cmacme.ACMEChallengeSolverDNS01, fldPat
 			if len(p.CloudDNS.Project) == 0 {
 				el = append(el, field.Required(fldPath.Child("cloudDNS", "project"), ""))
 			}
+			switch p.CloudDNS.HostedZoneVisibility {
+			case "", cmacme.CloudDNSZoneVisibilityPublic, cmacme.CloudDNSZoneVisibilityPrivate:
+			default:
+				el = append(el, field.Invalid(fldPath.Child("cloudDNS", "hostedZoneVisibility"), p.CloudDNS.HostedZoneVisibility,
+					fmt.Sprintf("must be either empty or one of %s or %s", cmacme.CloudDNSZoneVisibilityPublic, cmacme.CloudDNSZoneVisibilityPrivate)))
+			}
 		}
 	}
 	if p.Cloudflare != nil {
@@ -515,8 +985,16 @@ func ValidateACMEChallengeSolverDNS01(p *cmacme.ACMEChallengeSolverDNS01, fldPat
 			if p.Cloudflare.APIKey != nil && p.Cloudflare.APIToken != nil {
 				el = append(el, field.Forbidden(fldPath.Child("cloudflare"), "apiKeySecretRef and apiTokenSecretRef cannot both be specified"))
 			}
-			if p.Cloudflare.APIKey == nil && p.Cloudflare.APIToken == nil {
-				el = append(el, field.Required(fldPath.Child("cloudflare"), "apiKeySecretRef or apiTokenSecretRef is required"))
+			for _, zone := range sets.List(sets.KeySet(p.Cloudflare.ZoneAPITokens)) {
+				zonePath := fldPath.Child("cloudflare", "zoneAPITokenSecretRefs").Key(zone)
+				if len(zone) == 0 {
+					el = append(el, field.Required(zonePath, "zone name is required"))
+				}
+				ref := p.Cloudflare.ZoneAPITokens[zone]
+				el = append(el, ValidateSecretKeySelector(&ref, zonePath)...)
+			}
+			if p.Cloudflare.APIKey == nil && p.Cloudflare.APIToken == nil && len(p.Cloudflare.ZoneAPITokens) == 0 {
+				el = append(el, field.Required(fldPath.Child("cloudflare"), "apiKeySecretRef, apiTokenSecretRef or zoneAPITokenSecretRefs is required"))
 			}
 			if len(p.Cloudflare.Email) == 0 && p.Cloudflare.APIKey != nil {
 				el = append(el, field.Required(fldPath.Child("cloudflare", "email"), ""))
@@ -542,6 +1020,11 @@ func ValidateACMEChallengeSolverDNS01(p *cmacme.ACMEChallengeSolverDNS01, fldPat
 			if p.Route53.SecretAccessKeyID != nil {
 				el = append(el, ValidateSecretKeySelector(p.Route53.SecretAccessKeyID, fldPath.Child("route53", "accessKeyIDSecretRef"))...)
 			}
+			for i, role := range p.Route53.RoleChain {
+				if len(role) == 0 {
+					el = append(el, field.Required(fldPath.Child("route53", "roleChain").Index(i), "role must not be empty"))
+				}
+			}
 		}
 	}
 	if p.AcmeDNS != nil {
@@ -574,9 +1057,11 @@ func ValidateACMEChallengeSolverDNS01(p *cmacme.ACMEChallengeSolverDNS01, fldPat
 				}
 			}
 			if len(p.RFC2136.TSIGAlgorithm) > 0 {
+				// Accept the RFC 8945 algorithm names (e.g. hmac-sha512.) as well
+				algorithm := strings.ToUpper(strings.ReplaceAll(strings.TrimSuffix(p.RFC2136.TSIGAlgorithm, "."), "-", ""))
 				present := false
 				for _, b := range supportedTSIGAlgorithms {
-					if b == strings.ToUpper(p.RFC2136.TSIGAlgorithm) {
+					if b == algorithm {
 						present = true
 					}
 				}
@@ -604,6 +1089,9 @@ func ValidateACMEChallengeSolverDNS01(p *cmacme.ACMEChallengeSolverDNS01, fldPat
 			if len(p.Webhook.SolverName) == 0 {
 				el = append(el, field.Required(fldPath.Child("webhook", "solverName"), "solver name must be specified"))
 			}
+			if p.Webhook.Timeout != nil && p.Webhook.Timeout.Duration < 0 {
+				el = append(el, field.Invalid(fldPath.Child("webhook", "timeout"), p.Webhook.Timeout.Duration, "must not be negative"))
+			}
 		}
 	}
 	if numProviders == 0 {
@@ -621,6 +1109,36 @@ func ValidateSecretKeySelector(sks *cmmeta.SecretKeySelector, fldPath *field.Pat
 	if sks.Key == "" {
 		el = append(el, field.Required(fldPath.Child("key"), "secret key is required"))
 	}
+	el = append(el, validateSecretRefName(sks.Name, fldPath.Child("name"))...)
+	return el
+}
+
+// validateOptionalSecretKeySelectorName validates the name of the Secret
+// referenced by an optional SecretKeySelector, if it is set.
+func validateOptionalSecretKeySelectorName(sks *cmmeta.SecretKeySelector, fldPath *field.Path) field.ErrorList {
+	if sks == nil {
+		return nil
+	}
+	return validateSecretRefName(sks.Name, fldPath.Child("name"))
+}
+
+// validateSecretRefName validates that a non-empty name of a referenced Secret
+// is a valid Secret name. Secrets are always looked up in the namespace of the
+// referencing resource, or in the cluster resource namespace for cluster
+// scoped resources, so a name which looks like a namespaced reference is
+// rejected with an explicit message.
+func validateSecretRefName(name string, fldPath *field.Path) field.ErrorList {
+	if name == "" {
+		return nil
+	}
+	if strings.Contains(name, "/") {
+		return field.ErrorList{field.Invalid(fldPath, name, "must be the name of a Secret in the same namespace, references to Secrets in other namespaces are not supported")}
+	}
+
+	el := field.ErrorList{}
+	for _, msg := range validation.IsDNS1123Subdomain(name) {
+		el = append(el, field.Invalid(fldPath, name, msg))
+	}
 	return el
 }
 
@@ -17,13 +17,17 @@ limitations under the License.
 package validation
 
 import (
+	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	admissionv1 "k8s.io/api/admission/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	featuregatetesting "k8s.io/component-base/featuregate/testing"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	gwapi "sigs.k8s.io/gateway-api/apis/v1"
@@ -31,7 +35,10 @@ import (
 	cmacme "github.com/cert-manager/cert-manager/internal/apis/acme"
 	cmapi "github.com/cert-manager/cert-manager/internal/apis/certmanager"
 	cmmeta "github.com/cert-manager/cert-manager/internal/apis/meta"
+	"github.com/cert-manager/cert-manager/internal/webhook/feature"
 	pubcmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
 	unitcrypto "github.com/cert-manager/cert-manager/test/unit/crypto"
 )
 
@@ -335,6 +342,45 @@ func TestValidateVaultIssuerAuth(t *testing.T) {
 				field.Forbidden(fldPath.Child("kubernetes"), "please supply one of: secretRef, serviceAccountRef"),
 			},
 		},
+		"invalid auth.tokenSecretRef: name references a Secret in another namespace": {
+			auth: &cmapi.VaultAuth{
+				TokenSecretRef: &cmmeta.SecretKeySelector{
+					LocalObjectReference: cmmeta.LocalObjectReference{Name: "other-namespace/secret"},
+					Key:                  "key",
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("tokenSecretRef", "name"), "other-namespace/secret", "must be the name of a Secret in the same namespace, references to Secrets in other namespaces are not supported"),
+			},
+		},
+		"invalid auth.appRole: secretRef name is not a valid Secret name": {
+			auth: &cmapi.VaultAuth{
+				AppRole: &cmapi.VaultAppRole{
+					RoleId: "role-id",
+					SecretRef: cmmeta.SecretKeySelector{
+						LocalObjectReference: cmmeta.LocalObjectReference{Name: "Invalid_Name"},
+						Key:                  "key",
+					},
+					Path: "path",
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("appRole", "secretRef", "name"), "Invalid_Name", "a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')"),
+			},
+		},
+		"invalid auth.kubernetes: secretRef name references a Secret in another namespace": {
+			auth: &cmapi.VaultAuth{
+				Kubernetes: &cmapi.VaultKubernetesAuth{
+					SecretRef: cmmeta.SecretKeySelector{
+						LocalObjectReference: cmmeta.LocalObjectReference{Name: "other-namespace/secret"},
+					},
+					Role: "role",
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("kubernetes", "secretRef", "name"), "other-namespace/secret", "must be the name of a Secret in the same namespace, references to Secrets in other namespaces are not supported"),
+			},
+		},
 	}
 	for n, s := range scenarios {
 		t.Run(n, func(t *testing.T) {
@@ -362,10 +408,29 @@ func TestValidateACMEIssuerConfig(t *testing.T) {
 	).CertBytes
 
 	scenarios := map[string]struct {
-		spec     *cmacme.ACMEIssuer
-		errs     []*field.Error
-		warnings []string
+		spec                                *cmacme.ACMEIssuer
+		certificateRevocationFeatureEnabled bool
+		errs                                []*field.Error
+		warnings                            []string
 	}{
+		"acme issuer with revokeOnDelete and the CertificateRevocation feature enabled": {
+			spec: &cmacme.ACMEIssuer{
+				Email:          "valid-email",
+				Server:         "valid-server",
+				PrivateKey:     validSecretKeyRef,
+				RevokeOnDelete: true,
+			},
+			certificateRevocationFeatureEnabled: true,
+		},
+		"acme issuer with revokeOnDelete and the CertificateRevocation feature disabled": {
+			spec: &cmacme.ACMEIssuer{
+				Email:          "valid-email",
+				Server:         "valid-server",
+				PrivateKey:     validSecretKeyRef,
+				RevokeOnDelete: true,
+			},
+			warnings: []string{revokeOnDeleteWithoutCertificateRevocation},
+		},
 		"valid acme issuer": {
 			spec: &validACMEIssuer,
 		},
@@ -376,6 +441,85 @@ func TestValidateACMEIssuerConfig(t *testing.T) {
 				field.Required(fldPath.Child("server"), "acme server URL is a required field"),
 			},
 		},
+		"acme issuer with a pre-registered account": {
+			spec: &cmacme.ACMEIssuer{
+				Server:                      "valid-server",
+				PrivateKey:                  validSecretKeyRef,
+				DisableAccountKeyGeneration: true,
+				AccountURI:                  "https://acme.example.com/acct/1",
+			},
+		},
+		"acme issuer with a pre-registered account but account key generation enabled": {
+			spec: &cmacme.ACMEIssuer{
+				Server:     "valid-server",
+				PrivateKey: validSecretKeyRef,
+				AccountURI: "https://acme.example.com/acct/1",
+			},
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("accountURI"), "may only be set when disableAccountKeyGeneration is true"),
+			},
+		},
+		"acme issuer with a pre-registered account URI which is not an absolute URL": {
+			spec: &cmacme.ACMEIssuer{
+				Server:                      "valid-server",
+				PrivateKey:                  validSecretKeyRef,
+				DisableAccountKeyGeneration: true,
+				AccountURI:                  "acct/1",
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("accountURI"), "acct/1", "must be an absolute URL"),
+			},
+		},
+		"acme issuer with proxies": {
+			spec: &cmacme.ACMEIssuer{
+				Server:     "valid-server",
+				PrivateKey: validSecretKeyRef,
+				HTTPProxy:  "http://proxy.example.com:3128",
+				HTTPSProxy: "socks5://proxy.example.com:1080",
+				NoProxy:    "localhost,.internal",
+			},
+		},
+		"acme issuer with invalid proxies": {
+			spec: &cmacme.ACMEIssuer{
+				Server:     "valid-server",
+				PrivateKey: validSecretKeyRef,
+				HTTPProxy:  "proxy",
+				HTTPSProxy: "ftp://proxy.example.com",
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("httpProxy"), "proxy", "must be an absolute URL"),
+				field.NotSupported(fldPath.Child("httpsProxy"), "ftp", []string{"http", "https", "socks5"}),
+			},
+		},
+		"acme issuer with a challenge type preference": {
+			spec: &cmacme.ACMEIssuer{
+				Server:                  "valid-server",
+				PrivateKey:              validSecretKeyRef,
+				ChallengeTypePreference: []cmacme.ACMEChallengeType{cmacme.ACMEChallengeTypeDNS01, cmacme.ACMEChallengeTypeHTTP01},
+			},
+		},
+		"acme issuer with an unsupported or duplicated challenge type preference": {
+			spec: &cmacme.ACMEIssuer{
+				Server:                  "valid-server",
+				PrivateKey:              validSecretKeyRef,
+				ChallengeTypePreference: []cmacme.ACMEChallengeType{cmacme.ACMEChallengeTypeDNS01, "TLS-SNI-01", cmacme.ACMEChallengeTypeDNS01},
+			},
+			errs: []*field.Error{
+				field.NotSupported(fldPath.Child("challengeTypePreference").Index(1), cmacme.ACMEChallengeType("TLS-SNI-01"), []string{"HTTP-01", "DNS-01", "TLS-ALPN-01"}),
+				field.Duplicate(fldPath.Child("challengeTypePreference").Index(2), cmacme.ACMEChallengeTypeDNS01),
+			},
+		},
+		"acme issuer with a private key secret in another namespace": {
+			spec: &cmacme.ACMEIssuer{
+				Server: "valid-server",
+				PrivateKey: cmmeta.SecretKeySelector{
+					LocalObjectReference: cmmeta.LocalObjectReference{Name: "other-namespace/account-key"},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("privateKeySecretRef", "name"), "other-namespace/account-key", "must be the name of a Secret in the same namespace, references to Secrets in other namespaces are not supported"),
+			},
+		},
 		"acme issuer with an invalid CA bundle": {
 			spec: &cmacme.ACMEIssuer{
 				Email:      "valid-email",
@@ -414,6 +558,59 @@ func TestValidateACMEIssuerConfig(t *testing.T) {
 				field.Invalid(fldPath.Child("skipTLSVerify"), true, "caBundle and skipTLSVerify are mutually exclusive and cannot both be set"),
 			},
 		},
+		"acme issuer with a CA bundle Secret reference": {
+			spec: &cmacme.ACMEIssuer{
+				Email:             "valid-email",
+				Server:            "valid-server",
+				CABundleSecretRef: &cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "ca-bundle"}},
+				PrivateKey:        validSecretKeyRef,
+				Solvers: []cmacme.ACMEChallengeSolver{
+					{
+						DNS01: &cmacme.ACMEChallengeSolverDNS01{
+							CloudDNS: &validCloudDNSProvider,
+						},
+					},
+				},
+			},
+		},
+		"acme issuer with both a CA bundle and a CA bundle Secret reference": {
+			spec: &cmacme.ACMEIssuer{
+				Email:             "valid-email",
+				Server:            "valid-server",
+				CABundle:          caBundle,
+				CABundleSecretRef: &cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "ca-bundle"}},
+				PrivateKey:        validSecretKeyRef,
+				Solvers: []cmacme.ACMEChallengeSolver{
+					{
+						DNS01: &cmacme.ACMEChallengeSolverDNS01{
+							CloudDNS: &validCloudDNSProvider,
+						},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("caBundleSecretRef"), "ca-bundle", "specified caBundleSecretRef and caBundle cannot be used together"),
+			},
+		},
+		"acme issuer with both a CA bundle Secret reference and SkipTLSVerify": {
+			spec: &cmacme.ACMEIssuer{
+				Email:             "valid-email",
+				Server:            "valid-server",
+				CABundleSecretRef: &cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "ca-bundle"}},
+				SkipTLSVerify:     true,
+				PrivateKey:        validSecretKeyRef,
+				Solvers: []cmacme.ACMEChallengeSolver{
+					{
+						DNS01: &cmacme.ACMEChallengeSolverDNS01{
+							CloudDNS: &validCloudDNSProvider,
+						},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("caBundleSecretRef"), "ca-bundle", "caBundleSecretRef and skipTLSVerify are mutually exclusive and cannot both be set"),
+			},
+		},
 		"acme solver without any config": {
 			spec: &cmacme.ACMEIssuer{
 				Email:      "valid-email",
@@ -528,6 +725,43 @@ func TestValidateACMEIssuerConfig(t *testing.T) {
 				},
 			},
 		},
+		"acme solver with valid http01 deployment config": {
+			spec: &cmacme.ACMEIssuer{
+				Email:      "valid-email",
+				Server:     "valid-server",
+				PrivateKey: validSecretKeyRef,
+				Solvers: []cmacme.ACMEChallengeSolver{
+					{
+						HTTP01: &cmacme.ACMEChallengeSolverHTTP01{
+							Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{},
+							Deployment: &cmacme.ACMEChallengeSolverHTTP01Deployment{
+								Replicas: ptr.To(int32(2)),
+							},
+						},
+					},
+				},
+			},
+		},
+		"acme solver with zero http01 deployment replicas": {
+			spec: &cmacme.ACMEIssuer{
+				Email:      "valid-email",
+				Server:     "valid-server",
+				PrivateKey: validSecretKeyRef,
+				Solvers: []cmacme.ACMEChallengeSolver{
+					{
+						HTTP01: &cmacme.ACMEChallengeSolverHTTP01{
+							Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{},
+							Deployment: &cmacme.ACMEChallengeSolverHTTP01Deployment{
+								Replicas: ptr.To(int32(0)),
+							},
+						},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("solvers").Index(0).Child("http01", "deployment", "replicas"), int32(0), "must be at least 1"),
+			},
+		},
 		"acme solver with valid http01 gateway config": {
 			spec: &cmacme.ACMEIssuer{
 				Email:      "valid-email",
@@ -679,9 +913,96 @@ func TestValidateACMEIssuerConfig(t *testing.T) {
 				},
 			},
 		},
+		"acme issuer with the same dnsZone in solvers with identical selectors": {
+			spec: &cmacme.ACMEIssuer{
+				Email:      "valid-email",
+				Server:     "valid-server",
+				PrivateKey: validSecretKeyRef,
+				Solvers: []cmacme.ACMEChallengeSolver{
+					selectedHTTP01Solver(&cmacme.CertificateDNSNameSelector{
+						MatchLabels: map[string]string{"team": "a"},
+						DNSZones:    []string{"example.com"},
+					}),
+					selectedHTTP01Solver(&cmacme.CertificateDNSNameSelector{
+						MatchLabels: map[string]string{"team": "a"},
+						DNSZones:    []string{"other.com", "Example.com."},
+					}),
+				},
+			},
+			warnings: []string{
+				fmt.Sprintf(ambiguousACMESolverDNSZone, "Example.com.", "solvers[1].selector.dnsZones[1]", "solvers[0]"),
+			},
+		},
+		"acme issuer with the same dnsZone in solvers with different matchLabels": {
+			spec: &cmacme.ACMEIssuer{
+				Email:      "valid-email",
+				Server:     "valid-server",
+				PrivateKey: validSecretKeyRef,
+				Solvers: []cmacme.ACMEChallengeSolver{
+					selectedHTTP01Solver(&cmacme.CertificateDNSNameSelector{
+						MatchLabels: map[string]string{"team": "a"},
+						DNSZones:    []string{"example.com"},
+					}),
+					selectedHTTP01Solver(&cmacme.CertificateDNSNameSelector{
+						MatchLabels: map[string]string{"team": "b"},
+						DNSZones:    []string{"example.com"},
+					}),
+				},
+			},
+		},
+		"acme issuer with the same dnsZone in solvers with different dnsNames": {
+			spec: &cmacme.ACMEIssuer{
+				Email:      "valid-email",
+				Server:     "valid-server",
+				PrivateKey: validSecretKeyRef,
+				Solvers: []cmacme.ACMEChallengeSolver{
+					selectedHTTP01Solver(&cmacme.CertificateDNSNameSelector{
+						DNSNames: []string{"a.example.com"},
+						DNSZones: []string{"example.com"},
+					}),
+					selectedHTTP01Solver(&cmacme.CertificateDNSNameSelector{
+						DNSZones: []string{"example.com"},
+					}),
+				},
+			},
+		},
+		"acme issuer with the same dnsZone in solvers of different types": {
+			spec: &cmacme.ACMEIssuer{
+				Email:      "valid-email",
+				Server:     "valid-server",
+				PrivateKey: validSecretKeyRef,
+				Solvers: []cmacme.ACMEChallengeSolver{
+					selectedHTTP01Solver(&cmacme.CertificateDNSNameSelector{
+						DNSZones: []string{"example.com"},
+					}),
+					{
+						Selector: &cmacme.CertificateDNSNameSelector{
+							DNSZones: []string{"example.com"},
+						},
+						TLSALPN01: &cmacme.ACMEChallengeSolverTLSALPN01{},
+					},
+				},
+			},
+		},
+		"acme issuer with distinct dnsZones in solvers": {
+			spec: &cmacme.ACMEIssuer{
+				Email:      "valid-email",
+				Server:     "valid-server",
+				PrivateKey: validSecretKeyRef,
+				Solvers: []cmacme.ACMEChallengeSolver{
+					selectedHTTP01Solver(&cmacme.CertificateDNSNameSelector{
+						DNSZones: []string{"example.com"},
+					}),
+					selectedHTTP01Solver(&cmacme.CertificateDNSNameSelector{
+						DNSZones: []string{"sub.example.com"},
+					}),
+				},
+			},
+		},
 	}
 	for n, s := range scenarios {
 		t.Run(n, func(t *testing.T) {
+			defer featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultMutableFeatureGate, feature.CertificateRevocation, s.certificateRevocationFeatureEnabled)()
 			errs, warnings := ValidateACMEIssuerConfig(s.spec, fldPath)
 			if len(errs) != len(s.errs) {
 				t.Errorf("Expected %v but got %v", s.errs, errs)
@@ -698,6 +1019,36 @@ func TestValidateACMEIssuerConfig(t *testing.T) {
 	}
 }
 
+func selectedHTTP01Solver(selector *cmacme.CertificateDNSNameSelector) cmacme.ACMEChallengeSolver {
+	return cmacme.ACMEChallengeSolver{
+		Selector: selector,
+		HTTP01: &cmacme.ACMEChallengeSolverHTTP01{
+			Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{},
+		},
+	}
+}
+
+func crlDistributionPointsIssuerSpec(crlURLs ...string) cmapi.IssuerSpec {
+	return cmapi.IssuerSpec{
+		IssuerConfig: cmapi.IssuerConfig{
+			CA: &cmapi.CAIssuer{
+				SecretName:            "valid",
+				CRLDistributionPoints: crlURLs,
+			},
+		},
+	}
+}
+
+func http01IngressIssuerSpec(ingress cmacme.ACMEChallengeSolverHTTP01Ingress) cmapi.IssuerSpec {
+	acme := validACMEIssuer
+	acme.Solvers = []cmacme.ACMEChallengeSolver{{
+		HTTP01: &cmacme.ACMEChallengeSolverHTTP01{Ingress: &ingress},
+	}}
+	return cmapi.IssuerSpec{
+		IssuerConfig: cmapi.IssuerConfig{ACME: &acme},
+	}
+}
+
 func TestValidateIssuerSpec(t *testing.T) {
 	fldPath := (*field.Path)(nil)
 
@@ -705,6 +1056,8 @@ func TestValidateIssuerSpec(t *testing.T) {
 		spec     *cmapi.IssuerSpec
 		errs     field.ErrorList
 		warnings []string
+
+		issuerCertificateDefaultsFeatureEnabled bool
 	}{
 		"valid ca issuer": {
 			spec: &cmapi.IssuerSpec{
@@ -724,6 +1077,16 @@ func TestValidateIssuerSpec(t *testing.T) {
 			},
 			errs: []*field.Error{field.Required(fldPath.Child("ca", "secretName"), "")},
 		},
+		"ca issuer with a secret name referencing a Secret in another namespace": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					CA: &cmapi.CAIssuer{SecretName: "other-namespace/ca-key-pair"},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("ca", "secretName"), "other-namespace/ca-key-pair", "must be the name of a Secret in the same namespace, references to Secrets in other namespaces are not supported"),
+			},
+		},
 		"valid self signed issuer": {
 			spec: &cmapi.IssuerSpec{
 				IssuerConfig: cmapi.IssuerConfig{
@@ -817,42 +1180,249 @@ func TestValidateIssuerSpec(t *testing.T) {
 				field.Invalid(fldPath.Child("ca", "issuingCertificateURLs").Index(0), "", `must be a valid URL`),
 			},
 		},
-	}
-	for n, s := range scenarios {
-		t.Run(n, func(t *testing.T) {
-			gotErrs, warnings := ValidateIssuerSpec(s.spec, fldPath)
-			assert.Equal(t, s.errs, gotErrs)
-			assert.Equal(t, s.warnings, warnings)
-		})
-	}
-}
-
-func TestValidateACMEIssuerHTTP01Config(t *testing.T) {
-	fldPath := (*field.Path)(nil)
-
-	scenarios := map[string]struct {
-		isExpectedFailure bool
-		cfg               *cmacme.ACMEChallengeSolverHTTP01
-		errs              []*field.Error
-	}{
-		"ingress field specified": {
-			cfg: &cmacme.ACMEChallengeSolverHTTP01{
-				Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{Name: "abc"},
+		"valid caExpiryPolicy": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					CA: &cmapi.CAIssuer{
+						SecretName:     "valid",
+						CAExpiryPolicy: cmapi.CAExpiryPolicyClamp,
+					},
+				},
 			},
+			errs: []*field.Error{},
 		},
-		"ingress class field specified": {
-			cfg: &cmacme.ACMEChallengeSolverHTTP01{
-				Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{Class: ptr.To("abc")},
+		"invalid caExpiryPolicy": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					CA: &cmapi.CAIssuer{
+						SecretName:     "valid",
+						CAExpiryPolicy: "true",
+					},
+				},
 			},
-		},
-		"ingressClassName field specified": {
-			cfg: &cmacme.ACMEChallengeSolverHTTP01{
-				Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{IngressClassName: ptr.To("abc")},
+			errs: []*field.Error{
+				field.NotSupported(fldPath.Child("ca", "caExpiryPolicy"), cmapi.CAExpiryPolicy("true"), []string{"Ignore", "Clamp", "Reject"}),
 			},
 		},
-		"neither field specified": {
-			cfg: &cmacme.ACMEChallengeSolverHTTP01{
-				Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{},
+		"invalid serialNumberStrategy": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					CA: &cmapi.CAIssuer{
+						SecretName:           "valid",
+						SerialNumberStrategy: "incrementing",
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.NotSupported(fldPath.Child("ca", "serialNumberStrategy"), cmapi.CASerialNumberStrategy("incrementing"), []string{"random", "sequential"}),
+			},
+		},
+		"valid signatureAlgorithm": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					CA: &cmapi.CAIssuer{
+						SecretName:         "valid",
+						SignatureAlgorithm: "SHA384WithRSA",
+					},
+				},
+			},
+			errs: []*field.Error{},
+		},
+		"invalid signatureAlgorithm": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					CA: &cmapi.CAIssuer{
+						SecretName:         "valid",
+						SignatureAlgorithm: "SHA1WithRSA",
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.NotSupported(fldPath.Child("ca", "signatureAlgorithm"), "SHA1WithRSA", pki.SignatureAlgorithmNames()),
+			},
+		},
+		"valid authorityKeyIdentifier": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					CA: &cmapi.CAIssuer{
+						SecretName:             "valid",
+						AuthorityKeyIdentifier: "0a:1b:2c:3d",
+					},
+				},
+			},
+			errs: []*field.Error{},
+		},
+		"invalid authorityKeyIdentifier": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					CA: &cmapi.CAIssuer{
+						SecretName:             "valid",
+						AuthorityKeyIdentifier: "not-hex",
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("ca", "authorityKeyIdentifier"), "not-hex", "key identifier must be hex encoded: encoding/hex: invalid byte: U+006E 'n'"),
+			},
+		},
+		"invalid allowedExtraExtensions": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					CA: &cmapi.CAIssuer{
+						SecretName:             "valid",
+						AllowedExtraExtensions: []string{"1.3.6.1.4.1.11129.2.1.17", "not-an-oid"},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("ca", "allowedExtraExtensions").Index(1), "not-an-oid", "oid syntax invalid"),
+			},
+		},
+		"allowedExtraExtensions containing extensions managed by cert-manager": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					CA: &cmapi.CAIssuer{
+						SecretName:             "valid",
+						AllowedExtraExtensions: []string{"2.5.29.19", "2.5.29.17", "2.5.29.30", "2.5.29.37", "1.3.6.1.5.5.7.1.1"},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("ca", "allowedExtraExtensions").Index(0), "2.5.29.19", "extension is managed by cert-manager and cannot be copied from the certificate request"),
+				field.Invalid(fldPath.Child("ca", "allowedExtraExtensions").Index(1), "2.5.29.17", "extension is managed by cert-manager and cannot be copied from the certificate request"),
+				field.Invalid(fldPath.Child("ca", "allowedExtraExtensions").Index(2), "2.5.29.30", "extension is managed by cert-manager and cannot be copied from the certificate request"),
+				field.Invalid(fldPath.Child("ca", "allowedExtraExtensions").Index(3), "2.5.29.37", "extension is managed by cert-manager and cannot be copied from the certificate request"),
+				field.Invalid(fldPath.Child("ca", "allowedExtraExtensions").Index(4), "1.3.6.1.5.5.7.1.1", "extension is managed by cert-manager and cannot be copied from the certificate request"),
+			},
+		},
+		"valid selfSigned issuerDN": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					SelfSigned: &cmapi.SelfSignedIssuer{
+						IssuerDN: "CN=Example Root,O=Example Org,C=GB",
+					},
+				},
+			},
+			errs: []*field.Error{},
+		},
+		"unparseable selfSigned issuerDN": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					SelfSigned: &cmapi.SelfSignedIssuer{
+						IssuerDN: "CN=Example Root,O",
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("selfSigned", "issuerDN"), "CN=Example Root,O", "DN ended with incomplete type, value pair"),
+			},
+		},
+		"selfSigned issuerDN with unrecognized key": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					SelfSigned: &cmapi.SelfSignedIssuer{
+						IssuerDN: "CN=Example Root,FOO=bar",
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("selfSigned", "issuerDN"), "CN=Example Root,FOO=bar", "issuerDN contains unrecognized key with value [bar]"),
+			},
+		},
+		"valid certificate defaults": {
+			issuerCertificateDefaultsFeatureEnabled: true,
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					SelfSigned: &cmapi.SelfSignedIssuer{},
+				},
+				CertificateDefaults: &cmapi.IssuerCertificateDefaults{
+					Duration:    &metav1.Duration{Duration: 30 * 24 * time.Hour},
+					RenewBefore: &metav1.Duration{Duration: 10 * 24 * time.Hour},
+				},
+			},
+			errs: []*field.Error{},
+		},
+		"certificate defaults with the feature gate disabled": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					SelfSigned: &cmapi.SelfSignedIssuer{},
+				},
+				CertificateDefaults: &cmapi.IssuerCertificateDefaults{
+					Duration: &metav1.Duration{Duration: 30 * 24 * time.Hour},
+				},
+			},
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("certificateDefaults"), "feature gate IssuerCertificateDefaults must be enabled"),
+			},
+		},
+		"certificate defaults below the minimum": {
+			issuerCertificateDefaultsFeatureEnabled: true,
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					SelfSigned: &cmapi.SelfSignedIssuer{},
+				},
+				CertificateDefaults: &cmapi.IssuerCertificateDefaults{
+					Duration:    &metav1.Duration{Duration: time.Minute},
+					RenewBefore: &metav1.Duration{Duration: time.Second},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("certificateDefaults", "duration"), time.Minute, "certificate duration must be greater than 1h0m0s"),
+				field.Invalid(fldPath.Child("certificateDefaults", "renewBefore"), time.Second, "certificate renewBefore must be greater than 5m0s"),
+			},
+		},
+		"certificate defaults with renewBefore not less than duration": {
+			issuerCertificateDefaultsFeatureEnabled: true,
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					SelfSigned: &cmapi.SelfSignedIssuer{},
+				},
+				CertificateDefaults: &cmapi.IssuerCertificateDefaults{
+					Duration:    &metav1.Duration{Duration: 24 * time.Hour},
+					RenewBefore: &metav1.Duration{Duration: 24 * time.Hour},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("certificateDefaults", "renewBefore"), 24*time.Hour, "certificate duration 24h0m0s must be greater than renewBefore 24h0m0s"),
+			},
+		},
+	}
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			defer featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultMutableFeatureGate, feature.IssuerCertificateDefaults, s.issuerCertificateDefaultsFeatureEnabled)()
+			gotErrs, warnings := ValidateIssuerSpec(s.spec, fldPath)
+			assert.Equal(t, s.errs, gotErrs)
+			assert.Equal(t, s.warnings, warnings)
+		})
+	}
+}
+
+func TestValidateACMEIssuerHTTP01Config(t *testing.T) {
+	fldPath := (*field.Path)(nil)
+
+	scenarios := map[string]struct {
+		isExpectedFailure bool
+		cfg               *cmacme.ACMEChallengeSolverHTTP01
+		errs              []*field.Error
+	}{
+		"ingress field specified": {
+			cfg: &cmacme.ACMEChallengeSolverHTTP01{
+				Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{Name: "abc"},
+			},
+		},
+		"ingress class field specified": {
+			cfg: &cmacme.ACMEChallengeSolverHTTP01{
+				Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{Class: ptr.To("abc")},
+			},
+		},
+		"ingressClassName field specified": {
+			cfg: &cmacme.ACMEChallengeSolverHTTP01{
+				Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{IngressClassName: ptr.To("abc")},
+			},
+		},
+		"neither field specified": {
+			cfg: &cmacme.ACMEChallengeSolverHTTP01{
+				Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{},
 			},
 		},
 		"no solver config type specified": {
@@ -873,6 +1443,35 @@ func TestValidateACMEIssuerHTTP01Config(t *testing.T) {
 				field.Forbidden(fldPath.Child("ingress"), "only one of 'ingressClassName', 'name' or 'class' should be specified"),
 			},
 		},
+		"ingressClassName with valid ingress template labels and annotations": {
+			cfg: &cmacme.ACMEChallengeSolverHTTP01{
+				Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{
+					IngressClassName: ptr.To("internal"),
+					IngressTemplate: &cmacme.ACMEChallengeSolverHTTP01IngressTemplate{
+						ACMEChallengeSolverHTTP01IngressObjectMeta: cmacme.ACMEChallengeSolverHTTP01IngressObjectMeta{
+							Labels:      map[string]string{"environment": "staging"},
+							Annotations: map[string]string{"haproxy.org/ssl-redirect": "false"},
+						},
+					},
+				},
+			},
+		},
+		"invalid ingress template labels and annotations": {
+			cfg: &cmacme.ACMEChallengeSolverHTTP01{
+				Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{
+					IngressTemplate: &cmacme.ACMEChallengeSolverHTTP01IngressTemplate{
+						ACMEChallengeSolverHTTP01IngressObjectMeta: cmacme.ACMEChallengeSolverHTTP01IngressObjectMeta{
+							Labels:      map[string]string{"environment": "not a valid value"},
+							Annotations: map[string]string{"not/a/valid/key": "value"},
+						},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("ingress", "ingressTemplate", "metadata", "labels"), "not a valid value", "a valid label must be an empty string or consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyValue',  or 'my_value',  or '12345', regex used for validation is '(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])?')"),
+				field.Invalid(fldPath.Child("ingress", "ingressTemplate", "metadata", "annotations"), "not/a/valid/key", "a qualified name must consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyName',  or 'my.name',  or '123-abc', regex used for validation is '([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]') with an optional DNS subdomain prefix and '/' (e.g. 'example.com/MyName')"),
+			},
+		},
 		"ingressClassName is invalid": {
 			cfg: &cmacme.ACMEChallengeSolverHTTP01{
 				Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{
@@ -932,6 +1531,47 @@ func TestValidateACMEIssuerHTTP01Config(t *testing.T) {
 	}
 }
 
+func TestValidateACMEIssuerTLSALPN01Config(t *testing.T) {
+	fldPath := (*field.Path)(nil)
+
+	scenarios := map[string]struct {
+		cfg  *cmacme.ACMEChallengeSolverTLSALPN01
+		errs []*field.Error
+	}{
+		"no fields specified": {
+			cfg: &cmacme.ACMEChallengeSolverTLSALPN01{},
+		},
+		"valid serviceType ClusterIP": {
+			cfg: &cmacme.ACMEChallengeSolverTLSALPN01{
+				ServiceType: corev1.ServiceTypeClusterIP,
+			},
+		},
+		"invalid serviceType": {
+			cfg: &cmacme.ACMEChallengeSolverTLSALPN01{
+				ServiceType: corev1.ServiceTypeLoadBalancer,
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("serviceType"), corev1.ServiceTypeLoadBalancer, `must be empty, "ClusterIP" or "NodePort"`),
+			},
+		},
+	}
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			errs := ValidateACMEIssuerChallengeSolverTLSALPN01Config(s.cfg, fldPath)
+			if len(errs) != len(s.errs) {
+				t.Errorf("Expected %v but got %v", s.errs, errs)
+				return
+			}
+			for i, e := range errs {
+				expectedErr := s.errs[i]
+				if !reflect.DeepEqual(e, expectedErr) {
+					t.Errorf("Expected %v but got %v", expectedErr, e)
+				}
+			}
+		})
+	}
+}
+
 func TestValidateACMEIssuerDNS01Config(t *testing.T) {
 	fldPath := field.NewPath("test")
 	scenarios := map[string]struct {
@@ -983,6 +1623,25 @@ func TestValidateACMEIssuerDNS01Config(t *testing.T) {
 				},
 			},
 		},
+		"clouddns private hosted zone visibility": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				CloudDNS: &cmacme.ACMEIssuerDNS01ProviderCloudDNS{
+					Project:              "valid",
+					HostedZoneVisibility: cmacme.CloudDNSZoneVisibilityPrivate,
+				},
+			},
+		},
+		"invalid clouddns hosted zone visibility": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				CloudDNS: &cmacme.ACMEIssuerDNS01ProviderCloudDNS{
+					Project:              "valid",
+					HostedZoneVisibility: "internal",
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("cloudDNS", "hostedZoneVisibility"), cmacme.CloudDNSZoneVisibility("internal"), "must be either empty or one of Public or Private"),
+			},
+		},
 		"missing cloudflare api key fields": {
 			cfg: &cmacme.ACMEChallengeSolverDNS01{
 				Cloudflare: &cmacme.ACMEIssuerDNS01ProviderCloudflare{
@@ -1014,7 +1673,31 @@ func TestValidateACMEIssuerDNS01Config(t *testing.T) {
 				},
 			},
 			errs: []*field.Error{
-				field.Required(fldPath.Child("cloudflare"), "apiKeySecretRef or apiTokenSecretRef is required"),
+				field.Required(fldPath.Child("cloudflare"), "apiKeySecretRef, apiTokenSecretRef or zoneAPITokenSecretRefs is required"),
+			},
+		},
+		"cloudflare per-zone api tokens without a global credential": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				Cloudflare: &cmacme.ACMEIssuerDNS01ProviderCloudflare{
+					ZoneAPITokens: map[string]cmmeta.SecretKeySelector{
+						"example.com": validSecretKeyRef,
+					},
+				},
+			},
+		},
+		"invalid cloudflare per-zone api tokens": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				Cloudflare: &cmacme.ACMEIssuerDNS01ProviderCloudflare{
+					ZoneAPITokens: map[string]cmmeta.SecretKeySelector{
+						"":            validSecretKeyRef,
+						"example.com": {},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("cloudflare", "zoneAPITokenSecretRefs").Key(""), "zone name is required"),
+				field.Required(fldPath.Child("cloudflare", "zoneAPITokenSecretRefs").Key("example.com").Child("name"), "secret name is required"),
+				field.Required(fldPath.Child("cloudflare", "zoneAPITokenSecretRefs").Key("example.com").Child("key"), "secret key is required"),
 			},
 		},
 		"both cloudflare api token and key specified": {
@@ -1095,6 +1778,18 @@ func TestValidateACMEIssuerDNS01Config(t *testing.T) {
 				field.Required(fldPath.Child("route53", "accessKeyIDSecretRef", "key"), "secret key is required"),
 			},
 		},
+		"route53 roleChain with an empty role": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				Route53: &cmacme.ACMEIssuerDNS01ProviderRoute53{
+					Region:    "valid",
+					Role:      "role-a",
+					RoleChain: []string{"role-b", ""},
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("route53", "roleChain").Index(1), "role must not be empty"),
+			},
+		},
 		"missing provider config": {
 			cfg: &cmacme.ACMEChallengeSolverDNS01{},
 			errs: []*field.Error{
@@ -1395,6 +2090,48 @@ func TestValidateACMEIssuerDNS01Config(t *testing.T) {
 			},
 			errs: []*field.Error{},
 		},
+		"valid propagationCheckTimeout": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				PropagationCheckTimeout: &metav1.Duration{Duration: 10 * time.Minute},
+				RFC2136: &cmacme.ACMEIssuerDNS01ProviderRFC2136{
+					Nameserver: "127.0.0.1",
+				},
+			},
+			errs: []*field.Error{},
+		},
+		"negative propagationCheckTimeout": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				PropagationCheckTimeout: &metav1.Duration{Duration: -time.Minute},
+				RFC2136: &cmacme.ACMEIssuerDNS01ProviderRFC2136{
+					Nameserver: "127.0.0.1",
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("propagationCheckTimeout"), -time.Minute, "must not be negative"),
+			},
+		},
+		"webhook provider with timeout": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				Webhook: &cmacme.ACMEIssuerDNS01ProviderWebhook{
+					GroupName:  "acme.example.com",
+					SolverName: "example",
+					Timeout:    &metav1.Duration{Duration: 30 * time.Second},
+				},
+			},
+			errs: []*field.Error{},
+		},
+		"webhook provider with negative timeout": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				Webhook: &cmacme.ACMEIssuerDNS01ProviderWebhook{
+					GroupName:  "acme.example.com",
+					SolverName: "example",
+					Timeout:    &metav1.Duration{Duration: -time.Second},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("webhook", "timeout"), -time.Second, "must not be negative"),
+			},
+		},
 		"rfc2136 provider with unenclosed IPv6 nameserver": {
 			cfg: &cmacme.ACMEChallengeSolverDNS01{
 				RFC2136: &cmacme.ACMEIssuerDNS01ProviderRFC2136{
@@ -1458,6 +2195,33 @@ func TestValidateACMEIssuerDNS01Config(t *testing.T) {
 			},
 			errs: []*field.Error{},
 		},
+		"rfc2136 provider using HMACSHA384 algorithm": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				RFC2136: &cmacme.ACMEIssuerDNS01ProviderRFC2136{
+					Nameserver:    "127.0.0.1",
+					TSIGAlgorithm: "HMACSHA384",
+				},
+			},
+			errs: []*field.Error{},
+		},
+		"rfc2136 provider using RFC 8945 algorithm name": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				RFC2136: &cmacme.ACMEIssuerDNS01ProviderRFC2136{
+					Nameserver:    "127.0.0.1",
+					TSIGAlgorithm: "hmac-sha512",
+				},
+			},
+			errs: []*field.Error{},
+		},
+		"rfc2136 provider using fully qualified RFC 8945 algorithm name": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				RFC2136: &cmacme.ACMEIssuerDNS01ProviderRFC2136{
+					Nameserver:    "127.0.0.1",
+					TSIGAlgorithm: "hmac-sha384.",
+				},
+			},
+			errs: []*field.Error{},
+		},
 		"rfc2136 provider using unsupported algorithm": {
 			cfg: &cmacme.ACMEChallengeSolverDNS01{
 				RFC2136: &cmacme.ACMEIssuerDNS01ProviderRFC2136{
@@ -1569,6 +2333,24 @@ func TestValidateSecretKeySelector(t *testing.T) {
 				field.Required(fldPath.Child("key"), "secret key is required"),
 			},
 		},
+		"invalid name": {
+			selector: &cmmeta.SecretKeySelector{
+				LocalObjectReference: cmmeta.LocalObjectReference{Name: "Invalid_Name"},
+				Key:                  validKey,
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("name"), "Invalid_Name", "a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')"),
+			},
+		},
+		"name referencing a Secret in another namespace": {
+			selector: &cmmeta.SecretKeySelector{
+				LocalObjectReference: cmmeta.LocalObjectReference{Name: "other-namespace/name"},
+				Key:                  validKey,
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("name"), "other-namespace/name", "must be the name of a Secret in the same namespace, references to Secrets in other namespaces are not supported"),
+			},
+		},
 	}
 	for n, s := range scenarios {
 		t.Run(n, func(t *testing.T) {
@@ -1632,6 +2414,27 @@ func TestValidateVenafiIssuerConfig(t *testing.T) {
 				field.Forbidden(fldPath, "please supply one of: tpp, cloud"),
 			},
 		},
+		"valid credential check interval": {
+			cfg: &cmapi.VenafiIssuer{
+				Zone: "a\\b\\c",
+				TPP: &cmapi.VenafiTPP{
+					URL: "https://tpp.example.com/vedsdk",
+				},
+				CredentialCheckInterval: &metav1.Duration{Duration: time.Hour},
+			},
+		},
+		"non-positive credential check interval": {
+			cfg: &cmapi.VenafiIssuer{
+				Zone: "a\\b\\c",
+				TPP: &cmapi.VenafiTPP{
+					URL: "https://tpp.example.com/vedsdk",
+				},
+				CredentialCheckInterval: &metav1.Duration{},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("credentialCheckInterval"), time.Duration(0), "must be greater than zero"),
+			},
+		},
 	}
 
 	for n, s := range scenarios {
@@ -1650,6 +2453,133 @@ func TestValidateVenafiIssuerConfig(t *testing.T) {
 	}
 }
 
+func TestValidateSigningWebhookIssuerConfig(t *testing.T) {
+	fldPath := field.NewPath("test")
+	scenarios := map[string]struct {
+		cfg  *cmapi.SigningWebhookIssuer
+		errs []*field.Error
+	}{
+		"valid": {
+			cfg: &cmapi.SigningWebhookIssuer{
+				URL: "https://signer.example.com/sign",
+			},
+		},
+		"valid with client certificate": {
+			cfg: &cmapi.SigningWebhookIssuer{
+				URL:                 "https://signer.example.com/sign",
+				ClientCertSecretRef: &validSecretKeyRef,
+				ClientKeySecretRef:  &validSecretKeyRef,
+			},
+		},
+		"missing url": {
+			cfg: &cmapi.SigningWebhookIssuer{},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("url"), ""),
+			},
+		},
+		"non-https url": {
+			cfg: &cmapi.SigningWebhookIssuer{
+				URL: "http://signer.example.com/sign",
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("url"), "http://signer.example.com/sign", "must be an absolute https URL"),
+			},
+		},
+		"invalid caBundle": {
+			cfg: &cmapi.SigningWebhookIssuer{
+				URL:      "https://signer.example.com/sign",
+				CABundle: []byte("not a certificate"),
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("caBundle"), "<snip>", "cert bundle didn't contain any valid certificates"),
+			},
+		},
+		"client certificate without a client key": {
+			cfg: &cmapi.SigningWebhookIssuer{
+				URL:                 "https://signer.example.com/sign",
+				ClientCertSecretRef: &validSecretKeyRef,
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("clientKeySecretRef"), "<snip>", "clientKeySecretRef must be provided when defining the clientCertSecretRef"),
+			},
+		},
+	}
+
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			errs := ValidateSigningWebhookIssuerConfig(s.cfg, fldPath)
+			if len(errs) != len(s.errs) {
+				t.Fatalf("Expected %v but got %v", s.errs, errs)
+			}
+			for i, e := range errs {
+				expectedErr := s.errs[i]
+				if !reflect.DeepEqual(e, expectedErr) {
+					t.Errorf("Expected %v but got %v", expectedErr, e)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateKubernetesCSRIssuerConfig(t *testing.T) {
+	fldPath := field.NewPath("test")
+	scenarios := map[string]struct {
+		cfg  *cmapi.KubernetesCSRIssuer
+		errs []*field.Error
+	}{
+		"valid": {
+			cfg: &cmapi.KubernetesCSRIssuer{
+				SignerName: "kubernetes.io/kubelet-serving",
+			},
+		},
+		"missing signerName": {
+			cfg: &cmapi.KubernetesCSRIssuer{},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("signerName"), ""),
+			},
+		},
+		"signerName without a path": {
+			cfg: &cmapi.KubernetesCSRIssuer{
+				SignerName: "example.com",
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("signerName"), "example.com", "must be a fully qualified domain and path of the form 'example.com/signer-name'"),
+			},
+		},
+		"signerName with an invalid domain": {
+			cfg: &cmapi.KubernetesCSRIssuer{
+				SignerName: "Example_Com/signer",
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("signerName"), "Example_Com/signer", "a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')"),
+			},
+		},
+		"cert-manager signerName": {
+			cfg: &cmapi.KubernetesCSRIssuer{
+				SignerName: "issuers.cert-manager.io/default.ca-issuer",
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("signerName"), "issuers.cert-manager.io/default.ca-issuer", "must not reference a cert-manager signer"),
+			},
+		},
+	}
+
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			errs := ValidateKubernetesCSRIssuerConfig(s.cfg, fldPath)
+			if len(errs) != len(s.errs) {
+				t.Fatalf("Expected %v but got %v", s.errs, errs)
+			}
+			for i, e := range errs {
+				expectedErr := s.errs[i]
+				if !reflect.DeepEqual(e, expectedErr) {
+					t.Errorf("Expected %v but got %v", expectedErr, e)
+				}
+			}
+		})
+	}
+}
+
 func TestValidateVenafiTPP(t *testing.T) {
 	caBundle := unitcrypto.MustCreateCryptoBundle(t,
 		&pubcmapi.Certificate{Spec: pubcmapi.CertificateSpec{CommonName: "test"}},
@@ -1686,6 +2616,116 @@ func TestValidateVenafiTPP(t *testing.T) {
 				field.Forbidden(fldPath, "may not specify more than one of caBundle/caBundleSecretRef as TPP CA Bundle"),
 			},
 		},
+		"venafi TPP issuer with credentialsRef referencing a Secret in another namespace": {
+			cfg: &cmapi.VenafiTPP{
+				URL:            "https://tpp.example.com/vedsdk",
+				CredentialsRef: cmmeta.LocalObjectReference{Name: "other-namespace/credentials"},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("credentialsRef", "name"), "other-namespace/credentials", "must be the name of a Secret in the same namespace, references to Secrets in other namespaces are not supported"),
+			},
+		},
+		"venafi TPP issuer with caBundleSecretRef which is not a valid Secret name": {
+			cfg: &cmapi.VenafiTPP{
+				URL: "https://tpp.example.com/vedsdk",
+				CABundleSecretRef: &cmmeta.SecretKeySelector{
+					Key:                  "ca.crt",
+					LocalObjectReference: cmmeta.LocalObjectReference{Name: "Invalid_Name"},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("caBundleSecretRef", "name"), "Invalid_Name", "a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')"),
+			},
+		},
+		"venafi TPP issuer with a client certificate and key": {
+			cfg: &cmapi.VenafiTPP{
+				URL: "https://tpp.example.com/vedsdk",
+				ClientCertSecretRef: &cmmeta.SecretKeySelector{
+					LocalObjectReference: cmmeta.LocalObjectReference{Name: "client-certificate"},
+				},
+				ClientKeySecretRef: &cmmeta.SecretKeySelector{
+					LocalObjectReference: cmmeta.LocalObjectReference{Name: "client-certificate"},
+				},
+			},
+		},
+		"venafi TPP issuer with a client certificate but no key": {
+			cfg: &cmapi.VenafiTPP{
+				URL: "https://tpp.example.com/vedsdk",
+				ClientCertSecretRef: &cmmeta.SecretKeySelector{
+					LocalObjectReference: cmmeta.LocalObjectReference{Name: "client-certificate"},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("clientKeySecretRef"), "<snip>", "clientKeySecretRef must be provided when defining the clientCertSecretRef"),
+			},
+		},
+		"venafi TPP issuer with a client key but no certificate": {
+			cfg: &cmapi.VenafiTPP{
+				URL: "https://tpp.example.com/vedsdk",
+				ClientKeySecretRef: &cmmeta.SecretKeySelector{
+					LocalObjectReference: cmmeta.LocalObjectReference{Name: "client-certificate"},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("clientCertSecretRef"), "<snip>", "clientCertSecretRef must be provided when defining the clientKeySecretRef"),
+			},
+		},
+		"venafi TPP issuer with a pickup timeout": {
+			cfg: &cmapi.VenafiTPP{
+				URL:           "https://tpp.example.com/vedsdk",
+				PickupTimeout: &metav1.Duration{Duration: 90 * time.Second},
+			},
+		},
+		"venafi TPP issuer with a zero pickup timeout": {
+			cfg: &cmapi.VenafiTPP{
+				URL:           "https://tpp.example.com/vedsdk",
+				PickupTimeout: &metav1.Duration{},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("pickupTimeout"), time.Duration(0), "must be greater than zero"),
+			},
+		},
+		"venafi TPP issuer with a pickup timeout greater than the controller sync timeout": {
+			cfg: &cmapi.VenafiTPP{
+				URL:           "https://tpp.example.com/vedsdk",
+				PickupTimeout: &metav1.Duration{Duration: 3 * time.Minute},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("pickupTimeout"), 3*time.Minute, "must not be greater than 2m0s"),
+			},
+		},
+		"venafi TPP issuer with custom fields": {
+			cfg: &cmapi.VenafiTPP{
+				URL: "https://tpp.example.com/vedsdk",
+				CustomFields: []cmapi.VenafiCustomField{
+					{Name: "Cost Center", Value: "1234"},
+					{Name: "App ID", Required: true},
+				},
+			},
+		},
+		"venafi TPP issuer with a custom field without a name": {
+			cfg: &cmapi.VenafiTPP{
+				URL: "https://tpp.example.com/vedsdk",
+				CustomFields: []cmapi.VenafiCustomField{
+					{Value: "1234"},
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("customFields").Index(0).Child("name"), ""),
+			},
+		},
+		"venafi TPP issuer with duplicate custom fields": {
+			cfg: &cmapi.VenafiTPP{
+				URL: "https://tpp.example.com/vedsdk",
+				CustomFields: []cmapi.VenafiCustomField{
+					{Name: "Cost Center", Value: "1234"},
+					{Name: "Cost Center", Value: "5678"},
+				},
+			},
+			errs: []*field.Error{
+				field.Duplicate(fldPath.Child("customFields").Index(1).Child("name"), "Cost Center"),
+			},
+		},
 	}
 
 	for n, s := range scenarios {
@@ -1710,7 +2750,55 @@ func TestValidateIssuer(t *testing.T) {
 		a         *admissionv1.AdmissionRequest
 		expectedE []*field.Error
 		expectedW []string
-	}{}
+	}{
+		"selfSigned issuer": {
+			cfg: &cmapi.Issuer{
+				Spec: cmapi.IssuerSpec{IssuerConfig: cmapi.IssuerConfig{SelfSigned: &cmapi.SelfSignedIssuer{}}},
+			},
+		},
+		"invalid crlDistributionPoints": {
+			cfg: &cmapi.Issuer{
+				Spec: crlDistributionPointsIssuerSpec(
+					"http://crl.example.com/${issuerName}.crl",
+					"",
+					"ldap:///cn=${issuerName}",
+					"http://crl.example.com/${namespace}.crl",
+					"${serial}.crl",
+				),
+			},
+			expectedE: []*field.Error{
+				field.Invalid(field.NewPath("spec", "ca", "crlDistributionPoints").Index(1), "", `must be a valid URL`),
+				field.Invalid(field.NewPath("spec", "ca", "crlDistributionPoints").Index(3), "http://crl.example.com/${namespace}.crl", `references unknown variables ["namespace"], only ${serial} and ${issuerName} are supported`),
+				field.Invalid(field.NewPath("spec", "ca", "crlDistributionPoints").Index(4), "${serial}.crl", `rendered to "1.crl", which is not an absolute URL`),
+			},
+		},
+		"class and ingressClassName fields specified": {
+			cfg: &cmapi.Issuer{
+				Spec: http01IngressIssuerSpec(cmacme.ACMEChallengeSolverHTTP01Ingress{Class: ptr.To("abc"), IngressClassName: ptr.To("abc")}),
+			},
+			expectedE: []*field.Error{
+				field.Forbidden(field.NewPath("spec", "acme", "solvers").Index(0).Child("http01", "ingress"), "only one of 'ingressClassName', 'name' or 'class' should be specified"),
+			},
+		},
+		"name and class fields specified": {
+			cfg: &cmapi.Issuer{
+				Spec: http01IngressIssuerSpec(cmacme.ACMEChallengeSolverHTTP01Ingress{Name: "abc", Class: ptr.To("abc")}),
+			},
+			expectedE: []*field.Error{
+				field.Forbidden(field.NewPath("spec", "acme", "solvers").Index(0).Child("http01", "ingress"), "only one of 'ingressClassName', 'name' or 'class' should be specified"),
+			},
+		},
+		"kubernetesCSR issuer is only supported by ClusterIssuers": {
+			cfg: &cmapi.Issuer{
+				Spec: cmapi.IssuerSpec{IssuerConfig: cmapi.IssuerConfig{
+					KubernetesCSR: &cmapi.KubernetesCSRIssuer{SignerName: "example.com/signer"},
+				}},
+			},
+			expectedE: []*field.Error{
+				field.Forbidden(field.NewPath("spec", "kubernetesCSR"), "kubernetesCSR is only supported by ClusterIssuers"),
+			},
+		},
+	}
 
 	for n, s := range scenarios {
 		t.Run(n, func(t *testing.T) {
@@ -1746,15 +2834,54 @@ func TestUpdateValidateIssuer(t *testing.T) {
 		Spec: baseIssuerConfig,
 	}
 	scenarios := map[string]struct {
+		oldIss    *cmapi.Issuer
 		iss       *cmapi.Issuer
 		a         *admissionv1.AdmissionRequest
 		expectedE []*field.Error
 		expectedW []string
-	}{}
+	}{
+		"invalid crlDistributionPoints which are not changed are allowed": {
+			oldIss: &cmapi.Issuer{Spec: crlDistributionPointsIssuerSpec("${serial}.crl")},
+			iss:    &cmapi.Issuer{Spec: crlDistributionPointsIssuerSpec("${serial}.crl")},
+		},
+		"changed crlDistributionPoints are validated": {
+			oldIss: &cmapi.Issuer{Spec: crlDistributionPointsIssuerSpec("${serial}.crl")},
+			iss:    &cmapi.Issuer{Spec: crlDistributionPointsIssuerSpec("${serial}.crl", "http://crl.example.com/${namespace}.crl")},
+			expectedE: []*field.Error{
+				field.Invalid(field.NewPath("spec", "ca", "crlDistributionPoints").Index(0), "${serial}.crl", `rendered to "1.crl", which is not an absolute URL`),
+				field.Invalid(field.NewPath("spec", "ca", "crlDistributionPoints").Index(1), "http://crl.example.com/${namespace}.crl", `references unknown variables ["namespace"], only ${serial} and ${issuerName} are supported`),
+			},
+		},
+		"class and ingressClassName fields which are not changed are allowed": {
+			oldIss: &cmapi.Issuer{Spec: http01IngressIssuerSpec(cmacme.ACMEChallengeSolverHTTP01Ingress{Class: ptr.To("abc"), IngressClassName: ptr.To("abc")})},
+			iss:    &cmapi.Issuer{Spec: http01IngressIssuerSpec(cmacme.ACMEChallengeSolverHTTP01Ingress{Class: ptr.To("abc"), IngressClassName: ptr.To("abc")})},
+		},
+		"changed class and ingressClassName fields are validated": {
+			oldIss: &cmapi.Issuer{Spec: http01IngressIssuerSpec(cmacme.ACMEChallengeSolverHTTP01Ingress{Class: ptr.To("abc")})},
+			iss:    &cmapi.Issuer{Spec: http01IngressIssuerSpec(cmacme.ACMEChallengeSolverHTTP01Ingress{Class: ptr.To("abc"), IngressClassName: ptr.To("abc")})},
+			expectedE: []*field.Error{
+				field.Forbidden(field.NewPath("spec", "acme", "solvers").Index(0).Child("http01", "ingress"), "only one of 'ingressClassName', 'name' or 'class' should be specified"),
+			},
+		},
+		"updating to a kubernetesCSR issuer is forbidden": {
+			iss: &cmapi.Issuer{
+				Spec: cmapi.IssuerSpec{IssuerConfig: cmapi.IssuerConfig{
+					KubernetesCSR: &cmapi.KubernetesCSRIssuer{SignerName: "example.com/signer"},
+				}},
+			},
+			expectedE: []*field.Error{
+				field.Forbidden(field.NewPath("spec", "kubernetesCSR"), "kubernetesCSR is only supported by ClusterIssuers"),
+			},
+		},
+	}
 
 	for n, s := range scenarios {
 		t.Run(n, func(t *testing.T) {
-			gotE, gotW := ValidateUpdateIssuer(s.a, &baseIssuer, s.iss)
+			oldIss := s.oldIss
+			if oldIss == nil {
+				oldIss = &baseIssuer
+			}
+			gotE, gotW := ValidateUpdateIssuer(s.a, oldIss, s.iss)
 			if len(gotE) != len(s.expectedE) {
 				t.Fatalf("Expected errors %v but got %v", s.expectedE, gotE)
 			}
@@ -21,4 +21,10 @@ package validation
 const (
 	// deprecatedACMEEABKeyAlgorithmField is raised when the deprecated keyAlgorithm field for an ACME issuer's external account binding (EAB) is set.
 	deprecatedACMEEABKeyAlgorithmField = "ACME issuer spec field 'externalAccount.keyAlgorithm' is deprecated. The value of this field will be ignored."
+	// ambiguousACMESolverDNSZone is raised when a DNS zone is listed by more than one ACME solver of the same type with otherwise identical selectors.
+	ambiguousACMESolverDNSZone = "DNS zone %q in ACME issuer spec field '%s' is also listed by '%s' with an otherwise identical selector. Only the earlier solver will be used for names in this zone."
+	// revokeOnDeleteWithoutCertificateRevocation is raised when an ACME issuer sets revokeOnDelete while the CertificateRevocation feature gate is disabled.
+	revokeOnDeleteWithoutCertificateRevocation = "ACME issuer spec field 'revokeOnDelete' has no effect unless the CertificateRevocation feature gate is enabled. Certificates will not be revoked when they are deleted."
+	// nonCriticalNameConstraints is raised when a Certificate requests name constraints without marking them critical.
+	nonCriticalNameConstraints = "Certificate spec field 'nameConstraints.critical' is false. RFC 5280 requires conforming CAs to mark the name constraints extension as critical."
 )
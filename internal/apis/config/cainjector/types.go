@@ -17,6 +17,8 @@ limitations under the License.
 package cainjector
 
 import (
+	"time"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	logsapi "k8s.io/component-base/logs/api/v1"
 
@@ -47,6 +49,11 @@ type CAInjectorConfiguration struct {
 	// cert-manager resources as potential targets for CA data injection.
 	EnableInjectableConfig EnableInjectableConfig
 
+	// ResyncInterval is how often cainjector re-verifies and re-injects the CA
+	// data of each injectable, even if no watch event has been received.
+	// If zero, injectables are only reconciled in response to watch events.
+	ResyncInterval time.Duration
+
 	// Enable profiling for cainjector.
 	EnablePprof bool
 
@@ -89,4 +96,14 @@ type EnableInjectableConfig struct {
 	// will spin up a control loop to inject CA data to annotated
 	// APIServices
 	APIServices bool
+
+	// ConfigMaps determines whether cainjector
+	// will spin up a control loop to inject CA data to annotated
+	// ConfigMaps
+	ConfigMaps bool
+
+	// Secrets determines whether cainjector
+	// will spin up a control loop to inject CA data to annotated
+	// Secrets
+	Secrets bool
 }
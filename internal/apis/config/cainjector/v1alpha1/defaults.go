@@ -55,4 +55,10 @@ func SetDefaults_EnableInjectableConfig(obj *v1alpha1.EnableInjectableConfig) {
 	if obj.APIServices == nil {
 		obj.APIServices = ptr.To(true)
 	}
+	if obj.ConfigMaps == nil {
+		obj.ConfigMaps = ptr.To(false)
+	}
+	if obj.Secrets == nil {
+		obj.Secrets = ptr.To(false)
+	}
 }
@@ -84,6 +84,9 @@ func autoConvert_v1alpha1_CAInjectorConfiguration_To_cainjector_CAInjectorConfig
 	if err := Convert_v1alpha1_EnableInjectableConfig_To_cainjector_EnableInjectableConfig(&in.EnableInjectableConfig, &out.EnableInjectableConfig, s); err != nil {
 		return err
 	}
+	if err := sharedv1alpha1.Convert_Pointer_v1alpha1_Duration_To_time_Duration(&in.ResyncInterval, &out.ResyncInterval, s); err != nil {
+		return err
+	}
 	out.EnablePprof = in.EnablePprof
 	out.PprofAddress = in.PprofAddress
 	out.Logging = in.Logging
@@ -108,6 +111,9 @@ func autoConvert_cainjector_CAInjectorConfiguration_To_v1alpha1_CAInjectorConfig
 	if err := Convert_cainjector_EnableInjectableConfig_To_v1alpha1_EnableInjectableConfig(&in.EnableInjectableConfig, &out.EnableInjectableConfig, s); err != nil {
 		return err
 	}
+	if err := sharedv1alpha1.Convert_time_Duration_To_Pointer_v1alpha1_Duration(&in.ResyncInterval, &out.ResyncInterval, s); err != nil {
+		return err
+	}
 	out.EnablePprof = in.EnablePprof
 	out.PprofAddress = in.PprofAddress
 	out.Logging = in.Logging
@@ -157,6 +163,12 @@ func autoConvert_v1alpha1_EnableInjectableConfig_To_cainjector_EnableInjectableC
 	if err := v1.Convert_Pointer_bool_To_bool(&in.APIServices, &out.APIServices, s); err != nil {
 		return err
 	}
+	if err := v1.Convert_Pointer_bool_To_bool(&in.ConfigMaps, &out.ConfigMaps, s); err != nil {
+		return err
+	}
+	if err := v1.Convert_Pointer_bool_To_bool(&in.Secrets, &out.Secrets, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -178,6 +190,12 @@ func autoConvert_cainjector_EnableInjectableConfig_To_v1alpha1_EnableInjectableC
 	if err := v1.Convert_bool_To_Pointer_bool(&in.APIServices, &out.APIServices, s); err != nil {
 		return err
 	}
+	if err := v1.Convert_bool_To_Pointer_bool(&in.ConfigMaps, &out.ConfigMaps, s); err != nil {
+		return err
+	}
+	if err := v1.Convert_bool_To_Pointer_bool(&in.Secrets, &out.Secrets, s); err != nil {
+		return err
+	}
 	return nil
 }
 
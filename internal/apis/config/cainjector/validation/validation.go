@@ -30,5 +30,9 @@ func ValidateCAInjectorConfiguration(cfg *config.CAInjectorConfiguration, fldPat
 	allErrors = append(allErrors, logsapi.Validate(&cfg.Logging, nil, fldPath.Child("logging"))...)
 	allErrors = append(allErrors, sharedvalidation.ValidateLeaderElectionConfig(&cfg.LeaderElectionConfig, fldPath.Child("leaderElectionConfig"))...)
 
+	if cfg.ResyncInterval < 0 {
+		allErrors = append(allErrors, field.Invalid(fldPath.Child("resyncInterval"), cfg.ResyncInterval, "must not be negative"))
+	}
+
 	return allErrors
 }
@@ -18,6 +18,7 @@ package validation
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -73,6 +74,20 @@ func TestValidateCAInjectorConfiguration(t *testing.T) {
 				}
 			},
 		},
+		{
+			"with negative resync interval",
+			&config.CAInjectorConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				ResyncInterval: -time.Minute,
+			},
+			func(cc *config.CAInjectorConfiguration) field.ErrorList {
+				return field.ErrorList{
+					field.Invalid(field.NewPath("resyncInterval"), cc.ResyncInterval, "must not be negative"),
+				}
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
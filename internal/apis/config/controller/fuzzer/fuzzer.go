@@ -114,6 +114,14 @@ var Funcs = func(codecs runtimeserializer.CodecFactory) []interface{} {
 			if s.ACMEDNS01Config.CheckRetryPeriod == time.Duration(0) {
 				s.ACMEDNS01Config.CheckRetryPeriod = time.Second * 8875
 			}
+
+			if s.VenafiConfig.MaxSetupRetryInterval == time.Duration(0) {
+				s.VenafiConfig.MaxSetupRetryInterval = time.Second * 8875
+			}
+
+			if s.PodIdentityConfig.TrustDomain == "" {
+				s.PodIdentityConfig.TrustDomain = "test-roundtrip"
+			}
 		},
 	}
 }
@@ -0,0 +1,40 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"strings"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+)
+
+// ParseIssuerReference parses an issuer of PodIdentityConfig.AllowedIssuers,
+// given in the form <kind>.<group>/<name>, or <kind>/<name> for
+// cert-manager's own issuer kinds.
+func ParseIssuerReference(s string) (cmmeta.ObjectReference, error) {
+	kindGroup, name, ok := strings.Cut(s, "/")
+	if !ok || kindGroup == "" || name == "" {
+		return cmmeta.ObjectReference{}, fmt.Errorf("invalid issuer %q: must be in the form <kind>.<group>/<name> or <kind>/<name>", s)
+	}
+	kind, group, _ := strings.Cut(kindGroup, ".")
+	if group == "" {
+		group = cmapi.SchemeGroupVersion.Group
+	}
+	return cmmeta.ObjectReference{Name: name, Kind: kind, Group: group}, nil
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+)
+
+func TestParseIssuerReference(t *testing.T) {
+	tests := map[string]struct {
+		in        string
+		expected  cmmeta.ObjectReference
+		expectErr bool
+	}{
+		"cert-manager issuer kind without a group": {
+			in:       "ClusterIssuer/pod-identity",
+			expected: cmmeta.ObjectReference{Name: "pod-identity", Kind: "ClusterIssuer", Group: "cert-manager.io"},
+		},
+		"external issuer kind with a group": {
+			in:       "AWSPCAClusterIssuer.awspca.cert-manager.io/pod-identity",
+			expected: cmmeta.ObjectReference{Name: "pod-identity", Kind: "AWSPCAClusterIssuer", Group: "awspca.cert-manager.io"},
+		},
+		"missing kind": {
+			in:        "pod-identity",
+			expectErr: true,
+		},
+		"missing name": {
+			in:        "Issuer/",
+			expectErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseIssuerReference(test.in)
+			if (err != nil) != test.expectErr {
+				t.Fatalf("expected error=%t, got %v", test.expectErr, err)
+			}
+			if got != test.expected {
+				t.Errorf("expected %+v, got %+v", test.expected, got)
+			}
+		})
+	}
+}
@@ -19,6 +19,7 @@ package controller
 import (
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	logsapi "k8s.io/component-base/logs/api/v1"
 
@@ -81,11 +82,23 @@ type ControllerConfiguration struct {
 	// automatically removed when the certificate resource is deleted.
 	EnableCertificateOwnerRef bool
 
+	// Whether to verify that the certificate chain returned by an issuer is
+	// complete before it is written to the Certificate's Secret. Each
+	// certificate in the chain must be signed by the certificate which follows
+	// it, and the last certificate must be self-signed, signed by the issuer's
+	// CA certificate, or signed by a root in the system trust store. Issuance
+	// of an incomplete chain fails with the reason IncompleteChain.
+	EnableCertificateChainVerification bool
+
 	// Whether gateway API integration is enabled within cert-manager. The
 	// ExperimentalGatewayAPISupport feature gate must also be enabled (default
 	// as of 1.15).
 	EnableGatewayAPI bool
 
+	// Whether to refuse to start if the cryptographic module is not operating
+	// in FIPS 140 mode. If false, only a warning is logged.
+	RequireFIPSMode bool
+
 	// Specify which annotations should/shouldn't be copied from Certificate to
 	// CertificateRequest and Order, as well as from CertificateSigningRequest to
 	// Order, by passing a list of annotation key prefixes. A prefix starting with
@@ -100,6 +113,33 @@ type ControllerConfiguration struct {
 	// The maximum number of challenges that can be scheduled as 'processing' at once.
 	MaxConcurrentChallenges int
 
+	// The maximum number of CertificateRequests which may be processed at
+	// once for each Issuer or ClusterIssuer, keyed by issuer type (one of
+	// "acme", "ca", "selfsigned", "vault" or "venafi"). A CertificateRequest
+	// counts towards the limit from when it is first passed to the issuer
+	// until it is issued, failed or denied. Excess CertificateRequests are
+	// kept queued until capacity is available.
+	// Issuer types which are not listed are not limited.
+	MaxConcurrentCertificateRequestsPerIssuer map[string]int
+
+	// The maximum jitter factor applied to the interval at which pending ACME
+	// Orders are polled. The interval starts at 5 seconds and doubles each time
+	// an Order is found to still be pending, up to 2 minutes. Each requeue is delayed by a random duration of up to
+	// this factor multiplied by the poll interval, spreading out requests to
+	// the ACME server when many Orders are processed at once. 0 disables
+	// jitter.
+	ACMEOrderPollJitter float32
+
+	// The maximum number of new ACME Orders and Authorization requests per
+	// second sent for a single ACME account, shared by all Issuers using the
+	// account. This can be used to avoid tripping the ACME server's rate
+	// limits. 0 disables client-side rate limiting.
+	ACMEAccountQPS float32
+
+	// The maximum burst of new ACME Orders and Authorization requests sent for
+	// a single ACME account when acmeAccountQPS is set.
+	ACMEAccountBurst int
+
 	// The host and port that the metrics endpoint should listen on.
 	MetricsListenAddress string
 
@@ -133,6 +173,13 @@ type ControllerConfiguration struct {
 
 	// ACMEDNS01Config configures the behaviour of the ACME DNS01 challenge solver
 	ACMEDNS01Config ACMEDNS01Config
+
+	// VenafiConfig configures the behaviour of the Venafi issuer
+	VenafiConfig VenafiConfig
+
+	// PodIdentityConfig configures the behaviour of the certificate pod
+	// identity controller
+	PodIdentityConfig PodIdentityConfig
 }
 
 type LeaderElectionConfig struct {
@@ -188,6 +235,19 @@ type ACMEHTTP01Config struct {
 	// issues
 	SolverRunAsNonRoot bool
 
+	// SolverSecurityContext is the container security context applied to the
+	// ACME HTTP01 challenge solver container. If not set, a locked-down
+	// profile suitable for the "restricted" Pod Security Standard is used:
+	// a read-only root filesystem, no privilege escalation and all
+	// capabilities dropped.
+	SolverSecurityContext *corev1.SecurityContext
+
+	// SolverPodSecurityContext is the pod security context applied to ACME
+	// HTTP01 challenge solver pods. If not set, the pod runs with the
+	// RuntimeDefault seccomp profile and runAsNonRoot set according to
+	// SolverRunAsNonRoot.
+	SolverPodSecurityContext *corev1.PodSecurityContext
+
 	// A list of comma separated dns server endpoints used for
 	// ACME HTTP01 check requests. This should be a list containing host and
 	// port, for example ["8.8.8.8:53","8.8.4.4:53"]
@@ -210,6 +270,12 @@ type ACMEDNS01Config struct {
 	// due to caching performed by the recursive nameservers.
 	RecursiveNameserversOnly bool
 
+	// The number of configured recursive nameservers which must agree that the
+	// TXT record is present before the DNS01 self check passes. Only used when
+	// RecursiveNameserversOnly is true. If zero, all of the nameservers must
+	// agree.
+	RecursiveNameserversQuorum int
+
 	// The duration the controller should wait between a propagation check. Despite
 	// the name, this flag is used to configure the wait period for both DNS01 and
 	// HTTP01 challenge propagation checks. For DNS01 challenges the propagation
@@ -218,4 +284,38 @@ type ACMEDNS01Config struct {
 	// token is served at the challenge URL. This should be a valid duration
 	// string, for example 180s or 1h
 	CheckRetryPeriod time.Duration
+
+	// The maximum duration to wait for a DNS01 challenge record to propagate,
+	// measured from when it was presented, before the challenge is
+	// marked as errored. It can be overridden for each DNS01 solver using the
+	// propagationCheckTimeout field. A value of zero disables the timeout.
+	CheckTimeout time.Duration
+}
+
+type VenafiConfig struct {
+	// The maximum duration the controller should wait before retrying the
+	// setup of a Venafi issuer which failed to connect to or authenticate with
+	// the Venafi server. Failed setups are retried with an exponential backoff
+	// which will never exceed this duration. This should be a valid duration
+	// string, for example 180s or 1h. Defaults to 1m, which is lower than the
+	// 5m maximum backoff the issuer controllers use for other errors.
+	MaxSetupRetryInterval time.Duration
+}
+
+type PodIdentityConfig struct {
+	// The issuers which Pods may request a certificate from with the
+	// cert-manager.io/pod-identity-issuer annotations, each in the form
+	// <kind>.<group>/<name>, or <kind>/<name> for cert-manager's own issuer
+	// kinds. For example, ClusterIssuer/pod-identity. Pods referencing any
+	// other issuer do not get a certificate. If empty, no Pod gets a
+	// certificate.
+	// This does not stop Certificates or CertificateRequests created by users
+	// from requesting the SPIFFE URI SAN of any ServiceAccount from these
+	// issuers, so they should be restricted, for example with an approver
+	// policy, to Certificates created for Pods.
+	AllowedIssuers []string
+
+	// The SPIFFE trust domain of the URI SANs identifying the ServiceAccount of
+	// a Pod. Defaults to cluster.local.
+	TrustDomain string
 }
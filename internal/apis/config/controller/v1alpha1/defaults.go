@@ -33,15 +33,24 @@ import (
 	cracmecontroller "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/acme"
 	crapprovercontroller "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/approver"
 	crcacontroller "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/ca"
+	crkubernetescsrcontroller "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/kubernetescsr"
 	crselfsignedcontroller "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/selfsigned"
+	crsigningwebhookcontroller "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/signingwebhook"
 	crvaultcontroller "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/vault"
 	crvenaficontroller "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/venafi"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates/issuing"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates/keymanager"
 	certificatesmetricscontroller "github.com/cert-manager/cert-manager/pkg/controller/certificates/metrics"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates/podidentity"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates/podreadiness"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates/readiness"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates/renewalinfo"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates/requestmanager"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates/revisionmanager"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates/revocation"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates/secretdeletion"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates/secretmirror"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates/servicednsnames"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates/trigger"
 	csracmecontroller "github.com/cert-manager/cert-manager/pkg/controller/certificatesigningrequests/acme"
 	csrcacontroller "github.com/cert-manager/cert-manager/pkg/controller/certificatesigningrequests/ca"
@@ -68,19 +77,27 @@ var (
 	defaultClusterIssuerAmbientCredentials = true
 	defaultIssuerAmbientCredentials        = false
 
-	defaultTLSACMEIssuerName         = ""
-	defaultTLSACMEIssuerKind         = "Issuer"
-	defaultTLSACMEIssuerGroup        = cm.GroupName
-	defaultEnableCertificateOwnerRef = false
-	defaultEnableGatewayAPI          = false
+	defaultTLSACMEIssuerName                  = ""
+	defaultTLSACMEIssuerKind                  = "Issuer"
+	defaultTLSACMEIssuerGroup                 = cm.GroupName
+	defaultEnableCertificateOwnerRef          = false
+	defaultEnableCertificateChainVerification = false
+	defaultEnableGatewayAPI                   = false
+	defaultRequireFIPSMode                    = false
 
-	defaultDNS01RecursiveNameserversOnly = false
-	defaultDNS01RecursiveNameservers     = []string{}
-	defaultDNS01CheckRetryPeriod         = 10 * time.Second
+	defaultDNS01RecursiveNameserversOnly         = false
+	defaultDNS01RecursiveNameserversQuorum int32 = 0
+	defaultDNS01RecursiveNameservers             = []string{}
+	defaultDNS01CheckRetryPeriod                 = 10 * time.Second
+	defaultDNS01CheckTimeout                     = time.Duration(0)
 
 	defaultNumberOfConcurrentWorkers int32 = 5
 	defaultMaxConcurrentChallenges   int32 = 60
 
+	defaultACMEOrderPollJitter float32 = 0
+	defaultACMEAccountQPS      float32 = 0
+	defaultACMEAccountBurst    int32   = 10
+
 	defaultPrometheusMetricsServerAddress = "0.0.0.0:9402"
 
 	defaultHealthzServerAddress = "0.0.0.0:9403"
@@ -100,6 +117,13 @@ var (
 
 	defaultAutoCertificateAnnotations = []string{"kubernetes.io/tls-acme"}
 
+	// This default value is lower than the 5 minute maximum delay used by the
+	// issuer controllers' workqueue rate limiter, so that Venafi issuers
+	// become ready sooner once the Venafi server is reachable again.
+	defaultVenafiMaxSetupRetryInterval = time.Minute
+
+	defaultPodIdentityTrustDomain = "cluster.local"
+
 	AllControllers = []string{
 		issuerscontroller.ControllerName,
 		clusterissuerscontroller.ControllerName,
@@ -111,7 +135,9 @@ var (
 		cracmecontroller.CRControllerName,
 		crapprovercontroller.ControllerName,
 		crcacontroller.CRControllerName,
+		crkubernetescsrcontroller.CRControllerName,
 		crselfsignedcontroller.CRControllerName,
+		crsigningwebhookcontroller.CRControllerName,
 		crvaultcontroller.CRControllerName,
 		crvenaficontroller.CRControllerName,
 		// certificate controllers
@@ -121,6 +147,14 @@ var (
 		requestmanager.ControllerName,
 		readiness.ControllerName,
 		revisionmanager.ControllerName,
+		podreadiness.ControllerName,
+		podidentity.ControllerName,
+		renewalinfo.ControllerName,
+		revocation.ControllerName,
+		revocation.RevokeOnDeleteControllerName,
+		secretdeletion.ControllerName,
+		secretmirror.ControllerName,
+		servicednsnames.ControllerName,
 	}
 
 	DefaultEnabledControllers = []string{
@@ -133,7 +167,9 @@ var (
 		cracmecontroller.CRControllerName,
 		crapprovercontroller.ControllerName,
 		crcacontroller.CRControllerName,
+		crkubernetescsrcontroller.CRControllerName,
 		crselfsignedcontroller.CRControllerName,
+		crsigningwebhookcontroller.CRControllerName,
 		crvaultcontroller.CRControllerName,
 		crvenaficontroller.CRControllerName,
 		// certificate controllers
@@ -143,6 +179,11 @@ var (
 		requestmanager.ControllerName,
 		readiness.ControllerName,
 		revisionmanager.ControllerName,
+		// The revoke-on-delete controller is enabled by default so that it
+		// removes the revoke-on-delete finalizers added while the
+		// CertificateRevocation feature was enabled, if it is disabled.
+		revocation.RevokeOnDeleteControllerName,
+		secretdeletion.ControllerName,
 	}
 
 	ExperimentalCertificateSigningRequestControllers = []string{
@@ -208,10 +249,18 @@ func SetDefaults_ControllerConfiguration(obj *v1alpha1.ControllerConfiguration)
 		obj.EnableCertificateOwnerRef = &defaultEnableCertificateOwnerRef
 	}
 
+	if obj.EnableCertificateChainVerification == nil {
+		obj.EnableCertificateChainVerification = &defaultEnableCertificateChainVerification
+	}
+
 	if obj.EnableGatewayAPI == nil {
 		obj.EnableGatewayAPI = &defaultEnableGatewayAPI
 	}
 
+	if obj.RequireFIPSMode == nil {
+		obj.RequireFIPSMode = &defaultRequireFIPSMode
+	}
+
 	if len(obj.CopiedAnnotationPrefixes) == 0 {
 		obj.CopiedAnnotationPrefixes = defaultCopiedAnnotationPrefixes
 	}
@@ -224,6 +273,18 @@ func SetDefaults_ControllerConfiguration(obj *v1alpha1.ControllerConfiguration)
 		obj.MaxConcurrentChallenges = &defaultMaxConcurrentChallenges
 	}
 
+	if obj.ACMEOrderPollJitter == nil {
+		obj.ACMEOrderPollJitter = &defaultACMEOrderPollJitter
+	}
+
+	if obj.ACMEAccountQPS == nil {
+		obj.ACMEAccountQPS = &defaultACMEAccountQPS
+	}
+
+	if obj.ACMEAccountBurst == nil {
+		obj.ACMEAccountBurst = &defaultACMEAccountBurst
+	}
+
 	if obj.MetricsListenAddress == "" {
 		obj.MetricsListenAddress = defaultPrometheusMetricsServerAddress
 	}
@@ -307,7 +368,27 @@ func SetDefaults_ACMEDNS01Config(obj *v1alpha1.ACMEDNS01Config) {
 		obj.RecursiveNameserversOnly = &defaultDNS01RecursiveNameserversOnly
 	}
 
+	if obj.RecursiveNameserversQuorum == nil {
+		obj.RecursiveNameserversQuorum = &defaultDNS01RecursiveNameserversQuorum
+	}
+
 	if obj.CheckRetryPeriod.IsZero() {
 		obj.CheckRetryPeriod = sharedv1alpha1.DurationFromTime(defaultDNS01CheckRetryPeriod)
 	}
+
+	if obj.CheckTimeout == nil {
+		obj.CheckTimeout = sharedv1alpha1.DurationFromTime(defaultDNS01CheckTimeout)
+	}
+}
+
+func SetDefaults_VenafiConfig(obj *v1alpha1.VenafiConfig) {
+	if obj.MaxSetupRetryInterval.IsZero() {
+		obj.MaxSetupRetryInterval = sharedv1alpha1.DurationFromTime(defaultVenafiMaxSetupRetryInterval)
+	}
+}
+
+func SetDefaults_PodIdentityConfig(obj *v1alpha1.PodIdentityConfig) {
+	if obj.TrustDomain == "" {
+		obj.TrustDomain = defaultPodIdentityTrustDomain
+	}
 }
@@ -27,6 +27,7 @@ import (
 	controller "github.com/cert-manager/cert-manager/internal/apis/config/controller"
 	sharedv1alpha1 "github.com/cert-manager/cert-manager/internal/apis/config/shared/v1alpha1"
 	v1alpha1 "github.com/cert-manager/cert-manager/pkg/apis/config/controller/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
@@ -89,6 +90,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.PodIdentityConfig)(nil), (*controller.PodIdentityConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PodIdentityConfig_To_controller_PodIdentityConfig(a.(*v1alpha1.PodIdentityConfig), b.(*controller.PodIdentityConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controller.PodIdentityConfig)(nil), (*v1alpha1.PodIdentityConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controller_PodIdentityConfig_To_v1alpha1_PodIdentityConfig(a.(*controller.PodIdentityConfig), b.(*v1alpha1.PodIdentityConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.VenafiConfig)(nil), (*controller.VenafiConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VenafiConfig_To_controller_VenafiConfig(a.(*v1alpha1.VenafiConfig), b.(*controller.VenafiConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controller.VenafiConfig)(nil), (*v1alpha1.VenafiConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controller_VenafiConfig_To_v1alpha1_VenafiConfig(a.(*controller.VenafiConfig), b.(*v1alpha1.VenafiConfig), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -97,9 +118,15 @@ func autoConvert_v1alpha1_ACMEDNS01Config_To_controller_ACMEDNS01Config(in *v1al
 	if err := v1.Convert_Pointer_bool_To_bool(&in.RecursiveNameserversOnly, &out.RecursiveNameserversOnly, s); err != nil {
 		return err
 	}
+	if err := sharedv1alpha1.Convert_Pointer_int32_To_int(&in.RecursiveNameserversQuorum, &out.RecursiveNameserversQuorum, s); err != nil {
+		return err
+	}
 	if err := sharedv1alpha1.Convert_Pointer_v1alpha1_Duration_To_time_Duration(&in.CheckRetryPeriod, &out.CheckRetryPeriod, s); err != nil {
 		return err
 	}
+	if err := sharedv1alpha1.Convert_Pointer_v1alpha1_Duration_To_time_Duration(&in.CheckTimeout, &out.CheckTimeout, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -113,9 +140,15 @@ func autoConvert_controller_ACMEDNS01Config_To_v1alpha1_ACMEDNS01Config(in *cont
 	if err := v1.Convert_bool_To_Pointer_bool(&in.RecursiveNameserversOnly, &out.RecursiveNameserversOnly, s); err != nil {
 		return err
 	}
+	if err := sharedv1alpha1.Convert_int_To_Pointer_int32(&in.RecursiveNameserversQuorum, &out.RecursiveNameserversQuorum, s); err != nil {
+		return err
+	}
 	if err := sharedv1alpha1.Convert_time_Duration_To_Pointer_v1alpha1_Duration(&in.CheckRetryPeriod, &out.CheckRetryPeriod, s); err != nil {
 		return err
 	}
+	if err := sharedv1alpha1.Convert_time_Duration_To_Pointer_v1alpha1_Duration(&in.CheckTimeout, &out.CheckTimeout, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -133,6 +166,8 @@ func autoConvert_v1alpha1_ACMEHTTP01Config_To_controller_ACMEHTTP01Config(in *v1
 	if err := v1.Convert_Pointer_bool_To_bool(&in.SolverRunAsNonRoot, &out.SolverRunAsNonRoot, s); err != nil {
 		return err
 	}
+	out.SolverSecurityContext = (*corev1.SecurityContext)(unsafe.Pointer(in.SolverSecurityContext))
+	out.SolverPodSecurityContext = (*corev1.PodSecurityContext)(unsafe.Pointer(in.SolverPodSecurityContext))
 	out.SolverNameservers = *(*[]string)(unsafe.Pointer(&in.SolverNameservers))
 	return nil
 }
@@ -151,6 +186,8 @@ func autoConvert_controller_ACMEHTTP01Config_To_v1alpha1_ACMEHTTP01Config(in *co
 	if err := v1.Convert_bool_To_Pointer_bool(&in.SolverRunAsNonRoot, &out.SolverRunAsNonRoot, s); err != nil {
 		return err
 	}
+	out.SolverSecurityContext = (*corev1.SecurityContext)(unsafe.Pointer(in.SolverSecurityContext))
+	out.SolverPodSecurityContext = (*corev1.PodSecurityContext)(unsafe.Pointer(in.SolverPodSecurityContext))
 	out.SolverNameservers = *(*[]string)(unsafe.Pointer(&in.SolverNameservers))
 	return nil
 }
@@ -184,9 +221,15 @@ func autoConvert_v1alpha1_ControllerConfiguration_To_controller_ControllerConfig
 	if err := v1.Convert_Pointer_bool_To_bool(&in.EnableCertificateOwnerRef, &out.EnableCertificateOwnerRef, s); err != nil {
 		return err
 	}
+	if err := v1.Convert_Pointer_bool_To_bool(&in.EnableCertificateChainVerification, &out.EnableCertificateChainVerification, s); err != nil {
+		return err
+	}
 	if err := v1.Convert_Pointer_bool_To_bool(&in.EnableGatewayAPI, &out.EnableGatewayAPI, s); err != nil {
 		return err
 	}
+	if err := v1.Convert_Pointer_bool_To_bool(&in.RequireFIPSMode, &out.RequireFIPSMode, s); err != nil {
+		return err
+	}
 	out.CopiedAnnotationPrefixes = *(*[]string)(unsafe.Pointer(&in.CopiedAnnotationPrefixes))
 	if err := sharedv1alpha1.Convert_Pointer_int32_To_int(&in.NumberOfConcurrentWorkers, &out.NumberOfConcurrentWorkers, s); err != nil {
 		return err
@@ -194,6 +237,24 @@ func autoConvert_v1alpha1_ControllerConfiguration_To_controller_ControllerConfig
 	if err := sharedv1alpha1.Convert_Pointer_int32_To_int(&in.MaxConcurrentChallenges, &out.MaxConcurrentChallenges, s); err != nil {
 		return err
 	}
+	if in.MaxConcurrentCertificateRequestsPerIssuer != nil {
+		in, out := &in.MaxConcurrentCertificateRequestsPerIssuer, &out.MaxConcurrentCertificateRequestsPerIssuer
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = int(val)
+		}
+	} else {
+		out.MaxConcurrentCertificateRequestsPerIssuer = nil
+	}
+	if err := sharedv1alpha1.Convert_Pointer_float32_To_float32(&in.ACMEOrderPollJitter, &out.ACMEOrderPollJitter, s); err != nil {
+		return err
+	}
+	if err := sharedv1alpha1.Convert_Pointer_float32_To_float32(&in.ACMEAccountQPS, &out.ACMEAccountQPS, s); err != nil {
+		return err
+	}
+	if err := sharedv1alpha1.Convert_Pointer_int32_To_int(&in.ACMEAccountBurst, &out.ACMEAccountBurst, s); err != nil {
+		return err
+	}
 	out.MetricsListenAddress = in.MetricsListenAddress
 	if err := sharedv1alpha1.Convert_v1alpha1_TLSConfig_To_shared_TLSConfig(&in.MetricsTLSConfig, &out.MetricsTLSConfig, s); err != nil {
 		return err
@@ -214,6 +275,12 @@ func autoConvert_v1alpha1_ControllerConfiguration_To_controller_ControllerConfig
 	if err := Convert_v1alpha1_ACMEDNS01Config_To_controller_ACMEDNS01Config(&in.ACMEDNS01Config, &out.ACMEDNS01Config, s); err != nil {
 		return err
 	}
+	if err := Convert_v1alpha1_VenafiConfig_To_controller_VenafiConfig(&in.VenafiConfig, &out.VenafiConfig, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_PodIdentityConfig_To_controller_PodIdentityConfig(&in.PodIdentityConfig, &out.PodIdentityConfig, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -246,9 +313,15 @@ func autoConvert_controller_ControllerConfiguration_To_v1alpha1_ControllerConfig
 	if err := v1.Convert_bool_To_Pointer_bool(&in.EnableCertificateOwnerRef, &out.EnableCertificateOwnerRef, s); err != nil {
 		return err
 	}
+	if err := v1.Convert_bool_To_Pointer_bool(&in.EnableCertificateChainVerification, &out.EnableCertificateChainVerification, s); err != nil {
+		return err
+	}
 	if err := v1.Convert_bool_To_Pointer_bool(&in.EnableGatewayAPI, &out.EnableGatewayAPI, s); err != nil {
 		return err
 	}
+	if err := v1.Convert_bool_To_Pointer_bool(&in.RequireFIPSMode, &out.RequireFIPSMode, s); err != nil {
+		return err
+	}
 	out.CopiedAnnotationPrefixes = *(*[]string)(unsafe.Pointer(&in.CopiedAnnotationPrefixes))
 	if err := sharedv1alpha1.Convert_int_To_Pointer_int32(&in.NumberOfConcurrentWorkers, &out.NumberOfConcurrentWorkers, s); err != nil {
 		return err
@@ -256,6 +329,24 @@ func autoConvert_controller_ControllerConfiguration_To_v1alpha1_ControllerConfig
 	if err := sharedv1alpha1.Convert_int_To_Pointer_int32(&in.MaxConcurrentChallenges, &out.MaxConcurrentChallenges, s); err != nil {
 		return err
 	}
+	if in.MaxConcurrentCertificateRequestsPerIssuer != nil {
+		in, out := &in.MaxConcurrentCertificateRequestsPerIssuer, &out.MaxConcurrentCertificateRequestsPerIssuer
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = int32(val)
+		}
+	} else {
+		out.MaxConcurrentCertificateRequestsPerIssuer = nil
+	}
+	if err := sharedv1alpha1.Convert_float32_To_Pointer_float32(&in.ACMEOrderPollJitter, &out.ACMEOrderPollJitter, s); err != nil {
+		return err
+	}
+	if err := sharedv1alpha1.Convert_float32_To_Pointer_float32(&in.ACMEAccountQPS, &out.ACMEAccountQPS, s); err != nil {
+		return err
+	}
+	if err := sharedv1alpha1.Convert_int_To_Pointer_int32(&in.ACMEAccountBurst, &out.ACMEAccountBurst, s); err != nil {
+		return err
+	}
 	out.MetricsListenAddress = in.MetricsListenAddress
 	if err := sharedv1alpha1.Convert_shared_TLSConfig_To_v1alpha1_TLSConfig(&in.MetricsTLSConfig, &out.MetricsTLSConfig, s); err != nil {
 		return err
@@ -276,6 +367,12 @@ func autoConvert_controller_ControllerConfiguration_To_v1alpha1_ControllerConfig
 	if err := Convert_controller_ACMEDNS01Config_To_v1alpha1_ACMEDNS01Config(&in.ACMEDNS01Config, &out.ACMEDNS01Config, s); err != nil {
 		return err
 	}
+	if err := Convert_controller_VenafiConfig_To_v1alpha1_VenafiConfig(&in.VenafiConfig, &out.VenafiConfig, s); err != nil {
+		return err
+	}
+	if err := Convert_controller_PodIdentityConfig_To_v1alpha1_PodIdentityConfig(&in.PodIdentityConfig, &out.PodIdentityConfig, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -339,3 +436,49 @@ func autoConvert_controller_LeaderElectionConfig_To_v1alpha1_LeaderElectionConfi
 func Convert_controller_LeaderElectionConfig_To_v1alpha1_LeaderElectionConfig(in *controller.LeaderElectionConfig, out *v1alpha1.LeaderElectionConfig, s conversion.Scope) error {
 	return autoConvert_controller_LeaderElectionConfig_To_v1alpha1_LeaderElectionConfig(in, out, s)
 }
+
+func autoConvert_v1alpha1_PodIdentityConfig_To_controller_PodIdentityConfig(in *v1alpha1.PodIdentityConfig, out *controller.PodIdentityConfig, s conversion.Scope) error {
+	out.AllowedIssuers = *(*[]string)(unsafe.Pointer(&in.AllowedIssuers))
+	out.TrustDomain = in.TrustDomain
+	return nil
+}
+
+// Convert_v1alpha1_PodIdentityConfig_To_controller_PodIdentityConfig is an autogenerated conversion function.
+func Convert_v1alpha1_PodIdentityConfig_To_controller_PodIdentityConfig(in *v1alpha1.PodIdentityConfig, out *controller.PodIdentityConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PodIdentityConfig_To_controller_PodIdentityConfig(in, out, s)
+}
+
+func autoConvert_controller_PodIdentityConfig_To_v1alpha1_PodIdentityConfig(in *controller.PodIdentityConfig, out *v1alpha1.PodIdentityConfig, s conversion.Scope) error {
+	out.AllowedIssuers = *(*[]string)(unsafe.Pointer(&in.AllowedIssuers))
+	out.TrustDomain = in.TrustDomain
+	return nil
+}
+
+// Convert_controller_PodIdentityConfig_To_v1alpha1_PodIdentityConfig is an autogenerated conversion function.
+func Convert_controller_PodIdentityConfig_To_v1alpha1_PodIdentityConfig(in *controller.PodIdentityConfig, out *v1alpha1.PodIdentityConfig, s conversion.Scope) error {
+	return autoConvert_controller_PodIdentityConfig_To_v1alpha1_PodIdentityConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_VenafiConfig_To_controller_VenafiConfig(in *v1alpha1.VenafiConfig, out *controller.VenafiConfig, s conversion.Scope) error {
+	if err := sharedv1alpha1.Convert_Pointer_v1alpha1_Duration_To_time_Duration(&in.MaxSetupRetryInterval, &out.MaxSetupRetryInterval, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_VenafiConfig_To_controller_VenafiConfig is an autogenerated conversion function.
+func Convert_v1alpha1_VenafiConfig_To_controller_VenafiConfig(in *v1alpha1.VenafiConfig, out *controller.VenafiConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VenafiConfig_To_controller_VenafiConfig(in, out, s)
+}
+
+func autoConvert_controller_VenafiConfig_To_v1alpha1_VenafiConfig(in *controller.VenafiConfig, out *v1alpha1.VenafiConfig, s conversion.Scope) error {
+	if err := sharedv1alpha1.Convert_time_Duration_To_Pointer_v1alpha1_Duration(&in.MaxSetupRetryInterval, &out.MaxSetupRetryInterval, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_controller_VenafiConfig_To_v1alpha1_VenafiConfig is an autogenerated conversion function.
+func Convert_controller_VenafiConfig_To_v1alpha1_VenafiConfig(in *controller.VenafiConfig, out *v1alpha1.VenafiConfig, s conversion.Scope) error {
+	return autoConvert_controller_VenafiConfig_To_v1alpha1_VenafiConfig(in, out, s)
+}
@@ -45,4 +45,6 @@ func SetObjectDefaults_ControllerConfiguration(in *v1alpha1.ControllerConfigurat
 	SetDefaults_IngressShimConfig(&in.IngressShimConfig)
 	SetDefaults_ACMEHTTP01Config(&in.ACMEHTTP01Config)
 	SetDefaults_ACMEDNS01Config(&in.ACMEDNS01Config)
+	SetDefaults_VenafiConfig(&in.VenafiConfig)
+	SetDefaults_PodIdentityConfig(&in.PodIdentityConfig)
 }
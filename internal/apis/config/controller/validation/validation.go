@@ -22,12 +22,14 @@ import (
 	"strings"
 
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	logsapi "k8s.io/component-base/logs/api/v1"
 
 	config "github.com/cert-manager/cert-manager/internal/apis/config/controller"
 	defaults "github.com/cert-manager/cert-manager/internal/apis/config/controller/v1alpha1"
 	sharedvalidation "github.com/cert-manager/cert-manager/internal/apis/config/shared/validation"
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
 )
 
 func ValidateControllerConfiguration(cfg *config.ControllerConfiguration, fldPath *field.Path) field.ErrorList {
@@ -57,6 +59,29 @@ func ValidateControllerConfiguration(cfg *config.ControllerConfiguration, fldPat
 		allErrors = append(allErrors, field.Invalid(fldPath.Child("kubernetesAPIBurst"), cfg.KubernetesAPIBurst, "must be higher or equal to kubernetesAPIQPS"))
 	}
 
+	knownIssuerTypes := sets.NewString(apiutil.IssuerACME, apiutil.IssuerCA, apiutil.IssuerSelfSigned, apiutil.IssuerVault, apiutil.IssuerVenafi, apiutil.IssuerSigningWebhook, apiutil.IssuerKubernetesCSR)
+	for issuerType, limit := range cfg.MaxConcurrentCertificateRequestsPerIssuer {
+		fld := fldPath.Child("maxConcurrentCertificateRequestsPerIssuer").Key(issuerType)
+		if !knownIssuerTypes.Has(issuerType) {
+			allErrors = append(allErrors, field.NotSupported(fld, issuerType, knownIssuerTypes.List()))
+		}
+		if limit <= 0 {
+			allErrors = append(allErrors, field.Invalid(fld, limit, "must be higher than 0"))
+		}
+	}
+
+	if cfg.ACMEOrderPollJitter < 0 {
+		allErrors = append(allErrors, field.Invalid(fldPath.Child("acmeOrderPollJitter"), cfg.ACMEOrderPollJitter, "must not be negative"))
+	}
+
+	if cfg.ACMEAccountQPS < 0 {
+		allErrors = append(allErrors, field.Invalid(fldPath.Child("acmeAccountQPS"), cfg.ACMEAccountQPS, "must not be negative"))
+	}
+
+	if cfg.ACMEAccountQPS > 0 && cfg.ACMEAccountBurst <= 0 {
+		allErrors = append(allErrors, field.Invalid(fldPath.Child("acmeAccountBurst"), cfg.ACMEAccountBurst, "must be higher than 0 when acmeAccountQPS is set"))
+	}
+
 	for i, server := range cfg.ACMEHTTP01Config.SolverNameservers {
 		// ensure all servers have a port number
 		_, _, err := net.SplitHostPort(server)
@@ -81,6 +106,31 @@ func ValidateControllerConfiguration(cfg *config.ControllerConfiguration, fldPat
 		}
 	}
 
+	if cfg.ACMEDNS01Config.RecursiveNameserversQuorum < 0 {
+		allErrors = append(allErrors, field.Invalid(fldPath.Child("acmeDNS01Config").Child("recursiveNameserversQuorum"), cfg.ACMEDNS01Config.RecursiveNameserversQuorum, "must not be negative"))
+	} else if n := len(cfg.ACMEDNS01Config.RecursiveNameservers); n > 0 && cfg.ACMEDNS01Config.RecursiveNameserversQuorum > n {
+		allErrors = append(allErrors, field.Invalid(fldPath.Child("acmeDNS01Config").Child("recursiveNameserversQuorum"), cfg.ACMEDNS01Config.RecursiveNameserversQuorum, "must not be greater than the number of recursive nameservers"))
+	}
+
+	if cfg.ACMEDNS01Config.CheckTimeout < 0 {
+		allErrors = append(allErrors, field.Invalid(fldPath.Child("acmeDNS01Config").Child("checkTimeout"), cfg.ACMEDNS01Config.CheckTimeout, "must not be negative"))
+	}
+
+	if cfg.VenafiConfig.MaxSetupRetryInterval < 0 {
+		allErrors = append(allErrors, field.Invalid(fldPath.Child("venafiConfig").Child("maxSetupRetryInterval"), cfg.VenafiConfig.MaxSetupRetryInterval, "must not be negative"))
+	}
+
+	for i, issuer := range cfg.PodIdentityConfig.AllowedIssuers {
+		if _, err := config.ParseIssuerReference(issuer); err != nil {
+			allErrors = append(allErrors, field.Invalid(fldPath.Child("podIdentityConfig").Child("allowedIssuers").Index(i), issuer, err.Error()))
+		}
+	}
+	if cfg.PodIdentityConfig.TrustDomain != "" {
+		for _, msg := range validation.IsDNS1123Subdomain(cfg.PodIdentityConfig.TrustDomain) {
+			allErrors = append(allErrors, field.Invalid(fldPath.Child("podIdentityConfig").Child("trustDomain"), cfg.PodIdentityConfig.TrustDomain, msg))
+		}
+	}
+
 	allControllersSet := sets.NewString(defaults.AllControllers...)
 	for i, controller := range cfg.Controllers {
 		if controller == "*" {
@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	logsapi "k8s.io/component-base/logs/api/v1"
 
@@ -218,6 +219,105 @@ func TestValidateControllerConfiguration(t *testing.T) {
 				}
 			},
 		},
+		{
+			"with negative acmeOrderPollJitter",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst:  1,
+				KubernetesAPIQPS:    1,
+				ACMEOrderPollJitter: -0.5,
+			},
+			func(cc *config.ControllerConfiguration) field.ErrorList {
+				return field.ErrorList{
+					field.Invalid(field.NewPath("acmeOrderPollJitter"), cc.ACMEOrderPollJitter, "must not be negative"),
+				}
+			},
+		},
+		{
+			"with negative acmeAccountQPS",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst: 1,
+				KubernetesAPIQPS:   1,
+				ACMEAccountQPS:     -1,
+			},
+			func(cc *config.ControllerConfiguration) field.ErrorList {
+				return field.ErrorList{
+					field.Invalid(field.NewPath("acmeAccountQPS"), cc.ACMEAccountQPS, "must not be negative"),
+				}
+			},
+		},
+		{
+			"with acmeAccountQPS set and zero acmeAccountBurst",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst: 1,
+				KubernetesAPIQPS:   1,
+				ACMEAccountQPS:     0.5,
+			},
+			func(cc *config.ControllerConfiguration) field.ErrorList {
+				return field.ErrorList{
+					field.Invalid(field.NewPath("acmeAccountBurst"), cc.ACMEAccountBurst, "must be higher than 0 when acmeAccountQPS is set"),
+				}
+			},
+		},
+		{
+			"with valid maxConcurrentCertificateRequestsPerIssuer",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst: 1,
+				KubernetesAPIQPS:   1,
+				MaxConcurrentCertificateRequestsPerIssuer: map[string]int{
+					"vault":  10,
+					"venafi": 5,
+				},
+			},
+			nil,
+		},
+		{
+			"with invalid maxConcurrentCertificateRequestsPerIssuer",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst: 1,
+				KubernetesAPIQPS:   1,
+				MaxConcurrentCertificateRequestsPerIssuer: map[string]int{
+					"vault": 0,
+					"foo":   1,
+				},
+			},
+			func(cc *config.ControllerConfiguration) field.ErrorList {
+				fld := field.NewPath("maxConcurrentCertificateRequestsPerIssuer")
+				return field.ErrorList{
+					field.NotSupported(fld.Key("foo"), "foo", []string{"acme", "ca", "kubernetescsr", "selfsigned", "signingwebhook", "vault", "venafi"}),
+					field.Invalid(fld.Key("vault"), 0, "must be higher than 0"),
+				}
+			},
+		},
 		{
 			"with valid acme http solver nameservers",
 			&config.ControllerConfiguration{
@@ -330,6 +430,94 @@ func TestValidateControllerConfiguration(t *testing.T) {
 				}
 			},
 		},
+		{
+			"with valid acme dns recursive nameservers quorum",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst: 1,
+				KubernetesAPIQPS:   1,
+				ACMEDNS01Config: config.ACMEDNS01Config{
+					RecursiveNameservers: []string{
+						"1.1.1.1:53",
+						"8.8.8.8:53",
+					},
+					RecursiveNameserversQuorum: 2,
+				},
+			},
+			nil,
+		},
+		{
+			"with acme dns recursive nameservers quorum greater than the number of nameservers",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst: 1,
+				KubernetesAPIQPS:   1,
+				ACMEDNS01Config: config.ACMEDNS01Config{
+					RecursiveNameservers: []string{
+						"1.1.1.1:53",
+						"8.8.8.8:53",
+					},
+					RecursiveNameserversQuorum: 3,
+				},
+			},
+			func(cc *config.ControllerConfiguration) field.ErrorList {
+				return field.ErrorList{
+					field.Invalid(field.NewPath("acmeDNS01Config.recursiveNameserversQuorum"), 3, "must not be greater than the number of recursive nameservers"),
+				}
+			},
+		},
+		{
+			"with negative acme dns recursive nameservers quorum",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst: 1,
+				KubernetesAPIQPS:   1,
+				ACMEDNS01Config: config.ACMEDNS01Config{
+					RecursiveNameserversQuorum: -1,
+				},
+			},
+			func(cc *config.ControllerConfiguration) field.ErrorList {
+				return field.ErrorList{
+					field.Invalid(field.NewPath("acmeDNS01Config.recursiveNameserversQuorum"), -1, "must not be negative"),
+				}
+			},
+		},
+		{
+			"with negative acme dns check timeout",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst: 1,
+				KubernetesAPIQPS:   1,
+				ACMEDNS01Config: config.ACMEDNS01Config{
+					CheckTimeout: -time.Minute,
+				},
+			},
+			func(cc *config.ControllerConfiguration) field.ErrorList {
+				return field.ErrorList{
+					field.Invalid(field.NewPath("acmeDNS01Config.checkTimeout"), -time.Minute, "must not be negative"),
+				}
+			},
+		},
 		{
 			"with valid controllers named",
 			&config.ControllerConfiguration{
@@ -379,6 +567,52 @@ func TestValidateControllerConfiguration(t *testing.T) {
 				}
 			},
 		},
+		{
+			"with invalid venafi max setup retry interval",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst: 1,
+				KubernetesAPIQPS:   1,
+				VenafiConfig: config.VenafiConfig{
+					MaxSetupRetryInterval: -1 * time.Second,
+				},
+			},
+			func(cc *config.ControllerConfiguration) field.ErrorList {
+				return field.ErrorList{
+					field.Invalid(field.NewPath("venafiConfig", "maxSetupRetryInterval"), cc.VenafiConfig.MaxSetupRetryInterval, "must not be negative"),
+				}
+			},
+		},
+		{
+			"with invalid pod identity config",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst: 1,
+				KubernetesAPIQPS:   1,
+				PodIdentityConfig: config.PodIdentityConfig{
+					AllowedIssuers: []string{"ClusterIssuer/pod-identity", "pod-identity"},
+					TrustDomain:    "Not_A_Domain",
+				},
+			},
+			func(cc *config.ControllerConfiguration) field.ErrorList {
+				return field.ErrorList{
+					field.Invalid(field.NewPath("podIdentityConfig", "allowedIssuers").Index(1), "pod-identity",
+						`invalid issuer "pod-identity": must be in the form <kind>.<group>/<name> or <kind>/<name>`),
+					field.Invalid(field.NewPath("podIdentityConfig", "trustDomain"), "Not_A_Domain",
+						validation.IsDNS1123Subdomain("Not_A_Domain")[0]),
+				}
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
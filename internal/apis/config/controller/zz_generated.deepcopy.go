@@ -22,6 +22,7 @@ limitations under the License.
 package controller
 
 import (
+	v1 "k8s.io/api/core/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -49,6 +50,16 @@ func (in *ACMEDNS01Config) DeepCopy() *ACMEDNS01Config {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ACMEHTTP01Config) DeepCopyInto(out *ACMEHTTP01Config) {
 	*out = *in
+	if in.SolverSecurityContext != nil {
+		in, out := &in.SolverSecurityContext, &out.SolverSecurityContext
+		*out = new(v1.SecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SolverPodSecurityContext != nil {
+		in, out := &in.SolverPodSecurityContext, &out.SolverPodSecurityContext
+		*out = new(v1.PodSecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.SolverNameservers != nil {
 		in, out := &in.SolverNameservers, &out.SolverNameservers
 		*out = make([]string, len(*in))
@@ -82,6 +93,13 @@ func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.MaxConcurrentCertificateRequestsPerIssuer != nil {
+		in, out := &in.MaxConcurrentCertificateRequestsPerIssuer, &out.MaxConcurrentCertificateRequestsPerIssuer
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	in.MetricsTLSConfig.DeepCopyInto(&out.MetricsTLSConfig)
 	in.Logging.DeepCopyInto(&out.Logging)
 	if in.FeatureGates != nil {
@@ -94,6 +112,8 @@ func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
 	in.IngressShimConfig.DeepCopyInto(&out.IngressShimConfig)
 	in.ACMEHTTP01Config.DeepCopyInto(&out.ACMEHTTP01Config)
 	in.ACMEDNS01Config.DeepCopyInto(&out.ACMEDNS01Config)
+	out.VenafiConfig = in.VenafiConfig
+	in.PodIdentityConfig.DeepCopyInto(&out.PodIdentityConfig)
 	return
 }
 
@@ -152,3 +172,40 @@ func (in *LeaderElectionConfig) DeepCopy() *LeaderElectionConfig {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodIdentityConfig) DeepCopyInto(out *PodIdentityConfig) {
+	*out = *in
+	if in.AllowedIssuers != nil {
+		in, out := &in.AllowedIssuers, &out.AllowedIssuers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodIdentityConfig.
+func (in *PodIdentityConfig) DeepCopy() *PodIdentityConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PodIdentityConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VenafiConfig) DeepCopyInto(out *VenafiConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VenafiConfig.
+func (in *VenafiConfig) DeepCopy() *VenafiConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(VenafiConfig)
+	in.DeepCopyInto(out)
+	return out
+}
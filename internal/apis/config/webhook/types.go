@@ -62,4 +62,16 @@ type WebhookConfiguration struct {
 	// featureGates is a map of feature names to bools that enable or disable experimental
 	// features.
 	FeatureGates map[string]bool
+
+	// defaultIssuerName is the name of the issuer set on Certificates which
+	// are created without an issuerRef. If empty, no issuerRef is defaulted.
+	DefaultIssuerName string
+
+	// defaultIssuerKind is the kind of the issuer set on Certificates which
+	// are created without an issuerRef.
+	DefaultIssuerKind string
+
+	// defaultIssuerGroup is the group of the issuer set on Certificates which
+	// are created without an issuerRef.
+	DefaultIssuerGroup string
 }
@@ -68,6 +68,9 @@ func autoConvert_v1alpha1_WebhookConfiguration_To_webhook_WebhookConfiguration(i
 	out.PprofAddress = in.PprofAddress
 	out.Logging = in.Logging
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+	out.DefaultIssuerName = in.DefaultIssuerName
+	out.DefaultIssuerKind = in.DefaultIssuerKind
+	out.DefaultIssuerGroup = in.DefaultIssuerGroup
 	return nil
 }
 
@@ -92,6 +95,9 @@ func autoConvert_webhook_WebhookConfiguration_To_v1alpha1_WebhookConfiguration(i
 	out.PprofAddress = in.PprofAddress
 	out.Logging = in.Logging
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+	out.DefaultIssuerName = in.DefaultIssuerName
+	out.DefaultIssuerKind = in.DefaultIssuerKind
+	out.DefaultIssuerGroup = in.DefaultIssuerGroup
 	return nil
 }
 
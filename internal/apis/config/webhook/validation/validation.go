@@ -37,5 +37,14 @@ func ValidateWebhookConfiguration(cfg *config.WebhookConfiguration, fldPath *fie
 		allErrors = append(allErrors, field.Invalid(fldPath.Child("securePort"), cfg.SecurePort, "must be a valid port number"))
 	}
 
+	if cfg.DefaultIssuerName == "" {
+		if cfg.DefaultIssuerKind != "" {
+			allErrors = append(allErrors, field.Invalid(fldPath.Child("defaultIssuerKind"), cfg.DefaultIssuerKind, "cannot be set without defaultIssuerName"))
+		}
+		if cfg.DefaultIssuerGroup != "" {
+			allErrors = append(allErrors, field.Invalid(fldPath.Child("defaultIssuerGroup"), cfg.DefaultIssuerGroup, "cannot be set without defaultIssuerName"))
+		}
+	}
+
 	return allErrors
 }
@@ -127,6 +127,34 @@ func TestValidateWebhookConfiguration(t *testing.T) {
 				}
 			},
 		},
+		{
+			"with valid default issuer",
+			&config.WebhookConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				DefaultIssuerName:  "letsencrypt",
+				DefaultIssuerKind:  "ClusterIssuer",
+				DefaultIssuerGroup: "cert-manager.io",
+			},
+			nil,
+		},
+		{
+			"with default issuer kind and group but no name",
+			&config.WebhookConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				DefaultIssuerKind:  "ClusterIssuer",
+				DefaultIssuerGroup: "cert-manager.io",
+			},
+			func(wc *config.WebhookConfiguration) field.ErrorList {
+				return field.ErrorList{
+					field.Invalid(field.NewPath("defaultIssuerKind"), wc.DefaultIssuerKind, "cannot be set without defaultIssuerName"),
+					field.Invalid(field.NewPath("defaultIssuerGroup"), wc.DefaultIssuerGroup, "cannot be set without defaultIssuerName"),
+				}
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
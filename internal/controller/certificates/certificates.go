@@ -20,6 +20,7 @@ import (
 	"context"
 	"slices"
 	"strings"
+	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/labels"
@@ -111,3 +112,27 @@ func CertificateOwnsSecret(
 	})
 	return isOwner, otherCertificatesWithSameSecretName, nil
 }
+
+// PrivateKeyRotationTime returns the time at which the private key of the
+// given Certificate is due to be rotated, if its private key rotation policy is
+// Scheduled. This is the time of the last private key rotation plus the
+// rotation interval. If no rotation has been recorded yet, the private key is
+// assumed to be as old as the current certificate.
+// False is returned if the rotation policy is not Scheduled, or if the
+// Certificate has not been issued yet.
+func PrivateKeyRotationTime(crt *cmapi.Certificate) (time.Time, bool) {
+	pk := crt.Spec.PrivateKey
+	if pk == nil || pk.RotationPolicy != cmapi.RotationPolicyScheduled || pk.RotationInterval == nil {
+		return time.Time{}, false
+	}
+
+	lastRotation := crt.Status.LastPrivateKeyRotationTime
+	if lastRotation == nil {
+		lastRotation = crt.Status.NotBefore
+	}
+	if lastRotation == nil {
+		return time.Time{}, false
+	}
+
+	return lastRotation.Add(pk.RotationInterval.Duration), true
+}
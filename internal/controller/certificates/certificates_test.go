@@ -202,3 +202,51 @@ func TestCertificateOwnsSecret(t *testing.T) {
 		})
 	}
 }
+
+func TestPrivateKeyRotationTime(t *testing.T) {
+	lastRotation := metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	notBefore := metav1.NewTime(lastRotation.Add(24 * time.Hour))
+	interval := &metav1.Duration{Duration: 90 * 24 * time.Hour}
+
+	tests := map[string]struct {
+		privateKey *cmapi.CertificatePrivateKey
+		status     cmapi.CertificateStatus
+		expTime    time.Time
+		expOK      bool
+	}{
+		"no private key options should not be scheduled": {
+			status: cmapi.CertificateStatus{LastPrivateKeyRotationTime: &lastRotation},
+		},
+		"Always rotation policy should not be scheduled": {
+			privateKey: &cmapi.CertificatePrivateKey{RotationPolicy: cmapi.RotationPolicyAlways},
+			status:     cmapi.CertificateStatus{LastPrivateKeyRotationTime: &lastRotation},
+		},
+		"Scheduled rotation policy of a Certificate which was never issued should not be scheduled": {
+			privateKey: &cmapi.CertificatePrivateKey{RotationPolicy: cmapi.RotationPolicyScheduled, RotationInterval: interval},
+		},
+		"Scheduled rotation policy should be due an interval after the last rotation": {
+			privateKey: &cmapi.CertificatePrivateKey{RotationPolicy: cmapi.RotationPolicyScheduled, RotationInterval: interval},
+			status:     cmapi.CertificateStatus{LastPrivateKeyRotationTime: &lastRotation, NotBefore: &notBefore},
+			expTime:    lastRotation.Add(interval.Duration),
+			expOK:      true,
+		},
+		"Scheduled rotation policy with no recorded rotation should be due an interval after the certificate's notBefore": {
+			privateKey: &cmapi.CertificatePrivateKey{RotationPolicy: cmapi.RotationPolicyScheduled, RotationInterval: interval},
+			status:     cmapi.CertificateStatus{NotBefore: &notBefore},
+			expTime:    notBefore.Add(interval.Duration),
+			expOK:      true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			crt := &cmapi.Certificate{
+				Spec:   cmapi.CertificateSpec{PrivateKey: test.privateKey},
+				Status: test.status,
+			}
+			gotTime, gotOK := PrivateKeyRotationTime(crt)
+			assert.Equal(t, test.expOK, gotOK)
+			assert.True(t, test.expTime.Equal(gotTime), "unexpected rotation time, exp=%s got=%s", test.expTime, gotTime)
+		})
+	}
+}
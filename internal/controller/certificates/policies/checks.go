@@ -34,6 +34,7 @@ import (
 
 	cmmeta "github.com/cert-manager/cert-manager/internal/apis/meta"
 	internalcertificates "github.com/cert-manager/cert-manager/internal/controller/certificates"
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
 )
@@ -106,11 +107,21 @@ func SecretPrivateKeyMismatchesSpec(input Input) (string, string, bool) {
 func SecretKeystoreFormatMismatch(input Input) (string, string, bool) {
 	_, issuerProvidesCA := input.Secret.Data[cmmeta.TLSCAKey]
 
+	// Keys which are preserved for a third party are neither expected to
+	// be present nor absent.
+	preserved := internalcertificates.PreservedSecretKeys(input.Certificate, input.Secret)
+	hasData := func(key string) bool {
+		return !preserved.Has(key) && len(input.Secret.Data[key]) != 0
+	}
+	missingData := func(key string) bool {
+		return !preserved.Has(key) && len(input.Secret.Data[key]) == 0
+	}
+
 	if input.Certificate.Spec.Keystores == nil {
-		if len(input.Secret.Data[cmapi.PKCS12SecretKey]) != 0 ||
-			len(input.Secret.Data[cmapi.PKCS12TruststoreKey]) != 0 ||
-			len(input.Secret.Data[cmapi.JKSSecretKey]) != 0 ||
-			len(input.Secret.Data[cmapi.JKSTruststoreKey]) != 0 {
+		if hasData(cmapi.PKCS12SecretKey) ||
+			hasData(cmapi.PKCS12TruststoreKey) ||
+			hasData(cmapi.JKSSecretKey) ||
+			hasData(cmapi.JKSTruststoreKey) {
 			return SecretMismatch, "Keystore is not defined", true
 		}
 		return "", "", false
@@ -118,38 +129,38 @@ func SecretKeystoreFormatMismatch(input Input) (string, string, bool) {
 
 	if input.Certificate.Spec.Keystores.JKS != nil {
 		if input.Certificate.Spec.Keystores.JKS.Create {
-			if len(input.Secret.Data[cmapi.JKSSecretKey]) == 0 ||
-				(len(input.Secret.Data[cmapi.JKSTruststoreKey]) == 0 && issuerProvidesCA) {
+			if missingData(cmapi.JKSSecretKey) ||
+				(missingData(cmapi.JKSTruststoreKey) && issuerProvidesCA) {
 				return SecretMismatch, "JKS Keystore key does not contain data", true
 			}
 		} else {
-			if len(input.Secret.Data[cmapi.JKSSecretKey]) != 0 ||
-				len(input.Secret.Data[cmapi.JKSTruststoreKey]) != 0 {
+			if hasData(cmapi.JKSSecretKey) ||
+				hasData(cmapi.JKSTruststoreKey) {
 				return SecretMismatch, "JKS Keystore create disabled", true
 			}
 		}
 	} else {
-		if len(input.Secret.Data[cmapi.JKSSecretKey]) != 0 ||
-			len(input.Secret.Data[cmapi.JKSTruststoreKey]) != 0 {
+		if hasData(cmapi.JKSSecretKey) ||
+			hasData(cmapi.JKSTruststoreKey) {
 			return SecretMismatch, "JKS Keystore not defined", true
 		}
 	}
 
 	if input.Certificate.Spec.Keystores.PKCS12 != nil {
 		if input.Certificate.Spec.Keystores.PKCS12.Create {
-			if len(input.Secret.Data[cmapi.PKCS12SecretKey]) == 0 ||
-				(len(input.Secret.Data[cmapi.PKCS12TruststoreKey]) == 0 && issuerProvidesCA) {
+			if missingData(cmapi.PKCS12SecretKey) ||
+				(missingData(cmapi.PKCS12TruststoreKey) && issuerProvidesCA) {
 				return SecretMismatch, "PKCS12 Keystore key does not contain data", true
 			}
 		} else {
-			if len(input.Secret.Data[cmapi.PKCS12SecretKey]) != 0 ||
-				len(input.Secret.Data[cmapi.PKCS12TruststoreKey]) != 0 {
+			if hasData(cmapi.PKCS12SecretKey) ||
+				hasData(cmapi.PKCS12TruststoreKey) {
 				return SecretMismatch, "PKCS12 Keystore create disabled", true
 			}
 		}
 	} else {
-		if len(input.Secret.Data[cmapi.PKCS12SecretKey]) != 0 ||
-			len(input.Secret.Data[cmapi.PKCS12TruststoreKey]) != 0 {
+		if hasData(cmapi.PKCS12SecretKey) ||
+			hasData(cmapi.PKCS12TruststoreKey) {
 			return SecretMismatch, "PKCS12 Keystore not defined", true
 		}
 	}
@@ -223,7 +234,7 @@ func CurrentCertificateRequestMismatchesSpec(input Input) (string, string, bool)
 		return currentSecretValidForSpec(input)
 	}
 
-	violations, err := pki.RequestMatchesSpec(input.CurrentRevisionRequest, input.Certificate.Spec)
+	violations, err := pki.RequestMatchesSpec(input.CurrentRevisionRequest, pki.SpecForCertificate(input.Certificate))
 	if err != nil {
 		// If parsing the request fails, we don't immediately trigger a re-issuance as
 		// the existing certificate stored in the Secret may still be valid/up to date.
@@ -240,7 +251,7 @@ func CurrentCertificateRequestMismatchesSpec(input Input) (string, string, bool)
 // and is instead called by currentCertificateRequestValidForSpec if no there
 // is no existing CertificateRequest resource.
 func currentSecretValidForSpec(input Input) (string, string, bool) {
-	violations, err := pki.SecretDataAltNamesMatchSpec(input.Secret, input.Certificate.Spec)
+	violations, err := pki.SecretDataAltNamesMatchSpec(input.Secret, pki.SpecForCertificate(input.Certificate))
 	if err != nil {
 		// This case should never be reached as we already check the certificate data can
 		// be parsed in an earlier policy check, but handle it anyway.
@@ -274,6 +285,9 @@ func CurrentCertificateNearingExpiry(c clock.Clock) Func {
 		notAfter := metav1.NewTime(x509Cert.NotAfter)
 		crt := input.Certificate
 		renewalTime := pki.RenewalTime(notBefore.Time, notAfter.Time, crt.Spec.RenewBefore, crt.Spec.RenewBeforePercentage)
+		if ariRenewalTime := pki.RenewalInfoTime(x509Cert, crt.Status.RenewalInfo); ariRenewalTime != nil {
+			renewalTime = ariRenewalTime
+		}
 
 		renewIn := renewalTime.Time.Sub(c.Now())
 		if renewIn > 0 {
@@ -285,6 +299,20 @@ func CurrentCertificateNearingExpiry(c clock.Clock) Func {
 	}
 }
 
+// CurrentPrivateKeyRotationDue returns a policy function that can be used to
+// check whether the Certificate's private key is due to be rotated, when its
+// private key rotation policy is Scheduled.
+func CurrentPrivateKeyRotationDue(c clock.Clock) Func {
+	return func(input Input) (string, string, bool) {
+		rotationTime, ok := internalcertificates.PrivateKeyRotationTime(input.Certificate)
+		if !ok || rotationTime.After(c.Now()) {
+			return "", "", false
+		}
+
+		return PrivateKeyRotationDue, fmt.Sprintf("Rotating private key as rotation was scheduled at %s", rotationTime.Format(time.RFC3339)), true
+	}
+}
+
 // CurrentCertificateHasExpired is used exclusively to check if the current
 // issued certificate has actually expired rather than just nearing expiry.
 func CurrentCertificateHasExpired(c clock.Clock) Func {
@@ -301,6 +329,29 @@ func CurrentCertificateHasExpired(c clock.Clock) Func {
 	}
 }
 
+// CurrentCertificateRevoked checks whether the certificate currently issued
+// for a Certificate has been recorded as revoked in the Certificate's status.
+func CurrentCertificateRevoked(input Input) (string, string, bool) {
+	revocation := input.Certificate.Status.LastRevocation
+	if revocation == nil {
+		return "", "", false
+	}
+	revokedSerial, err := pki.ParseSerialNumber(revocation.SerialNumber)
+	if err != nil {
+		return "", "", false
+	}
+
+	x509Cert, err := pki.DecodeX509CertificateBytes(input.Secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		return InvalidCertificate, fmt.Sprintf("Issuing certificate as Secret contains an invalid certificate: %v", err), true
+	}
+
+	if x509Cert.SerialNumber != nil && x509Cert.SerialNumber.Cmp(revokedSerial) == 0 {
+		return Revoked, fmt.Sprintf("Certificate with serial number %s was revoked at %s", revocation.SerialNumber, revocation.RevocationTime.Format(time.RFC3339)), true
+	}
+	return "", "", false
+}
+
 func formatIssuerRef(name, kind, group string) string {
 	if group == "" {
 		group = "cert-manager.io"
@@ -346,13 +397,29 @@ func SecretSecretTemplateMismatch(input Input) (string, string, bool) {
 		return "", "", false
 	}
 
-	for kSpec, vSpec := range input.Certificate.Spec.SecretTemplate.Annotations {
+	var certificate *x509.Certificate
+	if len(input.Secret.Data[corev1.TLSCertKey]) > 0 {
+		var err error
+		certificate, err = pki.DecodeX509CertificateBytes(input.Secret.Data[corev1.TLSCertKey])
+		if err != nil {
+			return InvalidCertificate, fmt.Sprintf("Failed to decode stored certificate: %v", err), true
+		}
+	}
+
+	// Templated values are compared against their rendered value for the
+	// certificate stored in the Secret.
+	expLabels, expAnnotations, err := apiutil.RenderSecretTemplate(input.Certificate.Spec.SecretTemplate, certificate)
+	if err != nil {
+		return SecretTemplateMismatch, fmt.Sprintf("Failed to render Certificate's SecretTemplate: %v", err), true
+	}
+
+	for kSpec, vSpec := range expAnnotations {
 		if v, ok := input.Secret.Annotations[kSpec]; !ok || v != vSpec {
 			return SecretTemplateMismatch, "Certificate's SecretTemplate Annotations missing or incorrect value on Secret", true
 		}
 	}
 
-	for kSpec, vSpec := range input.Certificate.Spec.SecretTemplate.Labels {
+	for kSpec, vSpec := range expLabels {
 		if v, ok := input.Secret.Labels[kSpec]; !ok || v != vSpec {
 			return SecretTemplateMismatch, "Certificate's SecretTemplate Labels missing or incorrect value on Secret", true
 		}
@@ -615,20 +682,25 @@ func SecretCertificateDetailsAnnotationsMismatch(input Input) (string, string, b
 //   - Secret value is incorrect
 func SecretAdditionalOutputFormatsMismatch(input Input) (string, string, bool) {
 	const message = "Certificate's AdditionalOutputFormats doesn't match Secret Data"
+	preserved := internalcertificates.PreservedSecretKeys(input.Certificate, input.Secret)
 	for _, format := range input.Certificate.Spec.AdditionalOutputFormats {
 		switch format.Type {
 		case cmapi.CertificateOutputFormatCombinedPEM:
 			v, ok := input.Secret.Data[cmapi.CertificateOutputFormatCombinedPEMKey]
-			if !ok || !bytes.Equal(v, internalcertificates.OutputFormatCombinedPEM(
+			if !preserved.Has(cmapi.CertificateOutputFormatCombinedPEMKey) && (!ok || !bytes.Equal(v, internalcertificates.OutputFormatCombinedPEM(
 				input.Secret.Data[corev1.TLSPrivateKeyKey],
 				input.Secret.Data[corev1.TLSCertKey],
-			)) {
+			))) {
 				return AdditionalOutputFormatsMismatch, message, true
 			}
 
 		case cmapi.CertificateOutputFormatDER:
 			v, ok := input.Secret.Data[cmapi.CertificateOutputFormatDERKey]
-			if !ok || !bytes.Equal(v, internalcertificates.OutputFormatDER(input.Secret.Data[corev1.TLSPrivateKeyKey])) {
+			if !preserved.Has(cmapi.CertificateOutputFormatDERKey) && (!ok || !bytes.Equal(v, internalcertificates.OutputFormatDER(input.Secret.Data[corev1.TLSPrivateKeyKey]))) {
+				return AdditionalOutputFormatsMismatch, message, true
+			}
+			v, ok = input.Secret.Data[cmapi.CertificateOutputFormatDERCertificateKey]
+			if !preserved.Has(cmapi.CertificateOutputFormatDERCertificateKey) && (!ok || !bytes.Equal(v, internalcertificates.OutputFormatDERCertificate(input.Secret.Data[corev1.TLSCertKey]))) {
 				return AdditionalOutputFormatsMismatch, message, true
 			}
 		}
@@ -649,8 +721,8 @@ func SecretAdditionalOutputFormatsManagedFieldsMismatch(fieldManager string) Fun
 	const message = "Certificate's AdditionalOutputFormats doesn't match Secret ManagedFields"
 	return func(input Input) (string, string, bool) {
 		var (
-			crtHasCombinedPEM, crtHasDER       bool
-			secretHasCombinedPEM, secretHasDER bool
+			crtHasCombinedPEM, crtHasDER                                bool
+			secretHasCombinedPEM, secretHasDER, secretHasDERCertificate bool
 		)
 
 		// Gather which additional output formats have been defined on the
@@ -689,11 +761,31 @@ func SecretAdditionalOutputFormatsManagedFieldsMismatch(fieldManager string) Fun
 			}) {
 				secretHasDER = true
 			}
+
+			if fieldset.Has(fieldpath.Path{
+				{FieldName: ptr.To("data")},
+				{FieldName: ptr.To(cmapi.CertificateOutputFormatDERCertificateKey)},
+			}) {
+				secretHasDERCertificate = true
+			}
+		}
+
+		// Keys which are preserved for a third party are never applied, so
+		// whether they are owned by the field manager is irrelevant.
+		preserved := internalcertificates.PreservedSecretKeys(input.Certificate, input.Secret)
+		if preserved.Has(cmapi.CertificateOutputFormatCombinedPEMKey) {
+			secretHasCombinedPEM = crtHasCombinedPEM
+		}
+		if preserved.Has(cmapi.CertificateOutputFormatDERKey) {
+			secretHasDER = crtHasDER
+		}
+		if preserved.Has(cmapi.CertificateOutputFormatDERCertificateKey) {
+			secretHasDERCertificate = crtHasDER
 		}
 
 		// Format present or missing on the Certificate should be reflected on the
 		// Secret.
-		if crtHasCombinedPEM != secretHasCombinedPEM || crtHasDER != secretHasDER {
+		if crtHasCombinedPEM != secretHasCombinedPEM || crtHasDER != secretHasDER || crtHasDER != secretHasDERCertificate {
 			return AdditionalOutputFormatsMismatch, message, true
 		}
 
@@ -18,6 +18,8 @@ package policies
 
 import (
 	"encoding/pem"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -43,6 +45,29 @@ import (
 func Test_NewTriggerPolicyChain(t *testing.T) {
 	clock := &fakeclock.FakeClock{}
 	staticFixedPrivateKey := testcrypto.MustCreatePEMPrivateKey(t)
+	revokedCertPEM := testcrypto.MustCreateCertWithNotBeforeAfter(t, staticFixedPrivateKey,
+		&cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "example.com"}},
+		clock.Now(),
+		clock.Now().Add(time.Hour),
+	)
+	revokedCert, err := pki.DecodeX509CertificateBytes(revokedCertPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	revokedSerial := pki.FormatSerialNumber(revokedCert.SerialNumber)
+	revokedSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "something",
+			Annotations: map[string]string{
+				cmapi.IssuerNameAnnotationKey:  "testissuer",
+				cmapi.IssuerKindAnnotationKey:  "IssuerKind",
+				cmapi.IssuerGroupAnnotationKey: "group.example.com",
+			},
+		},
+		Data: map[string][]byte{
+			corev1.TLSPrivateKeyKey: staticFixedPrivateKey,
+			corev1.TLSCertKey:       revokedCertPEM,
+		},
+	}
 	tests := map[string]struct {
 		// policy inputs
 		certificate *cmapi.Certificate
@@ -402,6 +427,83 @@ func Test_NewTriggerPolicyChain(t *testing.T) {
 				},
 			},
 		},
+		"do nothing if the private key rotation is scheduled in the future": {
+			certificate: &cmapi.Certificate{
+				Spec: cmapi.CertificateSpec{
+					CommonName: "example.com",
+					IssuerRef: cmmeta.ObjectReference{
+						Name:  "testissuer",
+						Kind:  "IssuerKind",
+						Group: "group.example.com",
+					},
+					PrivateKey: &cmapi.CertificatePrivateKey{
+						RotationPolicy:   cmapi.RotationPolicyScheduled,
+						RotationInterval: &metav1.Duration{Duration: time.Hour * 24},
+					},
+				},
+				Status: cmapi.CertificateStatus{
+					LastPrivateKeyRotationTime: &metav1.Time{Time: clock.Now().Add(time.Hour * -1)},
+				},
+			},
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "something",
+					Annotations: map[string]string{
+						cmapi.IssuerNameAnnotationKey:  "testissuer",
+						cmapi.IssuerKindAnnotationKey:  "IssuerKind",
+						cmapi.IssuerGroupAnnotationKey: "group.example.com",
+					},
+				},
+				Data: map[string][]byte{
+					corev1.TLSPrivateKeyKey: staticFixedPrivateKey,
+					corev1.TLSCertKey: testcrypto.MustCreateCertWithNotBeforeAfter(t, staticFixedPrivateKey,
+						&cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "example.com"}},
+						clock.Now().Add(time.Hour*-1),
+						// not due for renewal
+						clock.Now().Add(time.Hour*24*90),
+					),
+				},
+			},
+		},
+		"trigger issuance if the scheduled private key rotation is due": {
+			certificate: &cmapi.Certificate{
+				Spec: cmapi.CertificateSpec{
+					CommonName: "example.com",
+					IssuerRef: cmmeta.ObjectReference{
+						Name:  "testissuer",
+						Kind:  "IssuerKind",
+						Group: "group.example.com",
+					},
+					PrivateKey: &cmapi.CertificatePrivateKey{
+						RotationPolicy:   cmapi.RotationPolicyScheduled,
+						RotationInterval: &metav1.Duration{Duration: time.Hour * 24},
+					},
+				},
+				Status: cmapi.CertificateStatus{
+					LastPrivateKeyRotationTime: &metav1.Time{Time: clock.Now().Add(time.Hour * -24)},
+				},
+			},
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "something",
+					Annotations: map[string]string{
+						cmapi.IssuerNameAnnotationKey:  "testissuer",
+						cmapi.IssuerKindAnnotationKey:  "IssuerKind",
+						cmapi.IssuerGroupAnnotationKey: "group.example.com",
+					},
+				},
+				Data: map[string][]byte{
+					corev1.TLSPrivateKeyKey: staticFixedPrivateKey,
+					corev1.TLSCertKey: testcrypto.MustCreateCertWithNotBeforeAfter(t, staticFixedPrivateKey,
+						&cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "example.com"}},
+						clock.Now().Add(time.Hour*-1),
+						// not due for renewal
+						clock.Now().Add(time.Hour*24*90),
+					),
+				},
+			},
+			reason:  PrivateKeyRotationDue,
+			message: "Rotating private key as rotation was scheduled at 0001-01-01T00:00:00Z",
+			reissue: true,
+		},
 		"trigger renewal if renewalTime is right now": {
 			certificate: &cmapi.Certificate{
 				Spec: cmapi.CertificateSpec{
@@ -544,6 +646,47 @@ func Test_NewTriggerPolicyChain(t *testing.T) {
 				},
 			},
 		},
+		"trigger issuance if the certificate in the Secret has been revoked": {
+			certificate: &cmapi.Certificate{
+				Spec: cmapi.CertificateSpec{
+					CommonName: "example.com",
+					IssuerRef: cmmeta.ObjectReference{
+						Name:  "testissuer",
+						Kind:  "IssuerKind",
+						Group: "group.example.com",
+					},
+				},
+				Status: cmapi.CertificateStatus{
+					LastRevocation: &cmapi.CertificateRevocation{
+						SerialNumber:   revokedSerial,
+						RevocationTime: metav1.NewTime(clock.Now()),
+					},
+				},
+			},
+			secret:  revokedSecret,
+			reason:  Revoked,
+			message: fmt.Sprintf("Certificate with serial number %s was revoked at %s", revokedSerial, clock.Now().Format(time.RFC3339)),
+			reissue: true,
+		},
+		"does not trigger issuance if a previous certificate has been revoked": {
+			certificate: &cmapi.Certificate{
+				Spec: cmapi.CertificateSpec{
+					CommonName: "example.com",
+					IssuerRef: cmmeta.ObjectReference{
+						Name:  "testissuer",
+						Kind:  "IssuerKind",
+						Group: "group.example.com",
+					},
+				},
+				Status: cmapi.CertificateStatus{
+					LastRevocation: &cmapi.CertificateRevocation{
+						SerialNumber:   "1a2b3c",
+						RevocationTime: metav1.NewTime(clock.Now()),
+					},
+				},
+			},
+			secret: revokedSecret,
+		},
 	}
 	policyChain := NewTriggerPolicyChain(clock)
 	for name, test := range tests {
@@ -687,6 +830,14 @@ func Test_SecretManagedLabelsAndAnnotationsManagedFieldsMismatch(t *testing.T) {
 }
 
 func Test_SecretSecretTemplateMismatch(t *testing.T) {
+	certPEM := testcrypto.MustCreateCert(t, testcrypto.MustCreatePEMPrivateKey(t),
+		&cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "example.com"}})
+	cert, err := pki.DecodeX509CertificateBytes(certPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serial := cert.SerialNumber.Text(16)
+
 	tests := map[string]struct {
 		tmpl         *cmapi.CertificateSecretTemplate
 		secret       *corev1.Secret
@@ -816,6 +967,52 @@ func Test_SecretSecretTemplateMismatch(t *testing.T) {
 			expReason:    "",
 			expMessage:   "",
 		},
+		"if SecretTemplate has templated values which match the rendered values on the Secret, return false": {
+			tmpl: &cmapi.CertificateSecretTemplate{
+				Annotations:     map[string]string{"serial": "{{ .SerialNumber }}"},
+				Labels:          map[string]string{"serial": "{{ upper .SerialNumber }}"},
+				RenderTemplates: true,
+			},
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{"serial": serial},
+					Labels:      map[string]string{"serial": strings.ToUpper(serial)},
+				},
+				Data: map[string][]byte{corev1.TLSCertKey: certPEM},
+			},
+			expViolation: false,
+			expReason:    "",
+			expMessage:   "",
+		},
+		"if SecretTemplate has templated values which don't match the rendered values on the Secret, return true": {
+			tmpl: &cmapi.CertificateSecretTemplate{
+				Annotations:     map[string]string{"serial": "{{ .SerialNumber }}"},
+				RenderTemplates: true,
+			},
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{"serial": "{{ .SerialNumber }}"},
+				},
+				Data: map[string][]byte{corev1.TLSCertKey: certPEM},
+			},
+			expViolation: true,
+			expReason:    SecretTemplateMismatch,
+			expMessage:   "Certificate's SecretTemplate Annotations missing or incorrect value on Secret",
+		},
+		"if SecretTemplate has template-like values but RenderTemplates is not set, compare them as is": {
+			tmpl: &cmapi.CertificateSecretTemplate{
+				Annotations: map[string]string{"serial": "{{ .SerialNumber }}"},
+			},
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{"serial": "{{ .SerialNumber }}"},
+				},
+				Data: map[string][]byte{corev1.TLSCertKey: certPEM},
+			},
+			expViolation: false,
+			expReason:    "",
+			expMessage:   "",
+		},
 	}
 
 	for name, test := range tests {
@@ -1146,7 +1343,8 @@ func Test_SecretSecretTemplateManagedFieldsMismatch(t *testing.T) {
 }
 
 func Test_SecretAdditionalOutputFormatsMismatch(t *testing.T) {
-	cert := []byte("a")
+	certDER := []byte("a")
+	cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})
 	pk := testcrypto.MustCreatePEMPrivateKey(t)
 	block, _ := pem.Decode(pk)
 	pkDER := block.Bytes
@@ -1288,6 +1486,7 @@ func Test_SecretAdditionalOutputFormatsMismatch(t *testing.T) {
 						"tls.crt": cert,
 						"tls.key": pk,
 						"key.der": pkDER,
+						"tls.der": certDER,
 					},
 				},
 			},
@@ -1295,6 +1494,45 @@ func Test_SecretAdditionalOutputFormatsMismatch(t *testing.T) {
 			expMessage:   "",
 			expViolation: false,
 		},
+		"if additional output has der and Secret has correct der key but no der certificate, should return true": {
+			input: Input{
+				Certificate: &cmapi.Certificate{Spec: cmapi.CertificateSpec{
+					AdditionalOutputFormats: []cmapi.CertificateAdditionalOutputFormat{
+						{Type: "DER"},
+					}},
+				},
+				Secret: &corev1.Secret{
+					Data: map[string][]byte{
+						"tls.crt": cert,
+						"tls.key": pk,
+						"key.der": pkDER,
+					},
+				},
+			},
+			expReason:    "AdditionalOutputFormatsMismatch",
+			expMessage:   "Certificate's AdditionalOutputFormats doesn't match Secret Data",
+			expViolation: true,
+		},
+		"if additional output has der and Secret has correct der key and wrong der certificate, should return true": {
+			input: Input{
+				Certificate: &cmapi.Certificate{Spec: cmapi.CertificateSpec{
+					AdditionalOutputFormats: []cmapi.CertificateAdditionalOutputFormat{
+						{Type: "DER"},
+					}},
+				},
+				Secret: &corev1.Secret{
+					Data: map[string][]byte{
+						"tls.crt": cert,
+						"tls.key": pk,
+						"key.der": pkDER,
+						"tls.der": []byte("wrong"),
+					},
+				},
+			},
+			expReason:    "AdditionalOutputFormatsMismatch",
+			expMessage:   "Certificate's AdditionalOutputFormats doesn't match Secret Data",
+			expViolation: true,
+		},
 		"if additional output has combined and der and Secret has correct combined and der, should return false": {
 			input: Input{
 				Certificate: &cmapi.Certificate{Spec: cmapi.CertificateSpec{
@@ -1308,6 +1546,7 @@ func Test_SecretAdditionalOutputFormatsMismatch(t *testing.T) {
 						"tls.crt":          cert,
 						"tls.key":          pk,
 						"key.der":          pkDER,
+						"tls.der":          certDER,
 						"tls-combined.pem": combinedPEM,
 					},
 				},
@@ -1398,6 +1637,29 @@ func Test_SecretAdditionalOutputFormatsMismatch(t *testing.T) {
 			expMessage:   "Certificate's AdditionalOutputFormats doesn't match Secret Data",
 			expViolation: true,
 		},
+		"if additional output has der and the der keys are preserved for a third party, should return false": {
+			input: Input{
+				Certificate: &cmapi.Certificate{
+					ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+						cmapi.PreserveSecretKeysAnnotationKey: "key.der,tls.der",
+					}},
+					Spec: cmapi.CertificateSpec{
+						AdditionalOutputFormats: []cmapi.CertificateAdditionalOutputFormat{
+							{Type: "DER"},
+						}},
+				},
+				Secret: &corev1.Secret{
+					Data: map[string][]byte{
+						"tls.crt": cert,
+						"tls.key": pk,
+						"key.der": []byte("third-party"),
+					},
+				},
+			},
+			expReason:    "",
+			expMessage:   "",
+			expViolation: false,
+		},
 	}
 
 	for name, test := range tests {
@@ -1724,7 +1986,8 @@ func Test_SecretAdditionalOutputFormatsManagedFieldsMismatch(t *testing.T) {
 								Raw: []byte(`
               {"f:data": {
 							  ".": {},
-								"f:key.der": {}
+								"f:key.der": {},
+								"f:tls.der": {}
 							}}`),
 							}},
 						},
@@ -1735,6 +1998,54 @@ func Test_SecretAdditionalOutputFormatsManagedFieldsMismatch(t *testing.T) {
 			expMessage:   "",
 			expViolation: false,
 		},
+		"if additional output formats has der, and secret has managed fields for the der key but not the der certificate, should return true": {
+			input: Input{
+				Certificate: &cmapi.Certificate{Spec: cmapi.CertificateSpec{
+					AdditionalOutputFormats: []cmapi.CertificateAdditionalOutputFormat{
+						{Type: "DER"},
+					}},
+				},
+				Secret: &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						ManagedFields: []metav1.ManagedFieldsEntry{
+							{Manager: fieldManager, FieldsV1: &metav1.FieldsV1{
+								Raw: []byte(`
+              {"f:data": {
+							  ".": {},
+								"f:key.der": {}
+							}}`),
+							}},
+						},
+					},
+				},
+			},
+			expReason:    "AdditionalOutputFormatsMismatch",
+			expMessage:   "Certificate's AdditionalOutputFormats doesn't match Secret ManagedFields",
+			expViolation: true,
+		},
+		"if additional output formats is empty, and secret has managed fields for the der certificate, should return true": {
+			input: Input{
+				Certificate: &cmapi.Certificate{Spec: cmapi.CertificateSpec{
+					AdditionalOutputFormats: []cmapi.CertificateAdditionalOutputFormat{}},
+				},
+				Secret: &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						ManagedFields: []metav1.ManagedFieldsEntry{
+							{Manager: fieldManager, FieldsV1: &metav1.FieldsV1{
+								Raw: []byte(`
+              {"f:data": {
+							  ".": {},
+								"f:tls.der": {}
+							}}`),
+							}},
+						},
+					},
+				},
+			},
+			expReason:    "AdditionalOutputFormatsMismatch",
+			expMessage:   "Certificate's AdditionalOutputFormats doesn't match Secret ManagedFields",
+			expViolation: true,
+		},
 		"if additional output formats has combined pem and der, and secret has managed fields for combined pem and der, should return false": {
 			input: Input{
 				Certificate: &cmapi.Certificate{Spec: cmapi.CertificateSpec{
@@ -1751,6 +2062,7 @@ func Test_SecretAdditionalOutputFormatsManagedFieldsMismatch(t *testing.T) {
               {"f:data": {
 							  ".": {},
 								"f:key.der": {},
+								"f:tls.der": {},
 								"f:tls-combined.pem": {}
 							}}`),
 							}},
@@ -1777,7 +2089,8 @@ func Test_SecretAdditionalOutputFormatsManagedFieldsMismatch(t *testing.T) {
 								Raw: []byte(`
               {"f:data": {
 							  ".": {},
-								"f:key.der": {}
+								"f:key.der": {},
+								"f:tls.der": {}
 							}}`),
 							}},
 							{Manager: fieldManager, FieldsV1: &metav1.FieldsV1{
@@ -1811,7 +2124,8 @@ func Test_SecretAdditionalOutputFormatsManagedFieldsMismatch(t *testing.T) {
               {"f:data": {
 							  ".": {},
 								"f:tls-combined.pem": {},
-								"f:key.der": {}
+								"f:key.der": {},
+								"f:tls.der": {}
 							}}`),
 							}},
 							{Manager: "not-cert-manager", FieldsV1: &metav1.FieldsV1{
@@ -1830,6 +2144,23 @@ func Test_SecretAdditionalOutputFormatsManagedFieldsMismatch(t *testing.T) {
 			expMessage:   "",
 			expViolation: false,
 		},
+		"if additional output formats has der, and the der keys are preserved on the secret with no managed fields, should return false": {
+			input: Input{
+				Certificate: &cmapi.Certificate{Spec: cmapi.CertificateSpec{
+					AdditionalOutputFormats: []cmapi.CertificateAdditionalOutputFormat{
+						{Type: "DER"},
+					}},
+				},
+				Secret: &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+						cmapi.PreserveSecretKeysAnnotationKey: "key.der,tls.der",
+					}},
+				},
+			},
+			expReason:    "",
+			expMessage:   "",
+			expViolation: false,
+		},
 	}
 
 	for name, test := range tests {
@@ -53,6 +53,13 @@ const (
 	// Expired is a policy violation reason for a scenario where Certificate has
 	// expired.
 	Expired string = "Expired"
+	// Revoked is a policy violation reason for a scenario where the
+	// certificate in the Certificate's Secret has been recorded as revoked.
+	Revoked string = "Revoked"
+	// PrivateKeyRotationDue is a policy violation reason for a scenario where
+	// the Certificate's private key rotation policy is Scheduled and the
+	// private key is older than the rotation interval.
+	PrivateKeyRotationDue string = "PrivateKeyRotationDue"
 	// SecretTemplateMisMatch is a policy violation whereby the Certificate's
 	// SecretTemplate is not reflected on the target Secret, either by having
 	// extra, missing, or wrong Annotations or Labels.
@@ -77,7 +77,9 @@ func NewTriggerPolicyChain(c clock.Clock) Chain {
 		SecretPrivateKeyMismatchesSpec,                      // Make sure the PrivateKey Type and Size match the Certificate spec
 		SecretPublicKeyDiffersFromCurrentCertificateRequest, // Make sure the Secret's PublicKey matches the current CertificateRequest
 		CurrentCertificateRequestMismatchesSpec,             // Make sure the current CertificateRequest matches the Certificate spec
+		CurrentCertificateRevoked,                           // Make sure the Certificate in the Secret has not been revoked
 		CurrentCertificateNearingExpiry(c),                  // Make sure the Certificate in the Secret is not nearing expiry
+		CurrentPrivateKeyRotationDue(c),                     // Make sure the PrivateKey is not due to be rotated
 	}
 }
 
@@ -95,6 +97,7 @@ func NewReadinessPolicyChain(c clock.Clock) Chain {
 		SecretPrivateKeyMismatchesSpec,                      // Make sure the PrivateKey Type and Size match the Certificate spec
 		SecretPublicKeyDiffersFromCurrentCertificateRequest, // Make sure the Secret's PublicKey matches the current CertificateRequest
 		CurrentCertificateRequestMismatchesSpec,             // Make sure the current CertificateRequest matches the Certificate spec
+		CurrentCertificateRevoked,                           // Make sure the Certificate in the Secret has not been revoked
 		CurrentCertificateHasExpired(c),                     // Make sure the Certificate in the Secret has not expired
 	}
 }
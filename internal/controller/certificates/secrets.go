@@ -20,6 +20,10 @@ import (
 	"bytes"
 	"crypto/x509"
 	"encoding/pem"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmutil "github.com/cert-manager/cert-manager/pkg/util"
@@ -90,9 +94,41 @@ func OutputFormatDER(privateKey []byte) []byte {
 	return block.Bytes
 }
 
+// OutputFormatDERCertificate returns the byte slice of the leaf certificate
+// in DER format. To be used for Certificate's Additional Output Format DER.
+func OutputFormatDERCertificate(certificate []byte) []byte {
+	block, _ := pem.Decode(certificate)
+	if block == nil {
+		return nil
+	}
+	return block.Bytes
+}
+
 // OutputFormatCombinedPEM returns the byte slice of the PEM encoded private
 // key and signed certificate chain, concatenated. To be used for Certificate's
 // Additional Output Format Combined PEM.
 func OutputFormatCombinedPEM(privateKey, certificate []byte) []byte {
 	return bytes.Join([][]byte{privateKey, certificate}, []byte("\n"))
 }
+
+// PreservedSecretKeys returns the Secret data keys which have been listed in
+// the preserve-secret-keys annotation of the Certificate or the Secret. These
+// keys should not be written or removed by cert-manager. The `tls.crt` and
+// `tls.key` keys are never preserved. The Secret may be nil.
+func PreservedSecretKeys(crt *cmapi.Certificate, secret *corev1.Secret) sets.Set[string] {
+	keys := sets.New[string]()
+	addKeys := func(annotations map[string]string) {
+		for _, key := range strings.Split(annotations[cmapi.PreserveSecretKeysAnnotationKey], ",") {
+			if key = strings.TrimSpace(key); len(key) > 0 {
+				keys.Insert(key)
+			}
+		}
+	}
+
+	addKeys(crt.Annotations)
+	if secret != nil {
+		addKeys(secret.Annotations)
+	}
+
+	return keys.Delete(corev1.TLSCertKey, corev1.TLSPrivateKeyKey)
+}
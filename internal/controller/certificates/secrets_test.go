@@ -24,6 +24,14 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	testcrypto "github.com/cert-manager/cert-manager/test/unit/crypto"
 )
 
 func Test_AnnotationsForCertificateSecret(t *testing.T) {
@@ -137,3 +145,84 @@ func Test_AnnotationsForCertificateSecret(t *testing.T) {
 		})
 	}
 }
+
+func Test_OutputFormatDERCertificate(t *testing.T) {
+	pk := testcrypto.MustCreatePEMPrivateKey(t)
+	leafPEM := testcrypto.MustCreateCert(t, pk, &cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "leaf"}})
+	caPEM := testcrypto.MustCreateCert(t, pk, &cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "ca", IsCA: true}})
+
+	leaf, err := pki.DecodeX509CertificateBytes(leafPEM)
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		certificate []byte
+		expDER      []byte
+	}{
+		"a single certificate should be returned in DER": {
+			certificate: leafPEM,
+			expDER:      leaf.Raw,
+		},
+		"only the leaf of a chain should be returned in DER": {
+			certificate: append(append([]byte{}, leafPEM...), caPEM...),
+			expDER:      leaf.Raw,
+		},
+		"no certificate should return nil": {
+			certificate: nil,
+			expDER:      nil,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			der := OutputFormatDERCertificate(test.certificate)
+			assert.Equal(t, test.expDER, der)
+			if test.expDER == nil {
+				return
+			}
+
+			// The DER output must decode to the same certificate as the PEM
+			parsed, err := x509.ParseCertificate(der)
+			require.NoError(t, err)
+			assert.True(t, parsed.Equal(leaf), "DER certificate does not match the PEM certificate")
+		})
+	}
+}
+
+func Test_PreservedSecretKeys(t *testing.T) {
+	withAnnotation := func(value string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{Annotations: map[string]string{cmapi.PreserveSecretKeysAnnotationKey: value}}
+	}
+
+	tests := map[string]struct {
+		crt     *cmapi.Certificate
+		secret  *corev1.Secret
+		expKeys sets.Set[string]
+	}{
+		"no annotations should preserve no keys": {
+			crt:     &cmapi.Certificate{},
+			secret:  &corev1.Secret{},
+			expKeys: sets.New[string](),
+		},
+		"a nil Secret should only use the Certificate annotation": {
+			crt:     &cmapi.Certificate{ObjectMeta: withAnnotation("ca.crt")},
+			secret:  nil,
+			expKeys: sets.New("ca.crt"),
+		},
+		"keys from the Certificate and Secret should be combined": {
+			crt:     &cmapi.Certificate{ObjectMeta: withAnnotation("ca.crt, mesh.pem")},
+			secret:  &corev1.Secret{ObjectMeta: withAnnotation("mesh.pem,,keystore.p12 ")},
+			expKeys: sets.New("ca.crt", "mesh.pem", "keystore.p12"),
+		},
+		"tls.crt and tls.key should never be preserved": {
+			crt:     &cmapi.Certificate{ObjectMeta: withAnnotation("tls.crt,tls.key,ca.crt")},
+			secret:  &corev1.Secret{},
+			expKeys: sets.New("ca.crt"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expKeys, PreservedSecretKeys(test.crt, test.secret))
+		})
+	}
+}
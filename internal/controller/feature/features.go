@@ -137,6 +137,93 @@ const (
 	// Certificate resources.
 	// Github Issue: https://github.com/cert-manager/cert-manager/issues/6393
 	OtherNames featuregate.Feature = "OtherNames"
+
+	// Owner: N/A
+	// Alpha: v1.16
+	//
+	// CertificatePodReadinessGate enables the certificates-pod-readiness
+	// controller, which sets a readiness gate condition on Pods that reference
+	// a Certificate so that they only become Ready once it has been issued.
+	CertificatePodReadinessGate featuregate.Feature = "CertificatePodReadinessGate"
+
+	// Owner: N/A
+	// Alpha: v1.16
+	//
+	// IssuerAllowedRequesters makes the built-in CertificateRequest approver
+	// only approve requests for an Issuer or ClusterIssuer annotated with
+	// cert-manager.io/allowed-requesters if the requesting identity matches
+	// one of the patterns in the annotation. Other requests are left Pending.
+	IssuerAllowedRequesters featuregate.Feature = "IssuerAllowedRequesters"
+
+	// Owner: N/A
+	// Alpha: v1.16
+	//
+	// CertificatePodIdentity enables the certificates-pod-identity controller,
+	// which issues a short-lived Certificate identifying the ServiceAccount of
+	// each annotated Pod, and removes it once the Pod is deleted. Only the
+	// issuers listed in --pod-identity-allowed-issuers may be used.
+	CertificatePodIdentity featuregate.Feature = "CertificatePodIdentity"
+
+	// Owner: N/A
+	// Alpha: v1.16
+	//
+	// ACMERenewalInfo enables the certificates-acme-renewal-info controller,
+	// which fetches ACME Renewal Information (RFC 9773) for certificates issued
+	// by ACME issuers so that they are renewed within the window suggested by
+	// the ACME server rather than at the time derived from `renewBefore`.
+	ACMERenewalInfo featuregate.Feature = "ACMERenewalInfo"
+
+	// Owner: N/A
+	// Alpha: v1.16
+	//
+	// ExperimentalPostQuantumKeys allows Certificates to use the experimental
+	// ML-DSA post-quantum private key algorithm. Key generation fails if
+	// cert-manager was built with a Go version without ML-DSA support.
+	// This feature gate must be used together with the
+	// ExperimentalPostQuantumKeys webhook feature gate.
+	ExperimentalPostQuantumKeys featuregate.Feature = "ExperimentalPostQuantumKeys"
+
+	// Owner: N/A
+	// Alpha: v1.16
+	//
+	// CertificateRevocation enables the certificates-revocation controller,
+	// which records the revocation of a certificate marked with the
+	// cert-manager.io/revoked-serial-number annotation in the Certificate's
+	// status so that it is re-issued immediately, optionally revoking it with
+	// the ACME server first. It also enables the revocation of the
+	// certificates of deleted Certificates issued by an ACME issuer with
+	// revokeOnDelete set, by the certificates-revoke-on-delete controller.
+	// That controller is enabled by default. While the feature is disabled,
+	// it only removes the revoke-on-delete finalizers added while it was
+	// enabled.
+	CertificateRevocation featuregate.Feature = "CertificateRevocation"
+
+	// Owner: N/A
+	// Alpha: v1.16
+	//
+	// CertificateSecretMirror enables the certificates-secret-mirror
+	// controller, which mirrors the target Secret of Certificates listing
+	// spec.secretMirrorNamespaces into those namespaces, if they allow it with
+	// the cert-manager.io/allow-secret-mirror-from annotation.
+	CertificateSecretMirror featuregate.Feature = "CertificateSecretMirror"
+
+	// Owner: N/A
+	// Alpha: v1.16
+	//
+	// CertificateServiceDNSNames enables the certificates-service-dns-names
+	// controller, which resolves the Service referenced by a Certificate's
+	// spec.serviceRef into the cluster DNS names, and optionally the IP
+	// addresses, requested for it.
+	CertificateServiceDNSNames featuregate.Feature = "CertificateServiceDNSNames"
+
+	// Owner: N/A
+	// Alpha: v1.16
+	//
+	// CABundleIncludeSystemRoots makes the CA bundles configured on ACME, Vault,
+	// Venafi and signing webhook issuers trusted in addition to the system root
+	// CAs of the controller container. When disabled, a configured CA bundle
+	// replaces the system root CAs, so that only the configured CAs are trusted.
+	CABundleIncludeSystemRoots featuregate.Feature = "CABundleIncludeSystemRoots"
 )
 
 func init() {
@@ -160,4 +247,13 @@ var defaultCertManagerFeatureGates = map[featuregate.Feature]featuregate.Feature
 	UseCertificateRequestBasicConstraints:            {Default: false, PreRelease: featuregate.Alpha},
 	NameConstraints:                                  {Default: false, PreRelease: featuregate.Alpha},
 	OtherNames:                                       {Default: false, PreRelease: featuregate.Alpha},
+	CertificatePodReadinessGate:                      {Default: false, PreRelease: featuregate.Alpha},
+	IssuerAllowedRequesters:                          {Default: false, PreRelease: featuregate.Alpha},
+	CertificatePodIdentity:                           {Default: false, PreRelease: featuregate.Alpha},
+	ACMERenewalInfo:                                  {Default: false, PreRelease: featuregate.Alpha},
+	ExperimentalPostQuantumKeys:                      {Default: false, PreRelease: featuregate.Alpha},
+	CertificateRevocation:                            {Default: false, PreRelease: featuregate.Alpha},
+	CertificateSecretMirror:                          {Default: false, PreRelease: featuregate.Alpha},
+	CertificateServiceDNSNames:                       {Default: false, PreRelease: featuregate.Alpha},
+	CABundleIncludeSystemRoots:                       {Default: false, PreRelease: featuregate.Alpha},
 }
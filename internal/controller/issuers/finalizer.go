@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issuers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
+	"github.com/cert-manager/cert-manager/pkg/issuer"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+// SyncFinalizer manages the finalizer of an issuer implementation which
+// implements issuer.Finalizer. The finalizer is added to or removed from the
+// Issuer or ClusterIssuer depending on whether the issuer requires it. If the
+// issuer is being deleted, it is finalized before the finalizer is removed.
+// It returns true if the issuer has been updated or is being finalized, in
+// which case the issuer must not be set up.
+func SyncFinalizer(ctx context.Context, cl cmclient.Interface, iss cmapi.GenericIssuer, f issuer.Finalizer) (bool, error) {
+	name, required := f.Finalizer()
+	meta := iss.GetObjectMeta()
+	hasFinalizer := slices.Contains(meta.Finalizers, name)
+
+	if meta.DeletionTimestamp != nil {
+		if !hasFinalizer {
+			return false, nil
+		}
+		if err := f.Finalize(ctx); err != nil {
+			return true, err
+		}
+		required = false
+	} else if required == hasFinalizer {
+		return false, nil
+	}
+
+	iss = iss.DeepCopyObject().(cmapi.GenericIssuer)
+	meta = iss.GetObjectMeta()
+	if required {
+		meta.Finalizers = append(meta.Finalizers, name)
+	} else {
+		meta.Finalizers = slices.DeleteFunc(meta.Finalizers, func(f string) bool {
+			return f == name
+		})
+	}
+	logf.FromContext(ctx).V(logf.DebugLevel).Info("updating issuer finalizer", "finalizer", name, "required", required)
+
+	var err error
+	switch iss := iss.(type) {
+	case *cmapi.Issuer:
+		_, err = cl.CertmanagerV1().Issuers(iss.Namespace).Update(ctx, iss, metav1.UpdateOptions{})
+	case *cmapi.ClusterIssuer:
+		_, err = cl.CertmanagerV1().ClusterIssuers().Update(ctx, iss, metav1.UpdateOptions{})
+	default:
+		return true, fmt.Errorf("unexpected issuer type %T", iss)
+	}
+	if apierrors.IsNotFound(err) {
+		return true, nil
+	}
+	return true, err
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issuers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmfake "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned/fake"
+	"github.com/cert-manager/cert-manager/pkg/issuer/fake"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestSyncFinalizer(t *testing.T) {
+	const finalizer = "example.cert-manager.io/finalizer"
+	finalizeErr := errors.New("finalize failed")
+
+	tests := map[string]struct {
+		finalizers       []string
+		deleting         bool
+		required         bool
+		finalizeErr      error
+		expectErr        error
+		expectFinalizers []string
+		expectResult     bool
+		expectUpdate     bool
+		expectFinalize   bool
+	}{
+		"adds the finalizer if required": {
+			required:         true,
+			expectFinalizers: []string{finalizer},
+			expectResult:     true,
+			expectUpdate:     true,
+		},
+		"does nothing if the finalizer is present and required": {
+			finalizers: []string{finalizer},
+			required:   true,
+		},
+		"removes the finalizer if no longer required": {
+			finalizers:       []string{"other", finalizer},
+			expectFinalizers: []string{"other"},
+			expectResult:     true,
+			expectUpdate:     true,
+		},
+		"finalizes the issuer and removes the finalizer if deleted": {
+			finalizers:       []string{finalizer},
+			deleting:         true,
+			required:         true,
+			expectFinalizers: []string{},
+			expectResult:     true,
+			expectUpdate:     true,
+			expectFinalize:   true,
+		},
+		"keeps the finalizer if finalizing fails": {
+			finalizers:     []string{finalizer},
+			deleting:       true,
+			required:       true,
+			finalizeErr:    finalizeErr,
+			expectErr:      finalizeErr,
+			expectResult:   true,
+			expectFinalize: true,
+		},
+		"does nothing for a deleted issuer without the finalizer": {
+			deleting: true,
+			required: true,
+		},
+	}
+
+	issuers := map[string]func() cmapi.GenericIssuer{
+		"Issuer": func() cmapi.GenericIssuer {
+			return gen.Issuer("test", gen.SetIssuerNamespace("testns"), gen.SetIssuerCA(cmapi.CAIssuer{}))
+		},
+		"ClusterIssuer": func() cmapi.GenericIssuer {
+			return gen.ClusterIssuer("test", gen.SetIssuerCA(cmapi.CAIssuer{}))
+		},
+	}
+
+	for kind, newIssuer := range issuers {
+		for name, test := range tests {
+			t.Run(kind+"/"+name, func(t *testing.T) {
+				iss := newIssuer()
+				iss.GetObjectMeta().Finalizers = test.finalizers
+				if test.deleting {
+					iss.GetObjectMeta().DeletionTimestamp = &metav1.Time{}
+				}
+
+				finalizeCalled := false
+				cmClient := cmfake.NewSimpleClientset(iss)
+				f := &fake.FinalizerIssuer{
+					FinalizerName:     finalizer,
+					FinalizerRequired: test.required,
+					FinalizeFunc: func(context.Context) error {
+						finalizeCalled = true
+						return test.finalizeErr
+					},
+				}
+
+				result, err := SyncFinalizer(context.TODO(), cmClient, iss, f)
+				assert.ErrorIs(t, err, test.expectErr)
+				assert.Equal(t, test.expectResult, result, "result")
+				assert.Equal(t, test.expectFinalize, finalizeCalled, "finalize called")
+
+				updated := false
+				for _, action := range cmClient.Actions() {
+					if action.GetVerb() == "update" && action.GetSubresource() == "" {
+						updated = true
+					}
+				}
+				assert.Equal(t, test.expectUpdate, updated, "issuer updated")
+				if test.expectUpdate {
+					var got cmapi.GenericIssuer
+					if iss.GetObjectMeta().Namespace != "" {
+						got, err = cmClient.CertmanagerV1().Issuers("testns").Get(context.TODO(), "test", metav1.GetOptions{})
+					} else {
+						got, err = cmClient.CertmanagerV1().ClusterIssuers().Get(context.TODO(), "test", metav1.GetOptions{})
+					}
+					assert.NoError(t, err)
+					assert.ElementsMatch(t, test.expectFinalizers, got.GetObjectMeta().Finalizers)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,24 @@
+//go:build boringcrypto
+
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fips
+
+// Build is true if cert-manager was built as the FIPS variant, i.e. with the
+// boringcrypto GOEXPERIMENT. FIPS builds refuse to generate or accept private
+// key algorithms which are not approved for use in FIPS 140 mode.
+const Build = true
@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fips reports whether the cryptographic module cert-manager was
+// built with is operating in FIPS 140 mode.
+//
+// Go 1.24 and later ship with the Go Cryptographic Module, which operates in
+// FIPS 140-3 mode when run with GODEBUG=fips140=on (or only). Builds using the
+// boringcrypto GOEXPERIMENT are always in FIPS mode.
+package fips
+
+import (
+	"errors"
+
+	"github.com/go-logr/logr"
+)
+
+// ErrNotEnabled is returned when the cryptographic module is not operating
+// in FIPS 140 mode.
+var ErrNotEnabled = errors.New("the cryptographic module is not operating in FIPS 140 mode: run with GODEBUG=fips140=on, or build with a FIPS 140 validated module such as boringcrypto")
+
+// enabled is a variable so that it can be overridden in tests.
+var enabled = moduleEnabled
+
+// Enabled returns true if the cryptographic module is operating in FIPS 140
+// mode.
+func Enabled() bool {
+	return enabled()
+}
+
+// Check returns ErrNotEnabled if the cryptographic module is not operating
+// in FIPS 140 mode.
+func Check() error {
+	if !enabled() {
+		return ErrNotEnabled
+	}
+	return nil
+}
+
+// AssertEnabled is called once at startup. If the cryptographic module is
+// not operating in FIPS 140 mode, it logs a warning, or returns an error if
+// strict is true so that the caller can refuse to start.
+func AssertEnabled(log logr.Logger, strict bool) error {
+	err := Check()
+	if err == nil {
+		log.Info("the cryptographic module is operating in FIPS 140 mode")
+		return nil
+	}
+	if strict {
+		return err
+	}
+
+	log.Error(err, "WARNING: cert-manager is NOT running in FIPS 140 mode, cryptographic operations may use non-approved algorithms")
+	return nil
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fips
+
+import (
+	"testing"
+
+	logtesting "github.com/go-logr/logr/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssertEnabled(t *testing.T) {
+	tests := map[string]struct {
+		enabled   bool
+		strict    bool
+		expectErr error
+	}{
+		"FIPS mode enabled": {
+			enabled: true,
+		},
+		"FIPS mode enabled in strict mode": {
+			enabled: true,
+			strict:  true,
+		},
+		"FIPS mode disabled only warns": {
+			enabled: false,
+		},
+		"FIPS mode disabled in strict mode returns an error": {
+			enabled:   false,
+			strict:    true,
+			expectErr: ErrNotEnabled,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer func(orig func() bool) { enabled = orig }(enabled)
+			enabled = func() bool { return test.enabled }
+
+			assert.Equal(t, test.enabled, Enabled())
+			if test.enabled {
+				assert.NoError(t, Check())
+			} else {
+				assert.ErrorIs(t, Check(), ErrNotEnabled)
+			}
+			assert.Equal(t, test.expectErr, AssertEnabled(logtesting.NewTestLogger(t), test.strict))
+		})
+	}
+}
@@ -79,6 +79,18 @@ func (bf *baseFactory) CertificateSigningRequests() certificatesv1.CertificateSi
 	return bf.f.Certificates().V1().CertificateSigningRequests()
 }
 
+func (bf *baseFactory) Pods() corev1informers.PodInformer {
+	return bf.f.Core().V1().Pods()
+}
+
+func (bf *baseFactory) Namespaces() corev1informers.NamespaceInformer {
+	return bf.f.Core().V1().Namespaces()
+}
+
+func (bf *baseFactory) Services() corev1informers.ServiceInformer {
+	return bf.f.Core().V1().Services()
+}
+
 var _ SecretInformer = &baseSecretInformer{}
 
 // baseSecretInformer is an implementation of SecretInformer that only uses
@@ -123,6 +123,18 @@ func (bf *filteredSecretsFactory) CertificateSigningRequests() certificatesv1.Ce
 	return bf.typedInformerFactory.Certificates().V1().CertificateSigningRequests()
 }
 
+func (bf *filteredSecretsFactory) Pods() corev1informers.PodInformer {
+	return bf.typedInformerFactory.Core().V1().Pods()
+}
+
+func (bf *filteredSecretsFactory) Namespaces() corev1informers.NamespaceInformer {
+	return bf.typedInformerFactory.Core().V1().Namespaces()
+}
+
+func (bf *filteredSecretsFactory) Services() corev1informers.ServiceInformer {
+	return bf.typedInformerFactory.Core().V1().Services()
+}
+
 func (bf *filteredSecretsFactory) Secrets() SecretInformer {
 	f := func(client kubernetes.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
 		return corev1informers.NewFilteredSecretInformer(client, bf.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, func(listOptions *metav1.ListOptions) {
@@ -19,7 +19,6 @@ package vault
 import (
 	"context"
 	"crypto/tls"
-	"crypto/x509"
 	"errors"
 	"fmt"
 	"net/http"
@@ -35,15 +34,23 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
+	"github.com/cert-manager/cert-manager/internal/controller/feature"
 	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
 	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	cmerrors "github.com/cert-manager/cert-manager/pkg/util/errors"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
 )
 
 var _ Interface = &Vault{}
 
+// ErrReauthenticationFailed is returned by Sign when Vault rejected the token
+// used to sign a certificate, for example because it had expired, and logging
+// in to Vault again failed. Callers should retry rather than consider the
+// request failed.
+var ErrReauthenticationFailed = errors.New("failed to re-authenticate with Vault")
+
 // ClientBuilder is a function type that returns a new Interface.
 // Can be used in tests to create a mock signer of Vault certificate requests.
 type ClientBuilder func(ctx context.Context, namespace string, _ func(ns string) CreateToken, _ internalinformers.SecretLister, _ v1.GenericIssuer) (Interface, error)
@@ -91,6 +98,12 @@ type Vault struct {
 	// header is provided
 	// See https://developer.hashicorp.com/vault/docs/enterprise/namespaces#root-only-api-paths
 	clientSys Client
+
+	// reauthenticate logs in to Vault again using the namespaced client,
+	// re-reading the credentials of the configured authentication method.
+	// It is nil if the authentication method requests a new Vault token when
+	// the client is created, as logging in again would not help.
+	reauthenticate func() error
 }
 
 // New returns a new Vault instance with the given namespace, issuer and
@@ -128,6 +141,11 @@ func New(ctx context.Context, namespace string, createTokenFn func(ns string) Cr
 
 	// A client for use with namespaced API paths
 	v.client = clientNS
+	if canReauthenticate(issuer.GetSpec().Vault.Auth) {
+		v.reauthenticate = func() error {
+			return v.setToken(ctx, clientNS)
+		}
+	}
 
 	// Create duplicate Vault client without a namespace, for interacting with root-only API paths.
 	// For backwards compatibility, this client will use the token from the namespaced client,
@@ -146,11 +164,17 @@ func (v *Vault) Sign(csrPEM []byte, duration time.Duration) (cert []byte, ca []b
 		return nil, nil, fmt.Errorf("failed to decode CSR for signing: %s", err)
 	}
 
+	otherNames, err := pki.OtherNamesFromExtensions(csr.Extensions)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decode otherName SANs from CSR for signing: %s", err)
+	}
+
 	parameters := map[string]string{
 		"common_name": csr.Subject.CommonName,
 		"alt_names":   strings.Join(csr.DNSNames, ","),
 		"ip_sans":     strings.Join(pki.IPAddressesToString(csr.IPAddresses), ","),
 		"uri_sans":    strings.Join(pki.URLsToString(csr.URIs), ","),
+		"other_sans":  strings.Join(otherSANs(otherNames), ","),
 		"ttl":         duration.String(),
 		"csr":         string(csrPEM),
 
@@ -160,15 +184,19 @@ func (v *Vault) Sign(csrPEM []byte, duration time.Duration) (cert []byte, ca []b
 	vaultIssuer := v.issuer.GetSpec().Vault
 	url := path.Join("/v1", vaultIssuer.Path)
 
-	request := v.client.NewRequest("POST", url)
-
-	if err := request.SetJSONBody(parameters); err != nil {
-		return nil, nil, fmt.Errorf("failed to build vault request: %s", err)
+	resp, err := v.signRequest(url, parameters)
+	if IsPermissionDenied(err) && v.reauthenticate != nil {
+		// The Vault token may have expired or been revoked since it was
+		// requested, for example when it was obtained using a projected
+		// ServiceAccount token which has since been rotated. Log in again,
+		// which re-reads the credentials, and retry once.
+		if authErr := v.reauthenticate(); authErr != nil {
+			return nil, nil, fmt.Errorf("%w after the Vault token was rejected (%s): %w", ErrReauthenticationFailed, err, authErr)
+		}
+		resp, err = v.signRequest(url, parameters)
 	}
-
-	resp, err := v.client.RawRequest(request)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to sign certificate by vault: %s", err)
+		return nil, nil, fmt.Errorf("failed to sign certificate by vault: %w", err)
 	}
 
 	defer resp.Body.Close()
@@ -182,6 +210,57 @@ func (v *Vault) Sign(csrPEM []byte, duration time.Duration) (cert []byte, ca []b
 	return extractCertificatesFromVaultCertificateSecret(&vaultResult)
 }
 
+// otherSANs formats otherName SANs in the "<oid>;UTF8:<value>" form expected
+// by the other_sans parameter of the Vault PKI sign endpoint.
+func otherSANs(otherNames []v1.OtherName) []string {
+	sans := make([]string, 0, len(otherNames))
+	for _, otherName := range otherNames {
+		sans = append(sans, otherName.OID+";UTF8:"+otherName.UTF8Value)
+	}
+	return sans
+}
+
+// signRequest sends a signing request to Vault. A new request is built on
+// each call so that it uses the current token of the client.
+func (v *Vault) signRequest(url string, parameters map[string]string) (*vault.Response, error) {
+	request := v.client.NewRequest("POST", url)
+
+	if err := request.SetJSONBody(parameters); err != nil {
+		return nil, fmt.Errorf("failed to build vault request: %s", err)
+	}
+
+	return v.client.RawRequest(request)
+}
+
+// IsPermissionDenied returns true if Vault rejected a request with a 403
+// status, for example because the token or the credentials used to log in
+// are invalid or have expired.
+func IsPermissionDenied(err error) bool {
+	var respErr *vault.ResponseError
+	return errors.As(err, &respErr) && respErr.StatusCode == http.StatusForbidden
+}
+
+// canReauthenticate returns true if the Vault token used by a client created
+// with the given authentication method may have expired or been revoked while
+// the client is in use, and logging in again may return a different token.
+// This is the case for a token read from a Secret, and for Kubernetes auth
+// with a ServiceAccount token read from a Secret, both of which may have been
+// rotated. The other authentication methods request a new token when the
+// client is created.
+func canReauthenticate(auth v1.VaultAuth) bool {
+	// The order of precedence matches setToken.
+	switch {
+	case auth.TokenSecretRef != nil:
+		return true
+	case auth.AppRole != nil, auth.ClientCertificate != nil:
+		return false
+	case auth.Kubernetes != nil:
+		return auth.Kubernetes.SecretRef.Name != ""
+	default:
+		return false
+	}
+}
+
 func (v *Vault) setToken(ctx context.Context, client Client) error {
 	// IMPORTANT: Because of backwards compatibility with older versions that
 	// incorrectly allowed multiple authentication methods to be specified at
@@ -246,8 +325,7 @@ func (v *Vault) newConfig() (*vault.Config, error) {
 	}
 
 	if len(caBundle) != 0 {
-		caCertPool := x509.NewCertPool()
-		ok := caCertPool.AppendCertsFromPEM(caBundle)
+		caCertPool, ok := pki.CertPoolFromCABundle(caBundle, utilfeature.DefaultFeatureGate.Enabled(feature.CABundleIncludeSystemRoots))
 		if !ok {
 			return nil, fmt.Errorf("no Vault CA bundles loaded, check bundle contents")
 		}
@@ -420,7 +498,7 @@ func (v *Vault) requestTokenWithAppRoleRef(client Client, appRole *v1.VaultAppRo
 
 	resp, err := client.RawRequest(request)
 	if err != nil {
-		return "", fmt.Errorf("error logging in to Vault server: %s", err.Error())
+		return "", fmt.Errorf("error logging in to Vault server: %w", err)
 	}
 
 	defer resp.Body.Close()
@@ -497,7 +575,7 @@ func (v *Vault) requestTokenWithClientCertificate(client Client, clientCertifica
 
 	resp, err := client.RawRequest(request)
 	if err != nil {
-		return "", fmt.Errorf("error calling Vault server: %s", err.Error())
+		return "", fmt.Errorf("error calling Vault server: %w", err)
 	}
 
 	defer resp.Body.Close()
@@ -534,7 +612,9 @@ func (v *Vault) requestTokenWithKubernetesAuth(ctx context.Context, client Clien
 			return "", fmt.Errorf("no data for %q in secret '%s/%s'", key, v.namespace, kubernetesAuth.SecretRef.Name)
 		}
 
-		jwt = string(keyBytes)
+		// The Secret is read on each login, so that rotated tokens are
+		// picked up.
+		jwt = strings.TrimSpace(string(keyBytes))
 
 	case kubernetesAuth.ServiceAccountRef != nil:
 		defaultAudience := "vault://"
@@ -598,7 +678,7 @@ func (v *Vault) requestTokenWithKubernetesAuth(ctx context.Context, client Clien
 
 	resp, err := client.RawRequest(request)
 	if err != nil {
-		return "", fmt.Errorf("error calling Vault server: %s", err.Error())
+		return "", fmt.Errorf("error calling Vault server: %w", err)
 	}
 
 	defer resp.Body.Close()
@@ -22,6 +22,7 @@ import (
 	"crypto"
 	"crypto/rsa"
 	"crypto/x509"
+	"encoding/pem"
 	"errors"
 	"fmt"
 	"io"
@@ -1246,8 +1247,7 @@ func TestNewConfig(t *testing.T) {
 			),
 			expectedErr: nil,
 			checkFunc: func(cfg *vault.Config, err error) error {
-				testCA := x509.NewCertPool()
-				testCA.AppendCertsFromPEM([]byte(testLeafCertificate))
+				testCA, _ := pki.CertPoolFromCABundle([]byte(testLeafCertificate), false)
 				clientCA := cfg.HttpClient.Transport.(*http.Transport).TLSClientConfig.RootCAs
 
 				if !clientCA.Equal(testCA) {
@@ -1276,8 +1276,7 @@ func TestNewConfig(t *testing.T) {
 					return err
 				}
 
-				testCA := x509.NewCertPool()
-				testCA.AppendCertsFromPEM([]byte(testLeafCertificate))
+				testCA, _ := pki.CertPoolFromCABundle([]byte(testLeafCertificate), false)
 				clientCA := cfg.HttpClient.Transport.(*http.Transport).TLSClientConfig.RootCAs
 
 				if !clientCA.Equal(testCA) {
@@ -1305,8 +1304,7 @@ func TestNewConfig(t *testing.T) {
 					return err
 				}
 
-				testCA := x509.NewCertPool()
-				testCA.AppendCertsFromPEM([]byte(testLeafCertificate))
+				testCA, _ := pki.CertPoolFromCABundle([]byte(testLeafCertificate), false)
 				clientCA := cfg.HttpClient.Transport.(*http.Transport).TLSClientConfig.RootCAs
 
 				if !clientCA.Equal(testCA) {
@@ -1446,6 +1444,51 @@ func TestNewConfig(t *testing.T) {
 	}
 }
 
+func TestNewConfigTrustsCABundle(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	tests := map[string]struct {
+		caBundle []byte
+		expErr   bool
+	}{
+		"a client without a CA bundle does not trust the server": {
+			expErr: true,
+		},
+		"a client with the server's CA in its CA bundle trusts the server": {
+			caBundle: caBundle,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			v := &Vault{
+				namespace: "test-namespace",
+				issuer: gen.Issuer("vault-issuer",
+					gen.SetIssuerVault(cmapi.VaultIssuer{
+						Server:   server.URL,
+						CABundle: test.caBundle,
+					}),
+				),
+			}
+
+			cfg, err := v.newConfig()
+			require.NoError(t, err)
+
+			resp, err := cfg.HttpClient.Get(server.URL)
+			if test.expErr != (err != nil) {
+				t.Fatalf("unexpected error, exp=%t got=%v", test.expErr, err)
+			}
+			if resp != nil {
+				resp.Body.Close()
+			}
+		})
+	}
+}
+
 type requestTokenWithAppRoleRefT struct {
 	client  Client
 	appRole *cmapi.VaultAppRole
@@ -1761,3 +1804,296 @@ func TestSignIntegration(t *testing.T) {
 	require.NotEmpty(t, certPEM)
 	require.NotEmpty(t, caPEM)
 }
+
+// TestSignRequestParameters demonstrates that the SANs requested in the CSR and
+// the requested duration are passed to the Vault PKI sign endpoint.
+func TestSignRequestParameters(t *testing.T) {
+	const vaultPath = "my_pki_mount/sign/my-role-name"
+
+	privatekey := generateRSAPrivateKey(t)
+	template, err := pki.GenerateCSR(gen.Certificate("test",
+		gen.SetCertificateCommonName("test"),
+		gen.SetCertificateDNSNames("example.com", "www.example.com"),
+		gen.SetCertificateIPs("10.0.0.1", "2001:db8::1"),
+		gen.SetCertificateURIs("spiffe://cluster.local/ns/test/sa/test"),
+		gen.SetCertificateOtherNames(
+			cmapi.OtherName{OID: "1.3.6.1.4.1.311.20.2.3", UTF8Value: "user@example.com"},
+			cmapi.OtherName{OID: "1.2.3.4", UTF8Value: "other"},
+		),
+	), pki.WithOtherNames(true))
+	require.NoError(t, err)
+	csrDER, err := pki.EncodeCSR(template, privatekey)
+	require.NoError(t, err)
+	csrPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDER})
+
+	rootBundleData, err := bundlePEM(testIntermediateCa, testRootCa)
+	require.NoError(t, err)
+
+	var parameters map[string]string
+	mux := http.NewServeMux()
+	mux.HandleFunc(fmt.Sprintf("/v1/%s", vaultPath), func(response http.ResponseWriter, request *http.Request) {
+		require.NoError(t, jsonutil.DecodeJSONFromReader(request.Body, &parameters))
+		_, err := response.Write(rootBundleData)
+		require.NoError(t, err)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	v, err := New(
+		context.TODO(),
+		"k8s-ns1",
+		func(ns string) CreateToken { return nil },
+		listers.FakeSecretListerFrom(listers.NewFakeSecretLister(),
+			listers.SetFakeSecretNamespaceListerGet(
+				&corev1.Secret{
+					Data: map[string][]byte{
+						"key1": []byte("token1"),
+					},
+				}, nil),
+		),
+		&cmapi.Issuer{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "issuer1",
+				Namespace: "k8s-ns1",
+			},
+			Spec: v1.IssuerSpec{
+				IssuerConfig: v1.IssuerConfig{
+					Vault: &v1.VaultIssuer{
+						Server: server.URL,
+						Path:   vaultPath,
+						Auth: cmapi.VaultAuth{
+							TokenSecretRef: &cmmeta.SecretKeySelector{
+								LocalObjectReference: cmmeta.LocalObjectReference{
+									Name: "secret1",
+								},
+								Key: "key1",
+							},
+						},
+					},
+				},
+			},
+		})
+	require.NoError(t, err)
+
+	_, _, err = v.Sign(csrPEM, 90*time.Minute)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{
+		"common_name":          "test",
+		"alt_names":            "example.com,www.example.com",
+		"ip_sans":              "10.0.0.1,2001:db8::1",
+		"uri_sans":             "spiffe://cluster.local/ns/test/sa/test",
+		"other_sans":           "1.3.6.1.4.1.311.20.2.3;UTF8:user@example.com,1.2.3.4;UTF8:other",
+		"ttl":                  "1h30m0s",
+		"csr":                  string(csrPEM),
+		"exclude_cn_from_sans": "true",
+	}, parameters)
+}
+
+// TestSignReauthenticatesWithKubernetesAuth demonstrates that when Vault
+// rejects the token used to sign a certificate, for example because it has
+// expired, the ServiceAccount token is read again from the Secret, which may
+// have been rotated, and used to log in before retrying.
+func TestSignReauthenticatesWithKubernetesAuth(t *testing.T) {
+	const vaultPath = "my_pki_mount/sign/my-role-name"
+
+	privatekey := generateRSAPrivateKey(t)
+	csrPEM := generateCSR(t, privatekey)
+
+	rootBundleData, err := bundlePEM(testIntermediateCa, testRootCa)
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		// rotatedJWTAccepted is whether Vault accepts the ServiceAccount token
+		// which has been rotated into the Secret after the first login.
+		rotatedJWTAccepted bool
+
+		expectedLogins []string
+		expectedErr    error
+	}{
+		"an expired Vault token should trigger a login with the rotated ServiceAccount token": {
+			rotatedJWTAccepted: true,
+			expectedLogins:     []string{"jwt-1", "jwt-2"},
+		},
+		"a failed login after the Vault token expired should return ErrReauthenticationFailed": {
+			rotatedJWTAccepted: false,
+			expectedLogins:     []string{"jwt-1", "jwt-2"},
+			expectedErr:        ErrReauthenticationFailed,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var logins []string
+			jwt := "jwt-1"
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("/v1/auth/kubernetes/login", func(response http.ResponseWriter, request *http.Request) {
+				var body map[string]string
+				require.NoError(t, jsonutil.DecodeJSONFromReader(request.Body, &body))
+				assert.Equal(t, "my-role", body["role"])
+				logins = append(logins, body["jwt"])
+
+				switch {
+				case body["jwt"] == "jwt-1":
+					// The projected ServiceAccount token is rotated after it has been used.
+					jwt = "jwt-2"
+					_, err := response.Write([]byte(`{"auth":{"client_token":"token-1"}}`))
+					require.NoError(t, err)
+				case body["jwt"] == "jwt-2" && test.rotatedJWTAccepted:
+					_, err := response.Write([]byte(`{"auth":{"client_token":"token-2"}}`))
+					require.NoError(t, err)
+				default:
+					response.WriteHeader(http.StatusForbidden)
+					_, err := response.Write([]byte(`{"errors":["permission denied"]}`))
+					require.NoError(t, err)
+				}
+			})
+			mux.HandleFunc(fmt.Sprintf("/v1/%s", vaultPath), func(response http.ResponseWriter, request *http.Request) {
+				if request.Header.Get("X-Vault-Token") != "token-2" {
+					// token-1 has expired by the time the certificate is signed.
+					response.WriteHeader(http.StatusForbidden)
+					_, err := response.Write([]byte(`{"errors":["permission denied"]}`))
+					require.NoError(t, err)
+					return
+				}
+				_, err := response.Write(rootBundleData)
+				require.NoError(t, err)
+			})
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			secretsLister := listers.FakeSecretListerFrom(listers.NewFakeSecretLister(),
+				listers.SetFakeSecretListerSecret(func(string) clientcorev1.SecretNamespaceLister {
+					return listers.NewFakeSecretNamespaceLister().SetFakeSecretNamespaceListerGet(&corev1.Secret{
+						Data: map[string][]byte{
+							"token": []byte(jwt + "\n"),
+						},
+					}, nil)
+				}),
+			)
+
+			v, err := New(
+				context.TODO(),
+				"k8s-ns1",
+				func(ns string) CreateToken { return nil },
+				secretsLister,
+				&cmapi.Issuer{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "issuer1",
+						Namespace: "k8s-ns1",
+					},
+					Spec: v1.IssuerSpec{
+						IssuerConfig: v1.IssuerConfig{
+							Vault: &v1.VaultIssuer{
+								Server: server.URL,
+								Path:   vaultPath,
+								Auth: cmapi.VaultAuth{
+									Kubernetes: &cmapi.VaultKubernetesAuth{
+										Role: "my-role",
+										SecretRef: cmmeta.SecretKeySelector{
+											LocalObjectReference: cmmeta.LocalObjectReference{
+												Name: "sa-token",
+											},
+											Key: "token",
+										},
+									},
+								},
+							},
+						},
+					},
+				})
+			require.NoError(t, err)
+
+			certPEM, caPEM, err := v.Sign(csrPEM, time.Hour)
+			assert.Equal(t, test.expectedLogins, logins)
+			if test.expectedErr != nil {
+				assert.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotEmpty(t, certPEM)
+			assert.NotEmpty(t, caPEM)
+		})
+	}
+}
+
+// TestSignDoesNotReauthenticateWithAppRole demonstrates that a Vault token
+// obtained with AppRole auth, which is requested when the client is created,
+// is not replaced when Vault rejects it: the rejection is returned unchanged.
+func TestSignDoesNotReauthenticateWithAppRole(t *testing.T) {
+	const vaultPath = "my_pki_mount/sign/my-role-name"
+
+	privatekey := generateRSAPrivateKey(t)
+	csrPEM := generateCSR(t, privatekey)
+
+	var logins []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/auth/approle/login", func(response http.ResponseWriter, request *http.Request) {
+		var body map[string]string
+		require.NoError(t, jsonutil.DecodeJSONFromReader(request.Body, &body))
+		logins = append(logins, body["secret_id"])
+		_, err := response.Write([]byte(`{"auth":{"client_token":"token-1"}}`))
+		require.NoError(t, err)
+	})
+	mux.HandleFunc(fmt.Sprintf("/v1/%s", vaultPath), func(response http.ResponseWriter, request *http.Request) {
+		response.WriteHeader(http.StatusForbidden)
+		_, err := response.Write([]byte(`{"errors":["permission denied"]}`))
+		require.NoError(t, err)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	secretsLister := listers.FakeSecretListerFrom(listers.NewFakeSecretLister(),
+		listers.SetFakeSecretListerSecret(func(string) clientcorev1.SecretNamespaceLister {
+			return listers.NewFakeSecretNamespaceLister().SetFakeSecretNamespaceListerGet(&corev1.Secret{
+				Data: map[string][]byte{
+					"secret-id": []byte("secret-id-1"),
+				},
+			}, nil)
+		}),
+	)
+
+	v, err := New(
+		context.TODO(),
+		"k8s-ns1",
+		func(ns string) CreateToken { return nil },
+		secretsLister,
+		&cmapi.Issuer{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "issuer1",
+				Namespace: "k8s-ns1",
+			},
+			Spec: v1.IssuerSpec{
+				IssuerConfig: v1.IssuerConfig{
+					Vault: &v1.VaultIssuer{
+						Server: server.URL,
+						Path:   vaultPath,
+						Auth: cmapi.VaultAuth{
+							AppRole: &cmapi.VaultAppRole{
+								RoleId: "my-role-id",
+								SecretRef: cmmeta.SecretKeySelector{
+									LocalObjectReference: cmmeta.LocalObjectReference{
+										Name: "approle",
+									},
+									Key: "secret-id",
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+	require.NoError(t, err)
+
+	_, _, err = v.Sign(csrPEM, time.Hour)
+	assert.Equal(t, []string{"secret-id-1"}, logins)
+	assert.True(t, IsPermissionDenied(err), "expected a permission denied error, got: %v", err)
+	assert.NotErrorIs(t, err, ErrReauthenticationFailed)
+}
+
+func TestIsPermissionDenied(t *testing.T) {
+	assert.True(t, IsPermissionDenied(fmt.Errorf("error logging in to Vault server: %w", &vault.ResponseError{StatusCode: http.StatusForbidden})))
+	assert.False(t, IsPermissionDenied(&vault.ResponseError{StatusCode: http.StatusInternalServerError}))
+	assert.False(t, IsPermissionDenied(errors.New("permission denied")))
+}
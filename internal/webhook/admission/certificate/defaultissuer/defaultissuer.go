@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultissuer
+
+import (
+	"context"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/pkg/webhook/admission"
+)
+
+// defaultIssuer sets the issuerRef of Certificates which do not specify one
+// to the configured default issuer.
+type defaultIssuer struct {
+	*admission.Handler
+
+	issuerRef cmmeta.ObjectReference
+}
+
+var _ admission.MutationInterface = &defaultIssuer{}
+
+// NewPlugin returns an admission plugin which sets the issuerRef of
+// Certificates which do not specify one to the given issuerRef.
+func NewPlugin(issuerRef cmmeta.ObjectReference) admission.Interface {
+	return &defaultIssuer{
+		// Updates are mutated too, so that re-applying a manifest which does
+		// not specify an issuerRef keeps using the default issuer.
+		Handler:   admission.NewHandler(admissionv1.Create, admissionv1.Update),
+		issuerRef: issuerRef,
+	}
+}
+
+func (p *defaultIssuer) Mutate(ctx context.Context, request admissionv1.AdmissionRequest, obj *unstructured.Unstructured) error {
+	// Only run this admission plugin for Certificate resources
+	if request.RequestResource.Group != "cert-manager.io" ||
+		request.RequestResource.Resource != "certificates" ||
+		request.SubResource != "" {
+		return nil
+	}
+
+	// An explicit issuerRef always takes precedence over the default, even
+	// if it only sets some of its fields.
+	if isIssuerRefSet(obj) {
+		return nil
+	}
+
+	issuerRef := map[string]interface{}{"name": p.issuerRef.Name}
+	if p.issuerRef.Kind != "" {
+		issuerRef["kind"] = p.issuerRef.Kind
+	}
+	if p.issuerRef.Group != "" {
+		issuerRef["group"] = p.issuerRef.Group
+	}
+	return unstructured.SetNestedMap(obj.Object, issuerRef, "spec", "issuerRef")
+}
+
+// isIssuerRefSet returns true if any of the fields of the issuerRef of the
+// given Certificate are set. Clients using the typed API send an issuerRef
+// with an empty name when none has been specified, which is treated as unset.
+func isIssuerRefSet(obj *unstructured.Unstructured) bool {
+	for _, field := range []string{"name", "kind", "group"} {
+		if value, _, _ := unstructured.NestedString(obj.Object, "spec", "issuerRef", field); value != "" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultissuer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+)
+
+var certificatesResource = &metav1.GroupVersionResource{
+	Group:    "cert-manager.io",
+	Version:  "v1",
+	Resource: "certificates",
+}
+
+func toUnstructured(t *testing.T, obj runtime.Object) *unstructured.Unstructured {
+	scheme := runtime.NewScheme()
+	require.NoError(t, cmapi.AddToScheme(scheme))
+
+	unstr := unstructured.Unstructured{}
+	require.NoError(t, scheme.Convert(obj, &unstr, nil))
+	return &unstr
+}
+
+func TestMutate(t *testing.T) {
+	defaultRef := cmmeta.ObjectReference{Name: "letsencrypt", Kind: "ClusterIssuer", Group: "cert-manager.io"}
+
+	tests := map[string]struct {
+		defaultRef  cmmeta.ObjectReference
+		op          admissionv1.Operation
+		gvr         *metav1.GroupVersionResource
+		subResource string
+		issuerRef   cmmeta.ObjectReference
+		// rawIssuerRef, if set, replaces the issuerRef of the Certificate
+		// before it is mutated.
+		rawIssuerRef func(obj *unstructured.Unstructured)
+		expIssuerRef interface{}
+	}{
+		"sets the default issuerRef on create if none is set": {
+			defaultRef: defaultRef,
+			op:         admissionv1.Create,
+			gvr:        certificatesResource,
+			expIssuerRef: map[string]interface{}{
+				"name":  "letsencrypt",
+				"kind":  "ClusterIssuer",
+				"group": "cert-manager.io",
+			},
+		},
+		"sets the default issuerRef on update if none is set": {
+			defaultRef: defaultRef,
+			op:         admissionv1.Update,
+			gvr:        certificatesResource,
+			expIssuerRef: map[string]interface{}{
+				"name":  "letsencrypt",
+				"kind":  "ClusterIssuer",
+				"group": "cert-manager.io",
+			},
+		},
+		"sets the default issuerRef if issuerRef is omitted": {
+			defaultRef: defaultRef,
+			op:         admissionv1.Create,
+			gvr:        certificatesResource,
+			rawIssuerRef: func(obj *unstructured.Unstructured) {
+				unstructured.RemoveNestedField(obj.Object, "spec", "issuerRef")
+			},
+			expIssuerRef: map[string]interface{}{
+				"name":  "letsencrypt",
+				"kind":  "ClusterIssuer",
+				"group": "cert-manager.io",
+			},
+		},
+		"sets the default issuerRef if issuerRef is null": {
+			defaultRef: defaultRef,
+			op:         admissionv1.Create,
+			gvr:        certificatesResource,
+			rawIssuerRef: func(obj *unstructured.Unstructured) {
+				obj.Object["spec"].(map[string]interface{})["issuerRef"] = nil
+			},
+			expIssuerRef: map[string]interface{}{
+				"name":  "letsencrypt",
+				"kind":  "ClusterIssuer",
+				"group": "cert-manager.io",
+			},
+		},
+		"omits the kind and group if they are not configured": {
+			defaultRef: cmmeta.ObjectReference{Name: "ca"},
+			op:         admissionv1.Create,
+			gvr:        certificatesResource,
+			expIssuerRef: map[string]interface{}{
+				"name": "ca",
+			},
+		},
+		"does not override an explicit issuerRef": {
+			defaultRef: defaultRef,
+			op:         admissionv1.Create,
+			gvr:        certificatesResource,
+			issuerRef:  cmmeta.ObjectReference{Name: "ca", Kind: "Issuer"},
+			expIssuerRef: map[string]interface{}{
+				"name": "ca",
+				"kind": "Issuer",
+			},
+		},
+		"does not override a partially set issuerRef": {
+			defaultRef: defaultRef,
+			op:         admissionv1.Create,
+			gvr:        certificatesResource,
+			issuerRef:  cmmeta.ObjectReference{Kind: "Issuer"},
+			expIssuerRef: map[string]interface{}{
+				"name": "",
+				"kind": "Issuer",
+			},
+		},
+		"ignores resources other than certificates": {
+			defaultRef: defaultRef,
+			op:         admissionv1.Create,
+			gvr: &metav1.GroupVersionResource{
+				Group:    "cert-manager.io",
+				Version:  "v1",
+				Resource: "certificaterequests",
+			},
+			expIssuerRef: map[string]interface{}{
+				"name": "",
+			},
+		},
+		"ignores the status sub-resource": {
+			defaultRef:  defaultRef,
+			op:          admissionv1.Update,
+			gvr:         certificatesResource,
+			subResource: "status",
+			expIssuerRef: map[string]interface{}{
+				"name": "",
+			},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			crt := &cmapi.Certificate{Spec: cmapi.CertificateSpec{IssuerRef: test.issuerRef}}
+			obj := toUnstructured(t, crt)
+			if test.rawIssuerRef != nil {
+				test.rawIssuerRef(obj)
+			}
+
+			plugin := NewPlugin(test.defaultRef).(*defaultIssuer)
+			err := plugin.Mutate(context.Background(), admissionv1.AdmissionRequest{
+				Operation:       test.op,
+				RequestResource: test.gvr,
+				SubResource:     test.subResource,
+			}, obj)
+			require.NoError(t, err)
+
+			issuerRef, _, err := unstructured.NestedFieldNoCopy(obj.Object, "spec", "issuerRef")
+			require.NoError(t, err)
+			assert.Equal(t, test.expIssuerRef, issuerRef)
+		})
+	}
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issuerdefaults
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cminformers "github.com/cert-manager/cert-manager/pkg/client/informers/externalversions"
+	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/webhook/admission"
+)
+
+// issuerDefaults applies the spec.certificateDefaults of the issuer referenced
+// by a Certificate to the fields of the Certificate which are not set.
+type issuerDefaults struct {
+	*admission.Handler
+
+	issuerLister        cmlisters.IssuerLister
+	clusterIssuerLister cmlisters.ClusterIssuerLister
+	cachesSynced        []cache.InformerSynced
+}
+
+var _ admission.MutationInterface = &issuerDefaults{}
+
+// NewPlugin returns an admission plugin which applies the certificate
+// defaults of the issuer referenced by a Certificate. The issuer is looked up
+// using the Issuer and ClusterIssuer informers of the given factory, which
+// must be started by the caller.
+func NewPlugin(factory cminformers.SharedInformerFactory) admission.Interface {
+	issuers := factory.Certmanager().V1().Issuers()
+	clusterIssuers := factory.Certmanager().V1().ClusterIssuers()
+	return &issuerDefaults{
+		Handler:             admission.NewHandler(admissionv1.Create, admissionv1.Update),
+		issuerLister:        issuers.Lister(),
+		clusterIssuerLister: clusterIssuers.Lister(),
+		cachesSynced:        []cache.InformerSynced{issuers.Informer().HasSynced, clusterIssuers.Informer().HasSynced},
+	}
+}
+
+func (p *issuerDefaults) Mutate(ctx context.Context, request admissionv1.AdmissionRequest, obj *unstructured.Unstructured) error {
+	// Only run this admission plugin for Certificate resources
+	if request.RequestResource.Group != "cert-manager.io" ||
+		request.RequestResource.Resource != "certificates" ||
+		request.SubResource != "" {
+		return nil
+	}
+
+	crt := &cmapi.Certificate{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, crt); err != nil {
+		return err
+	}
+
+	defaults, err := p.certificateDefaults(request.Namespace, crt.Spec.IssuerRef.Name, crt.Spec.IssuerRef.Kind, crt.Spec.IssuerRef.Group)
+	if err != nil || defaults == nil {
+		return err
+	}
+
+	// Values set on the Certificate always take precedence. Defaults are
+	// only applied if the resulting Certificate would still be valid.
+	spec := &crt.Spec
+	if spec.Duration == nil && defaults.Duration != nil &&
+		(spec.RenewBefore == nil || spec.RenewBefore.Duration < defaults.Duration.Duration) {
+		if err := setDuration(obj, defaults.Duration.Duration, "duration"); err != nil {
+			return err
+		}
+		spec.Duration = defaults.Duration
+	}
+	if spec.RenewBefore == nil && spec.RenewBeforePercentage == nil && defaults.RenewBefore != nil &&
+		defaults.RenewBefore.Duration < apiutil.DefaultCertDuration(spec.Duration) {
+		if err := setDuration(obj, defaults.RenewBefore.Duration, "renewBefore"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// certificateDefaults returns the certificate defaults of the referenced
+// issuer, or nil if it has none, is not found, or is not a cert-manager.io
+// issuer.
+func (p *issuerDefaults) certificateDefaults(namespace, name, kind, group string) (*cmapi.IssuerCertificateDefaults, error) {
+	if name == "" || (group != "" && group != "cert-manager.io") {
+		return nil, nil
+	}
+
+	// Until the caches have synced, an issuer which exists would look as if
+	// it did not, and the Certificate would be admitted without its defaults.
+	for _, synced := range p.cachesSynced {
+		if !synced() {
+			return nil, errors.New("waiting for the Issuer and ClusterIssuer caches to sync")
+		}
+	}
+
+	var spec *cmapi.IssuerSpec
+	switch kind {
+	case "", cmapi.IssuerKind:
+		issuer, err := p.issuerLister.Issuers(namespace).Get(name)
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get Issuer %q: %w", name, err)
+		}
+		spec = &issuer.Spec
+	case cmapi.ClusterIssuerKind:
+		issuer, err := p.clusterIssuerLister.Get(name)
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get ClusterIssuer %q: %w", name, err)
+		}
+		spec = &issuer.Spec
+	default:
+		return nil, nil
+	}
+
+	return spec.CertificateDefaults, nil
+}
+
+// setDuration sets the given spec field of the Certificate to d, formatted as
+// metav1.Duration would be.
+func setDuration(obj *unstructured.Unstructured, d time.Duration, field string) error {
+	return unstructured.SetNestedField(obj.Object, d.String(), "spec", field)
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issuerdefaults
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/pkg/client/clientset/versioned/fake"
+	cminformers "github.com/cert-manager/cert-manager/pkg/client/informers/externalversions"
+)
+
+var certificatesResource = &metav1.GroupVersionResource{
+	Group:    "cert-manager.io",
+	Version:  "v1",
+	Resource: "certificates",
+}
+
+func duration(d time.Duration) *metav1.Duration {
+	return &metav1.Duration{Duration: d}
+}
+
+func TestMutate(t *testing.T) {
+	defaults := &cmapi.IssuerCertificateDefaults{
+		Duration:    duration(30 * 24 * time.Hour),
+		RenewBefore: duration(10 * 24 * time.Hour),
+	}
+	issuer := &cmapi.Issuer{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "issuer"},
+		Spec:       cmapi.IssuerSpec{CertificateDefaults: defaults},
+	}
+	clusterIssuer := &cmapi.ClusterIssuer{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster-issuer"},
+		Spec: cmapi.IssuerSpec{CertificateDefaults: &cmapi.IssuerCertificateDefaults{
+			Duration: duration(60 * 24 * time.Hour),
+		}},
+	}
+	noDefaultsIssuer := &cmapi.Issuer{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "no-defaults"},
+	}
+
+	tests := map[string]struct {
+		issuerRef   cmmeta.ObjectReference
+		spec        cmapi.CertificateSpec
+		subResource string
+
+		expDuration    *metav1.Duration
+		expRenewBefore *metav1.Duration
+	}{
+		"applies the defaults of an Issuer to a Certificate which sets neither": {
+			issuerRef:      cmmeta.ObjectReference{Name: "issuer"},
+			expDuration:    defaults.Duration,
+			expRenewBefore: defaults.RenewBefore,
+		},
+		"applies the defaults of a ClusterIssuer": {
+			issuerRef:   cmmeta.ObjectReference{Name: "cluster-issuer", Kind: "ClusterIssuer", Group: "cert-manager.io"},
+			expDuration: duration(60 * 24 * time.Hour),
+		},
+		"an explicit duration takes precedence": {
+			issuerRef:      cmmeta.ObjectReference{Name: "issuer"},
+			spec:           cmapi.CertificateSpec{Duration: duration(20 * 24 * time.Hour)},
+			expDuration:    duration(20 * 24 * time.Hour),
+			expRenewBefore: defaults.RenewBefore,
+		},
+		"an explicit renewBefore takes precedence": {
+			issuerRef:      cmmeta.ObjectReference{Name: "issuer"},
+			spec:           cmapi.CertificateSpec{RenewBefore: duration(5 * 24 * time.Hour)},
+			expDuration:    defaults.Duration,
+			expRenewBefore: duration(5 * 24 * time.Hour),
+		},
+		"renewBefore is not defaulted if renewBeforePercentage is set": {
+			issuerRef:   cmmeta.ObjectReference{Name: "issuer"},
+			spec:        cmapi.CertificateSpec{RenewBeforePercentage: ptr.To(int32(25))},
+			expDuration: defaults.Duration,
+		},
+		"renewBefore is not defaulted if it is not less than an explicit duration": {
+			issuerRef:   cmmeta.ObjectReference{Name: "issuer"},
+			spec:        cmapi.CertificateSpec{Duration: duration(10 * 24 * time.Hour)},
+			expDuration: duration(10 * 24 * time.Hour),
+		},
+		"duration is not defaulted if it is not greater than an explicit renewBefore": {
+			issuerRef:      cmmeta.ObjectReference{Name: "issuer"},
+			spec:           cmapi.CertificateSpec{RenewBefore: duration(40 * 24 * time.Hour)},
+			expRenewBefore: duration(40 * 24 * time.Hour),
+		},
+		"does nothing if the issuer has no defaults": {
+			issuerRef: cmmeta.ObjectReference{Name: "no-defaults"},
+		},
+		"does nothing if the issuer does not exist": {
+			issuerRef: cmmeta.ObjectReference{Name: "missing"},
+		},
+		"does nothing for issuers in other groups": {
+			issuerRef: cmmeta.ObjectReference{Name: "issuer", Kind: "Issuer", Group: "example.com"},
+		},
+		"ignores the status sub-resource": {
+			issuerRef:   cmmeta.ObjectReference{Name: "issuer"},
+			subResource: "status",
+		},
+	}
+	factory := cminformers.NewSharedInformerFactory(fake.NewSimpleClientset(issuer, clusterIssuer, noDefaultsIssuer), 0)
+	plugin := NewPlugin(factory)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	factory.Start(ctx.Done())
+	factory.WaitForCacheSync(ctx.Done())
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			crt := &cmapi.Certificate{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test"},
+				Spec:       test.spec,
+			}
+			crt.Spec.IssuerRef = test.issuerRef
+			unstr, err := runtime.DefaultUnstructuredConverter.ToUnstructured(crt)
+			require.NoError(t, err)
+			obj := &unstructured.Unstructured{Object: unstr}
+
+			err = plugin.(*issuerDefaults).Mutate(context.Background(), admissionv1.AdmissionRequest{
+				Operation:       admissionv1.Create,
+				Namespace:       "testns",
+				RequestResource: certificatesResource,
+				SubResource:     test.subResource,
+			}, obj)
+			require.NoError(t, err)
+
+			got := &cmapi.Certificate{}
+			require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, got))
+			assert.Equal(t, test.expDuration, got.Spec.Duration)
+			assert.Equal(t, test.expRenewBefore, got.Spec.RenewBefore)
+		})
+	}
+}
+
+func TestMutateBeforeCachesSynced(t *testing.T) {
+	crt := &cmapi.Certificate{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test"},
+		Spec:       cmapi.CertificateSpec{IssuerRef: cmmeta.ObjectReference{Name: "issuer"}},
+	}
+	unstr, err := runtime.DefaultUnstructuredConverter.ToUnstructured(crt)
+	require.NoError(t, err)
+
+	// The informers of the factory are never started.
+	plugin := NewPlugin(cminformers.NewSharedInformerFactory(fake.NewSimpleClientset(), 0))
+	err = plugin.(*issuerDefaults).Mutate(context.Background(), admissionv1.AdmissionRequest{
+		Operation:       admissionv1.Create,
+		Namespace:       "testns",
+		RequestResource: certificatesResource,
+	}, &unstructured.Unstructured{Object: unstr})
+	assert.EqualError(t, err, "waiting for the Issuer and ClusterIssuer caches to sync")
+}
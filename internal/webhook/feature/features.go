@@ -79,6 +79,33 @@ const (
 	// Certificate resources.
 	// Github Issue: https://github.com/cert-manager/cert-manager/issues/6393
 	OtherNames featuregate.Feature = "OtherNames"
+
+	// Owner: N/A
+	// Alpha: v1.16
+	//
+	// ExperimentalPostQuantumKeys allows Certificates to use the experimental
+	// ML-DSA post-quantum private key algorithm.
+	// This feature gate must be used together with the
+	// ExperimentalPostQuantumKeys controller feature gate.
+	ExperimentalPostQuantumKeys featuregate.Feature = "ExperimentalPostQuantumKeys"
+
+	// Owner: N/A
+	// Alpha: v1.16
+	//
+	// IssuerCertificateDefaults allows Issuers and ClusterIssuers to set
+	// spec.certificateDefaults, which the webhook applies to the duration and
+	// renewBefore of Certificates referencing them that do not set these.
+	IssuerCertificateDefaults featuregate.Feature = "IssuerCertificateDefaults"
+
+	// Owner: N/A
+	// Alpha: v1.16
+	//
+	// CertificateRevocation tells the webhook that the CertificateRevocation
+	// controller feature gate is enabled. If it is not, the webhook warns
+	// when an ACME issuer sets revokeOnDelete, which has no effect.
+	// This feature gate must be used together with the CertificateRevocation
+	// controller feature gate.
+	CertificateRevocation featuregate.Feature = "CertificateRevocation"
 )
 
 func init() {
@@ -99,4 +126,7 @@ var webhookFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
 	LiteralCertificateSubject:          {Default: true, PreRelease: featuregate.Beta},
 	NameConstraints:                    {Default: false, PreRelease: featuregate.Alpha},
 	OtherNames:                         {Default: false, PreRelease: featuregate.Alpha},
+	ExperimentalPostQuantumKeys:        {Default: false, PreRelease: featuregate.Alpha},
+	IssuerCertificateDefaults:          {Default: false, PreRelease: featuregate.Alpha},
+	CertificateRevocation:              {Default: false, PreRelease: featuregate.Alpha},
 }
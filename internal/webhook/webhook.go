@@ -17,6 +17,7 @@ limitations under the License.
 package webhook
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -28,18 +29,26 @@ import (
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	crlog "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	acmeinstall "github.com/cert-manager/cert-manager/internal/apis/acme/install"
 	cminstall "github.com/cert-manager/cert-manager/internal/apis/certmanager/install"
 	"github.com/cert-manager/cert-manager/internal/apis/config/shared"
 	config "github.com/cert-manager/cert-manager/internal/apis/config/webhook"
 	metainstall "github.com/cert-manager/cert-manager/internal/apis/meta/install"
+	"github.com/cert-manager/cert-manager/internal/webhook/admission/certificate/defaultissuer"
+	"github.com/cert-manager/cert-manager/internal/webhook/admission/certificate/issuerdefaults"
 	crapproval "github.com/cert-manager/cert-manager/internal/webhook/admission/certificaterequest/approval"
 	cridentity "github.com/cert-manager/cert-manager/internal/webhook/admission/certificaterequest/identity"
 	"github.com/cert-manager/cert-manager/internal/webhook/admission/resourcevalidation"
+	"github.com/cert-manager/cert-manager/internal/webhook/feature"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
+	cminformers "github.com/cert-manager/cert-manager/pkg/client/informers/externalversions"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 	"github.com/cert-manager/cert-manager/pkg/server/tls"
 	"github.com/cert-manager/cert-manager/pkg/server/tls/authority"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 	"github.com/cert-manager/cert-manager/pkg/webhook/admission"
 	"github.com/cert-manager/cert-manager/pkg/webhook/server"
 )
@@ -59,8 +68,16 @@ func NewCertManagerWebhookServer(log logr.Logger, opts config.WebhookConfigurati
 		return nil, fmt.Errorf("error creating kubernetes client: %s", err)
 	}
 
+	cmcl, err := cmclient.NewForConfig(restcfg)
+	if err != nil {
+		return nil, fmt.Errorf("error creating cert-manager client: %s", err)
+	}
+	// Admission plugins only read from the listers of this factory, so its
+	// informers never need to resync.
+	cmFactory := cminformers.NewSharedInformerFactory(cmcl, 0)
+
 	// Set up the admission chain
-	admissionHandler, err := buildAdmissionChain(cl)
+	admissionHandler, err := buildAdmissionChain(cl, cmFactory, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -81,6 +98,15 @@ func NewCertManagerWebhookServer(log logr.Logger, opts config.WebhookConfigurati
 		MinTLSVersion:     opts.TLSConfig.MinTLSVersion,
 		ValidationWebhook: admissionHandler,
 		MutationWebhook:   admissionHandler,
+		Runnables: []manager.Runnable{
+			// Run the informers requested by the admission plugins, if any.
+			manager.RunnableFunc(func(ctx context.Context) error {
+				cmFactory.Start(ctx.Done())
+				<-ctx.Done()
+				cmFactory.Shutdown()
+				return nil
+			}),
+		},
 	}
 	for _, fn := range optionFunctions {
 		fn(s)
@@ -88,7 +114,7 @@ func NewCertManagerWebhookServer(log logr.Logger, opts config.WebhookConfigurati
 	return s, nil
 }
 
-func buildAdmissionChain(client kubernetes.Interface) (admission.PluginChain, error) {
+func buildAdmissionChain(client kubernetes.Interface, cmFactory cminformers.SharedInformerFactory, opts config.WebhookConfiguration) (admission.PluginChain, error) {
 	authorizer, err := authorizerfactory.DelegatingAuthorizerConfig{
 		SubjectAccessReviewClient: client.AuthorizationV1(),
 		// cache responses for 1 second
@@ -112,6 +138,20 @@ func buildAdmissionChain(client kubernetes.Interface) (admission.PluginChain, er
 		resourcevalidation.NewPlugin(),
 	})
 
+	if opts.DefaultIssuerName != "" {
+		pluginChain = append(pluginChain, defaultissuer.NewPlugin(cmmeta.ObjectReference{
+			Name:  opts.DefaultIssuerName,
+			Kind:  opts.DefaultIssuerKind,
+			Group: opts.DefaultIssuerGroup,
+		}))
+	}
+
+	// Issuer defaults must be applied after the default issuerRef has been
+	// set, so that the defaults of the default issuer are used.
+	if utilfeature.DefaultFeatureGate.Enabled(feature.IssuerCertificateDefaults) {
+		pluginChain = append(pluginChain, issuerdefaults.NewPlugin(cmFactory))
+	}
+
 	return pluginChain, nil
 }
 
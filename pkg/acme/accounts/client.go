@@ -19,18 +19,22 @@ package accounts
 import (
 	"crypto/rsa"
 	"crypto/tls"
-	"crypto/x509"
 	"net"
 	"net/http"
+	"net/url"
 	"time"
 
 	acmeapi "golang.org/x/crypto/acme"
+	"golang.org/x/net/http/httpproxy"
 
+	"github.com/cert-manager/cert-manager/internal/controller/feature"
 	acmecl "github.com/cert-manager/cert-manager/pkg/acme/client"
 	"github.com/cert-manager/cert-manager/pkg/acme/client/middleware"
 	acmeutil "github.com/cert-manager/cert-manager/pkg/acme/util"
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	"github.com/cert-manager/cert-manager/pkg/metrics"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
 )
 
 const (
@@ -47,12 +51,14 @@ var _ NewClientFunc = NewClient
 
 // NewClient is an implementation of NewClientFunc that returns a real ACME client.
 func NewClient(client *http.Client, config cmacme.ACMEIssuer, privateKey *rsa.PrivateKey, userAgent string) acmecl.Interface {
-	return middleware.NewLogger(&acmeapi.Client{
-		Key:          privateKey,
-		HTTPClient:   client,
-		DirectoryURL: config.Server,
-		UserAgent:    userAgent,
-		RetryBackoff: acmeutil.RetryBackoff,
+	return middleware.NewLogger(&acmecl.Client{
+		Client: &acmeapi.Client{
+			Key:          privateKey,
+			HTTPClient:   client,
+			DirectoryURL: config.Server,
+			UserAgent:    userAgent,
+			RetryBackoff: acmeutil.RetryBackoff,
+		},
 	})
 }
 
@@ -69,17 +75,45 @@ func BuildHTTPClient(metrics *metrics.Metrics, skipTLSVerify bool) *http.Client
 // to set the 'skipTLSVerify' flag and the CA bundle on the HTTP client itself, distinct
 // from the ACME client
 func BuildHTTPClientWithCABundle(metrics *metrics.Metrics, skipTLSVerify bool, caBundle []byte) *http.Client {
+	return buildHTTPClient(metrics, skipTLSVerify, caBundle, http.ProxyFromEnvironment)
+}
+
+// BuildHTTPClientForIssuer returns a instrumented HTTP client to be used by an
+// ACME client for the given ACME issuer, using its CA bundle, skipTLSVerify
+// and proxy configuration.
+func BuildHTTPClientForIssuer(metrics *metrics.Metrics, config cmacme.ACMEIssuer) *http.Client {
+	return buildHTTPClient(metrics, config.SkipTLSVerify, config.CABundle, ProxyFunc(config))
+}
+
+// ProxyFunc returns the function used to select the proxy for requests to the
+// ACME server of the given issuer. If the issuer does not configure a proxy,
+// the proxy is selected using the HTTP_PROXY, HTTPS_PROXY and NO_PROXY
+// environment variables.
+func ProxyFunc(config cmacme.ACMEIssuer) func(*http.Request) (*url.URL, error) {
+	if config.HTTPProxy == "" && config.HTTPSProxy == "" && config.NoProxy == "" {
+		return http.ProxyFromEnvironment
+	}
+
+	proxyFunc := (&httpproxy.Config{
+		HTTPProxy:  config.HTTPProxy,
+		HTTPSProxy: config.HTTPSProxy,
+		NoProxy:    config.NoProxy,
+	}).ProxyFunc()
+	return func(req *http.Request) (*url.URL, error) {
+		return proxyFunc(req.URL)
+	}
+}
+
+func buildHTTPClient(metrics *metrics.Metrics, skipTLSVerify bool, caBundle []byte, proxy func(*http.Request) (*url.URL, error)) *http.Client {
 	tlsConfig := &tls.Config{
 		InsecureSkipVerify: skipTLSVerify,
 	}
 
 	// len also checks if the bundle is nil
 	if len(caBundle) > 0 {
-		pool := x509.NewCertPool()
-
 		// We only want tlsConfig.RootCAs to be non-nil if we added at least one custom
 		// CA to "pool".
-		if ok := pool.AppendCertsFromPEM(caBundle); ok {
+		if pool, ok := pki.CertPoolFromCABundle(caBundle, utilfeature.DefaultFeatureGate.Enabled(feature.CABundleIncludeSystemRoots)); ok {
 			tlsConfig.RootCAs = pool
 		}
 	}
@@ -88,7 +122,7 @@ func BuildHTTPClientWithCABundle(metrics *metrics.Metrics, skipTLSVerify bool, c
 		metrics,
 		&http.Client{
 			Transport: &http.Transport{
-				Proxy: http.ProxyFromEnvironment,
+				Proxy: proxy,
 				DialContext: (&net.Dialer{
 					Timeout:   30 * time.Second,
 					KeepAlive: 30 * time.Second,
@@ -0,0 +1,137 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accounts
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"k8s.io/utils/clock"
+
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	"github.com/cert-manager/cert-manager/pkg/metrics"
+)
+
+func TestProxyFunc(t *testing.T) {
+	config := cmacme.ACMEIssuer{
+		HTTPProxy:  "http://http-proxy.example.com:3128",
+		HTTPSProxy: "http://https-proxy.example.com:3128",
+		NoProxy:    "internal.example.com",
+	}
+
+	tests := map[string]struct {
+		url      string
+		expProxy string
+	}{
+		"plain HTTP requests use httpProxy": {
+			url:      "http://acme.example.com/directory",
+			expProxy: "http://http-proxy.example.com:3128",
+		},
+		"HTTPS requests use httpsProxy": {
+			url:      "https://acme.example.com/directory",
+			expProxy: "http://https-proxy.example.com:3128",
+		},
+		"requests to hosts in noProxy are not proxied": {
+			url: "https://internal.example.com/directory",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, test.url, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			proxy, err := ProxyFunc(config)(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got string
+			if proxy != nil {
+				got = proxy.String()
+			}
+			if got != test.expProxy {
+				t.Errorf("unexpected proxy, exp=%q got=%q", test.expProxy, got)
+			}
+		})
+	}
+}
+
+func TestBuildHTTPClientForIssuer_UsesProxy(t *testing.T) {
+	var proxiedURL string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Requests sent to a forward proxy contain the absolute URL of the
+		// target.
+		proxiedURL = r.URL.String()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer proxy.Close()
+
+	client := BuildHTTPClientForIssuer(metrics.New(logr.Discard(), clock.RealClock{}), cmacme.ACMEIssuer{
+		HTTPProxy: proxy.URL,
+	})
+
+	target := &url.URL{Scheme: "http", Host: "acme.example.com", Path: "/directory"}
+	resp, err := client.Get(target.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if proxiedURL != target.String() {
+		t.Errorf("expected the request to be sent through the proxy, exp=%q got=%q", target.String(), proxiedURL)
+	}
+}
+
+func TestBuildHTTPClientForIssuer_TrustsCABundle(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	tests := map[string]struct {
+		caBundle []byte
+		expErr   bool
+	}{
+		"a client without a CA bundle does not trust the server": {
+			expErr: true,
+		},
+		"a client with the server's CA in its CA bundle trusts the server": {
+			caBundle: caBundle,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := BuildHTTPClientForIssuer(metrics.New(logr.Discard(), clock.RealClock{}), cmacme.ACMEIssuer{
+				CABundle: test.caBundle,
+			})
+
+			resp, err := client.Get(server.URL)
+			if test.expErr != (err != nil) {
+				t.Fatalf("unexpected error, exp=%t got=%v", test.expErr, err)
+			}
+			if resp != nil {
+				resp.Body.Close()
+			}
+		})
+	}
+}
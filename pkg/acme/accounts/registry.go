@@ -25,7 +25,10 @@ import (
 	"net/http"
 	"sync"
 
+	"k8s.io/client-go/util/flowcontrol"
+
 	acmecl "github.com/cert-manager/cert-manager/pkg/acme/client"
+	"github.com/cert-manager/cert-manager/pkg/acme/client/middleware"
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 )
 
@@ -67,8 +70,20 @@ type Getter interface {
 
 // NewDefaultRegistry returns a new default instantiation of a client registry.
 func NewDefaultRegistry() Registry {
+	return NewRateLimitedRegistry(0, 0)
+}
+
+// NewRateLimitedRegistry returns a new client registry whose clients limit
+// the rate at which new Orders are created and Authorizations are fetched to
+// the given QPS and burst. The limit is shared by all clients using the same
+// ACME account on the same ACME server. If qps is 0, requests are not rate
+// limited.
+func NewRateLimitedRegistry(qps float32, burst int) Registry {
 	return &registry{
-		clients: make(map[string]clientWithMeta),
+		clients:  make(map[string]clientWithMeta),
+		limiters: make(map[accountKey]flowcontrol.RateLimiter),
+		qps:      qps,
+		burst:    burst,
 	}
 }
 
@@ -78,6 +93,18 @@ type registry struct {
 
 	// a map of an issuer's 'uid' to an ACME client with metadata
 	clients map[string]clientWithMeta
+
+	// a map of ACME accounts to the rate limiter shared by their clients
+	limiters map[accountKey]flowcontrol.RateLimiter
+	qps      float32
+	burst    int
+}
+
+// accountKey identifies an ACME account by the ACME server it is registered
+// with and its public key.
+type accountKey struct {
+	serverURL string
+	publicKey string
 }
 
 // stableOptions contains data about an ACME client that can be used to compare
@@ -91,12 +118,22 @@ type stableOptions struct {
 	exponent      int
 	caBundle      string
 	keyChecksum   [sha256.Size]byte
+	httpProxy     string
+	httpsProxy    string
+	noProxy       string
 }
 
 func (c stableOptions) equalTo(c2 stableOptions) bool {
 	return c == c2
 }
 
+func (c stableOptions) accountKey() accountKey {
+	return accountKey{
+		serverURL: c.serverURL,
+		publicKey: c.publicKey,
+	}
+}
+
 func newStableOptions(uid string, config cmacme.ACMEIssuer, privateKey *rsa.PrivateKey) stableOptions {
 	// Encoding a big.Int cannot fail
 	publicNBytes, _ := privateKey.PublicKey.N.GobEncode()
@@ -110,6 +147,9 @@ func newStableOptions(uid string, config cmacme.ACMEIssuer, privateKey *rsa.Priv
 		exponent:      privateKey.PublicKey.E,
 		caBundle:      string(config.CABundle),
 		keyChecksum:   checksum,
+		httpProxy:     config.HTTPProxy,
+		httpsProxy:    config.HTTPSProxy,
+		noProxy:       config.NoProxy,
 	}
 }
 
@@ -148,10 +188,46 @@ func (r *registry) ensureClient(httpClient *http.Client, uid string, config cmac
 
 	// create a new client if one is not registered or if the
 	// 'metadata' does not match
+	cl := NewClient(httpClient, config, privateKey, userAgent)
+	if r.qps > 0 {
+		cl = middleware.NewRateLimiter(cl, r.limiterFor(newOpts.accountKey()))
+	}
 	r.clients[uid] = clientWithMeta{
-		Interface:     NewClient(httpClient, config, privateKey, userAgent),
+		Interface:     cl,
 		stableOptions: newOpts,
 	}
+	r.pruneLimiters()
+}
+
+// limiterFor returns the rate limiter for the given ACME account, creating
+// one if it does not exist yet. The caller must hold the write lock.
+func (r *registry) limiterFor(key accountKey) flowcontrol.RateLimiter {
+	if limiter, ok := r.limiters[key]; ok {
+		return limiter
+	}
+	limiter := flowcontrol.NewTokenBucketRateLimiter(r.qps, r.burst)
+	r.limiters[key] = limiter
+	return limiter
+}
+
+// pruneLimiters removes the rate limiters of ACME accounts which are no
+// longer used by any registered client. It is only called when a client is
+// added, so that the rate limiter of an account whose client was removed is
+// kept if the account is registered again. The caller must hold the write
+// lock.
+func (r *registry) pruneLimiters() {
+	for key := range r.limiters {
+		inUse := false
+		for _, c := range r.clients {
+			if c.accountKey() == key {
+				inUse = true
+				break
+			}
+		}
+		if !inUse {
+			delete(r.limiters, key)
+		}
+	}
 }
 
 // GetClient will fetch a registered client using the UID of the Issuer
@@ -175,6 +251,9 @@ func (r *registry) RemoveClient(uid string) {
 	if _, ok := r.clients[uid]; !ok {
 		return
 	}
+	// The rate limiter of the client's account is only pruned once another
+	// client is added, since Issuers remove their client before registering
+	// it again and would otherwise reset the rate limit each time.
 	delete(r.clients, uid)
 }
 
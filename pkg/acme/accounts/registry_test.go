@@ -23,6 +23,7 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/cert-manager/cert-manager/pkg/acme/client/middleware"
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
 )
@@ -149,6 +150,31 @@ func TestRegistry_AddClient_UpdatesExistingWhenPrivateKeyChanges(t *testing.T) {
 	}
 }
 
+func TestRegistry_AddClient_UpdatesExistingWhenProxyChanges(t *testing.T) {
+	r := NewDefaultRegistry()
+	pk, err := pki.GenerateRSAPrivateKey(2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Register a new client
+	r.AddClient(http.DefaultClient, "abc", cmacme.ACMEIssuer{}, pk, "cert-manager-test")
+	c, err := r.GetClient("abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Update the client with a proxy
+	r.AddClient(http.DefaultClient, "abc", cmacme.ACMEIssuer{HTTPSProxy: "http://proxy.example.com:3128"}, pk, "cert-manager-test")
+	c2, err := r.GetClient("abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c == c2 {
+		t.Error("expected the client to be replaced when the proxy changes")
+	}
+}
+
 func TestRegistry_AddClient_UpdatesClientPKChecksum(t *testing.T) {
 	r := NewDefaultRegistry()
 	pk, err := pki.GenerateRSAPrivateKey(2048)
@@ -181,3 +207,78 @@ func TestRegistry_AddClient_UpdatesClientPKChecksum(t *testing.T) {
 		t.Fatal("checksum reported same for different keys")
 	}
 }
+
+func TestRegistry_AddClient_SharesRateLimiterPerAccount(t *testing.T) {
+	r := NewRateLimitedRegistry(1, 5).(*registry)
+	pk, err := pki.GenerateRSAPrivateKey(2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk2, err := pki.GenerateRSAPrivateKey(2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Two Issuers using the same account share a rate limiter, whereas a
+	// different account or ACME server gets a rate limiter of its own.
+	r.AddClient(http.DefaultClient, "abc", cmacme.ACMEIssuer{Server: "https://acme.example.com"}, pk, "cert-manager-test")
+	r.AddClient(http.DefaultClient, "def", cmacme.ACMEIssuer{Server: "https://acme.example.com"}, pk, "cert-manager-test")
+	if len(r.limiters) != 1 {
+		t.Errorf("expected 1 rate limiter for a shared account but got %d", len(r.limiters))
+	}
+	r.AddClient(http.DefaultClient, "ghi", cmacme.ACMEIssuer{Server: "https://acme.example.com"}, pk2, "cert-manager-test")
+	r.AddClient(http.DefaultClient, "jkl", cmacme.ACMEIssuer{Server: "https://acme-staging.example.com"}, pk, "cert-manager-test")
+	if len(r.limiters) != 3 {
+		t.Errorf("expected 3 rate limiters for distinct accounts but got %d", len(r.limiters))
+	}
+
+	for _, uid := range []string{"abc", "def", "ghi", "jkl"} {
+		c, err := r.GetClient(uid)
+		if err != nil {
+			t.Fatalf("unexpected error getting client: %v", err)
+		}
+		if _, ok := c.(*middleware.RateLimiter); !ok {
+			t.Errorf("expected client %q to be rate limited but got %T", uid, c)
+		}
+	}
+
+	// The rate limiter of an account is kept when all of its clients are
+	// removed, so that it is not reset when an Issuer registers its client
+	// again.
+	key := accountKey{serverURL: "https://acme.example.com", publicKey: newStableOptions("abc", cmacme.ACMEIssuer{}, pk).publicKey}
+	limiter := r.limiters[key]
+	r.RemoveClient("abc")
+	r.RemoveClient("def")
+	if len(r.limiters) != 3 {
+		t.Errorf("expected 3 rate limiters after removing all clients of an account but got %d", len(r.limiters))
+	}
+	r.AddClient(http.DefaultClient, "abc", cmacme.ACMEIssuer{Server: "https://acme.example.com"}, pk, "cert-manager-test")
+	if r.limiters[key] != limiter {
+		t.Error("expected the rate limiter to be kept when the account is registered again")
+	}
+
+	// Rate limiters are removed once a client is added and no client uses
+	// the account anymore.
+	r.RemoveClient("abc")
+	r.AddClient(http.DefaultClient, "mno", cmacme.ACMEIssuer{Server: "https://acme.example.com"}, pk2, "cert-manager-test")
+	if len(r.limiters) != 2 {
+		t.Errorf("expected 2 rate limiters after adding a client once an account is no longer used but got %d", len(r.limiters))
+	}
+}
+
+func TestRegistry_AddClient_NotRateLimitedByDefault(t *testing.T) {
+	r := NewDefaultRegistry()
+	pk, err := pki.GenerateRSAPrivateKey(2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r.AddClient(http.DefaultClient, "abc", cmacme.ACMEIssuer{}, pk, "cert-manager-test")
+	c, err := r.GetClient("abc")
+	if err != nil {
+		t.Fatalf("unexpected error getting client: %v", err)
+	}
+	if _, ok := c.(*middleware.RateLimiter); ok {
+		t.Error("expected client not to be rate limited")
+	}
+}
@@ -0,0 +1,568 @@
+/*
+Copyright 2020 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/acme"
+)
+
+// Client is an ACME client which extends golang.org/x/crypto/acme.Client with
+// support for ACME extensions which the upstream client does not implement:
+//   - certificate profiles (https://datatracker.ietf.org/doc/draft-ietf-acme-profiles/)
+//   - ACME Renewal Information, or ARI (RFC 9773)
+type Client struct {
+	*acme.Client
+}
+
+// OrderExtensions are the optional fields which may be set when creating a
+// new order using AuthorizeOrderWithExtensions.
+type OrderExtensions struct {
+	// NotAfter is the requested notAfter time of the certificate.
+	// A zero NotAfter means no notAfter is requested.
+	NotAfter time.Time
+
+	// Profile is the name of the certificate profile to request.
+	Profile string
+
+	// Replaces is the ARI identifier of the certificate which the new
+	// order will replace, as described in RFC 9773 section 5.
+	Replaces string
+}
+
+// RenewalInfo is the renewal information returned by an ACME server for a
+// certificate.
+type RenewalInfo struct {
+	// SuggestedWindowStart and SuggestedWindowEnd bound the window in which
+	// the ACME server suggests that the certificate is renewed.
+	SuggestedWindowStart time.Time
+	SuggestedWindowEnd   time.Time
+
+	// ExplanationURL optionally points to a page explaining the suggested
+	// window.
+	ExplanationURL string
+
+	// RetryAfter is how long the client should wait before fetching the
+	// renewal information again, as advised by the Retry-After header.
+	// It is zero if the ACME server did not set the header.
+	RetryAfter time.Duration
+}
+
+// ErrRenewalInfoNotSupported is returned by RenewalInfo if the ACME server
+// does not advertise a renewalInfo endpoint in its directory.
+var ErrRenewalInfoNotSupported = errors.New("ACME server does not support renewal information")
+
+// directory is the subset of the ACME directory used by the extensions
+// implemented by Client.
+type directory struct {
+	RenewalInfo string `json:"renewalInfo"`
+	Meta        struct {
+		Profiles map[string]string `json:"profiles"`
+	} `json:"meta"`
+}
+
+func (c *Client) directory(ctx context.Context) (*directory, error) {
+	res, err := c.get(ctx, c.DirectoryURL)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, responseError(res)
+	}
+
+	dir := &directory{}
+	if err := json.NewDecoder(res.Body).Decode(dir); err != nil {
+		return nil, fmt.Errorf("failed to decode ACME directory: %w", err)
+	}
+	return dir, nil
+}
+
+// Profiles returns the certificate profiles advertised by the ACME server in
+// the 'meta.profiles' field of its directory, keyed by profile name.
+// An empty map is returned if the server does not support profiles.
+func (c *Client) Profiles(ctx context.Context) (map[string]string, error) {
+	dir, err := c.directory(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if dir.Meta.Profiles == nil {
+		return map[string]string{}, nil
+	}
+	return dir.Meta.Profiles, nil
+}
+
+// RenewalInfo fetches the renewal information for the certificate with the
+// given ARI identifier, as described in RFC 9773 section 4.
+// ErrRenewalInfoNotSupported is returned if the ACME server does not
+// support ARI.
+func (c *Client) RenewalInfo(ctx context.Context, certID string) (*RenewalInfo, error) {
+	dir, err := c.directory(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if dir.RenewalInfo == "" {
+		return nil, ErrRenewalInfoNotSupported
+	}
+
+	res, err := c.get(ctx, strings.TrimSuffix(dir.RenewalInfo, "/")+"/"+certID)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, responseError(res)
+	}
+
+	var v struct {
+		SuggestedWindow struct {
+			Start time.Time `json:"start"`
+			End   time.Time `json:"end"`
+		} `json:"suggestedWindow"`
+		ExplanationURL string `json:"explanationURL"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&v); err != nil {
+		return nil, fmt.Errorf("failed to decode ACME renewal information: %w", err)
+	}
+	if v.SuggestedWindow.End.Before(v.SuggestedWindow.Start) {
+		return nil, fmt.Errorf("ACME server returned an invalid suggested window: end %s is before start %s",
+			v.SuggestedWindow.End.Format(time.RFC3339), v.SuggestedWindow.Start.Format(time.RFC3339))
+	}
+
+	return &RenewalInfo{
+		SuggestedWindowStart: v.SuggestedWindow.Start,
+		SuggestedWindowEnd:   v.SuggestedWindow.End,
+		ExplanationURL:       v.ExplanationURL,
+		RetryAfter:           retryAfter(res.Header.Get("Retry-After"), time.Now()),
+	}, nil
+}
+
+// retryAfter parses the value of a Retry-After header, which may either be a
+// number of seconds or an HTTP date. Zero is returned if the value is empty
+// or invalid.
+func retryAfter(v string, now time.Time) time.Duration {
+	if v == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(v); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil && t.After(now) {
+		return t.Sub(now)
+	}
+	return 0
+}
+
+// acmeRateLimitedError is the ACME problem type returned when a request
+// exceeds one of the ACME server's rate limits, as defined in RFC 8555
+// section 6.7.
+const acmeRateLimitedError = "urn:ietf:params:acme:error:rateLimited"
+
+// RateLimitRetryAfter returns how long to wait before retrying a request
+// which was rejected by the ACME server due to rate limiting, as given by the
+// Retry-After header of the response. False is returned if err is not a rate
+// limit error or if the server did not specify when to retry.
+func RateLimitRetryAfter(err error, now time.Time) (time.Duration, bool) {
+	var acmeErr *acme.Error
+	if !errors.As(err, &acmeErr) {
+		return 0, false
+	}
+	if acmeErr.StatusCode != http.StatusTooManyRequests && acmeErr.ProblemType != acmeRateLimitedError {
+		return 0, false
+	}
+	d := retryAfter(acmeErr.Header.Get("Retry-After"), now)
+	if d <= 0 {
+		return 0, false
+	}
+	return d, true
+}
+
+// AuthorizeOrderWithExtensions creates a new order for the given identifiers,
+// setting the extension fields given in ext. The replaced certificate is
+// only sent if the ACME server advertises support for ACME Renewal
+// Information. If neither a profile nor a replaced certificate are
+// requested, the order is created using AuthorizeOrder.
+func (c *Client) AuthorizeOrderWithExtensions(ctx context.Context, id []acme.AuthzID, ext OrderExtensions) (*acme.Order, error) {
+	if ext.Replaces != "" {
+		dir, err := c.directory(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if dir.RenewalInfo == "" {
+			ext.Replaces = ""
+		}
+	}
+	if ext.Profile == "" && ext.Replaces == "" {
+		var opts []acme.OrderOption
+		if !ext.NotAfter.IsZero() {
+			opts = append(opts, acme.WithOrderNotAfter(ext.NotAfter))
+		}
+		return c.AuthorizeOrder(ctx, id, opts...)
+	}
+
+	dir, err := c.Discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	kid := string(c.KID)
+	if kid == "" {
+		acct, err := c.GetReg(ctx, "")
+		if err != nil {
+			return nil, err
+		}
+		kid = acct.URI
+	}
+
+	type wireAuthzID struct {
+		Type  string `json:"type"`
+		Value string `json:"value"`
+	}
+	req := struct {
+		Identifiers []wireAuthzID `json:"identifiers"`
+		NotAfter    string        `json:"notAfter,omitempty"`
+		Profile     string        `json:"profile,omitempty"`
+		Replaces    string        `json:"replaces,omitempty"`
+	}{
+		Profile:  ext.Profile,
+		Replaces: ext.Replaces,
+	}
+	for _, v := range id {
+		req.Identifiers = append(req.Identifiers, wireAuthzID{Type: v.Type, Value: v.Value})
+	}
+	if !ext.NotAfter.IsZero() {
+		req.NotAfter = ext.NotAfter.Format(time.RFC3339)
+	}
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.postJWS(ctx, dir.NonceURL, kid, dir.OrderURL, payload)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusCreated {
+		return nil, responseError(res)
+	}
+
+	var v struct {
+		Status         string
+		Expires        time.Time
+		Identifiers    []wireAuthzID
+		NotBefore      time.Time
+		NotAfter       time.Time
+		Authorizations []string
+		Finalize       string
+		Certificate    string
+		Error          *wireError
+	}
+	if err := json.NewDecoder(res.Body).Decode(&v); err != nil {
+		return nil, fmt.Errorf("failed to decode ACME order: %w", err)
+	}
+	o := &acme.Order{
+		URI:         res.Header.Get("Location"),
+		Status:      v.Status,
+		Expires:     v.Expires,
+		NotBefore:   v.NotBefore,
+		NotAfter:    v.NotAfter,
+		AuthzURLs:   v.Authorizations,
+		FinalizeURL: v.Finalize,
+		CertURL:     v.Certificate,
+	}
+	for _, i := range v.Identifiers {
+		o.Identifiers = append(o.Identifiers, acme.AuthzID{Type: i.Type, Value: i.Value})
+	}
+	if v.Error != nil {
+		o.Error = v.Error.acmeError(res.StatusCode, res.Header)
+	}
+	return o, nil
+}
+
+// maxRetries bounds the number of times a request is retried, whatever
+// the backoff policy in use.
+const maxRetries = 10
+
+// get issues an unsigned GET request to url. Rate limited and server error
+// responses are retried in the same way as by acme.Client, see backoff.
+// Other responses are returned to the caller whatever their status code.
+func (c *Client) get(ctx context.Context, url string) (*http.Response, error) {
+	for n := 1; ; n++ {
+		req, err := c.newRequest(ctx, http.MethodGet, url, "", nil)
+		if err != nil {
+			return nil, err
+		}
+		res, err := c.httpClient().Do(req)
+		if err != nil {
+			return nil, err
+		}
+		if !isRetriable(res.StatusCode) {
+			return res, nil
+		}
+		resErr := responseError(res)
+		res.Body.Close()
+		if err := c.backoff(ctx, n, req, res); err != nil {
+			return nil, resErr
+		}
+	}
+}
+
+// postJWS signs payload as a JWS using the account key identified by kid and
+// POSTs it to url. A request rejected due to a bad nonce is retried with a
+// fresh nonce, and rate limited or server error responses are retried, in
+// the same way as by acme.Client, see backoff. Successful responses are
+// returned to the caller, and an *acme.Error otherwise.
+func (c *Client) postJWS(ctx context.Context, nonceURL, kid, url string, payload []byte) (*http.Response, error) {
+	for n := 1; ; n++ {
+		nonce, err := c.fetchNonce(ctx, nonceURL)
+		if err != nil {
+			return nil, err
+		}
+		body, err := signJWS(c.Key, kid, nonce, url, payload)
+		if err != nil {
+			return nil, err
+		}
+		req, err := c.newRequest(ctx, http.MethodPost, url, "application/jose+json", body)
+		if err != nil {
+			return nil, err
+		}
+		res, err := c.httpClient().Do(req)
+		if err != nil {
+			return nil, err
+		}
+		if res.StatusCode < http.StatusBadRequest {
+			return res, nil
+		}
+		resErr := responseError(res)
+		res.Body.Close()
+		// A bad nonce may be returned with an otherwise non-retriable status
+		// code such as 400 Bad Request.
+		var e *acme.Error
+		badNonce := errors.As(resErr, &e) && e.ProblemType == "urn:ietf:params:acme:error:badNonce"
+		if !badNonce && !isRetriable(res.StatusCode) {
+			return nil, resErr
+		}
+		if err := c.backoff(ctx, n, req, res); err != nil {
+			return nil, resErr
+		}
+	}
+}
+
+// isRetriable reports whether a request which failed with the given status
+// code may be retried, following the policy of acme.Client.
+func isRetriable(code int) bool {
+	return code == http.StatusTooManyRequests || (code >= http.StatusInternalServerError && code != http.StatusNotImplemented)
+}
+
+// backoff waits before the n'th retry of req, which failed with res. The
+// delay is given by the RetryBackoff of the embedded acme.Client, so that
+// requests made by Client are retried in the same way as those made by
+// acme.Client, or by defaultBackoff if it is not set. An error is returned
+// if the request should not be retried, either because the backoff returned
+// a non-positive delay, maxRetries was reached or ctx is done.
+func (c *Client) backoff(ctx context.Context, n int, req *http.Request, res *http.Response) error {
+	if n > maxRetries {
+		return fmt.Errorf("no more retries for %s; tried %d time(s)", req.URL, n)
+	}
+	backoffFn := c.RetryBackoff
+	if backoffFn == nil {
+		backoffFn = defaultBackoff
+	}
+	d := backoffFn(n, req, res)
+	if d <= 0 {
+		return fmt.Errorf("no more retries for %s; tried %d time(s)", req.URL, n)
+	}
+	wakeup := time.NewTimer(d)
+	defer wakeup.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-wakeup.C:
+		return nil
+	}
+}
+
+// defaultBackoff is the truncated exponential backoff with jitter used by
+// acme.Client when no RetryBackoff is set. The Retry-After header of the
+// response is honoured if set.
+func defaultBackoff(n int, _ *http.Request, res *http.Response) time.Duration {
+	const maxDelay = 10 * time.Second
+	var jitter time.Duration
+	if x, err := rand.Int(rand.Reader, big.NewInt(1000)); err == nil {
+		// Always wait at least 1ms so that an invalid Retry-After, which
+		// parses to zero, does not stop the retries.
+		jitter = (1 + time.Duration(x.Int64())) * time.Millisecond
+	}
+	if v := res.Header.Get("Retry-After"); v != "" {
+		return retryAfter(v, time.Now()) + jitter
+	}
+	if n < 1 {
+		n = 1
+	}
+	if n > 30 {
+		n = 30
+	}
+	d := time.Duration(1<<uint(n-1))*time.Second + jitter
+	if d > maxDelay {
+		return maxDelay
+	}
+	return d
+}
+
+func (c *Client) fetchNonce(ctx context.Context, url string) (string, error) {
+	req, err := c.newRequest(ctx, http.MethodHead, url, "", nil)
+	if err != nil {
+		return "", err
+	}
+	res, err := c.httpClient().Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+	nonce := res.Header.Get("Replay-Nonce")
+	if nonce == "" {
+		return "", errors.New("ACME server did not return a Replay-Nonce")
+	}
+	return nonce, nil
+}
+
+func (c *Client) newRequest(ctx context.Context, method, url, contentType string, body []byte) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+	return req, nil
+}
+
+func (c *Client) httpClient() *http.Client {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+	return http.DefaultClient
+}
+
+// signJWS returns the flattened JSON serialization of a JWS over payload, as
+// described in RFC 8555 section 6.2.
+func signJWS(key crypto.Signer, kid, nonce, url string, payload []byte) ([]byte, error) {
+	var alg string
+	switch pub := key.Public().(type) {
+	case *rsa.PublicKey:
+		alg = "RS256"
+	case *ecdsa.PublicKey:
+		if pub.Curve.Params().BitSize != 256 {
+			return nil, fmt.Errorf("unsupported ECDSA curve %s", pub.Curve.Params().Name)
+		}
+		alg = "ES256"
+	default:
+		return nil, fmt.Errorf("unsupported account key type %T", pub)
+	}
+
+	protected, err := json.Marshal(struct {
+		Alg   string `json:"alg"`
+		KID   string `json:"kid"`
+		Nonce string `json:"nonce"`
+		URL   string `json:"url"`
+	}{alg, kid, nonce, url})
+	if err != nil {
+		return nil, err
+	}
+	phead := base64.RawURLEncoding.EncodeToString(protected)
+	payload64 := base64.RawURLEncoding.EncodeToString(payload)
+
+	digest := sha256.Sum256([]byte(phead + "." + payload64))
+	sig, err := key.Sign(rand.Reader, digest[:], crypto.SHA256)
+	if err != nil {
+		return nil, err
+	}
+	if alg == "ES256" {
+		// JWS requires the fixed length R || S encoding rather than ASN.1.
+		var es struct{ R, S *big.Int }
+		if _, err := asn1.Unmarshal(sig, &es); err != nil {
+			return nil, err
+		}
+		sig = make([]byte, 64)
+		es.R.FillBytes(sig[:32])
+		es.S.FillBytes(sig[32:])
+	}
+
+	return json.Marshal(struct {
+		Protected string `json:"protected"`
+		Payload   string `json:"payload"`
+		Sig       string `json:"signature"`
+	}{phead, payload64, base64.RawURLEncoding.EncodeToString(sig)})
+}
+
+// wireError is an RFC 7807 problem document returned by an ACME server.
+type wireError struct {
+	Status      int
+	Type        string
+	Detail      string
+	Instance    string
+	Subproblems []acme.Subproblem
+}
+
+func (e *wireError) acmeError(status int, h http.Header) *acme.Error {
+	if e.Status == 0 {
+		e.Status = status
+	}
+	return &acme.Error{
+		StatusCode:  e.Status,
+		ProblemType: e.Type,
+		Detail:      e.Detail,
+		Instance:    e.Instance,
+		Header:      h,
+		Subproblems: e.Subproblems,
+	}
+}
+
+// responseError converts a non-successful response into an *acme.Error.
+func responseError(res *http.Response) error {
+	b, _ := io.ReadAll(io.LimitReader(res.Body, 1<<20))
+	e := &wireError{}
+	if err := json.Unmarshal(b, e); err != nil {
+		// Not a problem document, use the raw body as the detail.
+		e.Detail = string(b)
+	}
+	return e.acmeError(res.StatusCode, res.Header)
+}
@@ -0,0 +1,393 @@
+/*
+Copyright 2020 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/acme"
+
+	acmeutil "github.com/cert-manager/cert-manager/pkg/acme/util"
+)
+
+// fakeACMEServer is a minimal ACME server which advertises the given
+// profiles in its directory, records the payload of newOrder requests and
+// serves the given renewal information for the certificate with ID certID.
+// If orderRetryAfter is set, newOrder requests are rejected as rate limited
+// with the given Retry-After header.
+type fakeACMEServer struct {
+	profiles map[string]string
+
+	certID      string
+	renewalInfo string
+	retryAfter  string
+
+	orderPayload    map[string]interface{}
+	orderRetryAfter string
+	badNonces       int
+	serverErrors    int
+	orderRequests   int
+}
+
+func (f *fakeACMEServer) start(t *testing.T) *httptest.Server {
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/directory":
+			dir := map[string]interface{}{
+				"newNonce":   srv.URL + "/nonce",
+				"newAccount": srv.URL + "/account",
+				"newOrder":   srv.URL + "/order",
+			}
+			if f.profiles != nil {
+				dir["meta"] = map[string]interface{}{"profiles": f.profiles}
+			}
+			if f.renewalInfo != "" {
+				dir["renewalInfo"] = srv.URL + "/renewal-info"
+			}
+			_ = json.NewEncoder(w).Encode(dir)
+		case "/renewal-info/" + f.certID:
+			if f.retryAfter != "" {
+				w.Header().Set("Retry-After", f.retryAfter)
+			}
+			fmt.Fprint(w, f.renewalInfo)
+		case "/nonce":
+			w.Header().Set("Replay-Nonce", "nonce")
+		case "/order":
+			f.orderRequests++
+			if f.serverErrors > 0 {
+				f.serverErrors--
+				w.Header().Set("Content-Type", "application/problem+json")
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprint(w, `{"type":"urn:ietf:params:acme:error:serverInternal","detail":"try again"}`)
+				return
+			}
+			if f.badNonces > 0 {
+				f.badNonces--
+				w.Header().Set("Content-Type", "application/problem+json")
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{"type":"urn:ietf:params:acme:error:badNonce","detail":"bad nonce"}`)
+				return
+			}
+			if f.orderRetryAfter != "" {
+				w.Header().Set("Content-Type", "application/problem+json")
+				w.Header().Set("Retry-After", f.orderRetryAfter)
+				w.WriteHeader(http.StatusTooManyRequests)
+				fmt.Fprint(w, `{"type":"urn:ietf:params:acme:error:rateLimited","detail":"too many new orders"}`)
+				return
+			}
+			var jws struct {
+				Protected string `json:"protected"`
+				Payload   string `json:"payload"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&jws); err != nil {
+				t.Errorf("failed to decode JWS: %v", err)
+			}
+			payload, err := base64.RawURLEncoding.DecodeString(jws.Payload)
+			if err != nil {
+				t.Errorf("failed to decode JWS payload: %v", err)
+			}
+			if err := json.Unmarshal(payload, &f.orderPayload); err != nil {
+				t.Errorf("failed to decode newOrder payload: %v", err)
+			}
+			w.Header().Set("Location", srv.URL+"/order/1")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, `{"status":"pending","identifiers":[{"type":"dns","value":"example.com"}],"authorizations":["%[1]s/authz/1"],"finalize":"%[1]s/order/1/finalize"}`, srv.URL)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func newTestClient(t *testing.T, srv *httptest.Server) *Client {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Client{
+		Client: &acme.Client{
+			Key:          key,
+			KID:          acme.KeyID(srv.URL + "/account/1"),
+			DirectoryURL: srv.URL + "/directory",
+			HTTPClient:   srv.Client(),
+			RetryBackoff: func(int, *http.Request, *http.Response) time.Duration {
+				return time.Millisecond
+			},
+		},
+	}
+}
+
+func TestProfiles(t *testing.T) {
+	tests := map[string]struct {
+		profiles map[string]string
+		expected map[string]string
+	}{
+		"server advertising profiles": {
+			profiles: map[string]string{"classic": "The default profile", "shortlived": "Six day certificates"},
+			expected: map[string]string{"classic": "The default profile", "shortlived": "Six day certificates"},
+		},
+		"server not advertising profiles": {
+			expected: map[string]string{},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			srv := (&fakeACMEServer{profiles: test.profiles}).start(t)
+			profiles, err := newTestClient(t, srv).Profiles(context.Background())
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(profiles, test.expected) {
+				t.Errorf("expected profiles %v but got %v", test.expected, profiles)
+			}
+		})
+	}
+}
+
+func TestAuthorizeOrderWithExtensions(t *testing.T) {
+	tests := map[string]struct {
+		ext          OrderExtensions
+		renewalInfo  string
+		badNonces    int
+		serverErrors int
+		expected     map[string]interface{}
+	}{
+		"order includes the selected profile": {
+			ext:      OrderExtensions{Profile: "shortlived"},
+			expected: map[string]interface{}{"profile": "shortlived"},
+		},
+		"order includes the replaced certificate": {
+			ext:         OrderExtensions{Replaces: "aYhba4dGQEHhs3uEe6CuLN4ByNQ.AIdlQyE"},
+			renewalInfo: "{}",
+			expected:    map[string]interface{}{"replaces": "aYhba4dGQEHhs3uEe6CuLN4ByNQ.AIdlQyE"},
+		},
+		"replaced certificate is omitted if the server does not support renewal information": {
+			ext:      OrderExtensions{Profile: "shortlived", Replaces: "aYhba4dGQEHhs3uEe6CuLN4ByNQ.AIdlQyE"},
+			expected: map[string]interface{}{"profile": "shortlived"},
+		},
+		"request is retried after a bad nonce": {
+			ext:       OrderExtensions{Profile: "shortlived"},
+			badNonces: 1,
+			expected:  map[string]interface{}{"profile": "shortlived"},
+		},
+		"request is retried after server errors": {
+			ext:          OrderExtensions{Profile: "shortlived"},
+			serverErrors: 2,
+			expected:     map[string]interface{}{"profile": "shortlived"},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := &fakeACMEServer{
+				profiles:     map[string]string{"shortlived": ""},
+				renewalInfo:  test.renewalInfo,
+				badNonces:    test.badNonces,
+				serverErrors: test.serverErrors,
+			}
+			srv := f.start(t)
+
+			order, err := newTestClient(t, srv).AuthorizeOrderWithExtensions(context.Background(), acme.DomainIDs("example.com"), test.ext)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			delete(f.orderPayload, "identifiers")
+			if !reflect.DeepEqual(f.orderPayload, test.expected) {
+				t.Errorf("expected newOrder payload extensions %v but got %v", test.expected, f.orderPayload)
+			}
+			expected := &acme.Order{
+				URI:         srv.URL + "/order/1",
+				Status:      acme.StatusPending,
+				Identifiers: acme.DomainIDs("example.com"),
+				AuthzURLs:   []string{srv.URL + "/authz/1"},
+				FinalizeURL: srv.URL + "/order/1/finalize",
+			}
+			if !reflect.DeepEqual(order, expected) {
+				t.Errorf("expected order %+v but got %+v", expected, order)
+			}
+		})
+	}
+}
+
+func TestAuthorizeOrderWithExtensionsGivesUp(t *testing.T) {
+	tests := map[string]struct {
+		retryBackoff     func(n int, r *http.Request, resp *http.Response) time.Duration
+		expectedRequests int
+	}{
+		"retries stop when the backoff returns a negative delay": {
+			retryBackoff: func(n int, _ *http.Request, _ *http.Response) time.Duration {
+				if n > 2 {
+					return -1
+				}
+				return time.Millisecond
+			},
+			expectedRequests: 3,
+		},
+		"retries stop after the maximum number of retries": {
+			retryBackoff: func(int, *http.Request, *http.Response) time.Duration {
+				return time.Millisecond
+			},
+			expectedRequests: maxRetries + 1,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := &fakeACMEServer{
+				profiles:     map[string]string{"shortlived": ""},
+				serverErrors: 100,
+			}
+			srv := f.start(t)
+			cl := newTestClient(t, srv)
+			cl.Client.RetryBackoff = test.retryBackoff
+
+			_, err := cl.AuthorizeOrderWithExtensions(context.Background(), acme.DomainIDs("example.com"), OrderExtensions{Profile: "shortlived"})
+			var acmeErr *acme.Error
+			if !errors.As(err, &acmeErr) || acmeErr.StatusCode != http.StatusServiceUnavailable {
+				t.Fatalf("expected the ACME server error but got %v", err)
+			}
+			if f.orderRequests != test.expectedRequests {
+				t.Errorf("expected %d newOrder requests but got %d", test.expectedRequests, f.orderRequests)
+			}
+		})
+	}
+}
+
+func TestRenewalInfo(t *testing.T) {
+	const certID = "aYhba4dGQEHhs3uEe6CuLN4ByNQ.AIdlQyE"
+	tests := map[string]struct {
+		renewalInfo string
+		retryAfter  string
+		expected    *RenewalInfo
+		expectedErr error
+	}{
+		"server returning a suggested window": {
+			renewalInfo: `{"suggestedWindow":{"start":"2025-01-02T04:00:00Z","end":"2025-01-03T04:00:00Z"},"explanationURL":"https://acme.example.com/docs/ari"}`,
+			retryAfter:  "21600",
+			expected: &RenewalInfo{
+				SuggestedWindowStart: time.Date(2025, 1, 2, 4, 0, 0, 0, time.UTC),
+				SuggestedWindowEnd:   time.Date(2025, 1, 3, 4, 0, 0, 0, time.UTC),
+				ExplanationURL:       "https://acme.example.com/docs/ari",
+				RetryAfter:           6 * time.Hour,
+			},
+		},
+		"server returning a suggested window without Retry-After": {
+			renewalInfo: `{"suggestedWindow":{"start":"2025-01-02T04:00:00Z","end":"2025-01-03T04:00:00Z"}}`,
+			expected: &RenewalInfo{
+				SuggestedWindowStart: time.Date(2025, 1, 2, 4, 0, 0, 0, time.UTC),
+				SuggestedWindowEnd:   time.Date(2025, 1, 3, 4, 0, 0, 0, time.UTC),
+			},
+		},
+		"server not supporting renewal information": {
+			expectedErr: ErrRenewalInfoNotSupported,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			srv := (&fakeACMEServer{certID: certID, renewalInfo: test.renewalInfo, retryAfter: test.retryAfter}).start(t)
+			info, err := newTestClient(t, srv).RenewalInfo(context.Background(), certID)
+			if !errors.Is(err, test.expectedErr) {
+				t.Fatalf("expected error %v but got %v", test.expectedErr, err)
+			}
+			if !reflect.DeepEqual(info, test.expected) {
+				t.Errorf("expected renewal information %+v but got %+v", test.expected, info)
+			}
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2025, 1, 2, 3, 0, 0, 0, time.UTC)
+	tests := map[string]struct {
+		value    string
+		expected time.Duration
+	}{
+		"empty":          {},
+		"seconds":        {value: "120", expected: 2 * time.Minute},
+		"HTTP date":      {value: "Thu, 02 Jan 2025 04:00:00 GMT", expected: time.Hour},
+		"date in past":   {value: "Thu, 02 Jan 2025 02:00:00 GMT"},
+		"invalid value":  {value: "soon"},
+		"negative value": {value: "-5"},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := retryAfter(test.value, now); got != test.expected {
+				t.Errorf("expected %s but got %s", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestRateLimitRetryAfter(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	tests := map[string]struct {
+		retryAfter    string
+		expected      time.Duration
+		expectLimited bool
+	}{
+		"server returning Retry-After in seconds": {
+			retryAfter:    "120",
+			expected:      2 * time.Minute,
+			expectLimited: true,
+		},
+		"server returning Retry-After as an HTTP date": {
+			retryAfter:    now.Add(time.Hour).UTC().Format(http.TimeFormat),
+			expected:      time.Hour,
+			expectLimited: true,
+		},
+		"server returning an invalid Retry-After": {
+			retryAfter: "soon",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			srv := (&fakeACMEServer{orderRetryAfter: test.retryAfter}).start(t)
+			cl := newTestClient(t, srv)
+			cl.Client.RetryBackoff = acmeutil.RetryBackoff
+
+			_, err := cl.AuthorizeOrderWithExtensions(context.Background(), acme.DomainIDs("example.com"), OrderExtensions{})
+			if err == nil {
+				t.Fatal("expected a rate limit error but got none")
+			}
+			d, limited := RateLimitRetryAfter(err, now)
+			if limited != test.expectLimited {
+				t.Errorf("expected rate limited %v but got %v", test.expectLimited, limited)
+			}
+			if d != test.expected {
+				t.Errorf("expected to retry after %s but got %s", test.expected, d)
+			}
+		})
+	}
+
+	t.Run("error not returned by the ACME server", func(t *testing.T) {
+		if _, limited := RateLimitRetryAfter(errors.New("some error"), now); limited {
+			t.Error("expected error not to be treated as rate limited")
+		}
+	})
+}
@@ -18,6 +18,7 @@ package client
 
 import (
 	"context"
+	"crypto"
 	"fmt"
 
 	"golang.org/x/crypto/acme"
@@ -28,22 +29,27 @@ import (
 
 // FakeACME implements Interface and can be used as a mock acme.Client in tests.
 type FakeACME struct {
-	FakeAuthorizeOrder          func(ctx context.Context, id []acme.AuthzID, opt ...acme.OrderOption) (*acme.Order, error)
-	FakeGetOrder                func(ctx context.Context, url string) (*acme.Order, error)
-	FakeFetchCert               func(ctx context.Context, url string, bundle bool) ([][]byte, error)
-	FakeListCertAlternates      func(ctx context.Context, url string) ([]string, error)
-	FakeWaitOrder               func(ctx context.Context, url string) (*acme.Order, error)
-	FakeCreateOrderCert         func(ctx context.Context, finalizeURL string, csr []byte, bundle bool) (der [][]byte, certURL string, err error)
-	FakeAccept                  func(ctx context.Context, chal *acme.Challenge) (*acme.Challenge, error)
-	FakeGetChallenge            func(ctx context.Context, url string) (*acme.Challenge, error)
-	FakeGetAuthorization        func(ctx context.Context, url string) (*acme.Authorization, error)
-	FakeWaitAuthorization       func(ctx context.Context, url string) (*acme.Authorization, error)
-	FakeRegister                func(ctx context.Context, a *acme.Account, prompt func(tosURL string) bool) (*acme.Account, error)
-	FakeGetReg                  func(ctx context.Context, url string) (*acme.Account, error)
-	FakeHTTP01ChallengeResponse func(token string) (string, error)
-	FakeDNS01ChallengeRecord    func(token string) (string, error)
-	FakeDiscover                func(ctx context.Context) (acme.Directory, error)
-	FakeUpdateReg               func(ctx context.Context, a *acme.Account) (*acme.Account, error)
+	FakeAuthorizeOrder               func(ctx context.Context, id []acme.AuthzID, opt ...acme.OrderOption) (*acme.Order, error)
+	FakeAuthorizeOrderWithExtensions func(ctx context.Context, id []acme.AuthzID, ext OrderExtensions) (*acme.Order, error)
+	FakeProfiles                     func(ctx context.Context) (map[string]string, error)
+	FakeRenewalInfo                  func(ctx context.Context, certID string) (*RenewalInfo, error)
+	FakeGetOrder                     func(ctx context.Context, url string) (*acme.Order, error)
+	FakeFetchCert                    func(ctx context.Context, url string, bundle bool) ([][]byte, error)
+	FakeListCertAlternates           func(ctx context.Context, url string) ([]string, error)
+	FakeWaitOrder                    func(ctx context.Context, url string) (*acme.Order, error)
+	FakeCreateOrderCert              func(ctx context.Context, finalizeURL string, csr []byte, bundle bool) (der [][]byte, certURL string, err error)
+	FakeAccept                       func(ctx context.Context, chal *acme.Challenge) (*acme.Challenge, error)
+	FakeGetChallenge                 func(ctx context.Context, url string) (*acme.Challenge, error)
+	FakeGetAuthorization             func(ctx context.Context, url string) (*acme.Authorization, error)
+	FakeWaitAuthorization            func(ctx context.Context, url string) (*acme.Authorization, error)
+	FakeRegister                     func(ctx context.Context, a *acme.Account, prompt func(tosURL string) bool) (*acme.Account, error)
+	FakeGetReg                       func(ctx context.Context, url string) (*acme.Account, error)
+	FakeHTTP01ChallengeResponse      func(token string) (string, error)
+	FakeDNS01ChallengeRecord         func(token string) (string, error)
+	FakeDiscover                     func(ctx context.Context) (acme.Directory, error)
+	FakeUpdateReg                    func(ctx context.Context, a *acme.Account) (*acme.Account, error)
+	FakeRevokeCert                   func(ctx context.Context, key crypto.Signer, cert []byte, reason acme.CRLReasonCode) error
+	FakeDeactivateReg                func(ctx context.Context) error
 }
 
 var _ Interface = &FakeACME{}
@@ -55,6 +61,29 @@ func (f *FakeACME) AuthorizeOrder(ctx context.Context, id []acme.AuthzID, opt ..
 	return nil, fmt.Errorf("AuthorizeOrder not implemented")
 }
 
+func (f *FakeACME) AuthorizeOrderWithExtensions(ctx context.Context, id []acme.AuthzID, ext OrderExtensions) (*acme.Order, error) {
+	if f.FakeAuthorizeOrderWithExtensions != nil {
+		return f.FakeAuthorizeOrderWithExtensions(ctx, id, ext)
+	}
+	return nil, fmt.Errorf("AuthorizeOrderWithExtensions not implemented")
+}
+
+func (f *FakeACME) Profiles(ctx context.Context) (map[string]string, error) {
+	if f.FakeProfiles != nil {
+		return f.FakeProfiles(ctx)
+	}
+	// Behave as an ACME server which does not advertise any profiles.
+	return map[string]string{}, nil
+}
+
+func (f *FakeACME) RenewalInfo(ctx context.Context, certID string) (*RenewalInfo, error) {
+	if f.FakeRenewalInfo != nil {
+		return f.FakeRenewalInfo(ctx, certID)
+	}
+	// Behave as an ACME server which does not support renewal information.
+	return nil, ErrRenewalInfoNotSupported
+}
+
 func (f *FakeACME) GetOrder(ctx context.Context, url string) (*acme.Order, error) {
 	if f.FakeGetOrder != nil {
 		return f.FakeGetOrder(ctx, url)
@@ -161,3 +190,17 @@ func (f *FakeACME) ListCertAlternates(ctx context.Context, url string) ([]string
 	}
 	return nil, fmt.Errorf("ListCertAlternates not implemented")
 }
+
+func (f *FakeACME) RevokeCert(ctx context.Context, key crypto.Signer, cert []byte, reason acme.CRLReasonCode) error {
+	if f.FakeRevokeCert != nil {
+		return f.FakeRevokeCert(ctx, key, cert, reason)
+	}
+	return fmt.Errorf("RevokeCert not implemented")
+}
+
+func (f *FakeACME) DeactivateReg(ctx context.Context) error {
+	if f.FakeDeactivateReg != nil {
+		return f.FakeDeactivateReg(ctx)
+	}
+	return fmt.Errorf("DeactivateReg not implemented")
+}
@@ -18,6 +18,7 @@ package client
 
 import (
 	"context"
+	"crypto"
 
 	"golang.org/x/crypto/acme"
 
@@ -31,6 +32,16 @@ import (
 // and RFC 8555 (https://tools.ietf.org/html/rfc8555).
 type Interface interface {
 	AuthorizeOrder(ctx context.Context, id []acme.AuthzID, opt ...acme.OrderOption) (*acme.Order, error)
+	// AuthorizeOrderWithExtensions creates a new Order, setting fields
+	// defined by ACME extensions such as the certificate profile or the
+	// certificate being replaced.
+	AuthorizeOrderWithExtensions(ctx context.Context, id []acme.AuthzID, ext OrderExtensions) (*acme.Order, error)
+	// Profiles returns the certificate profiles advertised by the ACME
+	// server, keyed by name.
+	Profiles(ctx context.Context) (map[string]string, error)
+	// RenewalInfo returns the ACME Renewal Information for the certificate
+	// with the given ARI identifier.
+	RenewalInfo(ctx context.Context, certID string) (*RenewalInfo, error)
 	GetOrder(ctx context.Context, url string) (*acme.Order, error)
 	FetchCert(ctx context.Context, url string, bundle bool) ([][]byte, error)
 	ListCertAlternates(ctx context.Context, url string) ([]string, error)
@@ -57,8 +68,16 @@ type Interface interface {
 	DNS01ChallengeRecord(token string) (string, error)
 	Discover(ctx context.Context) (acme.Directory, error)
 	UpdateReg(ctx context.Context, a *acme.Account) (*acme.Account, error)
+	// RevokeCert revokes a previously issued certificate. If key is nil,
+	// the certificate is revoked using the account key that issued it.
+	RevokeCert(ctx context.Context, key crypto.Signer, cert []byte, reason acme.CRLReasonCode) error
+	// DeactivateReg permanently deactivates the ACME account identified by
+	// the client's key.
+	DeactivateReg(ctx context.Context) error
 }
 
-var _ Interface = &acme.Client{
-	RetryBackoff: acmeutil.RetryBackoff,
+var _ Interface = &Client{
+	Client: &acme.Client{
+		RetryBackoff: acmeutil.RetryBackoff,
+	},
 }
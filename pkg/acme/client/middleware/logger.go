@@ -18,6 +18,7 @@ package middleware
 
 import (
 	"context"
+	"crypto"
 
 	"github.com/go-logr/logr"
 	"golang.org/x/crypto/acme"
@@ -47,6 +48,24 @@ func (l *Logger) AuthorizeOrder(ctx context.Context, id []acme.AuthzID, opt ...a
 	return l.baseCl.AuthorizeOrder(ctx, id, opt...)
 }
 
+func (l *Logger) AuthorizeOrderWithExtensions(ctx context.Context, id []acme.AuthzID, ext client.OrderExtensions) (*acme.Order, error) {
+	l.log.V(logf.TraceLevel).Info("Calling AuthorizeOrderWithExtensions")
+
+	return l.baseCl.AuthorizeOrderWithExtensions(ctx, id, ext)
+}
+
+func (l *Logger) Profiles(ctx context.Context) (map[string]string, error) {
+	l.log.V(logf.TraceLevel).Info("Calling Profiles")
+
+	return l.baseCl.Profiles(ctx)
+}
+
+func (l *Logger) RenewalInfo(ctx context.Context, certID string) (*client.RenewalInfo, error) {
+	l.log.V(logf.TraceLevel).Info("Calling RenewalInfo")
+
+	return l.baseCl.RenewalInfo(ctx, certID)
+}
+
 func (l *Logger) GetOrder(ctx context.Context, url string) (*acme.Order, error) {
 	l.log.V(logf.TraceLevel).Info("Calling GetOrder")
 
@@ -136,3 +155,15 @@ func (l *Logger) UpdateReg(ctx context.Context, a *acme.Account) (*acme.Account,
 
 	return l.baseCl.UpdateReg(ctx, a)
 }
+
+func (l *Logger) RevokeCert(ctx context.Context, key crypto.Signer, cert []byte, reason acme.CRLReasonCode) error {
+	l.log.V(logf.TraceLevel).Info("Calling RevokeCert")
+
+	return l.baseCl.RevokeCert(ctx, key, cert, reason)
+}
+
+func (l *Logger) DeactivateReg(ctx context.Context) error {
+	l.log.V(logf.TraceLevel).Info("Calling DeactivateReg")
+
+	return l.baseCl.DeactivateReg(ctx)
+}
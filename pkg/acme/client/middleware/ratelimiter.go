@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/crypto/acme"
+	"k8s.io/client-go/util/flowcontrol"
+
+	"github.com/cert-manager/cert-manager/pkg/acme/client"
+)
+
+// NewRateLimiter returns an ACME client which waits for the given rate
+// limiter before creating new Orders or fetching Authorizations. The same
+// rate limiter can be shared by the clients of all Issuers using the same
+// ACME account, so that their combined request rate is bounded.
+func NewRateLimiter(baseCl client.Interface, limiter flowcontrol.RateLimiter) client.Interface {
+	return &RateLimiter{
+		Interface: baseCl,
+		limiter:   limiter,
+	}
+}
+
+// RateLimiter is a middleware for an ACME client which throttles the calls
+// that are most likely to trip the rate limits of an ACME server. All other
+// calls are passed through to the underlying client unchanged.
+type RateLimiter struct {
+	client.Interface
+	limiter flowcontrol.RateLimiter
+}
+
+var _ client.Interface = &RateLimiter{}
+
+func (r *RateLimiter) wait(ctx context.Context) error {
+	if err := r.limiter.Wait(ctx); err != nil {
+		return fmt.Errorf("waiting for client-side ACME rate limiter: %w", err)
+	}
+	return nil
+}
+
+func (r *RateLimiter) AuthorizeOrder(ctx context.Context, id []acme.AuthzID, opt ...acme.OrderOption) (*acme.Order, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+
+	return r.Interface.AuthorizeOrder(ctx, id, opt...)
+}
+
+func (r *RateLimiter) AuthorizeOrderWithExtensions(ctx context.Context, id []acme.AuthzID, ext client.OrderExtensions) (*acme.Order, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+
+	return r.Interface.AuthorizeOrderWithExtensions(ctx, id, ext)
+}
+
+func (r *RateLimiter) GetAuthorization(ctx context.Context, url string) (*acme.Authorization, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+
+	return r.Interface.GetAuthorization(ctx, url)
+}
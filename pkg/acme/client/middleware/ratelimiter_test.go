@@ -0,0 +1,104 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/acme"
+	"k8s.io/client-go/util/flowcontrol"
+
+	"github.com/cert-manager/cert-manager/pkg/acme/client"
+)
+
+func TestRateLimiterBoundsCallRate(t *testing.T) {
+	const (
+		qps   = 20
+		burst = 2
+		calls = 8
+	)
+
+	var orders, authorizations, challenges int
+	cl := NewRateLimiter(&client.FakeACME{
+		FakeAuthorizeOrder: func(context.Context, []acme.AuthzID, ...acme.OrderOption) (*acme.Order, error) {
+			orders++
+			return &acme.Order{}, nil
+		},
+		FakeGetAuthorization: func(context.Context, string) (*acme.Authorization, error) {
+			authorizations++
+			return &acme.Authorization{}, nil
+		},
+		FakeGetChallenge: func(context.Context, string) (*acme.Challenge, error) {
+			challenges++
+			return &acme.Challenge{}, nil
+		},
+	}, flowcontrol.NewTokenBucketRateLimiter(qps, burst))
+
+	start := time.Now()
+	for i := 0; i < calls/2; i++ {
+		if _, err := cl.AuthorizeOrder(context.Background(), acme.DomainIDs("example.com")); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, err := cl.GetAuthorization(context.Background(), "https://acme.example.com/authz/1"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	elapsed := time.Since(start)
+
+	// The first burst calls are allowed immediately, after which each call
+	// has to wait for a new token.
+	if minElapsed := time.Duration(calls-burst) * time.Second / qps; elapsed < minElapsed {
+		t.Errorf("expected %d calls to take at least %s, took %s", calls, minElapsed, elapsed)
+	}
+	if orders != calls/2 || authorizations != calls/2 {
+		t.Errorf("expected %d calls each to the underlying client, got %d AuthorizeOrder and %d GetAuthorization calls", calls/2, orders, authorizations)
+	}
+
+	// Calls which are not rate limited are passed through even if the
+	// limiter has no tokens left.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := cl.GetChallenge(ctx, "https://acme.example.com/chall/1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if challenges != 1 {
+		t.Errorf("expected 1 GetChallenge call to the underlying client, got %d", challenges)
+	}
+}
+
+func TestRateLimiterContextCancelled(t *testing.T) {
+	called := false
+	cl := NewRateLimiter(&client.FakeACME{
+		FakeAuthorizeOrderWithExtensions: func(context.Context, []acme.AuthzID, client.OrderExtensions) (*acme.Order, error) {
+			called = true
+			return &acme.Order{}, nil
+		},
+	}, flowcontrol.NewTokenBucketRateLimiter(1, 1))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := cl.AuthorizeOrderWithExtensions(ctx, acme.DomainIDs("example.com"), client.OrderExtensions{})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled error, got %v", err)
+	}
+	if called {
+		t.Error("expected the underlying client not to be called")
+	}
+}
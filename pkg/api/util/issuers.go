@@ -34,6 +34,10 @@ const (
 	IssuerSelfSigned string = "selfsigned"
 	// IssuerVenafi uses Venafi Trust Protection Platform and Venafi Cloud
 	IssuerVenafi string = "venafi"
+	// IssuerSigningWebhook delegates signing to an external signing webhook
+	IssuerSigningWebhook string = "signingwebhook"
+	// IssuerKubernetesCSR signs using a Kubernetes CertificateSigningRequest signer
+	IssuerKubernetesCSR string = "kubernetescsr"
 )
 
 // NameForIssuer determines the name of the Issuer implementation given an
@@ -50,6 +54,10 @@ func NameForIssuer(i cmapi.GenericIssuer) (string, error) {
 		return IssuerSelfSigned, nil
 	case i.GetSpec().Venafi != nil:
 		return IssuerVenafi, nil
+	case i.GetSpec().SigningWebhook != nil:
+		return IssuerSigningWebhook, nil
+	case i.GetSpec().KubernetesCSR != nil:
+		return IssuerKubernetesCSR, nil
 	}
 	return "", fmt.Errorf("no issuer specified for Issuer '%s/%s'", i.GetObjectMeta().Namespace, i.GetObjectMeta().Name)
 }
@@ -0,0 +1,178 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"crypto/x509"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// SecretTemplateData is the data available when rendering templated values
+// in a Certificate's secretTemplate.
+type SecretTemplateData struct {
+	// NotBefore is the time the issued certificate is valid from.
+	NotBefore time.Time
+	// NotAfter is the time the issued certificate expires.
+	NotAfter time.Time
+	// SerialNumber is the serial number of the issued certificate, hex
+	// encoded.
+	SerialNumber string
+	// Issuer is the distinguished name of the issued certificate's issuer.
+	Issuer string
+}
+
+// ExampleSecretTemplateData is used to check that templated secretTemplate
+// values render before a certificate has been issued.
+var ExampleSecretTemplateData = SecretTemplateData{
+	NotBefore:    time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+	NotAfter:     time.Date(2000, time.April, 1, 0, 0, 0, 0, time.UTC),
+	SerialNumber: "1a2b3c4d",
+	Issuer:       "CN=example-issuer",
+}
+
+var secretTemplateFuncs = template.FuncMap{
+	"rfc3339": func(t time.Time) string { return t.UTC().Format(time.RFC3339) },
+	"unix":    func(t time.Time) string { return strconv.FormatInt(t.Unix(), 10) },
+	"lower":   strings.ToLower,
+	"upper":   strings.ToUpper,
+	"replace": func(old, new, s string) string { return strings.ReplaceAll(s, old, new) },
+}
+
+// SecretTemplateDataForCertificate returns the data used to render
+// templated secretTemplate values for the given certificate.
+func SecretTemplateDataForCertificate(cert *x509.Certificate) SecretTemplateData {
+	return SecretTemplateData{
+		NotBefore:    cert.NotBefore,
+		NotAfter:     cert.NotAfter,
+		SerialNumber: cert.SerialNumber.Text(16),
+		Issuer:       cert.Issuer.String(),
+	}
+}
+
+// IsSecretTemplateValue returns true if the given secretTemplate label or
+// annotation value depends on the issued certificate when rendered. It is only
+// meaningful for secretTemplates with RenderTemplates set.
+func IsSecretTemplateValue(value string) bool {
+	return strings.Contains(value, "{{")
+}
+
+// RenderSecretTemplateValue renders a single secretTemplate label or
+// annotation value. Values which are not templates are returned unchanged.
+func RenderSecretTemplateValue(value string, data SecretTemplateData) (string, error) {
+	if !IsSecretTemplateValue(value) {
+		return value, nil
+	}
+
+	tmpl, err := template.New("").Funcs(secretTemplateFuncs).Option("missingkey=error").Parse(value)
+	if err != nil {
+		return "", err
+	}
+
+	var out strings.Builder
+	if err := tmpl.Execute(&out, data); err != nil {
+		return "", err
+	}
+	return out.String(), nil
+}
+
+// RenderSecretTemplate returns the labels and annotations of the given
+// secretTemplate. If the secretTemplate has RenderTemplates set, templated
+// values are rendered against the given certificate, and omitted if the
+// certificate is nil. Templated labels which do not render to a valid label
+// value for the certificate, for example because the issuer name contains a
+// space, are omitted too; see InvalidSecretTemplateLabels. Otherwise all
+// values are returned as is.
+func RenderSecretTemplate(secretTemplate *cmapi.CertificateSecretTemplate, cert *x509.Certificate) (labels, annotations map[string]string, err error) {
+	labels, annotations = make(map[string]string), make(map[string]string)
+	if secretTemplate == nil {
+		return labels, annotations, nil
+	}
+
+	if !secretTemplate.RenderTemplates {
+		for k, v := range secretTemplate.Labels {
+			labels[k] = v
+		}
+		for k, v := range secretTemplate.Annotations {
+			annotations[k] = v
+		}
+		return labels, annotations, nil
+	}
+
+	var data SecretTemplateData
+	if cert != nil {
+		data = SecretTemplateDataForCertificate(cert)
+	}
+
+	render := func(in, out map[string]string, isLabel bool) error {
+		for k, v := range in {
+			if cert == nil && IsSecretTemplateValue(v) {
+				continue
+			}
+			rendered, err := RenderSecretTemplateValue(v, data)
+			if err != nil {
+				return fmt.Errorf("failed to render secretTemplate value for %q: %w", k, err)
+			}
+			if isLabel && IsSecretTemplateValue(v) && len(validation.IsValidLabelValue(rendered)) > 0 {
+				continue
+			}
+			out[k] = rendered
+		}
+		return nil
+	}
+
+	if err := render(secretTemplate.Labels, labels, true); err != nil {
+		return nil, nil, err
+	}
+	if err := render(secretTemplate.Annotations, annotations, false); err != nil {
+		return nil, nil, err
+	}
+	return labels, annotations, nil
+}
+
+// InvalidSecretTemplateLabels returns the templated labels of the given
+// secretTemplate which do not render to a valid label value for the given
+// certificate, mapped to a description of why. These labels are omitted by
+// RenderSecretTemplate.
+func InvalidSecretTemplateLabels(secretTemplate *cmapi.CertificateSecretTemplate, cert *x509.Certificate) map[string]string {
+	invalid := make(map[string]string)
+	if secretTemplate == nil || !secretTemplate.RenderTemplates || cert == nil {
+		return invalid
+	}
+
+	data := SecretTemplateDataForCertificate(cert)
+	for k, v := range secretTemplate.Labels {
+		if !IsSecretTemplateValue(v) {
+			continue
+		}
+		rendered, err := RenderSecretTemplateValue(v, data)
+		if err != nil {
+			continue
+		}
+		if errs := validation.IsValidLabelValue(rendered); len(errs) > 0 {
+			invalid[k] = fmt.Sprintf("rendered value %q is not a valid label value: %s", rendered, strings.Join(errs, "; "))
+		}
+	}
+	return invalid
+}
@@ -18,4 +18,15 @@ package v1
 
 const (
 	ACMEFinalizer = "finalizer.acme.cert-manager.io"
+
+	// ACMERevokeOnDeleteFinalizer is added to Certificates issued by an ACME
+	// issuer with revokeOnDelete set, so that the issued certificate is
+	// revoked with the ACME server before the Certificate is removed.
+	ACMERevokeOnDeleteFinalizer = "acme.cert-manager.io/revoke-on-delete"
+
+	// ACMEDeactivateAccountFinalizer is added to ACME Issuers and
+	// ClusterIssuers with deactivateAccountOnDelete set, so that the ACME
+	// account is deactivated with the ACME server before the issuer is
+	// removed.
+	ACMEDeactivateAccountFinalizer = "acme.cert-manager.io/deactivate-account"
 )
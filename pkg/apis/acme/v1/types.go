@@ -38,6 +38,19 @@ const (
 	// of ingress on the created Certificate resource
 	IngressEditInPlaceAnnotationKey = "acme.cert-manager.io/http01-edit-in-place"
 
+	// ACMEReplacesAnnotationKey is set on a CertificateRequest for a renewal
+	// of a Certificate whose ACME Renewal Information is known. Its value is
+	// the ARI identifier of the certificate being replaced, which is sent to
+	// the ACME server as the 'replaces' field of the new order.
+	ACMEReplacesAnnotationKey = "acme.cert-manager.io/replaces"
+
+	// ACMERevokeAnnotationKey can be set to "true" on a Certificate issued by
+	// an ACME Issuer or ClusterIssuer alongside the
+	// `cert-manager.io/revoked-serial-number` annotation. cert-manager will
+	// then revoke the certificate with that serial number with the ACME
+	// server before re-issuing it.
+	ACMERevokeAnnotationKey = "acme.cert-manager.io/revoke"
+
 	// DomainLabelKey is added to the labels of a Pod serving an ACME challenge.
 	// Its value will be the hash of the domain name that is being verified.
 	DomainLabelKey = "acme.cert-manager.io/http-domain"
@@ -49,6 +62,11 @@ const (
 	// SolverIdentificationLabelKey is added to the labels of a Pod serving an ACME challenge.
 	// Its value will be the "true" if the Pod is an HTTP-01 solver.
 	SolverIdentificationLabelKey = "acme.cert-manager.io/http01-solver"
+
+	// TLSALPN01SolverIdentificationLabelKey is added to the labels of a Pod
+	// serving an ACME challenge.
+	// Its value will be the "true" if the Pod is a TLS-ALPN-01 solver.
+	TLSALPN01SolverIdentificationLabelKey = "acme.cert-manager.io/tls-alpn-01-solver"
 )
 
 const (
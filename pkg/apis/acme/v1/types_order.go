@@ -82,6 +82,19 @@ type OrderSpec struct {
 	// this is set on order creation as pe the ACME spec.
 	// +optional
 	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// Profile is the name of the ACME certificate profile requested when
+	// the order is created, as defined in draft-ietf-acme-profiles.
+	// If not set, the ACME server's default profile is used.
+	// +optional
+	Profile string `json:"profile,omitempty"`
+
+	// Replaces is the ARI identifier of the certificate which this order is
+	// renewing, as defined in RFC 9773. It is sent to ACME servers which
+	// support ACME Renewal Information so that the renewal can be exempted
+	// from rate limits.
+	// +optional
+	Replaces string `json:"replaces,omitempty"`
 }
 
 type OrderStatus struct {
@@ -28,6 +28,10 @@ const (
 	// minimum certificate duration before certificate expiration
 	MinimumRenewBefore = time.Minute * 5
 
+	// minimum permitted private key rotation interval when the private key
+	// rotation policy is Scheduled
+	MinimumPrivateKeyRotationInterval = time.Hour
+
 	// Deprecated: the default is now 2/3 of Certificate's duration
 	DefaultRenewBefore = time.Hour * 24 * 30
 )
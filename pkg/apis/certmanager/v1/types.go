@@ -92,6 +92,15 @@ const (
 	// Annotation key for the 'group' of the Issuer resource.
 	IssuerGroupAnnotationKey = "cert-manager.io/issuer-group"
 
+	// IssuerAllowedRequestersAnnotationKey is an annotation that can be added
+	// to Issuers and ClusterIssuers to restrict which identities may request
+	// certificates from them. Its value is a comma-separated list of username
+	// patterns, which may contain wildcards, e.g.
+	// "system:serviceaccount:cert-manager:cert-manager,system:serviceaccount:team-a:*".
+	// It is only honoured by the built-in approver when the
+	// IssuerAllowedRequesters feature gate is enabled.
+	IssuerAllowedRequestersAnnotationKey = "cert-manager.io/allowed-requesters"
+
 	// Annotation key for the name of the certificate that a resource is related to.
 	CertificateNameKey = "cert-manager.io/certificate-name"
 
@@ -99,6 +108,31 @@ const (
 	// as a 'next private key' Secret resource.
 	IsNextPrivateKeySecretLabelKey = "cert-manager.io/next-private-key"
 
+	// Label key used to denote whether a Secret is kept in sync with a
+	// Certificate's target Secret as one of its 'additional output' Secret
+	// resources.
+	IsAdditionalOutputSecretLabelKey = "cert-manager.io/additional-output-secret"
+
+	// Label key used to denote whether a Secret is a mirror of a Certificate's
+	// target Secret in another namespace.
+	IsSecretMirrorLabelKey = "cert-manager.io/secret-mirror"
+
+	// Annotation key set on mirrored Secrets to the namespace and name of the
+	// Certificate whose target Secret they mirror, as `<namespace>/<name>`.
+	SecretMirrorSourceAnnotationKey = "cert-manager.io/secret-mirror-source"
+
+	// Annotation key set on a Namespace to allow Certificates in other
+	// namespaces to mirror their target Secret into it. The value is a comma
+	// separated list of namespaces, or `*` to allow any namespace.
+	AllowSecretMirrorFromAnnotationKey = "cert-manager.io/allow-secret-mirror-from"
+
+	// Annotation key used to list the keys of a Certificate's Secret which
+	// should be left untouched by cert-manager when the Secret is updated, for
+	// example because they are managed by a third party. May be set on the
+	// Certificate or on the Secret as a comma separated list of keys. The
+	// `tls.crt` and `tls.key` keys are always managed by cert-manager.
+	PreserveSecretKeysAnnotationKey = "cert-manager.io/preserve-secret-keys"
+
 	// Annotation key used to limit the number of CertificateRequests to be kept for a Certificate.
 	// Minimum value is 1.
 	// If unset all CertificateRequests will be kept.
@@ -130,6 +164,25 @@ const (
 	// Annotation key used to set the PrivateKeyRotationPolicy for a Certificate.
 	// If unset a policy `Never` will be used.
 	PrivateKeyRotationPolicyAnnotationKey = "cert-manager.io/private-key-rotation-policy"
+
+	// Annotation key used to pause reconciliation of a Certificate. If set to
+	// `true`, cert-manager will not renew, re-issue or otherwise modify the
+	// Certificate or its Secret, and any in-flight CertificateRequests will be
+	// left as-is. Removing the annotation resumes reconciliation.
+	CertificatePausedAnnotationKey = "cert-manager.io/paused"
+
+	// Annotation key used to mark a certificate issued for a Certificate as
+	// revoked. Its value is the serial number of the revoked certificate,
+	// encoded as hexadecimal and optionally separated by colons. If the
+	// certificate stored in the Secret has this serial number it is re-issued
+	// immediately, and the revocation is recorded in the Certificate's
+	// `status.lastRevocation`.
+	RevokedSerialNumberAnnotationKey = "cert-manager.io/revoked-serial-number"
+
+	// CertificateDeleteSecretFinalizer is added to Certificates which set
+	// spec.deleteSecretOnCertificateDeletion, so that their Secret is deleted
+	// before the Certificate is removed.
+	CertificateDeleteSecretFinalizer = "cert-manager.io/delete-secret"
 )
 
 const (
@@ -152,6 +205,21 @@ const (
 	// IngressSecretTemplate can be used to set the secretTemplate field in the generated Certificate.
 	// The value is a JSON representation of secretTemplate and must not have any unknown fields.
 	IngressSecretTemplate = "cert-manager.io/secret-template"
+
+	// IngressHostIssuersAnnotationKey overrides the issuer used for some of the
+	// hosts of an Ingress-like resource. The value is a comma separated list of
+	// "<host>=<issuer name>" or "<host>=<kind>/<issuer name>" entries, where kind
+	// is either Issuer or ClusterIssuer. All hosts sharing a Secret must resolve
+	// to the same issuer.
+	IngressHostIssuersAnnotationKey = "cert-manager.io/host-issuers"
+
+	// IngressCertificateNameTemplateAnnotationKey sets the name of the
+	// Certificates generated for an Ingress-like resource. The value is a Go
+	// template which may refer to {{ .Name }}, the name of the Ingress-like
+	// resource, and {{ .SecretName }}, the name of the Secret the Certificate
+	// is stored in. The rendered name must be a DNS-1123 label. Defaults to
+	// the name of the Secret.
+	IngressCertificateNameTemplateAnnotationKey = "cert-manager.io/certificate-name-template"
 )
 
 // Annotation names for CertificateRequests
@@ -167,6 +235,42 @@ const (
 	CertificateRequestRevisionAnnotationKey = "cert-manager.io/certificate-revision"
 )
 
+// Annotation and condition names for Pods
+const (
+	// PodReadinessGateCertificateAnnotationKey is an annotation that can be
+	// added to Pods to name a Certificate in the same namespace. If the Pod
+	// also lists PodCertificateReadyConditionType in its readiness gates, the
+	// Pod will not become Ready until the Certificate is Ready.
+	PodReadinessGateCertificateAnnotationKey = "cert-manager.io/readiness-gate-certificate"
+
+	// PodCertificateReadyConditionType is the type of the Pod condition which
+	// reflects whether the Certificate named by the
+	// PodReadinessGateCertificateAnnotationKey annotation is Ready.
+	PodCertificateReadyConditionType = "cert-manager.io/certificate-ready"
+
+	// PodIdentityIssuerNameAnnotationKey is an annotation that can be added to
+	// Pods to request a short-lived Certificate identifying the Pod's
+	// ServiceAccount from the named issuer. The Certificate and its Secret are
+	// named after the Pod and are removed once the Pod is deleted. Only
+	// honoured when the certificates-pod-identity controller is enabled, and
+	// only for issuers allowed with its --pod-identity-allowed-issuers flag.
+	PodIdentityIssuerNameAnnotationKey = "cert-manager.io/pod-identity-issuer"
+
+	// PodIdentityIssuerKindAnnotationKey is the kind of the issuer named by
+	// the PodIdentityIssuerNameAnnotationKey annotation. Defaults to Issuer.
+	PodIdentityIssuerKindAnnotationKey = "cert-manager.io/pod-identity-issuer-kind"
+
+	// PodIdentityIssuerGroupAnnotationKey is the group of the issuer named by
+	// the PodIdentityIssuerNameAnnotationKey annotation. Defaults to
+	// cert-manager.io.
+	PodIdentityIssuerGroupAnnotationKey = "cert-manager.io/pod-identity-issuer-group"
+
+	// PodIdentityLabelKey is the label set on the Certificates and Secrets
+	// managed for a Pod by the certificates-pod-identity controller. Its value
+	// is the name of the Pod.
+	PodIdentityLabelKey = "cert-manager.io/pod-identity"
+)
+
 const (
 	// IssueTemporaryCertificateAnnotation is an annotation that can be added to
 	// Certificate resources.
@@ -207,6 +311,11 @@ const (
 	// If an injectable references a Secret that does NOT have this annotation,
 	// the cainjector will refuse to inject the secret.
 	AllowsInjectionFromSecretAnnotation = "cert-manager.io/allow-direct-injection"
+
+	// CAInjectedAnnotation is set by the cainjector on ConfigMaps and Secrets
+	// that it has injected CA data into, so that the injected data can be
+	// removed once they no longer want injection.
+	CAInjectedAnnotation = "cert-manager.io/ca-injected"
 )
 
 // Issuer specific Annotations
@@ -220,7 +329,20 @@ const (
 	// VenafiPickupIDAnnotationKey is the annotation key used to record the
 	// Venafi Pickup ID of a certificate signing request that has been submitted
 	// to the Venafi API for collection later.
+	// Deprecated: the Pickup ID is now recorded in the CertificateRequest's
+	// status.pickupID. This annotation is only read so that requests submitted
+	// by earlier versions of cert-manager are resumed.
 	VenafiPickupIDAnnotationKey = "venafi.cert-manager.io/pickup-id"
+
+	// VenafiZoneAnnotationKey is the annotation key used to request a Venafi
+	// zone other than the one configured on the issuer. The zone must be listed
+	// in the issuer's allowedZoneOverrides.
+	VenafiZoneAnnotationKey = "venafi.cert-manager.io/zone"
+
+	// VaultNamespaceAnnotationKey is the annotation key used to request a Vault
+	// Enterprise namespace other than the one configured on the issuer. The
+	// namespace must be listed in the issuer's allowedNamespaceOverrides.
+	VaultNamespaceAnnotationKey = "vault.cert-manager.io/namespace"
 )
 
 // KeyUsage specifies valid usage contexts for keys.
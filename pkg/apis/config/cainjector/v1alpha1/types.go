@@ -48,6 +48,13 @@ type CAInjectorConfiguration struct {
 	// cert-manager resources as potential targets for CA data injection.
 	EnableInjectableConfig EnableInjectableConfig `json:"enableInjectableConfig"`
 
+	// resyncInterval is how often cainjector re-verifies and re-injects the CA
+	// data of each injectable, even if no watch event has been received, so
+	// that injectables which have drifted from their CA source are corrected.
+	// If not set, injectables are only reconciled in response to watch events.
+	// +optional
+	ResyncInterval *sharedv1alpha1.Duration `json:"resyncInterval,omitempty"`
+
 	// Enable profiling for cainjector.
 	EnablePprof bool `json:"enablePprof"`
 
@@ -97,4 +104,16 @@ type EnableInjectableConfig struct {
 	// APIServices
 	// If not set, defaults to true.
 	APIServices *bool `json:"apiServices"`
+
+	// ConfigMaps determines whether cainjector
+	// will spin up a control loop to inject CA data to annotated
+	// ConfigMaps, under the "ca.crt" key.
+	// If not set, defaults to false.
+	ConfigMaps *bool `json:"configMaps"`
+
+	// Secrets determines whether cainjector
+	// will spin up a control loop to inject CA data to annotated
+	// Secrets, under the "ca.crt" key.
+	// If not set, defaults to false.
+	Secrets *bool `json:"secrets"`
 }
@@ -22,6 +22,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	sharedv1alpha1 "github.com/cert-manager/cert-manager/pkg/apis/config/shared/v1alpha1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -32,6 +33,11 @@ func (in *CAInjectorConfiguration) DeepCopyInto(out *CAInjectorConfiguration) {
 	in.LeaderElectionConfig.DeepCopyInto(&out.LeaderElectionConfig)
 	in.EnableDataSourceConfig.DeepCopyInto(&out.EnableDataSourceConfig)
 	in.EnableInjectableConfig.DeepCopyInto(&out.EnableInjectableConfig)
+	if in.ResyncInterval != nil {
+		in, out := &in.ResyncInterval, &out.ResyncInterval
+		*out = new(sharedv1alpha1.Duration)
+		**out = **in
+	}
 	in.Logging.DeepCopyInto(&out.Logging)
 	if in.FeatureGates != nil {
 		in, out := &in.FeatureGates, &out.FeatureGates
@@ -105,6 +111,16 @@ func (in *EnableInjectableConfig) DeepCopyInto(out *EnableInjectableConfig) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ConfigMaps != nil {
+		in, out := &in.ConfigMaps, &out.ConfigMaps
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Secrets != nil {
+		in, out := &in.Secrets, &out.Secrets
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -23,6 +23,7 @@ package v1alpha1
 
 import (
 	sharedv1alpha1 "github.com/cert-manager/cert-manager/pkg/apis/config/shared/v1alpha1"
+	v1 "k8s.io/api/core/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -39,11 +40,21 @@ func (in *ACMEDNS01Config) DeepCopyInto(out *ACMEDNS01Config) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.RecursiveNameserversQuorum != nil {
+		in, out := &in.RecursiveNameserversQuorum, &out.RecursiveNameserversQuorum
+		*out = new(int32)
+		**out = **in
+	}
 	if in.CheckRetryPeriod != nil {
 		in, out := &in.CheckRetryPeriod, &out.CheckRetryPeriod
 		*out = new(sharedv1alpha1.Duration)
 		**out = **in
 	}
+	if in.CheckTimeout != nil {
+		in, out := &in.CheckTimeout, &out.CheckTimeout
+		*out = new(sharedv1alpha1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -65,6 +76,16 @@ func (in *ACMEHTTP01Config) DeepCopyInto(out *ACMEHTTP01Config) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.SolverSecurityContext != nil {
+		in, out := &in.SolverSecurityContext, &out.SolverSecurityContext
+		*out = new(v1.SecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SolverPodSecurityContext != nil {
+		in, out := &in.SolverPodSecurityContext, &out.SolverPodSecurityContext
+		*out = new(v1.PodSecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.SolverNameservers != nil {
 		in, out := &in.SolverNameservers, &out.SolverNameservers
 		*out = make([]string, len(*in))
@@ -118,11 +139,21 @@ func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.EnableCertificateChainVerification != nil {
+		in, out := &in.EnableCertificateChainVerification, &out.EnableCertificateChainVerification
+		*out = new(bool)
+		**out = **in
+	}
 	if in.EnableGatewayAPI != nil {
 		in, out := &in.EnableGatewayAPI, &out.EnableGatewayAPI
 		*out = new(bool)
 		**out = **in
 	}
+	if in.RequireFIPSMode != nil {
+		in, out := &in.RequireFIPSMode, &out.RequireFIPSMode
+		*out = new(bool)
+		**out = **in
+	}
 	if in.CopiedAnnotationPrefixes != nil {
 		in, out := &in.CopiedAnnotationPrefixes, &out.CopiedAnnotationPrefixes
 		*out = make([]string, len(*in))
@@ -138,6 +169,28 @@ func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.MaxConcurrentCertificateRequestsPerIssuer != nil {
+		in, out := &in.MaxConcurrentCertificateRequestsPerIssuer, &out.MaxConcurrentCertificateRequestsPerIssuer
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ACMEOrderPollJitter != nil {
+		in, out := &in.ACMEOrderPollJitter, &out.ACMEOrderPollJitter
+		*out = new(float32)
+		**out = **in
+	}
+	if in.ACMEAccountQPS != nil {
+		in, out := &in.ACMEAccountQPS, &out.ACMEAccountQPS
+		*out = new(float32)
+		**out = **in
+	}
+	if in.ACMEAccountBurst != nil {
+		in, out := &in.ACMEAccountBurst, &out.ACMEAccountBurst
+		*out = new(int32)
+		**out = **in
+	}
 	in.MetricsTLSConfig.DeepCopyInto(&out.MetricsTLSConfig)
 	if in.EnablePprof != nil {
 		in, out := &in.EnablePprof, &out.EnablePprof
@@ -155,6 +208,8 @@ func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
 	in.IngressShimConfig.DeepCopyInto(&out.IngressShimConfig)
 	in.ACMEHTTP01Config.DeepCopyInto(&out.ACMEHTTP01Config)
 	in.ACMEDNS01Config.DeepCopyInto(&out.ACMEDNS01Config)
+	in.VenafiConfig.DeepCopyInto(&out.VenafiConfig)
+	in.PodIdentityConfig.DeepCopyInto(&out.PodIdentityConfig)
 	return
 }
 
@@ -218,3 +273,45 @@ func (in *LeaderElectionConfig) DeepCopy() *LeaderElectionConfig {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodIdentityConfig) DeepCopyInto(out *PodIdentityConfig) {
+	*out = *in
+	if in.AllowedIssuers != nil {
+		in, out := &in.AllowedIssuers, &out.AllowedIssuers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodIdentityConfig.
+func (in *PodIdentityConfig) DeepCopy() *PodIdentityConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PodIdentityConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VenafiConfig) DeepCopyInto(out *VenafiConfig) {
+	*out = *in
+	if in.MaxSetupRetryInterval != nil {
+		in, out := &in.MaxSetupRetryInterval, &out.MaxSetupRetryInterval
+		*out = new(sharedv1alpha1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VenafiConfig.
+func (in *VenafiConfig) DeepCopy() *VenafiConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(VenafiConfig)
+	in.DeepCopyInto(out)
+	return out
+}
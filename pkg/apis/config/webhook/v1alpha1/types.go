@@ -64,4 +64,19 @@ type WebhookConfiguration struct {
 	// features.
 	// +optional
 	FeatureGates map[string]bool `json:"featureGates,omitempty"`
+
+	// defaultIssuerName is the name of the issuer set on Certificates which
+	// are created without an issuerRef. If empty, no issuerRef is defaulted.
+	// +optional
+	DefaultIssuerName string `json:"defaultIssuerName,omitempty"`
+
+	// defaultIssuerKind is the kind of the issuer set on Certificates which
+	// are created without an issuerRef.
+	// +optional
+	DefaultIssuerKind string `json:"defaultIssuerKind,omitempty"`
+
+	// defaultIssuerGroup is the group of the issuer set on Certificates which
+	// are created without an issuerRef.
+	// +optional
+	DefaultIssuerGroup string `json:"defaultIssuerGroup,omitempty"`
 }
@@ -21,11 +21,13 @@ import (
 	"time"
 
 	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
 
 	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
 	"github.com/cert-manager/cert-manager/pkg/acme/accounts"
@@ -36,6 +38,7 @@ import (
 	"github.com/cert-manager/cert-manager/pkg/issuer"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/http"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/tlsalpn"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 )
 
@@ -55,8 +58,9 @@ type controller struct {
 	// ACME challenge solvers are instantiated once at the time of controller
 	// construction.
 	// This also allows for easy mocking of the different challenge mechanisms.
-	dnsSolver  solver
-	httpSolver solver
+	dnsSolver     solver
+	httpSolver    solver
+	tlsALPNSolver solver
 	// scheduler marks challenges as Processing=true if they can be scheduled
 	// for processing. This job runs periodically every N seconds, so it cannot
 	// be constructed as a traditional controller.
@@ -76,6 +80,9 @@ type controller struct {
 
 	DNS01CheckRetryPeriod time.Duration
 
+	// clock is used to record when a challenge was presented
+	clock clock.Clock
+
 	// objectUpdater implements the updateObject function which is used to save
 	// changes to the Challenge.Status and Challenge.Finalizers
 	objectUpdater
@@ -92,10 +99,11 @@ func (c *controller) Register(ctx *controllerpkg.Context) (workqueue.RateLimitin
 	challengeInformer := ctx.SharedInformerFactory.Acme().V1().Challenges()
 	issuerInformer := ctx.SharedInformerFactory.Certmanager().V1().Issuers()
 	secretInformer := ctx.KubeSharedInformerFactory.Secrets()
-	// we register these informers here so the HTTP01 solver has a synced
-	// cache when managing pod/service/ingress resources
+	// we register these informers here so the HTTP01 and TLSALPN01 solvers have a synced
+	// cache when managing pod/service/deployment/ingress resources
 	podInformer := ctx.HTTP01ResourceMetadataInformersFactory.ForResource(corev1.SchemeGroupVersion.WithResource("pods"))
 	serviceInformer := ctx.HTTP01ResourceMetadataInformersFactory.ForResource(corev1.SchemeGroupVersion.WithResource("services"))
+	deploymentInformer := ctx.HTTP01ResourceMetadataInformersFactory.ForResource(appsv1.SchemeGroupVersion.WithResource("deployments"))
 	ingressInformer := ctx.KubeSharedInformerFactory.Ingresses()
 
 	// build a list of InformerSynced functions that will be returned by the Register method.
@@ -106,6 +114,7 @@ func (c *controller) Register(ctx *controllerpkg.Context) (workqueue.RateLimitin
 		secretInformer.Informer().HasSynced,
 		podInformer.Informer().HasSynced,
 		serviceInformer.Informer().HasSynced,
+		deploymentInformer.Informer().HasSynced,
 		ingressInformer.Informer().HasSynced,
 	}
 
@@ -144,10 +153,15 @@ func (c *controller) Register(ctx *controllerpkg.Context) (workqueue.RateLimitin
 	if err != nil {
 		return nil, nil, err
 	}
+	c.tlsALPNSolver, err = tlsalpn.NewSolver(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	// read options from context
 	c.dns01Nameservers = ctx.ACMEOptions.DNS01Nameservers
 	c.DNS01CheckRetryPeriod = ctx.ACMEOptions.DNS01CheckRetryPeriod
+	c.clock = ctx.Clock
 
 	// Construct an objectUpdater which is used to save changes to the Challenge
 	// object, either using Update or using Patch + Server Side Apply.
@@ -226,6 +240,7 @@ func init() {
 		return controllerpkg.NewBuilder(ctx, ControllerName).
 			For(c).
 			With(c.runScheduler, time.Second).
+			WithShutdown(c.drainChallenges, shutdownGracePeriod).
 			Complete()
 	})
 }
@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acmechallenges
+
+import (
+	"context"
+	"time"
+
+	acmeapi "golang.org/x/crypto/acme"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/cert-manager/cert-manager/pkg/acme"
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+const (
+	// shutdownGracePeriod is the maximum amount of time the controller will
+	// spend draining in-flight challenges when it shuts down.
+	// It is kept well below the default Pod termination grace period of 30s.
+	shutdownGracePeriod = 10 * time.Second
+
+	reasonDrained    = "Drained"
+	reasonDrainError = "DrainError"
+)
+
+// drainChallenges is called when the controller shuts down, for example
+// during a rolling upgrade. It cleans up the resources presented for every
+// in-flight challenge which has not yet been submitted for validation and
+// marks the challenge as no longer processing, so that it will be
+// re-scheduled and presented again by the next leader rather than leaving
+// solver resources orphaned.
+// Challenges which the ACME server is currently validating are left untouched
+// as removing their solver resources would cause the validation to fail.
+// A challenge may have been accepted by a sync which was interrupted by the
+// shutdown before its new state could be stored, so the state of presented
+// challenges is re-queried from the ACME server before they are cleaned up.
+func (c *controller) drainChallenges(ctx context.Context) {
+	log := logf.FromContext(ctx, "drain")
+
+	challenges, err := c.challengeLister.List(labels.Everything())
+	if err != nil {
+		log.Error(err, "error listing in-flight challenges to drain")
+		return
+	}
+
+	for _, chOriginal := range challenges {
+		if ctx.Err() != nil {
+			log.Info("shutdown grace period expired before all in-flight challenges were drained")
+			return
+		}
+
+		if !chOriginal.Status.Processing ||
+			!chOriginal.DeletionTimestamp.IsZero() ||
+			chOriginal.Status.State == cmacme.Processing ||
+			acme.IsFinalState(chOriginal.Status.State) {
+			continue
+		}
+
+		log := logf.WithResource(log, chOriginal)
+		ch := chOriginal.DeepCopy()
+
+		if ch.Status.Presented {
+			accepted, err := c.acceptedByACMEServer(ctx, ch)
+			if err != nil {
+				// Leave the challenge as it is, as the ACME server may be
+				// validating it.
+				c.recorder.Eventf(ch, corev1.EventTypeWarning, reasonDrainError, "Error checking the challenge state with the ACME server on shutdown: %v", err)
+				log.Error(err, "error checking challenge state on shutdown")
+				continue
+			}
+			if accepted {
+				log.V(logf.DebugLevel).Info("challenge has been accepted by the ACME server, leaving it in place")
+				continue
+			}
+
+			if err := c.cleanUpChallenge(ctx, ch); err != nil {
+				// Leave the challenge as it is so that the next leader carries
+				// on with the resources that have already been presented.
+				c.recorder.Eventf(ch, corev1.EventTypeWarning, reasonCleanUpError, "Error cleaning up challenge on shutdown: %v", err)
+				log.Error(err, "error cleaning up challenge on shutdown")
+				continue
+			}
+			ch.Status.Presented = false
+			ch.Status.PresentedTime = nil
+		}
+		ch.Status.Processing = false
+
+		if err := c.updateObject(ctx, chOriginal, ch); err != nil {
+			log.Error(err, "error releasing challenge on shutdown")
+			continue
+		}
+		c.recorder.Event(ch, corev1.EventTypeNormal, reasonDrained, "Challenge released for re-scheduling as the controller is shutting down")
+	}
+}
+
+// acceptedByACMEServer returns true if the ACME server no longer considers the
+// given challenge pending, meaning that it has been accepted and the ACME
+// server is validating it or has finished validating it.
+func (c *controller) acceptedByACMEServer(ctx context.Context, ch *cmacme.Challenge) (bool, error) {
+	genericIssuer, err := c.helper.GetGenericIssuer(ch.Spec.IssuerRef, ch.Namespace)
+	if err != nil {
+		return false, err
+	}
+
+	cl, err := c.accountRegistry.GetClient(string(genericIssuer.GetUID()))
+	if err != nil {
+		return false, err
+	}
+
+	acmeChal, err := cl.GetChallenge(ctx, ch.Spec.URL)
+	if err != nil {
+		return false, err
+	}
+
+	return acmeChal.Status != acmeapi.StatusPending, nil
+}
+
+// cleanUpChallenge removes the resources presented for the given challenge
+// using the solver for its type.
+func (c *controller) cleanUpChallenge(ctx context.Context, ch *cmacme.Challenge) error {
+	genericIssuer, err := c.helper.GetGenericIssuer(ch.Spec.IssuerRef, ch.Namespace)
+	if err != nil {
+		return err
+	}
+
+	solver, err := c.solverFor(ch.Spec.Type)
+	if err != nil {
+		return err
+	}
+
+	return solver.CleanUp(ctx, genericIssuer, ch)
+}
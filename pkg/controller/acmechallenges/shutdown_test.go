@@ -0,0 +1,212 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acmechallenges
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	acmeapi "golang.org/x/crypto/acme"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+
+	accountstest "github.com/cert-manager/cert-manager/pkg/acme/accounts/test"
+	acmecl "github.com/cert-manager/cert-manager/pkg/acme/client"
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/pkg/issuer"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestDrainChallenges(t *testing.T) {
+	testIssuer := gen.Issuer("testissuer", gen.SetIssuerACME(cmacme.ACMEIssuer{
+		Solvers: []cmacme.ACMEChallengeSolver{
+			{
+				HTTP01: &cmacme.ACMEChallengeSolverHTTP01{
+					Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{},
+				},
+			},
+		},
+	}))
+	inFlightChallenge := gen.Challenge("testchal",
+		gen.SetChallengeIssuer(cmmeta.ObjectReference{
+			Name: "testissuer",
+		}),
+		gen.SetChallengeType(cmacme.ACMEChallengeTypeHTTP01),
+		gen.SetChallengeProcessing(true),
+		gen.SetChallengePresented(true),
+		gen.SetChallengeState(cmacme.Pending),
+	)
+
+	tests := map[string]struct {
+		challenge *cmacme.Challenge
+		// acmeStatus is the status of the challenge on the ACME server,
+		// pending if not set.
+		acmeStatus      string
+		getChallengeErr error
+		cleanUpErr      error
+		cancelled       bool
+		expectedCleanUp bool
+		expectedActions []testpkg.Action
+		expectedEvents  []string
+	}{
+		"presented in-flight challenge is cleaned up and released": {
+			challenge:       inFlightChallenge,
+			expectedCleanUp: true,
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+					cmacme.SchemeGroupVersion.WithResource("challenges"),
+					"status",
+					gen.DefaultTestNamespace,
+					gen.ChallengeFrom(inFlightChallenge,
+						gen.SetChallengeProcessing(false),
+						gen.SetChallengePresented(false),
+					))),
+			},
+			expectedEvents: []string{
+				"Normal Drained Challenge released for re-scheduling as the controller is shutting down",
+			},
+		},
+		"scheduled challenge which has not been presented is released": {
+			challenge: gen.ChallengeFrom(inFlightChallenge,
+				gen.SetChallengePresented(false),
+				gen.SetChallengeState(""),
+			),
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+					cmacme.SchemeGroupVersion.WithResource("challenges"),
+					"status",
+					gen.DefaultTestNamespace,
+					gen.ChallengeFrom(inFlightChallenge,
+						gen.SetChallengeProcessing(false),
+						gen.SetChallengePresented(false),
+						gen.SetChallengeState(""),
+					))),
+			},
+			expectedEvents: []string{
+				"Normal Drained Challenge released for re-scheduling as the controller is shutting down",
+			},
+		},
+		"challenge is left alone if cleaning up fails": {
+			challenge:       inFlightChallenge,
+			cleanUpErr:      errors.New("simulated-cleanup-error"),
+			expectedCleanUp: true,
+			expectedEvents: []string{
+				"Warning CleanUpError Error cleaning up challenge on shutdown: simulated-cleanup-error",
+			},
+		},
+		"challenge accepted by a sync interrupted during WaitAuthorization is left alone": {
+			// The sync accepted the challenge, but the shutdown interrupted
+			// it while waiting for the authorization, before the processing
+			// state could be stored.
+			challenge:  inFlightChallenge,
+			acmeStatus: acmeapi.StatusProcessing,
+		},
+		"challenge validated by the ACME server before its state was stored is left alone": {
+			challenge:  inFlightChallenge,
+			acmeStatus: acmeapi.StatusValid,
+		},
+		"challenge is left alone if its state cannot be checked with the ACME server": {
+			challenge:       inFlightChallenge,
+			getChallengeErr: errors.New("simulated-get-challenge-error"),
+			expectedEvents: []string{
+				"Warning DrainError Error checking the challenge state with the ACME server on shutdown: simulated-get-challenge-error",
+			},
+		},
+		"challenge being validated by the ACME server is left alone": {
+			challenge: gen.ChallengeFrom(inFlightChallenge,
+				gen.SetChallengeState(cmacme.Processing),
+			),
+		},
+		"challenge in a final state is left alone": {
+			challenge: gen.ChallengeFrom(inFlightChallenge,
+				gen.SetChallengeState(cmacme.Valid),
+			),
+		},
+		"challenge which is not processing is left alone": {
+			challenge: gen.ChallengeFrom(inFlightChallenge,
+				gen.SetChallengeProcessing(false),
+			),
+		},
+		"nothing is drained once the grace period has expired": {
+			challenge: inFlightChallenge,
+			cancelled: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{
+				T:                  t,
+				CertManagerObjects: []runtime.Object{test.challenge, testIssuer},
+				ExpectedActions:    test.expectedActions,
+				ExpectedEvents:     test.expectedEvents,
+			}
+			builder.Init()
+			defer builder.Stop()
+
+			c := &controller{}
+			_, _, err := c.Register(builder.Context)
+			require.NoError(t, err)
+			c.helper = issuer.NewHelper(
+				builder.SharedInformerFactory.Certmanager().V1().Issuers().Lister(),
+				builder.SharedInformerFactory.Certmanager().V1().ClusterIssuers().Lister(),
+			)
+			c.accountRegistry = &accountstest.FakeRegistry{
+				GetClientFunc: func(_ string) (acmecl.Interface, error) {
+					return &acmecl.FakeACME{
+						FakeGetChallenge: func(_ context.Context, url string) (*acmeapi.Challenge, error) {
+							if test.getChallengeErr != nil {
+								return nil, test.getChallengeErr
+							}
+							status := test.acmeStatus
+							if status == "" {
+								status = acmeapi.StatusPending
+							}
+							return &acmeapi.Challenge{URI: url, Status: status}, nil
+						},
+					}, nil
+				},
+			}
+			cleanedUp := false
+			c.httpSolver = &fakeSolver{
+				fakeCleanUp: func(context.Context, v1.GenericIssuer, *cmacme.Challenge) error {
+					cleanedUp = true
+					return test.cleanUpErr
+				},
+			}
+			builder.Start()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if test.cancelled {
+				cancel()
+			}
+
+			c.drainChallenges(ctx)
+
+			if cleanedUp != test.expectedCleanUp {
+				t.Errorf("expected CleanUp to be called=%t but got=%t", test.expectedCleanUp, cleanedUp)
+			}
+			builder.CheckAndFinish()
+		})
+	}
+}
@@ -23,7 +23,9 @@ import (
 
 	acmeapi "golang.org/x/crypto/acme"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/utils/ptr"
 
 	"github.com/cert-manager/cert-manager/internal/controller/feature"
 	"github.com/cert-manager/cert-manager/pkg/acme"
@@ -31,6 +33,7 @@ import (
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns"
 	dnsutil "github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
@@ -116,6 +119,7 @@ func (c *controller) Sync(ctx context.Context, chOriginal *cmacme.Challenge) (er
 			}
 
 			ch.Status.Presented = false
+			ch.Status.PresentedTime = nil
 		}
 
 		ch.Status.Processing = false
@@ -182,10 +186,18 @@ func (c *controller) Sync(ctx context.Context, chOriginal *cmacme.Challenge) (er
 		}
 
 		ch.Status.Presented = true
+		ch.Status.PresentedTime = ptr.To(metav1.NewTime(c.clock.Now()))
 		c.recorder.Eventf(ch, corev1.EventTypeNormal, reasonPresented, "Presented challenge using %s challenge mechanism", ch.Spec.Type)
 	}
 
 	err = solver.Check(ctx, genericIssuer, ch)
+	if errors.Is(err, dns.ErrPropagationCheckTimeout) {
+		log.Error(err, "propagation check timed out")
+		c.recorder.Eventf(ch, corev1.EventTypeWarning, reasonFailed, "Challenge propagation check timed out: %v", err)
+		ch.Status.State = cmacme.Errored
+		ch.Status.Reason = fmt.Sprintf("Failed to verify %s challenge propagation: %s", ch.Spec.Type, err)
+		return nil
+	}
 	if err != nil {
 		log.Error(err, "propagation check failed")
 		ch.Status.Reason = fmt.Sprintf("Waiting for %s challenge propagation: %s", ch.Spec.Type, err)
@@ -415,6 +427,8 @@ func (c *controller) solverFor(challengeType cmacme.ACMEChallengeType) (solver,
 		return c.httpSolver, nil
 	case cmacme.ACMEChallengeTypeDNS01:
 		return c.dnsSolver, nil
+	case cmacme.ACMEChallengeTypeTLSALPN01:
+		return c.tlsALPNSolver, nil
 	}
 	return nil, fmt.Errorf("no solver for %q implemented", challengeType)
 }
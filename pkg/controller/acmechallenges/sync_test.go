@@ -21,11 +21,13 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	acmeapi "golang.org/x/crypto/acme"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	coretesting "k8s.io/client-go/testing"
+	fakeclock "k8s.io/utils/clock/testing"
 
 	accountstest "github.com/cert-manager/cert-manager/pkg/acme/accounts/test"
 	acmecl "github.com/cert-manager/cert-manager/pkg/acme/client"
@@ -34,6 +36,7 @@ import (
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
 	"github.com/cert-manager/cert-manager/pkg/issuer"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns"
 	"github.com/cert-manager/cert-manager/test/unit/gen"
 )
 
@@ -63,15 +66,17 @@ type fakeSolver struct {
 }
 
 type testT struct {
-	challenge  *cmacme.Challenge
-	builder    *testpkg.Builder
-	httpSolver *fakeSolver
-	dnsSolver  *fakeSolver
-	expectErr  bool
-	acmeClient *acmecl.FakeACME
+	challenge     *cmacme.Challenge
+	builder       *testpkg.Builder
+	httpSolver    *fakeSolver
+	dnsSolver     *fakeSolver
+	tlsALPNSolver *fakeSolver
+	expectErr     bool
+	acmeClient    *acmecl.FakeACME
 }
 
 func TestSyncHappyPath(t *testing.T) {
+	fixedClockStart := time.Now().Truncate(time.Second)
 	testIssuerHTTP01Enabled := gen.Issuer("testissuer", gen.SetIssuerACME(cmacme.ACMEIssuer{
 		Solvers: []cmacme.ACMEChallengeSolver{
 			{
@@ -124,6 +129,38 @@ func TestSyncHappyPath(t *testing.T) {
 				},
 			},
 		},
+		"cleanup a deleted TLS-ALPN-01 challenge using the TLS-ALPN-01 solver": {
+			challenge: gen.ChallengeFrom(deletedChallenge,
+				gen.SetChallengeProcessing(true),
+				gen.SetChallengeURL("testurl"),
+				gen.SetChallengeType(cmacme.ACMEChallengeTypeTLSALPN01),
+			),
+			tlsALPNSolver: &fakeSolver{
+				fakeCleanUp: func(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.Challenge) error {
+					return nil
+				},
+			},
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{
+					gen.ChallengeFrom(deletedChallenge,
+						gen.SetChallengeProcessing(true),
+						gen.SetChallengeURL("testurl"),
+						gen.SetChallengeType(cmacme.ACMEChallengeTypeTLSALPN01),
+					),
+					testIssuerHTTP01Enabled,
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateAction(cmacme.SchemeGroupVersion.WithResource("challenges"),
+						gen.DefaultTestNamespace,
+						gen.ChallengeFrom(deletedChallenge,
+							gen.SetChallengeProcessing(true),
+							gen.SetChallengeURL("testurl"),
+							gen.SetChallengeType(cmacme.ACMEChallengeTypeTLSALPN01),
+							gen.SetChallengeFinalizers([]string{}),
+						))),
+				},
+			},
+		},
 		"if the challenge is deleted and the cleanup fails, set the reason (and remove the finalizer, which is a bug)": {
 			challenge: gen.ChallengeFrom(deletedChallenge,
 				gen.SetChallengeProcessing(true),
@@ -302,6 +339,7 @@ func TestSyncHappyPath(t *testing.T) {
 					gen.SetChallengeState(cmacme.Pending),
 					gen.SetChallengeType(cmacme.ACMEChallengeTypeHTTP01),
 				), testIssuerHTTP01Enabled},
+				Clock: fakeclock.NewFakeClock(fixedClockStart),
 				ExpectedActions: []testpkg.Action{
 					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(cmacme.SchemeGroupVersion.WithResource("challenges"),
 						"status",
@@ -311,6 +349,7 @@ func TestSyncHappyPath(t *testing.T) {
 							gen.SetChallengeURL("testurl"),
 							gen.SetChallengeState(cmacme.Pending),
 							gen.SetChallengePresented(true),
+							gen.SetChallengePresentedTime(metav1.NewTime(fixedClockStart)),
 							gen.SetChallengeType(cmacme.ACMEChallengeTypeHTTP01),
 							gen.SetChallengeReason("Waiting for HTTP-01 challenge propagation: some error"),
 						))),
@@ -321,6 +360,45 @@ func TestSyncHappyPath(t *testing.T) {
 				},
 			},
 		},
+		"mark the challenge as errored if the propagation check times out": {
+			challenge: gen.ChallengeFrom(baseChallenge,
+				gen.SetChallengeProcessing(true),
+				gen.SetChallengeURL("testurl"),
+				gen.SetChallengeState(cmacme.Pending),
+				gen.SetChallengeType(cmacme.ACMEChallengeTypeDNS01),
+				gen.SetChallengePresented(true),
+			),
+			dnsSolver: &fakeSolver{
+				fakeCheck: func(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.Challenge) error {
+					return fmt.Errorf("DNS record not propagated within 2m0s: %w", dns.ErrPropagationCheckTimeout)
+				},
+			},
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{gen.ChallengeFrom(baseChallenge,
+					gen.SetChallengeProcessing(true),
+					gen.SetChallengeURL("testurl"),
+					gen.SetChallengeState(cmacme.Pending),
+					gen.SetChallengeType(cmacme.ACMEChallengeTypeDNS01),
+					gen.SetChallengePresented(true),
+				), testIssuerHTTP01Enabled},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(cmacme.SchemeGroupVersion.WithResource("challenges"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.ChallengeFrom(baseChallenge,
+							gen.SetChallengeProcessing(true),
+							gen.SetChallengeURL("testurl"),
+							gen.SetChallengeState(cmacme.Errored),
+							gen.SetChallengeType(cmacme.ACMEChallengeTypeDNS01),
+							gen.SetChallengePresented(true),
+							gen.SetChallengeReason("Failed to verify DNS-01 challenge propagation: DNS record not propagated within 2m0s: propagation check timed out"),
+						))),
+				},
+				ExpectedEvents: []string{
+					"Warning Failed Challenge propagation check timed out: DNS record not propagated within 2m0s: propagation check timed out",
+				},
+			},
+		},
 		"accept the challenge if the self check is passing": {
 			challenge: gen.ChallengeFrom(baseChallenge,
 				gen.SetChallengeProcessing(true),
@@ -596,6 +674,7 @@ func runTest(t *testing.T, test testT) {
 	}
 	c.httpSolver = test.httpSolver
 	c.dnsSolver = test.dnsSolver
+	c.tlsALPNSolver = test.tlsALPNSolver
 	test.builder.Start()
 
 	err := c.Sync(context.Background(), test.challenge)
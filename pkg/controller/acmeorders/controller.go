@@ -70,6 +70,14 @@ type controller struct {
 
 	// scheduledWorkQueue holds items to be re-queued after a period of time.
 	scheduledWorkQueue scheduler.ScheduledWorkQueue
+
+	// pollBackoff tracks the period after which each pending Order is
+	// polled again.
+	pollBackoff workqueue.RateLimiter
+
+	// pollJitter is the maximum jitter factor applied to the poll period when
+	// re-queueing pending Orders.
+	pollJitter float64
 }
 
 // NewController constructs an orders controller using the provided options.
@@ -137,6 +145,8 @@ func NewController(
 		clock:               ctx.Clock,
 		queue:               queue,
 		scheduledWorkQueue:  scheduledWorkQueue,
+		pollBackoff:         workqueue.NewItemExponentialFailureRateLimiter(RequeuePeriod, MaxRequeuePeriod),
+		pollJitter:          ctx.ACMEOptions.OrderPollJitter,
 		orderLister:         orderLister,
 		issuerLister:        issuerLister,
 		challengeLister:     challengeLister,
@@ -163,6 +173,7 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 	if err != nil {
 		if k8sErrors.IsNotFound(err) {
 			log.Error(err, "order in work queue no longer exists")
+			c.forgetPollPeriod(key)
 			return nil
 		}
 
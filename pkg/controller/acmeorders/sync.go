@@ -32,6 +32,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/cert-manager/cert-manager/internal/controller/feature"
@@ -45,16 +46,51 @@ import (
 )
 
 const (
-	reasonSolver  = "Solver"
-	reasonCreated = "Created"
+	reasonSolver      = "Solver"
+	reasonCreated     = "Created"
+	reasonRateLimited = "RateLimited"
+
+	// acmeAlreadyReplacedError is the ACME problem type returned when an
+	// Order names a certificate which has already been replaced, as defined
+	// in RFC 9773 section 7.4.
+	acmeAlreadyReplacedError = "urn:ietf:params:acme:error:alreadyReplaced"
 )
 
 var (
 	// RequeuePeriod is the default period after which an Order should be re-queued.
 	// It can be overridden in tests.
 	RequeuePeriod = time.Second * 5
+
+	// MaxRequeuePeriod is the maximum period after which an Order which is
+	// still pending is re-queued, before any jitter is applied.
+	MaxRequeuePeriod = time.Minute * 2
 )
 
+// pollPeriod returns the duration after which the pending Order with the
+// given key should be polled again. The period starts at RequeuePeriod and
+// doubles each time the Order is polled, up to MaxRequeuePeriod, so that
+// Orders which stay pending poll the ACME server less and less often. The
+// period is then jittered so that Orders which become pending at the same
+// time do not all hit the ACME server at once.
+func (c *controller) pollPeriod(key string) time.Duration {
+	period := RequeuePeriod
+	if c.pollBackoff != nil {
+		period = c.pollBackoff.When(key)
+	}
+	if c.pollJitter <= 0 {
+		return period
+	}
+	return wait.Jitter(period, c.pollJitter)
+}
+
+// forgetPollPeriod resets the poll period of the Order with the given key,
+// once it is no longer pending.
+func (c *controller) forgetPollPeriod(key string) {
+	if c.pollBackoff != nil {
+		c.pollBackoff.Forget(key)
+	}
+}
+
 func (c *controller) Sync(ctx context.Context, o *cmacme.Order) (err error) {
 	log := logf.FromContext(ctx)
 	dbg := log.V(logf.DebugLevel)
@@ -89,6 +125,7 @@ func (c *controller) Sync(ctx context.Context, o *cmacme.Order) (err error) {
 	switch {
 	case acme.IsFailureState(o.Status.State):
 		log.V(logf.DebugLevel).Info("Doing nothing as Order is in a failed state")
+		c.forgetPollPeriod(cache.MetaObjectToName(o).String())
 		// if the Order is failed there's nothing left for us to do, return nil
 		return nil
 	case o.Status.URL == "":
@@ -97,6 +134,9 @@ func (c *controller) Sync(ctx context.Context, o *cmacme.Order) (err error) {
 	case o.Status.FinalizeURL == "":
 		log.V(logf.DebugLevel).Info("Updating Order status as status.finalizeURL is not set")
 		_, err := c.updateOrderStatus(ctx, cl, o)
+		if c.requeueIfRateLimited(ctx, o, err) {
+			return nil
+		}
 		if acmeErr, ok := err.(*acmeapi.Error); ok {
 			if acmeErr.StatusCode >= 400 && acmeErr.StatusCode < 500 {
 				log.Error(err, "failed to update Order status due to a 4xx error, marking Order as failed")
@@ -117,6 +157,7 @@ func (c *controller) Sync(ctx context.Context, o *cmacme.Order) (err error) {
 		log.V(logf.DebugLevel).Info("Order has already been completed, cleaning up any owned Challenge resources")
 		// if the Order is valid and the certificate data has been set, clean
 		// up any owned Challenge resources and do nothing
+		c.forgetPollPeriod(cache.MetaObjectToName(o).String())
 		return c.deleteAllChallenges(ctx, o)
 	}
 
@@ -178,6 +219,9 @@ func (c *controller) Sync(ctx context.Context, o *cmacme.Order) (err error) {
 	// if the new code does not need this ACME order, try to place it above
 	// this call to avoid extra calls to ACME.
 	acmeOrder, err := getACMEOrder(ctx, cl, o)
+	if c.requeueIfRateLimited(ctx, o, err) {
+		return nil
+	}
 	// Order probably has been deleted, we cannot recover here.
 	if acmeErr, ok := err.(*acmeapi.Error); ok {
 		if acmeErr.StatusCode >= 400 && acmeErr.StatusCode < 500 {
@@ -236,8 +280,9 @@ func (c *controller) Sync(ctx context.Context, o *cmacme.Order) (err error) {
 			// as failed here.
 			return nil
 		}
-		// Re-queue the Order to be processed again after 5 seconds.
-		c.scheduledWorkQueue.Add(key, RequeuePeriod)
+		// Re-queue the Order to be processed again, backing off each time
+		// the Order is found to still be pending.
+		c.scheduledWorkQueue.Add(key, c.pollPeriod(key))
 		return nil
 
 	case !anyChallengesFailed(challenges) && allChallengesFinal(challenges):
@@ -259,6 +304,27 @@ func (c *controller) Sync(ctx context.Context, o *cmacme.Order) (err error) {
 	return nil
 }
 
+// requeueIfRateLimited checks whether err is a rate limit error returned by
+// the ACME server which specifies when the request may be retried. If so, the
+// Order is re-queued to be processed again at that time rather than being
+// marked as failed, and true is returned.
+func (c *controller) requeueIfRateLimited(ctx context.Context, o *cmacme.Order, err error) bool {
+	log := logf.FromContext(ctx)
+	retryAfter, ok := acmecl.RateLimitRetryAfter(err, c.clock.Now())
+	if !ok {
+		return false
+	}
+	key, keyErr := cache.MetaNamespaceKeyFunc(o)
+	if keyErr != nil {
+		log.Error(keyErr, "failed to construct key for rate limited Order")
+		return false
+	}
+	log.V(logf.InfoLevel).Info("ACME server rate limited the request, re-queueing Order", "retry_after", retryAfter)
+	c.recorder.Eventf(o, corev1.EventTypeWarning, reasonRateLimited, "Request was rate limited by the ACME server, retrying in %s: %v", retryAfter, err)
+	c.scheduledWorkQueue.Add(key, retryAfter)
+	return true
+}
+
 func (c *controller) createOrder(ctx context.Context, cl acmecl.Interface, o *cmacme.Order) error {
 	log := logf.FromContext(ctx)
 
@@ -280,11 +346,53 @@ func (c *controller) createOrder(ctx context.Context, cl acmecl.Interface, o *cm
 	authzIDs = append(authzIDs, acmeapi.IPIDs(sets.List(ipIdentifierSet)...)...)
 	// create a new order with the acme server
 
-	var options []acmeapi.OrderOption
+	var notAfter time.Time
 	if o.Spec.Duration != nil {
-		options = append(options, acmeapi.WithOrderNotAfter(c.clock.Now().Add(o.Spec.Duration.Duration)))
+		notAfter = c.clock.Now().Add(o.Spec.Duration.Duration)
+	}
+
+	var acmeOrder *acmeapi.Order
+	var err error
+	if o.Spec.Profile == "" && o.Spec.Replaces == "" {
+		var options []acmeapi.OrderOption
+		if !notAfter.IsZero() {
+			options = append(options, acmeapi.WithOrderNotAfter(notAfter))
+		}
+		acmeOrder, err = cl.AuthorizeOrder(ctx, authzIDs, options...)
+	} else {
+		if o.Spec.Profile != "" {
+			profiles, perr := cl.Profiles(ctx)
+			if perr != nil {
+				return fmt.Errorf("error retrieving ACME profiles: %v", perr)
+			}
+			// Only validate the profile if the ACME server advertises any, leaving
+			// it to the server to reject the Order otherwise.
+			if _, ok := profiles[o.Spec.Profile]; len(profiles) > 0 && !ok {
+				log.V(logf.DebugLevel).Info("requested ACME profile is not offered by the ACME server, marking Order as failed", "profile", o.Spec.Profile)
+				c.setOrderState(&o.Status, string(cmacme.Errored))
+				o.Status.Reason = fmt.Sprintf("Failed to create Order: the ACME server does not offer the requested profile %q, available profiles: %v", o.Spec.Profile, sets.List(sets.KeySet(profiles)))
+				return nil
+			}
+			log.V(logf.DebugLevel).Info("requesting ACME profile for Order", "profile", o.Spec.Profile)
+		}
+		ext := acmecl.OrderExtensions{
+			NotAfter: notAfter,
+			Profile:  o.Spec.Profile,
+			Replaces: o.Spec.Replaces,
+		}
+		acmeOrder, err = cl.AuthorizeOrderWithExtensions(ctx, authzIDs, ext)
+		// The certificate may already have been replaced, for example by a
+		// previous Order which failed. As the replacement is only a hint to
+		// the ACME server, retry without it rather than failing the Order.
+		if ext.Replaces != "" && isReplacesRejected(err) {
+			log.V(logf.DebugLevel).Info("ACME server rejected the replaced certificate, creating Order without replacement", "replaces", ext.Replaces, "error", err.Error())
+			ext.Replaces = ""
+			acmeOrder, err = cl.AuthorizeOrderWithExtensions(ctx, authzIDs, ext)
+		}
+	}
+	if c.requeueIfRateLimited(ctx, o, err) {
+		return nil
 	}
-	acmeOrder, err := cl.AuthorizeOrder(ctx, authzIDs, options...)
 	if acmeErr, ok := err.(*acmeapi.Error); ok {
 		if acmeErr.StatusCode >= 400 && acmeErr.StatusCode < 500 {
 			log.Error(err, "failed to create Order resource due to bad request, marking Order as failed")
@@ -334,6 +442,17 @@ func (c *controller) updateOrderStatusFromACMEOrder(o *cmacme.Order, acmeOrder *
 	return acmeOrder, nil
 }
 
+// isReplacesRejected returns true if the given error is the ACME server
+// rejecting the replaces field of a new Order with the alreadyReplaced
+// problem defined in RFC 9773 section 5.
+func isReplacesRejected(err error) bool {
+	acmeErr, ok := err.(*acmeapi.Error)
+	if !ok || acmeErr.StatusCode < 400 || acmeErr.StatusCode >= 500 {
+		return false
+	}
+	return acmeErr.ProblemType == acmeAlreadyReplacedError
+}
+
 // setOrderState will set the 'State' field of the given Order to 's'.
 // It will set the Orders failureTime field if the state provided is classed as
 // a failure state.
@@ -377,6 +496,9 @@ func (c *controller) fetchMetadataForAuthorizations(ctx context.Context, o *cmac
 		}
 
 		acmeAuthz, err := cl.GetAuthorization(ctx, authz.URL)
+		if c.requeueIfRateLimited(ctx, o, err) {
+			return nil
+		}
 		if acmeErr, ok := err.(*acmeapi.Error); ok {
 			if acmeErr.StatusCode >= 400 && acmeErr.StatusCode < 500 {
 				log.Error(err, "failed to fetch authorization metadata from acme server")
@@ -528,6 +650,9 @@ func (c *controller) finalizeOrder(ctx context.Context, cl acmecl.Interface, o *
 
 	// Call to CreateOrderCert finalizes the ACME order. This call can only be made once.
 	certSlice, certURL, err := cl.CreateOrderCert(ctx, o.Status.FinalizeURL, derBytes, true)
+	if c.requeueIfRateLimited(ctx, o, err) {
+		return nil
+	}
 
 	acmeErr, ok := err.(*acmeapi.Error)
 
@@ -568,6 +693,9 @@ func (c *controller) finalizeOrder(ctx context.Context, cl acmecl.Interface, o *
 	// Before checking whether the call to CreateOrderCert returned a
 	// non-4xx error, ensure the order status is up-to-date.
 	_, errUpdate := c.updateOrderStatus(ctx, cl, o)
+	if c.requeueIfRateLimited(ctx, o, errUpdate) {
+		return nil
+	}
 	if acmeErr, ok := errUpdate.(*acmeapi.Error); ok {
 		if acmeErr.StatusCode >= 400 && acmeErr.StatusCode < 500 {
 			log.Error(err, "failed to update Order status due to a 4xx error, marking Order as failed")
@@ -626,6 +754,9 @@ func (c *controller) storeCertificateOnStatus(ctx context.Context, o *cmacme.Ord
 func (c *controller) syncCertificateData(ctx context.Context, cl acmecl.Interface, o *cmacme.Order, issuer cmapi.GenericIssuer) error {
 	log := logf.FromContext(ctx)
 	acmeOrder, err := c.updateOrderStatus(ctx, cl, o)
+	if c.requeueIfRateLimited(ctx, o, err) {
+		return nil
+	}
 	if acmeErr, ok := err.(*acmeapi.Error); ok {
 		if acmeErr.StatusCode >= 400 && acmeErr.StatusCode < 500 {
 			log.Error(err, "failed to update Order status due to a 4xx error, marking Order as failed")
@@ -653,6 +784,9 @@ func (c *controller) syncCertificateDataWithOrder(ctx context.Context, cl acmecl
 	}
 
 	certs, err := cl.FetchCert(ctx, acmeOrder.CertURL, true)
+	if c.requeueIfRateLimited(ctx, o, err) {
+		return nil
+	}
 	if acmeErr, ok := err.(*acmeapi.Error); ok {
 		if acmeErr.StatusCode >= 400 && acmeErr.StatusCode < 500 {
 			log.Error(err, "failed to retrieve issued certificate from ACME server")
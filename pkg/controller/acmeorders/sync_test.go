@@ -21,6 +21,7 @@ import (
 	"encoding/pem"
 	"errors"
 	"fmt"
+	"net/http"
 	"testing"
 	"time"
 
@@ -28,6 +29,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	coretesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/util/workqueue"
 	fakeclock "k8s.io/utils/clock/testing"
 
 	accountstest "github.com/cert-manager/cert-manager/pkg/acme/accounts/test"
@@ -144,6 +146,19 @@ func TestSync(t *testing.T) {
 		StatusCode: 403,
 		Detail:     "some error",
 	}
+	acmeError429RetryAfterSeconds := acmeapi.Error{
+		StatusCode:  429,
+		ProblemType: "urn:ietf:params:acme:error:rateLimited",
+		Detail:      "too many requests",
+		Header:      http.Header{"Retry-After": []string{"120"}},
+	}
+	retryAfterDate := nowTime.Add(time.Hour).Truncate(time.Second)
+	acmeError429RetryAfterDate := acmeapi.Error{
+		StatusCode:  429,
+		ProblemType: "urn:ietf:params:acme:error:rateLimited",
+		Detail:      "too many requests",
+		Header:      http.Header{"Retry-After": []string{retryAfterDate.UTC().Format(http.TimeFormat)}},
+	}
 
 	// testCert is using the following Let's Encrypt chain (X1 is not included):
 	//   leaf -> R3 -> ISRG Root X1
@@ -379,6 +394,162 @@ Dfvp7OOGAN6dEOM4+qR9sdjoSYKEBpsr6GtPAQw4dy753ec5
 				},
 			},
 		},
+		"create a new order with the acme server requesting an advertised ACME profile": {
+			order: gen.OrderFrom(testOrder, gen.SetOrderProfile("shortlived")),
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{testIssuerHTTP01TestCom, gen.OrderFrom(testOrder, gen.SetOrderProfile("shortlived"))},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(cmacme.SchemeGroupVersion.WithResource("orders"),
+						"status",
+						testOrderPending.Namespace,
+						gen.OrderFrom(testOrder, gen.SetOrderProfile("shortlived"), gen.SetOrderStatus(cmacme.OrderStatus{
+							State:       cmacme.Pending,
+							URL:         "http://testurl.com/abcde",
+							FinalizeURL: "http://testurl.com/abcde/finalize",
+							Authorizations: []cmacme.ACMEAuthorization{
+								{
+									URL: "http://authzurl",
+								},
+							},
+						})))),
+				},
+			},
+			acmeClient: &acmecl.FakeACME{
+				FakeProfiles: func(ctx context.Context) (map[string]string, error) {
+					return map[string]string{"classic": "", "shortlived": ""}, nil
+				},
+				FakeAuthorizeOrderWithExtensions: func(ctx context.Context, id []acmeapi.AuthzID, ext acmecl.OrderExtensions) (*acmeapi.Order, error) {
+					if ext.Profile != "shortlived" {
+						return nil, fmt.Errorf("expected profile %q but got %q", "shortlived", ext.Profile)
+					}
+					return testACMEOrderPending, nil
+				},
+				FakeGetAuthorization: func(ctx context.Context, url string) (*acmeapi.Authorization, error) {
+					if url != "http://authzurl" {
+						return nil, fmt.Errorf("Invalid URL: expected http://authzurl got %q", url)
+					}
+					return testACMEAuthorizationPending, nil
+				},
+				FakeHTTP01ChallengeResponse: func(s string) (string, error) {
+					return "key", nil
+				},
+			},
+		},
+		"create a new order with the acme server which replaces a previous certificate": {
+			order: gen.OrderFrom(testOrder, gen.SetOrderReplaces("aYhba4dGQEHhs3uEe6CuLN4ByNQ.AIdlQyE")),
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{testIssuerHTTP01TestCom, gen.OrderFrom(testOrder, gen.SetOrderReplaces("aYhba4dGQEHhs3uEe6CuLN4ByNQ.AIdlQyE"))},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(cmacme.SchemeGroupVersion.WithResource("orders"),
+						"status",
+						testOrderPending.Namespace,
+						gen.OrderFrom(testOrder, gen.SetOrderReplaces("aYhba4dGQEHhs3uEe6CuLN4ByNQ.AIdlQyE"), gen.SetOrderStatus(cmacme.OrderStatus{
+							State:       cmacme.Pending,
+							URL:         "http://testurl.com/abcde",
+							FinalizeURL: "http://testurl.com/abcde/finalize",
+							Authorizations: []cmacme.ACMEAuthorization{
+								{
+									URL: "http://authzurl",
+								},
+							},
+						})))),
+				},
+			},
+			acmeClient: &acmecl.FakeACME{
+				FakeAuthorizeOrderWithExtensions: func(ctx context.Context, id []acmeapi.AuthzID, ext acmecl.OrderExtensions) (*acmeapi.Order, error) {
+					if ext.Replaces != "aYhba4dGQEHhs3uEe6CuLN4ByNQ.AIdlQyE" {
+						return nil, fmt.Errorf("expected order to replace %q but got %q", "aYhba4dGQEHhs3uEe6CuLN4ByNQ.AIdlQyE", ext.Replaces)
+					}
+					return testACMEOrderPending, nil
+				},
+				FakeGetAuthorization: func(ctx context.Context, url string) (*acmeapi.Authorization, error) {
+					return testACMEAuthorizationPending, nil
+				},
+				FakeHTTP01ChallengeResponse: func(s string) (string, error) {
+					return "key", nil
+				},
+			},
+		},
+		"create a new order without a replacement if the previous certificate has already been replaced": {
+			order: gen.OrderFrom(testOrder, gen.SetOrderReplaces("aYhba4dGQEHhs3uEe6CuLN4ByNQ.AIdlQyE")),
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{testIssuerHTTP01TestCom, gen.OrderFrom(testOrder, gen.SetOrderReplaces("aYhba4dGQEHhs3uEe6CuLN4ByNQ.AIdlQyE"))},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(cmacme.SchemeGroupVersion.WithResource("orders"),
+						"status",
+						testOrderPending.Namespace,
+						gen.OrderFrom(testOrder, gen.SetOrderReplaces("aYhba4dGQEHhs3uEe6CuLN4ByNQ.AIdlQyE"), gen.SetOrderStatus(cmacme.OrderStatus{
+							State:       cmacme.Pending,
+							URL:         "http://testurl.com/abcde",
+							FinalizeURL: "http://testurl.com/abcde/finalize",
+							Authorizations: []cmacme.ACMEAuthorization{
+								{
+									URL: "http://authzurl",
+								},
+							},
+						})))),
+				},
+			},
+			acmeClient: &acmecl.FakeACME{
+				FakeAuthorizeOrderWithExtensions: func(ctx context.Context, id []acmeapi.AuthzID, ext acmecl.OrderExtensions) (*acmeapi.Order, error) {
+					if ext.Replaces != "" {
+						return nil, &acmeapi.Error{StatusCode: 409, ProblemType: "urn:ietf:params:acme:error:alreadyReplaced"}
+					}
+					return testACMEOrderPending, nil
+				},
+				FakeGetAuthorization: func(ctx context.Context, url string) (*acmeapi.Authorization, error) {
+					return testACMEAuthorizationPending, nil
+				},
+				FakeHTTP01ChallengeResponse: func(s string) (string, error) {
+					return "key", nil
+				},
+			},
+		},
+		"mark the order as errored if the acme server rejects the replaced certificate with a problem other than alreadyReplaced": {
+			order: gen.OrderFrom(testOrder, gen.SetOrderReplaces("aYhba4dGQEHhs3uEe6CuLN4ByNQ.AIdlQyE")),
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{testIssuerHTTP01TestCom, gen.OrderFrom(testOrder, gen.SetOrderReplaces("aYhba4dGQEHhs3uEe6CuLN4ByNQ.AIdlQyE"))},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(cmacme.SchemeGroupVersion.WithResource("orders"),
+						"status",
+						testOrderPending.Namespace,
+						gen.OrderFrom(testOrder, gen.SetOrderReplaces("aYhba4dGQEHhs3uEe6CuLN4ByNQ.AIdlQyE"), gen.SetOrderStatus(cmacme.OrderStatus{
+							State:       cmacme.Errored,
+							Reason:      "Failed to create Order: 400 urn:ietf:params:acme:error:malformed: Unknown certificate in replaces field",
+							FailureTime: &nowMetaTime,
+						})))),
+				},
+			},
+			acmeClient: &acmecl.FakeACME{
+				FakeAuthorizeOrderWithExtensions: func(ctx context.Context, id []acmeapi.AuthzID, ext acmecl.OrderExtensions) (*acmeapi.Order, error) {
+					if ext.Replaces == "" {
+						return nil, errors.New("expected the order to be created only once, with the replaced certificate")
+					}
+					return nil, &acmeapi.Error{StatusCode: 400, ProblemType: "urn:ietf:params:acme:error:malformed", Detail: "Unknown certificate in replaces field"}
+				},
+			},
+		},
+		"mark the order as errored if the requested ACME profile is not advertised by the acme server": {
+			order: gen.OrderFrom(testOrder, gen.SetOrderProfile("unknown")),
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{testIssuerHTTP01TestCom, gen.OrderFrom(testOrder, gen.SetOrderProfile("unknown"))},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(cmacme.SchemeGroupVersion.WithResource("orders"),
+						"status",
+						testOrderPending.Namespace,
+						gen.OrderFrom(testOrder, gen.SetOrderProfile("unknown"), gen.SetOrderStatus(cmacme.OrderStatus{
+							State:       cmacme.Errored,
+							Reason:      `Failed to create Order: the ACME server does not offer the requested profile "unknown", available profiles: [classic shortlived]`,
+							FailureTime: &nowMetaTime,
+						})))),
+				},
+			},
+			acmeClient: &acmecl.FakeACME{
+				FakeProfiles: func(ctx context.Context) (map[string]string, error) {
+					return map[string]string{"classic": "", "shortlived": ""}, nil
+				},
+			},
+		},
 		"create a new order with the acme server with an IP address": {
 			order: testOrderIP,
 			builder: &testpkg.Builder{
@@ -674,6 +845,54 @@ Dfvp7OOGAN6dEOM4+qR9sdjoSYKEBpsr6GtPAQw4dy753ec5
 			},
 			expectErr: true,
 		},
+		"call FinalizeOrder and re-queue the Order after Retry-After seconds if finalize is rate limited": {
+			order: testOrderReady,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{testIssuerHTTP01TestCom, testOrderReady, testAuthorizationChallengeValid},
+				ExpectedEvents: []string{
+					fmt.Sprintf("Warning RateLimited Request was rate limited by the ACME server, retrying in 2m0s: %v", &acmeError429RetryAfterSeconds),
+				},
+			},
+			acmeClient: &acmecl.FakeACME{
+				FakeCreateOrderCert: func(_ context.Context, url string, csr []byte, bundle bool) ([][]byte, string, error) {
+					return nil, "", &acmeError429RetryAfterSeconds
+				},
+			},
+			shouldSchedule: true,
+			requeuePeriod:  2 * time.Minute,
+		},
+		"call FinalizeOrder and re-queue the Order at the Retry-After date if finalize is rate limited": {
+			order: testOrderReady,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{testIssuerHTTP01TestCom, testOrderReady, testAuthorizationChallengeValid},
+				ExpectedEvents: []string{
+					fmt.Sprintf("Warning RateLimited Request was rate limited by the ACME server, retrying in %s: %v", retryAfterDate.Sub(nowTime), &acmeError429RetryAfterDate),
+				},
+			},
+			acmeClient: &acmecl.FakeACME{
+				FakeCreateOrderCert: func(_ context.Context, url string, csr []byte, bundle bool) ([][]byte, string, error) {
+					return nil, "", &acmeError429RetryAfterDate
+				},
+			},
+			shouldSchedule: true,
+			requeuePeriod:  retryAfterDate.Sub(nowTime),
+		},
+		"create a new order and re-queue it after Retry-After seconds if the request is rate limited": {
+			order: testOrder,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{testIssuerHTTP01TestCom, testOrder},
+				ExpectedEvents: []string{
+					fmt.Sprintf("Warning RateLimited Request was rate limited by the ACME server, retrying in 2m0s: %v", &acmeError429RetryAfterSeconds),
+				},
+			},
+			acmeClient: &acmecl.FakeACME{
+				FakeAuthorizeOrder: func(ctx context.Context, id []acmeapi.AuthzID, opt ...acmeapi.OrderOption) (*acmeapi.Order, error) {
+					return nil, &acmeError429RetryAfterSeconds
+				},
+			},
+			shouldSchedule: true,
+			requeuePeriod:  2 * time.Minute,
+		},
 		"call FinalizeOrder, recover if finalize fails because order is already finalized": {
 			order: testOrderReady,
 			builder: &testpkg.Builder{
@@ -911,7 +1130,10 @@ type testT struct {
 	builder        *testpkg.Builder
 	acmeClient     acmecl.Interface
 	shouldSchedule bool
-	expectErr      bool
+	// requeuePeriod is the period after which the Order is expected to be
+	// re-queued. Defaults to RequeuePeriod.
+	requeuePeriod time.Duration
+	expectErr     bool
 }
 
 func runTest(t *testing.T, test testT) {
@@ -931,10 +1153,17 @@ func runTest(t *testing.T, test testT) {
 			return test.acmeClient, nil
 		},
 	}
+	requeuePeriod := test.requeuePeriod
+	if requeuePeriod == 0 {
+		requeuePeriod = RequeuePeriod
+	}
 	gotScheduled := false
 	fakeScheduler := schedulertest.FakeScheduler{
 		AddFunc: func(obj interface{}, duration time.Duration) {
 			gotScheduled = true
+			if duration != requeuePeriod {
+				t.Errorf("Expected Order to be re-queued after %s, got %s", requeuePeriod, duration)
+			}
 		},
 	}
 	cw.scheduledWorkQueue = &fakeScheduler
@@ -954,3 +1183,69 @@ func runTest(t *testing.T, test testT) {
 
 	test.builder.CheckAndFinish(err)
 }
+
+func TestPollPeriod(t *testing.T) {
+	tests := map[string]struct {
+		pollJitter float64
+		minPeriod  time.Duration
+		maxPeriod  time.Duration
+	}{
+		"should not apply jitter if pollJitter is zero": {
+			minPeriod: RequeuePeriod,
+			maxPeriod: RequeuePeriod,
+		},
+		"should jitter the requeue period up to the configured factor": {
+			pollJitter: 0.5,
+			minPeriod:  RequeuePeriod,
+			maxPeriod:  RequeuePeriod + RequeuePeriod/2,
+		},
+		"should allow the requeue period to be more than doubled": {
+			pollJitter: 2,
+			minPeriod:  RequeuePeriod,
+			maxPeriod:  3 * RequeuePeriod,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := &controller{pollJitter: test.pollJitter}
+			for i := 0; i < 100; i++ {
+				period := c.pollPeriod("testns/test")
+				if period < test.minPeriod || period > test.maxPeriod {
+					t.Fatalf("expected requeue period within [%s, %s], got %s", test.minPeriod, test.maxPeriod, period)
+				}
+			}
+		})
+	}
+}
+
+func TestPollPeriodBackoff(t *testing.T) {
+	c := &controller{
+		pollBackoff: workqueue.NewItemExponentialFailureRateLimiter(RequeuePeriod, MaxRequeuePeriod),
+	}
+
+	want := []time.Duration{
+		RequeuePeriod,
+		2 * RequeuePeriod,
+		4 * RequeuePeriod,
+		8 * RequeuePeriod,
+		16 * RequeuePeriod,
+		MaxRequeuePeriod,
+		MaxRequeuePeriod,
+		MaxRequeuePeriod,
+	}
+	for i, w := range want {
+		if period := c.pollPeriod("testns/test"); period != w {
+			t.Errorf("poll %d: expected requeue period %s, got %s", i, w, period)
+		}
+	}
+
+	if period := c.pollPeriod("testns/other"); period != RequeuePeriod {
+		t.Errorf("expected the poll period of another Order to start at %s, got %s", RequeuePeriod, period)
+	}
+
+	c.forgetPollPeriod("testns/test")
+	if period := c.pollPeriod("testns/test"); period != RequeuePeriod {
+		t.Errorf("expected the poll period to be reset to %s once forgotten, got %s", RequeuePeriod, period)
+	}
+}
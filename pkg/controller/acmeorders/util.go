@@ -91,8 +91,9 @@ func partialChallengeSpecForAuthorization(ctx context.Context, issuer cmapi.Gene
 	log := logf.FromContext(ctx, "challengeSpecForAuthorization")
 	dbg := log.V(logf.DebugLevel)
 
-	// 1. fetch solvers from issuer
+	// 1. fetch solvers and challenge type preference from issuer
 	solvers := issuer.GetSpec().ACME.Solvers
+	preference := issuer.GetSpec().ACME.ChallengeTypePreference
 
 	wc := false
 	if authz.Wildcard != nil {
@@ -116,11 +117,21 @@ func partialChallengeSpecForAuthorization(ctx context.Context, issuer cmapi.Gene
 				return &ch
 			case ch.Type == "dns-01" && solver.DNS01 != nil:
 				return &ch
+			case ch.Type == "tls-alpn-01" && solver.TLSALPN01 != nil:
+				return &ch
 			}
 		}
 		return nil
 	}
 
+	// isPreferred returns true if the given challenge's type appears before
+	// the type of the previously selected challenge in the issuer's challenge
+	// type preference. It is used to break ties between solvers whose
+	// selectors match the authorization equally well.
+	isPreferred := func(acmech *cmacme.ACMEChallenge) bool {
+		return challengeTypeRank(preference, acmech.Type) < challengeTypeRank(preference, selectedChallenge.Type)
+	}
+
 	// 2. filter solvers to only those that matchLabels
 	for _, cfg := range solvers {
 		acmech := challengeForSolver(&cfg) // #nosec G601 -- False positive. See https://github.com/golang/go/discussions/56010
@@ -131,6 +142,13 @@ func partialChallengeSpecForAuthorization(ctx context.Context, issuer cmapi.Gene
 
 		if cfg.Selector == nil {
 			if selectedSolver != nil {
+				selectedMatchesAll := selectedNumLabelsMatch == 0 && selectedNumDNSNamesMatch == 0 && selectedNumDNSZonesMatch == 0
+				if selectedMatchesAll && isPreferred(acmech) {
+					dbg.Info("selecting solver as its challenge type is preferred over that of the previously selected solver with a just as specific selector")
+					selectedSolver = cfg.DeepCopy()
+					selectedChallenge = acmech
+					continue
+				}
 				dbg.Info("not selecting solver as previously selected solver has a just as or more specific selector")
 				continue
 			}
@@ -203,6 +221,11 @@ func partialChallengeSpecForAuthorization(ctx context.Context, issuer cmapi.Gene
 				selectSolver()
 				continue
 			}
+			if numLabelsMatch == selectedNumLabelsMatch && isPreferred(acmech) {
+				dbg.Info("selecting solver as its challenge type is preferred over that of the previously selected one")
+				selectSolver()
+				continue
+			}
 			dbg.Info("not selecting this solver as previous one has either the same number of or more labels")
 			continue
 		}
@@ -240,6 +263,11 @@ func partialChallengeSpecForAuthorization(ctx context.Context, issuer cmapi.Gene
 				selectSolver()
 				continue
 			}
+			if numLabelsMatch == selectedNumLabelsMatch && isPreferred(acmech) {
+				dbg.Info("selecting solver because its challenge type is preferred over that of the previous one")
+				selectSolver()
+				continue
+			}
 			dbg.Info("not selecting solver as this one's number of matching labels is equal to or less than the last one")
 			continue
 		}
@@ -249,6 +277,11 @@ func partialChallengeSpecForAuthorization(ctx context.Context, issuer cmapi.Gene
 			selectSolver()
 			continue
 		}
+		if numLabelsMatch == selectedNumLabelsMatch && isPreferred(acmech) {
+			dbg.Info("selecting solver as its challenge type is preferred over that of the last one")
+			selectSolver()
+			continue
+		}
 
 		dbg.Info("not selecting solver as this one's number of matching labels is equal to or less than the last one (reached end of loop)")
 		// if we get here, the number of matches is less than or equal so we
@@ -260,8 +293,8 @@ func partialChallengeSpecForAuthorization(ctx context.Context, issuer cmapi.Gene
 	}
 
 	// It should never be possible for this case to be hit as earlier in this
-	// method we already assert that the challenge type is one of 'http-01'
-	// or 'dns-01'.
+	// method we already assert that the challenge type is one of 'http-01',
+	// 'dns-01' or 'tls-alpn-01'.
 	chType, err := challengeType(selectedChallenge.Type)
 	if err != nil {
 		return nil, err
@@ -287,12 +320,30 @@ func partialChallengeSpecForAuthorization(ctx context.Context, issuer cmapi.Gene
 	}, nil
 }
 
+// challengeTypeRank returns the position of the ACME challenge type t in the
+// given challenge type preference. Challenge types which do not appear in the
+// preference are ranked after all those which do.
+func challengeTypeRank(preference []cmacme.ACMEChallengeType, t string) int {
+	chType, err := challengeType(t)
+	if err != nil {
+		return len(preference)
+	}
+	for i, p := range preference {
+		if p == chType {
+			return i
+		}
+	}
+	return len(preference)
+}
+
 func challengeType(t string) (cmacme.ACMEChallengeType, error) {
 	switch t {
 	case "http-01":
 		return cmacme.ACMEChallengeTypeHTTP01, nil
 	case "dns-01":
 		return cmacme.ACMEChallengeTypeDNS01, nil
+	case "tls-alpn-01":
+		return cmacme.ACMEChallengeTypeTLSALPN01, nil
 	default:
 		return "", fmt.Errorf("unsupported challenge type: %v", t)
 	}
@@ -335,6 +386,12 @@ func ensureKeysForChallenges(cl acmecl.Interface, challenges []*cmacme.Challenge
 			key, err = cl.HTTP01ChallengeResponse(ch.Spec.Token)
 		case cmacme.ACMEChallengeTypeDNS01:
 			key, err = cl.DNS01ChallengeRecord(ch.Spec.Token)
+		case cmacme.ACMEChallengeTypeTLSALPN01:
+			// The key authorization served in response to HTTP-01 challenges
+			// is also the value whose digest is presented in the
+			// acmeIdentifier extension of the TLS-ALPN-01 challenge
+			// certificate.
+			key, err = cl.HTTP01ChallengeResponse(ch.Spec.Token)
 		default:
 			return nil, fmt.Errorf("challenge %s has unsupported challenge type: %s", ch.Name, ch.Spec.Type)
 		}
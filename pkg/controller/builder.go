@@ -38,6 +38,10 @@ type Builder struct {
 	// runDurationFuncs are a list of functions that will be called every
 	// 'duration'
 	runDurationFuncs []runDurationFunc
+
+	// runShutdownFuncs are a list of functions that will be called once when
+	// the controller shuts down
+	runShutdownFuncs []runShutdownFunc
 }
 
 // New creates a basic Builder, setting the sync call to the one given
@@ -64,6 +68,22 @@ func (b *Builder) With(function func(context.Context), duration time.Duration) *
 	return b
 }
 
+// WithShutdown will register an additional function that should be called
+// once the controller has been signaled to shut down and all of its workers
+// have exited.
+// The function is not called if the controller stopped because leader
+// election was lost, as another instance may already be the leader.
+// The function is given a context which is cancelled after 'gracePeriod'.
+// This is useful if a controller needs to release or clean up state that it
+// holds before the process exits.
+func (b *Builder) WithShutdown(function func(context.Context), gracePeriod time.Duration) *Builder {
+	b.runShutdownFuncs = append(b.runShutdownFuncs, runShutdownFunc{
+		fn:          function,
+		gracePeriod: gracePeriod,
+	})
+	return b
+}
+
 func (b *Builder) Complete() (Interface, error) {
 	controllerctx, err := b.contextFactory.Build(b.name)
 	if err != nil {
@@ -78,5 +98,5 @@ func (b *Builder) Complete() (Interface, error) {
 		return nil, fmt.Errorf("error registering controller: %v", err)
 	}
 
-	return NewController(b.name, controllerctx.Metrics, b.impl.ProcessItem, mustSync, b.runDurationFuncs, queue), nil
+	return newController(b.name, controllerctx.Metrics, b.impl.ProcessItem, mustSync, b.runDurationFuncs, b.runShutdownFuncs, queue), nil
 }
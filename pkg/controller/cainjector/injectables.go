@@ -20,12 +20,18 @@ import (
 	"encoding/json"
 
 	admissionreg "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
 	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	applyadmissionreg "k8s.io/client-go/applyconfigurations/admissionregistration/v1"
+	applycorev1 "k8s.io/client-go/applyconfigurations/core/v1"
 	applymetav1 "k8s.io/client-go/applyconfigurations/meta/v1"
 	apireg "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 )
 
 // This file contains logic for dealing with injectables, such as injecting CA
@@ -59,6 +65,18 @@ func newCRDConversionInjectable() InjectTarget {
 	return &crdConversionTarget{}
 }
 
+var _ NewInjectableTarget = newConfigMapInjectable
+
+func newConfigMapInjectable() InjectTarget {
+	return &configMapTarget{}
+}
+
+var _ NewInjectableTarget = newSecretInjectable
+
+func newSecretInjectable() InjectTarget {
+	return &secretTarget{}
+}
+
 // InjectTarget knows how to set CA data to a particular instance of injectable,
 // for example an instance of ValidatingWebhookConfiguration.
 type InjectTarget interface {
@@ -78,6 +96,18 @@ type InjectTarget interface {
 	SetCA(data []byte)
 }
 
+// CleanupTarget is implemented by injectables which hold the injected CA data
+// alongside data that is not managed by cainjector, such as ConfigMaps and
+// Secrets. The injected CA data is removed from these once they no longer
+// want injection.
+type CleanupTarget interface {
+	InjectTarget
+
+	// RemoveCA removes CA data previously injected by cainjector. It returns
+	// false if cainjector has not injected CA data into this target.
+	RemoveCA() bool
+}
+
 type ssaPatch struct {
 	patch []byte
 	err   error
@@ -275,3 +305,85 @@ func (t *crdConversionTarget) AsApplyObject() (client.Object, client.Patch) {
 		},
 	})
 }
+
+// configMapTarget knows how to set CA data for the ca.crt key of a ConfigMap.
+type configMapTarget struct {
+	obj corev1.ConfigMap
+}
+
+var _ CleanupTarget = &configMapTarget{}
+
+func (t *configMapTarget) AsObject() client.Object {
+	return &t.obj
+}
+
+func (t *configMapTarget) SetCA(data []byte) {
+	metav1.SetMetaDataAnnotation(&t.obj.ObjectMeta, cmapi.CAInjectedAnnotation, "true")
+	if t.obj.Data == nil {
+		t.obj.Data = make(map[string]string)
+	}
+	t.obj.Data[cmmeta.TLSCAKey] = string(data)
+}
+
+func (t *configMapTarget) RemoveCA() bool {
+	if _, ok := t.obj.Annotations[cmapi.CAInjectedAnnotation]; !ok {
+		return false
+	}
+	delete(t.obj.Annotations, cmapi.CAInjectedAnnotation)
+	delete(t.obj.Data, cmmeta.TLSCAKey)
+	return true
+}
+
+func (t *configMapTarget) AsApplyObject() (client.Object, client.Patch) {
+	patch := applycorev1.ConfigMap(t.obj.Name, t.obj.Namespace)
+	// After RemoveCA, the apply configuration is left empty so that the
+	// fields previously applied by cainjector are removed.
+	if value, ok := t.obj.Annotations[cmapi.CAInjectedAnnotation]; ok {
+		patch = patch.
+			WithAnnotations(map[string]string{cmapi.CAInjectedAnnotation: value}).
+			WithData(map[string]string{cmmeta.TLSCAKey: t.obj.Data[cmmeta.TLSCAKey]})
+	}
+
+	return &t.obj, newSSAPatch(patch)
+}
+
+// secretTarget knows how to set CA data for the ca.crt key of a Secret.
+type secretTarget struct {
+	obj corev1.Secret
+}
+
+var _ CleanupTarget = &secretTarget{}
+
+func (t *secretTarget) AsObject() client.Object {
+	return &t.obj
+}
+
+func (t *secretTarget) SetCA(data []byte) {
+	metav1.SetMetaDataAnnotation(&t.obj.ObjectMeta, cmapi.CAInjectedAnnotation, "true")
+	if t.obj.Data == nil {
+		t.obj.Data = make(map[string][]byte)
+	}
+	t.obj.Data[cmmeta.TLSCAKey] = data
+}
+
+func (t *secretTarget) RemoveCA() bool {
+	if _, ok := t.obj.Annotations[cmapi.CAInjectedAnnotation]; !ok {
+		return false
+	}
+	delete(t.obj.Annotations, cmapi.CAInjectedAnnotation)
+	delete(t.obj.Data, cmmeta.TLSCAKey)
+	return true
+}
+
+func (t *secretTarget) AsApplyObject() (client.Object, client.Patch) {
+	patch := applycorev1.Secret(t.obj.Name, t.obj.Namespace)
+	// After RemoveCA, the apply configuration is left empty so that the
+	// fields previously applied by cainjector are removed.
+	if value, ok := t.obj.Annotations[cmapi.CAInjectedAnnotation]; ok {
+		patch = patch.
+			WithAnnotations(map[string]string{cmapi.CAInjectedAnnotation: value}).
+			WithData(map[string][]byte{cmmeta.TLSCAKey: t.obj.Data[cmmeta.TLSCAKey]})
+	}
+
+	return &t.obj, newSSAPatch(patch)
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
@@ -64,6 +65,11 @@ type reconciler struct {
 	fieldManager string
 
 	resourceName string // just used for logging
+
+	// resyncInterval, if non-zero, is how long to wait before reconciling an
+	// injectable again, so that it is corrected if it has drifted from its
+	// CA data source without a watch event being received.
+	resyncInterval time.Duration
 }
 
 // Reconcile attempts to ensure that a particular injectable has all the CAs injected that
@@ -101,13 +107,28 @@ func (r *reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	dataSource, err := r.caDataSourceFor(log, metaObj)
 	if err != nil {
 		log.V(logf.DebugLevel).Info("failed to determine ca data source for injectable")
+
+		// remove CA data that was injected before the injection annotations
+		// were removed
+		if cleanupTarget, ok := target.(CleanupTarget); ok && cleanupTarget.RemoveCA() {
+			if err := r.updateTarget(ctx, target); err != nil {
+				log.Error(err, "unable to remove injected CA data from target object")
+				return ctrl.Result{}, err
+			}
+			log.V(logf.InfoLevel).Info("Removed injected CA data from object")
+		}
+
 		return ctrl.Result{}, nil
 	}
 
+	// injectables which want injection are periodically reconciled again,
+	// in case their CA data source has changed without a watch event
+	resync := ctrl.Result{RequeueAfter: r.resyncInterval}
+
 	caData, err := dataSource.ReadCA(ctx, log, metaObj, r.namespace)
 	if apierrors.IsForbidden(err) {
 		log.V(logf.InfoLevel).Info("cainjector was forbidden to retrieve the ca data source")
-		return ctrl.Result{}, nil
+		return resync, nil
 	}
 	if err != nil {
 		log.Error(err, "failed to read CA from data source")
@@ -116,32 +137,35 @@ func (r *reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 
 	if caData == nil {
 		log.V(logf.InfoLevel).Info("could not find any ca data in data source for target")
-		return ctrl.Result{}, nil
+		return resync, nil
 	}
 
 	// actually do the injection
 	target.SetCA(caData)
 
 	// actually update with injected CA data
-	if utilfeature.DefaultFeatureGate.Enabled(feature.ServerSideApply) {
-		obj, patch := target.AsApplyObject()
-		if patch != nil {
-			err = r.Client.Patch(ctx, obj, patch, &client.PatchOptions{
-				Force: ptr.To(true), FieldManager: r.fieldManager,
-			})
-		}
-	} else {
-		err = r.Client.Update(ctx, target.AsObject())
-	}
-
-	if err != nil {
+	if err := r.updateTarget(ctx, target); err != nil {
 		log.Error(err, "unable to update target object with new CA data")
 		return ctrl.Result{}, err
 	}
 
 	log.V(logf.InfoLevel).Info("Updated object")
 
-	return ctrl.Result{}, nil
+	return resync, nil
+}
+
+// updateTarget writes the CA data of the target back to the API server.
+func (r *reconciler) updateTarget(ctx context.Context, target InjectTarget) error {
+	if utilfeature.DefaultFeatureGate.Enabled(feature.ServerSideApply) {
+		obj, patch := target.AsApplyObject()
+		if patch == nil {
+			return nil
+		}
+		return r.Client.Patch(ctx, obj, patch, &client.PatchOptions{
+			Force: ptr.To(true), FieldManager: r.fieldManager,
+		})
+	}
+	return r.Client.Update(ctx, target.AsObject())
 }
 
 func (r *reconciler) caDataSourceFor(log logr.Logger, metaObj metav1.Object) (caDataSource, error) {
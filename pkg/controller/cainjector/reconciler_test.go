@@ -0,0 +1,272 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cainjector
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	logtesting "github.com/go-logr/logr/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionreg "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+)
+
+func TestReconcileResync(t *testing.T) {
+	const resyncInterval = 10 * time.Minute
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, admissionreg.AddToScheme(scheme))
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "cert-manager",
+			Name:        "ca",
+			Annotations: map[string]string{cmapi.AllowsInjectionFromSecretAnnotation: "true"},
+		},
+		Data: map[string][]byte{cmmeta.TLSCAKey: []byte("ca-1")},
+	}
+	webhook := &admissionreg.ValidatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "webhook",
+			Annotations: map[string]string{cmapi.WantInjectFromSecretAnnotation: "cert-manager/ca"},
+		},
+		Webhooks: []admissionreg.ValidatingWebhook{{Name: "webhook.example.com"}},
+	}
+
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret, webhook).Build()
+	r := &reconciler{
+		newInjectableTarget: newValidatingWebhookInjectable,
+		sources:             []caDataSource{&secretDataSource{client: cl}},
+		log:                 logtesting.NewTestLogger(t),
+		Client:              cl,
+		resourceName:        ValidatingWebhookConfigurationName,
+		resyncInterval:      resyncInterval,
+	}
+	ctx := context.Background()
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "webhook"}}
+
+	assertInjected := func(t *testing.T, expected string) {
+		t.Helper()
+		var got admissionreg.ValidatingWebhookConfiguration
+		require.NoError(t, cl.Get(ctx, client.ObjectKeyFromObject(webhook), &got))
+		assert.Equal(t, expected, string(got.Webhooks[0].ClientConfig.CABundle))
+	}
+
+	result, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, resyncInterval, result.RequeueAfter, "an injectable should be requeued after the resync interval")
+	assertInjected(t, "ca-1")
+
+	t.Run("a target which has drifted is corrected on resync", func(t *testing.T) {
+		var drifted admissionreg.ValidatingWebhookConfiguration
+		require.NoError(t, cl.Get(ctx, client.ObjectKeyFromObject(webhook), &drifted))
+		drifted.Webhooks[0].ClientConfig.CABundle = []byte("stale")
+		require.NoError(t, cl.Update(ctx, &drifted))
+
+		result, err := r.Reconcile(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, resyncInterval, result.RequeueAfter)
+		assertInjected(t, "ca-1")
+	})
+
+	t.Run("a Secret updated without a watch event is injected on resync", func(t *testing.T) {
+		var updated corev1.Secret
+		require.NoError(t, cl.Get(ctx, client.ObjectKeyFromObject(secret), &updated))
+		updated.Data[cmmeta.TLSCAKey] = []byte("ca-2")
+		require.NoError(t, cl.Update(ctx, &updated))
+
+		result, err := r.Reconcile(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, resyncInterval, result.RequeueAfter)
+		assertInjected(t, "ca-2")
+	})
+
+	t.Run("an object which does not want injection is not requeued", func(t *testing.T) {
+		require.NoError(t, cl.Create(ctx, &admissionreg.ValidatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: "not-injectable"},
+		}))
+
+		result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "not-injectable"}})
+		require.NoError(t, err)
+		assert.Zero(t, result.RequeueAfter)
+	})
+}
+
+func TestReconcileConfigMapAndSecretTargets(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	source := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "cert-manager",
+			Name:        "ca",
+			Annotations: map[string]string{cmapi.AllowsInjectionFromSecretAnnotation: "true"},
+		},
+		Data: map[string][]byte{cmmeta.TLSCAKey: []byte("ca-1")},
+	}
+	injectAnnotations := map[string]string{cmapi.WantInjectFromSecretAnnotation: "cert-manager/ca"}
+
+	tests := map[string]struct {
+		newInjectableTarget NewInjectableTarget
+		// newTarget returns a target with the given annotations and data
+		newTarget func(annotations map[string]string, data map[string]string) client.Object
+		// data returns the data of the target as strings
+		data func(obj client.Object) map[string]string
+	}{
+		"ConfigMap": {
+			newInjectableTarget: newConfigMapInjectable,
+			newTarget: func(annotations map[string]string, data map[string]string) client.Object {
+				return &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "target", Annotations: annotations},
+					Data:       data,
+				}
+			},
+			data: func(obj client.Object) map[string]string {
+				return obj.(*corev1.ConfigMap).Data
+			},
+		},
+		"Secret": {
+			newInjectableTarget: newSecretInjectable,
+			newTarget: func(annotations map[string]string, data map[string]string) client.Object {
+				secret := &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "target", Annotations: annotations},
+				}
+				for k, v := range data {
+					if secret.Data == nil {
+						secret.Data = make(map[string][]byte)
+					}
+					secret.Data[k] = []byte(v)
+				}
+				return secret
+			},
+			data: func(obj client.Object) map[string]string {
+				data := make(map[string]string)
+				for k, v := range obj.(*corev1.Secret).Data {
+					data[k] = string(v)
+				}
+				return data
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "app", Name: "target"}}
+
+			get := func(t *testing.T, cl client.Client) client.Object {
+				t.Helper()
+				obj := test.newInjectableTarget().AsObject()
+				require.NoError(t, cl.Get(ctx, req.NamespacedName, obj))
+				return obj
+			}
+			newReconciler := func(cl client.Client) *reconciler {
+				return &reconciler{
+					newInjectableTarget: test.newInjectableTarget,
+					sources:             []caDataSource{&secretDataSource{client: cl}},
+					log:                 logtesting.NewTestLogger(t),
+					Client:              cl,
+					resourceName:        name,
+				}
+			}
+
+			t.Run("injects the CA and removes it once injection is no longer wanted", func(t *testing.T) {
+				target := test.newTarget(injectAnnotations, map[string]string{"config.yaml": "foo: bar"})
+				cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(source.DeepCopy(), target).Build()
+				r := newReconciler(cl)
+
+				_, err := r.Reconcile(ctx, req)
+				require.NoError(t, err)
+
+				injected := get(t, cl)
+				assert.Equal(t, map[string]string{"config.yaml": "foo: bar", cmmeta.TLSCAKey: "ca-1"}, test.data(injected))
+				assert.Equal(t, "true", injected.GetAnnotations()[cmapi.CAInjectedAnnotation])
+
+				annotations := injected.GetAnnotations()
+				delete(annotations, cmapi.WantInjectFromSecretAnnotation)
+				injected.SetAnnotations(annotations)
+				require.NoError(t, cl.Update(ctx, injected))
+
+				_, err = r.Reconcile(ctx, req)
+				require.NoError(t, err)
+
+				cleaned := get(t, cl)
+				assert.Equal(t, map[string]string{"config.yaml": "foo: bar"}, test.data(cleaned))
+				assert.NotContains(t, cleaned.GetAnnotations(), cmapi.CAInjectedAnnotation)
+			})
+
+			t.Run("does not remove CA data which was not injected by cainjector", func(t *testing.T) {
+				target := test.newTarget(nil, map[string]string{cmmeta.TLSCAKey: "user-ca"})
+				cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(source.DeepCopy(), target).Build()
+				r := newReconciler(cl)
+
+				_, err := r.Reconcile(ctx, req)
+				require.NoError(t, err)
+
+				assert.Equal(t, map[string]string{cmmeta.TLSCAKey: "user-ca"}, test.data(get(t, cl)))
+			})
+		})
+	}
+}
+
+func TestConfigMapAndSecretTargetsApplyPatch(t *testing.T) {
+	tests := map[string]struct {
+		target        CleanupTarget
+		expectedPatch string
+		expectedEmpty string
+	}{
+		"ConfigMap": {
+			target:        &configMapTarget{obj: corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "target"}, Data: map[string]string{"other": "data"}}},
+			expectedPatch: `{"kind":"ConfigMap","apiVersion":"v1","metadata":{"name":"target","namespace":"app","annotations":{"cert-manager.io/ca-injected":"true"}},"data":{"ca.crt":"ca"}}`,
+			expectedEmpty: `{"kind":"ConfigMap","apiVersion":"v1","metadata":{"name":"target","namespace":"app"}}`,
+		},
+		"Secret": {
+			target:        &secretTarget{obj: corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "target"}, Data: map[string][]byte{"other": []byte("data")}}},
+			expectedPatch: `{"kind":"Secret","apiVersion":"v1","metadata":{"name":"target","namespace":"app","annotations":{"cert-manager.io/ca-injected":"true"}},"data":{"ca.crt":"Y2E="}}`,
+			expectedEmpty: `{"kind":"Secret","apiVersion":"v1","metadata":{"name":"target","namespace":"app"}}`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.target.SetCA([]byte("ca"))
+			obj, patch := test.target.AsApplyObject()
+			data, err := patch.Data(obj)
+			require.NoError(t, err)
+			assert.JSONEq(t, test.expectedPatch, string(data), "only the fields managed by cainjector should be applied")
+
+			require.True(t, test.target.RemoveCA())
+			obj, patch = test.target.AsApplyObject()
+			data, err = patch.Data(obj)
+			require.NoError(t, err)
+			assert.JSONEq(t, test.expectedEmpty, string(data), "removed fields should not be applied")
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
 	admissionreg "k8s.io/api/admissionregistration/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -45,6 +46,8 @@ const (
 	ValidatingWebhookConfigurationName = "validatingwebhookconfiguration"
 	APIServiceName                     = "apiservice"
 	CustomResourceDefinitionName       = "customresourcedefinition"
+	ConfigMapName                      = "configmap"
+	SecretName                         = "secret"
 )
 
 // setup is setup for a reconciler for a particular injectable type
@@ -60,6 +63,10 @@ type SetupOptions struct {
 	Namespace                    string
 	EnableCertificatesDataSource bool
 	EnabledReconcilersFor        map[string]bool
+	// ResyncInterval is how often each injectable is reconciled even if no
+	// watch event has been received. If zero, injectables are only
+	// reconciled in response to watch events.
+	ResyncInterval time.Duration
 }
 
 var (
@@ -90,6 +97,20 @@ var (
 		listType:            &apiext.CustomResourceDefinitionList{},
 		objType:             &apiext.CustomResourceDefinition{},
 	}
+
+	ConfigMapSetup = setup{
+		resourceName:        ConfigMapName,
+		newInjectableTarget: newConfigMapInjectable,
+		listType:            &corev1.ConfigMapList{},
+		objType:             &corev1.ConfigMap{},
+	}
+
+	SecretSetup = setup{
+		resourceName:        SecretName,
+		newInjectableTarget: newSecretInjectable,
+		listType:            &corev1.SecretList{},
+		objType:             &corev1.Secret{},
+	}
 )
 
 // RegisterAllInjectors sets up watches for all injectable and injector types that cainjector should watch
@@ -108,8 +129,9 @@ func RegisterAllInjectors(ctx context.Context, mgr ctrl.Manager, opts SetupOptio
 	kds := &kubeconfigDataSource{
 		apiserverCABundle: caBundle,
 	}
-	injectorSetups := []setup{MutatingWebhookSetup, ValidatingWebhookSetup, APIServiceSetup, CRDSetup}
-	// Registers a c/r controller for each of APIService, CustomResourceDefinition, Mutating/ValidatingWebhookConfiguration
+	injectorSetups := []setup{MutatingWebhookSetup, ValidatingWebhookSetup, APIServiceSetup, CRDSetup, ConfigMapSetup, SecretSetup}
+	// Registers a c/r controller for each of APIService, CustomResourceDefinition, Mutating/ValidatingWebhookConfiguration,
+	// ConfigMap and Secret
 	for _, setup := range injectorSetups {
 		log := ctrl.Log.WithValues("kind", setup.resourceName)
 		if !opts.EnabledReconcilersFor[setup.resourceName] {
@@ -129,7 +151,8 @@ func RegisterAllInjectors(ctx context.Context, mgr ctrl.Manager, opts SetupOptio
 				cds,
 				kds,
 			},
-			fieldManager: util.PrefixFromUserAgent(mgr.GetConfig().UserAgent),
+			fieldManager:   util.PrefixFromUserAgent(mgr.GetConfig().UserAgent),
+			resyncInterval: opts.ResyncInterval,
 		}
 
 		// Index injectable with a new field. If the injectable's CA is
@@ -143,7 +166,9 @@ func RegisterAllInjectors(ctx context.Context, mgr ctrl.Manager, opts SetupOptio
 		}
 		predicates := predicate.Funcs{
 			UpdateFunc: func(e event.UpdateEvent) bool {
-				return hasInjectableAnnotation(e.ObjectNew)
+				// Objects which no longer want injection are reconciled
+				// once more, so that injected CA data can be cleaned up.
+				return hasInjectableAnnotation(e.ObjectNew) || hasInjectableAnnotation(e.ObjectOld)
 			},
 			CreateFunc: func(e event.CreateEvent) bool {
 				return hasInjectableAnnotation(e.Object)
@@ -22,8 +22,10 @@ import (
 	"fmt"
 	"net"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
+	"text/template"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
@@ -33,6 +35,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/tools/record"
 	gwapi "sigs.k8s.io/gateway-api/apis/v1"
@@ -115,13 +118,29 @@ func SyncFnFor(
 			return nil
 		}
 
+		hostIssuers, err := hostIssuersForIngressLike(ingLike)
+		if err != nil {
+			log.Error(err, "failed to determine per-host issuers to be used for ingress resource")
+			rec.Eventf(ingLikeObj, corev1.EventTypeWarning, reasonBadConfig, "Could not determine per-host issuers for ingress due to bad annotations: %s",
+				err)
+			return nil
+		}
+
+		nameTemplate, err := certificateNameTemplateForIngressLike(ingLike)
+		if err != nil {
+			log.Error(err, "failed to parse certificate name template of ingress resource")
+			rec.Eventf(ingLikeObj, corev1.EventTypeWarning, reasonBadConfig, "Could not parse certificate name template for ingress due to bad annotations: %s",
+				err)
+			return nil
+		}
+
 		err = validateIngressLike(ingLike).ToAggregate()
 		if err != nil {
 			rec.Eventf(ingLikeObj, corev1.EventTypeWarning, reasonBadConfig, err.Error())
 			return nil
 		}
 
-		newCrts, updateCrts, err := buildCertificates(rec, log, cmLister, ingLike, issuerName, issuerKind, issuerGroup)
+		newCrts, updateCrts, err := buildCertificates(rec, log, cmLister, ingLike, issuerName, issuerKind, issuerGroup, hostIssuers, nameTemplate)
 		if err != nil {
 			return err
 		}
@@ -167,6 +186,7 @@ func SyncFnFor(
 			return err
 		}
 		unrequiredCertNames := findCertificatesToBeRemoved(certs, ingLike)
+		unrequiredCertNames = append(unrequiredCertNames, findRenamedCertificates(certs, ingLike, nameTemplate, unrequiredCertNames)...)
 
 		for _, certName := range unrequiredCertNames {
 			err = cmClient.CertmanagerV1().Certificates(ingLike.GetNamespace()).Delete(ctx, certName, metav1.DeleteOptions{})
@@ -263,12 +283,14 @@ func validateGatewayListenerBlock(path *field.Path, l gwapi.Listener, ingLike me
 	} else {
 		// check that each CertificateRef is valid
 		for i, secretRef := range l.TLS.CertificateRefs {
-			if *secretRef.Group != "core" && *secretRef.Group != "" {
+			// Group and Kind are defaulted by the Gateway API CRDs, but may
+			// still be unset, in which case they refer to a core Secret.
+			if secretRef.Group != nil && *secretRef.Group != "core" && *secretRef.Group != "" {
 				errs = append(errs, field.NotSupported(path.Child("tls").Child("certificateRef").Index(i).Child("group"),
 					*secretRef.Group, []string{"core", ""}))
 			}
 
-			if *secretRef.Kind != "Secret" && *secretRef.Kind != "" {
+			if secretRef.Kind != nil && *secretRef.Kind != "Secret" && *secretRef.Kind != "" {
 				errs = append(errs, field.NotSupported(path.Child("tls").Child("certificateRef").Index(i).Child("kind"),
 					*secretRef.Kind, []string{"Secret", ""}))
 			}
@@ -297,6 +319,8 @@ func buildCertificates(
 	cmLister cmlisters.CertificateLister,
 	ingLike metav1.Object,
 	issuerName, issuerKind, issuerGroup string,
+	hostIssuers map[string]cmmeta.ObjectReference,
+	nameTemplate *template.Template,
 ) (newCrts, updateCrts []*cmapi.Certificate, _ error) {
 	tlsHosts := make(map[corev1.ObjectReference][]string)
 	switch ingLike := ingLike.(type) {
@@ -337,15 +361,44 @@ func buildCertificates(
 				}
 				// Gateway API hostname explicitly disallows IP addresses, so this
 				// should be OK.
-				tlsHosts[secretRef] = append(tlsHosts[secretRef], string(*l.Hostname))
+				// Several listeners, e.g. on different ports, may share the
+				// same hostname and Secret, so only add each hostname once.
+				if !slices.Contains(tlsHosts[secretRef], string(*l.Hostname)) {
+					tlsHosts[secretRef] = append(tlsHosts[secretRef], string(*l.Hostname))
+				}
 			}
 		}
 	default:
 		return nil, nil, fmt.Errorf("buildCertificates: expected ingress or gateway, got %T", ingLike)
 	}
 
-	for secretRef, hosts := range tlsHosts {
-		existingCrt, err := cmLister.Certificates(secretRef.Namespace).Get(secretRef.Name)
+	// Iterate over the Secrets in a stable order so that the same Secret is
+	// skipped each time if the names of two Certificates collide.
+	secretRefs := make([]corev1.ObjectReference, 0, len(tlsHosts))
+	for secretRef := range tlsHosts {
+		secretRefs = append(secretRefs, secretRef)
+	}
+	slices.SortFunc(secretRefs, func(a, b corev1.ObjectReference) int {
+		return strings.Compare(a.Namespace+"/"+a.Name, b.Namespace+"/"+b.Name)
+	})
+
+	crtSecretNames := make(map[corev1.ObjectReference]string)
+	for _, secretRef := range secretRefs {
+		hosts := tlsHosts[secretRef]
+
+		crtName, err := certificateName(nameTemplate, ingLike, secretRef.Name)
+		if err != nil {
+			rec.Eventf(ingLike.(runtime.Object), corev1.EventTypeWarning, reasonBadConfig, "Skipped Secret %q: %s", secretRef.Name, err)
+			continue
+		}
+		crtRef := corev1.ObjectReference{Namespace: secretRef.Namespace, Name: crtName}
+		if otherSecretName, ok := crtSecretNames[crtRef]; ok {
+			rec.Eventf(ingLike.(runtime.Object), corev1.EventTypeWarning, reasonBadConfig, "Skipped Secret %q: Certificate name %q is already used for Secret %q", secretRef.Name, crtName, otherSecretName)
+			continue
+		}
+		crtSecretNames[crtRef] = secretRef.Name
+
+		existingCrt, err := cmLister.Certificates(secretRef.Namespace).Get(crtName)
 		if !apierrors.IsNotFound(err) && err != nil {
 			return nil, nil, err
 		}
@@ -358,6 +411,16 @@ func buildCertificates(
 			controllerGVK = gatewayGVK
 		}
 
+		issuerRef, err := issuerRefForHosts(hosts, hostIssuers, cmmeta.ObjectReference{
+			Name:  issuerName,
+			Kind:  issuerKind,
+			Group: issuerGroup,
+		})
+		if err != nil {
+			rec.Eventf(ingLike.(runtime.Object), corev1.EventTypeWarning, reasonBadConfig, "Skipped Secret %q: %s", secretRef.Name, err)
+			continue
+		}
+
 		var (
 			ipAddress, dnsNames []string
 		)
@@ -371,7 +434,7 @@ func buildCertificates(
 
 		crt := &cmapi.Certificate{
 			ObjectMeta: metav1.ObjectMeta{
-				Name:            secretRef.Name,
+				Name:            crtName,
 				Namespace:       secretRef.Namespace,
 				Labels:          ingLike.GetLabels(),
 				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(ingLike, controllerGVK)},
@@ -380,12 +443,8 @@ func buildCertificates(
 				DNSNames:    dnsNames,
 				IPAddresses: ipAddress,
 				SecretName:  secretRef.Name,
-				IssuerRef: cmmeta.ObjectReference{
-					Name:  issuerName,
-					Kind:  issuerKind,
-					Group: issuerGroup,
-				},
-				Usages: cmapi.DefaultKeyUsages(),
+				IssuerRef:   issuerRef,
+				Usages:      cmapi.DefaultKeyUsages(),
 			},
 		}
 
@@ -451,6 +510,28 @@ func findCertificatesToBeRemoved(certs []*cmapi.Certificate, ingLike metav1.Obje
 	return toBeRemoved
 }
 
+// findRenamedCertificates returns the names of the Certificates controlled by
+// ingLike whose Secret is still in use, but which no longer have the name
+// the certificate name template renders for that Secret.
+func findRenamedCertificates(certs []*cmapi.Certificate, ingLike metav1.Object, nameTemplate *template.Template, toBeRemoved []string) []string {
+	var renamed []string
+	for _, crt := range certs {
+		if !metav1.IsControlledBy(crt, ingLike) || slices.Contains(toBeRemoved, crt.Name) {
+			continue
+		}
+		name, err := certificateName(nameTemplate, ingLike, crt.Spec.SecretName)
+		if err != nil {
+			// The Secret is skipped while the template is invalid, so keep
+			// its existing Certificate.
+			continue
+		}
+		if name != crt.Name {
+			renamed = append(renamed, crt.Name)
+		}
+	}
+	return renamed
+}
+
 func secretNameUsedIn(secretName string, ingLike metav1.Object) bool {
 	switch o := ingLike.(type) {
 	case *networkingv1.Ingress:
@@ -687,6 +768,120 @@ func isDeletedInForeground(ingLike metav1.Object) bool {
 	return deletionTimestamp != nil || foregroundDeletion
 }
 
+// certificateNameTemplateForIngressLike parses the
+// IngressCertificateNameTemplateAnnotationKey annotation of the given
+// Ingress-like object. It returns nil if the annotation is not set.
+func certificateNameTemplateForIngressLike(ingLike metav1.Object) (*template.Template, error) {
+	value, ok := ingLike.GetAnnotations()[cmapi.IngressCertificateNameTemplateAnnotationKey]
+	if !ok {
+		return nil, nil
+	}
+
+	tmpl, err := template.New("certificate-name").Option("missingkey=error").Parse(value)
+	if err != nil {
+		return nil, fmt.Errorf("%q: %w", cmapi.IngressCertificateNameTemplateAnnotationKey, err)
+	}
+	return tmpl, nil
+}
+
+// certificateName returns the name of the Certificate generated for the
+// Secret with the given name. If nameTemplate is nil, the Certificate is named
+// after the Secret.
+func certificateName(nameTemplate *template.Template, ingLike metav1.Object, secretName string) (string, error) {
+	if nameTemplate == nil {
+		return secretName, nil
+	}
+
+	var b strings.Builder
+	err := nameTemplate.Execute(&b, struct {
+		Name       string
+		SecretName string
+	}{
+		Name:       ingLike.GetName(),
+		SecretName: secretName,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to render %q: %w", cmapi.IngressCertificateNameTemplateAnnotationKey, err)
+	}
+
+	name := b.String()
+	if errs := validation.IsDNS1123Label(name); len(errs) > 0 {
+		return "", fmt.Errorf("rendered certificate name %q is not a valid DNS-1123 label: %s", name, strings.Join(errs, ", "))
+	}
+	return name, nil
+}
+
+// hostIssuersForIngressLike parses the IngressHostIssuersAnnotationKey
+// annotation of the given Ingress-like object into a map of host to issuer.
+func hostIssuersForIngressLike(ingLike metav1.Object) (map[string]cmmeta.ObjectReference, error) {
+	value, ok := ingLike.GetAnnotations()[cmapi.IngressHostIssuersAnnotationKey]
+	if !ok {
+		return nil, nil
+	}
+
+	hostIssuers := make(map[string]cmmeta.ObjectReference)
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		host, issuer, ok := strings.Cut(entry, "=")
+		host, issuer = strings.TrimSpace(host), strings.TrimSpace(issuer)
+		if !ok || host == "" || issuer == "" {
+			return nil, fmt.Errorf("%q: invalid entry %q, expected <host>=<issuer name> or <host>=<kind>/<issuer name>", cmapi.IngressHostIssuersAnnotationKey, entry)
+		}
+
+		ref := cmmeta.ObjectReference{
+			Name:  issuer,
+			Kind:  cmapi.IssuerKind,
+			Group: cmapi.SchemeGroupVersion.Group,
+		}
+		if kind, name, ok := strings.Cut(issuer, "/"); ok {
+			if kind != cmapi.IssuerKind && kind != cmapi.ClusterIssuerKind {
+				return nil, fmt.Errorf("%q: invalid issuer kind %q for host %q, must be one of %s or %s", cmapi.IngressHostIssuersAnnotationKey, kind, host, cmapi.IssuerKind, cmapi.ClusterIssuerKind)
+			}
+			if name == "" {
+				return nil, fmt.Errorf("%q: missing issuer name for host %q", cmapi.IngressHostIssuersAnnotationKey, host)
+			}
+			ref.Kind, ref.Name = kind, name
+		}
+
+		if _, ok := hostIssuers[host]; ok {
+			return nil, fmt.Errorf("%q: host %q is specified more than once", cmapi.IngressHostIssuersAnnotationKey, host)
+		}
+		hostIssuers[host] = ref
+	}
+
+	return hostIssuers, nil
+}
+
+// issuerRefForHosts returns the issuer to be used for a Certificate with the
+// given hosts. Hosts without an entry in hostIssuers use defaultRef. An error
+// is returned if the hosts resolve to different issuers, since they share a
+// single Certificate.
+func issuerRefForHosts(hosts []string, hostIssuers map[string]cmmeta.ObjectReference, defaultRef cmmeta.ObjectReference) (cmmeta.ObjectReference, error) {
+	var issuerRef *cmmeta.ObjectReference
+	for _, host := range hosts {
+		ref, ok := hostIssuers[host]
+		if !ok {
+			ref = defaultRef
+		}
+		if issuerRef == nil {
+			issuerRef = &ref
+			continue
+		}
+		if *issuerRef != ref {
+			return cmmeta.ObjectReference{}, fmt.Errorf("hosts %q resolve to different issuers (%s %q and %s %q) but share a single Secret",
+				hosts, issuerRef.Kind, issuerRef.Name, ref.Kind, ref.Name)
+		}
+	}
+	if issuerRef == nil {
+		return defaultRef, nil
+	}
+	return *issuerRef, nil
+}
+
 // issuerForIngressLike determines the Issuer that should be specified on a
 // Certificate created for the given ingress-like resource. If one is not set,
 // the default issuer given to the controller is used. We look up the following
@@ -143,6 +143,412 @@ func TestSync(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name:   "return a Certificate per TLS entry using the per-host issuer overrides of a multi-host ingress",
+			Issuer: acmeClusterIssuer,
+			IngressLike: &networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "ingress-name",
+					Namespace: gen.DefaultTestNamespace,
+					Annotations: map[string]string{
+						cmapi.IngressClusterIssuerNameAnnotationKey: "issuer-name",
+						cmapi.IngressHostIssuersAnnotationKey:       "internal.example.com=ClusterIssuer/internal-ca, admin.example.com=team-ca",
+					},
+					UID: types.UID("ingress-name"),
+				},
+				Spec: networkingv1.IngressSpec{
+					TLS: []networkingv1.IngressTLS{
+						{
+							Hosts:      []string{"example.com", "www.example.com"},
+							SecretName: "example-com-tls",
+						},
+						{
+							Hosts:      []string{"internal.example.com"},
+							SecretName: "internal-example-com-tls",
+						},
+						{
+							Hosts:      []string{"admin.example.com"},
+							SecretName: "admin-example-com-tls",
+						},
+					},
+				},
+			},
+			ClusterIssuerLister: []runtime.Object{acmeClusterIssuer},
+			ExpectedEvents: []string{
+				`Normal CreateCertificate Successfully created Certificate "example-com-tls"`,
+				`Normal CreateCertificate Successfully created Certificate "internal-example-com-tls"`,
+				`Normal CreateCertificate Successfully created Certificate "admin-example-com-tls"`,
+			},
+			ExpectedCreate: []*cmapi.Certificate{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "example-com-tls",
+						Namespace:       gen.DefaultTestNamespace,
+						OwnerReferences: buildIngressOwnerReferences("ingress-name"),
+					},
+					Spec: cmapi.CertificateSpec{
+						DNSNames:   []string{"example.com", "www.example.com"},
+						SecretName: "example-com-tls",
+						IssuerRef: cmmeta.ObjectReference{
+							Name: "issuer-name",
+							Kind: "ClusterIssuer",
+						},
+						Usages: cmapi.DefaultKeyUsages(),
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "internal-example-com-tls",
+						Namespace:       gen.DefaultTestNamespace,
+						OwnerReferences: buildIngressOwnerReferences("ingress-name"),
+					},
+					Spec: cmapi.CertificateSpec{
+						DNSNames:   []string{"internal.example.com"},
+						SecretName: "internal-example-com-tls",
+						IssuerRef: cmmeta.ObjectReference{
+							Name:  "internal-ca",
+							Kind:  "ClusterIssuer",
+							Group: "cert-manager.io",
+						},
+						Usages: cmapi.DefaultKeyUsages(),
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "admin-example-com-tls",
+						Namespace:       gen.DefaultTestNamespace,
+						OwnerReferences: buildIngressOwnerReferences("ingress-name"),
+					},
+					Spec: cmapi.CertificateSpec{
+						DNSNames:   []string{"admin.example.com"},
+						SecretName: "admin-example-com-tls",
+						IssuerRef: cmmeta.ObjectReference{
+							Name:  "team-ca",
+							Kind:  "Issuer",
+							Group: "cert-manager.io",
+						},
+						Usages: cmapi.DefaultKeyUsages(),
+					},
+				},
+			},
+		},
+		{
+			Name:   "skip a TLS entry whose hosts resolve to different issuers using per-host issuer overrides",
+			Issuer: acmeClusterIssuer,
+			IngressLike: &networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "ingress-name",
+					Namespace: gen.DefaultTestNamespace,
+					Annotations: map[string]string{
+						cmapi.IngressClusterIssuerNameAnnotationKey: "issuer-name",
+						cmapi.IngressHostIssuersAnnotationKey:       "internal.example.com=ClusterIssuer/internal-ca",
+					},
+					UID: types.UID("ingress-name"),
+				},
+				Spec: networkingv1.IngressSpec{
+					TLS: []networkingv1.IngressTLS{
+						{
+							Hosts:      []string{"example.com", "internal.example.com"},
+							SecretName: "example-com-tls",
+						},
+					},
+				},
+			},
+			ClusterIssuerLister: []runtime.Object{acmeClusterIssuer},
+			ExpectedEvents: []string{
+				`Warning BadConfig Skipped Secret "example-com-tls": hosts ["example.com" "internal.example.com"] resolve to different issuers (ClusterIssuer "issuer-name" and ClusterIssuer "internal-ca") but share a single Secret`,
+			},
+		},
+		{
+			Name:   "should not create Certificates if the per-host issuer override annotation is invalid",
+			Issuer: acmeClusterIssuer,
+			IngressLike: &networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "ingress-name",
+					Namespace: gen.DefaultTestNamespace,
+					Annotations: map[string]string{
+						cmapi.IngressClusterIssuerNameAnnotationKey: "issuer-name",
+						cmapi.IngressHostIssuersAnnotationKey:       "internal.example.com=Vault/internal-ca",
+					},
+					UID: types.UID("ingress-name"),
+				},
+				Spec: networkingv1.IngressSpec{
+					TLS: []networkingv1.IngressTLS{
+						{
+							Hosts:      []string{"internal.example.com"},
+							SecretName: "internal-example-com-tls",
+						},
+					},
+				},
+			},
+			ClusterIssuerLister: []runtime.Object{acmeClusterIssuer},
+			ExpectedEvents: []string{
+				`Warning BadConfig Could not determine per-host issuers for ingress due to bad annotations: "cert-manager.io/host-issuers": invalid issuer kind "Vault" for host "internal.example.com", must be one of Issuer or ClusterIssuer`,
+			},
+		},
+		{
+			Name:   "return a Certificate named using the certificate name template of the ingress",
+			Issuer: acmeClusterIssuer,
+			IngressLike: &networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "ingress-name",
+					Namespace: gen.DefaultTestNamespace,
+					Annotations: map[string]string{
+						cmapi.IngressClusterIssuerNameAnnotationKey:       "issuer-name",
+						cmapi.IngressCertificateNameTemplateAnnotationKey: "{{ .Name }}-{{ .SecretName }}",
+					},
+					UID: types.UID("ingress-name"),
+				},
+				Spec: networkingv1.IngressSpec{
+					TLS: []networkingv1.IngressTLS{
+						{
+							Hosts:      []string{"example.com"},
+							SecretName: "example-com-tls",
+						},
+					},
+				},
+			},
+			ClusterIssuerLister: []runtime.Object{acmeClusterIssuer},
+			ExpectedEvents:      []string{`Normal CreateCertificate Successfully created Certificate "ingress-name-example-com-tls"`},
+			ExpectedCreate: []*cmapi.Certificate{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "ingress-name-example-com-tls",
+						Namespace:       gen.DefaultTestNamespace,
+						OwnerReferences: buildIngressOwnerReferences("ingress-name"),
+					},
+					Spec: cmapi.CertificateSpec{
+						DNSNames:   []string{"example.com"},
+						SecretName: "example-com-tls",
+						IssuerRef: cmmeta.ObjectReference{
+							Name: "issuer-name",
+							Kind: "ClusterIssuer",
+						},
+						Usages: cmapi.DefaultKeyUsages(),
+					},
+				},
+			},
+		},
+		{
+			Name:   "avoid colliding with the Certificate of another ingress sharing the same secret name using the certificate name template",
+			Issuer: acmeClusterIssuer,
+			IngressLike: &networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "other-ingress",
+					Namespace: gen.DefaultTestNamespace,
+					Annotations: map[string]string{
+						cmapi.IngressClusterIssuerNameAnnotationKey:       "issuer-name",
+						cmapi.IngressCertificateNameTemplateAnnotationKey: "{{ .Name }}-{{ .SecretName }}",
+					},
+					UID: types.UID("other-ingress"),
+				},
+				Spec: networkingv1.IngressSpec{
+					TLS: []networkingv1.IngressTLS{
+						{
+							Hosts:      []string{"example.com"},
+							SecretName: "example-com-tls",
+						},
+					},
+				},
+			},
+			ClusterIssuerLister: []runtime.Object{acmeClusterIssuer},
+			CertificateLister: []runtime.Object{
+				&cmapi.Certificate{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "example-com-tls",
+						Namespace:       gen.DefaultTestNamespace,
+						OwnerReferences: buildIngressOwnerReferences("ingress-name"),
+					},
+					Spec: cmapi.CertificateSpec{
+						DNSNames:   []string{"example.com"},
+						SecretName: "example-com-tls",
+						IssuerRef: cmmeta.ObjectReference{
+							Name: "issuer-name",
+							Kind: "ClusterIssuer",
+						},
+						Usages: cmapi.DefaultKeyUsages(),
+					},
+				},
+			},
+			ExpectedEvents: []string{`Normal CreateCertificate Successfully created Certificate "other-ingress-example-com-tls"`},
+			ExpectedCreate: []*cmapi.Certificate{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "other-ingress-example-com-tls",
+						Namespace:       gen.DefaultTestNamespace,
+						OwnerReferences: buildIngressOwnerReferences("other-ingress"),
+					},
+					Spec: cmapi.CertificateSpec{
+						DNSNames:   []string{"example.com"},
+						SecretName: "example-com-tls",
+						IssuerRef: cmmeta.ObjectReference{
+							Name: "issuer-name",
+							Kind: "ClusterIssuer",
+						},
+						Usages: cmapi.DefaultKeyUsages(),
+					},
+				},
+			},
+		},
+		{
+			Name:   "skip a TLS entry whose rendered certificate name collides with the one of another TLS entry",
+			Issuer: acmeClusterIssuer,
+			IngressLike: &networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "ingress-name",
+					Namespace: gen.DefaultTestNamespace,
+					Annotations: map[string]string{
+						cmapi.IngressClusterIssuerNameAnnotationKey:       "issuer-name",
+						cmapi.IngressCertificateNameTemplateAnnotationKey: "{{ .Name }}",
+					},
+					UID: types.UID("ingress-name"),
+				},
+				Spec: networkingv1.IngressSpec{
+					TLS: []networkingv1.IngressTLS{
+						{
+							Hosts:      []string{"example.com"},
+							SecretName: "a-example-com-tls",
+						},
+						{
+							Hosts:      []string{"www.example.com"},
+							SecretName: "b-example-com-tls",
+						},
+					},
+				},
+			},
+			ClusterIssuerLister: []runtime.Object{acmeClusterIssuer},
+			ExpectedEvents: []string{
+				`Normal CreateCertificate Successfully created Certificate "ingress-name"`,
+				`Warning BadConfig Skipped Secret "b-example-com-tls": Certificate name "ingress-name" is already used for Secret "a-example-com-tls"`,
+			},
+			ExpectedCreate: []*cmapi.Certificate{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "ingress-name",
+						Namespace:       gen.DefaultTestNamespace,
+						OwnerReferences: buildIngressOwnerReferences("ingress-name"),
+					},
+					Spec: cmapi.CertificateSpec{
+						DNSNames:   []string{"example.com"},
+						SecretName: "a-example-com-tls",
+						IssuerRef: cmmeta.ObjectReference{
+							Name: "issuer-name",
+							Kind: "ClusterIssuer",
+						},
+						Usages: cmapi.DefaultKeyUsages(),
+					},
+				},
+			},
+		},
+		{
+			Name:   "skip a TLS entry whose rendered certificate name is not a DNS-1123 label",
+			Issuer: acmeClusterIssuer,
+			IngressLike: &networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "ingress-name",
+					Namespace: gen.DefaultTestNamespace,
+					Annotations: map[string]string{
+						cmapi.IngressClusterIssuerNameAnnotationKey:       "issuer-name",
+						cmapi.IngressCertificateNameTemplateAnnotationKey: "{{ .SecretName }}.crt",
+					},
+					UID: types.UID("ingress-name"),
+				},
+				Spec: networkingv1.IngressSpec{
+					TLS: []networkingv1.IngressTLS{
+						{
+							Hosts:      []string{"example.com"},
+							SecretName: "example-com-tls",
+						},
+					},
+				},
+			},
+			ClusterIssuerLister: []runtime.Object{acmeClusterIssuer},
+			ExpectedEvents: []string{
+				`Warning BadConfig Skipped Secret "example-com-tls": rendered certificate name "example-com-tls.crt" is not a valid DNS-1123 label: must not contain dots`,
+			},
+		},
+		{
+			Name:   "delete the Certificate of a secret which was renamed by the certificate name template",
+			Issuer: acmeClusterIssuer,
+			IngressLike: &networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "ingress-name",
+					Namespace: gen.DefaultTestNamespace,
+					Annotations: map[string]string{
+						cmapi.IngressClusterIssuerNameAnnotationKey:       "issuer-name",
+						cmapi.IngressCertificateNameTemplateAnnotationKey: "{{ .Name }}-{{ .SecretName }}",
+					},
+					UID: types.UID("ingress-name"),
+				},
+				Spec: networkingv1.IngressSpec{
+					TLS: []networkingv1.IngressTLS{
+						{
+							Hosts:      []string{"example.com"},
+							SecretName: "example-com-tls",
+						},
+					},
+				},
+			},
+			ClusterIssuerLister: []runtime.Object{acmeClusterIssuer},
+			CertificateLister: []runtime.Object{
+				&cmapi.Certificate{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "example-com-tls",
+						Namespace:       gen.DefaultTestNamespace,
+						OwnerReferences: buildIngressOwnerReferences("ingress-name"),
+					},
+					Spec: cmapi.CertificateSpec{
+						DNSNames:   []string{"example.com"},
+						SecretName: "example-com-tls",
+						IssuerRef: cmmeta.ObjectReference{
+							Name: "issuer-name",
+							Kind: "ClusterIssuer",
+						},
+						Usages: cmapi.DefaultKeyUsages(),
+					},
+				},
+			},
+			ExpectedEvents: []string{
+				`Normal CreateCertificate Successfully created Certificate "ingress-name-example-com-tls"`,
+				`Normal DeleteCertificate Successfully deleted unrequired Certificate "example-com-tls"`,
+			},
+			ExpectedCreate: []*cmapi.Certificate{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "ingress-name-example-com-tls",
+						Namespace:       gen.DefaultTestNamespace,
+						OwnerReferences: buildIngressOwnerReferences("ingress-name"),
+					},
+					Spec: cmapi.CertificateSpec{
+						DNSNames:   []string{"example.com"},
+						SecretName: "example-com-tls",
+						IssuerRef: cmmeta.ObjectReference{
+							Name: "issuer-name",
+							Kind: "ClusterIssuer",
+						},
+						Usages: cmapi.DefaultKeyUsages(),
+					},
+				},
+			},
+			ExpectedDelete: []*cmapi.Certificate{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "example-com-tls",
+						Namespace:       gen.DefaultTestNamespace,
+						OwnerReferences: buildIngressOwnerReferences("ingress-name"),
+					},
+					Spec: cmapi.CertificateSpec{
+						DNSNames:   []string{"example.com"},
+						SecretName: "example-com-tls",
+						IssuerRef: cmmeta.ObjectReference{
+							Name: "issuer-name",
+							Kind: "ClusterIssuer",
+						},
+						Usages: cmapi.DefaultKeyUsages(),
+					},
+				},
+			},
+		},
 		{
 			Name:   "return a single Certificate for an ingress with dnsNames and ipv4 addresses",
 			Issuer: acmeClusterIssuer,
@@ -2983,6 +3389,73 @@ func TestSync(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name:         "if a Gateway contains listeners on different ports with the same hostname and secretName, it should add the hostname once",
+			Issuer:       acmeIssuer,
+			IssuerLister: []runtime.Object{acmeIssuer},
+			IngressLike: &gwapi.Gateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "gateway-name",
+					Namespace: gen.DefaultTestNamespace,
+					Annotations: map[string]string{
+						cmapi.IngressIssuerNameAnnotationKey: "issuer-name",
+						cmapi.IssuerKindAnnotationKey:        "Issuer",
+						cmapi.IssuerGroupAnnotationKey:       "cert-manager.io",
+					},
+					UID: types.UID("gateway-name"),
+				},
+				Spec: gwapi.GatewaySpec{
+					GatewayClassName: "test-gateway",
+					Listeners: []gwapi.Listener{{
+						Hostname: ptrHostname("example.com"),
+						Port:     443,
+						Protocol: gwapi.HTTPSProtocolType,
+						TLS: &gwapi.GatewayTLSConfig{
+							Mode: ptrMode(gwapi.TLSModeTerminate),
+							CertificateRefs: []gwapi.SecretObjectReference{
+								{
+									Name: "example-com-tls",
+								},
+							},
+						},
+					}, {
+						Hostname: ptrHostname("example.com"),
+						Port:     8443,
+						Protocol: gwapi.HTTPSProtocolType,
+						TLS: &gwapi.GatewayTLSConfig{
+							Mode: ptrMode(gwapi.TLSModeTerminate),
+							CertificateRefs: []gwapi.SecretObjectReference{
+								{
+									Name: "example-com-tls",
+								},
+							},
+						},
+					}},
+				},
+			},
+			ExpectedEvents: []string{
+				`Normal CreateCertificate Successfully created Certificate "example-com-tls"`,
+			},
+			ExpectedCreate: []*cmapi.Certificate{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "example-com-tls",
+						Namespace:       gen.DefaultTestNamespace,
+						OwnerReferences: buildGatewayOwnerReferences("gateway-name"),
+					},
+					Spec: cmapi.CertificateSpec{
+						DNSNames:   []string{"example.com"},
+						SecretName: "example-com-tls",
+						IssuerRef: cmmeta.ObjectReference{
+							Name:  "issuer-name",
+							Kind:  "Issuer",
+							Group: "cert-manager.io",
+						},
+						Usages: cmapi.DefaultKeyUsages(),
+					},
+				},
+			},
+		},
 		{
 			Name:         "if a Gateway contains two listeners with different Secret names, it should create two Certificates",
 			Issuer:       acmeIssuer,
@@ -3480,6 +3953,29 @@ func Test_validateGatewayListenerBlock(t *testing.T) {
 			// no group is now supported
 			wantErr: "",
 		},
+		{
+			name: "unset group and kind",
+			ingLike: &gwapi.Gateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "example",
+					Namespace: gen.DefaultTestNamespace,
+				},
+			},
+			listener: gwapi.Listener{
+				Hostname: ptrHostname("example.com"),
+				Port:     gwapi.PortNumber(443),
+				Protocol: gwapi.HTTPSProtocolType,
+				TLS: &gwapi.GatewayTLSConfig{
+					Mode: ptrMode(gwapi.TLSModeTerminate),
+					CertificateRefs: []gwapi.SecretObjectReference{
+						{
+							Name: "example-com",
+						},
+					},
+				},
+			},
+			wantErr: "",
+		},
 		{
 			name: "unsupported group",
 			listener: gwapi.Listener{
@@ -3787,3 +4283,90 @@ func Test_isDeletedInForeground(t *testing.T) {
 		}
 	})
 }
+
+func Test_hostIssuersForIngressLike(t *testing.T) {
+	tests := map[string]struct {
+		annotations map[string]string
+		want        map[string]cmmeta.ObjectReference
+		wantErr     string
+	}{
+		"no annotation": {},
+		"issuer names with and without kind": {
+			annotations: map[string]string{
+				cmapi.IngressHostIssuersAnnotationKey: "a.example.com=ca, b.example.com=ClusterIssuer/acme,",
+			},
+			want: map[string]cmmeta.ObjectReference{
+				"a.example.com": {Name: "ca", Kind: "Issuer", Group: "cert-manager.io"},
+				"b.example.com": {Name: "acme", Kind: "ClusterIssuer", Group: "cert-manager.io"},
+			},
+		},
+		"entry without issuer": {
+			annotations: map[string]string{
+				cmapi.IngressHostIssuersAnnotationKey: "a.example.com",
+			},
+			wantErr: `"cert-manager.io/host-issuers": invalid entry "a.example.com", expected <host>=<issuer name> or <host>=<kind>/<issuer name>`,
+		},
+		"entry without issuer name": {
+			annotations: map[string]string{
+				cmapi.IngressHostIssuersAnnotationKey: "a.example.com=Issuer/",
+			},
+			wantErr: `"cert-manager.io/host-issuers": missing issuer name for host "a.example.com"`,
+		},
+		"duplicate host": {
+			annotations: map[string]string{
+				cmapi.IngressHostIssuersAnnotationKey: "a.example.com=ca,a.example.com=acme",
+			},
+			wantErr: `"cert-manager.io/host-issuers": host "a.example.com" is specified more than once`,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ing := &networkingv1.Ingress{ObjectMeta: metav1.ObjectMeta{Annotations: test.annotations}}
+			got, err := hostIssuersForIngressLike(ing)
+			if test.wantErr != "" {
+				assert.EqualError(t, err, test.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func Test_certificateName(t *testing.T) {
+	tests := map[string]struct {
+		template string
+		want     string
+		wantErr  string
+	}{
+		"no template": {
+			want: "example-com-tls",
+		},
+		"template using the ingress and secret names": {
+			template: "{{ .Name }}-{{ .SecretName }}",
+			want:     "ingress-name-example-com-tls",
+		},
+		"template referring to an unknown field": {
+			template: "{{ .Namespace }}",
+			wantErr:  `failed to render "cert-manager.io/certificate-name-template": template: certificate-name:1:3: executing "certificate-name" at <.Namespace>: can't evaluate field Namespace in type struct { Name string; SecretName string }`,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ing := buildIngress("ingress-name", gen.DefaultTestNamespace, nil)
+			if test.template != "" {
+				ing.Annotations = map[string]string{cmapi.IngressCertificateNameTemplateAnnotationKey: test.template}
+			}
+			tmpl, err := certificateNameTemplateForIngressLike(ing)
+			assert.NoError(t, err)
+
+			got, err := certificateName(tmpl, ing, "example-com-tls")
+			if test.wantErr != "" {
+				assert.EqualError(t, err, test.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
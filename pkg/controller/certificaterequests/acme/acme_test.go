@@ -189,12 +189,12 @@ func TestSign(t *testing.T) {
 		t.Fatal(err)
 	}
 	ipBaseCR := gen.CertificateRequestFrom(baseCR, gen.SetCertificateRequestCSR(ipCSRPEM))
-	ipBaseOrder, err := buildOrder(ipBaseCR, ipCSR, baseIssuer.GetSpec().ACME.EnableDurationFeature)
+	ipBaseOrder, err := buildOrder(ipBaseCR, ipCSR, baseIssuer.GetSpec().ACME)
 	if err != nil {
 		t.Fatalf("failed to build order during testing: %s", err)
 	}
 
-	baseOrder, err := buildOrder(baseCR, csr, baseIssuer.GetSpec().ACME.EnableDurationFeature)
+	baseOrder, err := buildOrder(baseCR, csr, baseIssuer.GetSpec().ACME)
 	if err != nil {
 		t.Fatalf("failed to build order during testing: %s", err)
 	}
@@ -226,10 +226,11 @@ func TestSign(t *testing.T) {
 								Type:               cmapi.CertificateRequestConditionReady,
 								Status:             cmmeta.ConditionFalse,
 								Reason:             "Denied",
-								Message:            "The CertificateRequest was denied by an approval controller",
+								Message:            `The CertificateRequest was denied by an approval controller with reason "Foo": Certificate request has been denied by cert-manager.io`,
 								LastTransitionTime: &metaFixedClockStart,
 							}),
 							gen.SetCertificateRequestFailureTime(metaFixedClockStart),
+							gen.SetCertificateRequestDenied("Foo", metaFixedClockStart),
 						),
 					)),
 				},
@@ -259,6 +260,11 @@ func TestSign(t *testing.T) {
 								Message:            "Failed to decode CSR in spec.request: error decoding certificate request PEM block",
 								LastTransitionTime: &metaFixedClockStart,
 							}),
+							gen.AddCertificateRequestIssuanceFailure(cmapi.CertificateRequestIssuanceFailure{
+								Time:    metaFixedClockStart,
+								Reason:  "RequestParsingError",
+								Message: "Failed to decode CSR in spec.request: error decoding certificate request PEM block",
+							}),
 							gen.SetCertificateRequestFailureTime(metaFixedClockStart),
 						),
 					)),
@@ -288,6 +294,11 @@ func TestSign(t *testing.T) {
 								Message:            `The CSR PEM requests a commonName that is not present in the list of dnsNames or ipAddresses. If a commonName is set, ACME requires that the value is also present in the list of dnsNames or ipAddresses: "example.com" does not exist in [foo.com] or []`,
 								LastTransitionTime: &metaFixedClockStart,
 							}),
+							gen.AddCertificateRequestIssuanceFailure(cmapi.CertificateRequestIssuanceFailure{
+								Time:    metaFixedClockStart,
+								Reason:  "InvalidOrder",
+								Message: `The CSR PEM requests a commonName that is not present in the list of dnsNames or ipAddresses. If a commonName is set, ACME requires that the value is also present in the list of dnsNames or ipAddresses: "example.com" does not exist in [foo.com] or []`,
+							}),
 							gen.SetCertificateRequestFailureTime(metaFixedClockStart),
 						),
 					)),
@@ -318,6 +329,11 @@ func TestSign(t *testing.T) {
 								Message:            `The CSR PEM requests a commonName that is not present in the list of dnsNames or ipAddresses. If a commonName is set, ACME requires that the value is also present in the list of dnsNames or ipAddresses: "10.0.0.1" does not exist in [example.com] or []`,
 								LastTransitionTime: &metaFixedClockStart,
 							}),
+							gen.AddCertificateRequestIssuanceFailure(cmapi.CertificateRequestIssuanceFailure{
+								Time:    metaFixedClockStart,
+								Reason:  "InvalidOrder",
+								Message: `The CSR PEM requests a commonName that is not present in the list of dnsNames or ipAddresses. If a commonName is set, ACME requires that the value is also present in the list of dnsNames or ipAddresses: "10.0.0.1" does not exist in [example.com] or []`,
+							}),
 							gen.SetCertificateRequestFailureTime(metaFixedClockStart),
 						),
 					)),
@@ -441,6 +457,11 @@ func TestSign(t *testing.T) {
 								Message:            "Failed to get order resource default-unit-test-ns/test-cr-1733622556: this is a network error",
 								LastTransitionTime: &metaFixedClockStart,
 							}),
+							gen.AddCertificateRequestIssuanceFailure(cmapi.CertificateRequestIssuanceFailure{
+								Time:    metaFixedClockStart,
+								Reason:  "OrderGetError",
+								Message: "Failed to get order resource default-unit-test-ns/test-cr-1733622556: this is a network error",
+							}),
 						),
 					)),
 				},
@@ -482,6 +503,11 @@ func TestSign(t *testing.T) {
 								Message:            `Failed to wait for order resource "test-cr-1733622556" to become ready: order is in "invalid" state: simulated failure`,
 								LastTransitionTime: &metaFixedClockStart,
 							}),
+							gen.AddCertificateRequestIssuanceFailure(cmapi.CertificateRequestIssuanceFailure{
+								Time:    metaFixedClockStart,
+								Reason:  "OrderFailed",
+								Message: `Failed to wait for order resource "test-cr-1733622556" to become ready: order is in "invalid" state: simulated failure`,
+							}),
 							gen.SetCertificateRequestFailureTime(metaFixedClockStart),
 						),
 					)),
@@ -675,9 +701,9 @@ func Test_buildOrder(t *testing.T) {
 
 	cr := gen.CertificateRequest("test", gen.SetCertificateRequestDuration(&metav1.Duration{Duration: time.Hour}), gen.SetCertificateRequestCSR(csrPEM))
 	type args struct {
-		cr                    *v1.CertificateRequest
-		csr                   *x509.CertificateRequest
-		enableDurationFeature bool
+		cr       *v1.CertificateRequest
+		csr      *x509.CertificateRequest
+		acmeSpec *cmacme.ACMEIssuer
 	}
 	tests := []struct {
 		name    string
@@ -688,9 +714,9 @@ func Test_buildOrder(t *testing.T) {
 		{
 			name: "Normal building of order",
 			args: args{
-				cr:                    cr,
-				csr:                   csr,
-				enableDurationFeature: false,
+				cr:       cr,
+				csr:      csr,
+				acmeSpec: &cmacme.ACMEIssuer{},
 			},
 			want: &cmacme.Order{
 				Spec: cmacme.OrderSpec{
@@ -704,9 +730,9 @@ func Test_buildOrder(t *testing.T) {
 		{
 			name: "Building with enableDurationFeature",
 			args: args{
-				cr:                    cr,
-				csr:                   csr,
-				enableDurationFeature: true,
+				cr:       cr,
+				csr:      csr,
+				acmeSpec: &cmacme.ACMEIssuer{EnableDurationFeature: true},
 			},
 			want: &cmacme.Order{
 				Spec: cmacme.OrderSpec{
@@ -718,10 +744,46 @@ func Test_buildOrder(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Building with an ACME profile",
+			args: args{
+				cr:       cr,
+				csr:      csr,
+				acmeSpec: &cmacme.ACMEIssuer{Profile: "shortlived"},
+			},
+			want: &cmacme.Order{
+				Spec: cmacme.OrderSpec{
+					Request:    csrPEM,
+					CommonName: "example.com",
+					DNSNames:   []string{"example.com"},
+					Profile:    "shortlived",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Building for a request replacing a certificate",
+			args: args{
+				cr: gen.CertificateRequestFrom(cr, gen.SetCertificateRequestAnnotations(map[string]string{
+					cmacme.ACMEReplacesAnnotationKey: "aYhba4dGQEHhs3uEe6CuLN4ByNQ.AIdlQyE",
+				})),
+				csr:      csr,
+				acmeSpec: &cmacme.ACMEIssuer{},
+			},
+			want: &cmacme.Order{
+				Spec: cmacme.OrderSpec{
+					Request:    csrPEM,
+					CommonName: "example.com",
+					DNSNames:   []string{"example.com"},
+					Replaces:   "aYhba4dGQEHhs3uEe6CuLN4ByNQ.AIdlQyE",
+				},
+			},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := buildOrder(tt.args.cr, tt.args.csr, tt.args.enableDurationFeature)
+			got, err := buildOrder(tt.args.cr, tt.args.csr, tt.args.acmeSpec)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("buildOrder() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -738,7 +800,7 @@ func Test_buildOrder(t *testing.T) {
 		"test-comparison-that-is-at-the-fifty-two-character-l",
 		gen.SetCertificateRequestDuration(&metav1.Duration{Duration: time.Hour}),
 		gen.SetCertificateRequestCSR(csrPEM))
-	orderOne, err := buildOrder(longCrOne, csr, false)
+	orderOne, err := buildOrder(longCrOne, csr, &cmacme.ACMEIssuer{})
 	if err != nil {
 		t.Errorf("buildOrder() received error %v", err)
 		return
@@ -750,7 +812,7 @@ func Test_buildOrder(t *testing.T) {
 			gen.SetCertificateRequestDuration(&metav1.Duration{Duration: time.Hour}),
 			gen.SetCertificateRequestCSR(csrPEM))
 
-		orderTwo, err := buildOrder(longCrTwo, csr, false)
+		orderTwo, err := buildOrder(longCrTwo, csr, &cmacme.ACMEIssuer{})
 		if err != nil {
 			t.Errorf("buildOrder() received error %v", err)
 			return
@@ -765,13 +827,13 @@ func Test_buildOrder(t *testing.T) {
 	})
 
 	t.Run("Builds two orders from the same long CRs to guarantee same name", func(t *testing.T) {
-		orderOne, err := buildOrder(longCrOne, csr, false)
+		orderOne, err := buildOrder(longCrOne, csr, &cmacme.ACMEIssuer{})
 		if err != nil {
 			t.Errorf("buildOrder() received error %v", err)
 			return
 		}
 
-		orderTwo, err := buildOrder(longCrOne, csr, false)
+		orderTwo, err := buildOrder(longCrOne, csr, &cmacme.ACMEIssuer{})
 		if err != nil {
 			t.Errorf("buildOrder() received error %v", err)
 			return
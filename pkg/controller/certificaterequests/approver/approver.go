@@ -22,14 +22,21 @@ import (
 
 	"github.com/go-logr/logr"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 
+	"github.com/cert-manager/cert-manager/internal/controller/feature"
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	"github.com/cert-manager/cert-manager/pkg/apis/certmanager"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
 	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
 	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/issuer"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 )
 
 const (
@@ -41,6 +48,9 @@ const (
 // will _always_ set the "Approved" condition to True. All CertificateRequest
 // signing controllers should wait until the "Approved" condition is set to
 // True before processing.
+// If the IssuerAllowedRequesters feature gate is enabled, requests for an
+// issuer which restricts its requesters are only approved if the requesting
+// identity is allowed.
 type Controller struct {
 	// logger to be used by this controller
 	log logr.Logger
@@ -49,6 +59,10 @@ type Controller struct {
 	cmClient                 cmclient.Interface
 	fieldManager             string
 
+	// helper is used to read the issuer referenced by a CertificateRequest.
+	// It is only set if the IssuerAllowedRequesters feature gate is enabled.
+	helper issuer.Helper
+
 	recorder record.EventRecorder
 
 	queue workqueue.RateLimitingInterface
@@ -74,6 +88,26 @@ func (c *Controller) Register(ctx *controllerpkg.Context) (workqueue.RateLimitin
 	certificateRequestInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: c.queue})
 
 	c.certificateRequestLister = certificateRequestInformer.Lister()
+
+	if utilfeature.DefaultFeatureGate.Enabled(feature.IssuerAllowedRequesters) {
+		// Requests which are left Pending must be re-evaluated when the
+		// issuer they reference is created or its annotations change.
+		issuerInformer := ctx.SharedInformerFactory.Certmanager().V1().Issuers()
+		issuerInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{WorkFunc: c.handleGenericIssuer})
+		mustSync = append(mustSync, issuerInformer.Informer().HasSynced)
+
+		// if we are running in non-namespaced mode (i.e. --namespace=""), we also
+		// register event handlers and obtain a lister for clusterissuers.
+		var clusterIssuerLister cmlisters.ClusterIssuerLister
+		if ctx.Namespace == "" {
+			clusterIssuerInformer := ctx.SharedInformerFactory.Certmanager().V1().ClusterIssuers()
+			clusterIssuerInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{WorkFunc: c.handleGenericIssuer})
+			mustSync = append(mustSync, clusterIssuerInformer.Informer().HasSynced)
+			clusterIssuerLister = clusterIssuerInformer.Lister()
+		}
+
+		c.helper = issuer.NewHelper(issuerInformer.Lister(), clusterIssuerLister)
+	}
 	c.cmClient = ctx.CMClient
 	c.fieldManager = ctx.FieldManager
 	c.recorder = ctx.Recorder
@@ -106,3 +140,52 @@ func (c *Controller) ProcessItem(ctx context.Context, key string) error {
 	ctx = logf.NewContext(ctx, logf.WithResource(log, cr))
 	return c.Sync(ctx, cr)
 }
+
+// handleGenericIssuer enqueues all CertificateRequests which reference the
+// given Issuer or ClusterIssuer and have not yet been approved or denied.
+func (c *Controller) handleGenericIssuer(obj interface{}) {
+	log := c.log.WithName("handleGenericIssuer")
+
+	iss, ok := obj.(cmapi.GenericIssuer)
+	if !ok {
+		log.Error(nil, "object does not implement GenericIssuer")
+		return
+	}
+
+	log = logf.WithResource(log, iss)
+	crs, err := c.certificateRequestLister.List(labels.Everything())
+	if err != nil {
+		log.Error(err, "error listing certificate requests")
+		return
+	}
+
+	_, isClusterIssuer := iss.(*cmapi.ClusterIssuer)
+	for _, cr := range crs {
+		if !referencesIssuer(cr, iss, isClusterIssuer) {
+			continue
+		}
+		if apiutil.CertificateRequestIsApproved(cr) || apiutil.CertificateRequestIsDenied(cr) {
+			continue
+		}
+		key, err := controllerpkg.KeyFunc(cr)
+		if err != nil {
+			logf.WithRelatedResource(log, cr).Error(err, "error computing key for resource")
+			continue
+		}
+		c.queue.Add(key)
+	}
+}
+
+func referencesIssuer(cr *cmapi.CertificateRequest, iss cmapi.GenericIssuer, isClusterIssuer bool) bool {
+	ref := cr.Spec.IssuerRef
+	if ref.Group != "" && ref.Group != certmanager.GroupName {
+		return false
+	}
+	if ref.Name != iss.GetObjectMeta().Name {
+		return false
+	}
+	if isClusterIssuer {
+		return ref.Kind == cmapi.ClusterIssuerKind
+	}
+	return (ref.Kind == "" || ref.Kind == cmapi.IssuerKind) && cr.Namespace == iss.GetObjectMeta().Namespace
+}
@@ -22,13 +22,17 @@ import (
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	coretesting "k8s.io/client-go/testing"
+	featuregatetesting "k8s.io/component-base/featuregate/testing"
 	fakeclock "k8s.io/utils/clock/testing"
 
+	"github.com/cert-manager/cert-manager/internal/controller/feature"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
 	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 )
 
 func TestProcessItem(t *testing.T) {
@@ -235,3 +239,201 @@ func TestProcessItem(t *testing.T) {
 		})
 	}
 }
+
+func TestProcessItemIssuerAllowedRequesters(t *testing.T) {
+	now := time.Now()
+	metaNow := metav1.NewTime(now)
+
+	approvedCondition := cmapi.CertificateRequestCondition{
+		Type:               cmapi.CertificateRequestConditionApproved,
+		Status:             cmmeta.ConditionTrue,
+		Reason:             "cert-manager.io",
+		Message:            ApprovedMessage,
+		LastTransitionTime: &metaNow,
+	}
+	approvedEvent := "Normal cert-manager.io Certificate request has been approved by cert-manager.io"
+
+	allowlist := map[string]string{
+		cmapi.IssuerAllowedRequestersAnnotationKey: "system:serviceaccount:cert-manager:cert-manager, system:serviceaccount:team-a:*",
+	}
+	issuer := &cmapi.Issuer{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test-issuer", Annotations: allowlist},
+	}
+	clusterIssuer := &cmapi.ClusterIssuer{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-issuer", Annotations: allowlist},
+	}
+	unrestrictedIssuer := &cmapi.Issuer{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test-issuer"},
+	}
+
+	request := func(username string, ref cmmeta.ObjectReference) *cmapi.CertificateRequest {
+		return &cmapi.CertificateRequest{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test"},
+			Spec: cmapi.CertificateRequestSpec{
+				Username:  username,
+				IssuerRef: ref,
+			},
+		}
+	}
+	issuerRef := cmmeta.ObjectReference{Name: "test-issuer", Kind: cmapi.IssuerKind, Group: "cert-manager.io"}
+	clusterIssuerRef := cmmeta.ObjectReference{Name: "test-issuer", Kind: cmapi.ClusterIssuerKind}
+
+	tests := map[string]struct {
+		featureEnabled bool
+		issuers        []runtime.Object
+		request        *cmapi.CertificateRequest
+
+		expectApproved bool
+		expectedEvent  string
+	}{
+		"approve a request from an exactly matching identity": {
+			featureEnabled: true,
+			issuers:        []runtime.Object{issuer},
+			request:        request("system:serviceaccount:cert-manager:cert-manager", issuerRef),
+			expectApproved: true,
+			expectedEvent:  approvedEvent,
+		},
+		"approve a request from a ServiceAccount matching a wildcard": {
+			featureEnabled: true,
+			issuers:        []runtime.Object{issuer},
+			request:        request("system:serviceaccount:team-a:builder", issuerRef),
+			expectApproved: true,
+			expectedEvent:  approvedEvent,
+		},
+		"approve a request allowed by a ClusterIssuer": {
+			featureEnabled: true,
+			issuers:        []runtime.Object{clusterIssuer},
+			request:        request("system:serviceaccount:team-a:builder", clusterIssuerRef),
+			expectApproved: true,
+			expectedEvent:  approvedEvent,
+		},
+		"leave a request from an unexpected ServiceAccount pending": {
+			featureEnabled: true,
+			issuers:        []runtime.Object{issuer},
+			request:        request("system:serviceaccount:team-b:builder", issuerRef),
+			expectedEvent:  `Warning RequesterNotAllowed Requesting identity "system:serviceaccount:team-b:builder" is not allowed by the "cert-manager.io/allowed-requesters" annotation on the referenced issuer`,
+		},
+		"leave a request from an unexpected user pending on a ClusterIssuer": {
+			featureEnabled: true,
+			issuers:        []runtime.Object{clusterIssuer},
+			request:        request("alice", clusterIssuerRef),
+			expectedEvent:  `Warning RequesterNotAllowed Requesting identity "alice" is not allowed by the "cert-manager.io/allowed-requesters" annotation on the referenced issuer`,
+		},
+		"leave a request pending if the issuer does not exist": {
+			featureEnabled: true,
+			request:        request("system:serviceaccount:team-a:builder", issuerRef),
+		},
+		"approve any request if the issuer is not annotated": {
+			featureEnabled: true,
+			issuers:        []runtime.Object{unrestrictedIssuer},
+			request:        request("alice", issuerRef),
+			expectApproved: true,
+			expectedEvent:  approvedEvent,
+		},
+		"approve any request for an external issuer": {
+			featureEnabled: true,
+			request:        request("alice", cmmeta.ObjectReference{Name: "test-issuer", Kind: "Issuer", Group: "example.com"}),
+			expectApproved: true,
+			expectedEvent:  approvedEvent,
+		},
+		"approve any request if the feature gate is disabled": {
+			issuers:        []runtime.Object{issuer},
+			request:        request("system:serviceaccount:team-b:builder", issuerRef),
+			expectApproved: true,
+			expectedEvent:  approvedEvent,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultFeatureGate, feature.IssuerAllowedRequesters, test.featureEnabled)()
+
+			builder := &testpkg.Builder{
+				T:                  t,
+				Clock:              fakeclock.NewFakeClock(now),
+				CertManagerObjects: append([]runtime.Object{test.request}, test.issuers...),
+			}
+			if test.expectApproved {
+				expectedRequest := test.request.DeepCopy()
+				expectedRequest.Status.Conditions = []cmapi.CertificateRequestCondition{approvedCondition}
+				builder.ExpectedActions = append(builder.ExpectedActions,
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						test.request.Namespace,
+						expectedRequest,
+					)),
+				)
+			}
+			if test.expectedEvent != "" {
+				builder.ExpectedEvents = []string{test.expectedEvent}
+			}
+			builder.Init()
+
+			c := new(Controller)
+			if _, _, err := c.Register(builder.Context); err != nil {
+				t.Fatal(err)
+			}
+			builder.Start()
+			defer builder.Stop()
+
+			key, err := controllerpkg.KeyFunc(test.request)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := c.ProcessItem(context.Background(), key); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			if err := builder.AllEventsCalled(); err != nil {
+				builder.T.Error(err)
+			}
+			if err := builder.AllActionsExecuted(); err != nil {
+				builder.T.Error(err)
+			}
+		})
+	}
+}
+
+func TestRequesterMatches(t *testing.T) {
+	tests := map[string]struct {
+		allowlist string
+		username  string
+		want      bool
+	}{
+		"exact match": {
+			allowlist: "system:serviceaccount:ns:sa",
+			username:  "system:serviceaccount:ns:sa",
+			want:      true,
+		},
+		"wildcard ServiceAccount name": {
+			allowlist: "system:serviceaccount:ns:cert-*",
+			username:  "system:serviceaccount:ns:cert-requester",
+			want:      true,
+		},
+		"wildcard does not match another namespace": {
+			allowlist: "system:serviceaccount:ns:*",
+			username:  "system:serviceaccount:other:sa",
+		},
+		"matches any entry in the list": {
+			allowlist: "alice, system:serviceaccount:ns:*",
+			username:  "system:serviceaccount:ns:sa",
+			want:      true,
+		},
+		"empty allowlist allows nobody": {
+			allowlist: "",
+			username:  "alice",
+		},
+		"malformed pattern is ignored": {
+			allowlist: "[, alice",
+			username:  "alice",
+			want:      true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := requesterMatches(test.allowlist, test.username); got != test.want {
+				t.Errorf("requesterMatches(%q, %q) = %t, want %t", test.allowlist, test.username, got, test.want)
+			}
+		})
+	}
+}
@@ -18,13 +18,18 @@ package approver
 
 import (
 	"context"
+	"fmt"
+	"path"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	internalcertificaterequests "github.com/cert-manager/cert-manager/internal/controller/certificaterequests"
 	"github.com/cert-manager/cert-manager/internal/controller/feature"
 	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	"github.com/cert-manager/cert-manager/pkg/apis/certmanager"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
@@ -33,11 +38,16 @@ import (
 
 const (
 	ApprovedMessage = "Certificate request has been approved by cert-manager.io"
+
+	reasonRequesterNotAllowed = "RequesterNotAllowed"
 )
 
 // Sync will set the "Approved" condition to True on synced
 // CertificateRequests. If the "Denied", "Approved" or "Ready" condition
 // already exists, exit early.
+// If the IssuerAllowedRequesters feature gate is enabled and the requesting
+// identity is not allowed by the referenced issuer, the CertificateRequest is
+// left untouched so that it stays Pending.
 func (c *Controller) Sync(ctx context.Context, cr *cmapi.CertificateRequest) (err error) {
 	log := logf.FromContext(ctx, "approver")
 
@@ -55,6 +65,13 @@ func (c *Controller) Sync(ctx context.Context, cr *cmapi.CertificateRequest) (er
 		return nil
 	}
 
+	if c.helper != nil {
+		allowed, err := c.requesterAllowed(ctx, cr)
+		if err != nil || !allowed {
+			return err
+		}
+	}
+
 	// Update the CertificateRequest approved condition to true.
 	cr = cr.DeepCopy()
 	apiutil.SetCertificateRequestCondition(cr,
@@ -75,6 +92,59 @@ func (c *Controller) Sync(ctx context.Context, cr *cmapi.CertificateRequest) (er
 	return nil
 }
 
+// requesterAllowed returns true if the issuer referenced by the
+// CertificateRequest allows its requesting identity. Requests for issuers
+// which are not part of the cert-manager.io group, or which are not annotated
+// with an allowlist, are always allowed. Requests for an issuer which does not
+// exist are not allowed until it has been created.
+func (c *Controller) requesterAllowed(ctx context.Context, cr *cmapi.CertificateRequest) (bool, error) {
+	log := logf.FromContext(ctx, "approver").V(logf.DebugLevel)
+
+	ref := cr.Spec.IssuerRef
+	if ref.Group != "" && ref.Group != certmanager.GroupName {
+		return true, nil
+	}
+
+	iss, err := c.helper.GetGenericIssuer(ref, cr.Namespace)
+	if apierrors.IsNotFound(err) {
+		log.Info("referenced issuer does not exist, leaving certificate request pending", "issuer", ref.Name, "kind", ref.Kind)
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	allowlist, ok := iss.GetObjectMeta().Annotations[cmapi.IssuerAllowedRequestersAnnotationKey]
+	if !ok {
+		return true, nil
+	}
+	if requesterMatches(allowlist, cr.Spec.Username) {
+		return true, nil
+	}
+
+	log.Info("requesting identity is not allowed by the referenced issuer, leaving certificate request pending", "username", cr.Spec.Username)
+	c.recorder.Event(cr, corev1.EventTypeWarning, reasonRequesterNotAllowed,
+		fmt.Sprintf("Requesting identity %q is not allowed by the %q annotation on the referenced issuer", cr.Spec.Username, cmapi.IssuerAllowedRequestersAnnotationKey))
+	return false, nil
+}
+
+// requesterMatches returns true if the username matches any of the patterns
+// in the comma-separated allowlist. Patterns use path.Match syntax, so that
+// for example "system:serviceaccount:team-a:*" matches all ServiceAccounts in
+// the team-a namespace.
+func requesterMatches(allowlist, username string) bool {
+	for _, pattern := range strings.Split(allowlist, ",") {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			continue
+		}
+		if ok, err := path.Match(pattern, username); err == nil && ok {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *Controller) updateStatusOrApply(ctx context.Context, cr *cmapi.CertificateRequest) error {
 	if utilfeature.DefaultFeatureGate.Enabled(feature.ServerSideApply) {
 		return internalcertificaterequests.ApplyStatus(ctx, c.cmClient, c.fieldManager, cr)
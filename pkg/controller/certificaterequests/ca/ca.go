@@ -20,9 +20,14 @@ import (
 	"context"
 	"crypto"
 	"crypto/x509"
+	"errors"
 	"fmt"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/record"
 
 	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
 	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
@@ -31,6 +36,7 @@ import (
 	"github.com/cert-manager/cert-manager/pkg/controller/certificaterequests"
 	crutil "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/util"
 	issuerpkg "github.com/cert-manager/cert-manager/pkg/issuer"
+	caissuer "github.com/cert-manager/cert-manager/pkg/issuer/ca"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 	cmerrors "github.com/cert-manager/cert-manager/pkg/util/errors"
 	"github.com/cert-manager/cert-manager/pkg/util/kube"
@@ -47,8 +53,10 @@ type signingFn func([]*x509.Certificate, crypto.Signer, *x509.Certificate) (pki.
 type CA struct {
 	issuerOptions controllerpkg.IssuerOptions
 	secretsLister internalinformers.SecretLister
+	secretsClient corev1client.SecretsGetter
 
 	reporter *crutil.Reporter
+	recorder record.EventRecorder
 
 	// Used for testing to get reproducible resulting certificates
 	templateGenerator templateGenerator
@@ -68,7 +76,9 @@ func NewCA(ctx *controllerpkg.Context) certificaterequests.Issuer {
 	return &CA{
 		issuerOptions:     ctx.IssuerOptions,
 		secretsLister:     ctx.KubeSharedInformerFactory.Secrets().Lister(),
+		secretsClient:     ctx.Client.CoreV1(),
 		reporter:          crutil.NewReporter(ctx.Clock, ctx.Recorder),
+		recorder:          ctx.Recorder,
 		templateGenerator: pki.CertificateTemplateFromCertificateRequest,
 		signingFn:         pki.SignCSRTemplate,
 	}
@@ -118,10 +128,68 @@ func (c *CA) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuerObj c
 		return nil, nil
 	}
 
-	template.CRLDistributionPoints = issuerObj.GetSpec().CA.CRLDistributionPoints
+	if issuerObj.GetSpec().CA.SerialNumberStrategy == cmapi.SequentialSerialNumberStrategy {
+		template.SerialNumber, err = caissuer.NextSequentialSerialNumber(ctx, c.secretsClient, resourceNamespace, secretName)
+		if errors.Is(err, caissuer.ErrSerialNumbersExhausted) {
+			message := "Failed to allocate a sequential serial number"
+			c.reporter.Failed(cr, err, "SigningError", message)
+			log.Error(err, message)
+			return nil, nil
+		}
+		if err != nil {
+			message := "Failed to allocate a sequential serial number"
+			c.reporter.Pending(cr, err, "SerialNumberError", message)
+			log.Error(err, message)
+			return nil, err
+		}
+	}
+
+	template.CRLDistributionPoints, err = caissuer.RenderCRLDistributionPoints(issuerObj.GetSpec().CA.CRLDistributionPoints, issuerObj.GetName(), template)
+	if err != nil {
+		message := "Error rendering CRL distribution points"
+		c.reporter.Failed(cr, err, "SigningError", message)
+		log.Error(err, message)
+		return nil, nil
+	}
 	template.OCSPServer = issuerObj.GetSpec().CA.OCSPServers
 	template.IssuingCertificateURL = issuerObj.GetSpec().CA.IssuingCertificateURLs
 
+	if err := caissuer.CopyAllowedExtraExtensions(issuerObj.GetSpec().CA.AllowedExtraExtensions, cr.Spec.Request, template); err != nil {
+		message := "Error copying allowed extensions from the certificate request"
+		c.reporter.Failed(cr, err, "SigningError", message)
+		log.Error(err, message)
+		return nil, nil
+	}
+
+	if err := caissuer.ApplySignatureAlgorithm(issuerObj.GetSpec().CA, caKey, template); err != nil {
+		message := "Error applying the issuer's signature algorithm"
+		c.reporter.Failed(cr, err, "SigningError", message)
+		log.Error(err, message)
+		return nil, nil
+	}
+
+	if err := caissuer.ApplyAuthorityKeyIdentifier(issuerObj.GetSpec().CA, template); err != nil {
+		message := "Error applying the issuer's authority key identifier"
+		c.reporter.Failed(cr, err, "SigningError", message)
+		log.Error(err, message)
+		return nil, nil
+	}
+
+	caissuer.ApplyOCSPNoCheck(template)
+
+	notAfter := template.NotAfter
+	clamped, err := caissuer.ApplyCAExpiryPolicy(issuerObj.GetSpec().CA, caCerts[0], template)
+	if err != nil {
+		message := "Refusing to sign certificate which would outlive the CA"
+		c.reporter.Failed(cr, err, "CAExpiryExceeded", message)
+		log.Error(err, message)
+		return nil, nil
+	}
+	if clamped {
+		c.recorder.Eventf(cr, corev1.EventTypeNormal, "NotAfterClamped", "Certificate NotAfter clamped from %s to %s to not outlive the CA",
+			notAfter.UTC().Format(time.RFC3339), template.NotAfter.UTC().Format(time.RFC3339))
+	}
+
 	bundle, err := c.signingFn(caCerts, caKey, template)
 	if err != nil {
 		message := "Error signing certificate"
@@ -26,6 +26,7 @@ import (
 	"errors"
 	"math"
 	"math/big"
+	"strings"
 	"testing"
 	"time"
 
@@ -34,6 +35,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
 	clientcorev1 "k8s.io/client-go/listers/core/v1"
 	coretesting "k8s.io/client-go/testing"
 	fakeclock "k8s.io/utils/clock/testing"
@@ -46,6 +48,7 @@ import (
 	"github.com/cert-manager/cert-manager/pkg/controller/certificaterequests"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/util"
 	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	caissuer "github.com/cert-manager/cert-manager/pkg/issuer/ca"
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
 	"github.com/cert-manager/cert-manager/test/unit/gen"
 	testlisters "github.com/cert-manager/cert-manager/test/unit/listers"
@@ -197,10 +200,11 @@ func TestSign(t *testing.T) {
 								Type:               cmapi.CertificateRequestConditionReady,
 								Status:             cmmeta.ConditionFalse,
 								Reason:             "Denied",
-								Message:            "The CertificateRequest was denied by an approval controller",
+								Message:            `The CertificateRequest was denied by an approval controller with reason "Foo": Certificate request has been denied by cert-manager.io`,
 								LastTransitionTime: &metaFixedClockStart,
 							}),
 							gen.SetCertificateRequestFailureTime(metaFixedClockStart),
+							gen.SetCertificateRequestDenied("Foo", metaFixedClockStart),
 						),
 					)),
 				},
@@ -227,6 +231,11 @@ func TestSign(t *testing.T) {
 								Message:            `Referenced secret default-unit-test-ns/root-ca-secret not found: secret "root-ca-secret" not found`,
 								LastTransitionTime: &metaFixedClockStart,
 							}),
+							gen.AddCertificateRequestIssuanceFailure(cmapi.CertificateRequestIssuanceFailure{
+								Time:    metaFixedClockStart,
+								Reason:  "SecretMissing",
+								Message: `Referenced secret default-unit-test-ns/root-ca-secret not found: secret "root-ca-secret" not found`,
+							}),
 						),
 					)),
 				},
@@ -257,6 +266,11 @@ func TestSign(t *testing.T) {
 								Message:            "Failed to parse signing CA keypair from secret default-unit-test-ns/root-ca-secret: error decoding private key PEM block",
 								LastTransitionTime: &metaFixedClockStart,
 							}),
+							gen.AddCertificateRequestIssuanceFailure(cmapi.CertificateRequestIssuanceFailure{
+								Time:    metaFixedClockStart,
+								Reason:  "SecretInvalidData",
+								Message: "Failed to parse signing CA keypair from secret default-unit-test-ns/root-ca-secret: error decoding private key PEM block",
+							}),
 						),
 					)),
 				},
@@ -283,6 +297,11 @@ func TestSign(t *testing.T) {
 								Message:            "Failed to get certificate key pair from secret default-unit-test-ns/root-ca-secret: this is a network error",
 								LastTransitionTime: &metaFixedClockStart,
 							}),
+							gen.AddCertificateRequestIssuanceFailure(cmapi.CertificateRequestIssuanceFailure{
+								Time:    metaFixedClockStart,
+								Reason:  "SecretGetError",
+								Message: "Failed to get certificate key pair from secret default-unit-test-ns/root-ca-secret: this is a network error",
+							}),
 						),
 					)),
 				},
@@ -351,6 +370,11 @@ func TestSign(t *testing.T) {
 								Message:            "Error generating certificate template: this is a template generate error",
 								LastTransitionTime: &metaFixedClockStart,
 							}),
+							gen.AddCertificateRequestIssuanceFailure(cmapi.CertificateRequestIssuanceFailure{
+								Time:    metaFixedClockStart,
+								Reason:  "SigningError",
+								Message: "Error generating certificate template: this is a template generate error",
+							}),
 							gen.SetCertificateRequestFailureTime(metaFixedClockStart),
 						),
 					)),
@@ -461,6 +485,10 @@ func TestCA_Sign(t *testing.T) {
 	}
 	rootCert, _ := generateSelfSignedCACert(t, rootPK, "root")
 
+	// a CA valid for an hour, used to test requests which outlive the CA
+	longLivedCert := *rootCert
+	longLivedCert.NotAfter = time.Now().Add(time.Hour)
+
 	// Build test CSR
 	testpk, err := pki.GenerateECPrivateKey(256)
 	if err != nil {
@@ -474,6 +502,7 @@ func TestCA_Sign(t *testing.T) {
 		givenCR          *cmapi.CertificateRequest
 		assertSignedCert func(t *testing.T, got *x509.Certificate)
 		wantErr          string
+		wantEvents       []string
 	}{
 		"when the CertificateRequest has the duration field set, it should appear as notAfter on the signed ca": {
 			givenCASecret: gen.SecretFrom(gen.Secret("secret-1"), gen.SetSecretNamespace("default"), gen.SetSecretData(secretDataFor(t, rootPK, rootCert))),
@@ -589,6 +618,101 @@ func TestCA_Sign(t *testing.T) {
 				assert.Equal(t, []string{"http://www.example.com/crl/test.crl"}, gotCA.CRLDistributionPoints)
 			},
 		},
+		"when the Issuer uses the sequential serialNumberStrategy, the serial number should be allocated from the counter": {
+			givenCASecret: gen.SecretFrom(gen.Secret("secret-1"), gen.SetSecretNamespace("default"), gen.SetSecretData(secretDataFor(t, rootPK, rootCert))),
+			givenCAIssuer: gen.Issuer("issuer-1", gen.SetIssuerCA(cmapi.CAIssuer{
+				SecretName:           "secret-1",
+				SerialNumberStrategy: cmapi.SequentialSerialNumberStrategy,
+			})),
+			givenCR: gen.CertificateRequest("cr-1",
+				gen.SetCertificateRequestCSR(testCSR),
+				gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{
+					Name:  "issuer-1",
+					Group: certmanager.GroupName,
+					Kind:  "Issuer",
+				}),
+			),
+			assertSignedCert: func(t *testing.T, got *x509.Certificate) {
+				assert.Equal(t, int64(1), got.SerialNumber.Int64())
+			},
+		},
+		"when the Issuer has templated crlDistributionPoints set, they should be rendered on the signed certificate": {
+			givenCASecret: gen.SecretFrom(gen.Secret("secret-1"), gen.SetSecretNamespace("default"), gen.SetSecretData(secretDataFor(t, rootPK, rootCert))),
+			givenCAIssuer: gen.Issuer("issuer-1", gen.SetIssuerCA(cmapi.CAIssuer{
+				SecretName:            "secret-1",
+				CRLDistributionPoints: []string{"http://www.example.com/crl/${issuerName}/${serial}.crl"},
+			})),
+			givenCR: gen.CertificateRequest("cr-1",
+				gen.SetCertificateRequestCSR(testCSR),
+				gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{
+					Name:  "issuer-1",
+					Group: certmanager.GroupName,
+					Kind:  "Issuer",
+				}),
+			),
+			assertSignedCert: func(t *testing.T, got *x509.Certificate) {
+				assert.Equal(t, []string{"http://www.example.com/crl/issuer-1/" + got.SerialNumber.Text(16) + ".crl"}, got.CRLDistributionPoints)
+			},
+		},
+		"when the Issuer has crlDistributionPoints with unknown variables set, the request should fail": {
+			givenCASecret: gen.SecretFrom(gen.Secret("secret-1"), gen.SetSecretNamespace("default"), gen.SetSecretData(secretDataFor(t, rootPK, rootCert))),
+			givenCAIssuer: gen.Issuer("issuer-1", gen.SetIssuerCA(cmapi.CAIssuer{
+				SecretName:            "secret-1",
+				CRLDistributionPoints: []string{"http://www.example.com/crl/${unknown}.crl"},
+			})),
+			givenCR: gen.CertificateRequest("cr-1",
+				gen.SetCertificateRequestCSR(testCSR),
+				gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{
+					Name:  "issuer-1",
+					Group: certmanager.GroupName,
+					Kind:  "Issuer",
+				}),
+			),
+			wantEvents: []string{"Warning SigningError Error rendering CRL distribution points"},
+		},
+		"when the Issuer has caExpiryPolicy set to Clamp, the notAfter should be clamped to the CA's expiry": {
+			givenCASecret: gen.SecretFrom(gen.Secret("secret-1"), gen.SetSecretNamespace("default"), gen.SetSecretData(secretDataFor(t, rootPK, &longLivedCert))),
+			givenCAIssuer: gen.Issuer("issuer-1", gen.SetIssuerCA(cmapi.CAIssuer{
+				SecretName:     "secret-1",
+				CAExpiryPolicy: cmapi.CAExpiryPolicyClamp,
+			})),
+			givenCR: gen.CertificateRequest("cr-1",
+				gen.SetCertificateRequestCSR(testCSR),
+				gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{
+					Name:  "issuer-1",
+					Group: certmanager.GroupName,
+					Kind:  "Issuer",
+				}),
+				gen.SetCertificateRequestDuration(&metav1.Duration{
+					Duration: 2 * time.Hour,
+				}),
+			),
+			assertSignedCert: func(t *testing.T, got *x509.Certificate) {
+				expectNotAfter := longLivedCert.NotAfter.Add(-caissuer.CAExpiryMargin)
+				deltaSec := math.Abs(expectNotAfter.Sub(got.NotAfter).Seconds())
+				assert.LessOrEqualf(t, deltaSec, 1., "expected a time delta lower than 1 second. Time expected='%s', got='%s'", expectNotAfter.String(), got.NotAfter.String())
+			},
+			wantEvents: []string{"Normal NotAfterClamped Certificate NotAfter clamped from"},
+		},
+		"when the Issuer has caExpiryPolicy set to Reject, a request outliving the CA should fail": {
+			givenCASecret: gen.SecretFrom(gen.Secret("secret-1"), gen.SetSecretNamespace("default"), gen.SetSecretData(secretDataFor(t, rootPK, &longLivedCert))),
+			givenCAIssuer: gen.Issuer("issuer-1", gen.SetIssuerCA(cmapi.CAIssuer{
+				SecretName:     "secret-1",
+				CAExpiryPolicy: cmapi.CAExpiryPolicyReject,
+			})),
+			givenCR: gen.CertificateRequest("cr-1",
+				gen.SetCertificateRequestCSR(testCSR),
+				gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{
+					Name:  "issuer-1",
+					Group: certmanager.GroupName,
+					Kind:  "Issuer",
+				}),
+				gen.SetCertificateRequestDuration(&metav1.Duration{
+					Duration: 2 * time.Hour,
+				}),
+			),
+			wantEvents: []string{"Warning CAExpiryExceeded Refusing to sign certificate which would outlive the CA"},
+		},
 	}
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -601,16 +725,25 @@ func TestCA_Sign(t *testing.T) {
 					IssuerAmbientCredentials:        false,
 				},
 				reporter: util.NewReporter(fixedClock, rec),
+				recorder: rec,
 				secretsLister: testlisters.FakeSecretListerFrom(testlisters.NewFakeSecretLister(),
 					testlisters.SetFakeSecretNamespaceListerGet(test.givenCASecret, nil),
 				),
+				secretsClient:     kubefake.NewSimpleClientset().CoreV1(),
 				templateGenerator: pki.CertificateTemplateFromCertificateRequest,
 				signingFn:         pki.SignCSRTemplate,
 			}
 
 			gotIssueResp, gotErr := c.Sign(context.Background(), test.givenCR, test.givenCAIssuer)
+			for i, event := range test.wantEvents {
+				require.Greater(t, len(rec.Events), i)
+				assert.True(t, strings.HasPrefix(rec.Events[i], event), "unexpected event %q", rec.Events[i])
+			}
 			if test.wantErr != "" {
 				require.EqualError(t, gotErr, test.wantErr)
+			} else if test.assertSignedCert == nil {
+				require.NoError(t, gotErr)
+				require.Nil(t, gotIssueResp)
 			} else {
 				require.NoError(t, gotErr)
 
@@ -96,6 +96,10 @@ type Controller struct {
 	issuerConstructor IssuerConstructor
 	issuer            Issuer
 
+	// inFlight bounds the number of CertificateRequests being processed by
+	// each issuer at once
+	inFlight *inFlightLimiter
+
 	// used for testing
 	clock clock.Clock
 
@@ -184,6 +188,7 @@ func (c *Controller) Register(ctx *controllerpkg.Context) (workqueue.RateLimitin
 	c.reporter = util.NewReporter(c.clock, c.recorder)
 	c.cmClient = ctx.CMClient
 	c.fieldManager = ctx.FieldManager
+	c.inFlight = newInFlightLimiter(ctx.SchedulerOptions.MaxConcurrentCertificateRequestsPerIssuer[c.issuerType])
 
 	// Construct the issuer implementation with the built component context.
 	c.issuer = c.issuerConstructor(ctx)
@@ -210,6 +215,7 @@ func (c *Controller) ProcessItem(ctx context.Context, key string) error {
 	if err != nil {
 		if k8sErrors.IsNotFound(err) {
 			dbg.Info(fmt.Sprintf("certificate request in work queue no longer exists: %s", err))
+			c.inFlight.release(key)
 			return nil
 		}
 
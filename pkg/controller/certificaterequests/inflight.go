@@ -0,0 +1,131 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificaterequests
+
+import (
+	"sync"
+	"time"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// inFlightRequeueDelay is how long a CertificateRequest waits before being
+// retried when its issuer is already processing the maximum number of
+// CertificateRequests.
+const inFlightRequeueDelay = 5 * time.Second
+
+// inFlightLimiter bounds the number of CertificateRequests being processed by
+// each issuer at once. A CertificateRequest holds its slot from the moment it
+// is first passed to the issuer until it reaches a final state, so that
+// issuers which sign asynchronously, such as ACME and Venafi, are limited for
+// the whole time their orders are outstanding rather than only for the
+// duration of a single Sign call.
+type inFlightLimiter struct {
+	// limit is the maximum number of CertificateRequests that may be in
+	// flight for a single issuer. If zero, there is no limit.
+	limit int
+
+	lock sync.Mutex
+	// inFlight holds the keys of the CertificateRequests holding a slot,
+	// indexed by issuer.
+	inFlight map[string]map[string]struct{}
+	// issuers maps the key of each CertificateRequest holding a slot to the
+	// issuer the slot belongs to.
+	issuers map[string]string
+}
+
+func newInFlightLimiter(limit int) *inFlightLimiter {
+	return &inFlightLimiter{
+		limit:    limit,
+		inFlight: make(map[string]map[string]struct{}),
+		issuers:  make(map[string]string),
+	}
+}
+
+// tryAcquire reserves a slot of the given issuer for the CertificateRequest
+// with the given key, without blocking. It returns true if the
+// CertificateRequest already holds a slot. It returns false if the issuer
+// already has the maximum number of CertificateRequests in flight. The slot
+// is held until release is called with the same key.
+func (l *inFlightLimiter) tryAcquire(iss cmapi.GenericIssuer, crKey string) bool {
+	if l.limit <= 0 {
+		return true
+	}
+
+	key := issuerKey(iss)
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	holders := l.inFlight[key]
+	if _, ok := holders[crKey]; ok {
+		return true
+	}
+	if len(holders) >= l.limit {
+		return false
+	}
+	if holders == nil {
+		holders = make(map[string]struct{})
+		l.inFlight[key] = holders
+	}
+	holders[crKey] = struct{}{}
+	l.issuers[crKey] = key
+
+	return true
+}
+
+// release frees the slot held by the CertificateRequest with the given key,
+// if any. It is safe to call for CertificateRequests which do not hold a
+// slot.
+func (l *inFlightLimiter) release(crKey string) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	key, ok := l.issuers[crKey]
+	if !ok {
+		return
+	}
+	delete(l.issuers, crKey)
+	delete(l.inFlight[key], crKey)
+	if len(l.inFlight[key]) == 0 {
+		delete(l.inFlight, key)
+	}
+}
+
+// certificateRequestIsFinal returns true if the CertificateRequest will not be
+// processed by its issuer any further, in which case it no longer holds an
+// in-flight slot.
+func certificateRequestIsFinal(cr *cmapi.CertificateRequest) bool {
+	if apiutil.CertificateRequestIsDenied(cr) || apiutil.CertificateRequestHasInvalidRequest(cr) {
+		return true
+	}
+	switch apiutil.CertificateRequestReadyReason(cr) {
+	case cmapi.CertificateRequestReasonIssued, cmapi.CertificateRequestReasonFailed, cmapi.CertificateRequestReasonDenied:
+		return true
+	}
+	return false
+}
+
+// issuerKey uniquely identifies an Issuer or ClusterIssuer, so that Issuers
+// and ClusterIssuers with the same name are limited separately.
+func issuerKey(iss cmapi.GenericIssuer) string {
+	if _, ok := iss.(*cmapi.ClusterIssuer); ok {
+		return cmapi.ClusterIssuerKind + "/" + iss.GetObjectMeta().Name
+	}
+	return cmapi.IssuerKind + "/" + iss.GetObjectMeta().Namespace + "/" + iss.GetObjectMeta().Name
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificaterequests
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/fake"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/pkg/issuer"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestInFlightLimiterBoundsConcurrency(t *testing.T) {
+	const limit = 3
+	l := newInFlightLimiter(limit)
+	iss := gen.Issuer("vault", gen.SetIssuerNamespace("ns"))
+
+	var (
+		wg          sync.WaitGroup
+		current     atomic.Int32
+		maxObserved atomic.Int32
+		processed   atomic.Int32
+	)
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			crKey := fmt.Sprintf("ns/cr-%d", i)
+			// Requests which are rejected are retried, as they would be when
+			// requeued by the controller.
+			for !l.tryAcquire(iss, crKey) {
+				time.Sleep(time.Millisecond)
+			}
+			n := current.Add(1)
+			for {
+				m := maxObserved.Load()
+				if n <= m || maxObserved.CompareAndSwap(m, n) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			current.Add(-1)
+			processed.Add(1)
+			l.release(crKey)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(50), processed.Load())
+	assert.LessOrEqual(t, maxObserved.Load(), int32(limit))
+	assert.Empty(t, l.inFlight, "all slots should have been released")
+	assert.Empty(t, l.issuers, "all slots should have been released")
+}
+
+func TestInFlightLimiter(t *testing.T) {
+	issA := gen.Issuer("a", gen.SetIssuerNamespace("ns"))
+	issAOtherNamespace := gen.Issuer("a", gen.SetIssuerNamespace("other"))
+	clusterIssA := gen.ClusterIssuer("a")
+
+	t.Run("rejects requests over the limit until a slot is released", func(t *testing.T) {
+		l := newInFlightLimiter(1)
+
+		require.True(t, l.tryAcquire(issA, "ns/one"))
+		assert.True(t, l.tryAcquire(issA, "ns/one"), "a request holding a slot should keep it")
+		assert.False(t, l.tryAcquire(issA, "ns/two"))
+
+		l.release("ns/one")
+		// releasing twice must not free a second slot
+		l.release("ns/one")
+
+		require.True(t, l.tryAcquire(issA, "ns/two"))
+		assert.False(t, l.tryAcquire(issA, "ns/three"))
+		l.release("ns/two")
+	})
+
+	t.Run("limits each issuer separately", func(t *testing.T) {
+		l := newInFlightLimiter(1)
+
+		require.True(t, l.tryAcquire(issA, "ns/one"))
+		assert.True(t, l.tryAcquire(issAOtherNamespace, "other/one"), "an Issuer with the same name in another namespace should not be limited")
+		assert.True(t, l.tryAcquire(clusterIssA, "ns/two"), "a ClusterIssuer with the same name should not be limited")
+	})
+
+	t.Run("does not limit if the limit is zero", func(t *testing.T) {
+		l := newInFlightLimiter(0)
+
+		for i := 0; i < 100; i++ {
+			require.True(t, l.tryAcquire(issA, fmt.Sprintf("ns/cr-%d", i)))
+		}
+	})
+}
+
+// An issuer which signs asynchronously, such as ACME, returns from Sign
+// without a certificate while its order is outstanding. The CertificateRequest
+// must keep its in-flight slot until it reaches a final state.
+func TestSyncKeepsInFlightSlotUntilFinal(t *testing.T) {
+	iss := gen.Issuer("test-issuer",
+		gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{}),
+		gen.AddIssuerCondition(cmapi.IssuerCondition{
+			Type:   cmapi.IssuerConditionReady,
+			Status: cmmeta.ConditionTrue,
+		}),
+	)
+	newCR := func(name string) *cmapi.CertificateRequest {
+		return gen.CertificateRequest(name,
+			gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{
+				Kind: iss.Kind,
+				Name: iss.Name,
+			}),
+			gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+				Type:   cmapi.CertificateRequestConditionApproved,
+				Status: cmmeta.ConditionTrue,
+				Reason: "cert-manager.io",
+			}),
+		)
+	}
+	pending, waiting := newCR("pending"), newCR("waiting")
+
+	builder := &testpkg.Builder{
+		T:                  t,
+		Clock:              fixedClock,
+		CertManagerObjects: []runtime.Object{iss, pending, waiting},
+	}
+	builder.Init()
+	defer builder.Stop()
+	builder.Context.SchedulerOptions.MaxConcurrentCertificateRequestsPerIssuer = map[string]int{util.IssuerSelfSigned: 1}
+
+	signed := map[string]int{}
+	c := New(util.IssuerSelfSigned, func(*controller.Context) Issuer {
+		return &fake.Issuer{
+			FakeSign: func(_ context.Context, cr *cmapi.CertificateRequest, _ cmapi.GenericIssuer) (*issuer.IssueResponse, error) {
+				signed[cr.Name]++
+				return nil, nil
+			},
+		}
+	})
+	_, _, err := c.Register(builder.Context)
+	require.NoError(t, err)
+	builder.Start()
+
+	require.NoError(t, c.Sync(context.Background(), pending))
+	require.NoError(t, c.Sync(context.Background(), waiting))
+	assert.Equal(t, map[string]int{"pending": 1}, signed, "the pending request should hold the only slot")
+
+	require.NoError(t, c.Sync(context.Background(), pending))
+	require.NoError(t, c.Sync(context.Background(), waiting))
+	assert.Equal(t, map[string]int{"pending": 2}, signed, "the pending request should keep its slot across syncs")
+
+	issued := gen.CertificateRequestFrom(pending,
+		gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+			Type:   cmapi.CertificateRequestConditionReady,
+			Status: cmmeta.ConditionTrue,
+			Reason: cmapi.CertificateRequestReasonIssued,
+		}),
+	)
+	require.NoError(t, c.Sync(context.Background(), issued))
+	require.NoError(t, c.Sync(context.Background(), waiting))
+	assert.Equal(t, map[string]int{"pending": 2, "waiting": 1}, signed, "the slot should be released once the request is issued")
+}
@@ -0,0 +1,295 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetescsr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	certificateslisters "k8s.io/client-go/listers/certificates/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/ptr"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificaterequests"
+	crutil "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/util"
+	csrutil "github.com/cert-manager/cert-manager/pkg/controller/certificatesigningrequests/util"
+	issuerpkg "github.com/cert-manager/cert-manager/pkg/issuer"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+const (
+	// CRControllerName is the name of the Kubernetes CSR certificate requests
+	// controller.
+	CRControllerName = "certificaterequests-issuer-kubernetescsr"
+
+	// CertificateRequestNamespaceAnnotationKey is the annotation set on
+	// CertificateSigningRequests created by this controller that holds the
+	// namespace of the CertificateRequest they were created for.
+	// CertificateSigningRequests are cluster scoped, so they cannot be owned by
+	// the CertificateRequest.
+	CertificateRequestNamespaceAnnotationKey = "cert-manager.io/certificate-request-namespace"
+
+	// CertificateRequestNameAnnotationKey is the annotation set on
+	// CertificateSigningRequests created by this controller that holds the
+	// name of the CertificateRequest they were created for.
+	CertificateRequestNameAnnotationKey = "cert-manager.io/certificate-request-name"
+)
+
+// KubernetesCSR is a Kubernetes CertificateSigningRequest specific
+// implementation of the pkg/controller/certificaterequests.Issuer interface.
+type KubernetesCSR struct {
+	csrLister  certificateslisters.CertificateSigningRequestLister
+	kubeClient kubernetes.Interface
+	reporter   *crutil.Reporter
+
+	// fieldManager is the manager name used for Create operations.
+	fieldManager string
+}
+
+func init() {
+	// create certificate request controller for Kubernetes CSR issuer
+	controllerpkg.Register(CRControllerName, func(ctx *controllerpkg.ContextFactory) (controllerpkg.Interface, error) {
+		// watch CertificateSigningRequests created by this controller and
+		// trigger resyncs of the CertificateRequests they were created for,
+		// so that approval and issuance are observed without polling.
+		return controllerpkg.NewBuilder(ctx, CRControllerName).
+			For(certificaterequests.New(
+				apiutil.IssuerKubernetesCSR,
+				NewKubernetesCSR,
+				func(ctx *controllerpkg.Context, log logr.Logger, queue workqueue.RateLimitingInterface) ([]cache.InformerSynced, error) {
+					csrInformer := ctx.KubeSharedInformerFactory.CertificateSigningRequests().Informer()
+					csrInformer.AddEventHandler(&controllerpkg.BlockingEventHandler{
+						WorkFunc: handleCertificateSigningRequest(log, queue),
+					})
+					return []cache.InformerSynced{csrInformer.HasSynced}, nil
+				},
+			)).
+			Complete()
+	})
+}
+
+// NewKubernetesCSR returns a new KubernetesCSR instance with the given
+// controller context.
+func NewKubernetesCSR(ctx *controllerpkg.Context) certificaterequests.Issuer {
+	return &KubernetesCSR{
+		csrLister:    ctx.KubeSharedInformerFactory.CertificateSigningRequests().Lister(),
+		kubeClient:   ctx.Client,
+		reporter:     crutil.NewReporter(ctx.Clock, ctx.Recorder),
+		fieldManager: ctx.FieldManager,
+	}
+}
+
+// handleCertificateSigningRequest returns a work function which queues the
+// CertificateRequest referenced by the annotations of a
+// CertificateSigningRequest.
+func handleCertificateSigningRequest(log logr.Logger, queue workqueue.RateLimitingInterface) func(obj interface{}) {
+	return func(obj interface{}) {
+		csr, ok := obj.(*certificatesv1.CertificateSigningRequest)
+		if !ok {
+			log.Error(nil, "object is not a CertificateSigningRequest", "object", obj)
+			return
+		}
+
+		namespace := csr.Annotations[CertificateRequestNamespaceAnnotationKey]
+		name := csr.Annotations[CertificateRequestNameAnnotationKey]
+		if len(namespace) == 0 || len(name) == 0 {
+			return
+		}
+
+		queue.Add(namespace + "/" + name)
+	}
+}
+
+// Sign creates a Kubernetes CertificateSigningRequest for the signer
+// configured on the issuer, and returns the signed certificate once the
+// CertificateSigningRequest has been approved and signed.
+func (k *KubernetesCSR) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuerObj cmapi.GenericIssuer) (*issuerpkg.IssueResponse, error) {
+	log := logf.FromContext(ctx, "sign")
+	log = logf.WithRelatedResource(log, issuerObj)
+
+	// CertificateSigningRequests are created with the controller's identity,
+	// so namespaced Issuers must not be able to choose the signer.
+	if _, ok := issuerObj.(*cmapi.ClusterIssuer); !ok {
+		err := errors.New("the kubernetesCSR issuer type is only supported by ClusterIssuers")
+		message := "Refusing to create a CertificateSigningRequest"
+
+		k.reporter.Failed(cr, err, "IssuerKindNotSupported", message)
+		log.Error(err, message)
+
+		return nil, nil
+	}
+
+	expectedCSR, err := buildCertificateSigningRequest(cr, issuerObj.GetSpec().KubernetesCSR)
+	if err != nil {
+		message := "Failed to build CertificateSigningRequest"
+
+		k.reporter.Failed(cr, err, "CSRBuildingError", message)
+		log.Error(err, message)
+
+		return nil, nil
+	}
+
+	csr, err := k.csrLister.Get(expectedCSR.Name)
+	if k8sErrors.IsNotFound(err) {
+		_, err = k.kubeClient.CertificatesV1().CertificateSigningRequests().Create(ctx, expectedCSR, metav1.CreateOptions{FieldManager: k.fieldManager})
+		if k8sErrors.IsInvalid(err) {
+			// The request will never be accepted by the API server, for
+			// example because the requested duration is too short.
+			message := fmt.Sprintf("CertificateSigningRequest %s was rejected by the API server", expectedCSR.Name)
+
+			k.reporter.Failed(cr, err, "CSRCreatingError", message)
+			log.Error(err, message)
+
+			return nil, nil
+		}
+		if err != nil {
+			message := fmt.Sprintf("Failed to create CertificateSigningRequest %s", expectedCSR.Name)
+
+			k.reporter.Pending(cr, err, "CSRCreatingError", message)
+			log.Error(err, message)
+
+			return nil, err
+		}
+
+		message := fmt.Sprintf("Created CertificateSigningRequest %s for signer %q", expectedCSR.Name, expectedCSR.Spec.SignerName)
+		k.reporter.Pending(cr, nil, "CSRCreated", message)
+		log.V(logf.DebugLevel).Info(message)
+
+		return nil, nil
+	}
+	if err != nil {
+		message := fmt.Sprintf("Failed to get CertificateSigningRequest %s", expectedCSR.Name)
+
+		k.reporter.Pending(cr, err, "CSRGetError", message)
+		log.Error(err, message)
+
+		return nil, err
+	}
+
+	if csr.Annotations[CertificateRequestNamespaceAnnotationKey] != cr.Namespace ||
+		csr.Annotations[CertificateRequestNameAnnotationKey] != cr.Name {
+		return nil, fmt.Errorf("found CertificateSigningRequest %s not created for this CertificateRequest, retrying", csr.Name)
+	}
+
+	log = logf.WithRelatedResource(log, csr)
+
+	if csrutil.CertificateSigningRequestIsDenied(csr) {
+		message := fmt.Sprintf("CertificateSigningRequest %s has been denied", csr.Name)
+		err := fmt.Errorf("%s", conditionMessage(csr, certificatesv1.CertificateDenied))
+
+		k.reporter.Failed(cr, err, "CSRDenied", message)
+		log.V(logf.DebugLevel).Info(message)
+
+		return nil, nil
+	}
+
+	if csrutil.CertificateSigningRequestIsFailed(csr) {
+		message := fmt.Sprintf("CertificateSigningRequest %s failed to be signed", csr.Name)
+		err := fmt.Errorf("%s", conditionMessage(csr, certificatesv1.CertificateFailed))
+
+		k.reporter.Failed(cr, err, "CSRFailed", message)
+		log.V(logf.DebugLevel).Info(message)
+
+		return nil, nil
+	}
+
+	if !csrutil.CertificateSigningRequestIsApproved(csr) {
+		message := fmt.Sprintf("Waiting for CertificateSigningRequest %s to be approved", csr.Name)
+
+		k.reporter.Pending(cr, nil, "CSRPendingApproval", message)
+		log.V(logf.DebugLevel).Info(message)
+
+		return nil, nil
+	}
+
+	if len(csr.Status.Certificate) == 0 {
+		message := fmt.Sprintf("Waiting for CertificateSigningRequest %s to be signed by %q", csr.Name, csr.Spec.SignerName)
+
+		k.reporter.Pending(cr, nil, "CSRPendingIssuance", message)
+		log.V(logf.DebugLevel).Info(message)
+
+		return nil, nil
+	}
+
+	log.V(logf.DebugLevel).Info("certificate issued")
+
+	return &issuerpkg.IssueResponse{
+		Certificate: csr.Status.Certificate,
+	}, nil
+}
+
+// buildCertificateSigningRequest returns the CertificateSigningRequest that
+// should be created for the given CertificateRequest.
+// The name is derived from the UID of the CertificateRequest, so that a
+// CertificateRequest which is re-created with the same name does not pick up
+// the CertificateSigningRequest of its predecessor.
+func buildCertificateSigningRequest(cr *cmapi.CertificateRequest, iss *cmapi.KubernetesCSRIssuer) (*certificatesv1.CertificateSigningRequest, error) {
+	name, err := apiutil.ComputeName(cr.Name, []string{cr.Namespace, cr.Name, string(cr.UID)})
+	if err != nil {
+		return nil, err
+	}
+
+	usages := make([]certificatesv1.KeyUsage, 0, len(cr.Spec.Usages))
+	for _, usage := range cr.Spec.Usages {
+		usages = append(usages, certificatesv1.KeyUsage(usage))
+	}
+	if len(usages) == 0 {
+		usages = []certificatesv1.KeyUsage{certificatesv1.UsageDigitalSignature, certificatesv1.UsageKeyEncipherment}
+	}
+
+	var expirationSeconds *int32
+	if cr.Spec.Duration != nil {
+		expirationSeconds = ptr.To(int32(cr.Spec.Duration.Duration.Seconds()))
+	}
+
+	return &certificatesv1.CertificateSigningRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Annotations: map[string]string{
+				CertificateRequestNamespaceAnnotationKey: cr.Namespace,
+				CertificateRequestNameAnnotationKey:      cr.Name,
+			},
+		},
+		Spec: certificatesv1.CertificateSigningRequestSpec{
+			Request:           cr.Spec.Request,
+			SignerName:        iss.SignerName,
+			Usages:            usages,
+			ExpirationSeconds: expirationSeconds,
+		},
+	}, nil
+}
+
+// conditionMessage returns the reason and message of the condition of the
+// given type.
+func conditionMessage(csr *certificatesv1.CertificateSigningRequest, condType certificatesv1.RequestConditionType) string {
+	for _, cond := range csr.Status.Conditions {
+		if cond.Type == condType {
+			return fmt.Sprintf("%s: %s", cond.Reason, cond.Message)
+		}
+	}
+	return ""
+}
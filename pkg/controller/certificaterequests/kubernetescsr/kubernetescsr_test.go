@@ -0,0 +1,331 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetescsr
+
+import (
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	coretesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+const testSignerName = "example.com/test-signer"
+
+func testIssuer() *cmapi.ClusterIssuer {
+	return gen.ClusterIssuer("kubernetes-csr-issuer",
+		gen.SetIssuerKubernetesCSR(cmapi.KubernetesCSRIssuer{SignerName: testSignerName}),
+	)
+}
+
+func testCertificateRequest(t *testing.T) *cmapi.CertificateRequest {
+	key, err := pki.GenerateECPrivateKey(256)
+	require.NoError(t, err)
+	csrPEM, err := gen.CSRWithSigner(key, gen.SetCSRDNSNames("example.com"))
+	require.NoError(t, err)
+
+	cr := gen.CertificateRequest("test-cr",
+		gen.SetCertificateRequestCSR(csrPEM),
+		gen.SetCertificateRequestDuration(&metav1.Duration{Duration: time.Hour}),
+		gen.SetCertificateRequestKeyUsages(cmapi.UsageDigitalSignature, cmapi.UsageServerAuth),
+	)
+	cr.UID = types.UID("test-uid")
+	return cr
+}
+
+func withConditions(csr *certificatesv1.CertificateSigningRequest, conds ...certificatesv1.CertificateSigningRequestCondition) *certificatesv1.CertificateSigningRequest {
+	csr = csr.DeepCopy()
+	csr.Status.Conditions = conds
+	return csr
+}
+
+var (
+	approvedCondition = certificatesv1.CertificateSigningRequestCondition{
+		Type:   certificatesv1.CertificateApproved,
+		Status: corev1.ConditionTrue,
+		Reason: "Approved",
+	}
+	deniedCondition = certificatesv1.CertificateSigningRequestCondition{
+		Type:    certificatesv1.CertificateDenied,
+		Status:  corev1.ConditionTrue,
+		Reason:  "PolicyViolation",
+		Message: "not allowed",
+	}
+	failedCondition = certificatesv1.CertificateSigningRequestCondition{
+		Type:    certificatesv1.CertificateFailed,
+		Status:  corev1.ConditionTrue,
+		Reason:  "SignerError",
+		Message: "signer unavailable",
+	}
+)
+
+func TestBuildCertificateSigningRequest(t *testing.T) {
+	cr := testCertificateRequest(t)
+
+	csr, err := buildCertificateSigningRequest(cr, &cmapi.KubernetesCSRIssuer{SignerName: testSignerName})
+	require.NoError(t, err)
+
+	assert.Equal(t, testSignerName, csr.Spec.SignerName)
+	assert.Equal(t, cr.Spec.Request, csr.Spec.Request)
+	assert.Equal(t, []certificatesv1.KeyUsage{certificatesv1.UsageDigitalSignature, certificatesv1.UsageServerAuth}, csr.Spec.Usages)
+	assert.Equal(t, ptr.To(int32(3600)), csr.Spec.ExpirationSeconds)
+	assert.Equal(t, map[string]string{
+		CertificateRequestNamespaceAnnotationKey: cr.Namespace,
+		CertificateRequestNameAnnotationKey:      cr.Name,
+	}, csr.Annotations)
+
+	recreated := cr.DeepCopy()
+	recreated.UID = types.UID("other-uid")
+	recreatedCSR, err := buildCertificateSigningRequest(recreated, &cmapi.KubernetesCSRIssuer{SignerName: testSignerName})
+	require.NoError(t, err)
+	assert.NotEqual(t, csr.Name, recreatedCSR.Name, "a re-created CertificateRequest must not reuse the CertificateSigningRequest of its predecessor")
+
+	cr.Spec.Usages = nil
+	cr.Spec.Duration = nil
+	csr, err = buildCertificateSigningRequest(cr, &cmapi.KubernetesCSRIssuer{SignerName: testSignerName})
+	require.NoError(t, err)
+	assert.Equal(t, []certificatesv1.KeyUsage{certificatesv1.UsageDigitalSignature, certificatesv1.UsageKeyEncipherment}, csr.Spec.Usages)
+	assert.Nil(t, csr.Spec.ExpirationSeconds)
+}
+
+func TestSign(t *testing.T) {
+	issuer := testIssuer()
+	cr := testCertificateRequest(t)
+
+	expectedCSR, err := buildCertificateSigningRequest(cr, issuer.Spec.KubernetesCSR)
+	require.NoError(t, err)
+
+	issuedCSR := withConditions(expectedCSR, approvedCondition)
+	issuedCSR.Status.Certificate = []byte("signed certificate")
+
+	foreignCSR := expectedCSR.DeepCopy()
+	foreignCSR.Annotations[CertificateRequestNameAnnotationKey] = "another-cr"
+
+	tests := map[string]struct {
+		issuer      cmapi.GenericIssuer
+		existingCSR *certificatesv1.CertificateSigningRequest
+
+		expectedActions []testpkg.Action
+		expectedEvents  []string
+		expectedReason  string
+		expectedCert    []byte
+		expectedErr     bool
+	}{
+		"the CertificateRequest fails if the issuer is a namespaced Issuer": {
+			issuer: gen.Issuer("kubernetes-csr-issuer",
+				gen.SetIssuerKubernetesCSR(cmapi.KubernetesCSRIssuer{SignerName: testSignerName}),
+			),
+			expectedEvents: []string{
+				"Warning IssuerKindNotSupported Refusing to create a CertificateSigningRequest: the kubernetesCSR issuer type is only supported by ClusterIssuers",
+			},
+			expectedReason: cmapi.CertificateRequestReasonFailed,
+		},
+		"a CertificateSigningRequest is created if one does not exist": {
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewCreateAction(
+					certificatesv1.SchemeGroupVersion.WithResource("certificatesigningrequests"),
+					"",
+					expectedCSR,
+				)),
+			},
+			expectedEvents: []string{
+				`Normal CSRCreated Created CertificateSigningRequest ` + expectedCSR.Name + ` for signer "example.com/test-signer"`,
+			},
+			expectedReason: cmapi.CertificateRequestReasonPending,
+		},
+		"a CertificateSigningRequest not created for this CertificateRequest causes a retry": {
+			existingCSR: foreignCSR,
+			expectedErr: true,
+		},
+		"the CertificateRequest is pending until the CertificateSigningRequest is approved": {
+			existingCSR: expectedCSR,
+			expectedEvents: []string{
+				"Normal CSRPendingApproval Waiting for CertificateSigningRequest " + expectedCSR.Name + " to be approved",
+			},
+			expectedReason: cmapi.CertificateRequestReasonPending,
+		},
+		"the CertificateRequest is pending until the CertificateSigningRequest is signed": {
+			existingCSR: withConditions(expectedCSR, approvedCondition),
+			expectedEvents: []string{
+				`Normal CSRPendingIssuance Waiting for CertificateSigningRequest ` + expectedCSR.Name + ` to be signed by "example.com/test-signer"`,
+			},
+			expectedReason: cmapi.CertificateRequestReasonPending,
+		},
+		"the CertificateRequest fails if the CertificateSigningRequest is denied": {
+			existingCSR: withConditions(expectedCSR, deniedCondition),
+			expectedEvents: []string{
+				"Warning CSRDenied CertificateSigningRequest " + expectedCSR.Name + " has been denied: PolicyViolation: not allowed",
+			},
+			expectedReason: cmapi.CertificateRequestReasonFailed,
+		},
+		"the CertificateRequest fails if the CertificateSigningRequest failed to be signed": {
+			existingCSR: withConditions(expectedCSR, approvedCondition, failedCondition),
+			expectedEvents: []string{
+				"Warning CSRFailed CertificateSigningRequest " + expectedCSR.Name + " failed to be signed: SignerError: signer unavailable",
+			},
+			expectedReason: cmapi.CertificateRequestReasonFailed,
+		},
+		"the signed certificate is returned once the CertificateSigningRequest is issued": {
+			existingCSR:  issuedCSR,
+			expectedCert: []byte("signed certificate"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{
+				T:               t,
+				ExpectedActions: test.expectedActions,
+				ExpectedEvents:  test.expectedEvents,
+			}
+			if test.existingCSR != nil {
+				builder.KubeObjects = []runtime.Object{test.existingCSR}
+			}
+			builder.Init()
+			defer builder.Stop()
+
+			k := NewKubernetesCSR(builder.Context).(*KubernetesCSR)
+			builder.Start()
+
+			cr := cr.DeepCopy()
+			var issuerObj cmapi.GenericIssuer = issuer
+			if test.issuer != nil {
+				issuerObj = test.issuer
+			}
+			resp, err := k.Sign(context.Background(), cr, issuerObj)
+			if test.expectedErr != (err != nil) {
+				t.Errorf("expected error=%t but got: %v", test.expectedErr, err)
+			}
+
+			if test.expectedCert != nil {
+				require.NotNil(t, resp)
+				assert.Equal(t, test.expectedCert, resp.Certificate)
+			} else {
+				assert.Nil(t, resp)
+			}
+
+			if test.expectedReason != "" {
+				cond := apiutil.GetCertificateRequestCondition(cr, cmapi.CertificateRequestConditionReady)
+				require.NotNil(t, cond)
+				assert.Equal(t, test.expectedReason, cond.Reason)
+			}
+
+			builder.CheckAndFinish(resp, err)
+		})
+	}
+}
+
+// fakeSigner approves and signs CertificateSigningRequests for
+// testSignerName using a self-signed CA, in the same way as a signer running
+// in the cluster would.
+type fakeSigner struct {
+	caCert *x509.Certificate
+	caKey  any
+}
+
+func newFakeSigner(t *testing.T) *fakeSigner {
+	key, err := pki.GenerateECPrivateKey(256)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "fake signer CA"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	_, caCert, err := pki.SignCertificate(tmpl, tmpl, key.Public(), key)
+	require.NoError(t, err)
+
+	return &fakeSigner{caCert: caCert, caKey: key}
+}
+
+func (f *fakeSigner) approveAndSign(t *testing.T, builder *testpkg.Builder, name string) {
+	ctx := context.Background()
+	client := builder.Client.CertificatesV1().CertificateSigningRequests()
+
+	csr, err := client.Get(ctx, name, metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, testSignerName, csr.Spec.SignerName)
+
+	csr.Status.Conditions = append(csr.Status.Conditions, approvedCondition)
+	csr, err = client.UpdateApproval(ctx, name, csr, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	tmpl, err := pki.CertificateTemplateFromCertificateSigningRequest(csr)
+	require.NoError(t, err)
+	certPEM, _, err := pki.SignCertificate(tmpl, f.caCert, tmpl.PublicKey, f.caKey)
+	require.NoError(t, err)
+
+	csr.Status.Certificate = certPEM
+	_, err = client.UpdateStatus(ctx, csr, metav1.UpdateOptions{})
+	require.NoError(t, err)
+}
+
+func TestSignWithFakeSigner(t *testing.T) {
+	issuer := testIssuer()
+	cr := testCertificateRequest(t)
+	signer := newFakeSigner(t)
+
+	builder := &testpkg.Builder{T: t}
+	builder.Init()
+	defer builder.Stop()
+
+	k := NewKubernetesCSR(builder.Context).(*KubernetesCSR)
+	builder.Start()
+
+	resp, err := k.Sign(context.Background(), cr, issuer)
+	require.NoError(t, err)
+	require.Nil(t, resp, "no certificate should be returned before the CertificateSigningRequest is signed")
+
+	expectedCSR, err := buildCertificateSigningRequest(cr, issuer.Spec.KubernetesCSR)
+	require.NoError(t, err)
+	builder.Sync()
+
+	signer.approveAndSign(t, builder, expectedCSR.Name)
+	builder.Sync()
+
+	resp, err = k.Sign(context.Background(), cr, issuer)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+
+	cert, err := pki.DecodeX509CertificateBytes(resp.Certificate)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"example.com"}, cert.DNSNames)
+	assert.Equal(t, []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}, cert.ExtKeyUsage)
+	assert.NoError(t, cert.CheckSignatureFrom(signer.caCert))
+	assert.Equal(t, time.Hour, cert.NotAfter.Sub(cert.NotBefore))
+}
@@ -37,6 +37,7 @@ import (
 	"github.com/cert-manager/cert-manager/pkg/controller/certificaterequests"
 	crutil "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/util"
 	"github.com/cert-manager/cert-manager/pkg/issuer"
+	selfsignedissuer "github.com/cert-manager/cert-manager/pkg/issuer/selfsigned"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 	cmerrors "github.com/cert-manager/cert-manager/pkg/util/errors"
 	"github.com/cert-manager/cert-manager/pkg/util/kube"
@@ -158,11 +159,19 @@ func (s *SelfSigned) Sign(ctx context.Context, cr *cmapi.CertificateRequest, iss
 
 	template.CRLDistributionPoints = issuerObj.GetSpec().SelfSigned.CRLDistributionPoints
 
-	if template.Subject.String() == "" {
+	parent, err := selfsignedissuer.ParentCertificate(issuerObj.GetSpec().SelfSigned, template)
+	if err != nil {
+		message := "Error generating certificate template"
+		s.reporter.Failed(cr, err, "ErrorGenerating", message)
+		log.Error(err, message)
+		return nil, nil
+	}
+
+	if parent == template && template.Subject.String() == "" {
 		// RFC 5280 (https://tools.ietf.org/html/rfc5280#section-4.1.2.4) says that:
 		// "The issuer field MUST contain a non-empty distinguished name (DN)."
-		// Since we're creating a self-signed cert, the issuer will match whatever is
-		// in the template's subject DN.
+		// Since we're creating a self-signed cert without an explicit issuerDN, the
+		// issuer will match whatever is in the template's subject DN.
 		log.V(logf.DebugLevel).Info("issued cert will have an empty issuer DN, which contravenes RFC 5280. emitting warning event")
 		s.recorder.Event(cr, corev1.EventTypeWarning, "BadConfig", emptyDNMessage)
 	}
@@ -191,7 +200,7 @@ func (s *SelfSigned) Sign(ctx context.Context, cr *cmapi.CertificateRequest, iss
 	}
 
 	// sign and encode the certificate
-	certPem, _, err := s.signingFn(template, template, publickey, privatekey)
+	certPem, _, err := s.signingFn(template, parent, publickey, privatekey)
 	if err != nil {
 		message := "Error signing certificate"
 		s.reporter.Failed(cr, err, "ErrorSigning", message)
@@ -120,6 +120,27 @@ func TestSign(t *testing.T) {
 
 	csrEmptyCertPEM := generateCSR(t, skEC, "")
 
+	skEC521, err := pki.GenerateECPrivateKey(pki.ECCurve521)
+	if err != nil {
+		t.Errorf("failed to generate P-521 ECDSA private key: %s", err)
+		t.FailNow()
+	}
+	skEC521PEM, err := pki.EncodeECPrivateKey(skEC521)
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	ec521KeySecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      rsaKeySecret.Name,
+			Namespace: gen.DefaultTestNamespace,
+		},
+		Data: map[string][]byte{
+			corev1.TLSPrivateKeyKey: skEC521PEM,
+		},
+	}
+	csrEC521PEM := generateCSR(t, skEC521, "test-ec-521")
+
 	baseCRNotApproved := gen.CertificateRequest("test-cr",
 		gen.SetCertificateRequestAnnotations(
 			map[string]string{
@@ -154,6 +175,9 @@ func TestSign(t *testing.T) {
 	ecCR := gen.CertificateRequestFrom(baseCR,
 		gen.SetCertificateRequestCSR(csrECPEM),
 	)
+	ec521CR := gen.CertificateRequestFrom(baseCR,
+		gen.SetCertificateRequestCSR(csrEC521PEM),
+	)
 	emptyCR := gen.CertificateRequestFrom(baseCR,
 		gen.SetCertificateRequestCSR(csrEmptyCertPEM),
 	)
@@ -180,6 +204,17 @@ func TestSign(t *testing.T) {
 		t.FailNow()
 	}
 
+	templateEC521, err := pki.CertificateTemplateFromCertificateRequest(ec521CR)
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+	certEC521PEM, _, err := pki.SignCertificate(templateEC521, templateEC521, skEC521.Public(), skEC521)
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
 	templateEmptyCert, err := pki.CertificateTemplateFromCertificateRequest(emptyCR)
 	if err != nil {
 		t.Error(err)
@@ -219,10 +254,11 @@ func TestSign(t *testing.T) {
 								Type:               cmapi.CertificateRequestConditionReady,
 								Status:             cmmeta.ConditionFalse,
 								Reason:             "Denied",
-								Message:            "The CertificateRequest was denied by an approval controller",
+								Message:            `The CertificateRequest was denied by an approval controller with reason "Foo": Certificate request has been denied by cert-manager.io`,
 								LastTransitionTime: &metaFixedClockStart,
 							}),
 							gen.SetCertificateRequestFailureTime(metaFixedClockStart),
+							gen.SetCertificateRequestDenied("Foo", metaFixedClockStart),
 						),
 					)),
 				},
@@ -256,6 +292,11 @@ func TestSign(t *testing.T) {
 								Message:            `Annotation "cert-manager.io/private-key-secret-name" missing or reference empty: secret name missing`,
 								LastTransitionTime: &metaFixedClockStart,
 							}),
+							gen.AddCertificateRequestIssuanceFailure(cmapi.CertificateRequestIssuanceFailure{
+								Time:    metaFixedClockStart,
+								Reason:  "MissingAnnotation",
+								Message: `Annotation "cert-manager.io/private-key-secret-name" missing or reference empty: secret name missing`,
+							}),
 							gen.SetCertificateRequestFailureTime(metaFixedClockStart),
 						),
 					)),
@@ -290,6 +331,11 @@ func TestSign(t *testing.T) {
 								Message:            `Annotation "cert-manager.io/private-key-secret-name" missing or reference empty: secret name missing`,
 								LastTransitionTime: &metaFixedClockStart,
 							}),
+							gen.AddCertificateRequestIssuanceFailure(cmapi.CertificateRequestIssuanceFailure{
+								Time:    metaFixedClockStart,
+								Reason:  "MissingAnnotation",
+								Message: `Annotation "cert-manager.io/private-key-secret-name" missing or reference empty: secret name missing`,
+							}),
 							gen.SetCertificateRequestFailureTime(metaFixedClockStart),
 						),
 					)),
@@ -317,6 +363,11 @@ func TestSign(t *testing.T) {
 								Message:            `Referenced secret default-unit-test-ns/test-rsa-key not found: secret "test-rsa-key" not found`,
 								LastTransitionTime: &metaFixedClockStart,
 							}),
+							gen.AddCertificateRequestIssuanceFailure(cmapi.CertificateRequestIssuanceFailure{
+								Time:    metaFixedClockStart,
+								Reason:  "MissingSecret",
+								Message: `Referenced secret default-unit-test-ns/test-rsa-key not found: secret "test-rsa-key" not found`,
+							}),
 						),
 					)),
 				},
@@ -343,6 +394,11 @@ func TestSign(t *testing.T) {
 								Message:            `Failed to get key "test-rsa-key" referenced in annotation "cert-manager.io/private-key-secret-name": error decoding private key PEM block`,
 								LastTransitionTime: &metaFixedClockStart,
 							}),
+							gen.AddCertificateRequestIssuanceFailure(cmapi.CertificateRequestIssuanceFailure{
+								Time:    metaFixedClockStart,
+								Reason:  "ErrorParsingKey",
+								Message: `Failed to get key "test-rsa-key" referenced in annotation "cert-manager.io/private-key-secret-name": error decoding private key PEM block`,
+							}),
 						),
 					)),
 				},
@@ -398,6 +454,11 @@ func TestSign(t *testing.T) {
 								Message:            "Failed to get certificate key pair from secret default-unit-test-ns/test-rsa-key: this is a network error",
 								LastTransitionTime: &metaFixedClockStart,
 							}),
+							gen.AddCertificateRequestIssuanceFailure(cmapi.CertificateRequestIssuanceFailure{
+								Time:    metaFixedClockStart,
+								Reason:  "ErrorGettingSecret",
+								Message: "Failed to get certificate key pair from secret default-unit-test-ns/test-rsa-key: this is a network error",
+							}),
 						),
 					)),
 				},
@@ -434,6 +495,11 @@ func TestSign(t *testing.T) {
 								Message:            "Error generating certificate template: CSR not signed by referenced private key",
 								LastTransitionTime: &metaFixedClockStart,
 							}),
+							gen.AddCertificateRequestIssuanceFailure(cmapi.CertificateRequestIssuanceFailure{
+								Time:    metaFixedClockStart,
+								Reason:  "ErrorKeyMatch",
+								Message: "Error generating certificate template: CSR not signed by referenced private key",
+							}),
 							gen.SetCertificateRequestFailureTime(metaFixedClockStart),
 						),
 					)),
@@ -464,6 +530,11 @@ func TestSign(t *testing.T) {
 								Message:            "Error signing certificate: this is a signing error",
 								LastTransitionTime: &metaFixedClockStart,
 							}),
+							gen.AddCertificateRequestIssuanceFailure(cmapi.CertificateRequestIssuanceFailure{
+								Time:    metaFixedClockStart,
+								Reason:  "ErrorSigning",
+								Message: "Error signing certificate: this is a signing error",
+							}),
 							gen.SetCertificateRequestFailureTime(metaFixedClockStart),
 						),
 					)),
@@ -546,6 +617,50 @@ func TestSign(t *testing.T) {
 				},
 			},
 		},
+		"should sign a P-521 EC key set condition to Ready": {
+			certificateRequest: ec521CR.DeepCopy(),
+			signingFn: func(c1 *x509.Certificate, c2 *x509.Certificate, pk crypto.PublicKey, sk interface{}) ([]byte, *x509.Certificate, error) {
+				_, cert, err := pki.SignCertificate(c1, c2, pk, sk)
+				if err != nil {
+					return nil, nil, err
+				}
+
+				if cert.SignatureAlgorithm != x509.ECDSAWithSHA512 {
+					return nil, nil, fmt.Errorf("invalid test: expected signature algorithm %s, got %s", x509.ECDSAWithSHA512, cert.SignatureAlgorithm)
+				}
+
+				if err := cert.CheckSignature(cert.SignatureAlgorithm, cert.RawTBSCertificate, cert.Signature); err != nil {
+					return nil, nil, fmt.Errorf("invalid test: failed to verify self-signed certificate: %w", err)
+				}
+
+				return certEC521PEM, nil, nil
+			},
+			builder: &testpkg.Builder{
+				KubeObjects:        []runtime.Object{ec521KeySecret},
+				CertManagerObjects: []runtime.Object{ec521CR.DeepCopy(), baseIssuer},
+				ExpectedEvents: []string{
+					"Normal CertificateIssued Certificate fetched from issuer successfully",
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(ec521CR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionTrue,
+								Reason:             cmapi.CertificateRequestReasonIssued,
+								Message:            "Certificate fetched from issuer successfully",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.SetCertificateRequestCertificate(certEC521PEM),
+							gen.SetCertificateRequestCA(certEC521PEM),
+						),
+					)),
+				},
+			},
+		},
 		"should sign a cert with no subject DN and create a warning event": {
 			certificateRequest: emptyCR.DeepCopy(),
 			signingFn: func(c1 *x509.Certificate, c2 *x509.Certificate, pk crypto.PublicKey, sk interface{}) ([]byte, *x509.Certificate, error) {
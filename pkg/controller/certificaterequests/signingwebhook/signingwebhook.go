@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signingwebhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+
+	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificaterequests"
+	crutil "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/util"
+	issuerpkg "github.com/cert-manager/cert-manager/pkg/issuer"
+	signingwebhookissuer "github.com/cert-manager/cert-manager/pkg/issuer/signingwebhook"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	cmerrors "github.com/cert-manager/cert-manager/pkg/util/errors"
+)
+
+const (
+	// CRControllerName is the name of the signing webhook certificate
+	// requests controller.
+	CRControllerName = "certificaterequests-issuer-signingwebhook"
+)
+
+// SigningWebhook is a signing webhook specific implementation of the
+// pkg/controller/certificaterequests.Issuer interface.
+type SigningWebhook struct {
+	issuerOptions controllerpkg.IssuerOptions
+	secretsLister internalinformers.SecretLister
+	reporter      *crutil.Reporter
+
+	clientBuilder signingwebhookissuer.ClientBuilder
+}
+
+func init() {
+	// create certificate request controller for signing webhook issuer
+	controllerpkg.Register(CRControllerName, func(ctx *controllerpkg.ContextFactory) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, CRControllerName).
+			For(certificaterequests.New(apiutil.IssuerSigningWebhook, NewSigningWebhook)).
+			Complete()
+	})
+}
+
+// NewSigningWebhook returns a new SigningWebhook instance with the given
+// controller context.
+func NewSigningWebhook(ctx *controllerpkg.Context) certificaterequests.Issuer {
+	return &SigningWebhook{
+		issuerOptions: ctx.IssuerOptions,
+		secretsLister: ctx.KubeSharedInformerFactory.Secrets().Lister(),
+		reporter:      crutil.NewReporter(ctx.Clock, ctx.Recorder),
+		clientBuilder: signingwebhookissuer.New,
+	}
+}
+
+// Sign POSTs the X.509 certificate signing request of the CertificateRequest
+// to the signing webhook configured on the issuer, and returns the signed
+// certificate from its response.
+func (s *SigningWebhook) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuerObj cmapi.GenericIssuer) (*issuerpkg.IssueResponse, error) {
+	log := logf.FromContext(ctx, "sign")
+	log = logf.WithRelatedResource(log, issuerObj)
+
+	resourceNamespace := s.issuerOptions.ResourceNamespace(issuerObj)
+
+	client, err := s.clientBuilder(resourceNamespace, s.secretsLister, issuerObj)
+	if k8sErrors.IsNotFound(err) {
+		message := "Required secret resource not found"
+
+		s.reporter.Pending(cr, err, "SecretMissing", message)
+		log.Error(err, message)
+		return nil, nil
+	}
+
+	if err != nil {
+		message := "Failed to initialise signing webhook client"
+		s.reporter.Pending(cr, err, "SigningWebhookInitError", message)
+		log.Error(err, message)
+
+		if cmerrors.IsInvalidData(err) {
+			return nil, nil // Don't retry, wait for the issuer to be updated
+		}
+
+		return nil, err // Return error to requeue and retry
+	}
+
+	resp, err := client.Sign(ctx, &signingwebhookissuer.SignRequest{
+		Request:  cr.Spec.Request,
+		Duration: apiutil.DefaultCertDuration(cr.Spec.Duration).String(),
+		IsCA:     cr.Spec.IsCA,
+		Usages:   cr.Spec.Usages,
+	})
+	var statusErr *signingwebhookissuer.StatusError
+	if errors.As(err, &statusErr) && statusErr.Permanent() {
+		message := "Signing webhook rejected the certificate request"
+
+		s.reporter.Failed(cr, err, "SigningError", message)
+		log.Error(err, message)
+
+		return nil, nil
+	}
+
+	if errors.Is(err, signingwebhookissuer.ErrPublicKeyMismatch) {
+		message := "Signing webhook returned an invalid certificate"
+
+		s.reporter.Failed(cr, err, "SigningError", message)
+		log.Error(err, message)
+
+		return nil, nil
+	}
+
+	if err != nil {
+		message := fmt.Sprintf("Failed to call signing webhook %q", issuerObj.GetSpec().SigningWebhook.URL)
+
+		s.reporter.Pending(cr, err, "SigningWebhookError", message)
+		log.Error(err, message)
+
+		return nil, err // Return error to requeue and retry
+	}
+
+	log.V(logf.DebugLevel).Info("certificate issued")
+
+	return &issuerpkg.IssueResponse{
+		Certificate: resp.Certificate,
+		CA:          resp.CA,
+	}, nil
+}
@@ -0,0 +1,346 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signingwebhook
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+	"k8s.io/utils/clock"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	"github.com/cert-manager/cert-manager/pkg/apis/certmanager"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificaterequests"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	signingwebhookissuer "github.com/cert-manager/cert-manager/pkg/issuer/signingwebhook"
+	unitcrypto "github.com/cert-manager/cert-manager/test/unit/crypto"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+var (
+	fixedClockStart = time.Now()
+	fixedClock      = fakeclock.NewFakeClock(fixedClockStart)
+)
+
+func TestSign(t *testing.T) {
+	metaFixedClockStart := metav1.NewTime(fixedClockStart)
+
+	// handler is replaced by each test case to control how the signing
+	// webhook responds.
+	var handler http.HandlerFunc
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler(w, r)
+	}))
+	defer ts.Close()
+
+	bundle := unitcrypto.MustCreateCryptoBundle(t,
+		&cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "example.com"}},
+		clock.RealClock{},
+	)
+	otherBundle := unitcrypto.MustCreateCryptoBundle(t,
+		&cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "example.com"}},
+		clock.RealClock{},
+	)
+
+	baseIssuer := gen.Issuer("signing-webhook-issuer",
+		gen.SetIssuerSigningWebhook(cmapi.SigningWebhookIssuer{
+			URL:      ts.URL,
+			CABundle: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw}),
+		}),
+		gen.AddIssuerCondition(cmapi.IssuerCondition{
+			Type:   cmapi.IssuerConditionReady,
+			Status: cmmeta.ConditionTrue,
+		}),
+	)
+
+	baseCR := gen.CertificateRequest("test-cr",
+		gen.SetCertificateRequestCSR(bundle.CSRBytes),
+		gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{
+			Name:  baseIssuer.Name,
+			Group: certmanager.GroupName,
+			Kind:  baseIssuer.Kind,
+		}),
+		gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+			Type:               cmapi.CertificateRequestConditionApproved,
+			Status:             cmmeta.ConditionTrue,
+			Reason:             "cert-manager.io",
+			Message:            "Certificate request has been approved by cert-manager.io",
+			LastTransitionTime: &metaFixedClockStart,
+		}),
+	)
+
+	tests := map[string]testT{
+		"a signed certificate returned by the signing webhook should set the CertificateRequest Ready": {
+			certificateRequest: baseCR.DeepCopy(),
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_ = json.NewEncoder(w).Encode(signingwebhookissuer.SignResponse{Certificate: bundle.CertBytes, CA: bundle.CertBytes})
+			},
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{baseCR.DeepCopy(), baseIssuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Normal CertificateIssued Certificate fetched from issuer successfully",
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestCertificate(bundle.CertBytes),
+							gen.SetCertificateRequestCA(bundle.CertBytes),
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionTrue,
+								Reason:             cmapi.CertificateRequestReasonIssued,
+								Message:            "Certificate fetched from issuer successfully",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+						),
+					)),
+				},
+			},
+		},
+		"a request rejected by the signing webhook should fail the CertificateRequest": {
+			certificateRequest: baseCR.DeepCopy(),
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, "denied by policy", http.StatusForbidden)
+			},
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{baseCR.DeepCopy(), baseIssuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Warning SigningError Signing webhook rejected the certificate request: signing webhook responded with status 403: denied by policy",
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonFailed,
+								Message:            "Signing webhook rejected the certificate request: signing webhook responded with status 403: denied by policy",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.AddCertificateRequestIssuanceFailure(cmapi.CertificateRequestIssuanceFailure{
+								Time:    metaFixedClockStart,
+								Reason:  "SigningError",
+								Message: "Signing webhook rejected the certificate request: signing webhook responded with status 403: denied by policy",
+							}),
+							gen.SetCertificateRequestFailureTime(metaFixedClockStart),
+						),
+					)),
+				},
+			},
+		},
+		"an unavailable signing webhook should report pending and return an error to retry": {
+			certificateRequest: baseCR.DeepCopy(),
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, "offline CA unavailable", http.StatusServiceUnavailable)
+			},
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{baseCR.DeepCopy(), baseIssuer.DeepCopy()},
+				ExpectedEvents: []string{
+					fmt.Sprintf("Normal SigningWebhookError Failed to call signing webhook %q: signing webhook responded with status 503: offline CA unavailable", ts.URL),
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonPending,
+								Message:            fmt.Sprintf("Failed to call signing webhook %q: signing webhook responded with status 503: offline CA unavailable", ts.URL),
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.AddCertificateRequestIssuanceFailure(cmapi.CertificateRequestIssuanceFailure{
+								Time:    metaFixedClockStart,
+								Reason:  "SigningWebhookError",
+								Message: fmt.Sprintf("Failed to call signing webhook %q: signing webhook responded with status 503: offline CA unavailable", ts.URL),
+							}),
+						),
+					)),
+				},
+			},
+			expectedErr: true,
+		},
+		"should exit nil and set status pending if referenced issuer is not ready": {
+			certificateRequest: baseCR.DeepCopy(),
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				t.Error("the signing webhook should not be called for an issuer which is not ready")
+			},
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{baseCR.DeepCopy(),
+					gen.Issuer(baseIssuer.Name,
+						gen.SetIssuerSigningWebhook(*baseIssuer.Spec.SigningWebhook),
+					)},
+				ExpectedEvents: []string{
+					"Normal IssuerNotReady Referenced issuer does not have a Ready status condition",
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             "Pending",
+								Message:            "Referenced issuer does not have a Ready status condition",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+						),
+					)),
+				},
+			},
+		},
+		"a malformed response from the signing webhook should report pending and return an error to retry": {
+			certificateRequest: baseCR.DeepCopy(),
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte("{"))
+			},
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{baseCR.DeepCopy(), baseIssuer.DeepCopy()},
+				ExpectedEvents: []string{
+					fmt.Sprintf("Normal SigningWebhookError Failed to call signing webhook %q: failed to decode signing webhook response: unexpected end of JSON input", ts.URL),
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonPending,
+								Message:            fmt.Sprintf("Failed to call signing webhook %q: failed to decode signing webhook response: unexpected end of JSON input", ts.URL),
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.AddCertificateRequestIssuanceFailure(cmapi.CertificateRequestIssuanceFailure{
+								Time:    metaFixedClockStart,
+								Reason:  "SigningWebhookError",
+								Message: fmt.Sprintf("Failed to call signing webhook %q: failed to decode signing webhook response: unexpected end of JSON input", ts.URL),
+							}),
+						),
+					)),
+				},
+			},
+			expectedErr: true,
+		},
+		"a certificate for a different public key should fail the CertificateRequest": {
+			certificateRequest: baseCR.DeepCopy(),
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_ = json.NewEncoder(w).Encode(signingwebhookissuer.SignResponse{Certificate: otherBundle.CertBytes})
+			},
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{baseCR.DeepCopy(), baseIssuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Warning SigningError Signing webhook returned an invalid certificate: the public key of the signed certificate does not match the certificate signing request",
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonFailed,
+								Message:            "Signing webhook returned an invalid certificate: the public key of the signed certificate does not match the certificate signing request",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.AddCertificateRequestIssuanceFailure(cmapi.CertificateRequestIssuanceFailure{
+								Time:    metaFixedClockStart,
+								Reason:  "SigningError",
+								Message: "Signing webhook returned an invalid certificate: the public key of the signed certificate does not match the certificate signing request",
+							}),
+							gen.SetCertificateRequestFailureTime(metaFixedClockStart),
+						),
+					)),
+				},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			fixedClock.SetTime(fixedClockStart)
+			test.builder.Clock = fixedClock
+			handler = test.handler
+			runTest(t, test)
+		})
+	}
+}
+
+type testT struct {
+	builder            *testpkg.Builder
+	certificateRequest *cmapi.CertificateRequest
+
+	// handler is how the signing webhook responds to the request.
+	handler http.HandlerFunc
+
+	expectedErr bool
+}
+
+func runTest(t *testing.T, test testT) {
+	test.builder.T = t
+	test.builder.Init()
+	defer test.builder.Stop()
+
+	signingWebhook := NewSigningWebhook(test.builder.Context)
+
+	controller := certificaterequests.New(
+		apiutil.IssuerSigningWebhook,
+		func(*controllerpkg.Context) certificaterequests.Issuer { return signingWebhook },
+	)
+
+	if _, _, err := controller.Register(test.builder.Context); err != nil {
+		t.Errorf("failed to register context with controller: %v", err)
+	}
+
+	test.builder.Start()
+
+	err := controller.Sync(context.Background(), test.certificateRequest)
+	if err != nil && !test.expectedErr {
+		t.Errorf("expected to not get an error, but got: %v", err)
+	}
+	if err == nil && test.expectedErr {
+		t.Errorf("expected to get an error but did not get one")
+	}
+
+	test.builder.CheckAndFinish(err)
+}
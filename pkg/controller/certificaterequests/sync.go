@@ -50,7 +50,18 @@ func (c *Controller) Sync(ctx context.Context, cr *cmapi.CertificateRequest) (er
 
 	crCopy := cr.DeepCopy()
 
+	key, err := keyFunc(cr)
+	if err != nil {
+		return err
+	}
+
 	defer func() {
+		// Give up the issuer's in-flight slot once the request will no longer
+		// be processed, rather than when Sign returns, so that issuers which
+		// sign asynchronously stay limited while their requests are pending.
+		if certificateRequestIsFinal(crCopy) {
+			c.inFlight.release(key)
+		}
 		if saveErr := c.updateCertificateRequestStatusAndAnnotations(ctx, cr, crCopy); saveErr != nil {
 			err = utilerrors.NewAggregate([]error{saveErr, err})
 		}
@@ -135,6 +146,12 @@ func (c *Controller) Sync(ctx context.Context, cr *cmapi.CertificateRequest) (er
 		return nil
 	}
 
+	if !c.inFlight.tryAcquire(issuerObj, key) {
+		dbg.Info("issuer is already processing the maximum number of certificate requests, requeueing")
+		c.queue.AddAfter(key, inFlightRequeueDelay)
+		return nil
+	}
+
 	dbg.Info("invoking sign function as existing certificate does not exist")
 
 	// Attempt to call the Sign function on our issuer
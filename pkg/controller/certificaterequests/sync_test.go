@@ -211,10 +211,11 @@ func TestSync(t *testing.T) {
 								Type:               cmapi.CertificateRequestConditionReady,
 								Status:             cmmeta.ConditionFalse,
 								Reason:             "Denied",
-								Message:            "The CertificateRequest was denied by an approval controller",
+								Message:            `The CertificateRequest was denied by an approval controller with reason "Foo": Certificate request has been denied by cert-manager.io`,
 								LastTransitionTime: &nowMetaTime,
 							}),
 							gen.SetCertificateRequestFailureTime(nowMetaTime),
+							gen.SetCertificateRequestDenied("Foo", nowMetaTime),
 						),
 					)),
 				},
@@ -258,10 +259,11 @@ func TestSync(t *testing.T) {
 								Type:               cmapi.CertificateRequestConditionReady,
 								Status:             cmmeta.ConditionFalse,
 								Reason:             "Denied",
-								Message:            "The CertificateRequest was denied by an approval controller",
+								Message:            `The CertificateRequest was denied by an approval controller with reason "Foo": Certificate request has been denied by cert-manager.io`,
 								LastTransitionTime: &nowMetaTime,
 							}),
 							gen.SetCertificateRequestFailureTime(nowMetaTime),
+							gen.SetCertificateRequestDenied("Foo", nowMetaTime),
 						),
 					)),
 				},
@@ -280,10 +282,11 @@ func TestSync(t *testing.T) {
 					Type:               cmapi.CertificateRequestConditionReady,
 					Status:             cmmeta.ConditionFalse,
 					Reason:             "Denied",
-					Message:            "The CertificateRequest was denied by an approval controller",
+					Message:            `The CertificateRequest was denied by an approval controller with reason "Foo": Certificate request has been denied by cert-manager.io`,
 					LastTransitionTime: &nowMetaTime,
 				}),
 				gen.SetCertificateRequestFailureTime(nowMetaTime),
+				gen.SetCertificateRequestDenied("Foo", nowMetaTime),
 			),
 			builder: &testpkg.Builder{
 				CertManagerObjects: []runtime.Object{baseIssuer,
@@ -299,10 +302,11 @@ func TestSync(t *testing.T) {
 							Type:               cmapi.CertificateRequestConditionReady,
 							Status:             cmmeta.ConditionFalse,
 							Reason:             "Denied",
-							Message:            "The CertificateRequest was denied by an approval controller",
+							Message:            `The CertificateRequest was denied by an approval controller with reason "Foo": Certificate request has been denied by cert-manager.io`,
 							LastTransitionTime: &nowMetaTime,
 						}),
 						gen.SetCertificateRequestFailureTime(nowMetaTime),
+						gen.SetCertificateRequestDenied("Foo", nowMetaTime),
 					),
 				},
 				ExpectedEvents:  []string{},
@@ -346,10 +350,11 @@ func TestSync(t *testing.T) {
 								Type:               cmapi.CertificateRequestConditionReady,
 								Status:             cmmeta.ConditionFalse,
 								Reason:             "Denied",
-								Message:            "The CertificateRequest was denied by an approval controller",
+								Message:            `The CertificateRequest was denied by an approval controller with reason "Foo": Certificate request has been denied by cert-manager.io`,
 								LastTransitionTime: &nowMetaTime,
 							}),
 							gen.SetCertificateRequestFailureTime(nowMetaTime),
+							gen.SetCertificateRequestDenied("Foo", nowMetaTime),
 						),
 					)),
 				},
@@ -440,6 +445,11 @@ func TestSync(t *testing.T) {
 								Message:            `Referenced "Issuer" not found: issuer.cert-manager.io "test-issuer" not found`,
 								LastTransitionTime: &nowMetaTime,
 							}),
+							gen.AddCertificateRequestIssuanceFailure(cmapi.CertificateRequestIssuanceFailure{
+								Time:    nowMetaTime,
+								Reason:  "IssuerNotFound",
+								Message: `Referenced "Issuer" not found: issuer.cert-manager.io "test-issuer" not found`,
+							}),
 						),
 					)),
 				},
@@ -479,6 +489,11 @@ func TestSync(t *testing.T) {
 								Message:            "Missing issuer type: no issuer specified for Issuer 'default-unit-test-ns/test-issuer'",
 								LastTransitionTime: &nowMetaTime,
 							}),
+							gen.AddCertificateRequestIssuanceFailure(cmapi.CertificateRequestIssuanceFailure{
+								Time:    nowMetaTime,
+								Reason:  "IssuerTypeMissing",
+								Message: "Missing issuer type: no issuer specified for Issuer 'default-unit-test-ns/test-issuer'",
+							}),
 						),
 					)),
 				},
@@ -601,6 +616,11 @@ func TestSync(t *testing.T) {
 								Message:            "Failed to decode returned certificate: error decoding certificate PEM block",
 								LastTransitionTime: &nowMetaTime,
 							}),
+							gen.AddCertificateRequestIssuanceFailure(cmapi.CertificateRequestIssuanceFailure{
+								Time:    nowMetaTime,
+								Reason:  "DecodeError",
+								Message: "Failed to decode returned certificate: error decoding certificate PEM block",
+							}),
 							gen.SetCertificateRequestFailureTime(nowMetaTime),
 						),
 					)),
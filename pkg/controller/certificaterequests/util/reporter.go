@@ -31,6 +31,11 @@ import (
 
 const (
 	readyMessage = "Certificate fetched from issuer successfully"
+
+	// MaxIssuanceFailures is the maximum number of failures recorded in the
+	// IssuanceFailures of a CertificateRequest's status. Once reached, the
+	// oldest failure is discarded each time a new failure is recorded.
+	MaxIssuanceFailures = 5
 )
 
 // A Reporter updates the Status of a CertificateRequest and sends an event
@@ -60,19 +65,39 @@ func (r *Reporter) Failed(cr *cmapi.CertificateRequest, err error, reason, messa
 	r.recorder.Event(cr, corev1.EventTypeWarning, reason, message)
 	apiutil.SetCertificateRequestCondition(cr, cmapi.CertificateRequestConditionReady,
 		cmmeta.ConditionFalse, cmapi.CertificateRequestReasonFailed, message)
-
+	r.recordIssuanceFailure(cr, reason, message)
 }
 
 // Denied marks a CertificateRequest as terminally denied. No event is sent as it is
 // expected to be sent by the approval controller.
+// The Ready condition reason is always Denied, which distinguishes denied
+// requests from Failed ones. The reason of the Denied condition, which
+// identifies the approval controller, and the time at which the request was
+// denied are recorded in the DeniedBy and DeniedTime status fields, and the
+// FailureTime is set to the time at which the request was denied.
 func (r *Reporter) Denied(cr *cmapi.CertificateRequest) {
-	// Set the FailureTime to c.clock.Now(), only if it has not been already set.
+	denied := apiutil.GetCertificateRequestCondition(cr, cmapi.CertificateRequestConditionDenied)
+
+	deniedTime := metav1.NewTime(r.clock.Now())
+	if denied != nil && denied.LastTransitionTime != nil {
+		deniedTime = *denied.LastTransitionTime
+	}
+	if denied != nil {
+		cr.Status.DeniedBy = denied.Reason
+	}
+	// Set the DeniedTime and FailureTime to the time of denial, only if they
+	// have not been already set.
+	if cr.Status.DeniedTime == nil {
+		cr.Status.DeniedTime = deniedTime.DeepCopy()
+	}
 	if cr.Status.FailureTime == nil {
-		nowTime := metav1.NewTime(r.clock.Now())
-		cr.Status.FailureTime = &nowTime
+		cr.Status.FailureTime = deniedTime.DeepCopy()
 	}
 
 	message := "The CertificateRequest was denied by an approval controller"
+	if denied != nil && denied.Reason != "" {
+		message = fmt.Sprintf("%s with reason %q: %s", message, denied.Reason, denied.Message)
+	}
 	apiutil.SetCertificateRequestCondition(cr, cmapi.CertificateRequestConditionReady,
 		cmmeta.ConditionFalse, cmapi.CertificateRequestReasonDenied, message)
 }
@@ -87,9 +112,13 @@ func (r *Reporter) InvalidRequest(cr *cmapi.CertificateRequest, reason, message
 // Pending marks a CertificateRequest as pending and sends a corresponding event.
 //
 // The event is only sent if the CertificateRequest is not already pending.
+// If err is not nil, it is recorded as an issuance failure. Callers which are
+// only waiting on the issuer, rather than handling an error, should pass a nil
+// err so that the IssuanceFailures are not filled with expected retries.
 func (r *Reporter) Pending(cr *cmapi.CertificateRequest, err error, reason, message string) {
 	if err != nil {
 		message = fmt.Sprintf("%s: %v", message, err)
+		r.recordIssuanceFailure(cr, reason, message)
 	}
 
 	// If pending condition not already set then fire a Pending Event. This is to
@@ -109,3 +138,27 @@ func (r *Reporter) Ready(cr *cmapi.CertificateRequest) {
 	apiutil.SetCertificateRequestCondition(cr, cmapi.CertificateRequestConditionReady,
 		cmmeta.ConditionTrue, cmapi.CertificateRequestReasonIssued, readyMessage)
 }
+
+// recordIssuanceFailure appends a failure to the IssuanceFailures of the
+// CertificateRequest, discarding the oldest failures so that at most
+// MaxIssuanceFailures are kept.
+// If the most recent failure has the same reason, it is updated in place and
+// keeps its original time, so that a CertificateRequest which is repeatedly
+// synced while failing for the same reason does not have its status changed
+// on every sync.
+func (r *Reporter) recordIssuanceFailure(cr *cmapi.CertificateRequest, reason, message string) {
+	if n := len(cr.Status.IssuanceFailures); n > 0 && cr.Status.IssuanceFailures[n-1].Reason == reason {
+		cr.Status.IssuanceFailures[n-1].Message = message
+		return
+	}
+
+	failures := append(cr.Status.IssuanceFailures, cmapi.CertificateRequestIssuanceFailure{
+		Time:    metav1.NewTime(r.clock.Now()),
+		Reason:  reason,
+		Message: message,
+	})
+	if len(failures) > MaxIssuanceFailures {
+		failures = failures[len(failures)-MaxIssuanceFailures:]
+	}
+	cr.Status.IssuanceFailures = failures
+}
@@ -23,6 +23,7 @@ import (
 	"testing"
 	"time"
 
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	clocktesting "k8s.io/utils/clock/testing"
 
@@ -48,6 +49,8 @@ type reporterT struct {
 	expectedEvents      []string
 	expectedConditions  []cmapi.CertificateRequestCondition
 	expectedFailureTime *metav1.Time
+	expectedDeniedBy    string
+	expectedDeniedTime  *metav1.Time
 }
 
 func TestReporter(t *testing.T) {
@@ -108,6 +111,23 @@ func TestReporter(t *testing.T) {
 		LastTransitionTime: &nowMetaTime,
 	}
 
+	deniedTime := metav1.NewTime(fixedClockStart.Add(-time.Minute))
+	deniedCondition := cmapi.CertificateRequestCondition{
+		Type:               cmapi.CertificateRequestConditionDenied,
+		Reason:             "policy.example.com",
+		Message:            "Requesting identity is not allowed",
+		Status:             "True",
+		LastTransitionTime: &deniedTime,
+	}
+
+	deniedByApproverReadyCondition := cmapi.CertificateRequestCondition{
+		Type:               cmapi.CertificateRequestConditionReady,
+		Reason:             "Denied",
+		Message:            `The CertificateRequest was denied by an approval controller with reason "policy.example.com": Requesting identity is not allowed`,
+		Status:             "False",
+		LastTransitionTime: &nowMetaTime,
+	}
+
 	tests := map[string]reporterT{
 		"a failed report should update the conditions and set FailureTime as it is nil": {
 			certificateRequest: gen.CertificateRequestFrom(baseCR),
@@ -218,6 +238,35 @@ func TestReporter(t *testing.T) {
 			expectedEvents:      []string{},
 			expectedConditions:  []cmapi.CertificateRequestCondition{deniedReadyCondition},
 			expectedFailureTime: &nowMetaTime,
+			expectedDeniedTime:  &nowMetaTime,
+
+			call: "denied",
+		},
+
+		"a denied report should record the approver and the time of denial": {
+			certificateRequest: gen.CertificateRequestFrom(baseCR,
+				gen.SetCertificateRequestStatusCondition(deniedCondition),
+			),
+			expectedEvents:      []string{},
+			expectedConditions:  []cmapi.CertificateRequestCondition{deniedCondition, deniedByApproverReadyCondition},
+			expectedFailureTime: &deniedTime,
+			expectedDeniedBy:    "policy.example.com",
+			expectedDeniedTime:  &deniedTime,
+
+			call: "denied",
+		},
+
+		"a denied report should replace a Failed Ready condition": {
+			certificateRequest: gen.CertificateRequestFrom(baseCR,
+				gen.SetCertificateRequestStatusCondition(deniedCondition),
+				gen.SetCertificateRequestStatusCondition(failedCondition),
+				gen.SetCertificateRequestFailureTime(nowMetaTime),
+			),
+			expectedEvents:      []string{},
+			expectedConditions:  []cmapi.CertificateRequestCondition{deniedCondition, deniedByApproverReadyCondition},
+			expectedFailureTime: &nowMetaTime,
+			expectedDeniedBy:    "policy.example.com",
+			expectedDeniedTime:  &deniedTime,
 
 			call: "denied",
 		},
@@ -230,6 +279,7 @@ func TestReporter(t *testing.T) {
 			expectedEvents:      []string{},
 			expectedConditions:  []cmapi.CertificateRequestCondition{deniedReadyCondition},
 			expectedFailureTime: &oldMetaTime,
+			expectedDeniedTime:  &nowMetaTime,
 
 			call: "denied",
 		},
@@ -289,6 +339,116 @@ func (tt *reporterT) runTest(t *testing.T) {
 				tt.certificateRequest.Status.FailureTime.String())
 		}
 	}
+
+	if tt.certificateRequest.Status.DeniedBy != tt.expectedDeniedBy {
+		t.Errorf("got unexpected denied by, exp=%q got=%q",
+			tt.expectedDeniedBy, tt.certificateRequest.Status.DeniedBy)
+	}
+
+	if tt.expectedDeniedTime == nil {
+		if tt.certificateRequest.Status.DeniedTime != nil {
+			t.Errorf("got unexpected denied time, exp=nil got=%+v",
+				tt.certificateRequest.Status.DeniedTime)
+		}
+	} else if tt.certificateRequest.Status.DeniedTime.String() != tt.expectedDeniedTime.String() {
+		t.Errorf("got unexpected denied time, exp=%+v got=%+v",
+			tt.expectedDeniedTime.String(),
+			tt.certificateRequest.Status.DeniedTime.String())
+	}
+}
+
+func TestReporterIssuanceFailures(t *testing.T) {
+	fixedClock.SetTime(fixedClockStart)
+	apiutil.Clock = fixedClock
+
+	recorder := new(controllertest.FakeRecorder)
+	reporter := NewReporter(fixedClock, recorder)
+	cr := gen.CertificateRequest("test")
+
+	// A Pending report without an error is not an issuance failure.
+	reporter.Pending(cr, nil, "IssuancePending", "waiting for the issuer")
+	if len(cr.Status.IssuanceFailures) != 0 {
+		t.Fatalf("expected no issuance failures, got %+v", cr.Status.IssuanceFailures)
+	}
+
+	expectFailures := func(want []cmapi.CertificateRequestIssuanceFailure) {
+		t.Helper()
+		if !slices.Equal(cr.Status.IssuanceFailures, want) {
+			t.Errorf("got unexpected issuance failures, exp=%+v got=%+v", want, cr.Status.IssuanceFailures)
+		}
+	}
+
+	var want []cmapi.CertificateRequestIssuanceFailure
+	for i := range MaxIssuanceFailures + 2 {
+		fixedClock.SetTime(fixedClockStart.Add(time.Duration(i) * time.Minute))
+		err := fmt.Errorf("error %d", i)
+		if i%2 == 0 {
+			reporter.Pending(cr, err, "Transient", "transient failure")
+			want = append(want, cmapi.CertificateRequestIssuanceFailure{
+				Time:    metav1.NewTime(fixedClock.Now()),
+				Reason:  "Transient",
+				Message: fmt.Sprintf("transient failure: %v", err),
+			})
+		} else {
+			reporter.Failed(cr, err, "Terminal", "terminal failure")
+			want = append(want, cmapi.CertificateRequestIssuanceFailure{
+				Time:    metav1.NewTime(fixedClock.Now()),
+				Reason:  "Terminal",
+				Message: fmt.Sprintf("terminal failure: %v", err),
+			})
+		}
+
+		// Failures accumulate up to the bound, after which the oldest are
+		// discarded.
+		if len(want) > MaxIssuanceFailures {
+			want = want[len(want)-MaxIssuanceFailures:]
+		}
+		expectFailures(want)
+	}
+
+	if got := cr.Status.IssuanceFailures[0].Message; got != "transient failure: error 2" {
+		t.Errorf("expected the oldest recorded failure to be the third one, got %q", got)
+	}
+
+	// Failures are kept once the certificate has been issued.
+	reporter.Ready(cr)
+	expectFailures(want)
+}
+
+func TestReporterRepeatedPendingDoesNotChangeStatus(t *testing.T) {
+	fixedClock.SetTime(fixedClockStart)
+	apiutil.Clock = fixedClock
+
+	recorder := new(controllertest.FakeRecorder)
+	reporter := NewReporter(fixedClock, recorder)
+	cr := gen.CertificateRequest("test")
+
+	err := errors.New(`secret "ca" not found`)
+	reporter.Pending(cr, err, "SecretMissing", "Required secret resource not found")
+	firstStatus := cr.Status.DeepCopy()
+
+	// Each Sync of a CertificateRequest that is still failing for the same
+	// reason must leave the status unchanged, as writing it would trigger
+	// another Sync.
+	for i := 1; i <= 3; i++ {
+		fixedClock.SetTime(fixedClockStart.Add(time.Duration(i) * time.Minute))
+		reporter.Pending(cr, err, "SecretMissing", "Required secret resource not found")
+		if !apiequality.Semantic.DeepEqual(firstStatus, &cr.Status) {
+			t.Fatalf("expected status to be unchanged on sync %d, exp=%+v got=%+v", i, firstStatus, cr.Status)
+		}
+	}
+	if len(recorder.Events) != 1 {
+		t.Errorf("expected a single event, got %q", recorder.Events)
+	}
+
+	// A new message for the same reason updates the latest failure in place.
+	reporter.Pending(cr, errors.New(`secret "ca" is empty`), "SecretMissing", "Required secret resource not found")
+	if len(cr.Status.IssuanceFailures) != 1 {
+		t.Fatalf("expected a single issuance failure, got %+v", cr.Status.IssuanceFailures)
+	}
+	if got := cr.Status.IssuanceFailures[0]; got.Message != `Required secret resource not found: secret "ca" is empty` || !got.Time.Equal(&firstStatus.IssuanceFailures[0].Time) {
+		t.Errorf("expected the latest failure to be updated in place, got %+v", got)
+	}
 }
 
 func conditionsToString(conds []cmapi.CertificateRequestCondition) string {
@@ -18,6 +18,9 @@ package vault
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
 
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 
@@ -46,6 +49,9 @@ type Vault struct {
 	secretsLister internalinformers.SecretLister
 	reporter      *crutil.Reporter
 
+	// used to have the issuer set up again when Vault rejects its credentials
+	issuerSetupRequests *controllerpkg.IssuerSetupRequests
+
 	vaultClientBuilder vaultinternal.ClientBuilder
 }
 
@@ -65,9 +71,10 @@ func NewVault(ctx *controllerpkg.Context) certificaterequests.Issuer {
 		createTokenFn: func(ns string) vaultinternal.CreateToken {
 			return ctx.Client.CoreV1().ServiceAccounts(ns).CreateToken
 		},
-		secretsLister:      ctx.KubeSharedInformerFactory.Secrets().Lister(),
-		reporter:           crutil.NewReporter(ctx.Clock, ctx.Recorder),
-		vaultClientBuilder: vaultinternal.New,
+		secretsLister:       ctx.KubeSharedInformerFactory.Secrets().Lister(),
+		reporter:            crutil.NewReporter(ctx.Clock, ctx.Recorder),
+		issuerSetupRequests: ctx.IssuerSetupRequests,
+		vaultClientBuilder:  vaultinternal.New,
 	}
 }
 
@@ -77,9 +84,27 @@ func (v *Vault) Sign(ctx context.Context, cr *v1.CertificateRequest, issuerObj v
 	log := logf.FromContext(ctx, "sign")
 	log = logf.WithRelatedResource(log, issuerObj)
 
+	// clientIssuer is the issuer used to build the Vault client, which may
+	// select a different Vault namespace than issuerObj.
+	clientIssuer := issuerObj
+	if namespace, exists := cr.GetAnnotations()[v1.VaultNamespaceAnnotationKey]; exists {
+		if !namespaceOverrideAllowed(issuerObj, namespace) {
+			err := fmt.Errorf("namespace %q is not listed in the issuer's allowedNamespaceOverrides", namespace)
+			message := fmt.Sprintf("Failed to apply %q annotation", v1.VaultNamespaceAnnotationKey)
+
+			v.reporter.Failed(cr, err, "NamespaceOverrideDenied", message)
+			log.Error(err, message)
+
+			return nil, nil
+		}
+
+		clientIssuer = issuerObj.DeepCopyObject().(v1.GenericIssuer)
+		clientIssuer.GetSpec().Vault.Namespace = namespace
+	}
+
 	resourceNamespace := v.issuerOptions.ResourceNamespace(issuerObj)
 
-	client, err := v.vaultClientBuilder(ctx, resourceNamespace, v.createTokenFn, v.secretsLister, issuerObj)
+	client, err := v.vaultClientBuilder(ctx, resourceNamespace, v.createTokenFn, v.secretsLister, clientIssuer)
 	if k8sErrors.IsNotFound(err) {
 		message := "Required secret resource not found"
 
@@ -88,6 +113,20 @@ func (v *Vault) Sign(ctx context.Context, cr *v1.CertificateRequest, issuerObj v
 		return nil, nil
 	}
 
+	if vaultinternal.IsPermissionDenied(err) {
+		message := "Failed to authenticate with Vault"
+
+		v.reporter.Pending(cr, err, "VaultInitError", message)
+		log.Error(err, message)
+
+		// The issuer's credentials, such as an AppRole secret-id, may have
+		// expired. Have the issuer set up again so that its Ready condition
+		// reflects the failure.
+		v.issuerSetupRequests.Request(issuerObj)
+
+		return nil, err // Return error to requeue and retry
+	}
+
 	if err != nil {
 		message := "Failed to initialise vault client for signing"
 		v.reporter.Pending(cr, err, "VaultInitError", message)
@@ -102,6 +141,20 @@ func (v *Vault) Sign(ctx context.Context, cr *v1.CertificateRequest, issuerObj v
 
 	certDuration := apiutil.DefaultCertDuration(cr.Spec.Duration)
 	certPem, caPem, err := client.Sign(cr.Spec.Request, certDuration)
+	if errors.Is(err, vaultinternal.ErrReauthenticationFailed) {
+		message := "Failed to re-authenticate with Vault"
+
+		v.reporter.Pending(cr, err, "VaultInitError", message)
+		log.Error(err, message)
+
+		// Have the issuer set up again, so that its Ready condition reflects
+		// the authentication failure and other requests wait for it to become
+		// Ready again rather than repeatedly failing to authenticate.
+		v.issuerSetupRequests.Request(issuerObj)
+
+		return nil, err // Return error to requeue and retry
+	}
+
 	if err != nil {
 		message := "Vault failed to sign certificate"
 
@@ -118,3 +171,9 @@ func (v *Vault) Sign(ctx context.Context, cr *v1.CertificateRequest, issuerObj v
 		CA:          caPem,
 	}, nil
 }
+
+// namespaceOverrideAllowed returns true if the given Vault namespace is present
+// in the allowedNamespaceOverrides list of the issuer.
+func namespaceOverrideAllowed(issuerObj v1.GenericIssuer, namespace string) bool {
+	return slices.Contains(issuerObj.GetSpec().Vault.AllowedNamespaceOverrides, namespace)
+}
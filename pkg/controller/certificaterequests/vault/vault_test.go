@@ -22,16 +22,21 @@ import (
 	"crypto"
 	"crypto/rand"
 	"crypto/x509"
+	"encoding/json"
 	"encoding/pem"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
+	vaultapi "github.com/hashicorp/vault/api"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	coretesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/util/workqueue"
 	fakeclock "k8s.io/utils/clock/testing"
 
 	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
@@ -159,6 +164,43 @@ func TestSign(t *testing.T) {
 		},
 	}
 
+	tokenIssuer := gen.IssuerFrom(baseIssuer,
+		gen.SetIssuerVault(cmapi.VaultIssuer{
+			Auth: cmapi.VaultAuth{
+				TokenSecretRef: &cmmeta.SecretKeySelector{
+					Key: "my-token-key",
+					LocalObjectReference: cmmeta.LocalObjectReference{
+						Name: "token-secret",
+					},
+				},
+			},
+		}),
+	)
+
+	namespaceOverrideIssuer := gen.IssuerFrom(baseIssuer,
+		gen.SetIssuerVault(cmapi.VaultIssuer{
+			Namespace:                 "default",
+			AllowedNamespaceOverrides: []string{"tenant-a"},
+			Auth: cmapi.VaultAuth{
+				TokenSecretRef: &cmmeta.SecretKeySelector{
+					Key: "my-token-key",
+					LocalObjectReference: cmmeta.LocalObjectReference{
+						Name: "token-secret",
+					},
+				},
+			},
+		}),
+	)
+
+	crWithAllowedNamespace := gen.CertificateRequestFrom(baseCR, gen.SetCertificateRequestAnnotations(map[string]string{cmapi.VaultNamespaceAnnotationKey: "tenant-a"}))
+
+	crWithDeniedNamespace := gen.CertificateRequestFrom(baseCR, gen.SetCertificateRequestAnnotations(map[string]string{cmapi.VaultNamespaceAnnotationKey: "tenant-b"}))
+
+	loginErr := fmt.Errorf("error logging in to Vault server: %w", &vaultapi.ResponseError{
+		StatusCode: http.StatusForbidden,
+		Errors:     []string{"permission denied"},
+	})
+
 	tests := map[string]testT{
 		"a CertificateRequest without an approved condition should do nothing": {
 			certificateRequest: baseCRNotApproved.DeepCopy(),
@@ -186,10 +228,11 @@ func TestSign(t *testing.T) {
 								Type:               cmapi.CertificateRequestConditionReady,
 								Status:             cmmeta.ConditionFalse,
 								Reason:             "Denied",
-								Message:            "The CertificateRequest was denied by an approval controller",
+								Message:            `The CertificateRequest was denied by an approval controller with reason "Foo": Certificate request has been denied by cert-manager.io`,
 								LastTransitionTime: &metaFixedClockStart,
 							}),
 							gen.SetCertificateRequestFailureTime(metaFixedClockStart),
+							gen.SetCertificateRequestDenied("Foo", metaFixedClockStart),
 						),
 					)),
 				},
@@ -216,6 +259,11 @@ func TestSign(t *testing.T) {
 								Message:            "Failed to initialise vault client for signing: error initializing Vault client: tokenSecretRef, appRoleSecretRef, clientCertificate, or Kubernetes auth role not set",
 								LastTransitionTime: &metaFixedClockStart,
 							}),
+							gen.AddCertificateRequestIssuanceFailure(cmapi.CertificateRequestIssuanceFailure{
+								Time:    metaFixedClockStart,
+								Reason:  "VaultInitError",
+								Message: "Failed to initialise vault client for signing: error initializing Vault client: tokenSecretRef, appRoleSecretRef, clientCertificate, or Kubernetes auth role not set",
+							}),
 						),
 					)),
 				},
@@ -254,6 +302,11 @@ func TestSign(t *testing.T) {
 								Message:            `Required secret resource not found: secret "non-existing-secret" not found`,
 								LastTransitionTime: &metaFixedClockStart,
 							}),
+							gen.AddCertificateRequestIssuanceFailure(cmapi.CertificateRequestIssuanceFailure{
+								Time:    metaFixedClockStart,
+								Reason:  "SecretMissing",
+								Message: `Required secret resource not found: secret "non-existing-secret" not found`,
+							}),
 						),
 					)),
 				},
@@ -295,6 +348,11 @@ func TestSign(t *testing.T) {
 								Message:            `Required secret resource not found: secret "non-existing-secret" not found`,
 								LastTransitionTime: &metaFixedClockStart,
 							}),
+							gen.AddCertificateRequestIssuanceFailure(cmapi.CertificateRequestIssuanceFailure{
+								Time:    metaFixedClockStart,
+								Reason:  "SecretMissing",
+								Message: `Required secret resource not found: secret "non-existing-secret" not found`,
+							}),
 						),
 					)),
 				},
@@ -361,6 +419,11 @@ func TestSign(t *testing.T) {
 								Message:            "Failed to initialise vault client for signing: failed to create vault client, temporary auth failure",
 								LastTransitionTime: &metaFixedClockStart,
 							}),
+							gen.AddCertificateRequestIssuanceFailure(cmapi.CertificateRequestIssuanceFailure{
+								Time:    metaFixedClockStart,
+								Reason:  "VaultInitError",
+								Message: "Failed to initialise vault client for signing: failed to create vault client, temporary auth failure",
+							}),
 						),
 					)),
 				},
@@ -402,6 +465,11 @@ func TestSign(t *testing.T) {
 								Message:            "Vault failed to sign certificate: failed to sign",
 								LastTransitionTime: &metaFixedClockStart,
 							}),
+							gen.AddCertificateRequestIssuanceFailure(cmapi.CertificateRequestIssuanceFailure{
+								Time:    metaFixedClockStart,
+								Reason:  "SigningError",
+								Message: "Vault failed to sign certificate: failed to sign",
+							}),
 							gen.SetCertificateRequestFailureTime(metaFixedClockStart),
 						),
 					)),
@@ -409,6 +477,76 @@ func TestSign(t *testing.T) {
 			},
 			fakeVault: fakevault.New().WithSign(nil, nil, errors.New("failed to sign")),
 		},
+		"a client whose token was rejected and failed to re-authenticate should report pending and return error": {
+			certificateRequest: baseCR.DeepCopy(),
+			builder: &testpkg.Builder{
+				KubeObjects:        []runtime.Object{tokenSecret},
+				CertManagerObjects: []runtime.Object{baseCR.DeepCopy(), tokenIssuer},
+				ExpectedEvents: []string{
+					"Normal VaultInitError Failed to re-authenticate with Vault: failed to re-authenticate with Vault: login failed",
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonPending,
+								Message:            "Failed to re-authenticate with Vault: failed to re-authenticate with Vault: login failed",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.AddCertificateRequestIssuanceFailure(cmapi.CertificateRequestIssuanceFailure{
+								Time:    metaFixedClockStart,
+								Reason:  "VaultInitError",
+								Message: "Failed to re-authenticate with Vault: failed to re-authenticate with Vault: login failed",
+							}),
+						),
+					)),
+				},
+			},
+			fakeVault:                  fakevault.New().WithSign(nil, nil, fmt.Errorf("%w: login failed", internalvault.ErrReauthenticationFailed)),
+			expectedErr:                true,
+			expectedIssuerSetupRequest: true,
+		},
+		"a client whose credentials were rejected when logging in should report pending and return error": {
+			certificateRequest: baseCR.DeepCopy(),
+			builder: &testpkg.Builder{
+				KubeObjects:        []runtime.Object{tokenSecret},
+				CertManagerObjects: []runtime.Object{baseCR.DeepCopy(), tokenIssuer},
+				ExpectedEvents: []string{
+					"Normal VaultInitError Failed to authenticate with Vault: " + loginErr.Error(),
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonPending,
+								Message:            "Failed to authenticate with Vault: " + loginErr.Error(),
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.AddCertificateRequestIssuanceFailure(cmapi.CertificateRequestIssuanceFailure{
+								Time:    metaFixedClockStart,
+								Reason:  "VaultInitError",
+								Message: "Failed to authenticate with Vault: " + loginErr.Error(),
+							}),
+						),
+					)),
+				},
+			},
+			fakeVault: fakevault.New().WithNew(func(string, internalinformers.SecretLister, cmapi.GenericIssuer) (*fakevault.Vault, error) {
+				return nil, loginErr
+			}),
+			expectedErr:                true,
+			expectedIssuerSetupRequest: true,
+		},
 		"a client with a app role secret referenced with role but failed to sign should report fail": {
 			certificateRequest: baseCR.DeepCopy(),
 			builder: &testpkg.Builder{
@@ -444,6 +582,11 @@ func TestSign(t *testing.T) {
 								Message:            "Vault failed to sign certificate: failed to sign",
 								LastTransitionTime: &metaFixedClockStart,
 							}),
+							gen.AddCertificateRequestIssuanceFailure(cmapi.CertificateRequestIssuanceFailure{
+								Time:    metaFixedClockStart,
+								Reason:  "SigningError",
+								Message: "Vault failed to sign certificate: failed to sign",
+							}),
 							gen.SetCertificateRequestFailureTime(metaFixedClockStart),
 						),
 					)),
@@ -491,6 +634,72 @@ func TestSign(t *testing.T) {
 			},
 			fakeVault: fakevault.New().WithSign(rsaPEMCert, rsaPEMCert, nil),
 		},
+		"annotations: Vault namespace listed in allowedNamespaceOverrides is passed to the client": {
+			certificateRequest: crWithAllowedNamespace.DeepCopy(),
+			builder: &testpkg.Builder{
+				KubeObjects:        []runtime.Object{tokenSecret},
+				CertManagerObjects: []runtime.Object{crWithAllowedNamespace.DeepCopy(), namespaceOverrideIssuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Normal CertificateIssued Certificate fetched from issuer successfully",
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(crWithAllowedNamespace,
+							gen.SetCertificateRequestCertificate(rsaPEMCert),
+							gen.SetCertificateRequestCA(rsaPEMCert),
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionTrue,
+								Reason:             cmapi.CertificateRequestReasonIssued,
+								Message:            "Certificate fetched from issuer successfully",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+						),
+					)),
+				},
+			},
+			fakeVault:         fakevault.New().WithSign(rsaPEMCert, rsaPEMCert, nil),
+			expectedNamespace: "tenant-a",
+		},
+		"annotations: Vault namespace not listed in allowedNamespaceOverrides should hard fail": {
+			certificateRequest: crWithDeniedNamespace.DeepCopy(),
+			builder: &testpkg.Builder{
+				KubeObjects:        []runtime.Object{tokenSecret},
+				CertManagerObjects: []runtime.Object{crWithDeniedNamespace.DeepCopy(), namespaceOverrideIssuer.DeepCopy()},
+				ExpectedEvents: []string{
+					`Warning NamespaceOverrideDenied Failed to apply "vault.cert-manager.io/namespace" annotation: namespace "tenant-b" is not listed in the issuer's allowedNamespaceOverrides`,
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(crWithDeniedNamespace,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonFailed,
+								Message:            `Failed to apply "vault.cert-manager.io/namespace" annotation: namespace "tenant-b" is not listed in the issuer's allowedNamespaceOverrides`,
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.AddCertificateRequestIssuanceFailure(cmapi.CertificateRequestIssuanceFailure{
+								Time:    metaFixedClockStart,
+								Reason:  "NamespaceOverrideDenied",
+								Message: `Failed to apply "vault.cert-manager.io/namespace" annotation: namespace "tenant-b" is not listed in the issuer's allowedNamespaceOverrides`,
+							}),
+							gen.SetCertificateRequestFailureTime(metaFixedClockStart),
+						),
+					)),
+				},
+			},
+			fakeVault: fakevault.New().WithNew(func(string, internalinformers.SecretLister, cmapi.GenericIssuer) (*fakevault.Vault, error) {
+				t.Error("the Vault client should not be built for a denied namespace")
+				return nil, errors.New("unexpected call")
+			}),
+		},
 		"a client with a app role secret referenced with role should return certificate": {
 			certificateRequest: baseCR,
 			builder: &testpkg.Builder{
@@ -545,6 +754,87 @@ func TestSign(t *testing.T) {
 	}
 }
 
+// TestSignVaultNamespaceHeader demonstrates that the Vault namespace selected
+// by a CertificateRequest is sent to Vault in the X-Vault-Namespace header.
+func TestSignVaultNamespaceHeader(t *testing.T) {
+	const vaultPath = "my_pki_mount/sign/my-role-name"
+
+	rsaSK, err := pki.GenerateRSAPrivateKey(2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cr := gen.CertificateRequest("test-cr",
+		gen.SetCertificateRequestCSR(generateCSR(t, rsaSK)),
+		gen.SetCertificateRequestAnnotations(map[string]string{cmapi.VaultNamespaceAnnotationKey: "tenant-a"}),
+	)
+	certPEM, err := generateSelfSignedCertFromCR(cr, rsaSK)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var namespaces []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/"+vaultPath, func(w http.ResponseWriter, r *http.Request) {
+		namespaces = append(namespaces, r.Header.Get("X-Vault-Namespace"))
+		if err := json.NewEncoder(w).Encode(map[string]any{
+			"data": map[string]string{"certificate": string(certPEM)},
+		}); err != nil {
+			t.Error(err)
+		}
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	iss := gen.Issuer("vault-issuer",
+		gen.SetIssuerVault(cmapi.VaultIssuer{
+			Server:                    server.URL,
+			Path:                      vaultPath,
+			Namespace:                 "default",
+			AllowedNamespaceOverrides: []string{"tenant-a"},
+			Auth: cmapi.VaultAuth{
+				TokenSecretRef: &cmmeta.SecretKeySelector{
+					Key: "my-token-key",
+					LocalObjectReference: cmmeta.LocalObjectReference{
+						Name: "token-secret",
+					},
+				},
+			},
+		}),
+	)
+
+	builder := &testpkg.Builder{
+		T: t,
+		KubeObjects: []runtime.Object{&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: gen.DefaultTestNamespace,
+				Name:      "token-secret",
+			},
+			Data: map[string][]byte{
+				"my-token-key": []byte("my-secret-token"),
+			},
+		}},
+		CertManagerObjects: []runtime.Object{cr, iss},
+		ExpectedEvents:     []string{},
+	}
+	builder.Init()
+	defer builder.Stop()
+
+	vault := NewVault(builder.Context)
+	builder.Start()
+
+	resp, err := vault.Sign(context.Background(), cr, iss)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp == nil || !bytes.Equal(resp.Certificate, certPEM) {
+		t.Errorf("expected the certificate returned by Vault, got: %v", resp)
+	}
+	if len(namespaces) != 1 || namespaces[0] != "tenant-a" {
+		t.Errorf("expected a single signing request with X-Vault-Namespace %q, got: %q", "tenant-a", namespaces)
+	}
+}
+
 type testT struct {
 	builder            *testpkg.Builder
 	certificateRequest *cmapi.CertificateRequest
@@ -552,6 +842,14 @@ type testT struct {
 	expectedErr bool
 
 	fakeVault *fakevault.Vault
+
+	// expectedNamespace, if set, is the Vault namespace the client is
+	// expected to be built with.
+	expectedNamespace string
+
+	// expectedIssuerSetupRequest is true if the issuer is expected to be
+	// queued to be set up again.
+	expectedIssuerSetupRequest bool
 }
 
 func runTest(t *testing.T, test testT) {
@@ -559,11 +857,19 @@ func runTest(t *testing.T, test testT) {
 	test.builder.Init()
 	defer test.builder.Stop()
 
+	issuerSetupQueue := workqueue.NewRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(0, 0))
+	defer issuerSetupQueue.ShutDown()
+	test.builder.Context.IssuerSetupRequests = controllerpkg.NewIssuerSetupRequests()
+	test.builder.Context.IssuerSetupRequests.Register(cmapi.IssuerKind, issuerSetupQueue)
+
 	vault := NewVault(test.builder.Context).(*Vault)
 
 	if test.fakeVault != nil {
 		vault.vaultClientBuilder = func(_ context.Context, ns string, _ func(ns string) internalvault.CreateToken, sl internalinformers.SecretLister,
 			iss cmapi.GenericIssuer) (internalvault.Interface, error) {
+			if test.expectedNamespace != "" && iss.GetSpec().Vault.Namespace != test.expectedNamespace {
+				t.Errorf("expected client to be built with Vault namespace %q, got %q", test.expectedNamespace, iss.GetSpec().Vault.Namespace)
+			}
 			return test.fakeVault.New(ns, sl, iss)
 		}
 	}
@@ -587,5 +893,9 @@ func runTest(t *testing.T, test testT) {
 		t.Errorf("expected to get an error but did not get one")
 	}
 
+	if requested := issuerSetupQueue.Len() > 0; requested != test.expectedIssuerSetupRequest {
+		t.Errorf("expected issuer setup request %t, got %t", test.expectedIssuerSetupRequest, requested)
+	}
+
 	test.builder.CheckAndFinish(err)
 }
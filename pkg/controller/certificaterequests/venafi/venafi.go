@@ -20,10 +20,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 
 	"github.com/Venafi/vcert/v5/pkg/endpoint"
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
 	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
@@ -83,6 +83,21 @@ func (v *Venafi) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuerO
 	log := logf.FromContext(ctx, "sign")
 	log = logf.WithRelatedResource(log, issuerObj)
 
+	if zone, exists := cr.GetAnnotations()[cmapi.VenafiZoneAnnotationKey]; exists {
+		if !zoneOverrideAllowed(issuerObj, zone) {
+			err := fmt.Errorf("zone %q is not listed in the issuer's allowedZoneOverrides", zone)
+			message := fmt.Sprintf("Failed to apply %q annotation", cmapi.VenafiZoneAnnotationKey)
+
+			v.reporter.Failed(cr, err, "ZoneOverrideDenied", message)
+			log.Error(err, message)
+
+			return nil, nil
+		}
+
+		issuerObj = issuerObj.DeepCopyObject().(cmapi.GenericIssuer)
+		issuerObj.GetSpec().Venafi.Zone = zone
+	}
+
 	client, err := v.clientBuilder(v.issuerOptions.ResourceNamespace(issuerObj), v.secretsLister, issuerObj, v.metrics, log, v.userAgent)
 	if k8sErrors.IsNotFound(err) {
 		message := "Required secret resource not found"
@@ -115,16 +130,22 @@ func (v *Venafi) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuerO
 		}
 	}
 
-	pickupID := cr.ObjectMeta.Annotations[cmapi.VenafiPickupIDAnnotationKey]
+	// Resume an existing request if one has already been submitted, so that
+	// re-reconciling the CertificateRequest does not create duplicate
+	// requests. The annotation is read for requests submitted by earlier
+	// versions of cert-manager.
+	pickupID := cr.Status.PickupID
+	if pickupID == "" {
+		pickupID = cr.ObjectMeta.Annotations[cmapi.VenafiPickupIDAnnotationKey]
+	}
 
-	// check if the pickup ID annotation is there, if not set it up.
 	if pickupID == "" {
 		pickupID, err = client.RequestCertificate(cr.Spec.Request, customFields)
 		// Check some known error types
 		if err != nil {
 			switch err.(type) {
 
-			case venaficlient.ErrCustomFieldsType:
+			case venaficlient.ErrCustomFieldsType, venaficlient.ErrCustomFieldsRequired:
 				v.reporter.Failed(cr, err, "CustomFieldsError", err.Error())
 				log.Error(err, err.Error())
 
@@ -140,9 +161,9 @@ func (v *Venafi) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuerO
 			}
 		}
 
-		v.reporter.Pending(cr, err, "IssuancePending", "Venafi certificate is requested")
+		v.reporter.Pending(cr, nil, "IssuancePending", "Venafi certificate is requested")
 
-		metav1.SetMetaDataAnnotation(&cr.ObjectMeta, cmapi.VenafiPickupIDAnnotationKey, pickupID)
+		cr.Status.PickupID = pickupID
 
 		return nil, nil
 	}
@@ -150,10 +171,24 @@ func (v *Venafi) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuerO
 	certPem, err := client.RetrieveCertificate(pickupID, cr.Spec.Request, customFields)
 	if err != nil {
 		switch err.(type) {
-		case endpoint.ErrCertificatePending, endpoint.ErrRetrieveCertificateTimeout:
+		case endpoint.ErrCertificatePending:
 			message := "Venafi certificate still in a pending state, the request will be retried"
 
-			v.reporter.Pending(cr, err, "IssuancePending", message)
+			// Waiting for the certificate to be issued is not a failure, so
+			// it is not recorded in the IssuanceFailures.
+			v.reporter.Pending(cr, nil, "IssuancePending", fmt.Sprintf("%s: %v", message, err))
+			log.Error(err, message)
+			return nil, err
+
+		case endpoint.ErrRetrieveCertificateTimeout:
+			// The request may be awaiting manual approval in Venafi, which can
+			// take longer than the pickup timeout. Keep the request pending and
+			// retry, rather than failing it.
+			message := "Venafi certificate was not issued within the pickup timeout, the request will be retried"
+
+			// Waiting for the certificate to be issued is not a failure, so
+			// it is not recorded in the IssuanceFailures.
+			v.reporter.Pending(cr, nil, "IssuancePending", fmt.Sprintf("%s: %v", message, err))
 			log.Error(err, message)
 			return nil, err
 
@@ -182,3 +217,13 @@ func (v *Venafi) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuerO
 		CA:          bundle.CAPEM,
 	}, nil
 }
+
+// zoneOverrideAllowed returns true if the given zone is present in the TPP
+// allowedZoneOverrides list of the issuer.
+func zoneOverrideAllowed(issuerObj cmapi.GenericIssuer, zone string) bool {
+	tpp := issuerObj.GetSpec().Venafi.TPP
+	if tpp == nil {
+		return false
+	}
+	return slices.Contains(tpp.AllowedZoneOverrides, zone)
+}
@@ -23,6 +23,7 @@ import (
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"errors"
+	"fmt"
 	"math/big"
 	"testing"
 	"time"
@@ -145,8 +146,21 @@ func TestSign(t *testing.T) {
 		}),
 	)
 
+	tppZoneOverrideIssuer := gen.IssuerFrom(baseIssuer,
+		gen.SetIssuerVenafi(cmapi.VenafiIssuer{
+			Zone: "default",
+			TPP: &cmapi.VenafiTPP{
+				CredentialsRef: cmmeta.LocalObjectReference{
+					Name: tppSecret.Name,
+				},
+				AllowedZoneOverrides: []string{"team-a"},
+			},
+		}),
+	)
+
 	cloudIssuer := gen.IssuerFrom(baseIssuer,
 		gen.SetIssuerVenafi(cmapi.VenafiIssuer{
+			Zone: `test-application\test-template`,
 			Cloud: &cmapi.VenafiCloud{
 				APITokenSecretRef: cmmeta.SecretKeySelector{
 					LocalObjectReference: cmmeta.LocalObjectReference{
@@ -187,12 +201,19 @@ func TestSign(t *testing.T) {
 		}),
 	)
 
+	tppCRWithPickupID := gen.CertificateRequestFrom(tppCR, gen.SetCertificateRequestPickupID("test"))
+	tppCRWithPickupIDAnnotation := gen.CertificateRequestFrom(tppCR, gen.SetCertificateRequestAnnotations(map[string]string{cmapi.VenafiPickupIDAnnotationKey: "test"}))
+
 	tppCRWithCustomFields := gen.CertificateRequestFrom(tppCR, gen.SetCertificateRequestAnnotations(map[string]string{"venafi.cert-manager.io/custom-fields": `[{"name": "cert-manager-test", "value": "test ok"}]`}))
 
 	tppCRWithInvalidCustomFields := gen.CertificateRequestFrom(tppCR, gen.SetCertificateRequestAnnotations(map[string]string{"venafi.cert-manager.io/custom-fields": `[{"name": cert-manager-test}]`}))
 
 	tppCRWithInvalidCustomFieldType := gen.CertificateRequestFrom(tppCR, gen.SetCertificateRequestAnnotations(map[string]string{"venafi.cert-manager.io/custom-fields": `[{"name": "cert-manager-test", "value": "test ok", "type": "Bool"}]`}))
 
+	tppCRWithAllowedZone := gen.CertificateRequestFrom(tppCR, gen.SetCertificateRequestAnnotations(map[string]string{cmapi.VenafiZoneAnnotationKey: "team-a"}))
+
+	tppCRWithDeniedZone := gen.CertificateRequestFrom(tppCR, gen.SetCertificateRequestAnnotations(map[string]string{cmapi.VenafiZoneAnnotationKey: "team-b"}))
+
 	cloudCR := gen.CertificateRequestFrom(baseCR,
 		gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{
 			Group: certmanager.GroupName,
@@ -201,6 +222,8 @@ func TestSign(t *testing.T) {
 		}),
 	)
 
+	cloudCRWithZone := gen.CertificateRequestFrom(cloudCR, gen.SetCertificateRequestAnnotations(map[string]string{cmapi.VenafiZoneAnnotationKey: "team-a"}))
+
 	failGetSecretLister := &testlisters.FakeSecretLister{
 		SecretsFn: func(namespace string) corelisters.SecretNamespaceLister {
 			return &testlisters.FakeSecretNamespaceLister{
@@ -246,6 +269,37 @@ func TestSign(t *testing.T) {
 		},
 	}
 
+	clientReturnsCertWithoutRequest := &internalvenafifake.Venafi{
+		RequestCertificateFn: func(csrPEM []byte, customFields []api.CustomField) (string, error) {
+			return "", errors.New("a new certificate should not be requested")
+		},
+		RetrieveCertificateFn: func(pickupID string, _ []byte, _ []api.CustomField) ([]byte, error) {
+			if pickupID != "test" {
+				return nil, fmt.Errorf("unexpected pickup ID %q", pickupID)
+			}
+			return append(certPEM, rootPEM...), nil
+		},
+	}
+
+	// clientPendingApprovalThenReturnsCert returns a client which reports
+	// the certificate as pending with the given error the first time it is
+	// retrieved, and returns the certificate after that.
+	clientPendingApprovalThenReturnsCert := func(pendingErr error) *internalvenafifake.Venafi {
+		retrieved := false
+		return &internalvenafifake.Venafi{
+			RequestCertificateFn: func(csrPEM []byte, customFields []api.CustomField) (string, error) {
+				return "", errors.New("a new certificate should not be requested")
+			},
+			RetrieveCertificateFn: func(string, []byte, []api.CustomField) ([]byte, error) {
+				if !retrieved {
+					retrieved = true
+					return nil, pendingErr
+				}
+				return append(certPEM, rootPEM...), nil
+			},
+		}
+	}
+
 	clientReturnsCertIfCustomField := &internalvenafifake.Venafi{
 		RequestCertificateFn: func(csrPEM []byte, fields []api.CustomField) (string, error) {
 			if len(fields) > 0 && fields[0].Name == "cert-manager-test" && fields[0].Value == "test ok" {
@@ -264,6 +318,12 @@ func TestSign(t *testing.T) {
 		},
 	}
 
+	clientReturnsMissingRequiredCustomField := &internalvenafifake.Venafi{
+		RequestCertificateFn: func(csrPEM []byte, fields []api.CustomField) (string, error) {
+			return "", client.ErrCustomFieldsRequired{Names: []string{"App ID"}}
+		},
+	}
+
 	tests := map[string]testT{
 		"a CertificateRequest without an approved condition should do nothing": {
 			certificateRequest: baseCRNotApproved.DeepCopy(),
@@ -291,10 +351,11 @@ func TestSign(t *testing.T) {
 								Type:               cmapi.CertificateRequestConditionReady,
 								Status:             cmmeta.ConditionFalse,
 								Reason:             "Denied",
-								Message:            "The CertificateRequest was denied by an approval controller",
+								Message:            `The CertificateRequest was denied by an approval controller with reason "Foo": Certificate request has been denied by cert-manager.io`,
 								LastTransitionTime: &metaFixedClockStart,
 							}),
 							gen.SetCertificateRequestFailureTime(metaFixedClockStart),
+							gen.SetCertificateRequestDenied("Foo", metaFixedClockStart),
 						),
 					)),
 				},
@@ -320,6 +381,11 @@ func TestSign(t *testing.T) {
 								Message:            `Required secret resource not found: secret "test-tpp-secret" not found`,
 								LastTransitionTime: &metaFixedClockStart,
 							}),
+							gen.AddCertificateRequestIssuanceFailure(cmapi.CertificateRequestIssuanceFailure{
+								Time:    metaFixedClockStart,
+								Reason:  "SecretMissing",
+								Message: `Required secret resource not found: secret "test-tpp-secret" not found`,
+							}),
 						),
 					)),
 				},
@@ -345,6 +411,11 @@ func TestSign(t *testing.T) {
 								Message:            "Failed to initialise venafi client for signing: this is a network error",
 								LastTransitionTime: &metaFixedClockStart,
 							}),
+							gen.AddCertificateRequestIssuanceFailure(cmapi.CertificateRequestIssuanceFailure{
+								Time:    metaFixedClockStart,
+								Reason:  "VenafiInitError",
+								Message: "Failed to initialise venafi client for signing: this is a network error",
+							}),
 						),
 					)),
 				},
@@ -372,6 +443,11 @@ func TestSign(t *testing.T) {
 								Message:            `Required secret resource not found: secret "test-cloud-secret" not found`,
 								LastTransitionTime: &metaFixedClockStart,
 							}),
+							gen.AddCertificateRequestIssuanceFailure(cmapi.CertificateRequestIssuanceFailure{
+								Time:    metaFixedClockStart,
+								Reason:  "SecretMissing",
+								Message: `Required secret resource not found: secret "test-cloud-secret" not found`,
+							}),
 						),
 					)),
 				},
@@ -397,6 +473,11 @@ func TestSign(t *testing.T) {
 								Message:            "Failed to initialise venafi client for signing: this is a network error",
 								LastTransitionTime: &metaFixedClockStart,
 							}),
+							gen.AddCertificateRequestIssuanceFailure(cmapi.CertificateRequestIssuanceFailure{
+								Time:    metaFixedClockStart,
+								Reason:  "VenafiInitError",
+								Message: "Failed to initialise venafi client for signing: this is a network error",
+							}),
 						),
 					)),
 				},
@@ -445,7 +526,7 @@ func TestSign(t *testing.T) {
 				ExpectedActions: []controllertest.Action{
 					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
 						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
-						"",
+						"status",
 						gen.DefaultTestNamespace,
 						gen.CertificateRequestFrom(cloudCR,
 							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
@@ -455,7 +536,7 @@ func TestSign(t *testing.T) {
 								Message:            "Venafi certificate is requested",
 								LastTransitionTime: &metaFixedClockStart,
 							}),
-							gen.AddCertificateRequestAnnotations(map[string]string{cmapi.VenafiPickupIDAnnotationKey: "test"}),
+							gen.SetCertificateRequestPickupID("test"),
 						),
 					)),
 					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
@@ -470,7 +551,7 @@ func TestSign(t *testing.T) {
 								Message:            "Venafi certificate still in a pending state, the request will be retried: Issuance is pending. You may try retrieving the certificate later using Pickup ID: test-cert-id\n\tStatus: test-status-pending",
 								LastTransitionTime: &metaFixedClockStart,
 							}),
-							gen.AddCertificateRequestAnnotations(map[string]string{cmapi.VenafiPickupIDAnnotationKey: "test"}),
+							gen.SetCertificateRequestPickupID("test"),
 						),
 					)),
 				},
@@ -491,7 +572,7 @@ func TestSign(t *testing.T) {
 				ExpectedActions: []controllertest.Action{
 					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
 						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
-						"",
+						"status",
 						gen.DefaultTestNamespace,
 						gen.CertificateRequestFrom(cloudCR,
 							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
@@ -501,7 +582,7 @@ func TestSign(t *testing.T) {
 								Message:            "Venafi certificate is requested",
 								LastTransitionTime: &metaFixedClockStart,
 							}),
-							gen.AddCertificateRequestAnnotations(map[string]string{cmapi.VenafiPickupIDAnnotationKey: "test"}),
+							gen.SetCertificateRequestPickupID("test"),
 						),
 					)),
 					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
@@ -516,7 +597,7 @@ func TestSign(t *testing.T) {
 								Message:            "Venafi certificate still in a pending state, the request will be retried: Issuance is pending. You may try retrieving the certificate later using Pickup ID: test-cert-id\n\tStatus: test-status-pending",
 								LastTransitionTime: &metaFixedClockStart,
 							}),
-							gen.AddCertificateRequestAnnotations(map[string]string{cmapi.VenafiPickupIDAnnotationKey: "test"}),
+							gen.SetCertificateRequestPickupID("test"),
 						),
 					)),
 				},
@@ -546,6 +627,11 @@ func TestSign(t *testing.T) {
 								Message:            "Failed to request venafi certificate: this is an error",
 								LastTransitionTime: &metaFixedClockStart,
 							}),
+							gen.AddCertificateRequestIssuanceFailure(cmapi.CertificateRequestIssuanceFailure{
+								Time:    metaFixedClockStart,
+								Reason:  "RequestError",
+								Message: "Failed to request venafi certificate: this is an error",
+							}),
 							gen.SetCertificateRequestFailureTime(metaFixedClockStart),
 						),
 					)),
@@ -577,6 +663,11 @@ func TestSign(t *testing.T) {
 								Message:            "Failed to request venafi certificate: this is an error",
 								LastTransitionTime: &metaFixedClockStart,
 							}),
+							gen.AddCertificateRequestIssuanceFailure(cmapi.CertificateRequestIssuanceFailure{
+								Time:    metaFixedClockStart,
+								Reason:  "RequestError",
+								Message: "Failed to request venafi certificate: this is an error",
+							}),
 							gen.SetCertificateRequestFailureTime(metaFixedClockStart),
 						),
 					)),
@@ -599,7 +690,7 @@ func TestSign(t *testing.T) {
 				ExpectedActions: []controllertest.Action{
 					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
 						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
-						"",
+						"status",
 						gen.DefaultTestNamespace,
 						gen.CertificateRequestFrom(tppCR,
 							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
@@ -609,7 +700,7 @@ func TestSign(t *testing.T) {
 								Message:            "Venafi certificate is requested",
 								LastTransitionTime: &metaFixedClockStart,
 							}),
-							gen.AddCertificateRequestAnnotations(map[string]string{cmapi.VenafiPickupIDAnnotationKey: "test"}),
+							gen.SetCertificateRequestPickupID("test"),
 						),
 					)),
 					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
@@ -626,7 +717,7 @@ func TestSign(t *testing.T) {
 							}),
 							gen.SetCertificateRequestCertificate(certPEM),
 							gen.SetCertificateRequestCA(rootPEM),
-							gen.AddCertificateRequestAnnotations(map[string]string{cmapi.VenafiPickupIDAnnotationKey: "test"}),
+							gen.SetCertificateRequestPickupID("test"),
 						),
 					)),
 				},
@@ -634,6 +725,162 @@ func TestSign(t *testing.T) {
 			fakeSecretLister: failGetSecretLister,
 			fakeClient:       clientReturnsCert,
 		},
+		"tpp: if pickup ID is set in status then retrieve the existing request": {
+			certificateRequest: tppCRWithPickupID.DeepCopy(),
+			builder: &controllertest.Builder{
+				KubeObjects:        []runtime.Object{tppSecret},
+				CertManagerObjects: []runtime.Object{tppCRWithPickupID.DeepCopy(), tppIssuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Normal CertificateIssued Certificate fetched from issuer successfully",
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(tppCRWithPickupID,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionTrue,
+								Reason:             cmapi.CertificateRequestReasonIssued,
+								Message:            "Certificate fetched from issuer successfully",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.SetCertificateRequestCertificate(certPEM),
+							gen.SetCertificateRequestCA(rootPEM),
+						),
+					)),
+				},
+			},
+			fakeSecretLister:   failGetSecretLister,
+			fakeClient:         clientReturnsCertWithoutRequest,
+			skipSecondSignCall: true,
+		},
+		"tpp: if certificate is pending approval then keep pending and retrieve it when issued": {
+			certificateRequest: tppCRWithPickupID.DeepCopy(),
+			builder: &controllertest.Builder{
+				KubeObjects:        []runtime.Object{tppSecret},
+				CertManagerObjects: []runtime.Object{tppCRWithPickupID.DeepCopy(), tppIssuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Normal IssuancePending Venafi certificate still in a pending state, the request will be retried: Issuance is pending. You may try retrieving the certificate later using Pickup ID: test\n\tStatus: Pending approval",
+					"Normal CertificateIssued Certificate fetched from issuer successfully",
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(tppCRWithPickupID,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonPending,
+								Message:            "Venafi certificate still in a pending state, the request will be retried: Issuance is pending. You may try retrieving the certificate later using Pickup ID: test\n\tStatus: Pending approval",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+						),
+					)),
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(tppCRWithPickupID,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionTrue,
+								Reason:             cmapi.CertificateRequestReasonIssued,
+								Message:            "Certificate fetched from issuer successfully",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.SetCertificateRequestCertificate(certPEM),
+							gen.SetCertificateRequestCA(rootPEM),
+						),
+					)),
+				},
+			},
+			fakeSecretLister:   failGetSecretLister,
+			fakeClient:         clientPendingApprovalThenReturnsCert(endpoint.ErrCertificatePending{CertificateID: "test", Status: "Pending approval"}),
+			retryOnError:       true,
+			skipSecondSignCall: true,
+		},
+		"tpp: if pickup timeout is reached then keep pending and retrieve it when issued": {
+			certificateRequest: tppCRWithPickupID.DeepCopy(),
+			builder: &controllertest.Builder{
+				KubeObjects:        []runtime.Object{tppSecret},
+				CertManagerObjects: []runtime.Object{tppCRWithPickupID.DeepCopy(), tppIssuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Normal IssuancePending Venafi certificate was not issued within the pickup timeout, the request will be retried: Operation timed out. You may try retrieving the certificate later using Pickup ID: test",
+					"Normal CertificateIssued Certificate fetched from issuer successfully",
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(tppCRWithPickupID,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonPending,
+								Message:            "Venafi certificate was not issued within the pickup timeout, the request will be retried: Operation timed out. You may try retrieving the certificate later using Pickup ID: test",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+						),
+					)),
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(tppCRWithPickupID,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionTrue,
+								Reason:             cmapi.CertificateRequestReasonIssued,
+								Message:            "Certificate fetched from issuer successfully",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.SetCertificateRequestCertificate(certPEM),
+							gen.SetCertificateRequestCA(rootPEM),
+						),
+					)),
+				},
+			},
+			fakeSecretLister:   failGetSecretLister,
+			fakeClient:         clientPendingApprovalThenReturnsCert(endpoint.ErrRetrieveCertificateTimeout{CertificateID: "test"}),
+			retryOnError:       true,
+			skipSecondSignCall: true,
+		},
+		"tpp: if legacy pickup ID annotation is set then retrieve the existing request": {
+			certificateRequest: tppCRWithPickupIDAnnotation.DeepCopy(),
+			builder: &controllertest.Builder{
+				KubeObjects:        []runtime.Object{tppSecret},
+				CertManagerObjects: []runtime.Object{tppCRWithPickupIDAnnotation.DeepCopy(), tppIssuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Normal CertificateIssued Certificate fetched from issuer successfully",
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(tppCRWithPickupIDAnnotation,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionTrue,
+								Reason:             cmapi.CertificateRequestReasonIssued,
+								Message:            "Certificate fetched from issuer successfully",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.SetCertificateRequestCertificate(certPEM),
+							gen.SetCertificateRequestCA(rootPEM),
+						),
+					)),
+				},
+			},
+			fakeSecretLister:   failGetSecretLister,
+			fakeClient:         clientReturnsCertWithoutRequest,
+			skipSecondSignCall: true,
+		},
 		"cloud: if sign returns cert then return cert and not failed": {
 			certificateRequest: cloudCR.DeepCopy(),
 			builder: &controllertest.Builder{
@@ -646,7 +893,7 @@ func TestSign(t *testing.T) {
 				ExpectedActions: []controllertest.Action{
 					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
 						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
-						"",
+						"status",
 						gen.DefaultTestNamespace,
 						gen.CertificateRequestFrom(cloudCR,
 							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
@@ -656,7 +903,7 @@ func TestSign(t *testing.T) {
 								Message:            "Venafi certificate is requested",
 								LastTransitionTime: &metaFixedClockStart,
 							}),
-							gen.AddCertificateRequestAnnotations(map[string]string{cmapi.VenafiPickupIDAnnotationKey: "test"}),
+							gen.SetCertificateRequestPickupID("test"),
 						),
 					)),
 					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
@@ -673,7 +920,7 @@ func TestSign(t *testing.T) {
 							}),
 							gen.SetCertificateRequestCertificate(certPEM),
 							gen.SetCertificateRequestCA(rootPEM),
-							gen.AddCertificateRequestAnnotations(map[string]string{cmapi.VenafiPickupIDAnnotationKey: "test"}),
+							gen.SetCertificateRequestPickupID("test"),
 						),
 					)),
 				},
@@ -692,7 +939,7 @@ func TestSign(t *testing.T) {
 				ExpectedActions: []controllertest.Action{
 					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
 						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
-						"",
+						"status",
 						gen.DefaultTestNamespace,
 						gen.CertificateRequestFrom(tppCRWithCustomFields,
 							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
@@ -702,7 +949,7 @@ func TestSign(t *testing.T) {
 								Message:            "Venafi certificate is requested",
 								LastTransitionTime: &metaFixedClockStart,
 							}),
-							gen.AddCertificateRequestAnnotations(map[string]string{cmapi.VenafiPickupIDAnnotationKey: "test"}),
+							gen.SetCertificateRequestPickupID("test"),
 						),
 					)),
 					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
@@ -719,7 +966,7 @@ func TestSign(t *testing.T) {
 							}),
 							gen.SetCertificateRequestCertificate(certPEM),
 							gen.SetCertificateRequestCA(rootPEM),
-							gen.AddCertificateRequestAnnotations(map[string]string{cmapi.VenafiPickupIDAnnotationKey: "test"}),
+							gen.SetCertificateRequestPickupID("test"),
 						),
 					)),
 				},
@@ -748,6 +995,11 @@ func TestSign(t *testing.T) {
 								Message:            "Failed to parse \"venafi.cert-manager.io/custom-fields\" annotation: invalid character 'c' looking for beginning of value",
 								LastTransitionTime: &metaFixedClockStart,
 							}),
+							gen.AddCertificateRequestIssuanceFailure(cmapi.CertificateRequestIssuanceFailure{
+								Time:    metaFixedClockStart,
+								Reason:  "CustomFieldsError",
+								Message: "Failed to parse \"venafi.cert-manager.io/custom-fields\" annotation: invalid character 'c' looking for beginning of value",
+							}),
 							gen.SetCertificateRequestFailureTime(metaFixedClockStart),
 						),
 					)),
@@ -778,6 +1030,11 @@ func TestSign(t *testing.T) {
 								Message:            "certificate request contains an invalid Venafi custom fields type: \"Bool\": certificate request contains an invalid Venafi custom fields type: \"Bool\"",
 								LastTransitionTime: &metaFixedClockStart,
 							}),
+							gen.AddCertificateRequestIssuanceFailure(cmapi.CertificateRequestIssuanceFailure{
+								Time:    metaFixedClockStart,
+								Reason:  "CustomFieldsError",
+								Message: "certificate request contains an invalid Venafi custom fields type: \"Bool\": certificate request contains an invalid Venafi custom fields type: \"Bool\"",
+							}),
 							gen.SetCertificateRequestFailureTime(metaFixedClockStart),
 						),
 					)),
@@ -787,6 +1044,157 @@ func TestSign(t *testing.T) {
 			fakeClient:       clientReturnsInvalidCustomFieldType,
 			expectedErr:      false,
 		},
+		"annotations: Error on missing required custom fields": {
+			certificateRequest: tppCR.DeepCopy(),
+			builder: &controllertest.Builder{
+				CertManagerObjects: []runtime.Object{tppCR.DeepCopy(), tppIssuer.DeepCopy()},
+				ExpectedEvents: []string{
+					`Warning CustomFieldsError certificate request is missing values for required Venafi custom fields: App ID: certificate request is missing values for required Venafi custom fields: App ID`,
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(tppCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonFailed,
+								Message:            "certificate request is missing values for required Venafi custom fields: App ID: certificate request is missing values for required Venafi custom fields: App ID",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.AddCertificateRequestIssuanceFailure(cmapi.CertificateRequestIssuanceFailure{
+								Time:    metaFixedClockStart,
+								Reason:  "CustomFieldsError",
+								Message: "certificate request is missing values for required Venafi custom fields: App ID: certificate request is missing values for required Venafi custom fields: App ID",
+							}),
+							gen.SetCertificateRequestFailureTime(metaFixedClockStart),
+						),
+					)),
+				},
+			},
+			fakeSecretLister: failGetSecretLister,
+			fakeClient:       clientReturnsMissingRequiredCustomField,
+			expectedErr:      false,
+		},
+		"annotations: Zone override listed in allowedZoneOverrides is passed to the client": {
+			certificateRequest: tppCRWithAllowedZone.DeepCopy(),
+			builder: &controllertest.Builder{
+				CertManagerObjects: []runtime.Object{tppCRWithAllowedZone.DeepCopy(), tppZoneOverrideIssuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Normal IssuancePending Venafi certificate is requested",
+					"Normal CertificateIssued Certificate fetched from issuer successfully",
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(tppCRWithAllowedZone,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonPending,
+								Message:            "Venafi certificate is requested",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.SetCertificateRequestPickupID("test"),
+						),
+					)),
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(tppCRWithAllowedZone,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionTrue,
+								Reason:             cmapi.CertificateRequestReasonIssued,
+								Message:            "Certificate fetched from issuer successfully",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.SetCertificateRequestCertificate(certPEM),
+							gen.SetCertificateRequestCA(rootPEM),
+							gen.SetCertificateRequestPickupID("test"),
+						),
+					)),
+				},
+			},
+			fakeSecretLister: failGetSecretLister,
+			fakeClient:       clientReturnsCert,
+			expectedZone:     "team-a",
+		},
+		"annotations: Zone override not listed in allowedZoneOverrides should hard fail": {
+			certificateRequest: tppCRWithDeniedZone.DeepCopy(),
+			builder: &controllertest.Builder{
+				CertManagerObjects: []runtime.Object{tppCRWithDeniedZone.DeepCopy(), tppZoneOverrideIssuer.DeepCopy()},
+				ExpectedEvents: []string{
+					`Warning ZoneOverrideDenied Failed to apply "venafi.cert-manager.io/zone" annotation: zone "team-b" is not listed in the issuer's allowedZoneOverrides`,
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(tppCRWithDeniedZone,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonFailed,
+								Message:            `Failed to apply "venafi.cert-manager.io/zone" annotation: zone "team-b" is not listed in the issuer's allowedZoneOverrides`,
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.AddCertificateRequestIssuanceFailure(cmapi.CertificateRequestIssuanceFailure{
+								Time:    metaFixedClockStart,
+								Reason:  "ZoneOverrideDenied",
+								Message: `Failed to apply "venafi.cert-manager.io/zone" annotation: zone "team-b" is not listed in the issuer's allowedZoneOverrides`,
+							}),
+							gen.SetCertificateRequestFailureTime(metaFixedClockStart),
+						),
+					)),
+				},
+			},
+			fakeSecretLister:   failGetSecretLister,
+			fakeClient:         clientReturnsCert,
+			skipSecondSignCall: true,
+			expectedErr:        false,
+		},
+		"annotations: Zone override on a cloud issuer should hard fail": {
+			certificateRequest: cloudCRWithZone.DeepCopy(),
+			builder: &controllertest.Builder{
+				CertManagerObjects: []runtime.Object{cloudCRWithZone.DeepCopy(), cloudIssuer.DeepCopy()},
+				ExpectedEvents: []string{
+					`Warning ZoneOverrideDenied Failed to apply "venafi.cert-manager.io/zone" annotation: zone "team-a" is not listed in the issuer's allowedZoneOverrides`,
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(cloudCRWithZone,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonFailed,
+								Message:            `Failed to apply "venafi.cert-manager.io/zone" annotation: zone "team-a" is not listed in the issuer's allowedZoneOverrides`,
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.AddCertificateRequestIssuanceFailure(cmapi.CertificateRequestIssuanceFailure{
+								Time:    metaFixedClockStart,
+								Reason:  "ZoneOverrideDenied",
+								Message: `Failed to apply "venafi.cert-manager.io/zone" annotation: zone "team-a" is not listed in the issuer's allowedZoneOverrides`,
+							}),
+							gen.SetCertificateRequestFailureTime(metaFixedClockStart),
+						),
+					)),
+				},
+			},
+			fakeSecretLister:   failGetSecretLister,
+			fakeClient:         clientReturnsCert,
+			skipSecondSignCall: true,
+			expectedErr:        false,
+		},
 	}
 
 	for name, test := range tests {
@@ -804,10 +1212,18 @@ type testT struct {
 
 	fakeClient *internalvenafifake.Venafi
 
+	// expectedZone, if set, is the Venafi zone the client is expected to be
+	// built with.
+	expectedZone string
+
 	expectedErr bool
 
 	skipSecondSignCall bool
 
+	// retryOnError, if true, syncs the CertificateRequest again if the
+	// first sync returns an error, as the controller would when requeueing it.
+	retryOnError bool
+
 	fakeSecretLister *testlisters.FakeSecretLister
 }
 
@@ -825,6 +1241,9 @@ func runTest(t *testing.T, test testT) {
 	if test.fakeClient != nil {
 		v.clientBuilder = func(namespace string, secretsLister internalinformers.SecretLister,
 			issuer cmapi.GenericIssuer, _ *metrics.Metrics, _ logr.Logger, _ string) (client.Interface, error) {
+			if test.expectedZone != "" && issuer.GetSpec().Venafi.Zone != test.expectedZone {
+				t.Errorf("expected client to be built with zone %q, got %q", test.expectedZone, issuer.GetSpec().Venafi.Zone)
+			}
 			return test.fakeClient, nil
 		}
 	}
@@ -839,9 +1258,13 @@ func runTest(t *testing.T, test testT) {
 	// Deep copy the certificate request to prevent pulling condition state across tests
 	err := controller.Sync(context.Background(), test.certificateRequest)
 
+	if err != nil && test.retryOnError {
+		err = controller.Sync(context.Background(), test.certificateRequest)
+	}
+
 	if err == nil && test.fakeClient != nil && test.fakeClient.RetrieveCertificateFn != nil && !test.skipSecondSignCall {
 		// request state is ok! simulating a 2nd sync to fetch the cert
-		metav1.SetMetaDataAnnotation(&test.certificateRequest.ObjectMeta, cmapi.VenafiPickupIDAnnotationKey, "test")
+		test.certificateRequest.Status.PickupID = "test"
 		err = controller.Sync(context.Background(), test.certificateRequest)
 	}
 
@@ -0,0 +1,122 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"bytes"
+	"crypto/x509"
+	"fmt"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+// AssembleCertificateChain returns the PEM encoded certificate chain to be
+// written to the `tls.crt` key of a Certificate's Secret, given the chain and
+// CA returned by the issuer.
+// If order is empty, the chain is returned unchanged. Otherwise the chain is
+// rebuilt starting with the leaf certificate:
+//   - leaf-only returns only the leaf certificate.
+//   - leaf-then-intermediates returns the leaf followed by any intermediates,
+//     omitting any self-signed root.
+//   - full-chain-with-root returns the leaf followed by any intermediates and
+//     the self-signed root. If the root is not part of the chain, it is taken
+//     from the CA. An error is returned if the root cannot be found.
+func AssembleCertificateChain(order cmapi.CertificateChainOrder, chainPEM, caPEM []byte) ([]byte, error) {
+	if order == "" {
+		return chainPEM, nil
+	}
+
+	certs, err := pki.DecodeX509CertificateChainBytes(chainPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle, err := pki.ParseSingleCertificateChain(certs)
+	if err != nil {
+		return nil, err
+	}
+
+	// bundle.ChainPEM always starts with the leaf and never contains a
+	// self-signed root, unless the leaf is itself self-signed.
+	chain, err := pki.DecodeX509CertificateChainBytes(bundle.ChainPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	switch order {
+	case cmapi.CertificateChainOrderLeafOnly:
+		return pki.EncodeX509(chain[0])
+
+	case cmapi.CertificateChainOrderLeafThenIntermediates:
+		return bundle.ChainPEM, nil
+
+	case cmapi.CertificateChainOrderFullChainWithRoot:
+		last := chain[len(chain)-1]
+		if isSelfSigned(last) {
+			return bundle.ChainPEM, nil
+		}
+
+		root, err := findRoot(last, bundle.CAPEM, caPEM)
+		if err != nil {
+			return nil, err
+		}
+
+		rootPEM, err := pki.EncodeX509(root)
+		if err != nil {
+			return nil, err
+		}
+
+		return append(append([]byte{}, bundle.ChainPEM...), rootPEM...), nil
+
+	default:
+		return nil, fmt.Errorf("unknown certificate chain order %q", order)
+	}
+}
+
+// findRoot returns the self-signed certificate which issued cert from the
+// given PEM encoded certificate sets.
+func findRoot(cert *x509.Certificate, pemSets ...[]byte) (*x509.Certificate, error) {
+	for _, pemSet := range pemSets {
+		if len(pemSet) == 0 {
+			continue
+		}
+
+		candidates, err := pki.DecodeX509CertificateSetBytes(pemSet)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, candidate := range candidates {
+			if isSelfSigned(candidate) &&
+				bytes.Equal(cert.RawIssuer, candidate.RawSubject) &&
+				cert.CheckSignatureFrom(candidate) == nil {
+				return candidate, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("the root certificate %q which issued %q is not present in the chain or the CA",
+		cert.Issuer.String(), cert.Subject.String())
+}
+
+// isSelfSigned returns true if the certificate is signed by its own key. The
+// certificate need not be a CA.
+func isSelfSigned(cert *x509.Certificate) bool {
+	return bytes.Equal(cert.RawIssuer, cert.RawSubject) &&
+		cert.CheckSignature(cert.SignatureAlgorithm, cert.RawTBSCertificate, cert.Signature) == nil
+}
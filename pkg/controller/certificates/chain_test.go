@@ -0,0 +1,169 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+type testCertificate struct {
+	cert *x509.Certificate
+	pk   crypto.Signer
+	pem  []byte
+}
+
+// mustCreateCertificate returns a certificate signed by the given issuer, or
+// a self-signed certificate if issuer is nil.
+func mustCreateCertificate(t *testing.T, issuer *testCertificate, name string, isCA bool) *testCertificate {
+	pk, err := pki.GenerateECPrivateKey(256)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		BasicConstraintsValid: true,
+		SerialNumber:          big.NewInt(1),
+		IsCA:                  isCA,
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+
+	issuerCert, issuerKey := template, crypto.Signer(pk)
+	if issuer != nil {
+		issuerCert, issuerKey = issuer.cert, issuer.pk
+	}
+
+	certPEM, cert, err := pki.SignCertificate(template, issuerCert, pk.Public(), issuerKey)
+	require.NoError(t, err)
+
+	return &testCertificate{cert: cert, pk: pk, pem: certPEM}
+}
+
+func TestAssembleCertificateChain(t *testing.T) {
+	root := mustCreateCertificate(t, nil, "root", true)
+	intA := mustCreateCertificate(t, root, "intA", true)
+	intB := mustCreateCertificate(t, intA, "intB", true)
+	leaf := mustCreateCertificate(t, intB, "leaf", false)
+	selfSigned := mustCreateCertificate(t, nil, "self-signed", false)
+	otherRoot := mustCreateCertificate(t, nil, "other-root", true)
+
+	join := func(pems ...*testCertificate) []byte {
+		var b []byte
+		for _, p := range pems {
+			b = append(b, p.pem...)
+		}
+		return b
+	}
+
+	tests := map[string]struct {
+		order  cmapi.CertificateChainOrder
+		chain  []byte
+		ca     []byte
+		exp    []byte
+		expErr string
+	}{
+		"if no order is set, the chain is returned as is": {
+			chain: join(intB, leaf, root),
+			ca:    root.pem,
+			exp:   join(intB, leaf, root),
+		},
+		"leaf-only returns the leaf": {
+			order: cmapi.CertificateChainOrderLeafOnly,
+			chain: join(leaf, intB, intA),
+			ca:    root.pem,
+			exp:   leaf.pem,
+		},
+		"leaf-only returns the leaf of an unordered chain": {
+			order: cmapi.CertificateChainOrderLeafOnly,
+			chain: join(intA, leaf, intB),
+			exp:   leaf.pem,
+		},
+		"leaf-only returns a self-signed leaf": {
+			order: cmapi.CertificateChainOrderLeafOnly,
+			chain: selfSigned.pem,
+			exp:   selfSigned.pem,
+		},
+		"leaf-then-intermediates returns the leaf and intermediates": {
+			order: cmapi.CertificateChainOrderLeafThenIntermediates,
+			chain: join(leaf, intB, intA),
+			ca:    root.pem,
+			exp:   join(leaf, intB, intA),
+		},
+		"leaf-then-intermediates orders the chain and omits the root": {
+			order: cmapi.CertificateChainOrderLeafThenIntermediates,
+			chain: join(root, intA, leaf, intB),
+			exp:   join(leaf, intB, intA),
+		},
+		"full-chain-with-root appends the root from the CA": {
+			order: cmapi.CertificateChainOrderFullChainWithRoot,
+			chain: join(leaf, intB, intA),
+			ca:    join(otherRoot, root),
+			exp:   join(leaf, intB, intA, root),
+		},
+		"full-chain-with-root orders a chain which contains the root": {
+			order: cmapi.CertificateChainOrderFullChainWithRoot,
+			chain: join(root, intB, leaf, intA),
+			exp:   join(leaf, intB, intA, root),
+		},
+		"full-chain-with-root returns a self-signed leaf": {
+			order: cmapi.CertificateChainOrderFullChainWithRoot,
+			chain: selfSigned.pem,
+			exp:   selfSigned.pem,
+		},
+		"full-chain-with-root errors if the root cannot be found": {
+			order:  cmapi.CertificateChainOrderFullChainWithRoot,
+			chain:  join(leaf, intB),
+			ca:     root.pem,
+			expErr: `the root certificate "CN=intA" which issued "CN=intB" is not present in the chain or the CA`,
+		},
+		"a broken chain errors": {
+			order:  cmapi.CertificateChainOrderLeafThenIntermediates,
+			chain:  join(leaf, intA),
+			expErr: "certificate chain is malformed or broken",
+		},
+		"an unknown order errors": {
+			order:  "root-first",
+			chain:  leaf.pem,
+			expErr: `unknown certificate chain order "root-first"`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := AssembleCertificateChain(test.order, test.chain, test.ca)
+			if test.expErr != "" {
+				assert.EqualError(t, err, test.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, bytes.Equal(test.exp, got), "unexpected chain, exp=%s got=%s", test.exp, got)
+		})
+	}
+}
@@ -29,9 +29,13 @@ import (
 	"time"
 
 	jks "github.com/pavlo-v-chernykh/keystore-go/v4"
+	corev1 "k8s.io/api/core/v1"
 	"software.sslmate.com/src/go-pkcs12"
 
+	"github.com/cert-manager/cert-manager/internal/fips"
+	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
 )
 
@@ -62,16 +66,7 @@ func encodePKCS12Keystore(profile cmapi.PKCS12Profile, password string, rawKey [
 		cas = append(certs[1:], cas...)
 	}
 
-	switch profile {
-	case cmapi.Modern2023PKCS12Profile:
-		return pkcs12.Modern2023.Encode(key, certs[0], cas, password)
-	case cmapi.LegacyDESPKCS12Profile:
-		return pkcs12.LegacyDES.Encode(key, certs[0], cas, password)
-	case cmapi.LegacyRC2PKCS12Profile:
-		return pkcs12.LegacyRC2.Encode(key, certs[0], cas, password)
-	default:
-		return pkcs12.LegacyRC2.Encode(key, certs[0], cas, password)
-	}
+	return pkcs12Encoder(profile).Encode(key, certs[0], cas, password)
 }
 
 func encodePKCS12Truststore(profile cmapi.PKCS12Profile, password string, caPem []byte) ([]byte, error) {
@@ -80,15 +75,25 @@ func encodePKCS12Truststore(profile cmapi.PKCS12Profile, password string, caPem
 		return nil, err
 	}
 
+	return pkcs12Encoder(profile).EncodeTrustStore(cas, password)
+}
+
+// pkcs12Encoder returns the PKCS12 encoder for the given profile. If no
+// profile is set, LegacyRC2 is used for backward compatibility, unless running
+// in FIPS mode, where only the AES based Modern2023 profile can be used.
+func pkcs12Encoder(profile cmapi.PKCS12Profile) *pkcs12.Encoder {
 	switch profile {
 	case cmapi.Modern2023PKCS12Profile:
-		return pkcs12.Modern2023.EncodeTrustStore(cas, password)
+		return pkcs12.Modern2023
 	case cmapi.LegacyDESPKCS12Profile:
-		return pkcs12.LegacyDES.EncodeTrustStore(cas, password)
+		return pkcs12.LegacyDES
 	case cmapi.LegacyRC2PKCS12Profile:
-		return pkcs12.LegacyRC2.EncodeTrustStore(cas, password)
+		return pkcs12.LegacyRC2
 	default:
-		return pkcs12.LegacyRC2.EncodeTrustStore(cas, password)
+		if fips.Enabled() {
+			return pkcs12.Modern2023
+		}
+		return pkcs12.LegacyRC2
 	}
 }
 
@@ -175,3 +180,65 @@ func addCAsToJKSStore(ks *jks.KeyStore, caPem []byte) error {
 	}
 	return nil
 }
+
+// keystorePassword returns the keystore password stored in the Secret key
+// referenced by ref. The format is only used in error messages.
+func keystorePassword(secretLister internalinformers.SecretLister, namespace string, ref cmmeta.SecretKeySelector, format string) ([]byte, error) {
+	pwSecret, err := secretLister.Secrets(namespace).Get(ref.Name)
+	if err != nil {
+		return nil, fmt.Errorf("fetching %s keystore password from Secret: %v", format, err)
+	}
+	if pwSecret.Data == nil || len(pwSecret.Data[ref.Key]) == 0 {
+		return nil, fmt.Errorf("%s keystore password Secret contains no data for key %q", format, ref.Key)
+	}
+	return pwSecret.Data[ref.Key], nil
+}
+
+// KeystorePasswordsMismatch returns true, along with a message, if any of the
+// keystores or truststores in the given Secret can not be opened with the
+// password currently stored in the Secret referenced by the Certificate. This
+// is the case when a keystore password is rotated, and the keystores must be
+// re-encoded from the existing certificate and private key.
+// Missing keystore entries and missing or empty password Secrets are not
+// reported here; those are handled when the keystores are written.
+func KeystorePasswordsMismatch(secretLister internalinformers.SecretLister, crt *cmapi.Certificate, secret *corev1.Secret) (string, bool) {
+	if crt.Spec.Keystores == nil {
+		return "", false
+	}
+
+	if pkcs12Keystore := crt.Spec.Keystores.PKCS12; pkcs12Keystore != nil && pkcs12Keystore.Create {
+		pw, err := keystorePassword(secretLister, crt.Namespace, pkcs12Keystore.PasswordSecretRef, "PKCS12")
+		if err != nil {
+			return "", false
+		}
+		if data := secret.Data[cmapi.PKCS12SecretKey]; len(data) > 0 {
+			if _, _, _, err := pkcs12.DecodeChain(data, string(pw)); err != nil {
+				return "PKCS12 keystore can not be opened with the current password", true
+			}
+		}
+		if data := secret.Data[cmapi.PKCS12TruststoreKey]; len(data) > 0 {
+			if _, err := pkcs12.DecodeTrustStore(data, string(pw)); err != nil {
+				return "PKCS12 truststore can not be opened with the current password", true
+			}
+		}
+	}
+
+	if jksKeystore := crt.Spec.Keystores.JKS; jksKeystore != nil && jksKeystore.Create {
+		pw, err := keystorePassword(secretLister, crt.Namespace, jksKeystore.PasswordSecretRef, "JKS")
+		if err != nil {
+			return "", false
+		}
+		if data := secret.Data[cmapi.JKSSecretKey]; len(data) > 0 {
+			if err := jks.New().Load(bytes.NewReader(data), pw); err != nil {
+				return "JKS keystore can not be opened with the current password", true
+			}
+		}
+		if data := secret.Data[cmapi.JKSTruststoreKey]; len(data) > 0 {
+			if err := jks.New().Load(bytes.NewReader(data), pw); err != nil {
+				return "JKS truststore can not be opened with the current password", true
+			}
+		}
+	}
+
+	return "", false
+}
@@ -21,6 +21,8 @@ import (
 	"context"
 	"crypto"
 	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
 	"fmt"
 	"testing"
 
@@ -30,10 +32,16 @@ import (
 	"github.com/stretchr/testify/require"
 	"golang.org/x/sync/errgroup"
 	"golang.org/x/sync/semaphore"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"software.sslmate.com/src/go-pkcs12"
 
+	"github.com/cert-manager/cert-manager/internal/fips"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	testcorelisters "github.com/cert-manager/cert-manager/test/unit/listers"
 )
 
 func mustGeneratePrivateKey(t *testing.T, encoding cmapi.PrivateKeyEncoding) []byte {
@@ -588,3 +596,234 @@ func TestManyPasswordLengths(t *testing.T) {
 	err := g.Wait()
 	assert.NoError(t, err)
 }
+
+func TestKeystorePasswordsMismatch(t *testing.T) {
+	rawKey := mustGeneratePrivateKey(t, cmapi.PKCS8)
+	certPEM := mustSelfSignCertificate(t)
+	caPEM := mustSelfSignCertificate(t)
+
+	passwordRef := cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "keystore-password"}, Key: "password"}
+	crt := &cmapi.Certificate{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test"},
+		Spec: cmapi.CertificateSpec{
+			Keystores: &cmapi.CertificateKeystores{
+				JKS:    &cmapi.JKSKeystore{Create: true, PasswordSecretRef: passwordRef},
+				PKCS12: &cmapi.PKCS12Keystore{Create: true, PasswordSecretRef: passwordRef},
+			},
+		},
+	}
+
+	secretWithKeystores := func(t *testing.T, password string) *corev1.Secret {
+		pkcs12Keystore, err := encodePKCS12Keystore(cmapi.Modern2023PKCS12Profile, password, rawKey, certPEM, caPEM)
+		require.NoError(t, err)
+		pkcs12Truststore, err := encodePKCS12Truststore(cmapi.Modern2023PKCS12Profile, password, caPEM)
+		require.NoError(t, err)
+		jksKeystore, err := encodeJKSKeystore([]byte(password), "certificate", rawKey, certPEM, caPEM)
+		require.NoError(t, err)
+		jksTruststore, err := encodeJKSTruststore([]byte(password), caPEM)
+		require.NoError(t, err)
+		return &corev1.Secret{
+			Data: map[string][]byte{
+				cmapi.PKCS12SecretKey:     pkcs12Keystore,
+				cmapi.PKCS12TruststoreKey: pkcs12Truststore,
+				cmapi.JKSSecretKey:        jksKeystore,
+				cmapi.JKSTruststoreKey:    jksTruststore,
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		crt            *cmapi.Certificate
+		secret         *corev1.Secret
+		passwordSecret *corev1.Secret
+		expMismatch    bool
+	}{
+		"no keystores defined, expect no mismatch": {
+			crt:         &cmapi.Certificate{},
+			secret:      secretWithKeystores(t, "password"),
+			expMismatch: false,
+		},
+		"keystores encoded with the current password, expect no mismatch": {
+			crt:            crt,
+			secret:         secretWithKeystores(t, "password"),
+			passwordSecret: &corev1.Secret{Data: map[string][]byte{"password": []byte("password")}},
+			expMismatch:    false,
+		},
+		"keystores encoded with a previous password, expect mismatch": {
+			crt:            crt,
+			secret:         secretWithKeystores(t, "old-password"),
+			passwordSecret: &corev1.Secret{Data: map[string][]byte{"password": []byte("password")}},
+			expMismatch:    true,
+		},
+		"keystores not yet written, expect no mismatch": {
+			crt:            crt,
+			secret:         &corev1.Secret{},
+			passwordSecret: &corev1.Secret{Data: map[string][]byte{"password": []byte("password")}},
+			expMismatch:    false,
+		},
+		"password Secret does not exist, expect no mismatch": {
+			crt:         crt,
+			secret:      secretWithKeystores(t, "password"),
+			expMismatch: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var mod testcorelisters.FakeSecretListerModifier
+			if test.passwordSecret != nil {
+				mod = testcorelisters.SetFakeSecretNamespaceListerGet(test.passwordSecret, nil)
+			} else {
+				mod = testcorelisters.SetFakeSecretNamespaceListerGet(nil, apierrors.NewNotFound(corev1.Resource("secret"), "keystore-password"))
+			}
+
+			_, mismatch := KeystorePasswordsMismatch(testcorelisters.NewFakeSecretLister(mod), test.crt, test.secret)
+			assert.Equal(t, test.expMismatch, mismatch)
+		})
+	}
+}
+
+func TestEncodePKCS12KeystoreAlgorithms(t *testing.T) {
+	var (
+		oidSHA1                          = asn1.ObjectIdentifier{1, 3, 14, 3, 2, 26}
+		oidSHA256                        = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 1}
+		oidPBEWithSHAAnd40BitRC2CBC      = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 1, 6}
+		oidPBEWithSHAAnd3KeyTripleDESCBC = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 1, 3}
+		oidAES256CBC                     = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 42}
+	)
+
+	defaultAlgorithms := pkcs12Algorithms{mac: oidSHA1, certificates: oidPBEWithSHAAnd40BitRC2CBC, privateKey: oidPBEWithSHAAnd3KeyTripleDESCBC}
+	if fips.Enabled() {
+		defaultAlgorithms = pkcs12Algorithms{mac: oidSHA256, certificates: oidAES256CBC, privateKey: oidAES256CBC}
+	}
+
+	tests := map[cmapi.PKCS12Profile]pkcs12Algorithms{
+		"":                            defaultAlgorithms,
+		cmapi.LegacyRC2PKCS12Profile:  {mac: oidSHA1, certificates: oidPBEWithSHAAnd40BitRC2CBC, privateKey: oidPBEWithSHAAnd3KeyTripleDESCBC},
+		cmapi.LegacyDESPKCS12Profile:  {mac: oidSHA1, certificates: oidPBEWithSHAAnd3KeyTripleDESCBC, privateKey: oidPBEWithSHAAnd3KeyTripleDESCBC},
+		cmapi.Modern2023PKCS12Profile: {mac: oidSHA256, certificates: oidAES256CBC, privateKey: oidAES256CBC},
+	}
+
+	rawKey := mustGeneratePrivateKey(t, cmapi.PKCS8)
+	certPEM := mustSelfSignCertificate(t)
+	for profile, expected := range tests {
+		t.Run(fmt.Sprintf("profile=%q", profile), func(t *testing.T) {
+			out, err := encodePKCS12Keystore(profile, "password", rawKey, certPEM, nil)
+			require.NoError(t, err)
+
+			got := mustParsePKCS12Algorithms(t, out)
+			assert.True(t, expected.mac.Equal(got.mac), "unexpected MAC algorithm: %s", got.mac)
+			assert.True(t, expected.certificates.Equal(got.certificates), "unexpected certificate encryption algorithm: %s", got.certificates)
+			assert.True(t, expected.privateKey.Equal(got.privateKey), "unexpected private key encryption algorithm: %s", got.privateKey)
+		})
+	}
+}
+
+// pkcs12Algorithms holds the algorithms used to protect a PKCS#12 file. For
+// PBES2 the encryption scheme is recorded rather than the PBES2 identifier.
+type pkcs12Algorithms struct {
+	mac, certificates, privateKey asn1.ObjectIdentifier
+}
+
+// mustParsePKCS12Algorithms parses just enough of a PKCS#12 file, as defined
+// in RFC 7292, to return the algorithms which were used to encode it.
+func mustParsePKCS12Algorithms(t *testing.T, der []byte) pkcs12Algorithms {
+	t.Helper()
+
+	var (
+		oidDataContentType          = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}
+		oidEncryptedDataContentType = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 6}
+		oidPKCS8ShroudedKeyBag      = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 10, 1, 2}
+		oidPBES2                    = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 13}
+	)
+
+	type contentInfo struct {
+		ContentType asn1.ObjectIdentifier
+		Content     asn1.RawValue `asn1:"tag:0,explicit,optional"`
+	}
+	type digestInfo struct {
+		Algorithm pkix.AlgorithmIdentifier
+		Digest    []byte
+	}
+	type macData struct {
+		Mac        digestInfo
+		MacSalt    []byte
+		Iterations int `asn1:"optional,default:1"`
+	}
+	type pfxPdu struct {
+		Version  int
+		AuthSafe contentInfo
+		MacData  macData `asn1:"optional"`
+	}
+	type encryptedContentInfo struct {
+		ContentType                asn1.ObjectIdentifier
+		ContentEncryptionAlgorithm pkix.AlgorithmIdentifier
+		EncryptedContent           []byte `asn1:"tag:0,optional"`
+	}
+	type encryptedData struct {
+		Version              int
+		EncryptedContentInfo encryptedContentInfo
+	}
+	type safeBag struct {
+		ID         asn1.ObjectIdentifier
+		Value      asn1.RawValue   `asn1:"tag:0,explicit"`
+		Attributes []asn1.RawValue `asn1:"set,optional"`
+	}
+	type encryptedPrivateKeyInfo struct {
+		AlgorithmIdentifier pkix.AlgorithmIdentifier
+		EncryptedData       []byte
+	}
+	type pbes2Params struct {
+		Kdf              pkix.AlgorithmIdentifier
+		EncryptionScheme pkix.AlgorithmIdentifier
+	}
+
+	encryptionAlgorithm := func(algorithm pkix.AlgorithmIdentifier) asn1.ObjectIdentifier {
+		if !algorithm.Algorithm.Equal(oidPBES2) {
+			return algorithm.Algorithm
+		}
+		var params pbes2Params
+		_, err := asn1.Unmarshal(algorithm.Parameters.FullBytes, &params)
+		require.NoError(t, err)
+		return params.EncryptionScheme.Algorithm
+	}
+
+	var pfx pfxPdu
+	_, err := asn1.Unmarshal(der, &pfx)
+	require.NoError(t, err)
+
+	var authSafe []byte
+	_, err = asn1.Unmarshal(pfx.AuthSafe.Content.Bytes, &authSafe)
+	require.NoError(t, err)
+	var contentInfos []contentInfo
+	_, err = asn1.Unmarshal(authSafe, &contentInfos)
+	require.NoError(t, err)
+
+	algorithms := pkcs12Algorithms{mac: pfx.MacData.Mac.Algorithm.Algorithm}
+	for _, ci := range contentInfos {
+		switch {
+		case ci.ContentType.Equal(oidEncryptedDataContentType):
+			var data encryptedData
+			_, err := asn1.Unmarshal(ci.Content.Bytes, &data)
+			require.NoError(t, err)
+			algorithms.certificates = encryptionAlgorithm(data.EncryptedContentInfo.ContentEncryptionAlgorithm)
+		case ci.ContentType.Equal(oidDataContentType):
+			var data []byte
+			_, err := asn1.Unmarshal(ci.Content.Bytes, &data)
+			require.NoError(t, err)
+			var bags []safeBag
+			_, err = asn1.Unmarshal(data, &bags)
+			require.NoError(t, err)
+			for _, bag := range bags {
+				if !bag.ID.Equal(oidPKCS8ShroudedKeyBag) {
+					continue
+				}
+				var keyInfo encryptedPrivateKeyInfo
+				_, err := asn1.Unmarshal(bag.Value.Bytes, &keyInfo)
+				require.NoError(t, err)
+				algorithms.privateKey = encryptionAlgorithm(keyInfo.AlgorithmIdentifier)
+			}
+		}
+	}
+	return algorithms
+}
@@ -24,13 +24,17 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
 	applycorev1 "k8s.io/client-go/applyconfigurations/core/v1"
 	applymetav1 "k8s.io/client-go/applyconfigurations/meta/v1"
 	coreclient "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/record"
 
 	"github.com/cert-manager/cert-manager/internal/controller/certificates"
 	"github.com/cert-manager/cert-manager/internal/controller/feature"
 	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
@@ -42,10 +46,16 @@ var (
 	certificateGvk = cmapi.SchemeGroupVersion.WithKind("Certificate")
 )
 
+const (
+	reasonAdditionalOutputSecretConflict = "AdditionalOutputSecretConflict"
+	reasonSecretTemplateLabelInvalid     = "SecretTemplateLabelInvalid"
+)
+
 // SecretsManager creates and updates secrets with certificate and key data.
 type SecretsManager struct {
 	secretClient coreclient.SecretsGetter
 	secretLister internalinformers.SecretLister
+	recorder     record.EventRecorder
 
 	// fieldManager is the manager name used for the Apply operations on Secrets.
 	fieldManager string
@@ -70,12 +80,14 @@ type SecretData struct {
 func NewSecretsManager(
 	secretClient coreclient.SecretsGetter,
 	secretLister internalinformers.SecretLister,
+	recorder record.EventRecorder,
 	fieldManager string,
 	enableSecretOwnerReferences bool,
 ) *SecretsManager {
 	return &SecretsManager{
 		secretClient:                secretClient,
 		secretLister:                secretLister,
+		recorder:                    recorder,
 		fieldManager:                fieldManager,
 		enableSecretOwnerReferences: enableSecretOwnerReferences,
 	}
@@ -98,6 +110,27 @@ func (s *SecretsManager) UpdateData(ctx context.Context, crt *cmapi.Certificate,
 		return err
 	}
 
+	preserved, err := s.preservedKeys(crt)
+	if err != nil {
+		return err
+	}
+	// Preserved keys are not applied so that their values, and ownership, are
+	// left with whoever manages them.
+	for key := range preserved {
+		delete(secret.Data, key)
+	}
+
+	log.V(logf.DebugLevel).Info("applying secret")
+
+	if err := s.apply(ctx, crt, secret); err != nil {
+		return err
+	}
+
+	return s.updateAdditionalOutputSecrets(ctx, crt, secret)
+}
+
+// apply applies the given Secret using the SecretsManager's field manager.
+func (s *SecretsManager) apply(ctx context.Context, crt *cmapi.Certificate, secret *corev1.Secret) error {
 	// Build Secret apply configuration and options.
 	applyOpts := metav1.ApplyOptions{FieldManager: s.fieldManager, Force: true}
 	applyCnf := applycorev1.Secret(secret.Name, secret.Namespace).
@@ -116,9 +149,7 @@ func (s *SecretsManager) UpdateData(ctx context.Context, crt *cmapi.Certificate,
 		})
 	}
 
-	log.V(logf.DebugLevel).Info("applying secret")
-
-	_, err = s.secretClient.Secrets(secret.Namespace).Apply(ctx, applyCnf, applyOpts)
+	_, err := s.secretClient.Secrets(secret.Namespace).Apply(ctx, applyCnf, applyOpts)
 	if err != nil {
 		return fmt.Errorf("failed to apply secret %s/%s: %w", secret.Namespace, secret.Name, err)
 	}
@@ -126,6 +157,112 @@ func (s *SecretsManager) UpdateData(ctx context.Context, crt *cmapi.Certificate,
 	return nil
 }
 
+// updateAdditionalOutputSecrets applies the data, labels and annotations of
+// the Certificate's target Secret to each of the Certificate's additional
+// output Secrets. Secrets which were previously managed as additional output
+// Secrets of the Certificate, but are no longer listed, are released.
+// An existing Secret which is not an additional output Secret of the
+// Certificate, such as a user's Secret or another Certificate's Secret, is
+// never written to.
+func (s *SecretsManager) updateAdditionalOutputSecrets(ctx context.Context, crt *cmapi.Certificate, target *corev1.Secret) error {
+	log := logf.FromContext(ctx).WithName("secrets_manager")
+
+	desired := sets.New[string]()
+	for _, additional := range crt.Spec.AdditionalOutputSecrets {
+		if additional.Name == crt.Spec.SecretName || desired.Has(additional.Name) {
+			continue
+		}
+		desired.Insert(additional.Name)
+
+		existing, err := s.secretLister.Secrets(crt.Namespace).Get(additional.Name)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		if existing != nil && !IsAdditionalOutputSecretOf(existing, crt) {
+			s.recorder.Eventf(crt, corev1.EventTypeWarning, reasonAdditionalOutputSecretConflict,
+				"Not writing additional output Secret %q as a Secret with the same name which is not an additional output Secret of this Certificate already exists", additional.Name)
+			continue
+		}
+
+		secret, err := s.getSecret(crt.Namespace, additional.Name)
+		if err != nil {
+			return err
+		}
+		secret.Data = target.Data
+		secret.Annotations = make(map[string]string, len(target.Annotations)+1)
+		for k, v := range target.Annotations {
+			secret.Annotations[k] = v
+		}
+		secret.Annotations[cmapi.CertificateNameKey] = crt.Name
+		secret.Labels = make(map[string]string, len(target.Labels)+1)
+		for k, v := range target.Labels {
+			secret.Labels[k] = v
+		}
+		secret.Labels[cmapi.IsAdditionalOutputSecretLabelKey] = "true"
+
+		logf.WithResource(log, secret).V(logf.DebugLevel).Info("applying additional output secret")
+		if err := s.apply(ctx, crt, secret); err != nil {
+			return err
+		}
+	}
+
+	orphaned, err := OrphanedAdditionalOutputSecrets(s.secretLister, crt)
+	if err != nil {
+		return err
+	}
+	for _, secret := range orphaned {
+		logf.WithResource(log, secret).Info("releasing secret which is no longer an additional output secret of the certificate")
+
+		secret = secret.DeepCopy()
+		delete(secret.Labels, cmapi.IsAdditionalOutputSecretLabelKey)
+		var ownerRefs []metav1.OwnerReference
+		for _, ref := range secret.OwnerReferences {
+			if ref.UID != crt.UID {
+				ownerRefs = append(ownerRefs, ref)
+			}
+		}
+		secret.OwnerReferences = ownerRefs
+
+		if _, err := s.secretClient.Secrets(secret.Namespace).Update(ctx, secret, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("failed to release secret %s/%s: %w", secret.Namespace, secret.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// IsAdditionalOutputSecretOf returns true if the Secret is labelled as an
+// additional output Secret of the given Certificate.
+func IsAdditionalOutputSecretOf(secret *corev1.Secret, crt *cmapi.Certificate) bool {
+	return secret.Labels[cmapi.IsAdditionalOutputSecretLabelKey] == "true" &&
+		secret.Annotations[cmapi.CertificateNameKey] == crt.Name
+}
+
+// OrphanedAdditionalOutputSecrets returns the Secrets which are labelled as
+// additional output Secrets of the given Certificate, but are no longer listed
+// in its spec.additionalOutputSecrets.
+func OrphanedAdditionalOutputSecrets(lister internalinformers.SecretLister, crt *cmapi.Certificate) ([]*corev1.Secret, error) {
+	selector := labels.SelectorFromSet(labels.Set{cmapi.IsAdditionalOutputSecretLabelKey: "true"})
+	secrets, err := lister.Secrets(crt.Namespace).List(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	desired := sets.New[string](crt.Spec.SecretName)
+	for _, additional := range crt.Spec.AdditionalOutputSecrets {
+		desired.Insert(additional.Name)
+	}
+
+	var orphaned []*corev1.Secret
+	for _, secret := range secrets {
+		if !IsAdditionalOutputSecretOf(secret, crt) || desired.Has(secret.Name) {
+			continue
+		}
+		orphaned = append(orphaned, secret)
+	}
+	return orphaned, nil
+}
+
 // setValues will update the Secret resource 'secret' with the data contained
 // in the given secretData.
 // It will update labels and annotations on the Secret resource appropriately.
@@ -160,15 +297,6 @@ func (s *SecretsManager) setValues(crt *cmapi.Certificate, secret *corev1.Secret
 		secret.Labels = make(map[string]string)
 	}
 
-	if crt.Spec.SecretTemplate != nil {
-		for k, v := range crt.Spec.SecretTemplate.Labels {
-			secret.Labels[k] = v
-		}
-		for k, v := range crt.Spec.SecretTemplate.Annotations {
-			secret.Annotations[k] = v
-		}
-	}
-
 	var certificate *x509.Certificate
 	if len(data.Certificate) > 0 {
 		var err error
@@ -180,6 +308,22 @@ func (s *SecretsManager) setValues(crt *cmapi.Certificate, secret *corev1.Secret
 		}
 	}
 
+	templateLabels, templateAnnotations, err := apiutil.RenderSecretTemplate(crt.Spec.SecretTemplate, certificate)
+	if err != nil {
+		return err
+	}
+	invalidLabels := apiutil.InvalidSecretTemplateLabels(crt.Spec.SecretTemplate, certificate)
+	for _, k := range sets.List(sets.KeySet(invalidLabels)) {
+		s.recorder.Eventf(crt, corev1.EventTypeWarning, reasonSecretTemplateLabelInvalid,
+			"Not setting label %q of the secretTemplate on Secret %q: %s", k, secret.Name, invalidLabels[k])
+	}
+	for k, v := range templateLabels {
+		secret.Labels[k] = v
+	}
+	for k, v := range templateAnnotations {
+		secret.Annotations[k] = v
+	}
+
 	certificateDetailsAnnotations, err := certificates.AnnotationsForCertificate(certificate)
 	if err != nil {
 		return err
@@ -205,19 +349,38 @@ func (s *SecretsManager) setValues(crt *cmapi.Certificate, secret *corev1.Secret
 	return nil
 }
 
+// preservedKeys returns the keys of the Certificate's Secret which should not
+// be written, as listed on the Certificate or the existing Secret.
+func (s *SecretsManager) preservedKeys(crt *cmapi.Certificate) (sets.Set[string], error) {
+	existingSecret, err := s.secretLister.Secrets(crt.Namespace).Get(crt.Spec.SecretName)
+	if apierrors.IsNotFound(err) {
+		existingSecret = nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return certificates.PreservedSecretKeys(crt, existingSecret), nil
+}
+
 // getCertificateSecret will return a secret which is ready for fields to be
 // applied. Only the Secret Type will be persisted from the original Secret.
 func (s *SecretsManager) getCertificateSecret(crt *cmapi.Certificate) (*corev1.Secret, error) {
+	return s.getSecret(crt.Namespace, crt.Spec.SecretName)
+}
+
+// getSecret will return the named secret ready for fields to be applied. Only
+// the Secret Type will be persisted from the original Secret.
+func (s *SecretsManager) getSecret(namespace, name string) (*corev1.Secret, error) {
 	// Get existing secret if it exists.
-	existingSecret, err := s.secretLister.Secrets(crt.Namespace).Get(crt.Spec.SecretName)
+	existingSecret, err := s.secretLister.Secrets(namespace).Get(name)
 
 	// If secret doesn't exist yet, return an empty secret that should be
 	// created.
 	if apierrors.IsNotFound(err) {
 		return &corev1.Secret{
 			ObjectMeta: metav1.ObjectMeta{
-				Name:      crt.Spec.SecretName,
-				Namespace: crt.Namespace,
+				Name:      name,
+				Namespace: namespace,
 			},
 			Data: make(map[string][]byte),
 			Type: corev1.SecretTypeTLS,
@@ -233,8 +396,8 @@ func (s *SecretsManager) getCertificateSecret(crt *cmapi.Certificate) (*corev1.S
 	// Apply.
 	return &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      crt.Spec.SecretName,
-			Namespace: crt.Namespace,
+			Name:      name,
+			Namespace: namespace,
 		},
 		Data: make(map[string][]byte),
 		// Use the existing Secret's type since this may not be of type
@@ -249,15 +412,10 @@ func (s *SecretsManager) getCertificateSecret(crt *cmapi.Certificate) (*corev1.S
 func (s *SecretsManager) setKeystores(crt *cmapi.Certificate, secret *corev1.Secret, data SecretData) error {
 	// Handle the experimental PKCS12 support
 	if crt.Spec.Keystores != nil && crt.Spec.Keystores.PKCS12 != nil && crt.Spec.Keystores.PKCS12.Create {
-		ref := crt.Spec.Keystores.PKCS12.PasswordSecretRef
-		pwSecret, err := s.secretLister.Secrets(crt.Namespace).Get(ref.Name)
+		pw, err := keystorePassword(s.secretLister, crt.Namespace, crt.Spec.Keystores.PKCS12.PasswordSecretRef, "PKCS12")
 		if err != nil {
-			return fmt.Errorf("fetching PKCS12 keystore password from Secret: %v", err)
-		}
-		if pwSecret.Data == nil || len(pwSecret.Data[ref.Key]) == 0 {
-			return fmt.Errorf("PKCS12 keystore password Secret contains no data for key %q", ref.Key)
+			return err
 		}
-		pw := pwSecret.Data[ref.Key]
 		profile := crt.Spec.Keystores.PKCS12.Profile
 		keystoreData, err := encodePKCS12Keystore(profile, string(pw), data.PrivateKey, data.Certificate, data.CA)
 		if err != nil {
@@ -278,15 +436,10 @@ func (s *SecretsManager) setKeystores(crt *cmapi.Certificate, secret *corev1.Sec
 
 	// Handle the experimental JKS support
 	if crt.Spec.Keystores != nil && crt.Spec.Keystores.JKS != nil && crt.Spec.Keystores.JKS.Create {
-		ref := crt.Spec.Keystores.JKS.PasswordSecretRef
-		pwSecret, err := s.secretLister.Secrets(crt.Namespace).Get(ref.Name)
+		pw, err := keystorePassword(s.secretLister, crt.Namespace, crt.Spec.Keystores.JKS.PasswordSecretRef, "JKS")
 		if err != nil {
-			return fmt.Errorf("fetching JKS keystore password from Secret: %v", err)
-		}
-		if pwSecret.Data == nil || len(pwSecret.Data[ref.Key]) == 0 {
-			return fmt.Errorf("JKS keystore password Secret contains no data for key %q", ref.Key)
+			return err
 		}
-		pw := pwSecret.Data[ref.Key]
 		alias := "certificate"
 		if crt.Spec.Keystores.JKS.Alias != nil {
 			alias = *crt.Spec.Keystores.JKS.Alias
@@ -319,6 +472,8 @@ func setAdditionalOutputFormats(crt *cmapi.Certificate, secret *corev1.Secret, d
 		case cmapi.CertificateOutputFormatDER:
 			// Store binary format of the private key
 			secret.Data[cmapi.CertificateOutputFormatDERKey] = certificates.OutputFormatDER(data.PrivateKey)
+			// Store binary format of the leaf certificate
+			secret.Data[cmapi.CertificateOutputFormatDERCertificateKey] = certificates.OutputFormatDERCertificate(data.Certificate)
 		case cmapi.CertificateOutputFormatCombinedPEM:
 			// Combine tls.key and tls.crt
 			secret.Data[cmapi.CertificateOutputFormatCombinedPEMKey] = certificates.OutputFormatCombinedPEM(data.PrivateKey, data.Certificate)
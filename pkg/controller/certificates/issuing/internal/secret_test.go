@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/pem"
 	"errors"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -28,9 +29,13 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	apitypes "k8s.io/apimachinery/pkg/types"
 	applycorev1 "k8s.io/client-go/applyconfigurations/core/v1"
 	applymetav1 "k8s.io/client-go/applyconfigurations/meta/v1"
+	coreclient "k8s.io/client-go/kubernetes/typed/core/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/record"
 	fakeclock "k8s.io/utils/clock/testing"
 	"k8s.io/utils/ptr"
 
@@ -89,6 +94,8 @@ func Test_SecretsManager(t *testing.T) {
 	)
 	block, _ := pem.Decode(baseCertBundle.PrivateKeyBytes)
 	tlsDerContent := block.Bytes
+	// The DER encoded leaf certificate is the raw form of the PEM certificate
+	certDerContent := baseCertBundle.Cert.Raw
 
 	tests := map[string]struct {
 		certificateOptions controllerpkg.CertificateOptions
@@ -98,7 +105,8 @@ func Test_SecretsManager(t *testing.T) {
 		secretData SecretData
 		applyFn    func(t *testing.T) testcoreclients.ApplyFn
 
-		expectedErr bool
+		expectedErr    bool
+		expectedEvents []string
 	}{
 		"if secret does not exists and unable to decode certificate, then error": {
 			certificateOptions: controllerpkg.CertificateOptions{EnableOwnerRef: false},
@@ -345,6 +353,106 @@ func Test_SecretsManager(t *testing.T) {
 			expectedErr: false,
 		},
 
+		"if secretTemplate values are templates, render them using the issued certificate": {
+			certificateOptions: controllerpkg.CertificateOptions{EnableOwnerRef: false},
+			certificate: gen.CertificateFrom(baseCertBundle.Certificate,
+				gen.SetCertificateSecretTemplate(map[string]string{
+					"example.com/expiry": "{{ rfc3339 .NotAfter }}",
+					"example.com/serial": "{{ .SerialNumber }}",
+					"example.com/issuer": "{{ .Issuer }}",
+				}, map[string]string{
+					"example.com/expiry": "{{ unix .NotAfter }}",
+					"static":             "label",
+				}),
+				gen.SetCertificateSecretTemplateRenderTemplates(true),
+			),
+			existingSecret: nil,
+			secretData: SecretData{
+				Certificate: baseCertBundle.CertBytes, CA: []byte("test-ca"), PrivateKey: []byte("test-key"),
+				CertificateName: "test", IssuerName: "ca-issuer", IssuerKind: "Issuer", IssuerGroup: "foo.io",
+			},
+			applyFn: func(t *testing.T) testcoreclients.ApplyFn {
+				return func(_ context.Context, gotCnf *applycorev1.SecretApplyConfiguration, gotOpts metav1.ApplyOptions) (*corev1.Secret, error) {
+					assert.Equal(t, baseCertBundle.Cert.NotAfter.UTC().Format(time.RFC3339), gotCnf.Annotations["example.com/expiry"])
+					assert.Equal(t, baseCertBundle.Cert.SerialNumber.Text(16), gotCnf.Annotations["example.com/serial"])
+					assert.Equal(t, baseCertBundle.Cert.Issuer.String(), gotCnf.Annotations["example.com/issuer"])
+					assert.Equal(t, map[string]string{
+						"example.com/expiry": strconv.FormatInt(baseCertBundle.Cert.NotAfter.Unix(), 10),
+						"static":             "label",
+						cmapi.PartOfCertManagerControllerLabelKey: "true",
+					}, gotCnf.Labels)
+					return nil, nil
+				}
+			},
+			expectedErr: false,
+		},
+		"if a templated secretTemplate label does not render to a valid label value, do not set it and record an event": {
+			certificateOptions: controllerpkg.CertificateOptions{EnableOwnerRef: false},
+			certificate: gen.CertificateFrom(baseCertBundle.Certificate,
+				gen.SetCertificateSecretTemplate(nil, map[string]string{
+					"example.com/expiry": "{{ .NotAfter }}",
+					"static":             "label",
+				}),
+				gen.SetCertificateSecretTemplateRenderTemplates(true),
+			),
+			existingSecret: nil,
+			secretData: SecretData{
+				Certificate: baseCertBundle.CertBytes, CA: []byte("test-ca"), PrivateKey: []byte("test-key"),
+				CertificateName: "test", IssuerName: "ca-issuer", IssuerKind: "Issuer", IssuerGroup: "foo.io",
+			},
+			applyFn: func(t *testing.T) testcoreclients.ApplyFn {
+				return func(_ context.Context, gotCnf *applycorev1.SecretApplyConfiguration, gotOpts metav1.ApplyOptions) (*corev1.Secret, error) {
+					assert.Equal(t, map[string]string{
+						"static": "label",
+						cmapi.PartOfCertManagerControllerLabelKey: "true",
+					}, gotCnf.Labels)
+					return nil, nil
+				}
+			},
+			expectedErr: false,
+			expectedEvents: []string{
+				`Warning SecretTemplateLabelInvalid Not setting label "example.com/expiry" of the secretTemplate on Secret "output": ` +
+					`rendered value "` + baseCertBundle.Cert.NotAfter.String() + `" is not a valid label value`,
+			},
+		},
+		"if secretTemplate does not set renderTemplates, copy template-like values as is": {
+			certificateOptions: controllerpkg.CertificateOptions{EnableOwnerRef: false},
+			certificate: gen.CertificateFrom(baseCertBundle.Certificate,
+				gen.SetCertificateSecretTemplate(map[string]string{"example.com/values": "{{ .Unknown }}"}, nil),
+			),
+			existingSecret: nil,
+			secretData: SecretData{
+				Certificate: baseCertBundle.CertBytes, CA: []byte("test-ca"), PrivateKey: []byte("test-key"),
+				CertificateName: "test", IssuerName: "ca-issuer", IssuerKind: "Issuer", IssuerGroup: "foo.io",
+			},
+			applyFn: func(t *testing.T) testcoreclients.ApplyFn {
+				return func(_ context.Context, gotCnf *applycorev1.SecretApplyConfiguration, gotOpts metav1.ApplyOptions) (*corev1.Secret, error) {
+					assert.Equal(t, "{{ .Unknown }}", gotCnf.Annotations["example.com/values"])
+					return nil, nil
+				}
+			},
+			expectedErr: false,
+		},
+		"if a secretTemplate value fails to render, expect error response": {
+			certificateOptions: controllerpkg.CertificateOptions{EnableOwnerRef: false},
+			certificate: gen.CertificateFrom(baseCertBundle.Certificate,
+				gen.SetCertificateSecretTemplate(map[string]string{"example.com/bad": "{{ .Unknown }}"}, nil),
+				gen.SetCertificateSecretTemplateRenderTemplates(true),
+			),
+			existingSecret: nil,
+			secretData: SecretData{
+				Certificate: baseCertBundle.CertBytes, CA: []byte("test-ca"), PrivateKey: []byte("test-key"),
+				CertificateName: "test", IssuerName: "ca-issuer", IssuerKind: "Issuer", IssuerGroup: "foo.io",
+			},
+			applyFn: func(t *testing.T) testcoreclients.ApplyFn {
+				return func(_ context.Context, gotCnf *applycorev1.SecretApplyConfiguration, gotOpts metav1.ApplyOptions) (*corev1.Secret, error) {
+					t.Error("unexpected apply call")
+					return nil, nil
+				}
+			},
+			expectedErr: true,
+		},
+
 		"if secret does exist, ensure that any missing base labels and annotations are added": {
 			certificateOptions: controllerpkg.CertificateOptions{EnableOwnerRef: false},
 			certificate:        baseCertWithSecretTemplate,
@@ -465,10 +573,11 @@ func Test_SecretsManager(t *testing.T) {
 							}).
 						WithLabels(map[string]string{cmapi.PartOfCertManagerControllerLabelKey: "true"}).
 						WithData(map[string][]byte{
-							corev1.TLSCertKey:                   baseCertBundle.CertBytes,
-							corev1.TLSPrivateKeyKey:             baseCertBundle.PrivateKeyBytes,
-							cmmeta.TLSCAKey:                     []byte("test-ca"),
-							cmapi.CertificateOutputFormatDERKey: tlsDerContent,
+							corev1.TLSCertKey:                              baseCertBundle.CertBytes,
+							corev1.TLSPrivateKeyKey:                        baseCertBundle.PrivateKeyBytes,
+							cmmeta.TLSCAKey:                                []byte("test-ca"),
+							cmapi.CertificateOutputFormatDERKey:            tlsDerContent,
+							cmapi.CertificateOutputFormatDERCertificateKey: certDerContent,
 						}).
 						WithType(corev1.SecretTypeTLS)
 					assert.Equal(t, expCnf, gotCnf)
@@ -545,11 +654,12 @@ func Test_SecretsManager(t *testing.T) {
 							}).
 						WithLabels(map[string]string{cmapi.PartOfCertManagerControllerLabelKey: "true"}).
 						WithData(map[string][]byte{
-							corev1.TLSCertKey:                           baseCertBundle.CertBytes,
-							corev1.TLSPrivateKeyKey:                     baseCertBundle.PrivateKeyBytes,
-							cmmeta.TLSCAKey:                             []byte("test-ca"),
-							cmapi.CertificateOutputFormatDERKey:         tlsDerContent,
-							cmapi.CertificateOutputFormatCombinedPEMKey: []byte(strings.Join([]string{string(baseCertBundle.PrivateKeyBytes), string(baseCertBundle.CertBytes)}, "\n")),
+							corev1.TLSCertKey:                              baseCertBundle.CertBytes,
+							corev1.TLSPrivateKeyKey:                        baseCertBundle.PrivateKeyBytes,
+							cmmeta.TLSCAKey:                                []byte("test-ca"),
+							cmapi.CertificateOutputFormatDERKey:            tlsDerContent,
+							cmapi.CertificateOutputFormatDERCertificateKey: certDerContent,
+							cmapi.CertificateOutputFormatCombinedPEMKey:    []byte(strings.Join([]string{string(baseCertBundle.PrivateKeyBytes), string(baseCertBundle.CertBytes)}, "\n")),
 						}).
 						WithType(corev1.SecretTypeTLS)
 					assert.Equal(t, expCnf, gotCnf)
@@ -665,10 +775,11 @@ func Test_SecretsManager(t *testing.T) {
 							}).
 						WithLabels(map[string]string{cmapi.PartOfCertManagerControllerLabelKey: "true"}).
 						WithData(map[string][]byte{
-							corev1.TLSCertKey:                   baseCertBundle.CertBytes,
-							corev1.TLSPrivateKeyKey:             baseCertBundle.PrivateKeyBytes,
-							cmmeta.TLSCAKey:                     []byte("test-ca"),
-							cmapi.CertificateOutputFormatDERKey: tlsDerContent,
+							corev1.TLSCertKey:                              baseCertBundle.CertBytes,
+							corev1.TLSPrivateKeyKey:                        baseCertBundle.PrivateKeyBytes,
+							cmmeta.TLSCAKey:                                []byte("test-ca"),
+							cmapi.CertificateOutputFormatDERKey:            tlsDerContent,
+							cmapi.CertificateOutputFormatDERCertificateKey: certDerContent,
 						}).
 						WithType(corev1.SecretTypeOpaque)
 					assert.Equal(t, expCnf, gotCnf)
@@ -741,6 +852,59 @@ func Test_SecretsManager(t *testing.T) {
 			},
 			expectedErr: false,
 		},
+		"if secret does exist with keys preserved by a third party, do not apply the preserved keys on renewal": {
+			certificateOptions: controllerpkg.CertificateOptions{EnableOwnerRef: false},
+			certificate:        baseCertBundle.Certificate,
+			existingSecret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   gen.DefaultTestNamespace,
+					Name:        "output",
+					Annotations: map[string]string{cmapi.PreserveSecretKeysAnnotationKey: "ca.crt,mesh.pem"},
+				},
+				Data: map[string][]byte{
+					corev1.TLSCertKey: []byte("foo"), corev1.TLSPrivateKeyKey: []byte("foo"),
+					cmmeta.TLSCAKey: []byte("mesh-ca"), "mesh.pem": []byte("mesh"),
+				},
+				Type: corev1.SecretTypeTLS,
+			},
+			secretData: SecretData{
+				Certificate: baseCertBundle.CertBytes, CA: []byte("test-ca"), PrivateKey: []byte("test-key"),
+				CertificateName: "test", IssuerName: "ca-issuer", IssuerKind: "Issuer", IssuerGroup: "foo.io",
+			},
+			applyFn: func(t *testing.T) testcoreclients.ApplyFn {
+				return func(_ context.Context, gotCnf *applycorev1.SecretApplyConfiguration, gotOpts metav1.ApplyOptions) (*corev1.Secret, error) {
+					assert.Equal(t, map[string][]byte{
+						corev1.TLSCertKey:       baseCertBundle.CertBytes,
+						corev1.TLSPrivateKeyKey: []byte("test-key"),
+					}, gotCnf.Data)
+					return nil, nil
+				}
+			},
+			expectedErr: false,
+		},
+		"if certificate lists preserved keys, do not apply the preserved additional output format keys": {
+			certificateOptions: controllerpkg.CertificateOptions{EnableOwnerRef: false},
+			certificate: gen.CertificateFrom(baseCertWithAdditionalOutputFormatDER,
+				gen.AddCertificateAnnotations(map[string]string{cmapi.PreserveSecretKeysAnnotationKey: "key.der, tls.crt"}),
+			),
+			existingSecret: nil,
+			secretData: SecretData{
+				Certificate: baseCertBundle.CertBytes, CA: []byte("test-ca"), PrivateKey: baseCertBundle.PrivateKeyBytes,
+				CertificateName: "test", IssuerName: "ca-issuer", IssuerKind: "Issuer", IssuerGroup: "foo.io",
+			},
+			applyFn: func(t *testing.T) testcoreclients.ApplyFn {
+				return func(_ context.Context, gotCnf *applycorev1.SecretApplyConfiguration, gotOpts metav1.ApplyOptions) (*corev1.Secret, error) {
+					assert.Equal(t, map[string][]byte{
+						corev1.TLSCertKey:       baseCertBundle.CertBytes,
+						corev1.TLSPrivateKeyKey: baseCertBundle.PrivateKeyBytes,
+						cmmeta.TLSCAKey:         []byte("test-ca"),
+						cmapi.CertificateOutputFormatDERCertificateKey: certDerContent,
+					}, gotCnf.Data)
+					return nil, nil
+				}
+			},
+			expectedErr: false,
+		},
 		"if apply errors, expect error response": {
 			certificateOptions: controllerpkg.CertificateOptions{EnableOwnerRef: true},
 			certificate:        baseCertWithSecretTemplate,
@@ -772,8 +936,9 @@ func Test_SecretsManager(t *testing.T) {
 			}
 			secretLister := testcorelisters.NewFakeSecretLister(mod)
 
+			recorder := record.NewFakeRecorder(10)
 			testManager := NewSecretsManager(
-				secretClient, secretLister,
+				secretClient, secretLister, recorder,
 				"cert-manager-test",
 				test.certificateOptions.EnableOwnerRef,
 			)
@@ -785,6 +950,18 @@ func Test_SecretsManager(t *testing.T) {
 			if err == nil && test.expectedErr {
 				t.Errorf("expected to get an error but did not get one")
 			}
+
+			close(recorder.Events)
+			var gotEvents []string
+			for e := range recorder.Events {
+				gotEvents = append(gotEvents, e)
+			}
+			if !assert.Len(t, gotEvents, len(test.expectedEvents)) {
+				return
+			}
+			for i, e := range test.expectedEvents {
+				assert.True(t, strings.HasPrefix(gotEvents[i], e), "expected event %q to start with %q", gotEvents[i], e)
+			}
 		})
 	}
 }
@@ -872,3 +1049,144 @@ func Test_getCertificateSecret(t *testing.T) {
 		})
 	}
 }
+
+// fakeSecretInterface records the Secrets which were applied or updated.
+type fakeSecretInterface struct {
+	coreclient.SecretInterface
+	applied map[string]*applycorev1.SecretApplyConfiguration
+	updated map[string]*corev1.Secret
+}
+
+func (f *fakeSecretInterface) Secrets(string) coreclient.SecretInterface {
+	return f
+}
+
+func (f *fakeSecretInterface) Apply(_ context.Context, cnf *applycorev1.SecretApplyConfiguration, _ metav1.ApplyOptions) (*corev1.Secret, error) {
+	f.applied[*cnf.Name] = cnf
+	return nil, nil
+}
+
+func (f *fakeSecretInterface) Update(_ context.Context, secret *corev1.Secret, _ metav1.UpdateOptions) (*corev1.Secret, error) {
+	f.updated[secret.Name] = secret
+	return secret, nil
+}
+
+func Test_SecretsManager_AdditionalOutputSecrets(t *testing.T) {
+	crt := gen.Certificate("test",
+		gen.SetCertificateNamespace("default"),
+		gen.SetCertificateIssuer(cmmeta.ObjectReference{Name: "ca-issuer", Kind: "Issuer", Group: "foo.io"}),
+		gen.SetCertificateSecretName("output"),
+		gen.SetCertificateDNSNames("example.com"),
+		gen.SetCertificateUID(apitypes.UID("test-uid")),
+		gen.SetCertificateAdditionalOutputSecrets(
+			cmapi.CertificateAdditionalOutputSecret{Name: "copy-1"},
+			cmapi.CertificateAdditionalOutputSecret{Name: "copy-2"},
+			cmapi.CertificateAdditionalOutputSecret{Name: "output"},
+			cmapi.CertificateAdditionalOutputSecret{Name: "user"},
+			cmapi.CertificateAdditionalOutputSecret{Name: "other"},
+		),
+	)
+	bundle := testcrypto.MustCreateCryptoBundle(t, crt, fixedClock)
+
+	existingCopySecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "default",
+			Name:        "copy-2",
+			Annotations: map[string]string{cmapi.CertificateNameKey: "test"},
+			Labels:      map[string]string{cmapi.IsAdditionalOutputSecretLabelKey: "true"},
+		},
+		Type: corev1.SecretTypeTLS,
+	}
+	userSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "user",
+		},
+		Data: map[string][]byte{"password": []byte("secret")},
+	}
+
+	orphanedSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "default",
+			Name:        "removed",
+			Annotations: map[string]string{cmapi.CertificateNameKey: "test"},
+			Labels: map[string]string{
+				cmapi.IsAdditionalOutputSecretLabelKey: "true",
+				"other":                                "label",
+			},
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(crt, cmapi.SchemeGroupVersion.WithKind("Certificate"))},
+		},
+		Data: map[string][]byte{corev1.TLSCertKey: bundle.CertBytes},
+	}
+	otherCertificateSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "default",
+			Name:        "other",
+			Annotations: map[string]string{cmapi.CertificateNameKey: "other"},
+			Labels:      map[string]string{cmapi.IsAdditionalOutputSecretLabelKey: "true"},
+		},
+	}
+
+	secretClient := &fakeSecretInterface{
+		applied: make(map[string]*applycorev1.SecretApplyConfiguration),
+		updated: make(map[string]*corev1.Secret),
+	}
+	secretLister := testcorelisters.NewFakeSecretLister(testcorelisters.SetFakeSecretListerSecret(func(string) corelisters.SecretNamespaceLister {
+		return testcorelisters.NewFakeSecretNamespaceLister(func(f *testcorelisters.FakeSecretNamespaceLister) {
+			f.GetFn = func(name string) (*corev1.Secret, error) {
+				for _, secret := range []*corev1.Secret{existingCopySecret, userSecret, otherCertificateSecret} {
+					if secret.Name == name {
+						return secret, nil
+					}
+				}
+				return nil, apierrors.NewNotFound(corev1.Resource("secret"), name)
+			}
+			f.ListFn = func(labels.Selector) ([]*corev1.Secret, error) {
+				return []*corev1.Secret{orphanedSecret, existingCopySecret, otherCertificateSecret}, nil
+			}
+		})
+	}))
+	recorder := record.NewFakeRecorder(10)
+
+	testManager := NewSecretsManager(secretClient, secretLister, recorder, "cert-manager-test", true)
+
+	err := testManager.UpdateData(context.Background(), crt, SecretData{
+		Certificate: bundle.CertBytes, CA: []byte("test-ca"), PrivateKey: bundle.PrivateKeyBytes,
+		CertificateName: "test", IssuerName: "ca-issuer", IssuerKind: "Issuer", IssuerGroup: "foo.io",
+	})
+	assert.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{"output", "copy-1", "copy-2"}, keys(secretClient.applied))
+	for _, name := range []string{"copy-1", "copy-2"} {
+		cnf := secretClient.applied[name]
+		assert.Equal(t, secretClient.applied["output"].Data, cnf.Data, "unexpected data in %s", name)
+		assert.Equal(t, "test", cnf.Annotations[cmapi.CertificateNameKey])
+		assert.Equal(t, "true", cnf.Labels[cmapi.IsAdditionalOutputSecretLabelKey])
+		assert.Len(t, cnf.OwnerReferences, 1)
+	}
+
+	close(recorder.Events)
+	var events []string
+	for event := range recorder.Events {
+		events = append(events, event)
+	}
+	assert.Equal(t, []string{
+		`Warning AdditionalOutputSecretConflict Not writing additional output Secret "user" as a Secret with the same name which is not an additional output Secret of this Certificate already exists`,
+		`Warning AdditionalOutputSecretConflict Not writing additional output Secret "other" as a Secret with the same name which is not an additional output Secret of this Certificate already exists`,
+	}, events, "Secrets which are not additional output Secrets of the Certificate must not be written")
+
+	assert.ElementsMatch(t, []string{"removed"}, keys(secretClient.updated))
+	released := secretClient.updated["removed"]
+	assert.Equal(t, map[string]string{"other": "label"}, released.Labels)
+	assert.Empty(t, released.OwnerReferences)
+	assert.Equal(t, orphanedSecret.Data, released.Data)
+	assert.Equal(t, "true", orphanedSecret.Labels[cmapi.IsAdditionalOutputSecretLabelKey], "lister object must not be mutated")
+}
+
+func keys[T any](m map[string]T) []string {
+	var out []string
+	for k := range m {
+		out = append(out, k)
+	}
+	return out
+}
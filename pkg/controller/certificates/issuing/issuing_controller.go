@@ -19,6 +19,7 @@ package issuing
 import (
 	"context"
 	"crypto"
+	"crypto/x509"
 	"fmt"
 	"time"
 
@@ -54,6 +55,14 @@ import (
 
 const (
 	ControllerName = "certificates-issuing"
+
+	// reasonIncompleteChain is the reason set on the Issuing condition when
+	// the certificate chain returned by an issuer does not build to a root.
+	reasonIncompleteChain = "IncompleteChain"
+
+	// reasonChainOrderFailed is the reason set on the Issuing condition when
+	// the certificate chain cannot be assembled in the requested chainOrder.
+	reasonChainOrderFailed = "ChainOrderFailed"
 )
 
 type localTemporarySignerFn func(crt *cmapi.Certificate, pk []byte) ([]byte, error)
@@ -86,6 +95,15 @@ type controller struct {
 
 	// localTemporarySigner signs a certificate that is stored temporarily
 	localTemporarySigner localTemporarySignerFn
+
+	// verifyChain is true if the certificate chain of a CertificateRequest
+	// must be complete before it is stored in the Certificate's Secret.
+	verifyChain bool
+
+	// chainRoots are the trusted roots that an issued certificate chain may
+	// end at, in addition to the CA returned by the issuer. It is nil if the
+	// system trust store could not be loaded.
+	chainRoots *x509.CertPool
 }
 
 func NewController(
@@ -116,6 +134,18 @@ func NewController(
 		WorkFunc: certificates.EnqueueCertificatesForResourceUsingPredicates(log, queue, certificateInformer.Lister(), labels.Everything(),
 			predicate.ExtractResourceName(predicate.CertificateSecretName)),
 	})
+	secretsInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+		// Issuer reconciles on changes to the Secrets named in `spec.additionalOutputSecrets`
+		WorkFunc: certificates.EnqueueCertificatesForResourceUsingPredicates(log, queue, certificateInformer.Lister(), labels.Everything(),
+			predicate.ExtractResourceName(predicate.CertificateAdditionalOutputSecretName)),
+	})
+
+	secretsInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+		// Issuer reconciles on changes to keystore password Secrets, so that
+		// keystores can be re-encoded when their password is rotated
+		WorkFunc: certificates.EnqueueCertificatesForResourceUsingPredicates(log, queue, certificateInformer.Lister(), labels.Everything(),
+			predicate.ExtractResourceName(predicate.CertificateKeystorePasswordSecretName)),
+	})
 
 	// build a list of InformerSynced functions that will be returned by the Register method.
 	// the controller will only begin processing items once all of these informers have synced.
@@ -126,10 +156,19 @@ func NewController(
 	}
 
 	secretsManager := internal.NewSecretsManager(
-		ctx.Client.CoreV1(), secretsInformer.Lister(),
+		ctx.Client.CoreV1(), secretsInformer.Lister(), ctx.Recorder,
 		ctx.FieldManager, ctx.CertificateOptions.EnableOwnerRef,
 	)
 
+	var chainRoots *x509.CertPool
+	if ctx.CertificateOptions.EnableChainVerification {
+		var err error
+		chainRoots, err = x509.SystemCertPool()
+		if err != nil {
+			log.Error(err, "failed to load the system trust store, certificate chains must end at a self-signed certificate or the issuer's CA")
+		}
+	}
+
 	return &controller{
 		certificateLister:        certificateInformer.Lister(),
 		certificateRequestLister: certificateRequestInformer.Lister(),
@@ -144,6 +183,8 @@ func NewController(
 		),
 		fieldManager:         ctx.FieldManager,
 		localTemporarySigner: pki.GenerateLocallySignedTemporaryCertificate,
+		verifyChain:          ctx.CertificateOptions.EnableChainVerification,
+		chainRoots:           chainRoots,
 	}, queue, mustSync
 }
 
@@ -172,6 +213,11 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 	log = logf.WithResource(log, crt)
 	ctx = logf.NewContext(ctx, log)
 
+	if certificates.IsPaused(crt) {
+		log.V(logf.DebugLevel).Info("Certificate is paused, skipping")
+		return nil
+	}
+
 	if !apiutil.CertificateHasCondition(crt, cmapi.CertificateCondition{
 		Type:   cmapi.CertificateConditionIssuing,
 		Status: cmmeta.ConditionTrue,
@@ -242,7 +288,7 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 
 	// Verify the CSR options match what is requested in certificate.spec.
 	// If there are violations in the spec, then the requestmanager will handle this.
-	requestViolations, err := pki.RequestMatchesSpec(req, crt.Spec)
+	requestViolations, err := pki.RequestMatchesSpec(req, pki.SpecForCertificate(crt))
 	if err != nil {
 		return err
 	}
@@ -328,6 +374,19 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 	// If the CertificateRequest is valid and ready, verify its status and issue
 	// accordingly.
 	if crReadyCond.Reason == cmapi.CertificateRequestReasonIssued {
+		// If chain verification is enabled and the issuer returned an
+		// incomplete chain, fail issuance rather than storing a chain which
+		// clients cannot verify.
+		if c.verifyChain {
+			if err := c.verifyCertificateChain(req); err != nil {
+				log.V(logf.DebugLevel).Info("CertificateRequest has an incomplete certificate chain", "error", err.Error())
+				return c.failIssueCertificate(ctx, log, crt, &cmapi.CertificateRequestCondition{
+					Reason:  reasonIncompleteChain,
+					Message: fmt.Sprintf("The certificate chain returned by the issuer is incomplete: %s", err),
+				})
+			}
+		}
+
 		return c.issueCertificate(ctx, nextRevision, crt, req, pk)
 	}
 
@@ -376,10 +435,43 @@ func (c *controller) failIssueCertificate(ctx context.Context, log logr.Logger,
 	return nil
 }
 
+// verifyCertificateChain returns an error if the signed certificate chain of
+// the CertificateRequest does not build to a self-signed certificate, the CA
+// returned by the issuer or a trusted root.
+func (c *controller) verifyCertificateChain(req *cmapi.CertificateRequest) error {
+	chain, err := utilpki.DecodeX509CertificateChainBytes(req.Status.Certificate)
+	if err != nil {
+		return err
+	}
+
+	var cas []*x509.Certificate
+	if len(req.Status.CA) > 0 {
+		cas, err = utilpki.DecodeX509CertificateSetBytes(req.Status.CA)
+		if err != nil {
+			return err
+		}
+	}
+
+	return utilpki.VerifyCertificateChainComplete(chain, cas, c.chainRoots)
+}
+
 // issueCertificate will ensure the public key of the CSR matches the signed
 // certificate, and then store the certificate, CA and private key into the
 // Secret in the appropriate format type.
 func (c *controller) issueCertificate(ctx context.Context, nextRevision int, crt *cmapi.Certificate, req *cmapi.CertificateRequest, pk crypto.Signer) error {
+	// Assemble the chain written to tls.crt in the order requested by the
+	// Certificate. If the chain cannot be assembled, fail issuance so that it
+	// is retried.
+	certData, err := certificates.AssembleCertificateChain(crt.Spec.ChainOrder, req.Status.Certificate, req.Status.CA)
+	if err != nil {
+		log := logf.FromContext(ctx)
+		log.V(logf.DebugLevel).Info("failed to assemble certificate chain", "chain_order", crt.Spec.ChainOrder, "error", err.Error())
+		return c.failIssueCertificate(ctx, log, crt, &cmapi.CertificateRequestCondition{
+			Reason:  reasonChainOrderFailed,
+			Message: fmt.Sprintf("Failed to assemble the certificate chain in %q order: %s", crt.Spec.ChainOrder, err),
+		})
+	}
+
 	crt = crt.DeepCopy()
 	if crt.Spec.PrivateKey == nil {
 		crt.Spec.PrivateKey = &cmapi.CertificatePrivateKey{}
@@ -389,9 +481,10 @@ func (c *controller) issueCertificate(ctx context.Context, nextRevision int, crt
 	if err != nil {
 		return err
 	}
+
 	secretData := internal.SecretData{
 		PrivateKey:      pkData,
-		Certificate:     req.Status.Certificate,
+		Certificate:     certData,
 		CA:              req.Status.CA,
 		CertificateName: crt.Name,
 		IssuerName:      req.Spec.IssuerRef.Name,
@@ -399,6 +492,10 @@ func (c *controller) issueCertificate(ctx context.Context, nextRevision int, crt
 		IssuerGroup:     req.Spec.IssuerRef.Group,
 	}
 
+	// Determine whether the private key is being rotated before the Secret is
+	// updated with the new key.
+	keyRotated := c.privateKeyRotated(crt, pk)
+
 	if err := c.secretsUpdateData(ctx, crt, secretData); err != nil {
 		return err
 	}
@@ -406,6 +503,14 @@ func (c *controller) issueCertificate(ctx context.Context, nextRevision int, crt
 	// Set status.revision to revision of the CertificateRequest
 	crt.Status.Revision = &nextRevision
 
+	// Record when the private key was last rotated so that the next scheduled
+	// rotation can be computed
+	if crt.Spec.PrivateKey.RotationPolicy == cmapi.RotationPolicyScheduled &&
+		(keyRotated || crt.Status.LastPrivateKeyRotationTime == nil) {
+		rotationTime := metav1.NewTime(c.clock.Now())
+		crt.Status.LastPrivateKeyRotationTime = &rotationTime
+	}
+
 	// Remove Issuing status condition
 	// TODO @joshvanl: Once we move to only server-side apply API calls, this
 	// should be changed to setting the Issuing condition to False.
@@ -453,9 +558,10 @@ func (c *controller) updateOrApplyStatus(ctx context.Context, crt *cmapi.Certifi
 		return internalcertificates.ApplyStatus(ctx, c.client, c.fieldManager, &cmapi.Certificate{
 			ObjectMeta: metav1.ObjectMeta{Namespace: crt.Namespace, Name: crt.Name},
 			Status: cmapi.CertificateStatus{
-				Revision:        crt.Status.Revision,
-				LastFailureTime: crt.Status.LastFailureTime,
-				Conditions:      conditions,
+				Revision:                   crt.Status.Revision,
+				LastFailureTime:            crt.Status.LastFailureTime,
+				LastPrivateKeyRotationTime: crt.Status.LastPrivateKeyRotationTime,
+				Conditions:                 conditions,
 			},
 		})
 	} else {
@@ -464,6 +570,24 @@ func (c *controller) updateOrApplyStatus(ctx context.Context, crt *cmapi.Certifi
 	}
 }
 
+// privateKeyRotated returns true if the given private key differs from the
+// one currently stored in the Certificate's Secret, or if there is no private
+// key stored yet.
+func (c *controller) privateKeyRotated(crt *cmapi.Certificate, pk crypto.Signer) bool {
+	secret, err := c.secretLister.Secrets(crt.Namespace).Get(crt.Spec.SecretName)
+	if err != nil {
+		return true
+	}
+
+	existing, err := utilpki.DecodePrivateKeyBytes(secret.Data[corev1.TLSPrivateKeyKey])
+	if err != nil {
+		return true
+	}
+
+	equal, err := utilpki.PublicKeysEqual(existing.Public(), pk.Public())
+	return err != nil || !equal
+}
+
 // controllerWrapper wraps the `controller` structure to make it implement
 // the controllerpkg.queueingController interface
 type controllerWrapper struct {
@@ -18,7 +18,11 @@ package issuing
 
 import (
 	"context"
+	"crypto"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"fmt"
+	"math/big"
 	"testing"
 	"time"
 
@@ -36,6 +40,7 @@ import (
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates/issuing/internal"
 	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	utilpki "github.com/cert-manager/cert-manager/pkg/util/pki"
 	testcrypto "github.com/cert-manager/cert-manager/test/unit/crypto"
 	"github.com/cert-manager/cert-manager/test/unit/gen"
 )
@@ -58,6 +63,10 @@ func TestIssuingController(t *testing.T) {
 		certificate             *cmapi.Certificate
 		expSecretUpdateDataCall *internal.SecretData
 
+		// enableChainVerification enables verifying that certificate chains
+		// are complete before they are stored.
+		enableChainVerification bool
+
 		expectedErr bool
 	}
 
@@ -77,6 +86,30 @@ func TestIssuingController(t *testing.T) {
 	exampleBundleAlt := testcrypto.MustCreateCryptoBundle(t, baseCert.DeepCopy(), fixedClock)
 	metaFixedClockStart := metav1.NewTime(fixedClockStart)
 
+	// A certificate chain issued by an intermediate of a private root, which
+	// is used to test verification of the issued chain.
+	chainRoot, chainRootPEM := mustCreateChainCertificate(t, nil, "root")
+	chainIntermediate, chainIntermediatePEM := mustCreateChainCertificate(t, chainRoot, "intermediate")
+	_, chainLeafPEM := mustCreateChainCertificate(t, chainIntermediate, "leaf")
+	readyRequestWithChain := func(chain, ca []byte) *cmapi.CertificateRequest {
+		return gen.CertificateRequestFrom(exampleBundle.CertificateRequestReady,
+			gen.AddCertificateRequestAnnotations(map[string]string{
+				cmapi.CertificateRequestRevisionAnnotationKey: "2", // Current Certificate revision=1
+			}),
+			gen.SetCertificateRequestCertificate(chain),
+			gen.SetCertificateRequestCA(ca),
+		)
+	}
+	nextPrivateKeySecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      nextPrivateKeySecretName,
+			Namespace: exampleBundle.Certificate.Namespace,
+		},
+		Data: map[string][]byte{
+			corev1.TLSPrivateKeyKey: exampleBundle.PrivateKeyBytes,
+		},
+	}
+
 	issuingCert := gen.CertificateFrom(baseCert.DeepCopy(),
 		gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
 			Type:               cmapi.CertificateConditionIssuing,
@@ -1369,6 +1402,296 @@ func TestIssuingController(t *testing.T) {
 			},
 			expectedErr: false,
 		},
+		"if chain verification is enabled and the CertificateRequest has a self-signed certificate, store the signed certificate and log an event": {
+			certificate:             exampleBundle.Certificate,
+			enableChainVerification: true,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{
+					gen.CertificateFrom(issuingCert),
+					readyRequestWithChain(exampleBundle.CertificateRequestReady.Status.Certificate, nil),
+				},
+				KubeObjects: []runtime.Object{nextPrivateKeySecret},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						exampleBundle.Certificate.Namespace,
+						gen.CertificateFrom(exampleBundle.Certificate,
+							gen.SetCertificateRevision(2),
+						),
+					)),
+				},
+				ExpectedEvents: []string{
+					"Normal Issuing The certificate has been successfully issued",
+				},
+			},
+			expSecretUpdateDataCall: &internal.SecretData{
+				Certificate:     exampleBundle.CertificateRequestReady.Status.Certificate,
+				PrivateKey:      exampleBundle.PrivateKeyBytes,
+				CertificateName: "test",
+				IssuerName:      "ca-issuer",
+				IssuerKind:      "Issuer",
+				IssuerGroup:     "foo.io",
+			},
+		},
+		"if chain verification is enabled and the CertificateRequest has a chain which builds to its CA, store the signed certificate and log an event": {
+			certificate:             exampleBundle.Certificate,
+			enableChainVerification: true,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{
+					gen.CertificateFrom(issuingCert),
+					readyRequestWithChain(append(append([]byte{}, chainLeafPEM...), chainIntermediatePEM...), chainRootPEM),
+				},
+				KubeObjects: []runtime.Object{nextPrivateKeySecret},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						exampleBundle.Certificate.Namespace,
+						gen.CertificateFrom(exampleBundle.Certificate,
+							gen.SetCertificateRevision(2),
+						),
+					)),
+				},
+				ExpectedEvents: []string{
+					"Normal Issuing The certificate has been successfully issued",
+				},
+			},
+			expSecretUpdateDataCall: &internal.SecretData{
+				Certificate:     append(append([]byte{}, chainLeafPEM...), chainIntermediatePEM...),
+				CA:              chainRootPEM,
+				PrivateKey:      exampleBundle.PrivateKeyBytes,
+				CertificateName: "test",
+				IssuerName:      "ca-issuer",
+				IssuerKind:      "Issuer",
+				IssuerGroup:     "foo.io",
+			},
+		},
+		"if chain verification is enabled and the CertificateRequest has a chain missing its intermediate, set failed state with the IncompleteChain reason and log event": {
+			certificate:             exampleBundle.Certificate,
+			enableChainVerification: true,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{
+					gen.CertificateFrom(issuingCert),
+					readyRequestWithChain(chainLeafPEM, chainRootPEM),
+				},
+				KubeObjects: []runtime.Object{nextPrivateKeySecret},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						exampleBundle.Certificate.Namespace,
+						gen.CertificateFrom(exampleBundle.Certificate,
+							gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+								Type:               cmapi.CertificateConditionIssuing,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             "IncompleteChain",
+								Message:            `The certificate request has failed to complete and will be retried: The certificate chain returned by the issuer is incomplete: certificate chain is incomplete: the issuer "CN=intermediate" of certificate "CN=leaf" is not present in the chain or the CA`,
+								LastTransitionTime: &metaFixedClockStart,
+								ObservedGeneration: 3,
+							}),
+							gen.SetCertificateLastFailureTime(metaFixedClockStart),
+							gen.SetCertificateIssuanceAttempts(ptr.To(1)),
+						),
+					)),
+				},
+				ExpectedEvents: []string{
+					`Warning IncompleteChain The certificate request has failed to complete and will be retried: The certificate chain returned by the issuer is incomplete: certificate chain is incomplete: the issuer "CN=intermediate" of certificate "CN=leaf" is not present in the chain or the CA`,
+				},
+			},
+		},
+		"if chain verification is disabled and the CertificateRequest has a chain missing its intermediate, store the signed certificate and log an event": {
+			certificate: exampleBundle.Certificate,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{
+					gen.CertificateFrom(issuingCert),
+					readyRequestWithChain(chainLeafPEM, chainRootPEM),
+				},
+				KubeObjects: []runtime.Object{nextPrivateKeySecret},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						exampleBundle.Certificate.Namespace,
+						gen.CertificateFrom(exampleBundle.Certificate,
+							gen.SetCertificateRevision(2),
+						),
+					)),
+				},
+				ExpectedEvents: []string{
+					"Normal Issuing The certificate has been successfully issued",
+				},
+			},
+			expSecretUpdateDataCall: &internal.SecretData{
+				Certificate:     chainLeafPEM,
+				CA:              chainRootPEM,
+				PrivateKey:      exampleBundle.PrivateKeyBytes,
+				CertificateName: "test",
+				IssuerName:      "ca-issuer",
+				IssuerKind:      "Issuer",
+				IssuerGroup:     "foo.io",
+			},
+		},
+		"if certificate is in Issuing state with chainOrder leaf-only, one CertificateRequests, and is ready, store only the leaf certificate and log an event": {
+			certificate: exampleBundle.Certificate,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{
+					gen.CertificateFrom(issuingCert, gen.SetCertificateChainOrder(cmapi.CertificateChainOrderLeafOnly)),
+					readyRequestWithChain(append(append([]byte{}, chainLeafPEM...), chainIntermediatePEM...), chainRootPEM),
+				},
+				KubeObjects: []runtime.Object{nextPrivateKeySecret},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						exampleBundle.Certificate.Namespace,
+						gen.CertificateFrom(exampleBundle.Certificate,
+							gen.SetCertificateChainOrder(cmapi.CertificateChainOrderLeafOnly),
+							gen.SetCertificateRevision(2),
+						),
+					)),
+				},
+				ExpectedEvents: []string{
+					"Normal Issuing The certificate has been successfully issued",
+				},
+			},
+			expSecretUpdateDataCall: &internal.SecretData{
+				Certificate:     chainLeafPEM,
+				CA:              chainRootPEM,
+				PrivateKey:      exampleBundle.PrivateKeyBytes,
+				CertificateName: "test",
+				IssuerName:      "ca-issuer",
+				IssuerKind:      "Issuer",
+				IssuerGroup:     "foo.io",
+			},
+		},
+		"if certificate is in Issuing state with a Scheduled rotation policy and the private key has been rotated, record the private key rotation time": {
+			certificate: exampleBundle.Certificate,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{
+					gen.CertificateFrom(issuingCert,
+						gen.SetCertificateKeyRotationPolicy(cmapi.RotationPolicyScheduled, &metav1.Duration{Duration: time.Hour * 24}),
+						gen.SetCertificateLastPrivateKeyRotationTime(metav1.NewTime(fixedClockStart.Add(-time.Hour*48))),
+					),
+					gen.CertificateRequestFrom(exampleBundle.CertificateRequestReady,
+						gen.AddCertificateRequestAnnotations(map[string]string{
+							cmapi.CertificateRequestRevisionAnnotationKey: "2", // Current Certificate revision=1
+						}),
+					),
+				},
+				KubeObjects: []runtime.Object{
+					nextPrivateKeySecret,
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{Namespace: exampleBundle.Certificate.Namespace, Name: "output"},
+						Data:       map[string][]byte{corev1.TLSPrivateKeyKey: exampleBundleAlt.PrivateKeyBytes},
+					},
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						exampleBundle.Certificate.Namespace,
+						gen.CertificateFrom(exampleBundle.Certificate,
+							gen.SetCertificateKeyRotationPolicy(cmapi.RotationPolicyScheduled, &metav1.Duration{Duration: time.Hour * 24}),
+							gen.SetCertificateRevision(2),
+							gen.SetCertificateLastPrivateKeyRotationTime(metaFixedClockStart),
+						),
+					)),
+				},
+				ExpectedEvents: []string{
+					"Normal Issuing The certificate has been successfully issued",
+				},
+			},
+			expSecretUpdateDataCall: &internal.SecretData{
+				Certificate:     exampleBundle.CertBytes,
+				CA:              exampleBundle.CertificateRequestReady.Status.CA,
+				PrivateKey:      exampleBundle.PrivateKeyBytes,
+				CertificateName: "test",
+				IssuerName:      "ca-issuer",
+				IssuerKind:      "Issuer",
+				IssuerGroup:     "foo.io",
+			},
+		},
+		"if certificate is in Issuing state with a Scheduled rotation policy and the private key has been reused, keep the private key rotation time": {
+			certificate: exampleBundle.Certificate,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{
+					gen.CertificateFrom(issuingCert,
+						gen.SetCertificateKeyRotationPolicy(cmapi.RotationPolicyScheduled, &metav1.Duration{Duration: time.Hour * 24}),
+						gen.SetCertificateLastPrivateKeyRotationTime(metav1.NewTime(fixedClockStart.Add(-time.Hour))),
+					),
+					gen.CertificateRequestFrom(exampleBundle.CertificateRequestReady,
+						gen.AddCertificateRequestAnnotations(map[string]string{
+							cmapi.CertificateRequestRevisionAnnotationKey: "2", // Current Certificate revision=1
+						}),
+					),
+				},
+				KubeObjects: []runtime.Object{
+					nextPrivateKeySecret,
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{Namespace: exampleBundle.Certificate.Namespace, Name: "output"},
+						Data:       map[string][]byte{corev1.TLSPrivateKeyKey: exampleBundle.PrivateKeyBytes},
+					},
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						exampleBundle.Certificate.Namespace,
+						gen.CertificateFrom(exampleBundle.Certificate,
+							gen.SetCertificateKeyRotationPolicy(cmapi.RotationPolicyScheduled, &metav1.Duration{Duration: time.Hour * 24}),
+							gen.SetCertificateRevision(2),
+							gen.SetCertificateLastPrivateKeyRotationTime(metav1.NewTime(fixedClockStart.Add(-time.Hour))),
+						),
+					)),
+				},
+				ExpectedEvents: []string{
+					"Normal Issuing The certificate has been successfully issued",
+				},
+			},
+			expSecretUpdateDataCall: &internal.SecretData{
+				Certificate:     exampleBundle.CertBytes,
+				CA:              exampleBundle.CertificateRequestReady.Status.CA,
+				PrivateKey:      exampleBundle.PrivateKeyBytes,
+				CertificateName: "test",
+				IssuerName:      "ca-issuer",
+				IssuerKind:      "Issuer",
+				IssuerGroup:     "foo.io",
+			},
+		},
+		"if certificate is in Issuing state with chainOrder full-chain-with-root, one CertificateRequests without the root, set failed state with the ChainOrderFailed reason and log event": {
+			certificate: exampleBundle.Certificate,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{
+					gen.CertificateFrom(issuingCert, gen.SetCertificateChainOrder(cmapi.CertificateChainOrderFullChainWithRoot)),
+					readyRequestWithChain(append(append([]byte{}, chainLeafPEM...), chainIntermediatePEM...), nil),
+				},
+				KubeObjects: []runtime.Object{nextPrivateKeySecret},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						exampleBundle.Certificate.Namespace,
+						gen.CertificateFrom(exampleBundle.Certificate,
+							gen.SetCertificateChainOrder(cmapi.CertificateChainOrderFullChainWithRoot),
+							gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+								Type:               cmapi.CertificateConditionIssuing,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             "ChainOrderFailed",
+								Message:            `The certificate request has failed to complete and will be retried: Failed to assemble the certificate chain in "full-chain-with-root" order: the root certificate "CN=root" which issued "CN=intermediate" is not present in the chain or the CA`,
+								LastTransitionTime: &metaFixedClockStart,
+								ObservedGeneration: 3,
+							}),
+							gen.SetCertificateLastFailureTime(metaFixedClockStart),
+							gen.SetCertificateIssuanceAttempts(ptr.To(1)),
+						),
+					)),
+				},
+				ExpectedEvents: []string{
+					`Warning ChainOrderFailed The certificate request has failed to complete and will be retried: Failed to assemble the certificate chain in "full-chain-with-root" order: the root certificate "CN=root" which issued "CN=intermediate" is not present in the chain or the CA`,
+				},
+			},
+		},
 	}
 
 	for name, test := range tests {
@@ -1378,6 +1701,7 @@ func TestIssuingController(t *testing.T) {
 			test.builder.T = t
 			test.builder.InitWithRESTConfig()
 			defer test.builder.Stop()
+			test.builder.Context.CertificateOptions.EnableChainVerification = test.enableChainVerification
 
 			w := controllerWrapper{}
 			_, _, err := w.Register(test.builder.Context)
@@ -1414,3 +1738,35 @@ func TestIssuingController(t *testing.T) {
 		})
 	}
 }
+
+// mustCreateChainCertificate returns a certificate and its PEM encoding which
+// is signed by the given issuer, or is self-signed if issuer is nil.
+func mustCreateChainCertificate(t *testing.T, issuer *chainCertificate, name string) (*chainCertificate, []byte) {
+	pk, err := utilpki.GenerateECPrivateKey(256)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		BasicConstraintsValid: true,
+		SerialNumber:          big.NewInt(1),
+		IsCA:                  true,
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             fixedClockStart,
+		NotAfter:              fixedClockStart.Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+
+	issuerCert, issuerKey := template, crypto.Signer(pk)
+	if issuer != nil {
+		issuerCert, issuerKey = issuer.cert, issuer.pk
+	}
+
+	certPEM, cert, err := utilpki.SignCertificate(template, issuerCert, pk.Public(), issuerKey)
+	require.NoError(t, err)
+
+	return &chainCertificate{cert: cert, pk: pk}, certPEM
+}
+
+type chainCertificate struct {
+	cert *x509.Certificate
+	pk   crypto.Signer
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package issuing
 
 import (
+	"bytes"
 	"context"
 	"errors"
 
@@ -100,7 +101,64 @@ func (c *controller) ensureSecretData(ctx context.Context, log logr.Logger, crt
 		}
 	}
 
+	// Re-encode the keystores from the existing certificate and private key if
+	// the keystore password has been rotated. This does not require the
+	// Certificate to be re-issued.
+	if message, mismatch := internal.KeystorePasswordsMismatch(c.secretLister, crt, secret); mismatch {
+		log.Info("applying Secret data", "message", message)
+		return c.secretsUpdateData(ctx, crt, data)
+	}
+
+	// Make sure the Certificate's additional output Secrets are in sync with
+	// its target Secret.
+	outOfSync, err := c.additionalOutputSecretsOutOfSync(crt, secret)
+	if err != nil {
+		return err
+	}
+	if outOfSync {
+		log.Info("applying Secret data to additional output Secrets")
+		return c.secretsUpdateData(ctx, crt, data)
+	}
+
 	// No Secret violations, nothing to do.
 
 	return nil
 }
+
+// additionalOutputSecretsOutOfSync returns true if any of the Certificate's
+// additional output Secrets do not exist or hold different certificate data
+// than the Certificate's target Secret, or if a Secret which is no longer
+// listed as an additional output Secret has not been released yet.
+// An existing Secret which is not an additional output Secret of the
+// Certificate is never written to, so it is not out of sync. The conflict is
+// reported by an event when the Certificate's Secrets are next written.
+func (c *controller) additionalOutputSecretsOutOfSync(crt *cmapi.Certificate, target *corev1.Secret) (bool, error) {
+	for _, additional := range crt.Spec.AdditionalOutputSecrets {
+		if additional.Name == crt.Spec.SecretName {
+			continue
+		}
+
+		secret, err := c.secretLister.Secrets(crt.Namespace).Get(additional.Name)
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+
+		if !internal.IsAdditionalOutputSecretOf(secret, crt) {
+			continue
+		}
+		for _, key := range []string{corev1.TLSCertKey, corev1.TLSPrivateKeyKey, cmmeta.TLSCAKey} {
+			if !bytes.Equal(secret.Data[key], target.Data[key]) {
+				return true, nil
+			}
+		}
+	}
+
+	orphaned, err := internal.OrphanedAdditionalOutputSecrets(c.secretLister, crt)
+	if err != nil {
+		return false, err
+	}
+	return len(orphaned) > 0, nil
+}
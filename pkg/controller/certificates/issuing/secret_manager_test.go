@@ -17,14 +17,20 @@ limitations under the License.
 package issuing
 
 import (
+	"bytes"
 	"context"
 	"encoding/pem"
 	"testing"
+	"time"
 
+	jks "github.com/pavlo-v-chernykh/keystore-go/v4"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
+	corelisters "k8s.io/client-go/listers/core/v1"
 	"k8s.io/utils/ptr"
 
 	"github.com/cert-manager/cert-manager/internal/controller/certificates/policies"
@@ -33,6 +39,7 @@ import (
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates/issuing/internal"
 	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
 	testcrypto "github.com/cert-manager/cert-manager/test/unit/crypto"
+	testlisters "github.com/cert-manager/cert-manager/test/unit/listers"
 )
 
 func Test_ensureSecretData(t *testing.T) {
@@ -42,8 +49,74 @@ func Test_ensureSecretData(t *testing.T) {
 	cert := testcrypto.MustCreateCert(t, pk, &cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "test"}})
 	block, _ := pem.Decode(pk)
 	pkDER := block.Bytes
+	certBlock, _ := pem.Decode(cert)
+	certDER := certBlock.Bytes
 	combinedPEM := append(append(pk, '\n'), cert...)
 
+	// jksCertificate and jksSecret build a Certificate and Secret which are
+	// otherwise up to date, with a JKS keystore encoded using the given
+	// password.
+	jksCertificate := &cmapi.Certificate{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test-name", UID: types.UID("uid-123")},
+		Spec: cmapi.CertificateSpec{
+			CommonName: "example.com",
+			IssuerRef: cmmeta.ObjectReference{
+				Name:  "testissuer",
+				Kind:  "IssuerKind",
+				Group: "group.example.com",
+			},
+			SecretName: "something",
+			Keystores: &cmapi.CertificateKeystores{
+				JKS: &cmapi.JKSKeystore{
+					Create:            true,
+					PasswordSecretRef: cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "keystore-password"}, Key: "password"},
+				},
+			},
+		}}
+	jksSecret := func(password string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "something", Namespace: "test-namespace",
+				Annotations: map[string]string{
+					cmapi.IssuerNameAnnotationKey:  "testissuer",
+					cmapi.IssuerKindAnnotationKey:  "IssuerKind",
+					cmapi.IssuerGroupAnnotationKey: "group.example.com",
+				},
+				Labels: map[string]string{cmapi.PartOfCertManagerControllerLabelKey: "true"},
+				OwnerReferences: []metav1.OwnerReference{
+					{APIVersion: "cert-manager.io/v1", Kind: "Certificate", Name: "test-name", UID: types.UID("uid-123"), Controller: ptr.To(true), BlockOwnerDeletion: ptr.To(true)},
+				},
+				ManagedFields: []metav1.ManagedFieldsEntry{
+					{Manager: fieldManager, FieldsV1: &metav1.FieldsV1{
+						Raw: []byte(`
+						{"f:metadata": {
+							"f:labels": {
+								"f:controller.cert-manager.io/fao": {}
+							},
+							"f:annotations": {
+								"f:cert-manager.io/common-name": {},
+								"f:cert-manager.io/alt-names": {},
+								"f:cert-manager.io/ip-sans": {},
+								"f:cert-manager.io/uri-sans": {}
+							},
+							"f:ownerReferences": {
+								"k:{\"uid\":\"uid-123\"}": {}
+							}
+						}}`),
+					}},
+				},
+			},
+			Data: map[string][]byte{
+				corev1.TLSPrivateKeyKey: pk,
+				corev1.TLSCertKey:       cert,
+				cmapi.JKSSecretKey:      mustEncodeJKS(t, cert, password),
+			},
+		}
+	}
+	jksPasswordSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "keystore-password", Namespace: "test-namespace"},
+		Data:       map[string][]byte{"password": []byte("new-password")},
+	}
+
 	tests := map[string]struct {
 		// key that should be passed to ProcessItem.
 		// if not set, the 'namespace/name' of the 'Certificate' field will be used.
@@ -56,6 +129,10 @@ func Test_ensureSecretData(t *testing.T) {
 		// secret is the optional secret to be loaded into the fake clientset.
 		secret *corev1.Secret
 
+		// passwordSecret is the optional keystore password secret to be loaded
+		// into the fake clientset.
+		passwordSecret *corev1.Secret
+
 		// expectedAction is true if the test expects that the controller should
 		// reconcile the Secret.
 		expectedAction bool
@@ -485,7 +562,8 @@ func Test_ensureSecretData(t *testing.T) {
 								},
 								"f:data": {
 									"f:tls-combined.pem": {},
-									"f:key.der": {}
+									"f:key.der": {},
+									"f:tls.der": {}
 								}
 							}`),
 						},
@@ -496,6 +574,7 @@ func Test_ensureSecretData(t *testing.T) {
 					"tls.key":          pk,
 					"tls-combined.pem": combinedPEM,
 					"key.der":          pkDER,
+					"tls.der":          certDER,
 				},
 			},
 			expectedAction: false,
@@ -911,6 +990,22 @@ func Test_ensureSecretData(t *testing.T) {
 			},
 			expectedAction: false,
 		},
+		"refresh secrets when the JKS keystore password has been rotated": {
+			key:            "test-namespace/test-name",
+			enableOwnerRef: true,
+			cert:           jksCertificate,
+			secret:         jksSecret("old-password"),
+			passwordSecret: jksPasswordSecret,
+			expectedAction: true,
+		},
+		"do nothing when the JKS keystore can be opened with the current password": {
+			key:            "test-namespace/test-name",
+			enableOwnerRef: true,
+			cert:           jksCertificate,
+			secret:         jksSecret("new-password"),
+			passwordSecret: jksPasswordSecret,
+			expectedAction: false,
+		},
 		"refresh secret when PKCS12 keystore is defined and the secret does not have keystore/truststore fields": {
 			key:            "test-namespace/test-name",
 			enableOwnerRef: true,
@@ -1163,6 +1258,9 @@ func Test_ensureSecretData(t *testing.T) {
 				// Ensures secret is loaded into the builder's fake clientset.
 				builder.KubeObjects = append(builder.KubeObjects, test.secret)
 			}
+			if test.passwordSecret != nil {
+				builder.KubeObjects = append(builder.KubeObjects, test.passwordSecret)
+			}
 
 			// Initialise with RESTConfig which is used to discover the User Agent.
 			builder.InitWithRESTConfig()
@@ -1198,3 +1296,95 @@ func Test_ensureSecretData(t *testing.T) {
 		})
 	}
 }
+
+func Test_additionalOutputSecretsOutOfSync(t *testing.T) {
+	crt := &cmapi.Certificate{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test-name"},
+		Spec: cmapi.CertificateSpec{
+			SecretName:              "test-secret",
+			AdditionalOutputSecrets: []cmapi.CertificateAdditionalOutputSecret{{Name: "copy"}},
+		},
+	}
+	target := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test-secret"},
+		Data:       map[string][]byte{corev1.TLSCertKey: []byte("cert"), corev1.TLSPrivateKeyKey: []byte("key")},
+	}
+	additionalSecret := func(labels, annotations map[string]string, data map[string][]byte) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "copy", Labels: labels, Annotations: annotations},
+			Data:       data,
+		}
+	}
+	ownLabels := map[string]string{cmapi.IsAdditionalOutputSecretLabelKey: "true"}
+	ownAnnotations := map[string]string{cmapi.CertificateNameKey: "test-name"}
+
+	tests := map[string]struct {
+		secret    *corev1.Secret
+		outOfSync bool
+	}{
+		"additional output Secret does not exist": {
+			outOfSync: true,
+		},
+		"additional output Secret is up to date": {
+			secret: additionalSecret(ownLabels, ownAnnotations, target.Data),
+		},
+		"additional output Secret holds different data": {
+			secret:    additionalSecret(ownLabels, ownAnnotations, map[string][]byte{corev1.TLSCertKey: []byte("old")}),
+			outOfSync: true,
+		},
+		"a user's Secret with the same name is a conflict and never out of sync": {
+			secret: additionalSecret(nil, nil, map[string][]byte{"user": []byte("data")}),
+		},
+		"another Certificate's additional output Secret is a conflict and never out of sync": {
+			secret: additionalSecret(ownLabels, map[string]string{cmapi.CertificateNameKey: "other"}, nil),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var secrets []*corev1.Secret
+			if test.secret != nil {
+				secrets = append(secrets, test.secret)
+			}
+			c := &controller{
+				secretLister: testlisters.NewFakeSecretLister(testlisters.SetFakeSecretListerSecret(func(string) corelisters.SecretNamespaceLister {
+					return testlisters.NewFakeSecretNamespaceLister(func(f *testlisters.FakeSecretNamespaceLister) {
+						f.GetFn = func(name string) (*corev1.Secret, error) {
+							for _, secret := range secrets {
+								if secret.Name == name {
+									return secret, nil
+								}
+							}
+							return nil, apierrors.NewNotFound(corev1.Resource("secret"), name)
+						}
+						f.ListFn = func(labels.Selector) ([]*corev1.Secret, error) {
+							return secrets, nil
+						}
+					})
+				})),
+			}
+
+			outOfSync, err := c.additionalOutputSecretsOutOfSync(crt, target)
+			assert.NoError(t, err)
+			assert.Equal(t, test.outOfSync, outOfSync)
+		})
+	}
+}
+
+// mustEncodeJKS returns a JKS store containing the given PEM
+// certificate, protected with the given password.
+func mustEncodeJKS(t *testing.T, certPEM []byte, password string) []byte {
+	block, _ := pem.Decode(certPEM)
+	ks := jks.New()
+	if err := ks.SetTrustedCertificateEntry("ca", jks.TrustedCertificateEntry{
+		CreationTime: time.Now(),
+		Certificate:  jks.Certificate{Type: "X509", Content: block.Bytes},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	buf := &bytes.Buffer{}
+	if err := ks.Store(buf, []byte(password)); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
@@ -32,6 +32,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
 
 	internalcertificates "github.com/cert-manager/cert-manager/internal/controller/certificates"
 	"github.com/cert-manager/cert-manager/internal/controller/feature"
@@ -54,6 +55,8 @@ const (
 	reasonDecodeFailed        = "DecodeFailed"
 	reasonCannotRegenerateKey = "CannotRegenerateKey"
 	reasonDeleted             = "Deleted"
+	reasonExistingKeyInvalid  = "ExistingKeyInvalid"
+	reasonFeatureDisabled     = "FeatureDisabled"
 )
 
 var (
@@ -66,6 +69,7 @@ type controller struct {
 	client            cmclient.Interface
 	coreClient        kubernetes.Interface
 	recorder          record.EventRecorder
+	clock             clock.Clock
 
 	// fieldManager is the string which will be used as the Field Manager on
 	// fields created or edited by the cert-manager Kubernetes client during
@@ -111,6 +115,7 @@ func NewController(
 		client:            ctx.CMClient,
 		coreClient:        ctx.Client,
 		recorder:          ctx.Recorder,
+		clock:             ctx.Clock,
 		fieldManager:      ctx.FieldManager,
 	}, queue, mustSync
 }
@@ -146,6 +151,11 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 		return err
 	}
 
+	if certificates.IsPaused(crt) {
+		log.V(logf.DebugLevel).Info("Certificate is paused, skipping")
+		return nil
+	}
+
 	// Discover all 'owned' secrets that have the `next-private-key` label
 	secrets, err := certificates.ListSecretsMatchingPredicates(c.secretLister.Secrets(crt.Namespace), isNextPrivateKeyLabelSelector, predicate.ResourceOwnedBy(crt))
 	if err != nil {
@@ -165,6 +175,10 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 
 	// if there is no existing Secret resource, create a new one
 	if len(secrets) == 0 {
+		if crt.Spec.PrivateKey != nil && crt.Spec.PrivateKey.ExistingSecretRef != nil {
+			return c.createNextPrivateKeyFromExistingSecret(ctx, crt)
+		}
+
 		rotationPolicy := cmapi.RotationPolicyNever
 		if crt.Spec.PrivateKey != nil && crt.Spec.PrivateKey.RotationPolicy != "" {
 			rotationPolicy = crt.Spec.PrivateKey.RotationPolicy
@@ -175,6 +189,13 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 		case cmapi.RotationPolicyAlways:
 			log.V(logf.DebugLevel).Info("Creating new nextPrivateKeySecretName Secret because no existing Secret found")
 			return c.createAndSetNextPrivateKey(ctx, crt)
+		case cmapi.RotationPolicyScheduled:
+			rotationTime, ok := internalcertificates.PrivateKeyRotationTime(crt)
+			if ok && c.clock.Now().Before(rotationTime) {
+				return c.createNextPrivateKeyRotationPolicyNever(ctx, crt)
+			}
+			log.V(logf.DebugLevel).Info("Creating new nextPrivateKeySecretName Secret because the scheduled private key rotation is due")
+			return c.createAndSetNextPrivateKey(ctx, crt)
 		default:
 			log.V(logf.WarnLevel).Info("Certificate with unknown certificate.spec.privateKey.rotationPolicy value", "rotation_policy", rotationPolicy)
 			return nil
@@ -266,7 +287,50 @@ func (c *controller) createNextPrivateKeyRotationPolicyNever(ctx context.Context
 	return c.setNextPrivateKeySecretName(ctx, crt, &nextPkSecret.Name)
 }
 
+// createNextPrivateKeyFromExistingSecret stores the pre-generated private key
+// referenced by the Certificate's spec.privateKey.existingSecretRef as the next
+// private key. The referenced key is never rotated, so if it cannot be loaded
+// or does not match the Certificate's requirements a warning is raised to
+// await user intervention.
+func (c *controller) createNextPrivateKeyFromExistingSecret(ctx context.Context, crt *cmapi.Certificate) error {
+	ref := crt.Spec.PrivateKey.ExistingSecretRef
+	key := ref.Key
+	if key == "" {
+		key = corev1.TLSPrivateKeyKey
+	}
+
+	s, err := c.secretLister.Secrets(crt.Namespace).Get(ref.Name)
+	if apierrors.IsNotFound(err) {
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, reasonExistingKeyInvalid, "Secret %q containing the existing private key does not exist", ref.Name)
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	pk, err := pki.LoadPrivateKeyForCertificate(crt, s.Data[key])
+	if err != nil {
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, reasonExistingKeyInvalid, "User intervention required: failed to load the existing private key stored in key %q of Secret %q: %v", key, ref.Name, err)
+		return nil
+	}
+
+	nextPkSecret, err := c.createNewPrivateKeySecret(ctx, crt, pk)
+	if err != nil {
+		return err
+	}
+
+	c.recorder.Event(crt, corev1.EventTypeNormal, "Reused", fmt.Sprintf("Reusing existing private key stored in Secret resource %q", ref.Name))
+
+	return c.setNextPrivateKeySecretName(ctx, crt, &nextPkSecret.Name)
+}
+
 func (c *controller) createAndSetNextPrivateKey(ctx context.Context, crt *cmapi.Certificate) error {
+	if crt.Spec.PrivateKey != nil && crt.Spec.PrivateKey.Algorithm == cmapi.MLDSAKeyAlgorithm &&
+		!utilfeature.DefaultFeatureGate.Enabled(feature.ExperimentalPostQuantumKeys) {
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, reasonFeatureDisabled, "Not generating a private key as the %s feature gate must be enabled to use the %s key algorithm", feature.ExperimentalPostQuantumKeys, cmapi.MLDSAKeyAlgorithm)
+		return nil
+	}
+
 	pk, err := pki.GeneratePrivateKeyForCertificate(crt)
 	if err != nil {
 		return err
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/kr/pretty"
 	corev1 "k8s.io/api/core/v1"
@@ -94,6 +95,8 @@ func TestProcessItem(t *testing.T) {
 			Data: data,
 		}
 	}
+	rotationNotDue := time.Now().Add(-time.Hour).Truncate(time.Second)
+	rotationDue := time.Now().Add(-48 * time.Hour).Truncate(time.Second)
 	tests := map[string]struct {
 		// key that should be passed to ProcessItem.
 		// if not set, the 'namespace/name' of the 'Certificate' field will be used.
@@ -190,6 +193,25 @@ func TestProcessItem(t *testing.T) {
 				), relaxedSecretMatcher),
 			},
 		},
+		"do nothing and fire an event if the ML-DSA key algorithm is used and the feature gate is disabled": {
+			certificate: &cmapi.Certificate{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test"},
+				Spec: cmapi.CertificateSpec{
+					PrivateKey: &cmapi.CertificatePrivateKey{
+						Algorithm: cmapi.MLDSAKeyAlgorithm,
+					},
+				},
+				Status: cmapi.CertificateStatus{
+					Conditions: []cmapi.CertificateCondition{
+						{
+							Type:   cmapi.CertificateConditionIssuing,
+							Status: cmmeta.ConditionTrue,
+						},
+					},
+				},
+			},
+			expectedEvents: []string{`Warning FeatureDisabled Not generating a private key as the ExperimentalPostQuantumKeys feature gate must be enabled to use the ML-DSA key algorithm`},
+		},
 		"create a secret using the already allocated name if it is set": {
 			certificate: &cmapi.Certificate{
 				ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test"},
@@ -462,6 +484,90 @@ func TestProcessItem(t *testing.T) {
 				ownedSecretWithName("testns", "fixed-name", "test", map[string][]byte{"tls.key": mustGenerateRSA(t, 2048)}),
 			},
 		},
+		"reuse the existing private key if the scheduled private key rotation is not yet due": {
+			certificate: scheduledRotationCertificate(rotationNotDue),
+			secrets: []runtime.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test-secret"},
+					Data:       map[string][]byte{"tls.key": mustGenerateRSA(t, 2048)},
+				},
+			},
+			expectedEvents: []string{`Normal Reused Reusing private key stored in existing Secret resource "test-secret"`},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+					cmapi.SchemeGroupVersion.WithResource("certificates"),
+					"status",
+					"testns",
+					withNextPrivateKeySecretName(scheduledRotationCertificate(rotationNotDue), "test-notrandom"),
+				)),
+				testpkg.NewCustomMatch(coretesting.NewCreateAction(
+					corev1.SchemeGroupVersion.WithResource("secrets"),
+					"testns",
+					nextPrivateKeySecret(),
+				), relaxedSecretMatcher),
+			},
+		},
+		"create a new private key if the scheduled private key rotation is due": {
+			certificate: scheduledRotationCertificate(rotationDue),
+			secrets: []runtime.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test-secret"},
+					Data:       map[string][]byte{"tls.key": mustGenerateRSA(t, 2048)},
+				},
+			},
+			expectedEvents: []string{`Normal Generated Stored new private key in temporary Secret resource "test-notrandom"`},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+					cmapi.SchemeGroupVersion.WithResource("certificates"),
+					"status",
+					"testns",
+					withNextPrivateKeySecretName(scheduledRotationCertificate(rotationDue), "test-notrandom"),
+				)),
+				testpkg.NewCustomMatch(coretesting.NewCreateAction(
+					corev1.SchemeGroupVersion.WithResource("secrets"),
+					"testns",
+					nextPrivateKeySecret(),
+				), relaxedSecretMatcher),
+			},
+		},
+		"create a secret from the private key referenced by existingSecretRef": {
+			certificate: existingKeyCertificate(cmapi.RSAKeyAlgorithm),
+			secrets: []runtime.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "existing-key"},
+					Data:       map[string][]byte{"key.pem": mustGenerateRSA(t, 2048)},
+				},
+			},
+			expectedEvents: []string{`Normal Reused Reusing existing private key stored in Secret resource "existing-key"`},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+					cmapi.SchemeGroupVersion.WithResource("certificates"),
+					"status",
+					"testns",
+					withNextPrivateKeySecretName(existingKeyCertificate(cmapi.RSAKeyAlgorithm), "test-notrandom"),
+				)),
+				testpkg.NewCustomMatch(coretesting.NewCreateAction(
+					corev1.SchemeGroupVersion.WithResource("secrets"),
+					"testns",
+					nextPrivateKeySecret(),
+				), relaxedSecretMatcher),
+			},
+		},
+		"do nothing and fire an event if the private key referenced by existingSecretRef does not match the algorithm": {
+			certificate: existingKeyCertificate(cmapi.ECDSAKeyAlgorithm),
+			secrets: []runtime.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "existing-key"},
+					Data:       map[string][]byte{"key.pem": mustGenerateRSA(t, 2048)},
+				},
+			},
+			expectedEvents: []string{`Warning ExistingKeyInvalid User intervention required: failed to load the existing private key stored in key "key.pem" of Secret "existing-key": existing private key does not match the certificate's private key requirements, mismatching fields: [spec.privateKey.algorithm]`},
+		},
+		"error and fire an event if the Secret referenced by existingSecretRef does not exist": {
+			certificate:    existingKeyCertificate(cmapi.RSAKeyAlgorithm),
+			expectedEvents: []string{`Warning ExistingKeyInvalid Secret "existing-key" containing the existing private key does not exist`},
+			err:            `secret "existing-key" not found`,
+		},
 	}
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -523,3 +629,66 @@ func TestProcessItem(t *testing.T) {
 		})
 	}
 }
+
+func scheduledRotationCertificate(lastRotation time.Time) *cmapi.Certificate {
+	return &cmapi.Certificate{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test"},
+		Spec: cmapi.CertificateSpec{
+			SecretName: "test-secret",
+			PrivateKey: &cmapi.CertificatePrivateKey{
+				RotationPolicy:   cmapi.RotationPolicyScheduled,
+				RotationInterval: &metav1.Duration{Duration: 24 * time.Hour},
+			},
+		},
+		Status: cmapi.CertificateStatus{
+			LastPrivateKeyRotationTime: &metav1.Time{Time: lastRotation},
+			Conditions: []cmapi.CertificateCondition{
+				{
+					Type:   cmapi.CertificateConditionIssuing,
+					Status: cmmeta.ConditionTrue,
+				},
+			},
+		},
+	}
+}
+
+func existingKeyCertificate(algorithm cmapi.PrivateKeyAlgorithm) *cmapi.Certificate {
+	return &cmapi.Certificate{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test"},
+		Spec: cmapi.CertificateSpec{
+			SecretName: "test-secret",
+			PrivateKey: &cmapi.CertificatePrivateKey{
+				Algorithm: algorithm,
+				ExistingSecretRef: &cmmeta.SecretKeySelector{
+					LocalObjectReference: cmmeta.LocalObjectReference{Name: "existing-key"},
+					Key:                  "key.pem",
+				},
+			},
+		},
+		Status: cmapi.CertificateStatus{
+			Conditions: []cmapi.CertificateCondition{
+				{
+					Type:   cmapi.CertificateConditionIssuing,
+					Status: cmmeta.ConditionTrue,
+				},
+			},
+		},
+	}
+}
+
+func withNextPrivateKeySecretName(crt *cmapi.Certificate, name string) *cmapi.Certificate {
+	crt.Status.NextPrivateKeySecretName = ptr.To(name)
+	return crt
+}
+
+func nextPrivateKeySecret() *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       "testns",
+			GenerateName:    "test-",
+			Labels:          map[string]string{cmapi.IsNextPrivateKeySecretLabelKey: "true", cmapi.PartOfCertManagerControllerLabelKey: "true"},
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(&cmapi.Certificate{ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test"}}, certificateGvk)},
+		},
+		Data: map[string][]byte{"tls.key": nil},
+	}
+}
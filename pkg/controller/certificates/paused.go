@@ -0,0 +1,30 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"strconv"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// IsPaused returns true if reconciliation of the Certificate has been paused
+// by setting the `cert-manager.io/paused` annotation to `true`.
+func IsPaused(crt *cmapi.Certificate) bool {
+	paused, _ := strconv.ParseBool(crt.Annotations[cmapi.CertificatePausedAnnotationKey])
+	return paused
+}
@@ -0,0 +1,409 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podidentity
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	config "github.com/cert-manager/cert-manager/internal/apis/config/controller"
+	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
+	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+const (
+	// ControllerName is the name of the certificate pod identity controller.
+	ControllerName = "certificates-pod-identity"
+
+	// certificateDuration is the duration of the certificates issued for Pods.
+	// They are short-lived since they are renewed for as long as the Pod runs.
+	certificateDuration = time.Hour
+
+	reasonSecretAlreadyExists = "SecretAlreadyExists"
+	reasonIssuerNotAllowed    = "IssuerNotAllowed"
+)
+
+var podGVK = corev1.SchemeGroupVersion.WithKind("Pod")
+
+// controller creates a short-lived Certificate for each Pod annotated with
+// the name of an issuer. The Certificate identifies the Pod's ServiceAccount
+// and is issued to a Secret named after the Pod through the existing
+// Certificate and CertificateRequest controllers. The Certificate and Secret
+// are removed once the Pod is deleted.
+// Only issuers which have been allowed with the controller's
+// --pod-identity-allowed-issuers flag may be used, since anyone who can
+// create a Pod could otherwise get a certificate from any issuer in its
+// namespace or any ClusterIssuer. The flag does not restrict the URI SANs of
+// Certificates created by anyone else for these issuers: that is left to an
+// approver.
+type controller struct {
+	podLister         corelisters.PodLister
+	certificateLister cmlisters.CertificateLister
+	secretLister      internalinformers.SecretLister
+	kubeClient        kubernetes.Interface
+	cmClient          cmclient.Interface
+	recorder          record.EventRecorder
+	fieldManager      string
+
+	// allowedIssuers are the issuers which Pods may request a certificate
+	// from.
+	allowedIssuers []cmmeta.ObjectReference
+	// trustDomain is the SPIFFE trust domain of the URI SAN identifying the
+	// Pod's ServiceAccount.
+	trustDomain string
+
+	// notAllowedIssuers holds, keyed by Pod, the issuer which the Pod was last
+	// found to reference but which is not allowed, so that the
+	// IssuerNotAllowed event is only sent when that changes.
+	notAllowedIssuersLock sync.Mutex
+	notAllowedIssuers     map[string]cmmeta.ObjectReference
+}
+
+// NewController returns a new certificate pod identity controller.
+func NewController(
+	log logr.Logger,
+	ctx *controllerpkg.Context,
+) (*controller, workqueue.RateLimitingInterface, []cache.InformerSynced, error) {
+	allowedIssuers := make([]cmmeta.ObjectReference, 0, len(ctx.PodIdentityOptions.AllowedIssuers))
+	for _, s := range ctx.PodIdentityOptions.AllowedIssuers {
+		ref, err := config.ParseIssuerReference(s)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		allowedIssuers = append(allowedIssuers, ref)
+	}
+
+	// create a queue used to queue up items to be processed
+	queue := workqueue.NewNamedRateLimitingQueue(controllerpkg.DefaultItemBasedRateLimiter(), ControllerName)
+
+	// obtain references to all the informers used by this controller
+	podInformer := ctx.KubeSharedInformerFactory.Pods()
+	secretInformer := ctx.KubeSharedInformerFactory.Secrets()
+	certificateInformer := ctx.SharedInformerFactory.Certmanager().V1().Certificates()
+
+	// All Pods are queued, since a Pod which no longer has the issuer
+	// annotation may still have a Certificate to be cleaned up.
+	podInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: queue})
+	// When a Certificate managed for a Pod changes or is deleted, enqueue the
+	// Pod so that it is updated or recreated.
+	certificateInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+		WorkFunc: enqueuePodForCertificate(log, queue),
+	})
+
+	// build a list of InformerSynced functions that will be returned by the Register method.
+	// the controller will only begin processing items once all of these informers have synced.
+	mustSync := []cache.InformerSynced{
+		podInformer.Informer().HasSynced,
+		secretInformer.Informer().HasSynced,
+		certificateInformer.Informer().HasSynced,
+	}
+
+	return &controller{
+		podLister:         podInformer.Lister(),
+		certificateLister: certificateInformer.Lister(),
+		secretLister:      secretInformer.Lister(),
+		kubeClient:        ctx.Client,
+		cmClient:          ctx.CMClient,
+		recorder:          ctx.Recorder,
+		fieldManager:      ctx.FieldManager,
+		allowedIssuers:    allowedIssuers,
+		trustDomain:       ctx.PodIdentityOptions.TrustDomain,
+		notAllowedIssuers: make(map[string]cmmeta.ObjectReference),
+	}, queue, mustSync, nil
+}
+
+// enqueuePodForCertificate enqueues the Pod which the given Certificate has
+// been created for, if any.
+func enqueuePodForCertificate(log logr.Logger, queue workqueue.RateLimitingInterface) func(obj interface{}) {
+	return func(obj interface{}) {
+		crt, ok := obj.(*cmapi.Certificate)
+		if !ok {
+			log.Error(nil, "object is not a Certificate", "object", obj)
+			return
+		}
+
+		podName, ok := crt.Labels[cmapi.PodIdentityLabelKey]
+		if !ok {
+			return
+		}
+		queue.Add(crt.Namespace + "/" + podName)
+	}
+}
+
+// ProcessItem is a worker function that will be called when a new key
+// corresponding to a Pod to be re-synced is pulled from the workqueue.
+// ProcessItem ensures that an annotated Pod has an up to date Certificate, and
+// that the Certificate and Secret of a Pod which is gone, or no longer
+// annotated, are deleted.
+func (c *controller) ProcessItem(ctx context.Context, key string) error {
+	log := logf.FromContext(ctx).WithValues("key", key)
+
+	ctx = logf.NewContext(ctx, log)
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		log.Error(err, "invalid resource key passed to ProcessItem")
+		return nil
+	}
+
+	// The Certificate of a Pod is neither updated nor deleted while it is
+	// paused.
+	crt, err := c.certificateLister.Certificates(namespace).Get(name)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if crt != nil && isPodCertificate(crt, name) && certificates.IsPaused(crt) {
+		log.V(logf.DebugLevel).Info("Certificate is paused, skipping")
+		return nil
+	}
+
+	pod, err := c.podLister.Pods(namespace).Get(name)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if pod == nil || pod.Annotations[cmapi.PodIdentityIssuerNameAnnotationKey] == "" {
+		c.setNotAllowedIssuer(key, nil)
+		return c.cleanup(ctx, namespace, name)
+	}
+	if pod.DeletionTimestamp != nil {
+		// The Certificate is cleaned up once the Pod is gone.
+		return nil
+	}
+
+	desired := certificateForPod(pod, c.trustDomain)
+	if !c.issuerAllowed(desired.Spec.IssuerRef) {
+		ref := desired.Spec.IssuerRef
+		if c.setNotAllowedIssuer(key, &ref) {
+			log.Info("not managing a certificate for the pod since its issuer is not allowed",
+				"issuer_name", ref.Name, "issuer_kind", ref.Kind, "issuer_group", ref.Group)
+			c.recorder.Eventf(pod, corev1.EventTypeWarning, reasonIssuerNotAllowed,
+				"Not creating a Certificate for the Pod since %s %q is not allowed to issue Pod identities", ref.Kind, ref.Name)
+		}
+		return c.cleanup(ctx, namespace, name)
+	}
+	c.setNotAllowedIssuer(key, nil)
+
+	existing, err := c.certificateLister.Certificates(namespace).Get(desired.Name)
+	if apierrors.IsNotFound(err) {
+		// Never issue into a Secret which was not created for the Pod, since
+		// its contents would be overwritten and it would be deleted along
+		// with the Pod.
+		secret, err := c.secretLister.Secrets(namespace).Get(desired.Spec.SecretName)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		if secret != nil && secret.Labels[cmapi.PodIdentityLabelKey] != name {
+			log.Info("not managing a certificate for the pod since a secret with the same name already exists",
+				"secret", secret.Name)
+			c.recorder.Eventf(pod, corev1.EventTypeWarning, reasonSecretAlreadyExists,
+				"Not creating a Certificate for the Pod since Secret %q already exists and was not created for it", secret.Name)
+			return nil
+		}
+
+		log.V(logf.DebugLevel).Info("creating certificate for pod")
+		_, err = c.cmClient.CertmanagerV1().Certificates(namespace).Create(ctx, desired, metav1.CreateOptions{FieldManager: c.fieldManager})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	if !isPodCertificate(existing, name) {
+		log.Info("not managing a certificate for the pod since a certificate with the same name already exists",
+			"certificate", existing.Name)
+		return nil
+	}
+
+	if apiequality.Semantic.DeepEqual(existing.Spec, desired.Spec) &&
+		apiequality.Semantic.DeepEqual(existing.OwnerReferences, desired.OwnerReferences) {
+		return nil
+	}
+
+	log.V(logf.DebugLevel).Info("updating certificate for pod")
+	crt = existing.DeepCopy()
+	crt.Spec = desired.Spec
+	crt.OwnerReferences = desired.OwnerReferences
+	_, err = c.cmClient.CertmanagerV1().Certificates(namespace).Update(ctx, crt, metav1.UpdateOptions{FieldManager: c.fieldManager})
+	return err
+}
+
+// cleanup deletes the Certificate and Secret which were created for the named
+// Pod, if they exist. Resources which were not created for the Pod are left
+// untouched.
+func (c *controller) cleanup(ctx context.Context, namespace, podName string) error {
+	log := logf.FromContext(ctx)
+
+	crt, err := c.certificateLister.Certificates(namespace).Get(podName)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if crt != nil && isPodCertificate(crt, podName) {
+		log.V(logf.DebugLevel).Info("deleting certificate of pod")
+		err := c.cmClient.CertmanagerV1().Certificates(namespace).Delete(ctx, crt.Name, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	secret, err := c.secretLister.Secrets(namespace).Get(podName)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if secret != nil && secret.Labels[cmapi.PodIdentityLabelKey] == podName {
+		log.V(logf.DebugLevel).Info("deleting secret of pod")
+		err := c.kubeClient.CoreV1().Secrets(namespace).Delete(ctx, secret.Name, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// issuerAllowed returns true if Pods may request a certificate from the
+// referenced issuer.
+func (c *controller) issuerAllowed(ref cmmeta.ObjectReference) bool {
+	group := ref.Group
+	if group == "" {
+		group = cmapi.SchemeGroupVersion.Group
+	}
+	for _, allowed := range c.allowedIssuers {
+		if allowed.Name == ref.Name && allowed.Kind == ref.Kind && allowed.Group == group {
+			return true
+		}
+	}
+	return false
+}
+
+// setNotAllowedIssuer records the issuer which the Pod with the given key
+// references but which is not allowed, or nil if there is none. It returns
+// true if this differs from what was previously recorded for the Pod.
+func (c *controller) setNotAllowedIssuer(key string, ref *cmmeta.ObjectReference) bool {
+	c.notAllowedIssuersLock.Lock()
+	defer c.notAllowedIssuersLock.Unlock()
+
+	previous, found := c.notAllowedIssuers[key]
+	if ref == nil {
+		delete(c.notAllowedIssuers, key)
+		return found
+	}
+	c.notAllowedIssuers[key] = *ref
+	return !found || previous != *ref
+}
+
+// isPodCertificate returns true if the Certificate has been created for the
+// named Pod.
+func isPodCertificate(crt *cmapi.Certificate, podName string) bool {
+	if crt.Labels[cmapi.PodIdentityLabelKey] != podName {
+		return false
+	}
+	ref := metav1.GetControllerOf(crt)
+	return ref != nil && ref.Kind == podGVK.Kind && ref.Name == podName
+}
+
+// certificateForPod returns the Certificate which should exist for the given
+// annotated Pod.
+func certificateForPod(pod *corev1.Pod, trustDomain string) *cmapi.Certificate {
+	serviceAccount := pod.Spec.ServiceAccountName
+	if serviceAccount == "" {
+		serviceAccount = "default"
+	}
+
+	kind := pod.Annotations[cmapi.PodIdentityIssuerKindAnnotationKey]
+	if kind == "" {
+		kind = cmapi.IssuerKind
+	}
+
+	labels := map[string]string{cmapi.PodIdentityLabelKey: pod.Name}
+
+	return &cmapi.Certificate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            pod.Name,
+			Namespace:       pod.Namespace,
+			Labels:          labels,
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(pod, podGVK)},
+		},
+		Spec: cmapi.CertificateSpec{
+			SecretName:     pod.Name,
+			SecretTemplate: &cmapi.CertificateSecretTemplate{Labels: labels},
+			URIs: []string{
+				fmt.Sprintf("spiffe://%s/ns/%s/sa/%s", trustDomain, pod.Namespace, serviceAccount),
+			},
+			Duration: &metav1.Duration{Duration: certificateDuration},
+			PrivateKey: &cmapi.CertificatePrivateKey{
+				Algorithm:      cmapi.ECDSAKeyAlgorithm,
+				RotationPolicy: cmapi.RotationPolicyAlways,
+			},
+			Usages: []cmapi.KeyUsage{
+				cmapi.UsageDigitalSignature,
+				cmapi.UsageKeyEncipherment,
+				cmapi.UsageServerAuth,
+				cmapi.UsageClientAuth,
+			},
+			IssuerRef: cmmeta.ObjectReference{
+				Name:  pod.Annotations[cmapi.PodIdentityIssuerNameAnnotationKey],
+				Kind:  kind,
+				Group: pod.Annotations[cmapi.PodIdentityIssuerGroupAnnotationKey],
+			},
+		},
+	}
+}
+
+// controllerWrapper wraps the `controller` structure to make it implement
+// the controllerpkg.queueingController interface
+type controllerWrapper struct {
+	*controller
+}
+
+func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.RateLimitingInterface, []cache.InformerSynced, error) {
+	// construct a new named logger to be reused throughout the controller
+	log := logf.FromContext(ctx.RootContext, ControllerName)
+
+	ctrl, queue, mustSync, err := NewController(log, ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	c.controller = ctrl
+
+	return queue, mustSync, nil
+}
+
+func init() {
+	controllerpkg.Register(ControllerName, func(ctx *controllerpkg.ContextFactory) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, ControllerName).
+			For(&controllerWrapper{}).
+			Complete()
+	})
+}
@@ -0,0 +1,314 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podidentity
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	coretesting "k8s.io/client-go/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestProcessItem(t *testing.T) {
+	labels := map[string]string{cmapi.PodIdentityLabelKey: "test-pod"}
+
+	podCrt := &cmapi.Certificate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-pod",
+			Namespace: "test-ns",
+			Labels:    labels,
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "v1", Kind: "Pod", Name: "test-pod", UID: "test-uid",
+				Controller: ptr.To(true), BlockOwnerDeletion: ptr.To(true),
+			}},
+		},
+		Spec: cmapi.CertificateSpec{
+			SecretName:     "test-pod",
+			SecretTemplate: &cmapi.CertificateSecretTemplate{Labels: labels},
+			URIs:           []string{"spiffe://cluster.local/ns/test-ns/sa/test-sa"},
+			Duration:       &metav1.Duration{Duration: time.Hour},
+			PrivateKey: &cmapi.CertificatePrivateKey{
+				Algorithm:      cmapi.ECDSAKeyAlgorithm,
+				RotationPolicy: cmapi.RotationPolicyAlways,
+			},
+			Usages: []cmapi.KeyUsage{
+				cmapi.UsageDigitalSignature, cmapi.UsageKeyEncipherment,
+				cmapi.UsageServerAuth, cmapi.UsageClientAuth,
+			},
+			IssuerRef: cmmeta.ObjectReference{Name: "test-issuer", Kind: "Issuer"},
+		},
+	}
+
+	podSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-pod",
+			Namespace: "test-ns",
+			Labels: map[string]string{
+				cmapi.PodIdentityLabelKey:                 "test-pod",
+				cmapi.PartOfCertManagerControllerLabelKey: "true",
+			},
+		},
+	}
+
+	userCrt := gen.Certificate("test-pod", gen.SetCertificateNamespace("test-ns"))
+	userSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-pod",
+			Namespace: "test-ns",
+			Labels:    map[string]string{cmapi.PartOfCertManagerControllerLabelKey: "true"},
+		},
+	}
+
+	certificatesResource := cmapi.SchemeGroupVersion.WithResource("certificates")
+	secretsResource := corev1.SchemeGroupVersion.WithResource("secrets")
+
+	tests := map[string]struct {
+		pod          *corev1.Pod
+		certificates []runtime.Object
+		secrets      []runtime.Object
+		trustDomain  string
+		// syncs is the number of times the Pod is processed, once if zero.
+		syncs int
+
+		expectedActions []testpkg.Action
+		expectedEvents  []string
+	}{
+		"should do nothing if the pod is not annotated": {
+			pod: pod(),
+		},
+		"should create a certificate for an annotated pod": {
+			pod: pod(withIssuer("test-issuer")),
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewCreateAction(certificatesResource, "test-ns", podCrt)),
+			},
+		},
+		"should create a certificate using the issuer kind and group annotations": {
+			pod: pod(withIssuer("test-issuer"), withAnnotation(cmapi.PodIdentityIssuerKindAnnotationKey, "ClusterIssuer"),
+				withAnnotation(cmapi.PodIdentityIssuerGroupAnnotationKey, "cert-manager.io")),
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewCreateAction(certificatesResource, "test-ns",
+					gen.CertificateFrom(podCrt, gen.SetCertificateIssuer(cmmeta.ObjectReference{
+						Name: "test-issuer", Kind: "ClusterIssuer", Group: "cert-manager.io",
+					})))),
+			},
+		},
+		"should use the default service account if the pod does not name one": {
+			pod: pod(withIssuer("test-issuer"), func(p *corev1.Pod) { p.Spec.ServiceAccountName = "" }),
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewCreateAction(certificatesResource, "test-ns",
+					gen.CertificateFrom(podCrt, gen.SetCertificateURIs("spiffe://cluster.local/ns/test-ns/sa/default")))),
+			},
+		},
+		"should use the configured trust domain": {
+			pod:         pod(withIssuer("test-issuer")),
+			trustDomain: "example.org",
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewCreateAction(certificatesResource, "test-ns",
+					gen.CertificateFrom(podCrt, gen.SetCertificateURIs("spiffe://example.org/ns/test-ns/sa/test-sa")))),
+			},
+		},
+		"should not create a certificate if the issuer is not allowed": {
+			pod: pod(withIssuer("not-allowed")),
+			expectedEvents: []string{
+				`Warning IssuerNotAllowed Not creating a Certificate for the Pod since Issuer "not-allowed" is not allowed to issue Pod identities`,
+			},
+		},
+		"should only send the IssuerNotAllowed event once while the pod references the same issuer": {
+			pod:   pod(withIssuer("not-allowed")),
+			syncs: 2,
+			expectedEvents: []string{
+				`Warning IssuerNotAllowed Not creating a Certificate for the Pod since Issuer "not-allowed" is not allowed to issue Pod identities`,
+			},
+		},
+		"should not create a certificate if only an issuer of another kind with the same name is allowed": {
+			pod: pod(withIssuer("other-issuer"), withAnnotation(cmapi.PodIdentityIssuerKindAnnotationKey, "ClusterIssuer")),
+			expectedEvents: []string{
+				`Warning IssuerNotAllowed Not creating a Certificate for the Pod since ClusterIssuer "other-issuer" is not allowed to issue Pod identities`,
+			},
+		},
+		"should delete the certificate and secret if the issuer is no longer allowed": {
+			pod:          pod(withIssuer("not-allowed")),
+			certificates: []runtime.Object{podCrt},
+			secrets:      []runtime.Object{podSecret},
+			expectedEvents: []string{
+				`Warning IssuerNotAllowed Not creating a Certificate for the Pod since Issuer "not-allowed" is not allowed to issue Pod identities`,
+			},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewDeleteAction(certificatesResource, "test-ns", "test-pod")),
+				testpkg.NewAction(coretesting.NewDeleteAction(secretsResource, "test-ns", "test-pod")),
+			},
+		},
+		"should create a certificate if the pod's secret already exists from an earlier certificate": {
+			pod:     pod(withIssuer("test-issuer")),
+			secrets: []runtime.Object{podSecret},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewCreateAction(certificatesResource, "test-ns", podCrt)),
+			},
+		},
+		"should not create a certificate if a secret with the same name was not created for the pod": {
+			pod:     pod(withIssuer("test-issuer")),
+			secrets: []runtime.Object{userSecret},
+			expectedEvents: []string{
+				`Warning SecretAlreadyExists Not creating a Certificate for the Pod since Secret "test-pod" already exists and was not created for it`,
+			},
+		},
+		"should do nothing if the certificate is up to date": {
+			pod:          pod(withIssuer("test-issuer")),
+			certificates: []runtime.Object{podCrt},
+		},
+		"should update the certificate if the issuer annotation changes": {
+			pod:          pod(withIssuer("other-issuer")),
+			certificates: []runtime.Object{podCrt},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewUpdateAction(certificatesResource, "test-ns",
+					gen.CertificateFrom(podCrt, gen.SetCertificateIssuer(cmmeta.ObjectReference{
+						Name: "other-issuer", Kind: "Issuer",
+					})))),
+			},
+		},
+		"should not update the certificate if it is paused": {
+			pod: pod(withIssuer("other-issuer")),
+			certificates: []runtime.Object{gen.CertificateFrom(podCrt,
+				gen.AddCertificateAnnotations(map[string]string{cmapi.CertificatePausedAnnotationKey: "true"}))},
+		},
+		"should not delete the certificate and secret once the pod is gone if the certificate is paused": {
+			certificates: []runtime.Object{gen.CertificateFrom(podCrt,
+				gen.AddCertificateAnnotations(map[string]string{cmapi.CertificatePausedAnnotationKey: "true"}))},
+			secrets: []runtime.Object{podSecret},
+		},
+		"should not touch a certificate with the same name which was not created for the pod": {
+			pod:          pod(withIssuer("test-issuer")),
+			certificates: []runtime.Object{userCrt},
+		},
+		"should delete the certificate and secret once the pod is gone": {
+			certificates: []runtime.Object{podCrt},
+			secrets:      []runtime.Object{podSecret},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewDeleteAction(certificatesResource, "test-ns", "test-pod")),
+				testpkg.NewAction(coretesting.NewDeleteAction(secretsResource, "test-ns", "test-pod")),
+			},
+		},
+		"should delete the certificate and secret once the pod is no longer annotated": {
+			pod:          pod(),
+			certificates: []runtime.Object{podCrt},
+			secrets:      []runtime.Object{podSecret},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewDeleteAction(certificatesResource, "test-ns", "test-pod")),
+				testpkg.NewAction(coretesting.NewDeleteAction(secretsResource, "test-ns", "test-pod")),
+			},
+		},
+		"should delete the secret once the pod is gone, even if the certificate was already garbage collected": {
+			secrets: []runtime.Object{podSecret},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewDeleteAction(secretsResource, "test-ns", "test-pod")),
+			},
+		},
+		"should not delete a certificate and secret which were not created for the pod once it is gone": {
+			certificates: []runtime.Object{userCrt},
+			secrets:      []runtime.Object{userSecret},
+		},
+		"should do nothing if the pod is being deleted": {
+			pod: pod(withIssuer("test-issuer"), func(p *corev1.Pod) {
+				p.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+			}),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{
+				T:                  t,
+				Clock:              fakeclock.NewFakeClock(time.Now()),
+				KubeObjects:        test.secrets,
+				CertManagerObjects: test.certificates,
+				ExpectedActions:    test.expectedActions,
+				ExpectedEvents:     test.expectedEvents,
+			}
+			if test.pod != nil {
+				builder.KubeObjects = append(builder.KubeObjects, test.pod)
+			}
+			builder.Init()
+			builder.Context.PodIdentityOptions = controllerpkg.PodIdentityOptions{
+				AllowedIssuers: []string{"Issuer/test-issuer", "Issuer/other-issuer", "ClusterIssuer.cert-manager.io/test-issuer"},
+				TrustDomain:    "cluster.local",
+			}
+			if test.trustDomain != "" {
+				builder.Context.PodIdentityOptions.TrustDomain = test.trustDomain
+			}
+
+			w := &controllerWrapper{}
+			if _, _, err := w.Register(builder.Context); err != nil {
+				t.Fatal(err)
+			}
+
+			builder.Start()
+			defer builder.Stop()
+
+			for range max(test.syncs, 1) {
+				if err := w.controller.ProcessItem(context.Background(), "test-ns/test-pod"); err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+			}
+
+			if err := builder.AllActionsExecuted(); err != nil {
+				builder.T.Error(err)
+			}
+			if err := builder.AllEventsCalled(); err != nil {
+				builder.T.Error(err)
+			}
+		})
+	}
+}
+
+type podModifier func(*corev1.Pod)
+
+func pod(mods ...podModifier) *corev1.Pod {
+	p := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-pod", Namespace: "test-ns", UID: types.UID("test-uid")},
+		Spec:       corev1.PodSpec{ServiceAccountName: "test-sa"},
+	}
+	for _, mod := range mods {
+		mod(p)
+	}
+	return p
+}
+
+func withAnnotation(key, value string) podModifier {
+	return func(p *corev1.Pod) {
+		if p.Annotations == nil {
+			p.Annotations = make(map[string]string)
+		}
+		p.Annotations[key] = value
+	}
+}
+
+func withIssuer(name string) podModifier {
+	return withAnnotation(cmapi.PodIdentityIssuerNameAnnotationKey, name)
+}
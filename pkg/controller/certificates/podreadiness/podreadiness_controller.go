@@ -0,0 +1,313 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podreadiness
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+const (
+	// ControllerName is the name of the certificate pod readiness controller.
+	ControllerName = "certificates-pod-readiness"
+
+	reasonCertificateReady    = "CertificateReady"
+	reasonCertificateNotReady = "CertificateNotReady"
+	reasonCertificateNotFound = "CertificateNotFound"
+
+	// certificateIndex is the name of the index of Pods by the namespaced
+	// name of the Certificate they reference.
+	certificateIndex = "cert-manager.io/pod-readiness-certificate"
+)
+
+// controller sets the PodCertificateReadyConditionType condition on Pods
+// annotated with the name of a Certificate, so that Pods which declare it as a
+// readiness gate only become Ready once that Certificate is Ready.
+type controller struct {
+	podLister         corelisters.PodLister
+	certificateLister cmlisters.CertificateLister
+	kubeClient        kubernetes.Interface
+	clock             clock.Clock
+}
+
+// NewController returns a new certificate pod readiness controller.
+func NewController(
+	log logr.Logger,
+	ctx *controllerpkg.Context,
+) (*controller, workqueue.RateLimitingInterface, []cache.InformerSynced, error) {
+	// create a queue used to queue up items to be processed
+	queue := workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Second*1, time.Second*30), ControllerName)
+
+	// obtain references to all the informers used by this controller
+	podInformer := ctx.KubeSharedInformerFactory.Pods()
+	certificateInformer := ctx.SharedInformerFactory.Certmanager().V1().Certificates()
+
+	// Index Pods by the Certificate they reference, so that the Pods to
+	// update when a Certificate changes are found without listing every Pod
+	// in its namespace.
+	if err := podInformer.Informer().AddIndexers(cache.Indexers{certificateIndex: podCertificateIndexFunc}); err != nil {
+		return nil, nil, nil, err
+	}
+
+	// Only Pods which reference a Certificate are of interest.
+	podInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+		WorkFunc: enqueueAnnotatedPod(log, queue),
+	})
+	// When a Certificate changes, enqueue the Pods which reference it.
+	certificateInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+		WorkFunc: enqueuePodsForCertificate(log, queue, podInformer.Informer().GetIndexer()),
+	})
+
+	// build a list of InformerSynced functions that will be returned by the Register method.
+	// the controller will only begin processing items once all of these informers have synced.
+	mustSync := []cache.InformerSynced{
+		podInformer.Informer().HasSynced,
+		certificateInformer.Informer().HasSynced,
+	}
+
+	return &controller{
+		podLister:         podInformer.Lister(),
+		certificateLister: certificateInformer.Lister(),
+		kubeClient:        ctx.Client,
+		clock:             ctx.Clock,
+	}, queue, mustSync, nil
+}
+
+// podCertificateIndexFunc indexes a Pod by the namespaced name of the
+// Certificate it references, if any.
+func podCertificateIndexFunc(obj interface{}) ([]string, error) {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return nil, nil
+	}
+	crtName, ok := pod.Annotations[cmapi.PodReadinessGateCertificateAnnotationKey]
+	if !ok {
+		return nil, nil
+	}
+	return []string{pod.Namespace + "/" + crtName}, nil
+}
+
+// enqueueAnnotatedPod enqueues the given Pod if it references a Certificate.
+func enqueueAnnotatedPod(log logr.Logger, queue workqueue.RateLimitingInterface) func(obj interface{}) {
+	return func(obj interface{}) {
+		pod, ok := obj.(*corev1.Pod)
+		if !ok {
+			tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+			if !ok {
+				log.Error(nil, "object is not a Pod", "object", obj)
+				return
+			}
+			if pod, ok = tombstone.Obj.(*corev1.Pod); !ok {
+				log.Error(nil, "tombstone object is not a Pod", "object", obj)
+				return
+			}
+		}
+		if _, ok := pod.Annotations[cmapi.PodReadinessGateCertificateAnnotationKey]; !ok {
+			return
+		}
+
+		key, err := controllerpkg.KeyFunc(pod)
+		if err != nil {
+			log.Error(err, "error computing key for resource")
+			return
+		}
+		queue.Add(key)
+	}
+}
+
+// enqueuePodsForCertificate enqueues all Pods which reference the given
+// Certificate.
+func enqueuePodsForCertificate(log logr.Logger, queue workqueue.RateLimitingInterface, podIndexer cache.Indexer) func(obj interface{}) {
+	return func(obj interface{}) {
+		crt, ok := obj.(*cmapi.Certificate)
+		if !ok {
+			tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+			if !ok {
+				log.Error(nil, "object is not a Certificate", "object", obj)
+				return
+			}
+			if crt, ok = tombstone.Obj.(*cmapi.Certificate); !ok {
+				log.Error(nil, "tombstone object is not a Certificate", "object", obj)
+				return
+			}
+		}
+
+		pods, err := podIndexer.ByIndex(certificateIndex, crt.Namespace+"/"+crt.Name)
+		if err != nil {
+			log.Error(err, "failed listing Pods referencing Certificate", "namespace", crt.Namespace, "name", crt.Name)
+			return
+		}
+		for _, pod := range pods {
+			key, err := controllerpkg.KeyFunc(pod)
+			if err != nil {
+				log.Error(err, "error computing key for resource")
+				continue
+			}
+			queue.Add(key)
+		}
+	}
+}
+
+// ProcessItem is a worker function that will be called when a new key
+// corresponding to a Pod to be re-synced is pulled from the workqueue.
+// ProcessItem will update the certificate readiness gate condition of the Pod.
+func (c *controller) ProcessItem(ctx context.Context, key string) error {
+	log := logf.FromContext(ctx).WithValues("key", key)
+
+	ctx = logf.NewContext(ctx, log)
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		log.Error(err, "invalid resource key passed to ProcessItem")
+		return nil
+	}
+
+	pod, err := c.podLister.Pods(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		log.V(logf.DebugLevel).Info("pod not found for key", "error", err.Error())
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	crtName, ok := pod.Annotations[cmapi.PodReadinessGateCertificateAnnotationKey]
+	if !ok {
+		return nil
+	}
+	if !hasReadinessGate(pod) {
+		log.V(logf.DebugLevel).Info("pod references a certificate but does not declare the readiness gate, skipping",
+			"conditionType", cmapi.PodCertificateReadyConditionType)
+		return nil
+	}
+
+	crt, err := c.certificateLister.Certificates(namespace).Get(crtName)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	status, reason, message := certificateReadiness(crtName, crt)
+	if cond := podCondition(pod); cond != nil && cond.Status == status && cond.Reason == reason && cond.Message == message {
+		return nil
+	}
+
+	log.V(logf.DebugLevel).Info("updating pod readiness gate condition", "certificate", crtName, "status", status, "reason", reason)
+	pod = pod.DeepCopy()
+	setPodCondition(pod, corev1.PodCondition{
+		Type:               cmapi.PodCertificateReadyConditionType,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: metav1.NewTime(c.clock.Now()),
+	})
+	_, err = c.kubeClient.CoreV1().Pods(pod.Namespace).UpdateStatus(ctx, pod, metav1.UpdateOptions{})
+	return err
+}
+
+// certificateReadiness returns the status, reason and message of the Pod
+// condition for the given Certificate, which is nil if it does not exist.
+func certificateReadiness(name string, crt *cmapi.Certificate) (corev1.ConditionStatus, string, string) {
+	if crt == nil {
+		return corev1.ConditionFalse, reasonCertificateNotFound, fmt.Sprintf("Certificate %q does not exist", name)
+	}
+	if apiutil.CertificateHasCondition(crt, cmapi.CertificateCondition{
+		Type:   cmapi.CertificateConditionReady,
+		Status: cmmeta.ConditionTrue,
+	}) {
+		return corev1.ConditionTrue, reasonCertificateReady, fmt.Sprintf("Certificate %q is Ready", name)
+	}
+	return corev1.ConditionFalse, reasonCertificateNotReady, fmt.Sprintf("Certificate %q is not Ready", name)
+}
+
+// hasReadinessGate returns true if the Pod declares the certificate readiness
+// gate. The kubelet ignores conditions which are not declared as readiness
+// gates when computing the readiness of a Pod.
+func hasReadinessGate(pod *corev1.Pod) bool {
+	for _, gate := range pod.Spec.ReadinessGates {
+		if gate.ConditionType == cmapi.PodCertificateReadyConditionType {
+			return true
+		}
+	}
+	return false
+}
+
+func podCondition(pod *corev1.Pod) *corev1.PodCondition {
+	for i, cond := range pod.Status.Conditions {
+		if cond.Type == cmapi.PodCertificateReadyConditionType {
+			return &pod.Status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// setPodCondition sets the given condition on the Pod, keeping the last
+// transition time of an existing condition if its status is unchanged.
+func setPodCondition(pod *corev1.Pod, condition corev1.PodCondition) {
+	existing := podCondition(pod)
+	if existing == nil {
+		pod.Status.Conditions = append(pod.Status.Conditions, condition)
+		return
+	}
+	if existing.Status == condition.Status {
+		condition.LastTransitionTime = existing.LastTransitionTime
+	}
+	*existing = condition
+}
+
+// controllerWrapper wraps the `controller` structure to make it implement
+// the controllerpkg.queueingController interface
+type controllerWrapper struct {
+	*controller
+}
+
+func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.RateLimitingInterface, []cache.InformerSynced, error) {
+	// construct a new named logger to be reused throughout the controller
+	log := logf.FromContext(ctx.RootContext, ControllerName)
+
+	ctrl, queue, mustSync, err := NewController(log, ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	c.controller = ctrl
+
+	return queue, mustSync, nil
+}
+
+func init() {
+	controllerpkg.Register(ControllerName, func(ctx *controllerpkg.ContextFactory) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, ControllerName).
+			For(&controllerWrapper{}).
+			Complete()
+	})
+}
@@ -0,0 +1,229 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podreadiness
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestProcessItem(t *testing.T) {
+	now := metav1.NewTime(time.Now().Truncate(time.Second))
+	earlier := metav1.NewTime(now.Add(-time.Hour))
+
+	readyCrt := gen.Certificate("test-crt",
+		gen.SetCertificateNamespace("test-ns"),
+		gen.SetCertificateStatusCondition(cmapi.CertificateCondition{Type: cmapi.CertificateConditionReady, Status: cmmeta.ConditionTrue}),
+	)
+	notReadyCrt := gen.CertificateFrom(readyCrt,
+		gen.SetCertificateStatusCondition(cmapi.CertificateCondition{Type: cmapi.CertificateConditionReady, Status: cmmeta.ConditionFalse}),
+	)
+
+	readyCondition := corev1.PodCondition{
+		Type:               cmapi.PodCertificateReadyConditionType,
+		Status:             corev1.ConditionTrue,
+		Reason:             reasonCertificateReady,
+		Message:            `Certificate "test-crt" is Ready`,
+		LastTransitionTime: now,
+	}
+	notReadyCondition := corev1.PodCondition{
+		Type:               cmapi.PodCertificateReadyConditionType,
+		Status:             corev1.ConditionFalse,
+		Reason:             reasonCertificateNotReady,
+		Message:            `Certificate "test-crt" is not Ready`,
+		LastTransitionTime: now,
+	}
+	notFoundCondition := corev1.PodCondition{
+		Type:               cmapi.PodCertificateReadyConditionType,
+		Status:             corev1.ConditionFalse,
+		Reason:             reasonCertificateNotFound,
+		Message:            `Certificate "test-crt" does not exist`,
+		LastTransitionTime: now,
+	}
+
+	tests := map[string]struct {
+		pod  *corev1.Pod
+		cert *cmapi.Certificate
+
+		expectedCondition *corev1.PodCondition
+	}{
+		"should do nothing if the pod does not reference a certificate": {
+			pod: pod(withReadinessGate),
+		},
+		"should do nothing if the pod does not declare the readiness gate": {
+			pod:  pod(withCertificateAnnotation("test-crt")),
+			cert: readyCrt,
+		},
+		"should set the condition to true if the certificate is ready": {
+			pod:               pod(withCertificateAnnotation("test-crt"), withReadinessGate),
+			cert:              readyCrt,
+			expectedCondition: &readyCondition,
+		},
+		"should set the condition to false if the certificate is not ready": {
+			pod:               pod(withCertificateAnnotation("test-crt"), withReadinessGate),
+			cert:              notReadyCrt,
+			expectedCondition: &notReadyCondition,
+		},
+		"should set the condition to false if the certificate does not exist": {
+			pod:               pod(withCertificateAnnotation("test-crt"), withReadinessGate),
+			expectedCondition: &notFoundCondition,
+		},
+		"should flip the condition to true once the certificate becomes ready": {
+			pod:               pod(withCertificateAnnotation("test-crt"), withReadinessGate, withCondition(notReadyCondition, earlier)),
+			cert:              readyCrt,
+			expectedCondition: &readyCondition,
+		},
+		"should flip the condition to false if the certificate stops being ready": {
+			pod:               pod(withCertificateAnnotation("test-crt"), withReadinessGate, withCondition(readyCondition, earlier)),
+			cert:              notReadyCrt,
+			expectedCondition: &notReadyCondition,
+		},
+		"should keep the last transition time if only the reason changes": {
+			pod:  pod(withCertificateAnnotation("test-crt"), withReadinessGate, withCondition(notFoundCondition, earlier)),
+			cert: notReadyCrt,
+			expectedCondition: func(c corev1.PodCondition) *corev1.PodCondition {
+				c.LastTransitionTime = earlier
+				return &c
+			}(notReadyCondition),
+		},
+		"should do nothing if the condition is up to date": {
+			pod:  pod(withCertificateAnnotation("test-crt"), withReadinessGate, withCondition(readyCondition, earlier)),
+			cert: readyCrt,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{
+				T:           t,
+				Clock:       fakeclock.NewFakeClock(now.Time),
+				KubeObjects: []runtime.Object{test.pod},
+			}
+			if test.cert != nil {
+				builder.CertManagerObjects = append(builder.CertManagerObjects, test.cert)
+			}
+			if test.expectedCondition != nil {
+				expectedPod := test.pod.DeepCopy()
+				expectedPod.Status.Conditions = []corev1.PodCondition{*test.expectedCondition}
+				builder.ExpectedActions = append(builder.ExpectedActions,
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						corev1.SchemeGroupVersion.WithResource("pods"),
+						"status",
+						expectedPod.Namespace,
+						expectedPod)))
+			}
+			builder.Init()
+
+			w := &controllerWrapper{}
+			if _, _, err := w.Register(builder.Context); err != nil {
+				t.Fatal(err)
+			}
+
+			builder.Start()
+			defer builder.Stop()
+
+			if err := w.controller.ProcessItem(context.Background(), "test-ns/test-pod"); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			if err := builder.AllActionsExecuted(); err != nil {
+				builder.T.Error(err)
+			}
+		})
+	}
+}
+
+func TestEnqueuePodsForCertificate(t *testing.T) {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{certificateIndex: podCertificateIndexFunc})
+	for _, p := range []*corev1.Pod{
+		pod(withName("referencing"), withCertificateAnnotation("test-crt")),
+		pod(withName("other-certificate"), withCertificateAnnotation("other-crt")),
+		pod(withName("other-namespace"), withCertificateAnnotation("test-crt"), func(p *corev1.Pod) { p.Namespace = "other-ns" }),
+		pod(withName("not-annotated")),
+	} {
+		if err := indexer.Add(p); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	queue := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+	defer queue.ShutDown()
+
+	enqueuePodsForCertificate(logr.Discard(), queue, indexer)(gen.Certificate("test-crt", gen.SetCertificateNamespace("test-ns")))
+
+	if queue.Len() != 1 {
+		t.Fatalf("expected 1 queued Pod, got %d", queue.Len())
+	}
+	if key, _ := queue.Get(); key != "test-ns/referencing" {
+		t.Errorf("expected Pod %q to be queued, got %q", "test-ns/referencing", key)
+	}
+}
+
+type podModifier func(*corev1.Pod)
+
+func pod(mods ...podModifier) *corev1.Pod {
+	p := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-pod", Namespace: "test-ns"},
+	}
+	for _, mod := range mods {
+		mod(p)
+	}
+	return p
+}
+
+func withName(name string) podModifier {
+	return func(p *corev1.Pod) {
+		p.Name = name
+	}
+}
+
+func withCertificateAnnotation(name string) podModifier {
+	return func(p *corev1.Pod) {
+		if p.Annotations == nil {
+			p.Annotations = make(map[string]string)
+		}
+		p.Annotations[cmapi.PodReadinessGateCertificateAnnotationKey] = name
+	}
+}
+
+func withReadinessGate(p *corev1.Pod) {
+	p.Spec.ReadinessGates = append(p.Spec.ReadinessGates, corev1.PodReadinessGate{
+		ConditionType: cmapi.PodCertificateReadyConditionType,
+	})
+}
+
+func withCondition(c corev1.PodCondition, lastTransitionTime metav1.Time) podModifier {
+	return func(p *corev1.Pod) {
+		c.LastTransitionTime = lastTransitionTime
+		p.Status.Conditions = append(p.Status.Conditions, c)
+	}
+}
@@ -18,6 +18,7 @@ package readiness
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -28,6 +29,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
 
 	internalcertificates "github.com/cert-manager/cert-manager/internal/controller/certificates"
 	"github.com/cert-manager/cert-manager/internal/controller/certificates/policies"
@@ -41,6 +43,7 @@ import (
 	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/metrics"
 	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
 	"github.com/cert-manager/cert-manager/pkg/util/predicate"
@@ -66,6 +69,9 @@ type controller struct {
 	// renewalTimeCalculator calculates renewal time of a certificate
 	renewalTimeCalculator pki.RenewalTimeFunc
 
+	metrics *metrics.Metrics
+	clock   clock.Clock
+
 	// fieldManager is the string which will be used as the Field Manager on
 	// fields created or edited by the cert-manager Kubernetes client during
 	// Apply API calls.
@@ -124,6 +130,8 @@ func NewController(
 		},
 		policyEvaluator:       policyEvaluator,
 		renewalTimeCalculator: renewalTimeCalculator,
+		metrics:               ctx.Metrics,
+		clock:                 ctx.Clock,
 		fieldManager:          ctx.FieldManager,
 	}, queue, mustSync
 }
@@ -160,6 +168,13 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 	crt = crt.DeepCopy()
 	apiutil.SetCertificateCondition(crt, crt.Generation, condition.Type, condition.Status, condition.Reason, condition.Message)
 
+	if certificates.IsPaused(crt) {
+		apiutil.SetCertificateCondition(crt, crt.Generation, cmapi.CertificateConditionPaused, cmmeta.ConditionTrue, "Paused",
+			fmt.Sprintf("Reconciliation is paused as the %q annotation is set", cmapi.CertificatePausedAnnotationKey))
+	} else {
+		apiutil.RemoveCertificateCondition(crt, cmapi.CertificateConditionPaused)
+	}
+
 	switch {
 	case input.Secret != nil && input.Secret.Data != nil:
 		x509cert, err := pki.DecodeX509CertificateBytes(input.Secret.Data[corev1.TLSCertKey])
@@ -174,6 +189,10 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 		notBefore := metav1.NewTime(x509cert.NotBefore)
 		notAfter := metav1.NewTime(x509cert.NotAfter)
 		renewalTime := c.renewalTimeCalculator(x509cert.NotBefore, x509cert.NotAfter, crt.Spec.RenewBefore, crt.Spec.RenewBeforePercentage)
+		// Prefer the renewal window suggested by the ACME server, if known.
+		if ariRenewalTime := pki.RenewalInfoTime(x509cert, crt.Status.RenewalInfo); ariRenewalTime != nil {
+			renewalTime = ariRenewalTime
+		}
 
 		// update Certificate's Status
 		crt.Status.NotBefore = &notBefore
@@ -190,11 +209,45 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 		log.V(logf.DebugLevel).Info("updating status fields", "notAfter",
 			crt.Status.NotAfter, "notBefore", crt.Status.NotBefore, "renewalTime",
 			crt.Status.RenewalTime)
-		return c.updateOrApplyStatus(ctx, crt)
+		if err := c.updateOrApplyStatus(ctx, crt); err != nil {
+			return err
+		}
+		if duration, renewal, ok := issuanceDuration(oldCrt, crt, input.CurrentRevisionRequest, c.clock.Now()); ok {
+			c.metrics.ObserveCertificateReadyDuration(crt, renewal, duration)
+		}
 	}
 	return nil
 }
 
+// issuanceDuration returns the time taken for a newly issued certificate to
+// become Ready, and whether the issuance was a renewal. An initial issuance is
+// measured from the creation of the Certificate, and a renewal from the
+// creation of the CertificateRequest which was issued. ok is false unless the
+// Certificate is Ready and the NotBefore of its stored certificate has
+// changed, so that a Certificate which becomes Ready again without a new
+// certificate, for example after its Secret has been repaired, is not
+// observed. The status does not record the serial number of the stored
+// certificate, so a new certificate is detected by its NotBefore.
+func issuanceDuration(oldCrt, crt *cmapi.Certificate, currentRequest *cmapi.CertificateRequest, now time.Time) (duration time.Duration, renewal bool, ok bool) {
+	ready := cmapi.CertificateCondition{Type: cmapi.CertificateConditionReady, Status: cmmeta.ConditionTrue}
+	if !apiutil.CertificateHasCondition(crt, ready) || crt.Status.NotBefore == nil {
+		return 0, false, false
+	}
+	if oldCrt.Status.NotBefore != nil && oldCrt.Status.NotBefore.Equal(crt.Status.NotBefore) {
+		return 0, false, false
+	}
+
+	renewal = crt.Status.Revision != nil && *crt.Status.Revision > 1
+	start := crt.CreationTimestamp.Time
+	if renewal {
+		if currentRequest == nil {
+			return 0, false, false
+		}
+		start = currentRequest.CreationTimestamp.Time
+	}
+	return now.Sub(start), renewal, true
+}
+
 // updateOrApplyStatus will update the controller status. If the
 // ServerSideApply feature is enabled, the managed fields will instead get
 // applied using the relevant Patch API call.
@@ -204,6 +257,9 @@ func (c *controller) updateOrApplyStatus(ctx context.Context, crt *cmapi.Certifi
 		if cond := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionReady); cond != nil {
 			conditions = []cmapi.CertificateCondition{*cond}
 		}
+		if cond := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionPaused); cond != nil {
+			conditions = append(conditions, *cond)
+		}
 		return internalcertificates.ApplyStatus(ctx, c.client, c.fieldManager, &cmapi.Certificate{
 			ObjectMeta: metav1.ObjectMeta{Namespace: crt.Namespace, Name: crt.Name},
 			Status: cmapi.CertificateStatus{
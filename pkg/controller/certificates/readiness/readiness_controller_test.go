@@ -23,10 +23,12 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	coretesting "k8s.io/client-go/testing"
 	fakeclock "k8s.io/utils/clock/testing"
 
 	"github.com/cert-manager/cert-manager/internal/controller/certificates/policies"
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
@@ -85,6 +87,10 @@ func TestProcessItem(t *testing.T) {
 		// Certificate's Ready condition to be applied with the update
 		condition cmapi.CertificateCondition
 
+		// Certificate's Paused condition to be applied with the update. If
+		// nil, the Paused condition is expected to be removed.
+		pausedCondition *cmapi.CertificateCondition
+
 		// whether secret should be loaded into the fake clientset
 		// if notAfter, notBefore and renewalTime are set, an X509 cert will also be built and
 		// added as tls.crt value to the secret data
@@ -235,6 +241,59 @@ func TestProcessItem(t *testing.T) {
 			secretShouldExist: true,
 			certShouldUpdate:  false,
 		},
+		"set the Paused condition for a Certificate that is paused": {
+			condition: cmapi.CertificateCondition{
+				Type:               cmapi.CertificateConditionReady,
+				Status:             cmmeta.ConditionTrue,
+				Reason:             ReadyReason,
+				Message:            "ready message",
+				LastTransitionTime: &metaNow,
+			},
+			pausedCondition: &cmapi.CertificateCondition{
+				Type:               cmapi.CertificateConditionPaused,
+				Status:             cmmeta.ConditionTrue,
+				Reason:             "Paused",
+				Message:            `Reconciliation is paused as the "cert-manager.io/paused" annotation is set`,
+				LastTransitionTime: &metaNow,
+			},
+			cert: gen.CertificateFrom(cert,
+				gen.AddCertificateAnnotations(map[string]string{cmapi.CertificatePausedAnnotationKey: "true"}),
+				gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+					Type:               cmapi.CertificateConditionReady,
+					Status:             cmmeta.ConditionTrue,
+					Reason:             ReadyReason,
+					Message:            "ready message",
+					LastTransitionTime: &metaNow,
+				})),
+			secretShouldExist: true,
+			certShouldUpdate:  true,
+		},
+		"remove the Paused condition for a Certificate that is no longer paused": {
+			condition: cmapi.CertificateCondition{
+				Type:               cmapi.CertificateConditionReady,
+				Status:             cmmeta.ConditionTrue,
+				Reason:             ReadyReason,
+				Message:            "ready message",
+				LastTransitionTime: &metaNow,
+			},
+			cert: gen.CertificateFrom(cert,
+				gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+					Type:               cmapi.CertificateConditionReady,
+					Status:             cmmeta.ConditionTrue,
+					Reason:             ReadyReason,
+					Message:            "ready message",
+					LastTransitionTime: &metaNow,
+				}),
+				gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+					Type:               cmapi.CertificateConditionPaused,
+					Status:             cmmeta.ConditionTrue,
+					Reason:             "Paused",
+					Message:            `Reconciliation is paused as the "cert-manager.io/paused" annotation is set`,
+					LastTransitionTime: &metaNow,
+				})),
+			secretShouldExist: true,
+			certShouldUpdate:  true,
+		},
 	}
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -284,6 +343,11 @@ func TestProcessItem(t *testing.T) {
 			if test.certShouldUpdate {
 				c := gen.CertificateFrom(test.cert,
 					gen.SetCertificateStatusCondition(test.condition))
+				if test.pausedCondition != nil {
+					c = gen.CertificateFrom(c, gen.SetCertificateStatusCondition(*test.pausedCondition))
+				} else {
+					apiutil.RemoveCertificateCondition(c, cmapi.CertificateConditionPaused)
+				}
 
 				// gen package functions don't accept pointers- we need to test setting these values to nil in some scenarios.
 				c.Status.NotAfter = test.notAfter
@@ -533,3 +597,197 @@ func TestNewReadinessPolicyChain(t *testing.T) {
 		})
 	}
 }
+
+func TestIssuanceDuration(t *testing.T) {
+	now := time.Now().UTC()
+	created := metav1.NewTime(now.Add(-time.Minute))
+	oldNotBefore := metav1.NewTime(now.Add(-time.Hour * 24).Truncate(time.Second))
+	newNotBefore := metav1.NewTime(now.Truncate(time.Second))
+	ready := cmapi.CertificateCondition{Type: cmapi.CertificateConditionReady, Status: cmmeta.ConditionTrue}
+	notReady := cmapi.CertificateCondition{Type: cmapi.CertificateConditionReady, Status: cmmeta.ConditionFalse}
+	request := &cmapi.CertificateRequest{ObjectMeta: metav1.ObjectMeta{Name: "test-1", CreationTimestamp: metav1.NewTime(now.Add(-time.Second * 10))}}
+
+	crt := func(condition cmapi.CertificateCondition, notBefore *metav1.Time, revision int) *cmapi.Certificate {
+		c := gen.Certificate("test",
+			gen.SetCertificateCreationTimestamp(created),
+			gen.SetCertificateStatusCondition(condition),
+			gen.SetCertificateRevision(revision),
+		)
+		c.Status.NotBefore = notBefore
+		return c
+	}
+
+	tests := map[string]struct {
+		oldCrt, crt    *cmapi.Certificate
+		currentRequest *cmapi.CertificateRequest
+		expDuration    time.Duration
+		expRenewal     bool
+		expOK          bool
+	}{
+		"initial issuance is measured from the creation of the Certificate": {
+			oldCrt:         crt(notReady, nil, 1),
+			crt:            crt(ready, &newNotBefore, 1),
+			currentRequest: request,
+			expDuration:    time.Minute,
+			expRenewal:     false,
+			expOK:          true,
+		},
+		"renewal is measured from the creation of the CertificateRequest": {
+			oldCrt:         crt(ready, &oldNotBefore, 2),
+			crt:            crt(ready, &newNotBefore, 2),
+			currentRequest: request,
+			expDuration:    time.Second * 10,
+			expRenewal:     true,
+			expOK:          true,
+		},
+		"renewal without a CertificateRequest is not observed": {
+			oldCrt: crt(ready, &oldNotBefore, 2),
+			crt:    crt(ready, &newNotBefore, 2),
+			expOK:  false,
+		},
+		"a Certificate which was already Ready with the same certificate is not observed": {
+			oldCrt:         crt(ready, &newNotBefore, 2),
+			crt:            crt(ready, &newNotBefore, 2),
+			currentRequest: request,
+			expOK:          false,
+		},
+		"a Certificate which becomes Ready again with the same certificate is not observed": {
+			oldCrt:         crt(notReady, &newNotBefore, 2),
+			crt:            crt(ready, &newNotBefore, 2),
+			currentRequest: request,
+			expOK:          false,
+		},
+		"a Certificate which is not Ready is not observed": {
+			oldCrt:         crt(notReady, nil, 1),
+			crt:            crt(notReady, &newNotBefore, 1),
+			currentRequest: request,
+			expOK:          false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			duration, renewal, ok := issuanceDuration(test.oldCrt, test.crt, test.currentRequest, now)
+			if ok != test.expOK {
+				t.Fatalf("expected ok=%t, got %t", test.expOK, ok)
+			}
+			if !ok {
+				return
+			}
+			if renewal != test.expRenewal {
+				t.Errorf("expected renewal=%t, got %t", test.expRenewal, renewal)
+			}
+			if duration != test.expDuration {
+				t.Errorf("expected duration %s, got %s", test.expDuration, duration)
+			}
+		})
+	}
+}
+
+func TestProcessItemRenewalInfo(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	staticRenewalTime := metav1.NewTime(now.Add(time.Hour))
+	ariRenewalTime := metav1.NewTime(now.Add(30 * time.Minute))
+	ready := cmapi.CertificateCondition{
+		Type:               cmapi.CertificateConditionReady,
+		Status:             cmmeta.ConditionTrue,
+		Reason:             ReadyReason,
+		Message:            "ready message",
+		LastTransitionTime: &metav1.Time{Time: now},
+	}
+	cert := &cmapi.Certificate{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test"},
+		Spec: cmapi.CertificateSpec{
+			SecretName: "test-secret",
+			DNSNames:   []string{"example.com"},
+		},
+	}
+
+	// Build a certificate with an Authority Key Identifier, from which its
+	// ARI identifier is derived.
+	pk, err := pki.DecodePrivateKeyBytes(testcrypto.MustCreatePEMPrivateKey(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	template, err := pki.CertificateTemplateFromCertificate(cert)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template.NotBefore = now
+	template.NotAfter = now.Add(2 * time.Hour)
+	template.AuthorityKeyId = []byte{1, 2, 3, 4}
+	certPEM, x509Cert, err := pki.SignCertificate(template, template, pk.Public(), pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certID, err := pki.ARICertID(x509Cert)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		renewalInfo         cmapi.CertificateRenewalInfo
+		expectedRenewalTime metav1.Time
+	}{
+		"renewal time is taken from the suggested window of the current certificate": {
+			renewalInfo: cmapi.CertificateRenewalInfo{
+				CertID:               certID,
+				SuggestedWindowStart: ariRenewalTime,
+				SuggestedWindowEnd:   ariRenewalTime,
+			},
+			expectedRenewalTime: ariRenewalTime,
+		},
+		"renewal information for a previous certificate is ignored": {
+			renewalInfo: cmapi.CertificateRenewalInfo{
+				CertID:               "previous",
+				SuggestedWindowStart: ariRenewalTime,
+				SuggestedWindowEnd:   ariRenewalTime,
+			},
+			expectedRenewalTime: staticRenewalTime,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			crt := gen.CertificateFrom(cert, gen.SetCertificateRenewalInfo(test.renewalInfo))
+			builder := &testpkg.Builder{
+				T:                  t,
+				Clock:              fakeclock.NewFakeClock(now),
+				CertManagerObjects: []runtime.Object{crt},
+				KubeObjects: []runtime.Object{&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test-secret"},
+					Data:       map[string][]byte{corev1.TLSCertKey: certPEM},
+				}},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						crt.Namespace,
+						gen.CertificateFrom(crt,
+							gen.SetCertificateStatusCondition(ready),
+							gen.SetCertificateNotBefore(metav1.NewTime(now)),
+							gen.SetCertificateNotAfter(metav1.NewTime(now.Add(2*time.Hour))),
+							gen.SetCertificateRenewalTime(test.expectedRenewalTime),
+						))),
+				},
+			}
+			builder.Init()
+
+			w := &controllerWrapper{}
+			if _, _, err := w.Register(builder.Context); err != nil {
+				t.Fatal(err)
+			}
+			w.controller.policyEvaluator = policyEvaluatorBuilder(ready)
+			w.controller.renewalTimeCalculator = renewalTimeBuilder(&staticRenewalTime)
+
+			builder.Start()
+			defer builder.Stop()
+
+			if err := w.controller.ProcessItem(context.Background(), "testns/test"); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if err := builder.AllActionsExecuted(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,300 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package renewalinfo
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
+
+	internalcertificates "github.com/cert-manager/cert-manager/internal/controller/certificates"
+	"github.com/cert-manager/cert-manager/internal/controller/feature"
+	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
+	"github.com/cert-manager/cert-manager/pkg/acme/accounts"
+	acmecl "github.com/cert-manager/cert-manager/pkg/acme/client"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
+	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates"
+	"github.com/cert-manager/cert-manager/pkg/issuer"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/scheduler"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/cert-manager/cert-manager/pkg/util/predicate"
+)
+
+const (
+	// ControllerName is the name of the certificate ACME renewal information
+	// controller.
+	ControllerName = "certificates-acme-renewal-info"
+
+	// defaultRetryAfter is how long to wait before fetching renewal
+	// information again if the ACME server does not set Retry-After, as
+	// recommended by RFC 9773 section 4.3.3.
+	defaultRetryAfter = 6 * time.Hour
+
+	// minRetryAfter and maxRetryAfter bound the Retry-After advised by the
+	// ACME server, so that a misbehaving server can neither cause renewal
+	// information to be fetched in a tight loop nor stop it being fetched.
+	minRetryAfter = time.Minute
+	maxRetryAfter = 24 * time.Hour
+)
+
+// controller fetches ACME Renewal Information (ARI) for Certificates issued
+// by an ACME Issuer or ClusterIssuer and stores it on the Certificate's
+// status. The readiness controller then schedules renewal within the
+// window suggested by the ACME server instead of using `renewBefore`.
+type controller struct {
+	certificateLister cmlisters.CertificateLister
+	secretLister      internalinformers.SecretLister
+	helper            issuer.Helper
+	accountRegistry   accounts.Getter
+	client            cmclient.Interface
+
+	// scheduledWorkQueue is used to fetch renewal information again once
+	// the Retry-After advised by the ACME server has passed.
+	scheduledWorkQueue scheduler.ScheduledWorkQueue
+
+	clock clock.Clock
+
+	// fieldManager is the string which will be used as the Field Manager on
+	// fields created or edited by the cert-manager Kubernetes client during
+	// Apply API calls.
+	fieldManager string
+}
+
+// NewController returns a new certificate ACME renewal information controller.
+func NewController(
+	log logr.Logger,
+	ctx *controllerpkg.Context,
+) (*controller, workqueue.RateLimitingInterface, []cache.InformerSynced) {
+	// create a queue used to queue up items to be processed
+	queue := workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Second*1, time.Minute*5), ControllerName)
+
+	// obtain references to all the informers used by this controller
+	certificateInformer := ctx.SharedInformerFactory.Certmanager().V1().Certificates()
+	secretsInformer := ctx.KubeSharedInformerFactory.Secrets()
+	issuerInformer := ctx.SharedInformerFactory.Certmanager().V1().Issuers()
+
+	certificateInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: queue})
+	// When a Secret resource changes, enqueue any Certificate resources that name it as spec.secretName.
+	secretsInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+		WorkFunc: certificates.EnqueueCertificatesForResourceUsingPredicates(log, queue, certificateInformer.Lister(), labels.Everything(),
+			predicate.ExtractResourceName(predicate.CertificateSecretName)),
+	})
+
+	// build a list of InformerSynced functions that will be returned by the Register method.
+	// the controller will only begin processing items once all of these informers have synced.
+	mustSync := []cache.InformerSynced{
+		certificateInformer.Informer().HasSynced,
+		secretsInformer.Informer().HasSynced,
+		issuerInformer.Informer().HasSynced,
+	}
+
+	// ClusterIssuers can only be read if cert-manager is not scoped to a
+	// single namespace.
+	var clusterIssuerLister cmlisters.ClusterIssuerLister
+	if ctx.Namespace == "" {
+		clusterIssuerInformer := ctx.SharedInformerFactory.Certmanager().V1().ClusterIssuers()
+		mustSync = append(mustSync, clusterIssuerInformer.Informer().HasSynced)
+		clusterIssuerLister = clusterIssuerInformer.Lister()
+	}
+
+	return &controller{
+		certificateLister:  certificateInformer.Lister(),
+		secretLister:       secretsInformer.Lister(),
+		helper:             issuer.NewHelper(issuerInformer.Lister(), clusterIssuerLister),
+		accountRegistry:    ctx.AccountRegistry,
+		client:             ctx.CMClient,
+		scheduledWorkQueue: scheduler.NewScheduledWorkQueue(ctx.Clock, queue.Add),
+		clock:              ctx.Clock,
+		fieldManager:       ctx.FieldManager,
+	}, queue, mustSync
+}
+
+// ProcessItem is a worker function that will be called when a new key
+// corresponding to a Certificate to be re-synced is pulled from the workqueue.
+// ProcessItem will fetch the ACME renewal information of the Certificate's
+// current X.509 certificate if it is due to be fetched.
+func (c *controller) ProcessItem(ctx context.Context, key string) error {
+	log := logf.FromContext(ctx).WithValues("key", key)
+
+	ctx = logf.NewContext(ctx, log)
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		log.Error(err, "invalid resource key passed to ProcessItem")
+		return nil
+	}
+
+	crt, err := c.certificateLister.Certificates(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		log.V(logf.DebugLevel).Info("certificate not found for key", "error", err.Error())
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if certificates.IsPaused(crt) {
+		log.V(logf.DebugLevel).Info("Certificate is paused, skipping")
+		return nil
+	}
+
+	// Renewal information is only fetched for certificates issued by the
+	// built-in ACME issuer.
+	if group := crt.Spec.IssuerRef.Group; group != "" && group != cmapi.SchemeGroupVersion.Group {
+		return nil
+	}
+	genericIssuer, err := c.helper.GetGenericIssuer(crt.Spec.IssuerRef, crt.Namespace)
+	if apierrors.IsNotFound(err) {
+		log.V(logf.DebugLevel).Info("issuer not found, skipping", "issuer", crt.Spec.IssuerRef.Name)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if genericIssuer.GetSpec().ACME == nil {
+		return c.setRenewalInfo(ctx, crt, nil)
+	}
+
+	x509Cert, err := c.currentCertificate(crt)
+	if err != nil {
+		log.V(logf.DebugLevel).Info("certificate has not been issued or cannot be decoded, skipping", "error", err.Error())
+		return nil
+	}
+	certID, err := pki.ARICertID(x509Cert)
+	if err != nil {
+		log.V(logf.DebugLevel).Info("cannot identify certificate for ACME renewal information, skipping", "error", err.Error())
+		return c.setRenewalInfo(ctx, crt, nil)
+	}
+
+	// Honour the Retry-After advised by the ACME server when the renewal
+	// information for this certificate was last fetched.
+	if info := crt.Status.RenewalInfo; info != nil && info.CertID == certID && info.NextUpdateTime != nil {
+		if wait := info.NextUpdateTime.Time.Sub(c.clock.Now()); wait > 0 {
+			log.V(logf.DebugLevel).Info("renewal information is up to date, scheduling next update", "next_update_time", info.NextUpdateTime)
+			c.scheduledWorkQueue.Add(key, wait)
+			return nil
+		}
+	}
+
+	cl, err := c.accountRegistry.GetClient(string(genericIssuer.GetUID()))
+	if err != nil {
+		return err
+	}
+	ari, err := cl.RenewalInfo(ctx, certID)
+	if errors.Is(err, acmecl.ErrRenewalInfoNotSupported) {
+		log.V(logf.DebugLevel).Info("ACME server does not support renewal information, using the renewal time derived from the Certificate spec")
+		return c.setRenewalInfo(ctx, crt, nil)
+	}
+	if err != nil {
+		return fmt.Errorf("error fetching ACME renewal information: %w", err)
+	}
+
+	retryAfter := ari.RetryAfter
+	switch {
+	case retryAfter == 0:
+		retryAfter = defaultRetryAfter
+	case retryAfter < minRetryAfter:
+		retryAfter = minRetryAfter
+	case retryAfter > maxRetryAfter:
+		retryAfter = maxRetryAfter
+	}
+	nextUpdateTime := metav1.NewTime(c.clock.Now().Add(retryAfter).Truncate(time.Second))
+
+	log.V(logf.DebugLevel).Info("fetched ACME renewal information", "window_start", ari.SuggestedWindowStart,
+		"window_end", ari.SuggestedWindowEnd, "explanation_url", ari.ExplanationURL, "next_update_time", nextUpdateTime)
+	if err := c.setRenewalInfo(ctx, crt, &cmapi.CertificateRenewalInfo{
+		CertID:               certID,
+		SuggestedWindowStart: metav1.NewTime(ari.SuggestedWindowStart),
+		SuggestedWindowEnd:   metav1.NewTime(ari.SuggestedWindowEnd),
+		ExplanationURL:       ari.ExplanationURL,
+		NextUpdateTime:       &nextUpdateTime,
+	}); err != nil {
+		return err
+	}
+
+	c.scheduledWorkQueue.Add(key, retryAfter)
+	return nil
+}
+
+// currentCertificate returns the X.509 certificate stored in the
+// Certificate's Secret.
+func (c *controller) currentCertificate(crt *cmapi.Certificate) (*x509.Certificate, error) {
+	secret, err := c.secretLister.Secrets(crt.Namespace).Get(crt.Spec.SecretName)
+	if err != nil {
+		return nil, err
+	}
+	return pki.DecodeX509CertificateBytes(secret.Data[corev1.TLSCertKey])
+}
+
+// setRenewalInfo sets the renewal information on the Certificate's status,
+// updating the Certificate if it has changed.
+func (c *controller) setRenewalInfo(ctx context.Context, crt *cmapi.Certificate, info *cmapi.CertificateRenewalInfo) error {
+	if apiequality.Semantic.DeepEqual(crt.Status.RenewalInfo, info) {
+		return nil
+	}
+	crt = crt.DeepCopy()
+	crt.Status.RenewalInfo = info
+
+	if utilfeature.DefaultFeatureGate.Enabled(feature.ServerSideApply) {
+		return internalcertificates.ApplyStatus(ctx, c.client, c.fieldManager, &cmapi.Certificate{
+			ObjectMeta: metav1.ObjectMeta{Namespace: crt.Namespace, Name: crt.Name},
+			Status:     cmapi.CertificateStatus{RenewalInfo: info},
+		})
+	}
+	_, err := c.client.CertmanagerV1().Certificates(crt.Namespace).UpdateStatus(ctx, crt, metav1.UpdateOptions{})
+	return err
+}
+
+// controllerWrapper wraps the `controller` structure to make it implement
+// the controllerpkg.queueingController interface
+type controllerWrapper struct {
+	*controller
+}
+
+func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.RateLimitingInterface, []cache.InformerSynced, error) {
+	// construct a new named logger to be reused throughout the controller
+	log := logf.FromContext(ctx.RootContext, ControllerName)
+
+	ctrl, queue, mustSync := NewController(log, ctx)
+	c.controller = ctrl
+
+	return queue, mustSync, nil
+}
+
+func init() {
+	controllerpkg.Register(ControllerName, func(ctx *controllerpkg.ContextFactory) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, ControllerName).
+			For(&controllerWrapper{}).
+			Complete()
+	})
+}
@@ -0,0 +1,308 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package renewalinfo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/acme"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+
+	accountstest "github.com/cert-manager/cert-manager/pkg/acme/accounts/test"
+	acmecl "github.com/cert-manager/cert-manager/pkg/acme/client"
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	schedulertest "github.com/cert-manager/cert-manager/pkg/scheduler/test"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	testcrypto "github.com/cert-manager/cert-manager/test/unit/crypto"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+// fakeARIServer is an ACME server which only serves its directory and the
+// renewal information of a single certificate.
+type fakeARIServer struct {
+	certID     string
+	supported  bool
+	retryAfter string
+
+	windowStart, windowEnd time.Time
+
+	requests int
+}
+
+func (f *fakeARIServer) start(t *testing.T) *httptest.Server {
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/directory":
+			dir := map[string]interface{}{
+				"newNonce":   srv.URL + "/nonce",
+				"newAccount": srv.URL + "/account",
+				"newOrder":   srv.URL + "/order",
+			}
+			if f.supported {
+				dir["renewalInfo"] = srv.URL + "/renewal-info"
+			}
+			_ = json.NewEncoder(w).Encode(dir)
+		case "/renewal-info/" + f.certID:
+			f.requests++
+			if f.retryAfter != "" {
+				w.Header().Set("Retry-After", f.retryAfter)
+			}
+			fmt.Fprintf(w, `{"suggestedWindow":{"start":%q,"end":%q},"explanationURL":"https://acme.example.com/docs/ari"}`,
+				f.windowStart.Format(time.RFC3339), f.windowEnd.Format(time.RFC3339))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestProcessItem(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	windowStart := now.Add(24 * time.Hour)
+	windowEnd := now.Add(48 * time.Hour)
+
+	acmeIssuer := gen.Issuer("acme-issuer",
+		gen.SetIssuerNamespace("testns"),
+		gen.SetIssuerACME(cmacme.ACMEIssuer{Server: "https://acme.example.com/directory"}),
+	)
+	caIssuer := gen.Issuer("ca-issuer",
+		gen.SetIssuerNamespace("testns"),
+		gen.SetIssuerCA(cmapi.CAIssuer{SecretName: "ca"}),
+	)
+	crt := gen.Certificate("test",
+		gen.SetCertificateNamespace("testns"),
+		gen.SetCertificateSecretName("test-secret"),
+		gen.SetCertificateDNSNames("example.com"),
+		gen.SetCertificateIssuer(cmmeta.ObjectReference{Name: "acme-issuer", Kind: cmapi.IssuerKind}),
+	)
+
+	certPEM, certID := mustCreateCertWithAuthorityKeyID(t, crt, []byte{1, 2, 3, 4})
+	certWithoutAKIPEM, _ := mustCreateCertWithAuthorityKeyID(t, crt, nil)
+	secret := func(certPEM []byte) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test-secret"},
+			Data:       map[string][]byte{corev1.TLSCertKey: certPEM},
+		}
+	}
+	renewalInfo := func(certID string, nextUpdateTime time.Time) cmapi.CertificateRenewalInfo {
+		return cmapi.CertificateRenewalInfo{
+			CertID:               certID,
+			SuggestedWindowStart: metav1.NewTime(windowStart),
+			SuggestedWindowEnd:   metav1.NewTime(windowEnd),
+			ExplanationURL:       "https://acme.example.com/docs/ari",
+			NextUpdateTime:       &metav1.Time{Time: nextUpdateTime},
+		}
+	}
+
+	tests := map[string]struct {
+		certificate *cmapi.Certificate
+		secret      *corev1.Secret
+		server      fakeARIServer
+
+		expectedRenewalInfo *cmapi.CertificateRenewalInfo
+		// expectUpdate is true if the Certificate's status should be updated
+		// with expectedRenewalInfo.
+		expectUpdate      bool
+		expectedRequests  int
+		expectedScheduled time.Duration
+	}{
+		"fetch renewal information and schedule the next update after Retry-After": {
+			certificate:         crt,
+			secret:              secret(certPEM),
+			server:              fakeARIServer{supported: true, retryAfter: "21600"},
+			expectUpdate:        true,
+			expectedRenewalInfo: ptr.To(renewalInfo(certID, now.Add(6*time.Hour))),
+			expectedRequests:    1,
+			expectedScheduled:   6 * time.Hour,
+		},
+		"use the default update interval if Retry-After is not set": {
+			certificate:         crt,
+			secret:              secret(certPEM),
+			server:              fakeARIServer{supported: true},
+			expectUpdate:        true,
+			expectedRenewalInfo: ptr.To(renewalInfo(certID, now.Add(defaultRetryAfter))),
+			expectedRequests:    1,
+			expectedScheduled:   defaultRetryAfter,
+		},
+		"clamp a Retry-After which is too short": {
+			certificate:         crt,
+			secret:              secret(certPEM),
+			server:              fakeARIServer{supported: true, retryAfter: "1"},
+			expectUpdate:        true,
+			expectedRenewalInfo: ptr.To(renewalInfo(certID, now.Add(minRetryAfter))),
+			expectedRequests:    1,
+			expectedScheduled:   minRetryAfter,
+		},
+		"do not fetch renewal information before the next update time": {
+			certificate:       gen.CertificateFrom(crt, gen.SetCertificateRenewalInfo(renewalInfo(certID, now.Add(time.Hour)))),
+			secret:            secret(certPEM),
+			server:            fakeARIServer{supported: true},
+			expectedScheduled: time.Hour,
+		},
+		"fetch renewal information if the stored information is for a previous certificate": {
+			certificate:         gen.CertificateFrom(crt, gen.SetCertificateRenewalInfo(renewalInfo("previous", now.Add(time.Hour)))),
+			secret:              secret(certPEM),
+			server:              fakeARIServer{supported: true, retryAfter: "21600"},
+			expectUpdate:        true,
+			expectedRenewalInfo: ptr.To(renewalInfo(certID, now.Add(6*time.Hour))),
+			expectedRequests:    1,
+			expectedScheduled:   6 * time.Hour,
+		},
+		"clear renewal information if the ACME server does not support it": {
+			certificate:  gen.CertificateFrom(crt, gen.SetCertificateRenewalInfo(renewalInfo("previous", now.Add(-time.Hour)))),
+			secret:       secret(certPEM),
+			server:       fakeARIServer{},
+			expectUpdate: true,
+		},
+		"do nothing if the ACME server does not support renewal information": {
+			certificate: crt,
+			secret:      secret(certPEM),
+			server:      fakeARIServer{},
+		},
+		"do nothing if the certificate is paused": {
+			certificate: gen.CertificateFrom(crt,
+				gen.AddCertificateAnnotations(map[string]string{cmapi.CertificatePausedAnnotationKey: "true"})),
+			secret: secret(certPEM),
+			server: fakeARIServer{supported: true, retryAfter: "21600"},
+		},
+		"do nothing if the certificate has not been issued": {
+			certificate: crt,
+			server:      fakeARIServer{supported: true},
+		},
+		"do nothing if the certificate does not have an authority key identifier": {
+			certificate: crt,
+			secret:      secret(certWithoutAKIPEM),
+			server:      fakeARIServer{supported: true},
+		},
+		"do nothing if the certificate is not issued by an ACME issuer": {
+			certificate: gen.CertificateFrom(crt, gen.SetCertificateIssuer(cmmeta.ObjectReference{Name: "ca-issuer", Kind: cmapi.IssuerKind})),
+			secret:      secret(certPEM),
+			server:      fakeARIServer{supported: true},
+		},
+		"do nothing if the certificate is issued by an external issuer": {
+			certificate: gen.CertificateFrom(crt, gen.SetCertificateIssuer(cmmeta.ObjectReference{Name: "acme-issuer", Kind: cmapi.IssuerKind, Group: "example.com"})),
+			secret:      secret(certPEM),
+			server:      fakeARIServer{supported: true},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.server.certID = certID
+			test.server.windowStart = windowStart
+			test.server.windowEnd = windowEnd
+			srv := test.server.start(t)
+
+			builder := &testpkg.Builder{
+				T:                  t,
+				Clock:              fakeclock.NewFakeClock(now),
+				CertManagerObjects: []runtime.Object{test.certificate, acmeIssuer, caIssuer},
+			}
+			if test.secret != nil {
+				builder.KubeObjects = append(builder.KubeObjects, test.secret)
+			}
+			if test.expectUpdate {
+				expected := test.certificate.DeepCopy()
+				expected.Status.RenewalInfo = test.expectedRenewalInfo
+				builder.ExpectedActions = append(builder.ExpectedActions, testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+					cmapi.SchemeGroupVersion.WithResource("certificates"),
+					"status",
+					expected.Namespace,
+					expected,
+				)))
+			}
+			builder.Init()
+
+			w := &controllerWrapper{}
+			if _, _, err := w.Register(builder.Context); err != nil {
+				t.Fatal(err)
+			}
+			w.controller.accountRegistry = &accountstest.FakeRegistry{
+				GetClientFunc: func(_ string) (acmecl.Interface, error) {
+					return &acmecl.Client{Client: &acme.Client{
+						DirectoryURL: srv.URL + "/directory",
+						HTTPClient:   srv.Client(),
+					}}, nil
+				},
+			}
+			var scheduled time.Duration
+			w.controller.scheduledWorkQueue = &schedulertest.FakeScheduler{
+				AddFunc: func(_ interface{}, duration time.Duration) {
+					scheduled = duration
+				},
+			}
+
+			builder.Start()
+			defer builder.Stop()
+
+			if err := w.controller.ProcessItem(context.Background(), "testns/test"); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if err := builder.AllActionsExecuted(); err != nil {
+				t.Error(err)
+			}
+			if test.server.requests != test.expectedRequests {
+				t.Errorf("expected %d requests for renewal information but got %d", test.expectedRequests, test.server.requests)
+			}
+			if scheduled != test.expectedScheduled {
+				t.Errorf("expected next update to be scheduled after %s but got %s", test.expectedScheduled, scheduled)
+			}
+		})
+	}
+}
+
+// mustCreateCertWithAuthorityKeyID returns a PEM encoded certificate for crt
+// with the given Authority Key Identifier, and its ARI identifier if it has
+// one.
+func mustCreateCertWithAuthorityKeyID(t *testing.T, crt *cmapi.Certificate, authorityKeyID []byte) ([]byte, string) {
+	pk, err := pki.DecodePrivateKeyBytes(testcrypto.MustCreatePEMPrivateKey(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	template, err := pki.CertificateTemplateFromCertificate(crt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template.AuthorityKeyId = authorityKeyID
+	certPEM, x509Cert, err := pki.SignCertificate(template, template, pk.Public(), pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if authorityKeyID == nil {
+		return certPEM, ""
+	}
+	certID, err := pki.ARICertID(x509Cert)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return certPEM, certID
+}
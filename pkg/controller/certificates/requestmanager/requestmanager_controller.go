@@ -39,6 +39,8 @@ import (
 	"github.com/cert-manager/cert-manager/internal/controller/feature"
 	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
 	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	"github.com/cert-manager/cert-manager/pkg/apis/certmanager"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
@@ -139,6 +141,11 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 		return err
 	}
 
+	if certificates.IsPaused(crt) {
+		log.V(logf.DebugLevel).Info("Certificate is paused, skipping")
+		return nil
+	}
+
 	if !apiutil.CertificateHasCondition(crt, cmapi.CertificateCondition{
 		Type:   cmapi.CertificateConditionIssuing,
 		Status: cmmeta.ConditionTrue,
@@ -146,6 +153,14 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 		return nil
 	}
 
+	// Wait for the DNS names of the referenced Service to be resolved, so
+	// that the certificate is not issued without them.
+	if crt.Spec.ServiceRef != nil && len(crt.Status.ServiceDNSNames) == 0 &&
+		utilfeature.DefaultFeatureGate.Enabled(feature.CertificateServiceDNSNames) {
+		log.V(logf.DebugLevel).Info("status.serviceDNSNames not yet set, waiting for the Service DNS names to be resolved before processing certificate")
+		return nil
+	}
+
 	// Check for and fetch the 'status.nextPrivateKeySecretName' secret
 	if crt.Status.NextPrivateKeySecretName == nil {
 		log.V(logf.DebugLevel).Info("status.nextPrivateKeySecretName not yet set, waiting for keymanager before processing certificate")
@@ -311,7 +326,7 @@ func (c *controller) deleteRequestsNotMatchingSpec(ctx context.Context, crt *cma
 	var remaining []*cmapi.CertificateRequest
 	for _, req := range reqs {
 		log := logf.WithRelatedResource(log, req)
-		violations, err := pki.RequestMatchesSpec(req, crt.Spec)
+		violations, err := pki.RequestMatchesSpec(req, pki.SpecForCertificate(crt))
 		if err != nil {
 			log.Error(err, "Failed to check if CertificateRequest matches spec, deleting CertificateRequest")
 			if err := c.client.CertmanagerV1().CertificateRequests(req.Namespace).Delete(ctx, req.Name, metav1.DeleteOptions{}); err != nil {
@@ -376,6 +391,14 @@ func (c *controller) createNewCertificateRequest(ctx context.Context, crt *cmapi
 	annotations[cmapi.CertificateRequestRevisionAnnotationKey] = strconv.Itoa(nextRevision)
 	annotations[cmapi.CertificateRequestPrivateKeyAnnotationKey] = nextPrivateKeySecretName
 	annotations[cmapi.CertificateNameKey] = crt.Name
+	// Renewal information is only known for Certificates issued by an ACME
+	// issuer, so this tells the ACME server which certificate is being
+	// renewed without annotating requests for other issuers.
+	if crt.Status.RenewalInfo != nil {
+		if certID := c.replacedCertificateID(ctx, crt); certID != "" {
+			annotations[cmacme.ACMEReplacesAnnotationKey] = certID
+		}
+	}
 
 	cr := &cmapi.CertificateRequest{
 		ObjectMeta: metav1.ObjectMeta{
@@ -436,6 +459,65 @@ func (c *controller) createNewCertificateRequest(ctx context.Context, crt *cmapi
 	return nil
 }
 
+// replacedCertificateID returns the ARI identifier of the certificate
+// currently stored in the Certificate's Secret, which a new certificate will
+// replace. An empty string is returned on initial issuance, if the current
+// certificate was not issued by the Certificate's current issuer, or if the
+// current certificate cannot be identified.
+func (c *controller) replacedCertificateID(ctx context.Context, crt *cmapi.Certificate) string {
+	log := logf.FromContext(ctx)
+
+	if crt.Status.Revision == nil {
+		return ""
+	}
+	secret, err := c.secretLister.Secrets(crt.Namespace).Get(crt.Spec.SecretName)
+	if err != nil {
+		log.V(logf.DebugLevel).Info("cannot read the current certificate, not setting the replaced certificate", "error", err.Error())
+		return ""
+	}
+	// A certificate issued by another issuer, possibly another ACME server,
+	// is unknown to the issuer of the new certificate.
+	if !issuedByIssuerRef(secret, crt.Spec.IssuerRef) {
+		log.V(logf.DebugLevel).Info("the current certificate was not issued by the current issuer, not setting the replaced certificate")
+		return ""
+	}
+	cert, err := pki.DecodeX509CertificateBytes(secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		log.V(logf.DebugLevel).Info("cannot decode the current certificate, not setting the replaced certificate", "error", err.Error())
+		return ""
+	}
+	certID, err := pki.ARICertID(cert)
+	if err != nil {
+		log.V(logf.DebugLevel).Info("cannot identify the current certificate, not setting the replaced certificate", "error", err.Error())
+		return ""
+	}
+	return certID
+}
+
+// issuedByIssuerRef returns true if the issuer annotations of the Secret
+// name the given issuer.
+func issuedByIssuerRef(secret *corev1.Secret, ref cmmeta.ObjectReference) bool {
+	name, ok := secret.Annotations[cmapi.IssuerNameAnnotationKey]
+	if !ok || name != ref.Name {
+		return false
+	}
+	kind, refKind := secret.Annotations[cmapi.IssuerKindAnnotationKey], ref.Kind
+	if kind == "" {
+		kind = cmapi.IssuerKind
+	}
+	if refKind == "" {
+		refKind = cmapi.IssuerKind
+	}
+	group, refGroup := secret.Annotations[cmapi.IssuerGroupAnnotationKey], ref.Group
+	if group == "" {
+		group = certmanager.GroupName
+	}
+	if refGroup == "" {
+		refGroup = certmanager.GroupName
+	}
+	return kind == refKind && group == refGroup
+}
+
 func (c *controller) waitForCertificateRequestToExist(ctx context.Context, namespace, name string) error {
 	return wait.PollUntilContextTimeout(ctx, time.Millisecond*100, time.Second*5, false, func(_ context.Context) (bool, error) {
 		_, err := c.certificateRequestLister.CertificateRequests(namespace).Get(name)
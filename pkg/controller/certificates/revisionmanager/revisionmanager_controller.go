@@ -111,6 +111,11 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 
 	log = logf.WithResource(log, crt)
 
+	if certificates.IsPaused(crt) {
+		log.V(logf.DebugLevel).Info("Certificate is paused, skipping")
+		return nil
+	}
+
 	// If RevisionHistoryLimit is nil, don't attempt to garbage collect old
 	// CertificateRequests
 	if crt.Spec.RevisionHistoryLimit == nil {
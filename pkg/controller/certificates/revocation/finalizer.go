@@ -0,0 +1,309 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package revocation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/go-logr/logr"
+	"golang.org/x/crypto/acme"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/cert-manager/cert-manager/internal/controller/feature"
+	"github.com/cert-manager/cert-manager/pkg/acme/accounts"
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/cert-manager/cert-manager/pkg/util/predicate"
+)
+
+// RevokeOnDeleteControllerName is the name of the controller which manages
+// the revoke-on-delete finalizer of Certificates.
+const RevokeOnDeleteControllerName = "certificates-revoke-on-delete"
+
+// revokeOnDeleteController manages the revoke-on-delete finalizer of a
+// Certificate. The finalizer is added to Certificates issued by an ACME
+// Issuer or ClusterIssuer with revokeOnDelete set. When such a Certificate is
+// marked for deletion, the certificate stored in its Secret is revoked with
+// the ACME server before the finalizer is removed, allowing the garbage
+// collector to remove the Certificate and its Secret.
+// Unlike the revocation controller, this controller is enabled by default,
+// so that while the CertificateRevocation feature is disabled it removes the
+// finalizers added while the feature was enabled, which would otherwise
+// block the deletion of Certificates and their namespaces.
+type revokeOnDeleteController struct {
+	*controller
+}
+
+// NewRevokeOnDeleteController returns a new revoke-on-delete controller.
+func NewRevokeOnDeleteController(
+	log logr.Logger,
+	ctx *controllerpkg.Context,
+) (*revokeOnDeleteController, workqueue.RateLimitingInterface, []cache.InformerSynced) {
+	// create a queue used to queue up items to be processed
+	queue := workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Second*1, time.Minute*5), RevokeOnDeleteControllerName)
+
+	ctrl, mustSync := newController(log, ctx, queue)
+
+	// When an Issuer resource changes, enqueue any Certificate resources that
+	// reference it so that the revoke-on-delete finalizer is added or removed.
+	certificateLister := ctx.SharedInformerFactory.Certmanager().V1().Certificates().Lister()
+	ctx.SharedInformerFactory.Certmanager().V1().Issuers().Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+		WorkFunc: certificates.EnqueueCertificatesForResourceUsingPredicates(log, queue, certificateLister, labels.Everything(),
+			issuerRefPredicate(cmapi.IssuerKind)),
+	})
+	if ctx.Namespace == "" {
+		ctx.SharedInformerFactory.Certmanager().V1().ClusterIssuers().Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+			WorkFunc: certificates.EnqueueCertificatesForResourceUsingPredicates(log, queue, certificateLister, labels.Everything(),
+				issuerRefPredicate(cmapi.ClusterIssuerKind)),
+		})
+	}
+
+	return &revokeOnDeleteController{controller: ctrl}, queue, mustSync
+}
+
+// issuerRefPredicate returns a predicate extractor which filters Certificates
+// to those referencing the enqueued Issuer or ClusterIssuer.
+func issuerRefPredicate(kind string) predicate.ExtractorFunc {
+	return func(obj runtime.Object) predicate.Func {
+		return predicate.CertificateIssuerRef(kind, obj.(metav1.Object).GetName())
+	}
+}
+
+// ProcessItem is a worker function that will be called when a new key
+// corresponding to a Certificate to be re-synced is pulled from the workqueue.
+// ProcessItem adds or removes the revoke-on-delete finalizer according to the
+// Certificate's issuer, and revokes the certificate once the Certificate has
+// been marked for deletion.
+func (c *revokeOnDeleteController) ProcessItem(ctx context.Context, key string) error {
+	log := logf.FromContext(ctx).WithValues("key", key)
+
+	ctx = logf.NewContext(ctx, log)
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		log.Error(err, "invalid resource key passed to ProcessItem")
+		return nil
+	}
+
+	crt, err := c.certificateLister.Certificates(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		log.V(logf.DebugLevel).Info("certificate not found for key", "error", err.Error())
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if !utilfeature.DefaultFeatureGate.Enabled(feature.CertificateRevocation) {
+		// Remove the finalizers added while the feature was enabled, which
+		// would otherwise block the deletion of Certificates.
+		return c.releaseFinalizer(ctx, crt)
+	}
+
+	if crt.DeletionTimestamp != nil {
+		return c.finalize(ctx, crt)
+	}
+
+	// A paused Certificate is still finalized once it is deleted, otherwise it
+	// would never go away.
+	if certificates.IsPaused(crt) {
+		log.V(logf.DebugLevel).Info("Certificate is paused, skipping")
+		return nil
+	}
+
+	_, err = c.syncFinalizer(ctx, crt)
+	return err
+}
+
+// syncFinalizer adds the revoke-on-delete finalizer to the Certificate if its
+// issuer has revokeOnDelete set, and removes it otherwise. The updated
+// Certificate is returned.
+func (c *controller) syncFinalizer(ctx context.Context, crt *cmapi.Certificate) (*cmapi.Certificate, error) {
+	genericIssuer, err := c.acmeIssuer(crt)
+	if apierrors.IsNotFound(err) {
+		// Leave the finalizer as-is until the issuer exists.
+		return crt, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	required := genericIssuer != nil && genericIssuer.GetSpec().ACME.RevokeOnDelete
+	if required == hasFinalizer(crt) {
+		return crt, nil
+	}
+
+	crt = crt.DeepCopy()
+	if required {
+		crt.Finalizers = append(crt.Finalizers, cmacme.ACMERevokeOnDeleteFinalizer)
+	} else {
+		crt.Finalizers = removeFinalizer(crt.Finalizers)
+	}
+	logf.FromContext(ctx).V(logf.DebugLevel).Info("updating revoke-on-delete finalizer", "required", required)
+	return c.client.CertmanagerV1().Certificates(crt.Namespace).Update(ctx, crt, metav1.UpdateOptions{})
+}
+
+// finalize revokes the certificate of a Certificate which has been marked for
+// deletion with the ACME server, then removes the revoke-on-delete finalizer.
+// The finalizer is also removed if the certificate cannot be revoked because
+// the ACME server rejects the request, for example because the ACME account
+// is not authorized to revoke it, so that deletion is never blocked forever.
+func (c *controller) finalize(ctx context.Context, crt *cmapi.Certificate) error {
+	if !hasFinalizer(crt) {
+		return nil
+	}
+
+	if err := c.revokeOnDelete(ctx, crt); err != nil {
+		return err
+	}
+
+	return c.releaseFinalizer(ctx, crt)
+}
+
+// releaseFinalizer removes the revoke-on-delete finalizer from the Certificate
+// without revoking its certificate.
+func (c *controller) releaseFinalizer(ctx context.Context, crt *cmapi.Certificate) error {
+	if !hasFinalizer(crt) {
+		return nil
+	}
+
+	crt = crt.DeepCopy()
+	crt.Finalizers = removeFinalizer(crt.Finalizers)
+	_, err := c.client.CertmanagerV1().Certificates(crt.Namespace).Update(ctx, crt, metav1.UpdateOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// revokeOnDelete revokes the certificate stored in the Certificate's Secret
+// with the ACME server. An error is only returned if revocation should be
+// retried.
+func (c *controller) revokeOnDelete(ctx context.Context, crt *cmapi.Certificate) error {
+	log := logf.FromContext(ctx, "finalizer")
+
+	genericIssuer, err := c.acmeIssuer(crt)
+	if apierrors.IsNotFound(err) {
+		c.recorder.Event(crt, corev1.EventTypeWarning, reasonRevokeWithIssuerSkipped, "Not revoking certificate as the issuer does not exist")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if genericIssuer == nil || !genericIssuer.GetSpec().ACME.RevokeOnDelete {
+		log.V(logf.DebugLevel).Info("issuer no longer requires revocation on delete, skipping")
+		return nil
+	}
+
+	secret, err := c.secretLister.Secrets(crt.Namespace).Get(crt.Spec.SecretName)
+	if apierrors.IsNotFound(err) {
+		log.V(logf.DebugLevel).Info("secret not found, nothing to revoke")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	x509Cert, err := pki.DecodeX509CertificateBytes(secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		log.V(logf.DebugLevel).Info("secret does not contain a valid certificate, nothing to revoke", "error", err.Error())
+		return nil
+	}
+	serialNumber := pki.FormatSerialNumber(x509Cert.SerialNumber)
+	if revocation := crt.Status.LastRevocation; revocation != nil && revocation.SerialNumber == serialNumber && revocation.RevokedWithIssuer {
+		log.V(logf.DebugLevel).Info("certificate has already been revoked", "serial_number", serialNumber)
+		return nil
+	}
+
+	cl, err := c.accountRegistry.GetClient(string(genericIssuer.GetUID()))
+	if errors.Is(err, accounts.ErrNotFound) {
+		// The issuer's ACME account has not been registered, for example
+		// because the issuer is not ready. It may never become available, so
+		// retrying would block deletion of the Certificate forever.
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, reasonRevokeWithIssuerSkipped, "Not revoking certificate with serial number %s as the ACME account of the issuer is not available", serialNumber)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	err = cl.RevokeCert(ctx, nil, x509Cert.Raw, acme.CRLReasonCessationOfOperation)
+	var acmeErr *acme.Error
+	switch {
+	case err == nil:
+		c.recorder.Eventf(crt, corev1.EventTypeNormal, reasonRevoked, "Revoked certificate with serial number %s with the ACME server as the Certificate is being deleted", serialNumber)
+		return nil
+	case errors.As(err, &acmeErr) && acmeErr.ProblemType == acmeAlreadyRevokedError:
+		log.V(logf.DebugLevel).Info("certificate has already been revoked with the ACME server", "serial_number", serialNumber)
+		return nil
+	case errors.As(err, &acmeErr) && acmeErr.StatusCode >= 400 && acmeErr.StatusCode < 500:
+		// The ACME server will not revoke the certificate, for example
+		// because the account is not authorized to revoke it. Retrying
+		// would block deletion of the Certificate forever.
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, reasonRevokeWithIssuerSkipped, "Not revoking certificate with serial number %s as the ACME server rejected the request: %v", serialNumber, err)
+		return nil
+	default:
+		return fmt.Errorf("error revoking certificate with the ACME server: %w", err)
+	}
+}
+
+func hasFinalizer(crt *cmapi.Certificate) bool {
+	return slices.Contains(crt.Finalizers, cmacme.ACMERevokeOnDeleteFinalizer)
+}
+
+func removeFinalizer(finalizers []string) []string {
+	return slices.DeleteFunc(slices.Clone(finalizers), func(f string) bool {
+		return f == cmacme.ACMERevokeOnDeleteFinalizer
+	})
+}
+
+// revokeOnDeleteControllerWrapper wraps the `revokeOnDeleteController`
+// structure to make it implement the controllerpkg.queueingController
+// interface
+type revokeOnDeleteControllerWrapper struct {
+	*revokeOnDeleteController
+}
+
+func (c *revokeOnDeleteControllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.RateLimitingInterface, []cache.InformerSynced, error) {
+	// construct a new named logger to be reused throughout the controller
+	log := logf.FromContext(ctx.RootContext, RevokeOnDeleteControllerName)
+
+	ctrl, queue, mustSync := NewRevokeOnDeleteController(log, ctx)
+	c.revokeOnDeleteController = ctrl
+
+	return queue, mustSync, nil
+}
+
+func init() {
+	controllerpkg.Register(RevokeOnDeleteControllerName, func(ctx *controllerpkg.ContextFactory) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, RevokeOnDeleteControllerName).
+			For(&revokeOnDeleteControllerWrapper{}).
+			Complete()
+	})
+}
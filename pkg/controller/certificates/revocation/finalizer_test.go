@@ -0,0 +1,257 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package revocation
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/acme"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+	featuregatetesting "k8s.io/component-base/featuregate/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	"github.com/cert-manager/cert-manager/internal/controller/feature"
+	"github.com/cert-manager/cert-manager/pkg/acme/accounts"
+	accountstest "github.com/cert-manager/cert-manager/pkg/acme/accounts/test"
+	acmecl "github.com/cert-manager/cert-manager/pkg/acme/client"
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	testcrypto "github.com/cert-manager/cert-manager/test/unit/crypto"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestProcessItemRevokeOnDelete(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+
+	revokeOnDeleteIssuer := gen.Issuer("revoke-on-delete",
+		gen.SetIssuerNamespace("testns"),
+		gen.SetIssuerACME(cmacme.ACMEIssuer{Server: "https://acme.example.com/directory", RevokeOnDelete: true}),
+	)
+	acmeIssuer := gen.Issuer("acme-issuer",
+		gen.SetIssuerNamespace("testns"),
+		gen.SetIssuerACME(cmacme.ACMEIssuer{Server: "https://acme.example.com/directory"}),
+	)
+	crt := gen.Certificate("test",
+		gen.SetCertificateNamespace("testns"),
+		gen.SetCertificateSecretName("test-secret"),
+		gen.SetCertificateDNSNames("example.com"),
+		gen.SetCertificateIssuer(cmmeta.ObjectReference{Name: "revoke-on-delete", Kind: cmapi.IssuerKind}),
+	)
+	withFinalizer := func(crt *cmapi.Certificate) {
+		crt.Finalizers = append(crt.Finalizers, cmacme.ACMERevokeOnDeleteFinalizer)
+	}
+	deleted := func(crt *cmapi.Certificate) {
+		crt.DeletionTimestamp = &metav1.Time{Time: now}
+	}
+
+	pk := testcrypto.MustCreatePEMPrivateKey(t)
+	certPEM := testcrypto.MustCreateCertWithNotBeforeAfter(t, pk, crt, now, now.Add(time.Hour))
+	x509Cert, err := pki.DecodeX509CertificateBytes(certPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serial := pki.FormatSerialNumber(x509Cert.SerialNumber)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test-secret"},
+		Data:       map[string][]byte{corev1.TLSPrivateKeyKey: pk, corev1.TLSCertKey: certPEM},
+	}
+
+	tests := map[string]struct {
+		certificate        *cmapi.Certificate
+		revokeErr          error
+		accountUnavailable bool
+		featureDisabled    bool
+
+		// expectedFinalizers is the finalizers the Certificate should be
+		// updated with, if it should be updated.
+		expectedFinalizers []string
+		expectUpdate       bool
+		expectedRevoked    []byte
+		expectedEvents     []string
+		expectedErr        bool
+	}{
+		"add the finalizer if the issuer has revokeOnDelete set": {
+			certificate:        crt,
+			expectUpdate:       true,
+			expectedFinalizers: []string{cmacme.ACMERevokeOnDeleteFinalizer},
+		},
+		"remove the finalizer if the issuer does not have revokeOnDelete set": {
+			certificate: gen.CertificateFrom(crt, withFinalizer,
+				gen.SetCertificateIssuer(cmmeta.ObjectReference{Name: "acme-issuer", Kind: cmapi.IssuerKind})),
+			expectUpdate:       true,
+			expectedFinalizers: []string{},
+		},
+		"do nothing if the finalizer has already been added": {
+			certificate: gen.CertificateFrom(crt, withFinalizer),
+		},
+		"revoke the certificate and remove the finalizer when the Certificate is deleted": {
+			certificate:        gen.CertificateFrom(crt, withFinalizer, deleted),
+			expectUpdate:       true,
+			expectedFinalizers: []string{},
+			expectedRevoked:    x509Cert.Raw,
+			expectedEvents: []string{
+				fmt.Sprintf("Normal Revoked Revoked certificate with serial number %s with the ACME server as the Certificate is being deleted", serial),
+			},
+		},
+		"remove the finalizer if the ACME account is not authorized to revoke the certificate": {
+			certificate:        gen.CertificateFrom(crt, withFinalizer, deleted),
+			revokeErr:          &acme.Error{StatusCode: http.StatusForbidden, ProblemType: "urn:ietf:params:acme:error:unauthorized", Detail: "not authorized"},
+			expectUpdate:       true,
+			expectedFinalizers: []string{},
+			expectedRevoked:    x509Cert.Raw,
+			expectedEvents: []string{
+				fmt.Sprintf("Warning RevokeWithIssuerSkipped Not revoking certificate with serial number %s as the ACME server rejected the request: 403 urn:ietf:params:acme:error:unauthorized: not authorized", serial),
+			},
+		},
+		"remove the finalizer if the certificate has already been revoked by the ACME server": {
+			certificate:        gen.CertificateFrom(crt, withFinalizer, deleted),
+			revokeErr:          &acme.Error{StatusCode: http.StatusBadRequest, ProblemType: acmeAlreadyRevokedError},
+			expectUpdate:       true,
+			expectedFinalizers: []string{},
+			expectedRevoked:    x509Cert.Raw,
+		},
+		"keep the finalizer if revocation fails with a transient error": {
+			certificate:     gen.CertificateFrom(crt, withFinalizer, deleted),
+			revokeErr:       errors.New("this is a network error"),
+			expectedRevoked: x509Cert.Raw,
+			expectedErr:     true,
+		},
+		"remove the finalizer without revoking a certificate which has already been revoked": {
+			certificate: gen.CertificateFrom(crt, withFinalizer, deleted,
+				gen.SetCertificateLastRevocation(cmapi.CertificateRevocation{SerialNumber: serial, RevocationTime: metav1.NewTime(now), RevokedWithIssuer: true})),
+			expectUpdate:       true,
+			expectedFinalizers: []string{},
+		},
+		"remove the finalizer without revoking if the ACME account of the issuer is not available": {
+			certificate:        gen.CertificateFrom(crt, withFinalizer, deleted),
+			accountUnavailable: true,
+			expectUpdate:       true,
+			expectedFinalizers: []string{},
+			expectedEvents: []string{
+				fmt.Sprintf("Warning RevokeWithIssuerSkipped Not revoking certificate with serial number %s as the ACME account of the issuer is not available", serial),
+			},
+		},
+		"remove the finalizer without revoking if the CertificateRevocation feature is disabled": {
+			certificate:        gen.CertificateFrom(crt, withFinalizer, deleted),
+			featureDisabled:    true,
+			expectUpdate:       true,
+			expectedFinalizers: []string{},
+		},
+		"remove the finalizer from a Certificate which is not deleted if the CertificateRevocation feature is disabled": {
+			certificate:        gen.CertificateFrom(crt, withFinalizer),
+			featureDisabled:    true,
+			expectUpdate:       true,
+			expectedFinalizers: []string{},
+		},
+		"do not add the finalizer if the CertificateRevocation feature is disabled": {
+			certificate:     crt,
+			featureDisabled: true,
+		},
+		"do not add the finalizer if the Certificate is paused": {
+			certificate: gen.CertificateFrom(crt,
+				gen.AddCertificateAnnotations(map[string]string{cmapi.CertificatePausedAnnotationKey: "true"})),
+		},
+		"revoke the certificate and remove the finalizer when a paused Certificate is deleted": {
+			certificate: gen.CertificateFrom(crt, withFinalizer, deleted,
+				gen.AddCertificateAnnotations(map[string]string{cmapi.CertificatePausedAnnotationKey: "true"})),
+			expectUpdate:       true,
+			expectedFinalizers: []string{},
+			expectedRevoked:    x509Cert.Raw,
+			expectedEvents: []string{
+				fmt.Sprintf("Normal Revoked Revoked certificate with serial number %s with the ACME server as the Certificate is being deleted", serial),
+			},
+		},
+		"do nothing when a Certificate without the finalizer is deleted": {
+			certificate: gen.CertificateFrom(crt, deleted, func(crt *cmapi.Certificate) {
+				crt.Finalizers = []string{"example.com/other"}
+			}),
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultFeatureGate, feature.CertificateRevocation, !test.featureDisabled)()
+
+			builder := &testpkg.Builder{
+				T:                  t,
+				Clock:              fakeclock.NewFakeClock(now),
+				CertManagerObjects: []runtime.Object{test.certificate, revokeOnDeleteIssuer, acmeIssuer},
+				KubeObjects:        []runtime.Object{secret},
+				ExpectedEvents:     test.expectedEvents,
+			}
+			if test.expectUpdate {
+				expected := test.certificate.DeepCopy()
+				expected.Finalizers = test.expectedFinalizers
+				builder.ExpectedActions = append(builder.ExpectedActions, testpkg.NewAction(coretesting.NewUpdateAction(
+					cmapi.SchemeGroupVersion.WithResource("certificates"),
+					expected.Namespace,
+					expected,
+				)))
+			}
+			builder.Init()
+
+			w := &revokeOnDeleteControllerWrapper{}
+			if _, _, err := w.Register(builder.Context); err != nil {
+				t.Fatal(err)
+			}
+			var revoked []byte
+			w.controller.accountRegistry = &accountstest.FakeRegistry{
+				GetClientFunc: func(_ string) (acmecl.Interface, error) {
+					if test.accountUnavailable {
+						return nil, accounts.ErrNotFound
+					}
+					return &acmecl.FakeACME{
+						FakeRevokeCert: func(_ context.Context, _ crypto.Signer, cert []byte, _ acme.CRLReasonCode) error {
+							revoked = cert
+							return test.revokeErr
+						},
+					}, nil
+				},
+			}
+
+			builder.Start()
+			defer builder.Stop()
+
+			err := w.ProcessItem(context.Background(), "testns/test")
+			if test.expectedErr != (err != nil) {
+				t.Errorf("expected error=%t but got: %v", test.expectedErr, err)
+			}
+			if err := builder.AllActionsExecuted(); err != nil {
+				t.Error(err)
+			}
+			if err := builder.AllEventsCalled(); err != nil {
+				t.Error(err)
+			}
+			if !bytes.Equal(revoked, test.expectedRevoked) {
+				t.Errorf("unexpected certificate revoked with the ACME server")
+			}
+		})
+	}
+}
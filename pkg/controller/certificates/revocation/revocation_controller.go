@@ -0,0 +1,368 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package revocation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	"golang.org/x/crypto/acme"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
+
+	internalcertificates "github.com/cert-manager/cert-manager/internal/controller/certificates"
+	"github.com/cert-manager/cert-manager/internal/controller/feature"
+	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
+	"github.com/cert-manager/cert-manager/pkg/acme/accounts"
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
+	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates"
+	"github.com/cert-manager/cert-manager/pkg/issuer"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/cert-manager/cert-manager/pkg/util/predicate"
+)
+
+const (
+	// ControllerName is the name of the certificate revocation controller.
+	ControllerName = "certificates-revocation"
+
+	reasonRevoked                 = "Revoked"
+	reasonInvalidSerialNumber     = "InvalidSerialNumber"
+	reasonRevokeWithIssuerSkipped = "RevokeWithIssuerSkipped"
+
+	// acmeAlreadyRevokedError is the ACME problem type returned when the
+	// certificate being revoked has already been revoked.
+	acmeAlreadyRevokedError = "urn:ietf:params:acme:error:alreadyRevoked"
+)
+
+// controller records the revocation of a certificate issued for a
+// Certificate, as requested with the `cert-manager.io/revoked-serial-number`
+// annotation, on the Certificate's status. The trigger controller then
+// re-issues the Certificate if the revoked certificate is still stored in
+// its Secret. If the Certificate is also annotated with
+// `acme.cert-manager.io/revoke`, the certificate is first revoked with the
+// ACME server of its Issuer.
+type controller struct {
+	certificateLister        cmlisters.CertificateLister
+	certificateRequestLister cmlisters.CertificateRequestLister
+	secretLister             internalinformers.SecretLister
+	helper                   issuer.Helper
+	accountRegistry          accounts.Getter
+	client                   cmclient.Interface
+	recorder                 record.EventRecorder
+
+	clock clock.Clock
+
+	// fieldManager is the string which will be used as the Field Manager on
+	// fields created or edited by the cert-manager Kubernetes client during
+	// Apply API calls.
+	fieldManager string
+}
+
+// NewController returns a new certificate revocation controller.
+func NewController(
+	log logr.Logger,
+	ctx *controllerpkg.Context,
+) (*controller, workqueue.RateLimitingInterface, []cache.InformerSynced) {
+	// create a queue used to queue up items to be processed
+	queue := workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Second*1, time.Minute*5), ControllerName)
+
+	ctrl, mustSync := newController(log, ctx, queue)
+	return ctrl, queue, mustSync
+}
+
+// newController builds the state shared by the revocation and revoke-on-delete
+// controllers. Certificates are enqueued when they or their Secret change.
+func newController(
+	log logr.Logger,
+	ctx *controllerpkg.Context,
+	queue workqueue.RateLimitingInterface,
+) (*controller, []cache.InformerSynced) {
+	// obtain references to all the informers used by this controller
+	certificateInformer := ctx.SharedInformerFactory.Certmanager().V1().Certificates()
+	certificateRequestInformer := ctx.SharedInformerFactory.Certmanager().V1().CertificateRequests()
+	secretsInformer := ctx.KubeSharedInformerFactory.Secrets()
+	issuerInformer := ctx.SharedInformerFactory.Certmanager().V1().Issuers()
+
+	certificateInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: queue})
+	// When a Secret resource changes, enqueue any Certificate resources that name it as spec.secretName.
+	secretsInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+		WorkFunc: certificates.EnqueueCertificatesForResourceUsingPredicates(log, queue, certificateInformer.Lister(), labels.Everything(),
+			predicate.ExtractResourceName(predicate.CertificateSecretName)),
+	})
+
+	// build a list of InformerSynced functions that will be returned by the Register method.
+	// the controller will only begin processing items once all of these informers have synced.
+	mustSync := []cache.InformerSynced{
+		certificateInformer.Informer().HasSynced,
+		certificateRequestInformer.Informer().HasSynced,
+		secretsInformer.Informer().HasSynced,
+		issuerInformer.Informer().HasSynced,
+	}
+
+	// ClusterIssuers can only be read if cert-manager is not scoped to a
+	// single namespace.
+	var clusterIssuerLister cmlisters.ClusterIssuerLister
+	if ctx.Namespace == "" {
+		clusterIssuerInformer := ctx.SharedInformerFactory.Certmanager().V1().ClusterIssuers()
+		mustSync = append(mustSync, clusterIssuerInformer.Informer().HasSynced)
+		clusterIssuerLister = clusterIssuerInformer.Lister()
+	}
+
+	return &controller{
+		certificateLister:        certificateInformer.Lister(),
+		certificateRequestLister: certificateRequestInformer.Lister(),
+		secretLister:             secretsInformer.Lister(),
+		helper:                   issuer.NewHelper(issuerInformer.Lister(), clusterIssuerLister),
+		accountRegistry:          ctx.AccountRegistry,
+		client:                   ctx.CMClient,
+		recorder:                 ctx.Recorder,
+		clock:                    ctx.Clock,
+		fieldManager:             ctx.FieldManager,
+	}, mustSync
+}
+
+// ProcessItem is a worker function that will be called when a new key
+// corresponding to a Certificate to be re-synced is pulled from the workqueue.
+// ProcessItem will record the revocation of the certificate named by the
+// Certificate's `cert-manager.io/revoked-serial-number` annotation if it has
+// not been recorded already.
+func (c *controller) ProcessItem(ctx context.Context, key string) error {
+	log := logf.FromContext(ctx).WithValues("key", key)
+
+	ctx = logf.NewContext(ctx, log)
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		log.Error(err, "invalid resource key passed to ProcessItem")
+		return nil
+	}
+
+	crt, err := c.certificateLister.Certificates(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		log.V(logf.DebugLevel).Info("certificate not found for key", "error", err.Error())
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if !utilfeature.DefaultFeatureGate.Enabled(feature.CertificateRevocation) {
+		log.V(logf.DebugLevel).Info("CertificateRevocation feature is disabled, skipping")
+		return nil
+	}
+
+	if crt.DeletionTimestamp != nil {
+		log.V(logf.DebugLevel).Info("Certificate is being deleted, skipping")
+		return nil
+	}
+
+	if certificates.IsPaused(crt) {
+		log.V(logf.DebugLevel).Info("Certificate is paused, skipping")
+		return nil
+	}
+
+	value, ok := crt.Annotations[cmapi.RevokedSerialNumberAnnotationKey]
+	if !ok {
+		return nil
+	}
+	serial, err := pki.ParseSerialNumber(value)
+	if err != nil {
+		log.V(logf.WarnLevel).Info("ignoring invalid revoked serial number annotation", "error", err.Error())
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, reasonInvalidSerialNumber, "Ignoring annotation %s: %v", cmapi.RevokedSerialNumberAnnotationKey, err)
+		return nil
+	}
+
+	serialNumber := pki.FormatSerialNumber(serial)
+	if revocation := crt.Status.LastRevocation; revocation != nil && revocation.SerialNumber == serialNumber {
+		log.V(logf.DebugLevel).Info("revocation has already been recorded", "serial_number", serialNumber)
+		return nil
+	}
+
+	revokedWithIssuer := false
+	if revoke, _ := strconv.ParseBool(crt.Annotations[cmacme.ACMERevokeAnnotationKey]); revoke {
+		revokedWithIssuer, err = c.revokeWithACME(ctx, crt, serial)
+		if err != nil {
+			return err
+		}
+	}
+
+	crt = crt.DeepCopy()
+	crt.Status.LastRevocation = &cmapi.CertificateRevocation{
+		SerialNumber:      serialNumber,
+		RevocationTime:    metav1.NewTime(c.clock.Now()),
+		RevokedWithIssuer: revokedWithIssuer,
+	}
+	if err := c.updateStatus(ctx, crt); err != nil {
+		return err
+	}
+
+	message := fmt.Sprintf("Recorded revocation of certificate with serial number %s", serialNumber)
+	if revokedWithIssuer {
+		message = fmt.Sprintf("Revoked certificate with serial number %s with the ACME server", serialNumber)
+	}
+	log.V(logf.InfoLevel).Info(message)
+	c.recorder.Event(crt, corev1.EventTypeNormal, reasonRevoked, message)
+
+	return nil
+}
+
+// revokeWithACME revokes the certificate with the given serial number with
+// the ACME server of the Certificate's issuer. False is returned if the
+// certificate could not be revoked because the issuer is not an ACME issuer
+// or the certificate can no longer be found.
+func (c *controller) revokeWithACME(ctx context.Context, crt *cmapi.Certificate, serial *big.Int) (bool, error) {
+	log := logf.FromContext(ctx)
+
+	genericIssuer, err := c.acmeIssuer(crt)
+	if err != nil {
+		return false, err
+	}
+	if genericIssuer == nil {
+		c.recorder.Event(crt, corev1.EventTypeWarning, reasonRevokeWithIssuerSkipped, "Not revoking certificate with the issuer as it is not an ACME issuer")
+		return false, nil
+	}
+
+	der, err := c.findCertificate(crt, serial)
+	if err != nil {
+		return false, err
+	}
+	if der == nil {
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, reasonRevokeWithIssuerSkipped, "Not revoking certificate with the issuer as no certificate with serial number %s was found", pki.FormatSerialNumber(serial))
+		return false, nil
+	}
+
+	cl, err := c.accountRegistry.GetClient(string(genericIssuer.GetUID()))
+	if err != nil {
+		return false, err
+	}
+	// The certificate is revoked using the key of the ACME account which
+	// issued it.
+	err = cl.RevokeCert(ctx, nil, der, acme.CRLReasonUnspecified)
+	var acmeErr *acme.Error
+	if errors.As(err, &acmeErr) && acmeErr.ProblemType == acmeAlreadyRevokedError {
+		log.V(logf.DebugLevel).Info("certificate has already been revoked with the ACME server")
+		return true, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("error revoking certificate with the ACME server: %w", err)
+	}
+
+	return true, nil
+}
+
+// acmeIssuer returns the Issuer or ClusterIssuer of the Certificate, or nil
+// if it is not an ACME issuer.
+func (c *controller) acmeIssuer(crt *cmapi.Certificate) (cmapi.GenericIssuer, error) {
+	if group := crt.Spec.IssuerRef.Group; group != "" && group != cmapi.SchemeGroupVersion.Group {
+		return nil, nil
+	}
+	genericIssuer, err := c.helper.GetGenericIssuer(crt.Spec.IssuerRef, crt.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if genericIssuer.GetSpec().ACME == nil {
+		return nil, nil
+	}
+	return genericIssuer, nil
+}
+
+// findCertificate returns the DER encoded certificate with the given serial
+// number, either from the Certificate's Secret or from one of its
+// CertificateRequests. Nil is returned if the certificate cannot be found.
+func (c *controller) findCertificate(crt *cmapi.Certificate, serial *big.Int) ([]byte, error) {
+	candidates := [][]byte{}
+
+	secret, err := c.secretLister.Secrets(crt.Namespace).Get(crt.Spec.SecretName)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+	if secret != nil {
+		candidates = append(candidates, secret.Data[corev1.TLSCertKey])
+	}
+
+	requests, err := certificates.ListCertificateRequestsMatchingPredicates(c.certificateRequestLister.CertificateRequests(crt.Namespace),
+		labels.Everything(), predicate.ResourceOwnedBy(crt))
+	if err != nil {
+		return nil, err
+	}
+	for _, req := range requests {
+		candidates = append(candidates, req.Status.Certificate)
+	}
+
+	for _, certPEM := range candidates {
+		x509Cert, err := pki.DecodeX509CertificateBytes(certPEM)
+		if err != nil {
+			continue
+		}
+		if x509Cert.SerialNumber != nil && x509Cert.SerialNumber.Cmp(serial) == 0 {
+			return x509Cert.Raw, nil
+		}
+	}
+	return nil, nil
+}
+
+// updateStatus updates the Certificate's status.lastRevocation.
+func (c *controller) updateStatus(ctx context.Context, crt *cmapi.Certificate) error {
+	if utilfeature.DefaultFeatureGate.Enabled(feature.ServerSideApply) {
+		return internalcertificates.ApplyStatus(ctx, c.client, c.fieldManager, &cmapi.Certificate{
+			ObjectMeta: metav1.ObjectMeta{Namespace: crt.Namespace, Name: crt.Name},
+			Status:     cmapi.CertificateStatus{LastRevocation: crt.Status.LastRevocation},
+		})
+	}
+	_, err := c.client.CertmanagerV1().Certificates(crt.Namespace).UpdateStatus(ctx, crt, metav1.UpdateOptions{})
+	return err
+}
+
+// controllerWrapper wraps the `controller` structure to make it implement
+// the controllerpkg.queueingController interface
+type controllerWrapper struct {
+	*controller
+}
+
+func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.RateLimitingInterface, []cache.InformerSynced, error) {
+	// construct a new named logger to be reused throughout the controller
+	log := logf.FromContext(ctx.RootContext, ControllerName)
+
+	ctrl, queue, mustSync := NewController(log, ctx)
+	c.controller = ctrl
+
+	return queue, mustSync, nil
+}
+
+func init() {
+	controllerpkg.Register(ControllerName, func(ctx *controllerpkg.ContextFactory) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, ControllerName).
+			For(&controllerWrapper{}).
+			Complete()
+	})
+}
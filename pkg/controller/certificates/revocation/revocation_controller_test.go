@@ -0,0 +1,291 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package revocation
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/acme"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+	featuregatetesting "k8s.io/component-base/featuregate/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	"github.com/cert-manager/cert-manager/internal/controller/certificates/policies"
+	"github.com/cert-manager/cert-manager/internal/controller/feature"
+	accountstest "github.com/cert-manager/cert-manager/pkg/acme/accounts/test"
+	acmecl "github.com/cert-manager/cert-manager/pkg/acme/client"
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	testcrypto "github.com/cert-manager/cert-manager/test/unit/crypto"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestProcessItem(t *testing.T) {
+	defer featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultFeatureGate, feature.CertificateRevocation, true)()
+
+	now := time.Now().UTC().Truncate(time.Second)
+
+	acmeIssuer := gen.Issuer("acme-issuer",
+		gen.SetIssuerNamespace("testns"),
+		gen.SetIssuerACME(cmacme.ACMEIssuer{Server: "https://acme.example.com/directory"}),
+	)
+	caIssuer := gen.Issuer("ca-issuer",
+		gen.SetIssuerNamespace("testns"),
+		gen.SetIssuerCA(cmapi.CAIssuer{SecretName: "ca"}),
+	)
+	crt := gen.Certificate("test",
+		gen.SetCertificateNamespace("testns"),
+		gen.SetCertificateUID("test-uid"),
+		gen.SetCertificateSecretName("test-secret"),
+		gen.SetCertificateDNSNames("example.com"),
+		gen.SetCertificateIssuer(cmmeta.ObjectReference{Name: "acme-issuer", Kind: cmapi.IssuerKind}),
+	)
+
+	pk := testcrypto.MustCreatePEMPrivateKey(t)
+	revokedPEM := testcrypto.MustCreateCertWithNotBeforeAfter(t, pk, crt, now, now.Add(time.Hour))
+	revokedCert, err := pki.DecodeX509CertificateBytes(revokedPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	revokedSerial := pki.FormatSerialNumber(revokedCert.SerialNumber)
+	reissuedPEM := testcrypto.MustCreateCertWithNotBeforeAfter(t, pk, crt, now, now.Add(time.Hour))
+
+	secret := func(certPEM []byte) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test-secret"},
+			Data:       map[string][]byte{corev1.TLSPrivateKeyKey: pk, corev1.TLSCertKey: certPEM},
+		}
+	}
+	request := gen.CertificateRequest("test-1",
+		gen.SetCertificateRequestNamespace("testns"),
+		gen.AddCertificateRequestOwnerReferences(*metav1.NewControllerRef(crt, cmapi.SchemeGroupVersion.WithKind(cmapi.CertificateKind))),
+		gen.SetCertificateRequestCertificate(revokedPEM),
+	)
+	revoked := func(mods ...gen.CertificateModifier) *cmapi.Certificate {
+		return gen.CertificateFrom(crt, append([]gen.CertificateModifier{
+			gen.AddCertificateAnnotations(map[string]string{cmapi.RevokedSerialNumberAnnotationKey: revokedSerial}),
+		}, mods...)...)
+	}
+	revokeWithIssuer := gen.AddCertificateAnnotations(map[string]string{cmacme.ACMERevokeAnnotationKey: "true"})
+
+	tests := map[string]struct {
+		certificate *cmapi.Certificate
+		secret      *corev1.Secret
+		requests    []runtime.Object
+		revokeErr   error
+
+		// expectedRevocation is the revocation which should be recorded on
+		// the Certificate's status, if any.
+		expectedRevocation *cmapi.CertificateRevocation
+		expectedRevoked    []byte
+		expectedEvents     []string
+		expectedErr        bool
+		// expectReissue is true if the Certificate should be re-issued once
+		// the revocation has been recorded.
+		expectReissue bool
+	}{
+		"record the revocation of the current certificate": {
+			certificate:        revoked(),
+			secret:             secret(revokedPEM),
+			expectedRevocation: &cmapi.CertificateRevocation{SerialNumber: revokedSerial, RevocationTime: metav1.NewTime(now)},
+			expectedEvents:     []string{fmt.Sprintf("Normal Revoked Recorded revocation of certificate with serial number %s", revokedSerial)},
+			expectReissue:      true,
+		},
+		"accept a serial number separated by colons": {
+			certificate: gen.CertificateFrom(crt, gen.AddCertificateAnnotations(map[string]string{
+				cmapi.RevokedSerialNumberAnnotationKey: colonSeparated(revokedSerial),
+			})),
+			secret:             secret(revokedPEM),
+			expectedRevocation: &cmapi.CertificateRevocation{SerialNumber: revokedSerial, RevocationTime: metav1.NewTime(now)},
+			expectedEvents:     []string{fmt.Sprintf("Normal Revoked Recorded revocation of certificate with serial number %s", revokedSerial)},
+			expectReissue:      true,
+		},
+		"revoke the current certificate with the ACME server": {
+			certificate:        revoked(revokeWithIssuer),
+			secret:             secret(revokedPEM),
+			expectedRevoked:    revokedCert.Raw,
+			expectedRevocation: &cmapi.CertificateRevocation{SerialNumber: revokedSerial, RevocationTime: metav1.NewTime(now), RevokedWithIssuer: true},
+			expectedEvents:     []string{fmt.Sprintf("Normal Revoked Revoked certificate with serial number %s with the ACME server", revokedSerial)},
+			expectReissue:      true,
+		},
+		"revoke a certificate which has already been replaced in the Secret with the ACME server": {
+			certificate:        revoked(revokeWithIssuer),
+			secret:             secret(reissuedPEM),
+			requests:           []runtime.Object{request},
+			expectedRevoked:    revokedCert.Raw,
+			expectedRevocation: &cmapi.CertificateRevocation{SerialNumber: revokedSerial, RevocationTime: metav1.NewTime(now), RevokedWithIssuer: true},
+			expectedEvents:     []string{fmt.Sprintf("Normal Revoked Revoked certificate with serial number %s with the ACME server", revokedSerial)},
+		},
+		"treat a certificate already revoked by the ACME server as revoked": {
+			certificate:        revoked(revokeWithIssuer),
+			secret:             secret(revokedPEM),
+			revokeErr:          &acme.Error{StatusCode: http.StatusBadRequest, ProblemType: acmeAlreadyRevokedError},
+			expectedRevoked:    revokedCert.Raw,
+			expectedRevocation: &cmapi.CertificateRevocation{SerialNumber: revokedSerial, RevocationTime: metav1.NewTime(now), RevokedWithIssuer: true},
+			expectedEvents:     []string{fmt.Sprintf("Normal Revoked Revoked certificate with serial number %s with the ACME server", revokedSerial)},
+			expectReissue:      true,
+		},
+		"do not record the revocation if the ACME server fails to revoke the certificate": {
+			certificate:     revoked(revokeWithIssuer),
+			secret:          secret(revokedPEM),
+			revokeErr:       errors.New("this is a network error"),
+			expectedRevoked: revokedCert.Raw,
+			expectedErr:     true,
+		},
+		"record the revocation without revoking with a non-ACME issuer": {
+			certificate: revoked(revokeWithIssuer,
+				gen.SetCertificateIssuer(cmmeta.ObjectReference{Name: "ca-issuer", Kind: cmapi.IssuerKind})),
+			secret:             secret(revokedPEM),
+			expectedRevocation: &cmapi.CertificateRevocation{SerialNumber: revokedSerial, RevocationTime: metav1.NewTime(now)},
+			expectedEvents: []string{
+				"Warning RevokeWithIssuerSkipped Not revoking certificate with the issuer as it is not an ACME issuer",
+				fmt.Sprintf("Normal Revoked Recorded revocation of certificate with serial number %s", revokedSerial),
+			},
+			expectReissue: true,
+		},
+		"record the revocation without revoking if the certificate cannot be found": {
+			certificate:        revoked(revokeWithIssuer),
+			secret:             secret(reissuedPEM),
+			expectedRevocation: &cmapi.CertificateRevocation{SerialNumber: revokedSerial, RevocationTime: metav1.NewTime(now)},
+			expectedEvents: []string{
+				fmt.Sprintf("Warning RevokeWithIssuerSkipped Not revoking certificate with the issuer as no certificate with serial number %s was found", revokedSerial),
+				fmt.Sprintf("Normal Revoked Recorded revocation of certificate with serial number %s", revokedSerial),
+			},
+		},
+		"do nothing if the revocation has already been recorded": {
+			certificate: revoked(revokeWithIssuer,
+				gen.SetCertificateLastRevocation(cmapi.CertificateRevocation{SerialNumber: revokedSerial, RevocationTime: metav1.NewTime(now.Add(-time.Hour))})),
+			secret: secret(reissuedPEM),
+		},
+		"ignore an invalid serial number": {
+			certificate: gen.CertificateFrom(crt, gen.AddCertificateAnnotations(map[string]string{
+				cmapi.RevokedSerialNumberAnnotationKey: "not-a-serial",
+			})),
+			secret: secret(revokedPEM),
+			expectedEvents: []string{
+				`Warning InvalidSerialNumber Ignoring annotation cert-manager.io/revoked-serial-number: invalid serial number "not-a-serial": must be hexadecimal`,
+			},
+		},
+		"do nothing if the Certificate is paused": {
+			certificate: revoked(revokeWithIssuer,
+				gen.AddCertificateAnnotations(map[string]string{cmapi.CertificatePausedAnnotationKey: "true"})),
+			secret: secret(revokedPEM),
+		},
+		"do nothing if no certificate has been marked as revoked": {
+			certificate: crt,
+			secret:      secret(revokedPEM),
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{
+				T:                  t,
+				Clock:              fakeclock.NewFakeClock(now),
+				CertManagerObjects: append([]runtime.Object{test.certificate, acmeIssuer, caIssuer}, test.requests...),
+				KubeObjects:        []runtime.Object{test.secret},
+				ExpectedEvents:     test.expectedEvents,
+			}
+			expected := test.certificate.DeepCopy()
+			if test.expectedRevocation != nil {
+				expected.Status.LastRevocation = test.expectedRevocation
+				builder.ExpectedActions = append(builder.ExpectedActions, testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+					cmapi.SchemeGroupVersion.WithResource("certificates"),
+					"status",
+					expected.Namespace,
+					expected,
+				)))
+			}
+			builder.Init()
+
+			w := &controllerWrapper{}
+			if _, _, err := w.Register(builder.Context); err != nil {
+				t.Fatal(err)
+			}
+			var revoked []byte
+			w.controller.accountRegistry = &accountstest.FakeRegistry{
+				GetClientFunc: func(_ string) (acmecl.Interface, error) {
+					return &acmecl.FakeACME{
+						FakeRevokeCert: func(_ context.Context, key crypto.Signer, cert []byte, _ acme.CRLReasonCode) error {
+							if key != nil {
+								t.Errorf("expected the certificate to be revoked with the account key")
+							}
+							revoked = cert
+							return test.revokeErr
+						},
+					}, nil
+				},
+			}
+
+			builder.Start()
+			defer builder.Stop()
+
+			err := w.controller.ProcessItem(context.Background(), "testns/test")
+			if test.expectedErr != (err != nil) {
+				t.Errorf("expected error=%t but got: %v", test.expectedErr, err)
+			}
+			if err := builder.AllActionsExecuted(); err != nil {
+				t.Error(err)
+			}
+			if err := builder.AllEventsCalled(); err != nil {
+				t.Error(err)
+			}
+			if !bytes.Equal(revoked, test.expectedRevoked) {
+				t.Errorf("unexpected certificate revoked with the ACME server")
+			}
+
+			// The trigger policy chain should re-issue the Certificate if its
+			// Secret still contains the revoked certificate.
+			_, _, reissue := policies.NewTriggerPolicyChain(builder.Clock).Evaluate(policies.Input{
+				Certificate: expected,
+				Secret:      test.secret,
+			})
+			if reissue != test.expectReissue {
+				t.Errorf("expected reissue=%t but got %t", test.expectReissue, reissue)
+			}
+		})
+	}
+}
+
+// colonSeparated returns the hexadecimal serial number separated into
+// uppercase pairs of digits by colons, as printed by openssl.
+func colonSeparated(serial string) string {
+	if len(serial)%2 == 1 {
+		serial = "0" + serial
+	}
+	var b bytes.Buffer
+	for i := 0; i < len(serial); i += 2 {
+		if i > 0 {
+			b.WriteByte(':')
+		}
+		b.Write(bytes.ToUpper([]byte(serial[i : i+2])))
+	}
+	return b.String()
+}
@@ -0,0 +1,258 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretdeletion
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
+	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/util/predicate"
+)
+
+const (
+	// ControllerName is the name of the certificate secret deletion
+	// controller.
+	ControllerName = "certificates-secret-deletion"
+
+	reasonSecretDeleted   = "SecretDeleted"
+	reasonSecretPreserved = "SecretPreserved"
+)
+
+// controller deletes the Secret of a Certificate which sets
+// spec.deleteSecretOnCertificateDeletion when the Certificate is deleted. A
+// finalizer is added to such Certificates so that the Secret is deleted
+// before the Certificate is removed. Secrets which are managed by, or also
+// used by, a different Certificate are preserved.
+type controller struct {
+	certificateLister cmlisters.CertificateLister
+	secretLister      internalinformers.SecretLister
+	client            cmclient.Interface
+	coreClient        kubernetes.Interface
+	recorder          record.EventRecorder
+}
+
+// NewController returns a new certificate secret deletion controller.
+func NewController(
+	log logr.Logger,
+	ctx *controllerpkg.Context,
+) (*controller, workqueue.RateLimitingInterface, []cache.InformerSynced) {
+	// create a queue used to queue up items to be processed
+	queue := workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Second*1, time.Minute*5), ControllerName)
+
+	// obtain references to all the informers used by this controller
+	certificateInformer := ctx.SharedInformerFactory.Certmanager().V1().Certificates()
+	secretsInformer := ctx.KubeSharedInformerFactory.Secrets()
+
+	certificateInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: queue})
+	// When a Secret resource changes, enqueue any Certificate resources that name it as spec.secretName.
+	secretsInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+		WorkFunc: certificates.EnqueueCertificatesForResourceUsingPredicates(log, queue, certificateInformer.Lister(), labels.Everything(),
+			predicate.ExtractResourceName(predicate.CertificateSecretName)),
+	})
+
+	// build a list of InformerSynced functions that will be returned by the Register method.
+	// the controller will only begin processing items once all of these informers have synced.
+	mustSync := []cache.InformerSynced{
+		certificateInformer.Informer().HasSynced,
+		secretsInformer.Informer().HasSynced,
+	}
+
+	return &controller{
+		certificateLister: certificateInformer.Lister(),
+		secretLister:      secretsInformer.Lister(),
+		client:            ctx.CMClient,
+		coreClient:        ctx.Client,
+		recorder:          ctx.Recorder,
+	}, queue, mustSync
+}
+
+// ProcessItem is a worker function that will be called when a new key
+// corresponding to a Certificate to be re-synced is pulled from the workqueue.
+// ProcessItem adds or removes the delete-secret finalizer according to the
+// Certificate's spec.deleteSecretOnCertificateDeletion, and deletes the
+// Certificate's Secret once the Certificate has been marked for deletion.
+func (c *controller) ProcessItem(ctx context.Context, key string) error {
+	log := logf.FromContext(ctx).WithValues("key", key)
+
+	ctx = logf.NewContext(ctx, log)
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		log.Error(err, "invalid resource key passed to ProcessItem")
+		return nil
+	}
+
+	crt, err := c.certificateLister.Certificates(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		log.V(logf.DebugLevel).Info("certificate not found for key", "error", err.Error())
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if crt.DeletionTimestamp != nil {
+		return c.finalize(ctx, crt)
+	}
+
+	// A paused Certificate is still finalized once it is deleted, otherwise it
+	// would never go away.
+	if certificates.IsPaused(crt) {
+		log.V(logf.DebugLevel).Info("Certificate is paused, skipping")
+		return nil
+	}
+
+	if crt.Spec.DeleteSecretOnCertificateDeletion == hasFinalizer(crt) {
+		return nil
+	}
+	crt = crt.DeepCopy()
+	if crt.Spec.DeleteSecretOnCertificateDeletion {
+		crt.Finalizers = append(crt.Finalizers, cmapi.CertificateDeleteSecretFinalizer)
+	} else {
+		crt.Finalizers = removeFinalizer(crt.Finalizers)
+	}
+	_, err = c.client.CertmanagerV1().Certificates(crt.Namespace).Update(ctx, crt, metav1.UpdateOptions{})
+	return err
+}
+
+// finalize deletes the Secret of a Certificate which has been marked for
+// deletion, then removes the delete-secret finalizer. If the Certificate is
+// also to be revoked on deletion, the Secret is kept until the revocation
+// finalizer has been removed, since revoking needs the certificate stored in
+// the Secret.
+func (c *controller) finalize(ctx context.Context, crt *cmapi.Certificate) error {
+	if !hasFinalizer(crt) {
+		return nil
+	}
+
+	if slices.Contains(crt.Finalizers, cmacme.ACMERevokeOnDeleteFinalizer) {
+		// The Certificate is queued again when the revocation finalizer is
+		// removed.
+		logf.FromContext(ctx).V(logf.DebugLevel).Info("waiting for the certificate to be revoked before deleting the secret")
+		return nil
+	}
+
+	if err := c.deleteSecret(ctx, crt); err != nil {
+		return err
+	}
+
+	crt = crt.DeepCopy()
+	crt.Finalizers = removeFinalizer(crt.Finalizers)
+	_, err := c.client.CertmanagerV1().Certificates(crt.Namespace).Update(ctx, crt, metav1.UpdateOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// deleteSecret deletes the Certificate's Secret, unless it is managed by a
+// different Certificate or is also used by another Certificate.
+func (c *controller) deleteSecret(ctx context.Context, crt *cmapi.Certificate) error {
+	log := logf.FromContext(ctx).WithValues("secret", crt.Spec.SecretName)
+
+	secret, err := c.secretLister.Secrets(crt.Namespace).Get(crt.Spec.SecretName)
+	if apierrors.IsNotFound(err) {
+		log.V(logf.DebugLevel).Info("secret not found, nothing to delete")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if secret.Annotations[cmapi.CertificateNameKey] != crt.Name {
+		c.recorder.Eventf(crt, corev1.EventTypeNormal, reasonSecretPreserved, "Not deleting Secret %q as it is not managed by this Certificate", secret.Name)
+		return nil
+	}
+
+	crts, err := certificates.ListCertificatesMatchingPredicates(c.certificateLister.Certificates(crt.Namespace), labels.Everything(),
+		predicate.CertificateSecretName(crt.Spec.SecretName))
+	if err != nil {
+		return err
+	}
+	for _, other := range crts {
+		if other.Name != crt.Name {
+			c.recorder.Eventf(crt, corev1.EventTypeNormal, reasonSecretPreserved, "Not deleting Secret %q as it is also used by Certificate %q", secret.Name, other.Name)
+			return nil
+		}
+	}
+
+	err = c.coreClient.CoreV1().Secrets(secret.Namespace).Delete(ctx, secret.Name, metav1.DeleteOptions{
+		Preconditions: metav1.NewUIDPreconditions(string(secret.UID)),
+	})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	log.V(logf.InfoLevel).Info("deleted secret as the certificate is being deleted")
+	c.recorder.Eventf(crt, corev1.EventTypeNormal, reasonSecretDeleted, "Deleted Secret %q as the Certificate is being deleted", secret.Name)
+	return nil
+}
+
+func hasFinalizer(crt *cmapi.Certificate) bool {
+	return slices.Contains(crt.Finalizers, cmapi.CertificateDeleteSecretFinalizer)
+}
+
+func removeFinalizer(finalizers []string) []string {
+	return slices.DeleteFunc(slices.Clone(finalizers), func(f string) bool {
+		return f == cmapi.CertificateDeleteSecretFinalizer
+	})
+}
+
+// controllerWrapper wraps the `controller` structure to make it implement
+// the controllerpkg.queueingController interface
+type controllerWrapper struct {
+	*controller
+}
+
+func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.RateLimitingInterface, []cache.InformerSynced, error) {
+	// construct a new named logger to be reused throughout the controller
+	log := logf.FromContext(ctx.RootContext, ControllerName)
+
+	ctrl, queue, mustSync := NewController(log, ctx)
+	c.controller = ctrl
+
+	return queue, mustSync, nil
+}
+
+func init() {
+	controllerpkg.Register(ControllerName, func(ctx *controllerpkg.ContextFactory) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, ControllerName).
+			For(&controllerWrapper{}).
+			Complete()
+	})
+}
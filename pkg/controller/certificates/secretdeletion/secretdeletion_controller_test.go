@@ -0,0 +1,212 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretdeletion
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestProcessItem(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+
+	crt := gen.Certificate("test",
+		gen.SetCertificateNamespace("testns"),
+		gen.SetCertificateSecretName("test-secret"),
+		gen.SetCertificateDNSNames("example.com"),
+	)
+	deleteSecret := func(crt *cmapi.Certificate) {
+		crt.Spec.DeleteSecretOnCertificateDeletion = true
+	}
+	withFinalizer := func(crt *cmapi.Certificate) {
+		crt.Finalizers = append(crt.Finalizers, cmapi.CertificateDeleteSecretFinalizer)
+	}
+	deleted := func(crt *cmapi.Certificate) {
+		crt.DeletionTimestamp = &metav1.Time{Time: now}
+	}
+
+	secret := func(certificateName string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   "testns",
+				Name:        "test-secret",
+				UID:         "secret-uid",
+				Annotations: map[string]string{cmapi.CertificateNameKey: certificateName},
+			},
+		}
+	}
+	otherCrt := gen.Certificate("other",
+		gen.SetCertificateNamespace("testns"),
+		gen.SetCertificateSecretName("test-secret"),
+		gen.SetCertificateDNSNames("example.com"),
+	)
+
+	tests := map[string]struct {
+		certificate   *cmapi.Certificate
+		existingCerts []runtime.Object
+		secret        *corev1.Secret
+
+		// expectedFinalizers is the finalizers the Certificate should be
+		// updated with, if it should be updated.
+		expectedFinalizers []string
+		expectUpdate       bool
+		expectDelete       bool
+		expectedEvents     []string
+	}{
+		"add the finalizer if deleteSecretOnCertificateDeletion is set": {
+			certificate:        gen.CertificateFrom(crt, deleteSecret),
+			secret:             secret("test"),
+			expectUpdate:       true,
+			expectedFinalizers: []string{cmapi.CertificateDeleteSecretFinalizer},
+		},
+		"remove the finalizer if deleteSecretOnCertificateDeletion is not set": {
+			certificate:        gen.CertificateFrom(crt, withFinalizer),
+			secret:             secret("test"),
+			expectUpdate:       true,
+			expectedFinalizers: []string{},
+		},
+		"do nothing if the finalizer has already been added": {
+			certificate: gen.CertificateFrom(crt, deleteSecret, withFinalizer),
+			secret:      secret("test"),
+		},
+		"delete the Secret and remove the finalizer when the Certificate is deleted": {
+			certificate:        gen.CertificateFrom(crt, deleteSecret, withFinalizer, deleted),
+			secret:             secret("test"),
+			expectDelete:       true,
+			expectUpdate:       true,
+			expectedFinalizers: []string{},
+			expectedEvents: []string{
+				`Normal SecretDeleted Deleted Secret "test-secret" as the Certificate is being deleted`,
+			},
+		},
+		"preserve the Secret if it is also used by another Certificate": {
+			certificate:        gen.CertificateFrom(crt, deleteSecret, withFinalizer, deleted),
+			existingCerts:      []runtime.Object{otherCrt},
+			secret:             secret("test"),
+			expectUpdate:       true,
+			expectedFinalizers: []string{},
+			expectedEvents: []string{
+				`Normal SecretPreserved Not deleting Secret "test-secret" as it is also used by Certificate "other"`,
+			},
+		},
+		"preserve the Secret if it is managed by another Certificate": {
+			certificate:        gen.CertificateFrom(crt, deleteSecret, withFinalizer, deleted),
+			secret:             secret("other"),
+			expectUpdate:       true,
+			expectedFinalizers: []string{},
+			expectedEvents: []string{
+				`Normal SecretPreserved Not deleting Secret "test-secret" as it is not managed by this Certificate`,
+			},
+		},
+		"remove the finalizer if the Secret does not exist": {
+			certificate:        gen.CertificateFrom(crt, deleteSecret, withFinalizer, deleted),
+			expectUpdate:       true,
+			expectedFinalizers: []string{},
+		},
+		"do nothing if the Certificate is paused": {
+			certificate: gen.CertificateFrom(crt, deleteSecret,
+				gen.AddCertificateAnnotations(map[string]string{cmapi.CertificatePausedAnnotationKey: "true"}),
+			),
+			secret: secret("test"),
+		},
+		"delete the Secret and remove the finalizer when a paused Certificate is deleted": {
+			certificate: gen.CertificateFrom(crt, deleteSecret, withFinalizer, deleted,
+				gen.AddCertificateAnnotations(map[string]string{cmapi.CertificatePausedAnnotationKey: "true"}),
+			),
+			secret:             secret("test"),
+			expectDelete:       true,
+			expectUpdate:       true,
+			expectedFinalizers: []string{},
+			expectedEvents: []string{
+				`Normal SecretDeleted Deleted Secret "test-secret" as the Certificate is being deleted`,
+			},
+		},
+		"keep the Secret until the Certificate has been revoked when its issuer also revokes on deletion": {
+			certificate: gen.CertificateFrom(crt, deleteSecret, withFinalizer, deleted, func(crt *cmapi.Certificate) {
+				crt.Finalizers = append(crt.Finalizers, cmacme.ACMERevokeOnDeleteFinalizer)
+			}),
+			secret: secret("test"),
+		},
+		"do nothing when a Certificate without the finalizer is deleted": {
+			certificate: gen.CertificateFrom(crt, deleteSecret, deleted, func(crt *cmapi.Certificate) {
+				crt.Finalizers = []string{"example.com/other"}
+			}),
+			secret: secret("test"),
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{
+				T:                  t,
+				Clock:              fakeclock.NewFakeClock(now),
+				CertManagerObjects: append([]runtime.Object{test.certificate}, test.existingCerts...),
+				ExpectedEvents:     test.expectedEvents,
+			}
+			if test.secret != nil {
+				builder.KubeObjects = append(builder.KubeObjects, test.secret)
+			}
+			if test.expectDelete {
+				builder.ExpectedActions = append(builder.ExpectedActions, testpkg.NewAction(coretesting.NewDeleteActionWithOptions(
+					corev1.SchemeGroupVersion.WithResource("secrets"),
+					test.secret.Namespace,
+					test.secret.Name,
+					metav1.DeleteOptions{Preconditions: metav1.NewUIDPreconditions(string(test.secret.UID))},
+				)))
+			}
+			if test.expectUpdate {
+				expected := test.certificate.DeepCopy()
+				expected.Finalizers = test.expectedFinalizers
+				builder.ExpectedActions = append(builder.ExpectedActions, testpkg.NewAction(coretesting.NewUpdateAction(
+					cmapi.SchemeGroupVersion.WithResource("certificates"),
+					expected.Namespace,
+					expected,
+				)))
+			}
+			builder.Init()
+
+			w := &controllerWrapper{}
+			if _, _, err := w.Register(builder.Context); err != nil {
+				t.Fatal(err)
+			}
+
+			builder.Start()
+			defer builder.Stop()
+
+			if err := w.controller.ProcessItem(context.Background(), "testns/test"); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if err := builder.AllActionsExecuted(); err != nil {
+				t.Error(err)
+			}
+			if err := builder.AllEventsCalled(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
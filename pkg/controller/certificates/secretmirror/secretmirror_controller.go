@@ -0,0 +1,377 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretmirror
+
+import (
+	"bytes"
+	"context"
+	"maps"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/util/predicate"
+)
+
+const (
+	// ControllerName is the name of the certificate secret mirror controller.
+	ControllerName = "certificates-secret-mirror"
+
+	reasonSecretMirrored          = "SecretMirrored"
+	reasonSecretMirrorNotAllowed  = "SecretMirrorNotAllowed"
+	reasonSecretMirrorConflict    = "SecretMirrorConflict"
+	reasonSecretMirrorUnsupported = "SecretMirrorUnsupported"
+)
+
+var mirrorSelector = labels.SelectorFromSet(labels.Set{cmapi.IsSecretMirrorLabelKey: "true"})
+
+// controller mirrors the target Secret of Certificates which list
+// spec.secretMirrorNamespaces into each of those namespaces, and deletes
+// mirrored Secrets which are no longer wanted.
+//
+// The controller already has permission to write Secrets in every
+// namespace, so without further checks any user able to create a
+// Certificate could use it to write Secrets into namespaces they have no
+// access to. To prevent this, a Secret is only mirrored into a namespace
+// which names the Certificate's namespace in its
+// `cert-manager.io/allow-secret-mirror-from` annotation, and existing
+// Secrets which are not mirrors of the same Certificate are never
+// overwritten. Reading the annotation requires the controller to be able to
+// list and watch Namespaces.
+type controller struct {
+	certificateLister cmlisters.CertificateLister
+	secretLister      internalinformers.SecretLister
+	namespaceLister   corelisters.NamespaceLister
+	coreClient        kubernetes.Interface
+	recorder          record.EventRecorder
+
+	// namespace is the namespace the controller is limited to, if any.
+	// Secrets cannot be mirrored into other namespaces if set.
+	namespace string
+}
+
+// NewController returns a new certificate secret mirror controller.
+func NewController(
+	log logr.Logger,
+	ctx *controllerpkg.Context,
+) (*controller, workqueue.RateLimitingInterface, []cache.InformerSynced) {
+	// create a queue used to queue up items to be processed
+	queue := workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Second*1, time.Minute*5), ControllerName)
+
+	// obtain references to all the informers used by this controller
+	certificateInformer := ctx.SharedInformerFactory.Certmanager().V1().Certificates()
+	secretsInformer := ctx.KubeSharedInformerFactory.Secrets()
+	namespaceInformer := ctx.KubeSharedInformerFactory.Namespaces()
+
+	certificateInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: queue})
+	// When a Secret resource changes, enqueue any Certificate resources that name it as spec.secretName.
+	secretsInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+		WorkFunc: certificates.EnqueueCertificatesForResourceUsingPredicates(log, queue, certificateInformer.Lister(), labels.Everything(),
+			predicate.ExtractResourceName(predicate.CertificateSecretName)),
+	})
+	// When a mirrored Secret changes, enqueue the Certificate it mirrors.
+	secretsInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+		WorkFunc: func(obj interface{}) {
+			secret, ok := obj.(*corev1.Secret)
+			if !ok {
+				return
+			}
+			if source, ok := secret.Annotations[cmapi.SecretMirrorSourceAnnotationKey]; ok {
+				queue.Add(source)
+			}
+		},
+	})
+	// When a Namespace changes, enqueue any Certificates which mirror their
+	// Secret into it, since whether mirroring is allowed may have changed.
+	namespaceInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+		WorkFunc: func(obj interface{}) {
+			ns, ok := obj.(*corev1.Namespace)
+			if !ok {
+				return
+			}
+			crts, err := certificates.ListCertificatesMatchingPredicates(certificateInformer.Lister().Certificates(metav1.NamespaceAll), labels.Everything(),
+				predicate.CertificateSecretMirrorNamespace(ns.Name))
+			if err != nil {
+				log.Error(err, "failed listing Certificate resources")
+				return
+			}
+			for _, crt := range crts {
+				key, err := controllerpkg.KeyFunc(crt)
+				if err != nil {
+					log.Error(err, "error determining 'key' for resource")
+					continue
+				}
+				queue.Add(key)
+			}
+		},
+	})
+
+	// build a list of InformerSynced functions that will be returned by the Register method.
+	// the controller will only begin processing items once all of these informers have synced.
+	mustSync := []cache.InformerSynced{
+		certificateInformer.Informer().HasSynced,
+		secretsInformer.Informer().HasSynced,
+		namespaceInformer.Informer().HasSynced,
+	}
+
+	return &controller{
+		certificateLister: certificateInformer.Lister(),
+		secretLister:      secretsInformer.Lister(),
+		namespaceLister:   namespaceInformer.Lister(),
+		coreClient:        ctx.Client,
+		recorder:          ctx.Recorder,
+		namespace:         ctx.Namespace,
+	}, queue, mustSync
+}
+
+// ProcessItem is a worker function that will be called when a new key
+// corresponding to a Certificate to be re-synced is pulled from the workqueue.
+// ProcessItem creates or updates the mirrors of the Certificate's target
+// Secret, and deletes mirrors which are no longer wanted.
+func (c *controller) ProcessItem(ctx context.Context, key string) error {
+	log := logf.FromContext(ctx).WithValues("key", key)
+
+	ctx = logf.NewContext(ctx, log)
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		log.Error(err, "invalid resource key passed to ProcessItem")
+		return nil
+	}
+
+	crt, err := c.certificateLister.Certificates(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		log.V(logf.DebugLevel).Info("certificate not found for key, deleting any mirrored secrets", "error", err.Error())
+		return c.deleteMirrors(ctx, key, "", nil)
+	}
+	if err != nil {
+		return err
+	}
+
+	if certificates.IsPaused(crt) {
+		log.V(logf.DebugLevel).Info("certificate is paused, skipping")
+		return nil
+	}
+
+	if c.namespace != "" {
+		if len(crt.Spec.SecretMirrorNamespaces) > 0 {
+			c.recorder.Event(crt, corev1.EventTypeWarning, reasonSecretMirrorUnsupported,
+				"Secrets cannot be mirrored to other namespaces as cert-manager is limited to a single namespace")
+		}
+		return nil
+	}
+
+	allowed, err := c.allowedNamespaces(crt)
+	if err != nil {
+		return err
+	}
+
+	source, err := c.secretLister.Secrets(crt.Namespace).Get(crt.Spec.SecretName)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	// Only mirror the Secret once it holds a certificate. Existing mirrors
+	// are left as they are until then.
+	if err == nil && len(source.Data[corev1.TLSCertKey]) > 0 {
+		for _, ns := range sets.List(allowed) {
+			if err := c.syncMirror(ctx, crt, key, source, ns); err != nil {
+				return err
+			}
+		}
+	}
+
+	return c.deleteMirrors(ctx, key, crt.Spec.SecretName, allowed)
+}
+
+// allowedNamespaces returns the namespaces listed in the Certificate's
+// spec.secretMirrorNamespaces which exist and allow Secrets to be mirrored
+// from the Certificate's namespace.
+func (c *controller) allowedNamespaces(crt *cmapi.Certificate) (sets.Set[string], error) {
+	allowed := sets.New[string]()
+	for _, name := range crt.Spec.SecretMirrorNamespaces {
+		if name == crt.Namespace {
+			continue
+		}
+
+		ns, err := c.namespaceLister.Get(name)
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if !allowsMirrorFrom(ns, crt.Namespace) {
+			c.recorder.Eventf(crt, corev1.EventTypeWarning, reasonSecretMirrorNotAllowed,
+				"Not mirroring Secret %q to namespace %q as it does not allow Secrets to be mirrored from namespace %q using the %s annotation",
+				crt.Spec.SecretName, name, crt.Namespace, cmapi.AllowSecretMirrorFromAnnotationKey)
+			continue
+		}
+		allowed.Insert(name)
+	}
+	return allowed, nil
+}
+
+// allowsMirrorFrom returns true if the given Namespace allows Secrets to be
+// mirrored into it from the given namespace.
+func allowsMirrorFrom(ns *corev1.Namespace, from string) bool {
+	value, ok := ns.Annotations[cmapi.AllowSecretMirrorFromAnnotationKey]
+	if !ok {
+		return false
+	}
+	for _, allowed := range strings.Split(value, ",") {
+		allowed = strings.TrimSpace(allowed)
+		if allowed == "*" || allowed == from {
+			return true
+		}
+	}
+	return false
+}
+
+// syncMirror creates or updates the mirror of the source Secret in the given
+// namespace. A Secret which is not a mirror of the same Certificate is never
+// overwritten.
+func (c *controller) syncMirror(ctx context.Context, crt *cmapi.Certificate, key string, source *corev1.Secret, namespace string) error {
+	log := logf.FromContext(ctx).WithValues("mirror_namespace", namespace)
+
+	existing, err := c.secretLister.Secrets(namespace).Get(source.Name)
+	if apierrors.IsNotFound(err) {
+		mirror := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      source.Name,
+				Namespace: namespace,
+			},
+			Type: source.Type,
+		}
+		setMirrorData(mirror, key, source)
+
+		if _, err := c.coreClient.CoreV1().Secrets(namespace).Create(ctx, mirror, metav1.CreateOptions{}); err != nil {
+			return err
+		}
+		log.V(logf.DebugLevel).Info("created mirrored secret")
+		c.recorder.Eventf(crt, corev1.EventTypeNormal, reasonSecretMirrored, "Mirrored Secret %q to namespace %q", source.Name, namespace)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if existing.Annotations[cmapi.SecretMirrorSourceAnnotationKey] != key {
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, reasonSecretMirrorConflict,
+			"Not mirroring Secret %q to namespace %q as a Secret with the same name which is not a mirror of this Certificate already exists", source.Name, namespace)
+		return nil
+	}
+
+	mirror := existing.DeepCopy()
+	setMirrorData(mirror, key, source)
+	if maps.EqualFunc(existing.Data, mirror.Data, bytes.Equal) &&
+		maps.Equal(existing.Labels, mirror.Labels) {
+		return nil
+	}
+
+	if _, err := c.coreClient.CoreV1().Secrets(namespace).Update(ctx, mirror, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+	log.V(logf.DebugLevel).Info("updated mirrored secret")
+	return nil
+}
+
+// setMirrorData copies the data of the source Secret to the mirror, and
+// marks it as a mirror of the Certificate with the given key.
+func setMirrorData(mirror *corev1.Secret, key string, source *corev1.Secret) {
+	mirror.Data = maps.Clone(source.Data)
+
+	if mirror.Labels == nil {
+		mirror.Labels = make(map[string]string)
+	}
+	mirror.Labels[cmapi.IsSecretMirrorLabelKey] = "true"
+	mirror.Labels[cmapi.PartOfCertManagerControllerLabelKey] = "true"
+
+	if mirror.Annotations == nil {
+		mirror.Annotations = make(map[string]string)
+	}
+	mirror.Annotations[cmapi.SecretMirrorSourceAnnotationKey] = key
+}
+
+// deleteMirrors deletes the mirrors of the Certificate with the given key,
+// except those with the given name in one of the namespaces to keep.
+func (c *controller) deleteMirrors(ctx context.Context, key, secretName string, keep sets.Set[string]) error {
+	log := logf.FromContext(ctx)
+
+	mirrors, err := c.secretLister.Secrets(metav1.NamespaceAll).List(mirrorSelector)
+	if err != nil {
+		return err
+	}
+
+	for _, mirror := range mirrors {
+		if mirror.Annotations[cmapi.SecretMirrorSourceAnnotationKey] != key {
+			continue
+		}
+		if mirror.Name == secretName && keep.Has(mirror.Namespace) {
+			continue
+		}
+
+		err := c.coreClient.CoreV1().Secrets(mirror.Namespace).Delete(ctx, mirror.Name, metav1.DeleteOptions{
+			Preconditions: metav1.NewUIDPreconditions(string(mirror.UID)),
+		})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		logf.WithResource(log, mirror).V(logf.DebugLevel).Info("deleted mirrored secret which is no longer wanted")
+	}
+	return nil
+}
+
+// controllerWrapper wraps the `controller` structure to make it implement
+// the controllerpkg.queueingController interface
+type controllerWrapper struct {
+	*controller
+}
+
+func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.RateLimitingInterface, []cache.InformerSynced, error) {
+	// construct a new named logger to be reused throughout the controller
+	log := logf.FromContext(ctx.RootContext, ControllerName)
+
+	ctrl, queue, mustSync := NewController(log, ctx)
+	c.controller = ctrl
+
+	return queue, mustSync, nil
+}
+
+func init() {
+	controllerpkg.Register(ControllerName, func(ctx *controllerpkg.ContextFactory) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, ControllerName).
+			For(&controllerWrapper{}).
+			Complete()
+	})
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretmirror
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestProcessItem(t *testing.T) {
+	secretsGVR := corev1.SchemeGroupVersion.WithResource("secrets")
+
+	crt := gen.Certificate("test",
+		gen.SetCertificateNamespace("testns"),
+		gen.SetCertificateSecretName("test-secret"),
+		gen.SetCertificateDNSNames("example.com"),
+	)
+	mirrorTo := func(namespaces ...string) gen.CertificateModifier {
+		return func(crt *cmapi.Certificate) {
+			crt.Spec.SecretMirrorNamespaces = namespaces
+		}
+	}
+
+	namespace := func(name string, allowFrom string) *corev1.Namespace {
+		ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		if allowFrom != "" {
+			ns.Annotations = map[string]string{cmapi.AllowSecretMirrorFromAnnotationKey: allowFrom}
+		}
+		return ns
+	}
+	namespaces := []runtime.Object{
+		namespace("testns", ""),
+		namespace("ingress-a", "*"),
+		namespace("ingress-b", "other, testns"),
+		namespace("private", "other"),
+	}
+
+	source := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test-secret"},
+		Data:       map[string][]byte{corev1.TLSCertKey: []byte("cert"), corev1.TLSPrivateKeyKey: []byte("key")},
+		Type:       corev1.SecretTypeTLS,
+	}
+	mirror := func(namespace string, data map[string][]byte) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      "test-secret",
+				UID:       "mirror-uid",
+				Labels: map[string]string{
+					cmapi.IsSecretMirrorLabelKey:              "true",
+					cmapi.PartOfCertManagerControllerLabelKey: "true",
+				},
+				Annotations: map[string]string{cmapi.SecretMirrorSourceAnnotationKey: "testns/test"},
+			},
+			Data: data,
+			Type: corev1.SecretTypeTLS,
+		}
+	}
+	created := func(namespace string) *corev1.Secret {
+		s := mirror(namespace, source.Data)
+		s.UID = ""
+		return s
+	}
+	deleteAction := func(namespace string) testpkg.Action {
+		return testpkg.NewAction(coretesting.NewDeleteActionWithOptions(secretsGVR, namespace, "test-secret",
+			metav1.DeleteOptions{Preconditions: metav1.NewUIDPreconditions("mirror-uid")}))
+	}
+
+	tests := map[string]struct {
+		certificate *cmapi.Certificate
+		secrets     []runtime.Object
+
+		expectedActions []testpkg.Action
+		expectedEvents  []string
+	}{
+		"mirror the Secret into every namespace which allows it": {
+			certificate: gen.CertificateFrom(crt, mirrorTo("ingress-b", "ingress-a")),
+			secrets:     []runtime.Object{source},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewCreateAction(secretsGVR, "ingress-a", created("ingress-a"))),
+				testpkg.NewAction(coretesting.NewCreateAction(secretsGVR, "ingress-b", created("ingress-b"))),
+			},
+			expectedEvents: []string{
+				`Normal SecretMirrored Mirrored Secret "test-secret" to namespace "ingress-a"`,
+				`Normal SecretMirrored Mirrored Secret "test-secret" to namespace "ingress-b"`,
+			},
+		},
+		"do not mirror the Secret into a namespace which does not allow it": {
+			certificate: gen.CertificateFrom(crt, mirrorTo("private", "missing", "testns")),
+			secrets:     []runtime.Object{source},
+			expectedEvents: []string{
+				`Warning SecretMirrorNotAllowed Not mirroring Secret "test-secret" to namespace "private" as it does not allow Secrets to be mirrored from namespace "testns" using the cert-manager.io/allow-secret-mirror-from annotation`,
+			},
+		},
+		"update a mirror which is out of date after renewal": {
+			certificate: gen.CertificateFrom(crt, mirrorTo("ingress-a")),
+			secrets: []runtime.Object{source,
+				mirror("ingress-a", map[string][]byte{corev1.TLSCertKey: []byte("old-cert"), corev1.TLSPrivateKeyKey: []byte("old-key")}),
+			},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewUpdateAction(secretsGVR, "ingress-a", mirror("ingress-a", source.Data))),
+			},
+		},
+		"do nothing if the mirrors are up to date": {
+			certificate: gen.CertificateFrom(crt, mirrorTo("ingress-a", "ingress-b")),
+			secrets:     []runtime.Object{source, mirror("ingress-a", source.Data), mirror("ingress-b", source.Data)},
+		},
+		"do not overwrite a Secret which is not a mirror of the Certificate": {
+			certificate: gen.CertificateFrom(crt, mirrorTo("ingress-a")),
+			secrets: []runtime.Object{source, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ingress-a", Name: "test-secret"},
+				Data:       map[string][]byte{corev1.TLSCertKey: []byte("other")},
+			}},
+			expectedEvents: []string{
+				`Warning SecretMirrorConflict Not mirroring Secret "test-secret" to namespace "ingress-a" as a Secret with the same name which is not a mirror of this Certificate already exists`,
+			},
+		},
+		"do not mirror the Secret before it holds a certificate": {
+			certificate: gen.CertificateFrom(crt, mirrorTo("ingress-a")),
+			secrets: []runtime.Object{&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test-secret"},
+			}},
+		},
+		"delete the mirror in a namespace which is removed from the list": {
+			certificate: gen.CertificateFrom(crt, mirrorTo("ingress-a")),
+			secrets:     []runtime.Object{source, mirror("ingress-a", source.Data), mirror("ingress-b", source.Data)},
+			expectedActions: []testpkg.Action{
+				deleteAction("ingress-b"),
+			},
+		},
+		"delete the mirror in a namespace which no longer allows it": {
+			certificate: gen.CertificateFrom(crt, mirrorTo("private")),
+			secrets:     []runtime.Object{source, mirror("private", source.Data)},
+			expectedActions: []testpkg.Action{
+				deleteAction("private"),
+			},
+			expectedEvents: []string{
+				`Warning SecretMirrorNotAllowed Not mirroring Secret "test-secret" to namespace "private" as it does not allow Secrets to be mirrored from namespace "testns" using the cert-manager.io/allow-secret-mirror-from annotation`,
+			},
+		},
+		"delete the mirrors when the Certificate is deleted": {
+			secrets: []runtime.Object{source, mirror("ingress-a", source.Data)},
+			expectedActions: []testpkg.Action{
+				deleteAction("ingress-a"),
+			},
+		},
+		"do nothing if the Certificate is paused": {
+			certificate: gen.CertificateFrom(crt, mirrorTo("ingress-a"),
+				gen.AddCertificateAnnotations(map[string]string{cmapi.CertificatePausedAnnotationKey: "true"})),
+			secrets: []runtime.Object{source},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{
+				T:               t,
+				Clock:           fakeclock.NewFakeClock(time.Now()),
+				KubeObjects:     append(append([]runtime.Object{}, namespaces...), test.secrets...),
+				ExpectedActions: test.expectedActions,
+				ExpectedEvents:  test.expectedEvents,
+			}
+			if test.certificate != nil {
+				builder.CertManagerObjects = []runtime.Object{test.certificate}
+			}
+			builder.Init()
+
+			w := &controllerWrapper{}
+			if _, _, err := w.Register(builder.Context); err != nil {
+				t.Fatal(err)
+			}
+
+			builder.Start()
+			defer builder.Stop()
+
+			if err := w.controller.ProcessItem(context.Background(), "testns/test"); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if err := builder.AllActionsExecuted(); err != nil {
+				t.Error(err)
+			}
+			if err := builder.AllEventsCalled(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
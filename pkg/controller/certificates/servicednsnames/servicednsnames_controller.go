@@ -0,0 +1,254 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicednsnames
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	internalcertificates "github.com/cert-manager/cert-manager/internal/controller/certificates"
+	"github.com/cert-manager/cert-manager/internal/controller/feature"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
+	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+	"github.com/cert-manager/cert-manager/pkg/util/predicate"
+)
+
+const (
+	// ControllerName is the name of the certificate Service DNS names
+	// controller.
+	ControllerName = "certificates-service-dns-names"
+
+	// defaultClusterDomain is used when spec.serviceRef.clusterDomain is not
+	// set.
+	defaultClusterDomain = "cluster.local"
+
+	reasonServiceNotFound = "ServiceNotFound"
+)
+
+// controller resolves the Service referenced by a Certificate's
+// spec.serviceRef into its cluster DNS names, and optionally its IP
+// addresses, and records them in the Certificate's status.serviceDNSNames and
+// status.serviceIPAddresses. Changes to the names cause the Certificate to be
+// re-issued, as the names are compared with the issued certificate along with
+// spec.dnsNames and spec.ipAddresses.
+type controller struct {
+	certificateLister cmlisters.CertificateLister
+	serviceLister     corelisters.ServiceLister
+	client            cmclient.Interface
+	recorder          record.EventRecorder
+
+	// fieldManager is the string which will be used as the Field Manager on
+	// fields created or edited by the cert-manager Kubernetes client during
+	// Apply API calls.
+	fieldManager string
+}
+
+// NewController returns a new certificate Service DNS names controller.
+func NewController(
+	log logr.Logger,
+	ctx *controllerpkg.Context,
+) (*controller, workqueue.RateLimitingInterface, []cache.InformerSynced) {
+	// create a queue used to queue up items to be processed
+	queue := workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Second*1, time.Minute*5), ControllerName)
+
+	// obtain references to all the informers used by this controller
+	certificateInformer := ctx.SharedInformerFactory.Certmanager().V1().Certificates()
+	serviceInformer := ctx.KubeSharedInformerFactory.Services()
+
+	certificateInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: queue})
+	// When a Service changes, enqueue any Certificates which reference it.
+	// This includes changes to its status, as the ingress IPs of a
+	// LoadBalancer Service are assigned asynchronously.
+	serviceInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+		WorkFunc: certificates.EnqueueCertificatesForResourceUsingPredicates(log, queue, certificateInformer.Lister(), labels.Everything(),
+			predicate.ExtractResourceName(predicate.CertificateServiceRefName)),
+	})
+
+	// build a list of InformerSynced functions that will be returned by the Register method.
+	// the controller will only begin processing items once all of these informers have synced.
+	mustSync := []cache.InformerSynced{
+		certificateInformer.Informer().HasSynced,
+		serviceInformer.Informer().HasSynced,
+	}
+
+	return &controller{
+		certificateLister: certificateInformer.Lister(),
+		serviceLister:     serviceInformer.Lister(),
+		client:            ctx.CMClient,
+		recorder:          ctx.Recorder,
+		fieldManager:      ctx.FieldManager,
+	}, queue, mustSync
+}
+
+// ProcessItem is a worker function that will be called when a new key
+// corresponding to a Certificate to be re-synced is pulled from the workqueue.
+// ProcessItem resolves the DNS names and IP addresses of the Service
+// referenced by the Certificate, and updates the status if they have changed.
+func (c *controller) ProcessItem(ctx context.Context, key string) error {
+	log := logf.FromContext(ctx).WithValues("key", key)
+
+	ctx = logf.NewContext(ctx, log)
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		log.Error(err, "invalid resource key passed to ProcessItem")
+		return nil
+	}
+
+	crt, err := c.certificateLister.Certificates(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		log.V(logf.DebugLevel).Info("certificate not found for key", "error", err.Error())
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if certificates.IsPaused(crt) {
+		log.V(logf.DebugLevel).Info("certificate is paused, skipping")
+		return nil
+	}
+
+	var dnsNames, ipAddresses []string
+	if ref := crt.Spec.ServiceRef; ref != nil {
+		svc, err := c.serviceLister.Services(crt.Namespace).Get(ref.Name)
+		if apierrors.IsNotFound(err) {
+			// Keep the names resolved previously, if any, rather than
+			// re-issuing the certificate without them.
+			c.recorder.Eventf(crt, corev1.EventTypeWarning, reasonServiceNotFound, "Service %q referenced by spec.serviceRef does not exist", ref.Name)
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		dnsNames = ServiceDNSNames(svc, ref.ClusterDomain)
+		if ref.IncludeIPAddresses {
+			ipAddresses = ServiceIPAddresses(svc)
+		}
+	}
+
+	if slices.Equal(dnsNames, crt.Status.ServiceDNSNames) &&
+		slices.Equal(ipAddresses, crt.Status.ServiceIPAddresses) {
+		return nil
+	}
+
+	log.V(logf.DebugLevel).Info("updating the resolved Service names", "dns_names", dnsNames, "ip_addresses", ipAddresses)
+	crt = crt.DeepCopy()
+	crt.Status.ServiceDNSNames = dnsNames
+	crt.Status.ServiceIPAddresses = ipAddresses
+	return c.updateStatus(ctx, crt)
+}
+
+// ServiceDNSNames returns the cluster DNS names of the given Service. If the
+// Service is headless, a wildcard name covering the DNS names of its Pods is
+// included.
+func ServiceDNSNames(svc *corev1.Service, clusterDomain string) []string {
+	if clusterDomain == "" {
+		clusterDomain = defaultClusterDomain
+	}
+
+	fqdn := fmt.Sprintf("%s.%s.svc.%s", svc.Name, svc.Namespace, clusterDomain)
+	dnsNames := []string{
+		svc.Name,
+		fmt.Sprintf("%s.%s", svc.Name, svc.Namespace),
+		fmt.Sprintf("%s.%s.svc", svc.Name, svc.Namespace),
+		fqdn,
+	}
+	if svc.Spec.ClusterIP == corev1.ClusterIPNone {
+		dnsNames = append(dnsNames, "*."+fqdn)
+	}
+	return dnsNames
+}
+
+// ServiceIPAddresses returns the cluster IPs of the given Service, followed by
+// the ingress IPs of a LoadBalancer Service which have been assigned so far.
+func ServiceIPAddresses(svc *corev1.Service) []string {
+	clusterIPs := svc.Spec.ClusterIPs
+	if len(clusterIPs) == 0 && svc.Spec.ClusterIP != "" {
+		clusterIPs = []string{svc.Spec.ClusterIP}
+	}
+
+	var ipAddresses []string
+	add := func(ip string) {
+		if ip != "" && ip != corev1.ClusterIPNone && !slices.Contains(ipAddresses, ip) {
+			ipAddresses = append(ipAddresses, ip)
+		}
+	}
+	for _, ip := range clusterIPs {
+		add(ip)
+	}
+	for _, ingress := range svc.Status.LoadBalancer.Ingress {
+		add(ingress.IP)
+	}
+	return ipAddresses
+}
+
+// updateStatus updates the status.serviceDNSNames and
+// status.serviceIPAddresses of the given Certificate.
+func (c *controller) updateStatus(ctx context.Context, crt *cmapi.Certificate) error {
+	if utilfeature.DefaultFeatureGate.Enabled(feature.ServerSideApply) {
+		return internalcertificates.ApplyStatus(ctx, c.client, c.fieldManager, &cmapi.Certificate{
+			ObjectMeta: metav1.ObjectMeta{Namespace: crt.Namespace, Name: crt.Name},
+			Status: cmapi.CertificateStatus{
+				ServiceDNSNames:    crt.Status.ServiceDNSNames,
+				ServiceIPAddresses: crt.Status.ServiceIPAddresses,
+			},
+		})
+	}
+	_, err := c.client.CertmanagerV1().Certificates(crt.Namespace).UpdateStatus(ctx, crt, metav1.UpdateOptions{})
+	return err
+}
+
+// controllerWrapper wraps the `controller` structure to make it implement
+// the controllerpkg.queueingController interface
+type controllerWrapper struct {
+	*controller
+}
+
+func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.RateLimitingInterface, []cache.InformerSynced, error) {
+	// construct a new named logger to be reused throughout the controller
+	log := logf.FromContext(ctx.RootContext, ControllerName)
+
+	ctrl, queue, mustSync := NewController(log, ctx)
+	c.controller = ctrl
+
+	return queue, mustSync, nil
+}
+
+func init() {
+	controllerpkg.Register(ControllerName, func(ctx *controllerpkg.ContextFactory) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, ControllerName).
+			For(&controllerWrapper{}).
+			Complete()
+	})
+}
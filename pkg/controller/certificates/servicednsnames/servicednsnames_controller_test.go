@@ -0,0 +1,250 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicednsnames
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestServiceDNSNames(t *testing.T) {
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "web"},
+		Spec:       corev1.ServiceSpec{ClusterIP: "10.0.0.1"},
+	}
+	headless := svc.DeepCopy()
+	headless.Spec.ClusterIP = corev1.ClusterIPNone
+
+	tests := map[string]struct {
+		svc           *corev1.Service
+		clusterDomain string
+		expected      []string
+	}{
+		"uses the default cluster domain if none is set": {
+			svc:      svc,
+			expected: []string{"web", "web.testns", "web.testns.svc", "web.testns.svc.cluster.local"},
+		},
+		"uses the given cluster domain": {
+			svc:           svc,
+			clusterDomain: "example.internal",
+			expected:      []string{"web", "web.testns", "web.testns.svc", "web.testns.svc.example.internal"},
+		},
+		"includes a wildcard name for headless Services": {
+			svc:      headless,
+			expected: []string{"web", "web.testns", "web.testns.svc", "web.testns.svc.cluster.local", "*.web.testns.svc.cluster.local"},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, ServiceDNSNames(test.svc, test.clusterDomain))
+		})
+	}
+}
+
+func TestServiceIPAddresses(t *testing.T) {
+	tests := map[string]struct {
+		svc      *corev1.Service
+		expected []string
+	}{
+		"includes all cluster IPs of a dual-stack Service": {
+			svc: &corev1.Service{Spec: corev1.ServiceSpec{
+				ClusterIP:  "10.0.0.1",
+				ClusterIPs: []string{"10.0.0.1", "fd00::1"},
+			}},
+			expected: []string{"10.0.0.1", "fd00::1"},
+		},
+		"falls back to the cluster IP if cluster IPs is not set": {
+			svc:      &corev1.Service{Spec: corev1.ServiceSpec{ClusterIP: "10.0.0.1"}},
+			expected: []string{"10.0.0.1"},
+		},
+		"excludes the cluster IP of a headless Service": {
+			svc:      &corev1.Service{Spec: corev1.ServiceSpec{ClusterIP: corev1.ClusterIPNone, ClusterIPs: []string{corev1.ClusterIPNone}}},
+			expected: nil,
+		},
+		"includes the assigned LoadBalancer ingress IPs, ignoring hostnames": {
+			svc: &corev1.Service{
+				Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer, ClusterIP: "10.0.0.1", ClusterIPs: []string{"10.0.0.1"}},
+				Status: corev1.ServiceStatus{LoadBalancer: corev1.LoadBalancerStatus{Ingress: []corev1.LoadBalancerIngress{
+					{IP: "192.0.2.10"},
+					{Hostname: "lb.example.com"},
+					{IP: "192.0.2.10"},
+				}}},
+			},
+			expected: []string{"10.0.0.1", "192.0.2.10"},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, ServiceIPAddresses(test.svc))
+		})
+	}
+}
+
+func TestProcessItem(t *testing.T) {
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "web"},
+		Spec:       corev1.ServiceSpec{ClusterIP: "10.0.0.1", ClusterIPs: []string{"10.0.0.1"}},
+	}
+	lbSvc := svc.DeepCopy()
+	lbSvc.Spec.Type = corev1.ServiceTypeLoadBalancer
+	lbSvc.Status.LoadBalancer.Ingress = []corev1.LoadBalancerIngress{{IP: "192.0.2.10"}}
+	svcDNSNames := []string{"web", "web.testns", "web.testns.svc", "web.testns.svc.cluster.local"}
+
+	crt := gen.Certificate("test",
+		gen.SetCertificateNamespace("testns"),
+		gen.SetCertificateSecretName("test-secret"),
+	)
+	serviceRef := func(crt *cmapi.Certificate) {
+		crt.Spec.ServiceRef = &cmapi.CertificateServiceReference{Name: "web"}
+	}
+	includeIPAddresses := func(crt *cmapi.Certificate) {
+		crt.Spec.ServiceRef.IncludeIPAddresses = true
+	}
+	resolvedIPs := func(ipAddresses ...string) gen.CertificateModifier {
+		return func(crt *cmapi.Certificate) {
+			crt.Status.ServiceIPAddresses = ipAddresses
+		}
+	}
+	resolved := func(dnsNames ...string) gen.CertificateModifier {
+		return func(crt *cmapi.Certificate) {
+			crt.Status.ServiceDNSNames = dnsNames
+		}
+	}
+
+	tests := map[string]struct {
+		certificate *cmapi.Certificate
+		service     *corev1.Service
+
+		// expectedDNSNames is the status.serviceDNSNames the Certificate
+		// should be updated with, if it should be updated.
+		expectedDNSNames    []string
+		expectedIPAddresses []string
+		expectUpdate        bool
+		expectedEvents      []string
+	}{
+		"record the DNS names of the referenced Service": {
+			certificate:      gen.CertificateFrom(crt, serviceRef),
+			service:          svc,
+			expectUpdate:     true,
+			expectedDNSNames: svcDNSNames,
+		},
+		"update the DNS names if the Service has become headless": {
+			certificate:  gen.CertificateFrom(crt, serviceRef, resolved(svcDNSNames...)),
+			service:      &corev1.Service{ObjectMeta: svc.ObjectMeta, Spec: corev1.ServiceSpec{ClusterIP: corev1.ClusterIPNone}},
+			expectUpdate: true,
+			expectedDNSNames: append(append([]string{}, svcDNSNames...),
+				"*.web.testns.svc.cluster.local"),
+		},
+		"record the cluster IP of the referenced Service if includeIPAddresses is set": {
+			certificate:         gen.CertificateFrom(crt, serviceRef, includeIPAddresses),
+			service:             svc,
+			expectUpdate:        true,
+			expectedDNSNames:    svcDNSNames,
+			expectedIPAddresses: []string{"10.0.0.1"},
+		},
+		"add the LoadBalancer ingress IP once it has been assigned": {
+			certificate:         gen.CertificateFrom(crt, serviceRef, includeIPAddresses, resolved(svcDNSNames...), resolvedIPs("10.0.0.1")),
+			service:             lbSvc,
+			expectUpdate:        true,
+			expectedDNSNames:    svcDNSNames,
+			expectedIPAddresses: []string{"10.0.0.1", "192.0.2.10"},
+		},
+		"do nothing if the IP addresses are up to date": {
+			certificate: gen.CertificateFrom(crt, serviceRef, includeIPAddresses, resolved(svcDNSNames...), resolvedIPs("10.0.0.1", "192.0.2.10")),
+			service:     lbSvc,
+		},
+		"clear the IP addresses if includeIPAddresses is no longer set": {
+			certificate:      gen.CertificateFrom(crt, serviceRef, resolved(svcDNSNames...), resolvedIPs("10.0.0.1")),
+			service:          svc,
+			expectUpdate:     true,
+			expectedDNSNames: svcDNSNames,
+		},
+		"do nothing if the DNS names are up to date": {
+			certificate: gen.CertificateFrom(crt, serviceRef, resolved(svcDNSNames...)),
+			service:     svc,
+		},
+		"clear the DNS names if the Certificate no longer references a Service": {
+			certificate:  gen.CertificateFrom(crt, resolved(svcDNSNames...)),
+			service:      svc,
+			expectUpdate: true,
+		},
+		"fire an event and keep the DNS names if the Service does not exist": {
+			certificate: gen.CertificateFrom(crt, serviceRef, resolved(svcDNSNames...)),
+			expectedEvents: []string{
+				`Warning ServiceNotFound Service "web" referenced by spec.serviceRef does not exist`,
+			},
+		},
+		"do nothing if the Certificate is paused": {
+			certificate: gen.CertificateFrom(crt, serviceRef, func(crt *cmapi.Certificate) {
+				crt.Annotations = map[string]string{cmapi.CertificatePausedAnnotationKey: "true"}
+			}),
+			service: svc,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{
+				T:                  t,
+				CertManagerObjects: []runtime.Object{test.certificate},
+				ExpectedEvents:     test.expectedEvents,
+			}
+			if test.service != nil {
+				builder.KubeObjects = append(builder.KubeObjects, test.service)
+			}
+			if test.expectUpdate {
+				expected := test.certificate.DeepCopy()
+				expected.Status.ServiceDNSNames = test.expectedDNSNames
+				expected.Status.ServiceIPAddresses = test.expectedIPAddresses
+				builder.ExpectedActions = append(builder.ExpectedActions, testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+					cmapi.SchemeGroupVersion.WithResource("certificates"),
+					"status",
+					expected.Namespace,
+					expected,
+				)))
+			}
+			builder.Init()
+
+			w := &controllerWrapper{}
+			if _, _, err := w.Register(builder.Context); err != nil {
+				t.Fatal(err)
+			}
+
+			builder.Start()
+			defer builder.Stop()
+
+			if err := w.controller.ProcessItem(context.Background(), "testns/test"); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if err := builder.AllActionsExecuted(); err != nil {
+				t.Error(err)
+			}
+			if err := builder.AllEventsCalled(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -152,6 +152,12 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 	if err != nil {
 		return err
 	}
+
+	if certificates.IsPaused(crt) {
+		log.V(logf.DebugLevel).Info("Certificate is paused, skipping")
+		return nil
+	}
+
 	if apiutil.CertificateHasCondition(crt, cmapi.CertificateCondition{
 		Type:   cmapi.CertificateConditionIssuing,
 		Status: cmmeta.ConditionTrue,
@@ -196,10 +202,11 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 		return nil
 	}
 
-	if crt.Status.RenewalTime != nil {
+	if recheckTime, ok := nextRecheckTime(crt); ok {
 		// ensure a resync is scheduled in the future so that we re-check
-		// Certificate resources and trigger them near expiry time
-		c.scheduleRecheckOfCertificateIfRequired(log, key, crt.Status.RenewalTime.Time.Sub(c.clock.Now()))
+		// Certificate resources and trigger them near expiry time, or when
+		// their private key is due to be rotated
+		c.scheduleRecheckOfCertificateIfRequired(log, key, recheckTime.Sub(c.clock.Now()))
 	}
 
 	reason, message, reissue := c.shouldReissue(input)
@@ -273,7 +280,7 @@ func shouldBackoffReissuingOnFailure(log logr.Logger, c clock.Clock, crt *cmapi.
 	if nextCR == nil {
 		log.V(logf.InfoLevel).Info("next CertificateRequest not available, skipping checking if Certificate matches the CertificateRequest")
 	} else {
-		mismatches, err := pki.RequestMatchesSpec(nextCR, crt.Spec)
+		mismatches, err := pki.RequestMatchesSpec(nextCR, pki.SpecForCertificate(crt))
 		if err != nil {
 			log.V(logf.InfoLevel).Info("next CertificateRequest cannot be decoded, skipping checking if Certificate matches the CertificateRequest")
 			return false, 0
@@ -322,6 +329,22 @@ func shouldBackoffReissuingOnFailure(log logr.Logger, c clock.Clock, crt *cmapi.
 	return true, delay - durationSinceFailure
 }
 
+// nextRecheckTime returns the earliest of the Certificate's renewal time and
+// the time at which its private key is due to be rotated, if any.
+func nextRecheckTime(crt *cmapi.Certificate) (time.Time, bool) {
+	var recheckTime time.Time
+	if crt.Status.RenewalTime != nil {
+		recheckTime = crt.Status.RenewalTime.Time
+	}
+
+	if rotationTime, ok := internalcertificates.PrivateKeyRotationTime(crt); ok &&
+		(recheckTime.IsZero() || rotationTime.Before(recheckTime)) {
+		recheckTime = rotationTime
+	}
+
+	return recheckTime, !recheckTime.IsZero()
+}
+
 // scheduleRecheckOfCertificateIfRequired will schedule the resource with the
 // given key to be re-queued for processing after the given amount of time
 // has elapsed.
@@ -163,6 +163,42 @@ func Test_controller_ProcessItem(t *testing.T) {
 				ObservedGeneration: 42,
 			}},
 		},
+		"should do nothing if Certificate is paused": {
+			existingCertificate: gen.Certificate("cert-1", gen.SetCertificateNamespace("testns"),
+				gen.SetCertificateGeneration(42),
+				gen.AddCertificateAnnotations(map[string]string{cmapi.CertificatePausedAnnotationKey: "true"}),
+			),
+			wantDataForCertificateCalled: false,
+			wantShouldReissueCalled:      false,
+			mockShouldReissue: func(*testing.T) policies.Func {
+				return func(policies.Input) (string, string, bool) {
+					return "ForceTriggered", "Re-issuance forced by unit test case", true
+				}
+			},
+		},
+		"should set Issuing=True once a Certificate is unpaused": {
+			existingCertificate: gen.Certificate("cert-1", gen.SetCertificateNamespace("testns"),
+				gen.SetCertificateGeneration(42),
+				gen.AddCertificateAnnotations(map[string]string{cmapi.CertificatePausedAnnotationKey: "false"}),
+			),
+			wantDataForCertificateCalled: true,
+			mockDataForCertificateReturn: policies.Input{},
+			wantShouldReissueCalled:      true,
+			mockShouldReissue: func(*testing.T) policies.Func {
+				return func(policies.Input) (string, string, bool) {
+					return "ForceTriggered", "Re-issuance forced by unit test case", true
+				}
+			},
+			wantEvent: "Normal Issuing Re-issuance forced by unit test case",
+			wantConditions: []cmapi.CertificateCondition{{
+				Type:               "Issuing",
+				Status:             "True",
+				Reason:             "ForceTriggered",
+				Message:            "Re-issuance forced by unit test case",
+				LastTransitionTime: &fixedNow,
+				ObservedGeneration: 42,
+			}},
+		},
 		// The combinations of number of failed issuances and last
 		// failed issuance time that do or do not result in re-issuance
 		// are tested in Test_shouldBackoffReissuingOnFailure below
@@ -819,3 +855,54 @@ func Test_shouldBackoffReissuingOnFailure(t *testing.T) {
 
 	}
 }
+
+func Test_nextRecheckTime(t *testing.T) {
+	now := time.Date(2020, 11, 20, 16, 05, 00, 0000, time.UTC)
+	scheduled := gen.SetCertificateKeyRotationPolicy(cmapi.RotationPolicyScheduled, &metav1.Duration{Duration: 24 * time.Hour})
+
+	tests := map[string]struct {
+		givenCert *cmapi.Certificate
+		wantTime  time.Time
+		wantOK    bool
+	}{
+		"should not recheck if neither a renewal time nor a private key rotation is set": {
+			givenCert: gen.Certificate("cert-1"),
+			wantOK:    false,
+		},
+		"should recheck at the renewal time": {
+			givenCert: gen.Certificate("cert-1", gen.SetCertificateRenewalTime(metav1.NewTime(now.Add(time.Hour)))),
+			wantTime:  now.Add(time.Hour),
+			wantOK:    true,
+		},
+		"should recheck at the renewal time if it is before the private key rotation": {
+			givenCert: gen.Certificate("cert-1", scheduled,
+				gen.SetCertificateRenewalTime(metav1.NewTime(now.Add(time.Hour))),
+				gen.SetCertificateLastPrivateKeyRotationTime(metav1.NewTime(now)),
+			),
+			wantTime: now.Add(time.Hour),
+			wantOK:   true,
+		},
+		"should recheck at the private key rotation if it is before the renewal time": {
+			givenCert: gen.Certificate("cert-1", scheduled,
+				gen.SetCertificateRenewalTime(metav1.NewTime(now.Add(48*time.Hour))),
+				gen.SetCertificateLastPrivateKeyRotationTime(metav1.NewTime(now)),
+			),
+			wantTime: now.Add(24 * time.Hour),
+			wantOK:   true,
+		},
+		"should recheck at the private key rotation if no renewal time is set": {
+			givenCert: gen.Certificate("cert-1", scheduled,
+				gen.SetCertificateLastPrivateKeyRotationTime(metav1.NewTime(now)),
+			),
+			wantTime: now.Add(24 * time.Hour),
+			wantOK:   true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotTime, gotOK := nextRecheckTime(test.givenCert)
+			assert.Equal(t, test.wantOK, gotOK)
+			assert.True(t, test.wantTime.Equal(gotTime), "expected %s, got %s", test.wantTime, gotTime)
+		})
+	}
+}
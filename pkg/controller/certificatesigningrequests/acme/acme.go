@@ -283,6 +283,7 @@ func (a *ACME) buildOrder(csr *certificatesv1.CertificateSigningRequest, req *x5
 		CommonName:  req.Subject.CommonName,
 		DNSNames:    dnsNames,
 		IPAddresses: ipAddresses,
+		Profile:     iss.GetSpec().ACME.Profile,
 	}
 
 	if iss.GetSpec().ACME.EnableDurationFeature {
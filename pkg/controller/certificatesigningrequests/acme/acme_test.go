@@ -952,6 +952,7 @@ func Test_buildOrder(t *testing.T) {
 
 	tests := map[string]struct {
 		enableDurationFeature bool
+		profile               string
 
 		want    *cmacme.Order
 		wantErr bool
@@ -989,6 +990,23 @@ func Test_buildOrder(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		"Building with an ACME profile": {
+			profile: "shortlived",
+			want: &cmacme.Order{
+				Spec: cmacme.OrderSpec{
+					Request:    csrPEM,
+					CommonName: "example.com",
+					DNSNames:   []string{"example.com"},
+					Profile:    "shortlived",
+					IssuerRef: cmmeta.ObjectReference{
+						Name:  "test-name",
+						Kind:  "Issuer",
+						Group: "cert-manager.io",
+					},
+				},
+			},
+			wantErr: false,
+		},
 	}
 
 	for name, test := range tests {
@@ -998,6 +1016,7 @@ func Test_buildOrder(t *testing.T) {
 					IssuerConfig: cmapi.IssuerConfig{
 						ACME: &cmacme.ACMEIssuer{
 							EnableDurationFeature: test.enableDurationFeature,
+							Profile:               test.profile,
 						},
 					},
 				},
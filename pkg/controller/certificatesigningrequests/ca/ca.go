@@ -20,12 +20,15 @@ import (
 	"context"
 	"crypto"
 	"crypto/x509"
+	"errors"
 	"fmt"
+	"time"
 
 	certificatesv1 "k8s.io/api/certificates/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	certificatesclient "k8s.io/client-go/kubernetes/typed/certificates/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/record"
 
 	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
@@ -34,6 +37,7 @@ import (
 	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificatesigningrequests"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificatesigningrequests/util"
+	caissuer "github.com/cert-manager/cert-manager/pkg/issuer/ca"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 	cmerrors "github.com/cert-manager/cert-manager/pkg/util/errors"
 	"github.com/cert-manager/cert-manager/pkg/util/kube"
@@ -53,6 +57,7 @@ type signingFn func([]*x509.Certificate, crypto.Signer, *x509.Certificate) (pki.
 type CA struct {
 	issuerOptions controllerpkg.IssuerOptions
 	secretsLister internalinformers.SecretLister
+	secretsClient corev1client.SecretsGetter
 
 	certClient certificatesclient.CertificateSigningRequestInterface
 
@@ -79,6 +84,7 @@ func NewCA(ctx *controllerpkg.Context) certificatesigningrequests.Signer {
 	return &CA{
 		issuerOptions:     ctx.IssuerOptions,
 		secretsLister:     ctx.KubeSharedInformerFactory.Secrets().Lister(),
+		secretsClient:     ctx.Client.CoreV1(),
 		certClient:        ctx.Client.CertificatesV1().CertificateSigningRequests(),
 		fieldManager:      ctx.FieldManager,
 		recorder:          ctx.Recorder,
@@ -127,10 +133,73 @@ func (c *CA) Sign(ctx context.Context, csr *certificatesv1.CertificateSigningReq
 		return err
 	}
 
-	template.CRLDistributionPoints = issuerObj.GetSpec().CA.CRLDistributionPoints
+	if issuerObj.GetSpec().CA.SerialNumberStrategy == cmapi.SequentialSerialNumberStrategy {
+		template.SerialNumber, err = caissuer.NextSequentialSerialNumber(ctx, c.secretsClient, resourceNamespace, secretName)
+		if errors.Is(err, caissuer.ErrSerialNumbersExhausted) {
+			message := fmt.Sprintf("Failed to allocate a sequential serial number: %s", err)
+			c.recorder.Event(csr, corev1.EventTypeWarning, "SigningError", message)
+			util.CertificateSigningRequestSetFailed(csr, "SigningError", message)
+			_, err := util.UpdateOrApplyStatus(ctx, c.certClient, csr, certificatesv1.CertificateFailed, c.fieldManager)
+			return err
+		}
+		if err != nil {
+			message := "Failed to allocate a sequential serial number"
+			c.recorder.Eventf(csr, corev1.EventTypeWarning, "SerialNumberError", "%s: %s", message, err)
+			return err
+		}
+	}
+
+	template.CRLDistributionPoints, err = caissuer.RenderCRLDistributionPoints(issuerObj.GetSpec().CA.CRLDistributionPoints, issuerObj.GetName(), template)
+	if err != nil {
+		message := fmt.Sprintf("Error rendering CRL distribution points: %s", err)
+		c.recorder.Event(csr, corev1.EventTypeWarning, "SigningError", message)
+		util.CertificateSigningRequestSetFailed(csr, "SigningError", message)
+		_, err := util.UpdateOrApplyStatus(ctx, c.certClient, csr, certificatesv1.CertificateFailed, c.fieldManager)
+		return err
+	}
 	template.OCSPServer = issuerObj.GetSpec().CA.OCSPServers
 	template.IssuingCertificateURL = issuerObj.GetSpec().CA.IssuingCertificateURLs
 
+	if err := caissuer.CopyAllowedExtraExtensions(issuerObj.GetSpec().CA.AllowedExtraExtensions, csr.Spec.Request, template); err != nil {
+		message := fmt.Sprintf("Error copying allowed extensions from the certificate request: %s", err)
+		c.recorder.Event(csr, corev1.EventTypeWarning, "SigningError", message)
+		util.CertificateSigningRequestSetFailed(csr, "SigningError", message)
+		_, err := util.UpdateOrApplyStatus(ctx, c.certClient, csr, certificatesv1.CertificateFailed, c.fieldManager)
+		return err
+	}
+
+	if err := caissuer.ApplySignatureAlgorithm(issuerObj.GetSpec().CA, caKey, template); err != nil {
+		message := fmt.Sprintf("Error applying the issuer's signature algorithm: %s", err)
+		c.recorder.Event(csr, corev1.EventTypeWarning, "SigningError", message)
+		util.CertificateSigningRequestSetFailed(csr, "SigningError", message)
+		_, err := util.UpdateOrApplyStatus(ctx, c.certClient, csr, certificatesv1.CertificateFailed, c.fieldManager)
+		return err
+	}
+
+	if err := caissuer.ApplyAuthorityKeyIdentifier(issuerObj.GetSpec().CA, template); err != nil {
+		message := fmt.Sprintf("Error applying the issuer's authority key identifier: %s", err)
+		c.recorder.Event(csr, corev1.EventTypeWarning, "SigningError", message)
+		util.CertificateSigningRequestSetFailed(csr, "SigningError", message)
+		_, err := util.UpdateOrApplyStatus(ctx, c.certClient, csr, certificatesv1.CertificateFailed, c.fieldManager)
+		return err
+	}
+
+	caissuer.ApplyOCSPNoCheck(template)
+
+	notAfter := template.NotAfter
+	clamped, err := caissuer.ApplyCAExpiryPolicy(issuerObj.GetSpec().CA, caCerts[0], template)
+	if err != nil {
+		message := fmt.Sprintf("Refusing to sign certificate which would outlive the CA: %s", err)
+		c.recorder.Event(csr, corev1.EventTypeWarning, "CAExpiryExceeded", message)
+		util.CertificateSigningRequestSetFailed(csr, "CAExpiryExceeded", message)
+		_, err := util.UpdateOrApplyStatus(ctx, c.certClient, csr, certificatesv1.CertificateFailed, c.fieldManager)
+		return err
+	}
+	if clamped {
+		c.recorder.Eventf(csr, corev1.EventTypeNormal, "NotAfterClamped", "Certificate NotAfter clamped from %s to %s to not outlive the CA",
+			notAfter.UTC().Format(time.RFC3339), template.NotAfter.UTC().Format(time.RFC3339))
+	}
+
 	bundle, err := c.signingFn(caCerts, caKey, template)
 	if err != nil {
 		message := fmt.Sprintf("Error signing certificate: %s", err)
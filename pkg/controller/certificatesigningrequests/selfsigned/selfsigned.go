@@ -40,6 +40,7 @@ import (
 	"github.com/cert-manager/cert-manager/pkg/controller/certificatesigningrequests"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificatesigningrequests/util"
 	"github.com/cert-manager/cert-manager/pkg/issuer"
+	selfsignedissuer "github.com/cert-manager/cert-manager/pkg/issuer/selfsigned"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 	cmerrors "github.com/cert-manager/cert-manager/pkg/util/errors"
 	"github.com/cert-manager/cert-manager/pkg/util/kube"
@@ -172,6 +173,16 @@ func (s *SelfSigned) Sign(ctx context.Context, csr *certificatesv1.CertificateSi
 
 	template.CRLDistributionPoints = issuerObj.GetSpec().SelfSigned.CRLDistributionPoints
 
+	parent, err := selfsignedissuer.ParentCertificate(issuerObj.GetSpec().SelfSigned, template)
+	if err != nil {
+		message := fmt.Sprintf("Error generating certificate template: %s", err)
+		log.Error(err, message)
+		s.recorder.Event(csr, corev1.EventTypeWarning, "ErrorGenerating", message)
+		util.CertificateSigningRequestSetFailed(csr, "ErrorGenerating", message)
+		_, err = util.UpdateOrApplyStatus(ctx, s.certClient, csr, certificatesv1.CertificateFailed, s.fieldManager)
+		return err
+	}
+
 	// extract the public component of the key
 	publickey, err := pki.PublicKeyForPrivateKey(privatekey)
 	if err != nil {
@@ -197,7 +208,7 @@ func (s *SelfSigned) Sign(ctx context.Context, csr *certificatesv1.CertificateSi
 		return err
 	}
 
-	certPEM, _, err := s.signingFn(template, template, publickey, privatekey)
+	certPEM, _, err := s.signingFn(template, parent, publickey, privatekey)
 	if err != nil {
 		message := fmt.Sprintf("Error signing certificate: %s", err)
 		s.recorder.Event(csr, corev1.EventTypeWarning, "ErrorSigning", message)
@@ -18,6 +18,7 @@ package vault
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	certificatesv1 "k8s.io/api/certificates/v1"
@@ -116,6 +117,13 @@ func (v *Vault) Sign(ctx context.Context, csr *certificatesv1.CertificateSigning
 	}
 
 	certPEM, _, err := client.Sign(csr.Spec.Request, duration)
+	if errors.Is(err, internalvault.ErrReauthenticationFailed) {
+		message := fmt.Sprintf("Failed to re-authenticate with Vault: %s", err)
+		log.Error(err, message)
+		v.recorder.Event(csr, corev1.EventTypeWarning, "ErrorVaultInit", message)
+		return err
+	}
+
 	if err != nil {
 		message := fmt.Sprintf("Vault failed to sign: %s", err)
 		log.Error(err, message)
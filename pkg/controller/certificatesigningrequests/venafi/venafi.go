@@ -141,7 +141,7 @@ func (v *Venafi) Sign(ctx context.Context, csr *certificatesv1.CertificateSignin
 		if err != nil {
 			switch err.(type) {
 
-			case venaficlient.ErrCustomFieldsType:
+			case venaficlient.ErrCustomFieldsType, venaficlient.ErrCustomFieldsRequired:
 				log.Error(err, "")
 				v.recorder.Event(csr, corev1.EventTypeWarning, "ErrorCustomFields", err.Error())
 				util.CertificateSigningRequestSetFailed(csr, "ErrorCustomFields", err.Error())
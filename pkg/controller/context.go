@@ -114,6 +114,10 @@ type Context struct {
 	GWShared             gwinformers.SharedInformerFactory
 	GatewaySolverEnabled bool
 
+	// IssuerSetupRequests is used to ask the issuers and clusterissuers
+	// controllers to set up an issuer again.
+	IssuerSetupRequests *IssuerSetupRequests
+
 	ContextOptions
 }
 
@@ -146,6 +150,8 @@ type ContextOptions struct {
 
 	IssuerOptions
 	ACMEOptions
+	VenafiOptions
+	PodIdentityOptions
 	IngressShimOptions
 	CertificateOptions
 	SchedulerOptions
@@ -192,6 +198,14 @@ type ACMEOptions struct {
 	// ACMEHTTP01SolverRunAsNonRoot sets the ACME pod's ability to run as root
 	ACMEHTTP01SolverRunAsNonRoot bool
 
+	// HTTP01SolverSecurityContext, if set, replaces the default container
+	// security context of the ACME HTTP01 solver container
+	HTTP01SolverSecurityContext *corev1.SecurityContext
+
+	// HTTP01SolverPodSecurityContext, if set, replaces the default pod
+	// security context of the ACME HTTP01 solver pod
+	HTTP01SolverPodSecurityContext *corev1.PodSecurityContext
+
 	// HTTP01SolverNameservers is a list of nameservers to use when performing self-checks
 	// for ACME HTTP01 validations.
 	HTTP01SolverNameservers []string
@@ -200,6 +214,11 @@ type ACMEOptions struct {
 	// for checking propagation of an RR. This is the ideal scenario
 	DNS01CheckAuthoritative bool
 
+	// DNS01RecursiveNameserversQuorum is the number of DNS01Nameservers which
+	// must agree a record is present when DNS01CheckAuthoritative is false.
+	// If zero, all nameservers must agree.
+	DNS01RecursiveNameserversQuorum int
+
 	// DNS01Nameservers is a list of nameservers to use when performing self-checks
 	// for ACME DNS01 validations.
 	DNS01Nameservers []string
@@ -210,6 +229,31 @@ type ACMEOptions struct {
 
 	// DNS01CheckRetryPeriod is the time the controller should wait between checking if a ACME dns entry exists.
 	DNS01CheckRetryPeriod time.Duration
+
+	// DNS01CheckTimeout is the maximum time to wait for a DNS01 challenge
+	// record to propagate before the challenge is marked as errored, unless
+	// overridden by the solver. Zero disables the timeout.
+	DNS01CheckTimeout time.Duration
+
+	// OrderPollJitter is the maximum jitter factor applied to the interval at
+	// which pending ACME Orders are re-queued. If zero, no jitter is applied.
+	OrderPollJitter float64
+}
+
+type VenafiOptions struct {
+	// MaxSetupRetryInterval is the maximum duration to wait before retrying
+	// the setup of a Venafi issuer which has previously failed.
+	MaxSetupRetryInterval time.Duration
+}
+
+type PodIdentityOptions struct {
+	// AllowedIssuers are the issuers which Pods may request a certificate
+	// from, each in the form <kind>.<group>/<name> or <kind>/<name>.
+	AllowedIssuers []string
+
+	// TrustDomain is the SPIFFE trust domain of the URI SANs identifying the
+	// ServiceAccount of a Pod.
+	TrustDomain string
 }
 
 // IngressShimOptions contain default Issuer GVK config for the certificate-shim controllers.
@@ -229,12 +273,22 @@ type CertificateOptions struct {
 	// CopiedAnnotationPrefixes defines which annotations should be copied
 	// Certificate -> CertificateRequest, CertificateRequest -> Order.
 	CopiedAnnotationPrefixes []string
+	// EnableChainVerification controls whether the certificate chain returned
+	// by an issuer is verified to be complete before it is stored in the
+	// Certificate's Secret.
+	EnableChainVerification bool
 }
 
 type SchedulerOptions struct {
 	// MaxConcurrentChallenges determines the maximum number of challenges that can be
 	// scheduled as 'processing' at once.
 	MaxConcurrentChallenges int
+
+	// MaxConcurrentCertificateRequestsPerIssuer determines the maximum number
+	// of CertificateRequests that can be processed at once for each Issuer or
+	// ClusterIssuer, keyed by issuer type. Issuer types which are not present
+	// are not limited.
+	MaxConcurrentCertificateRequestsPerIssuer map[string]int
 }
 
 // ContextFactory is used for constructing new Contexts who's clients have been
@@ -297,8 +351,8 @@ func NewContextFactory(ctx context.Context, opts ContextOptions) (*ContextFactor
 	isHTTP01ChallengeResourceLabelSelector := labels.NewSelector().Add(*r)
 	http01ResourceMetadataInformerFactory := metadatainformer.NewFilteredSharedInformerFactory(clients.metadataOnlyClient, resyncPeriod, opts.Namespace, func(listOptions *metav1.ListOptions) {
 		// metadataInformersFactory is at the moment only used for pods
-		// and services for http-01 challenge which can be identified by
-		// the same label keys, so it is okay to set the label selector
+		// and services for http-01 and tls-alpn-01 challenges which can be
+		// identified by the same label keys, so it is okay to set the label selector
 		// here. If we start using it for other resources then we'll
 		// have to set the selectors on individual informers instead.
 		listOptions.LabelSelector = isHTTP01ChallengeResourceLabelSelector.String()
@@ -317,6 +371,7 @@ func NewContextFactory(ctx context.Context, opts ContextOptions) (*ContextFactor
 			GWShared:                               gwSharedInformerFactory,
 			GatewaySolverEnabled:                   clients.gatewayAvailable,
 			HTTP01ResourceMetadataInformersFactory: http01ResourceMetadataInformerFactory,
+			IssuerSetupRequests:                    NewIssuerSetupRequests(),
 			ContextOptions:                         opts,
 		},
 	}, nil
@@ -18,6 +18,7 @@ package controller
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"sync"
@@ -32,6 +33,12 @@ import (
 	"github.com/cert-manager/cert-manager/pkg/metrics"
 )
 
+// ErrLeaderElectionLost is the cause of the cancellation of a controller's
+// context when the process has lost leader election. Controllers do not run
+// their shutdown functions in this case, as another instance may already be
+// the leader.
+var ErrLeaderElectionLost = errors.New("leader election lost")
+
 type runFunc func(context.Context)
 
 type runDurationFunc struct {
@@ -39,6 +46,11 @@ type runDurationFunc struct {
 	duration time.Duration
 }
 
+type runShutdownFunc struct {
+	fn          runFunc
+	gracePeriod time.Duration
+}
+
 type queueingController interface {
 	Register(*Context) (workqueue.RateLimitingInterface, []cache.InformerSynced, error)
 	ProcessItem(ctx context.Context, key string) error
@@ -52,12 +64,25 @@ func NewController(
 	runDurationFuncs []runDurationFunc,
 	queue workqueue.RateLimitingInterface,
 ) Interface {
+	return newController(name, metrics, syncFunc, mustSync, runDurationFuncs, nil, queue)
+}
+
+func newController(
+	name string,
+	metrics *metrics.Metrics,
+	syncFunc func(ctx context.Context, key string) error,
+	mustSync []cache.InformerSynced,
+	runDurationFuncs []runDurationFunc,
+	runShutdownFuncs []runShutdownFunc,
+	queue workqueue.RateLimitingInterface,
+) *controller {
 	return &controller{
 		name:             name,
 		metrics:          metrics,
 		syncHandler:      syncFunc,
 		mustSync:         mustSync,
 		runDurationFuncs: runDurationFuncs,
+		runShutdownFuncs: runShutdownFuncs,
 		queue:            queue,
 	}
 }
@@ -80,6 +105,11 @@ type controller struct {
 	// a set of functions that should be called every duration.
 	runDurationFuncs []runDurationFunc
 
+	// a set of functions that will be called once all workers have exited
+	// after the controller has been signaled to shut down. Each is given a
+	// context which is not cancelled until its grace period has passed.
+	runShutdownFuncs []runShutdownFunc
+
 	// queue is a reference to the queue used to enqueue resources
 	// to be processed
 	queue workqueue.RateLimitingInterface
@@ -124,6 +154,19 @@ func (c *controller) Run(workers int, ctx context.Context) error {
 	log.V(logf.DebugLevel).Info("waiting for workers to exit...")
 	wg.Wait()
 	log.V(logf.DebugLevel).Info("workers exited")
+
+	if errors.Is(context.Cause(ctx), ErrLeaderElectionLost) {
+		if len(c.runShutdownFuncs) > 0 {
+			log.V(logf.InfoLevel).Info("not running shutdown functions as leader election was lost")
+		}
+		return nil
+	}
+
+	for _, f := range c.runShutdownFuncs {
+		shutdownCtx, cancelShutdown := context.WithTimeout(context.WithoutCancel(ctx), f.gracePeriod)
+		f.fn(shutdownCtx)
+		cancelShutdown()
+	}
 	return nil
 }
 
@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+)
+
+func TestRunShutdownFuncs(t *testing.T) {
+	tests := map[string]struct {
+		cause       error
+		expShutdown bool
+	}{
+		"shutdown functions are called when the controller is stopped": {
+			cause:       nil,
+			expShutdown: true,
+		},
+		"shutdown functions are called when another controller fails": {
+			cause:       errors.New("error starting controller"),
+			expShutdown: true,
+		},
+		"shutdown functions are not called when leader election is lost": {
+			cause:       ErrLeaderElectionLost,
+			expShutdown: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var called bool
+			shutdown := []runShutdownFunc{{
+				fn:          func(context.Context) { called = true },
+				gracePeriod: time.Second,
+			}}
+			queue := workqueue.NewRateLimitingQueue(DefaultItemBasedRateLimiter())
+			c := newController("test", nil, func(context.Context, string) error { return nil }, nil, nil, shutdown, queue)
+
+			ctx, cancel := context.WithCancelCause(context.Background())
+			cancel(test.cause)
+
+			if err := c.Run(1, ctx); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if called != test.expShutdown {
+				t.Errorf("expected shutdown functions called=%t, got %t", test.expShutdown, called)
+			}
+		})
+	}
+}
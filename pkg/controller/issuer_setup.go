@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sync"
+
+	"k8s.io/client-go/util/workqueue"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// IssuerSetupRequests lets controllers ask the issuers and clusterissuers
+// controllers to run Setup for an issuer again, for example when the issuer's
+// credentials were rejected while signing a request. The status of an issuer
+// is only ever written by the issuers and clusterissuers controllers.
+// A nil *IssuerSetupRequests ignores all requests.
+type IssuerSetupRequests struct {
+	lock   sync.Mutex
+	queues map[string]workqueue.RateLimitingInterface
+}
+
+// NewIssuerSetupRequests returns an IssuerSetupRequests with no registered
+// queues.
+func NewIssuerSetupRequests() *IssuerSetupRequests {
+	return &IssuerSetupRequests{
+		queues: make(map[string]workqueue.RateLimitingInterface),
+	}
+}
+
+// Register sets the queue of the controller which sets up issuers of the
+// given kind.
+func (r *IssuerSetupRequests) Register(kind string, queue workqueue.RateLimitingInterface) {
+	if r == nil {
+		return
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.queues[kind] = queue
+}
+
+// Request queues the given issuer to be set up again. The issuer is added to
+// the queue using its rate limiter, so that an issuer whose credentials are
+// repeatedly rejected is not checked more often than on any other failure.
+// Nothing is done if the controller for the issuer's kind is not running.
+func (r *IssuerSetupRequests) Request(iss cmapi.GenericIssuer) {
+	if r == nil {
+		return
+	}
+
+	var kind string
+	switch iss.(type) {
+	case *cmapi.Issuer:
+		kind = cmapi.IssuerKind
+	case *cmapi.ClusterIssuer:
+		kind = cmapi.ClusterIssuerKind
+	default:
+		return
+	}
+
+	r.lock.Lock()
+	queue := r.queues[kind]
+	r.lock.Unlock()
+	if queue == nil {
+		return
+	}
+
+	key, err := KeyFunc(iss)
+	if err != nil {
+		return
+	}
+	queue.AddRateLimited(key)
+}
@@ -100,6 +100,19 @@ func (c *controller) issuersForSecret(secret *corev1.Secret) ([]*v1.Issuer, erro
 					continue
 				}
 			}
+		case iss.Spec.SigningWebhook != nil:
+			if iss.Spec.SigningWebhook.ClientCertSecretRef != nil {
+				if iss.Spec.SigningWebhook.ClientCertSecretRef.Name == secret.Name {
+					affected = append(affected, iss)
+					continue
+				}
+			}
+			if iss.Spec.SigningWebhook.ClientKeySecretRef != nil {
+				if iss.Spec.SigningWebhook.ClientKeySecretRef.Name == secret.Name {
+					affected = append(affected, iss)
+					continue
+				}
+			}
 		}
 	}
 
@@ -18,6 +18,7 @@ package issuers
 
 import (
 	"context"
+	"time"
 
 	"github.com/go-logr/logr"
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
@@ -26,11 +27,13 @@ import (
 	"k8s.io/client-go/util/workqueue"
 
 	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
 	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
 	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
 	"github.com/cert-manager/cert-manager/pkg/issuer"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/metrics"
 )
 
 type controller struct {
@@ -56,6 +59,14 @@ type controller struct {
 
 	// fieldManager is the manager name used for the Apply operations.
 	fieldManager string
+
+	// metrics is used to stop exposing the metrics of deleted issuers.
+	metrics *metrics.Metrics
+
+	// venafiSetupBackoff computes the interval between attempts to set up
+	// Venafi issuers which have previously failed to do so. It is nil if no
+	// ceiling for the interval has been configured.
+	venafiSetupBackoff workqueue.RateLimiter
 }
 
 // Register registers and constructs the controller using the provided context.
@@ -91,6 +102,11 @@ func (c *controller) Register(ctx *controllerpkg.Context) (workqueue.RateLimitin
 	c.cmClient = ctx.CMClient
 	c.fieldManager = ctx.FieldManager
 	c.recorder = ctx.Recorder
+	ctx.IssuerSetupRequests.Register(cmapi.IssuerKind, c.queue)
+	c.metrics = ctx.Metrics
+	if ctx.VenafiOptions.MaxSetupRetryInterval > 0 {
+		c.venafiSetupBackoff = workqueue.NewItemExponentialFailureRateLimiter(time.Second*5, ctx.VenafiOptions.MaxSetupRetryInterval)
+	}
 
 	return c.queue, mustSync, nil
 }
@@ -132,6 +148,7 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 	if err != nil {
 		if k8sErrors.IsNotFound(err) {
 			log.Error(err, "issuer in work queue no longer exists")
+			c.metrics.RemoveIssuer(name, namespace, cmapi.IssuerKind)
 			return nil
 		}
 
@@ -28,6 +28,7 @@ import (
 	internalissuers "github.com/cert-manager/cert-manager/internal/controller/issuers"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	"github.com/cert-manager/cert-manager/pkg/controller/globals"
+	"github.com/cert-manager/cert-manager/pkg/issuer"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 )
@@ -56,17 +57,66 @@ func (c *controller) Sync(ctx context.Context, iss *cmapi.Issuer) (err error) {
 		return err
 	}
 
+	if f, ok := i.(issuer.Finalizer); ok {
+		if updated, err := internalissuers.SyncFinalizer(ctx, c.cmClient, iss, f); updated || err != nil {
+			return err
+		}
+	}
+
 	err = i.Setup(ctx)
 	if err != nil {
 		s := messageErrorInitIssuer + err.Error()
 		log.V(logf.WarnLevel).Info(s)
 		c.recorder.Event(issuerCopy, corev1.EventTypeWarning, errorInitIssuer, s)
+		if issuerCopy.Spec.Venafi != nil && c.venafiSetupBackoff != nil {
+			return c.requeueVenafiSetup(ctx, iss, err)
+		}
 		return err
 	}
 
+	if issuerCopy.Spec.Venafi != nil {
+		c.venafiSetupSucceeded(ctx, issuerCopy)
+	}
+
 	return nil
 }
 
+// requeueVenafiSetup schedules another attempt to set up a Venafi issuer
+// which failed to be set up. The interval between attempts grows
+// exponentially but, unlike the workqueue's own rate limiter, never exceeds
+// the configured MaxSetupRetryInterval.
+func (c *controller) requeueVenafiSetup(ctx context.Context, iss *cmapi.Issuer, setupErr error) error {
+	key, err := keyFunc(iss)
+	if err != nil {
+		return setupErr
+	}
+
+	interval := c.venafiSetupBackoff.When(key)
+	logf.FromContext(ctx).V(logf.DebugLevel).Info("retrying Venafi issuer setup", "interval", interval)
+	c.queue.AddAfter(key, interval)
+
+	return nil
+}
+
+// venafiSetupSucceeded resets the setup backoff of a Venafi issuer and, if
+// a credential check interval is configured, schedules the next run of Setup
+// so that the issuer's credentials are periodically re-verified.
+func (c *controller) venafiSetupSucceeded(ctx context.Context, iss *cmapi.Issuer) {
+	key, err := keyFunc(iss)
+	if err != nil {
+		return
+	}
+
+	if c.venafiSetupBackoff != nil {
+		c.venafiSetupBackoff.Forget(key)
+	}
+
+	if interval := iss.Spec.Venafi.CredentialCheckInterval; interval != nil && interval.Duration > 0 {
+		logf.FromContext(ctx).V(logf.DebugLevel).Info("scheduling Venafi credential check", "interval", interval.Duration)
+		c.queue.AddAfter(key, interval.Duration)
+	}
+}
+
 func (c *controller) updateIssuerStatus(ctx context.Context, oldIssuer, newIssuer *cmapi.Issuer) error {
 	if apiequality.Semantic.DeepEqual(oldIssuer.Status, newIssuer.Status) {
 		return nil
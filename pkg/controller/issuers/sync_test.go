@@ -18,18 +18,25 @@ package issuers
 
 import (
 	"context"
+	"errors"
 	"reflect"
 	"runtime/debug"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	clientgotesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/util/workqueue"
 
 	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/pkg/issuer"
+	"github.com/cert-manager/cert-manager/pkg/issuer/fake"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
 )
 
 func newFakeIssuerWithStatus(name string, status v1.IssuerStatus) *v1.Issuer {
@@ -45,6 +52,100 @@ func TestSync(t *testing.T) {
 
 }
 
+// fakeQueue records the durations items are requeued after.
+type fakeQueue struct {
+	workqueue.RateLimitingInterface
+
+	addAfter []time.Duration
+}
+
+func (q *fakeQueue) AddAfter(item interface{}, duration time.Duration) {
+	q.addAfter = append(q.addAfter, duration)
+}
+
+func TestSyncVenafiSetupBackoff(t *testing.T) {
+	iss := gen.Issuer("test",
+		gen.SetIssuerNamespace("testns"),
+		gen.SetIssuerVenafi(v1.VenafiIssuer{}),
+	)
+
+	pingErr := errors.New("error pinging Venafi API: this is a ping error")
+	pingFails := true
+	queue := &fakeQueue{}
+	c := &controller{
+		queue:    queue,
+		recorder: &testpkg.FakeRecorder{},
+		issuerFactory: &fake.Factory{
+			IssuerForFunc: func(v1.GenericIssuer) (issuer.Interface, error) {
+				return &fake.Issuer{
+					SetupFunc: func(context.Context) error {
+						if pingFails {
+							return pingErr
+						}
+						return nil
+					},
+				}, nil
+			},
+		},
+		venafiSetupBackoff: workqueue.NewItemExponentialFailureRateLimiter(time.Second*5, time.Second*30),
+	}
+
+	for range 6 {
+		require.NoError(t, c.Sync(context.TODO(), iss))
+	}
+	assert.Equal(t, []time.Duration{
+		time.Second * 5,
+		time.Second * 10,
+		time.Second * 20,
+		time.Second * 30,
+		time.Second * 30,
+		time.Second * 30,
+	}, queue.addAfter)
+
+	// A successful setup resets the backoff.
+	pingFails = false
+	require.NoError(t, c.Sync(context.TODO(), iss))
+	pingFails = true
+	require.NoError(t, c.Sync(context.TODO(), iss))
+	assert.Equal(t, time.Second*5, queue.addAfter[len(queue.addAfter)-1])
+
+	// Issuers other than Venafi are left to the workqueue's rate limiter.
+	queue.addAfter = nil
+	require.ErrorIs(t, c.Sync(context.TODO(), gen.Issuer("test-ca", gen.SetIssuerNamespace("testns"), gen.SetIssuerCA(v1.CAIssuer{}))), pingErr)
+	assert.Empty(t, queue.addAfter)
+}
+
+func TestSyncVenafiCredentialCheckInterval(t *testing.T) {
+	queue := &fakeQueue{}
+	c := &controller{
+		queue:    queue,
+		recorder: &testpkg.FakeRecorder{},
+		issuerFactory: &fake.Factory{
+			IssuerForFunc: func(v1.GenericIssuer) (issuer.Interface, error) {
+				return &fake.Issuer{
+					SetupFunc: func(context.Context) error {
+						return nil
+					},
+				}, nil
+			},
+		},
+	}
+
+	require.NoError(t, c.Sync(context.TODO(), gen.Issuer("test",
+		gen.SetIssuerNamespace("testns"),
+		gen.SetIssuerVenafi(v1.VenafiIssuer{}),
+	)))
+	assert.Empty(t, queue.addAfter)
+
+	require.NoError(t, c.Sync(context.TODO(), gen.Issuer("test",
+		gen.SetIssuerNamespace("testns"),
+		gen.SetIssuerVenafi(v1.VenafiIssuer{
+			CredentialCheckInterval: &metav1.Duration{Duration: time.Minute * 10},
+		}),
+	)))
+	assert.Equal(t, []time.Duration{time.Minute * 10}, queue.addAfter)
+}
+
 func TestUpdateIssuerStatus(t *testing.T) {
 	b := &testpkg.Builder{
 		T: t,
@@ -0,0 +1,47 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package healthz
+
+import (
+	"net/http"
+)
+
+// The fipsHealthAdaptor implements the HealthChecker interface.
+// It reports whether the cryptographic module is operating in FIPS 140 mode.
+// It is installed on the /healthz endpoint rather than /livez, so that a
+// controller which is not in FIPS mode is reported but not restarted.
+type fipsHealthAdaptor struct {
+	check func() error
+}
+
+// NewFIPSHealthAdaptor returns a health checker which fails when the given
+// check returns an error.
+func NewFIPSHealthAdaptor(check func() error) *fipsHealthAdaptor {
+	return &fipsHealthAdaptor{check: check}
+}
+
+// Name returns the name of the health check we are implementing.
+func (f *fipsHealthAdaptor) Name() string {
+	return "fips"
+}
+
+// Check is called by the healthz endpoint handler.
+// It fails (returns an error) when the cryptographic module is not operating
+// in FIPS 140 mode.
+func (f *fipsHealthAdaptor) Check(req *http.Request) error {
+	return f.check()
+}
@@ -27,6 +27,8 @@ import (
 	"k8s.io/apiserver/pkg/server/healthz"
 	"k8s.io/client-go/tools/leaderelection"
 	"k8s.io/utils/clock"
+
+	"github.com/cert-manager/cert-manager/internal/fips"
 )
 
 const (
@@ -55,6 +57,7 @@ func NewServer(leaderElectionHealthzAdaptorTimeout time.Duration) *Server {
 	clockHealthAdaptor := NewClockHealthAdaptor(clock.RealClock{})
 	mux := http.NewServeMux()
 	healthz.InstallLivezHandler(mux, leaderHealthzAdaptor, clockHealthAdaptor)
+	healthz.InstallHandler(mux, NewFIPSHealthAdaptor(fips.Check))
 	return &Server{
 		server: &http.Server{
 			ReadTimeout:    healthzServerReadTimeout,
@@ -48,6 +48,18 @@ const (
 	lockDescription = "fake-resource-lock"
 )
 
+// TestFIPSHealthAdaptor checks that the `fips` health check reports whether
+// the cryptographic module is operating in FIPS 140 mode.
+func TestFIPSHealthAdaptor(t *testing.T) {
+	adaptor := healthz.NewFIPSHealthAdaptor(func() error { return nil })
+	assert.Equal(t, "fips", adaptor.Name())
+	assert.NoError(t, adaptor.Check(nil))
+
+	notEnabled := fmt.Errorf("not in FIPS mode")
+	adaptor = healthz.NewFIPSHealthAdaptor(func() error { return notEnabled })
+	assert.Equal(t, notEnabled, adaptor.Check(nil))
+}
+
 // TestHealthzLivezLeaderElection checks the responses of the `/livez/leaderElection` endpoint.
 //
 // These tests are intended to demonstrate that the LeaderElectionHealthzAdaptor
@@ -23,11 +23,13 @@ import (
 
 	core "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
 
 	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
 	"github.com/cert-manager/cert-manager/pkg/acme/accounts"
 	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
 	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
 	"github.com/cert-manager/cert-manager/pkg/controller"
 	"github.com/cert-manager/cert-manager/pkg/issuer"
 	"github.com/cert-manager/cert-manager/pkg/metrics"
@@ -41,8 +43,15 @@ type Acme struct {
 	issuer v1.GenericIssuer
 
 	secretsClient core.SecretsGetter
+	secretsLister internalinformers.SecretLister
 	recorder      record.EventRecorder
 
+	// issuerLister and clusterIssuerLister are used to find other issuers
+	// sharing the same ACME account. clusterIssuerLister is nil if
+	// cert-manager is scoped to a single namespace.
+	issuerLister        cmlisters.IssuerLister
+	clusterIssuerLister cmlisters.ClusterIssuerLister
+
 	// keyFromSecret returns a decoded account key from a Kubernetes secret.
 	// It can be stubbed in unit tests.
 	keyFromSecret keyFromSecretFunc
@@ -60,6 +69,10 @@ type Acme struct {
 
 	// userAgent is the string used as the UserAgent when making HTTP calls.
 	userAgent string
+
+	// clock is used to determine how long deactivation of the ACME account
+	// has been retried for.
+	clock clock.Clock
 }
 
 // New returns a new ACME issuer interface for the given issuer.
@@ -70,16 +83,27 @@ func New(ctx *controller.Context, issuer v1.GenericIssuer) (issuer.Interface, er
 
 	secretsLister := ctx.KubeSharedInformerFactory.Secrets().Lister()
 
+	// ClusterIssuers can only be read if cert-manager is not scoped to a
+	// single namespace.
+	var clusterIssuerLister cmlisters.ClusterIssuerLister
+	if ctx.Namespace == "" {
+		clusterIssuerLister = ctx.SharedInformerFactory.Certmanager().V1().ClusterIssuers().Lister()
+	}
+
 	a := &Acme{
 		issuer:                   issuer,
+		issuerLister:             ctx.SharedInformerFactory.Certmanager().V1().Issuers().Lister(),
+		clusterIssuerLister:      clusterIssuerLister,
 		keyFromSecret:            newKeyFromSecret(secretsLister),
 		clientBuilder:            accounts.NewClient,
 		secretsClient:            ctx.Client.CoreV1(),
+		secretsLister:            secretsLister,
 		recorder:                 ctx.Recorder,
 		clusterResourceNamespace: ctx.IssuerOptions.ClusterResourceNamespace,
 		accountRegistry:          ctx.ACMEOptions.AccountRegistry,
 		metrics:                  ctx.Metrics,
 		userAgent:                ctx.RESTConfig.UserAgent,
+		clock:                    ctx.Clock,
 	}
 
 	return a, nil
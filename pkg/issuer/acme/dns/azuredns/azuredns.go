@@ -32,6 +32,13 @@ import (
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 )
 
+// The constructors of the ambient credentials, which can be replaced in tests
+// to inspect the identity they are configured with.
+var (
+	newWorkloadIdentityCredential = azidentity.NewWorkloadIdentityCredential
+	newManagedIdentityCredential  = azidentity.NewManagedIdentityCredential
+)
+
 // DNSProvider implements the util.ChallengeProvider interface
 type DNSProvider struct {
 	dns01Nameservers  []string
@@ -107,12 +114,17 @@ func getAuthorization(clientOpt policy.ClientOptions, clientID, clientSecret, te
 			ClientOptions: clientOpt,
 		}
 		if managedIdentity != nil {
+			// Workload Identity can only select a user-assigned identity by
+			// client ID, so fail rather than silently using another identity.
+			if managedIdentity.ResourceID != "" {
+				return nil, fmt.Errorf("managedIdentity.resourceID is not supported with Azure Workload Identity, use managedIdentity.clientID instead")
+			}
 			if managedIdentity.ClientID != "" {
 				wcOpt.ClientID = managedIdentity.ClientID
 			}
 		}
 
-		return azidentity.NewWorkloadIdentityCredential(wcOpt)
+		return newWorkloadIdentityCredential(wcOpt)
 	}
 
 	logf.Log.V(logf.InfoLevel).Info("No Azure Workload Identity found: attempting to authenticate with an Azure Managed Service Identity (MSI)")
@@ -127,7 +139,7 @@ func getAuthorization(clientOpt policy.ClientOptions, clientID, clientSecret, te
 		}
 	}
 
-	cred, err := azidentity.NewManagedIdentityCredential(msiOpt)
+	cred, err := newManagedIdentityCredential(msiOpt)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create the managed service identity token: %v", err)
 	}
@@ -365,6 +365,84 @@ see logs for more information`, ts.URL))
 	})
 }
 
+func TestGetAuthorizationManagedIdentitySelection(t *testing.T) {
+	tests := map[string]struct {
+		workloadIdentity bool
+		managedIdentity  *v1.AzureManagedIdentity
+
+		expectedWorkloadClientID string
+		expectedMSIID            azidentity.ManagedIDKind
+		expectedErr              string
+	}{
+		"MSI uses the default identity if none is selected": {
+			managedIdentity: nil,
+		},
+		"MSI uses the identity selected by client ID": {
+			managedIdentity: &v1.AzureManagedIdentity{ClientID: "some-client-id"},
+			expectedMSIID:   azidentity.ClientID("some-client-id"),
+		},
+		"MSI uses the identity selected by resource ID": {
+			managedIdentity: &v1.AzureManagedIdentity{ResourceID: "some-resource-id"},
+			expectedMSIID:   azidentity.ResourceID("some-resource-id"),
+		},
+		"Workload Identity uses the default identity if none is selected": {
+			workloadIdentity: true,
+			managedIdentity:  &v1.AzureManagedIdentity{},
+		},
+		"Workload Identity uses the identity selected by client ID": {
+			workloadIdentity:         true,
+			managedIdentity:          &v1.AzureManagedIdentity{ClientID: "some-client-id"},
+			expectedWorkloadClientID: "some-client-id",
+		},
+		"Workload Identity cannot select an identity by resource ID": {
+			workloadIdentity: true,
+			managedIdentity:  &v1.AzureManagedIdentity{ResourceID: "some-resource-id"},
+			expectedErr:      "managedIdentity.resourceID is not supported with Azure Workload Identity, use managedIdentity.clientID instead",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if test.workloadIdentity {
+				t.Setenv("AZURE_FEDERATED_TOKEN_FILE", "/path/to/token")
+			} else {
+				t.Setenv("AZURE_FEDERATED_TOKEN_FILE", "")
+			}
+
+			var workloadOpts *azidentity.WorkloadIdentityCredentialOptions
+			var msiOpts *azidentity.ManagedIdentityCredentialOptions
+			origWorkload, origMSI := newWorkloadIdentityCredential, newManagedIdentityCredential
+			defer func() {
+				newWorkloadIdentityCredential, newManagedIdentityCredential = origWorkload, origMSI
+			}()
+			newWorkloadIdentityCredential = func(opts *azidentity.WorkloadIdentityCredentialOptions) (*azidentity.WorkloadIdentityCredential, error) {
+				workloadOpts = opts
+				return nil, nil
+			}
+			newManagedIdentityCredential = func(opts *azidentity.ManagedIdentityCredentialOptions) (*azidentity.ManagedIdentityCredential, error) {
+				msiOpts = opts
+				return nil, nil
+			}
+
+			_, err := getAuthorization(policy.ClientOptions{}, "", "", "", true, test.managedIdentity)
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			if test.workloadIdentity {
+				require.NotNil(t, workloadOpts, "expected a workload identity credential to be created")
+				assert.Nil(t, msiOpts, "expected no managed identity credential to be created")
+				assert.Equal(t, test.expectedWorkloadClientID, workloadOpts.ClientID)
+			} else {
+				require.NotNil(t, msiOpts, "expected a managed identity credential to be created")
+				assert.Nil(t, workloadOpts, "expected no workload identity credential to be created")
+				assert.Equal(t, test.expectedMSIID, msiOpts.ID)
+			}
+		})
+	}
+}
+
 // TestStabilizeResponseError tests that the ResponseError errors returned by the AzureDNS API are
 // changed to be stable. We want our error messages to be the same when the cause
 // is the same to avoid spurious challenge updates.
@@ -28,15 +28,16 @@ import (
 
 // DNSProvider is an implementation of the DNSProvider interface.
 type DNSProvider struct {
-	hostedZoneName   string
-	dns01Nameservers []string
-	project          string
-	client           *dns.Service
-	log              logr.Logger
+	hostedZoneName       string
+	hostedZoneVisibility string
+	dns01Nameservers     []string
+	project              string
+	client               *dns.Service
+	log                  logr.Logger
 }
 
 // NewDNSProvider returns a new DNSProvider Instance with configuration
-func NewDNSProvider(ctx context.Context, project string, saBytes []byte, dns01Nameservers []string, ambient bool, hostedZoneName string) (*DNSProvider, error) {
+func NewDNSProvider(ctx context.Context, project string, saBytes []byte, dns01Nameservers []string, ambient bool, hostedZoneName, hostedZoneVisibility string) (*DNSProvider, error) {
 	// project is a required field
 	if project == "" {
 		return nil, fmt.Errorf("Google Cloud project name missing")
@@ -47,11 +48,11 @@ func NewDNSProvider(ctx context.Context, project string, saBytes []byte, dns01Na
 		if !ambient {
 			return nil, fmt.Errorf("unable to construct clouddns provider: empty credentials; perhaps you meant to enable ambient credentials?")
 		}
-		return NewDNSProviderCredentials(ctx, project, dns01Nameservers, hostedZoneName)
+		return NewDNSProviderCredentials(ctx, project, dns01Nameservers, hostedZoneName, hostedZoneVisibility)
 	}
 	// if service account data is provided, we instantiate using that
 	if len(saBytes) != 0 {
-		return NewDNSProviderServiceAccountBytes(ctx, project, saBytes, dns01Nameservers, hostedZoneName)
+		return NewDNSProviderServiceAccountBytes(ctx, project, saBytes, dns01Nameservers, hostedZoneName, hostedZoneVisibility)
 	}
 	return nil, fmt.Errorf("missing Google Cloud DNS provider credentials")
 }
@@ -60,17 +61,17 @@ func NewDNSProvider(ctx context.Context, project string, saBytes []byte, dns01Na
 // DNS. Project name must be passed in the environment variable: GCE_PROJECT.
 // A Service Account file can be passed in the environment variable:
 // GCE_SERVICE_ACCOUNT_FILE
-func NewDNSProviderEnvironment(ctx context.Context, dns01Nameservers []string, hostedZoneName string) (*DNSProvider, error) {
+func NewDNSProviderEnvironment(ctx context.Context, dns01Nameservers []string, hostedZoneName, hostedZoneVisibility string) (*DNSProvider, error) {
 	project := os.Getenv("GCE_PROJECT")
 	if saFile, ok := os.LookupEnv("GCE_SERVICE_ACCOUNT_FILE"); ok {
-		return NewDNSProviderServiceAccount(ctx, project, saFile, dns01Nameservers, hostedZoneName)
+		return NewDNSProviderServiceAccount(ctx, project, saFile, dns01Nameservers, hostedZoneName, hostedZoneVisibility)
 	}
-	return NewDNSProviderCredentials(ctx, project, dns01Nameservers, hostedZoneName)
+	return NewDNSProviderCredentials(ctx, project, dns01Nameservers, hostedZoneName, hostedZoneVisibility)
 }
 
 // NewDNSProviderCredentials uses the supplied credentials to return a
 // DNSProvider instance configured for Google Cloud DNS.
-func NewDNSProviderCredentials(ctx context.Context, project string, dns01Nameservers []string, hostedZoneName string) (*DNSProvider, error) {
+func NewDNSProviderCredentials(ctx context.Context, project string, dns01Nameservers []string, hostedZoneName, hostedZoneVisibility string) (*DNSProvider, error) {
 	if project == "" {
 		return nil, fmt.Errorf("Google Cloud project name missing")
 	}
@@ -86,17 +87,18 @@ func NewDNSProviderCredentials(ctx context.Context, project string, dns01Nameser
 	}
 
 	return &DNSProvider{
-		project:          project,
-		client:           svc,
-		dns01Nameservers: dns01Nameservers,
-		hostedZoneName:   hostedZoneName,
-		log:              logf.Log.WithName("clouddns"),
+		project:              project,
+		client:               svc,
+		dns01Nameservers:     dns01Nameservers,
+		hostedZoneName:       hostedZoneName,
+		hostedZoneVisibility: hostedZoneVisibility,
+		log:                  logf.Log.WithName("clouddns"),
 	}, nil
 }
 
 // NewDNSProviderServiceAccount uses the supplied service account JSON file to
 // return a DNSProvider instance configured for Google Cloud DNS.
-func NewDNSProviderServiceAccount(ctx context.Context, project string, saFile string, dns01Nameservers []string, hostedZoneName string) (*DNSProvider, error) {
+func NewDNSProviderServiceAccount(ctx context.Context, project string, saFile string, dns01Nameservers []string, hostedZoneName, hostedZoneVisibility string) (*DNSProvider, error) {
 	if project == "" {
 		return nil, fmt.Errorf("Google Cloud project name missing")
 	}
@@ -108,12 +110,12 @@ func NewDNSProviderServiceAccount(ctx context.Context, project string, saFile st
 	if err != nil {
 		return nil, fmt.Errorf("Unable to read Service Account file: %v", err)
 	}
-	return NewDNSProviderServiceAccountBytes(ctx, project, dat, dns01Nameservers, hostedZoneName)
+	return NewDNSProviderServiceAccountBytes(ctx, project, dat, dns01Nameservers, hostedZoneName, hostedZoneVisibility)
 }
 
 // NewDNSProviderServiceAccountBytes uses the supplied service account JSON
 // file data to return a DNSProvider instance configured for Google Cloud DNS.
-func NewDNSProviderServiceAccountBytes(ctx context.Context, project string, saBytes []byte, dns01Nameservers []string, hostedZoneName string) (*DNSProvider, error) {
+func NewDNSProviderServiceAccountBytes(ctx context.Context, project string, saBytes []byte, dns01Nameservers []string, hostedZoneName, hostedZoneVisibility string) (*DNSProvider, error) {
 	if project == "" {
 		return nil, fmt.Errorf("Google Cloud project name missing")
 	}
@@ -133,11 +135,12 @@ func NewDNSProviderServiceAccountBytes(ctx context.Context, project string, saBy
 		return nil, fmt.Errorf("Unable to create Google Cloud DNS service: %v", err)
 	}
 	return &DNSProvider{
-		project:          project,
-		client:           svc,
-		dns01Nameservers: dns01Nameservers,
-		hostedZoneName:   hostedZoneName,
-		log:              logf.Log.WithName("clouddns"),
+		project:              project,
+		client:               svc,
+		dns01Nameservers:     dns01Nameservers,
+		hostedZoneName:       hostedZoneName,
+		hostedZoneVisibility: hostedZoneVisibility,
+		log:                  logf.Log.WithName("clouddns"),
 	}, nil
 }
 
@@ -264,6 +267,16 @@ func (c *DNSProvider) getHostedZone(ctx context.Context, domain string) (string,
 		return "", fmt.Errorf("No matching GoogleCloud domain found for domain %s", authZone)
 	}
 
+	// only consider zones with the requested visibility, if any
+	if c.hostedZoneVisibility != "" {
+		for _, zone := range zones.ManagedZones {
+			if strings.EqualFold(zone.Visibility, c.hostedZoneVisibility) {
+				return zone.Name, nil
+			}
+		}
+		return "", fmt.Errorf("No matching GoogleCloud %s managed-zone found for domain %s", strings.ToLower(c.hostedZoneVisibility), authZone)
+	}
+
 	// attempt to get the first public zone
 	for _, zone := range zones.ManagedZones {
 		if zone.Visibility == "public" {
@@ -10,15 +10,27 @@ package clouddns
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	logtesting "github.com/go-logr/logr/testing"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/google"
 	"google.golang.org/api/dns/v1"
 
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	testserver "github.com/cert-manager/cert-manager/test/acme/server"
 )
 
 var (
@@ -41,7 +53,7 @@ func TestNewDNSProviderValid(t *testing.T) {
 		t.Skip("skipping live test (requires credentials)")
 	}
 	t.Setenv("GCE_PROJECT", "")
-	_, err := NewDNSProviderCredentials(context.TODO(), "my-project", util.RecursiveNameservers, "")
+	_, err := NewDNSProviderCredentials(context.TODO(), "my-project", util.RecursiveNameservers, "", "")
 	assert.NoError(t, err)
 }
 
@@ -50,13 +62,13 @@ func TestNewDNSProviderValidEnv(t *testing.T) {
 		t.Skip("skipping live test (requires credentials)")
 	}
 	t.Setenv("GCE_PROJECT", "my-project")
-	_, err := NewDNSProviderEnvironment(context.TODO(), util.RecursiveNameservers, "")
+	_, err := NewDNSProviderEnvironment(context.TODO(), util.RecursiveNameservers, "", "")
 	assert.NoError(t, err)
 }
 
 func TestNewDNSProviderMissingCredErr(t *testing.T) {
 	t.Setenv("GCE_PROJECT", "")
-	_, err := NewDNSProviderEnvironment(context.TODO(), util.RecursiveNameservers, "")
+	_, err := NewDNSProviderEnvironment(context.TODO(), util.RecursiveNameservers, "", "")
 	assert.EqualError(t, err, "Google Cloud project name missing")
 }
 
@@ -65,7 +77,7 @@ func TestLiveGoogleCloudPresent(t *testing.T) {
 		t.Skip("skipping live test")
 	}
 
-	provider, err := NewDNSProviderCredentials(context.TODO(), gcloudProject, util.RecursiveNameservers, "")
+	provider, err := NewDNSProviderCredentials(context.TODO(), gcloudProject, util.RecursiveNameservers, "", "")
 	assert.NoError(t, err)
 
 	err = provider.Present(context.TODO(), gcloudDomain, "_acme-challenge."+gcloudDomain+".", "123d==")
@@ -77,7 +89,7 @@ func TestLiveGoogleCloudPresentMultiple(t *testing.T) {
 		t.Skip("skipping live test")
 	}
 
-	provider, err := NewDNSProviderCredentials(context.TODO(), gcloudProject, util.RecursiveNameservers, "")
+	provider, err := NewDNSProviderCredentials(context.TODO(), gcloudProject, util.RecursiveNameservers, "", "")
 	assert.NoError(t, err)
 
 	// Check that we're able to create multiple entries
@@ -94,7 +106,7 @@ func TestLiveGoogleCloudCleanUp(t *testing.T) {
 
 	time.Sleep(time.Second * 1)
 
-	provider, err := NewDNSProviderCredentials(context.TODO(), gcloudProject, util.RecursiveNameservers, "")
+	provider, err := NewDNSProviderCredentials(context.TODO(), gcloudProject, util.RecursiveNameservers, "", "")
 	assert.NoError(t, err)
 
 	err = provider.CleanUp(context.TODO(), gcloudDomain, "_acme-challenge."+gcloudDomain+".", "123d==")
@@ -106,7 +118,7 @@ func TestDNSProvider_getHostedZone(t *testing.T) {
 		t.Skip("skipping live test")
 	}
 
-	testProvider, err := NewDNSProviderCredentials(context.TODO(), "my-project", util.RecursiveNameservers, "test-zone")
+	testProvider, err := NewDNSProviderCredentials(context.TODO(), "my-project", util.RecursiveNameservers, "test-zone", "")
 	assert.NoError(t, err)
 
 	type args struct {
@@ -141,3 +153,122 @@ func TestDNSProvider_getHostedZone(t *testing.T) {
 		})
 	}
 }
+
+func TestProjectAndHostedZoneVisibility(t *testing.T) {
+	ctx := logf.NewContext(context.TODO(), logtesting.NewTestLogger(t), t.Name())
+	dnsServer := &testserver.BasicServer{Zones: []string{"example.com."}}
+	require.NoError(t, dnsServer.Run(ctx))
+	defer func() {
+		require.NoError(t, dnsServer.Shutdown())
+	}()
+
+	pk, err := pki.GenerateRSAPrivateKey(2048)
+	require.NoError(t, err)
+	// The service account belongs to a different project than the managed
+	// zones.
+	saBytes, err := json.Marshal(map[string]string{
+		"type":         "service_account",
+		"project_id":   "credentials-project",
+		"client_email": "cert-manager@credentials-project.iam.gserviceaccount.com",
+		"private_key":  string(pki.EncodePKCS1PrivateKey(pk)),
+		"token_uri":    "https://oauth2.googleapis.com/token",
+	})
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		hostedZoneVisibility string
+		expectedChangesPath  string
+	}{
+		"prefers a public zone by default": {
+			expectedChangesPath: "/dns/v1/projects/dns-project/managedZones/public-zone/changes",
+		},
+		"uses a public zone if requested": {
+			hostedZoneVisibility: "Public",
+			expectedChangesPath:  "/dns/v1/projects/dns-project/managedZones/public-zone/changes",
+		},
+		"uses a private zone if requested": {
+			hostedZoneVisibility: "Private",
+			expectedChangesPath:  "/dns/v1/projects/dns-project/managedZones/private-zone/changes",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			api := newMockCloudDNSAPI(t)
+			defer api.Close()
+
+			// Send all requests, including the token request, to the mock API
+			ctx := context.WithValue(ctx, oauth2.HTTPClient, api.Client())
+			provider, err := NewDNSProviderServiceAccountBytes(ctx, "dns-project", saBytes, []string{dnsServer.ListenAddr()}, "", test.hostedZoneVisibility)
+			require.NoError(t, err)
+
+			require.NoError(t, provider.Present(ctx, "www.example.com", "_acme-challenge.www.example.com.", "123d=="))
+
+			for _, path := range api.paths {
+				if path != "/token" {
+					assert.True(t, strings.HasPrefix(path, "/dns/v1/projects/dns-project/"), "request to unexpected project: %s", path)
+				}
+			}
+			assert.Contains(t, api.paths, test.expectedChangesPath)
+		})
+	}
+}
+
+// mockCloudDNSAPI implements the subset of the Google Cloud DNS API used by
+// the DNSProvider, and records the paths of the requests it receives.
+type mockCloudDNSAPI struct {
+	*httptest.Server
+
+	lock  sync.Mutex
+	paths []string
+}
+
+func newMockCloudDNSAPI(t *testing.T) *mockCloudDNSAPI {
+	m := &mockCloudDNSAPI{}
+	m.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		m.lock.Lock()
+		m.paths = append(m.paths, r.URL.Path)
+		m.lock.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		var resp string
+		switch {
+		case r.URL.Path == "/token":
+			resp = `{"access_token": "token", "token_type": "Bearer", "expires_in": 3600}`
+		case strings.HasSuffix(r.URL.Path, "/managedZones"):
+			resp = `{"managedZones": [
+				{"name": "private-zone", "dnsName": "example.com.", "visibility": "private"},
+				{"name": "public-zone", "dnsName": "example.com.", "visibility": "public"}
+			]}`
+		case strings.HasSuffix(r.URL.Path, "/rrsets"):
+			resp = `{"rrsets": []}`
+		case strings.HasSuffix(r.URL.Path, "/changes"):
+			resp = `{"id": "1", "status": "done"}`
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(resp))
+	}))
+
+	return m
+}
+
+// Client returns an HTTP client which sends all requests to the mock server.
+func (m *mockCloudDNSAPI) Client() *http.Client {
+	target, _ := url.Parse(m.URL)
+	return &http.Client{
+		Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			req = req.Clone(req.Context())
+			req.URL.Scheme = target.Scheme
+			req.URL.Host = target.Host
+			return http.DefaultTransport.RoundTrip(req)
+		}),
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
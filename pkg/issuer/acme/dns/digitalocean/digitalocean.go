@@ -119,6 +119,12 @@ func (c *DNSProvider) CleanUp(ctx context.Context, domain, fqdn, value string) e
 	}
 
 	for _, record := range records {
+		// Other challenges may have presented a value for the same record
+		// (e.g. when several domains are CNAME delegated to it), so only
+		// remove the value which this challenge added.
+		if record.Type != "TXT" || record.Data != value {
+			continue
+		}
 		_, err = c.client.Domains.DeleteRecord(ctx, util.UnFqdn(zoneName), record.ID)
 
 		if err != nil {
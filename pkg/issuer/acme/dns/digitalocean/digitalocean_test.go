@@ -18,13 +18,25 @@ package digitalocean
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/digitalocean/godo"
+	logtesting "github.com/go-logr/logr/testing"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	testserver "github.com/cert-manager/cert-manager/test/acme/server"
 )
 
 var (
@@ -88,3 +100,101 @@ func TestDigitalOceanCleanUp(t *testing.T) {
 func TestDigitalOceanSolveForProvider(t *testing.T) {
 
 }
+
+func TestDigitalOceanMultipleValuesForSameRecord(t *testing.T) {
+	ctx := logf.NewContext(context.TODO(), logtesting.NewTestLogger(t), t.Name())
+	dnsServer := &testserver.BasicServer{Zones: []string{"example.com."}}
+	require.NoError(t, dnsServer.Run(ctx))
+	defer func() {
+		require.NoError(t, dnsServer.Shutdown())
+	}()
+
+	api := newMockDigitalOceanAPI(t, "example.com")
+	defer api.Close()
+
+	provider, err := NewDNSProviderCredentials("123", []string{dnsServer.ListenAddr()}, "cert-manager-test")
+	require.NoError(t, err)
+	provider.client.BaseURL, err = url.Parse(api.URL)
+	require.NoError(t, err)
+
+	// Two challenges for different domains which are both CNAME delegated
+	// to the same record.
+	fqdn := "_acme-challenge.delegated.example.com."
+	require.NoError(t, provider.Present(ctx, "a.example.org", fqdn, "value-a"))
+	require.NoError(t, provider.Present(ctx, "b.example.org", fqdn, "value-b"))
+	assert.ElementsMatch(t, []string{"value-a", "value-b"}, api.values())
+
+	require.NoError(t, provider.CleanUp(ctx, "a.example.org", fqdn, "value-a"))
+	assert.ElementsMatch(t, []string{"value-b"}, api.values())
+
+	require.NoError(t, provider.CleanUp(ctx, "b.example.org", fqdn, "value-b"))
+	assert.Empty(t, api.values())
+}
+
+// mockDigitalOceanAPI implements the subset of the DigitalOcean domain
+// records API used by the DNSProvider.
+type mockDigitalOceanAPI struct {
+	*httptest.Server
+
+	lock    sync.Mutex
+	nextID  int
+	records map[int]godo.DomainRecord
+}
+
+func newMockDigitalOceanAPI(t *testing.T, domain string) *mockDigitalOceanAPI {
+	m := &mockDigitalOceanAPI{records: make(map[int]godo.DomainRecord)}
+
+	recordsPath := "/v2/domains/" + domain + "/records"
+	m.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		m.lock.Lock()
+		defer m.lock.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == recordsPath:
+			records := []godo.DomainRecord{}
+			for _, record := range m.records {
+				if record.Type == r.URL.Query().Get("type") {
+					records = append(records, record)
+				}
+			}
+			assert.NoError(t, json.NewEncoder(w).Encode(map[string]interface{}{"domain_records": records}))
+		case r.Method == http.MethodPost && r.URL.Path == recordsPath:
+			var req godo.DomainRecordEditRequest
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			m.nextID++
+			// The API stores record names relative to the domain
+			record := godo.DomainRecord{
+				ID:   m.nextID,
+				Type: req.Type,
+				Name: strings.TrimSuffix(req.Name, "."+domain+"."),
+				Data: req.Data,
+				TTL:  req.TTL,
+			}
+			m.records[record.ID] = record
+			w.WriteHeader(http.StatusCreated)
+			assert.NoError(t, json.NewEncoder(w).Encode(map[string]interface{}{"domain_record": record}))
+		case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, recordsPath+"/"):
+			id, err := strconv.Atoi(strings.TrimPrefix(r.URL.Path, recordsPath+"/"))
+			assert.NoError(t, err)
+			delete(m.records, id)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	return m
+}
+
+// values returns the data of all TXT records.
+func (m *mockDigitalOceanAPI) values() []string {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	var values []string
+	for _, record := range m.records {
+		values = append(values, record.Data)
+	}
+	return values
+}
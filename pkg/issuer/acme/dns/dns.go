@@ -19,6 +19,7 @@ package dns
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -48,6 +49,10 @@ import (
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 )
 
+// ErrPropagationCheckTimeout is returned by Check if the DNS01 record has not
+// propagated within the propagation check timeout.
+var ErrPropagationCheckTimeout = errors.New("propagation check timed out")
+
 // solver is the old solver type interface.
 // All new solvers should be implemented using the new webhook.Solver interface.
 type solver interface {
@@ -59,9 +64,9 @@ type solver interface {
 // It is useful for mocking out a given provider since an alternate set of
 // constructors may be set.
 type dnsProviderConstructors struct {
-	cloudDNS     func(ctx context.Context, project string, serviceAccount []byte, dns01Nameservers []string, ambient bool, hostedZoneName string) (*clouddns.DNSProvider, error)
+	cloudDNS     func(ctx context.Context, project string, serviceAccount []byte, dns01Nameservers []string, ambient bool, hostedZoneName, hostedZoneVisibility string) (*clouddns.DNSProvider, error)
 	cloudFlare   func(email, apikey, apiToken string, dns01Nameservers []string, userAgent string) (*cloudflare.DNSProvider, error)
-	route53      func(ctx context.Context, accessKey, secretKey, hostedZoneID, region, role, webIdentityToken string, ambient bool, dns01Nameservers []string, userAgent string) (*route53.DNSProvider, error)
+	route53      func(ctx context.Context, accessKey, secretKey, hostedZoneID, region, role string, roleChain []string, webIdentityToken string, ambient bool, dns01Nameservers []string, userAgent string) (*route53.DNSProvider, error)
 	azureDNS     func(environment, clientID, clientSecret, subscriptionID, tenantID, resourceGroupName, hostedZoneName string, dns01Nameservers []string, ambient bool, managedIdentity *cmacme.AzureManagedIdentity) (*azuredns.DNSProvider, error)
 	acmeDNS      func(host string, accountJson []byte, dns01Nameservers []string) (*acmedns.DNSProvider, error)
 	digitalOcean func(token string, dns01Nameservers []string, userAgent string) (*digitalocean.DNSProvider, error)
@@ -118,11 +123,14 @@ func (s *Solver) Check(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.
 	log.V(logf.DebugLevel).Info("checking DNS propagation", "nameservers", s.Context.DNS01Nameservers)
 
 	ok, err := util.PreCheckDNS(ctx, fqdn, ch.Spec.Key, s.Context.DNS01Nameservers,
-		s.Context.DNS01CheckAuthoritative)
+		s.Context.DNS01CheckAuthoritative, s.Context.DNS01RecursiveNameserversQuorum)
 	if err != nil {
 		return err
 	}
 	if !ok {
+		if timeout := s.propagationCheckTimeout(ch); timeout > 0 && s.Clock.Since(presentedTime(ch)) >= timeout {
+			return fmt.Errorf("DNS record for %q not propagated within %s: %w", ch.Spec.DNSName, timeout, ErrPropagationCheckTimeout)
+		}
 		return fmt.Errorf("DNS record for %q not yet propagated", ch.Spec.DNSName)
 	}
 
@@ -134,6 +142,26 @@ func (s *Solver) Check(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.
 	return nil
 }
 
+// presentedTime returns the time at which the DNS01 record of the challenge
+// was presented. Challenges presented before this time was recorded fall back
+// to the creation time of the challenge.
+func presentedTime(ch *cmacme.Challenge) time.Time {
+	if ch.Status.PresentedTime != nil {
+		return ch.Status.PresentedTime.Time
+	}
+	return ch.CreationTimestamp.Time
+}
+
+// propagationCheckTimeout returns the maximum time to wait for the DNS01
+// record of the challenge to propagate. The timeout configured on the solver
+// takes precedence over the global timeout.
+func (s *Solver) propagationCheckTimeout(ch *cmacme.Challenge) time.Duration {
+	if ch.Spec.Solver.DNS01 != nil && ch.Spec.Solver.DNS01.PropagationCheckTimeout != nil {
+		return ch.Spec.Solver.DNS01.PropagationCheckTimeout.Duration
+	}
+	return s.DNS01CheckTimeout
+}
+
 // CleanUp removes DNS records which are no longer needed after
 // certificate issuance.
 func (s *Solver) CleanUp(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.Challenge) error {
@@ -162,6 +190,29 @@ func (s *Solver) CleanUp(ctx context.Context, issuer v1.GenericIssuer, ch *cmacm
 	return slv.CleanUp(ctx, ch.Spec.DNSName, fqdn, ch.Spec.Key)
 }
 
+// cloudflareCredentialsForZone returns a reference to the secret holding the
+// Cloudflare credentials to use for the given resolved zone, and whether it
+// is an API token rather than an API key. A per-zone API token takes
+// precedence over the global API key or API token.
+func cloudflareCredentialsForZone(cfg *cmacme.ACMEIssuerDNS01ProviderCloudflare, zone string) (*cmmeta.SecretKeySelector, bool, error) {
+	if zone != "" {
+		for name, ref := range cfg.ZoneAPITokens {
+			if strings.EqualFold(util.UnFqdn(name), util.UnFqdn(zone)) {
+				return &ref, true, nil
+			}
+		}
+	}
+
+	switch {
+	case cfg.APIKey != nil:
+		return cfg.APIKey, false, nil
+	case cfg.APIToken != nil:
+		return cfg.APIToken, true, nil
+	default:
+		return nil, false, fmt.Errorf("no Cloudflare API token configured for zone %q and no apiKeySecretRef or apiTokenSecretRef to fall back to", zone)
+	}
+}
+
 func followCNAME(strategy cmacme.CNAMEStrategy) bool {
 	return strategy == cmacme.FollowStrategy
 }
@@ -238,7 +289,7 @@ func (s *Solver) solverForChallenge(ctx context.Context, issuer v1.GenericIssuer
 		}
 
 		// attempt to construct the cloud dns provider
-		impl, err = s.dnsProviderConstructors.cloudDNS(ctx, providerConfig.CloudDNS.Project, keyData, s.DNS01Nameservers, s.CanUseAmbientCredentials(issuer), providerConfig.CloudDNS.HostedZoneName)
+		impl, err = s.dnsProviderConstructors.cloudDNS(ctx, providerConfig.CloudDNS.Project, keyData, s.DNS01Nameservers, s.CanUseAmbientCredentials(issuer), providerConfig.CloudDNS.HostedZoneName, string(providerConfig.CloudDNS.HostedZoneVisibility))
 		if err != nil {
 			return nil, nil, fmt.Errorf("error instantiating google clouddns challenge solver: %s", err)
 		}
@@ -248,30 +299,38 @@ func (s *Solver) solverForChallenge(ctx context.Context, issuer v1.GenericIssuer
 			return nil, nil, fmt.Errorf("API key and API token secret references are both present")
 		}
 
-		var saSecretName, saSecretKey string
-		if providerConfig.Cloudflare.APIKey != nil {
-			saSecretName = providerConfig.Cloudflare.APIKey.Name
-			saSecretKey = providerConfig.Cloudflare.APIKey.Key
-		} else {
-			saSecretName = providerConfig.Cloudflare.APIToken.Name
-			saSecretKey = providerConfig.Cloudflare.APIToken.Key
+		var zone string
+		if len(providerConfig.Cloudflare.ZoneAPITokens) > 0 {
+			fqdn, err := util.DNS01LookupFQDN(ctx, ch.Spec.DNSName, followCNAME(providerConfig.CNAMEStrategy), s.DNS01Nameservers...)
+			if err != nil {
+				return nil, nil, err
+			}
+			zone, err = util.FindZoneByFqdn(ctx, fqdn, s.DNS01Nameservers)
+			if err != nil {
+				return nil, nil, err
+			}
 		}
 
-		saSecret, err := s.secretLister.Secrets(resourceNamespace).Get(saSecretName)
+		secretRef, isToken, err := cloudflareCredentialsForZone(providerConfig.Cloudflare, zone)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		saSecret, err := s.secretLister.Secrets(resourceNamespace).Get(secretRef.Name)
 		if err != nil {
 			return nil, nil, fmt.Errorf("error getting cloudflare secret: %s", err)
 		}
 
-		keyData, ok := saSecret.Data[saSecretKey]
+		keyData, ok := saSecret.Data[secretRef.Key]
 		if !ok {
-			return nil, nil, fmt.Errorf("specified key %q not found in secret %s/%s", saSecretKey, saSecret.Namespace, saSecret.Name)
+			return nil, nil, fmt.Errorf("specified key %q not found in secret %s/%s", secretRef.Key, saSecret.Namespace, saSecret.Name)
 		}
 
 		var apiKey, apiToken string
-		if providerConfig.Cloudflare.APIKey != nil {
-			apiKey = string(keyData)
-		} else {
+		if isToken {
 			apiToken = string(keyData)
+		} else {
+			apiKey = string(keyData)
 		}
 
 		email := providerConfig.Cloudflare.Email
@@ -372,6 +431,7 @@ func (s *Solver) solverForChallenge(ctx context.Context, issuer v1.GenericIssuer
 			providerConfig.Route53.HostedZoneID,
 			providerConfig.Route53.Region,
 			providerConfig.Route53.Role,
+			providerConfig.Route53.RoleChain,
 			webIdentityToken,
 			canUseAmbientCredentials,
 			s.DNS01Nameservers,
@@ -18,13 +18,17 @@ package dns
 
 import (
 	"context"
+	"errors"
 	"reflect"
 	"testing"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
+	fakeclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
 
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
@@ -395,7 +399,7 @@ func TestRoute53TrimCreds(t *testing.T) {
 	expectedR53Call := []fakeDNSProviderCall{
 		{
 			name: "route53",
-			args: []interface{}{"test_with_spaces", "AKIENDINNEWLINE", "", "us-west-2", "", "", false, util.RecursiveNameservers},
+			args: []interface{}{"test_with_spaces", "AKIENDINNEWLINE", "", "us-west-2", "", []string(nil), "", false, util.RecursiveNameservers},
 		},
 	}
 
@@ -453,7 +457,7 @@ func TestRoute53SecretAccessKey(t *testing.T) {
 	expectedR53Call := []fakeDNSProviderCall{
 		{
 			name: "route53",
-			args: []interface{}{"AWSACCESSKEYID", "AKIENDINNEWLINE", "", "us-west-2", "", "", false, util.RecursiveNameservers},
+			args: []interface{}{"AWSACCESSKEYID", "AKIENDINNEWLINE", "", "us-west-2", "", []string(nil), "", false, util.RecursiveNameservers},
 		},
 	}
 
@@ -501,7 +505,7 @@ func TestRoute53AmbientCreds(t *testing.T) {
 			result{
 				expectedCall: &fakeDNSProviderCall{
 					name: "route53",
-					args: []interface{}{"", "", "", "us-west-2", "", "", true, util.RecursiveNameservers},
+					args: []interface{}{"", "", "", "us-west-2", "", []string(nil), "", true, util.RecursiveNameservers},
 				},
 			},
 		},
@@ -534,7 +538,7 @@ func TestRoute53AmbientCreds(t *testing.T) {
 			result{
 				expectedCall: &fakeDNSProviderCall{
 					name: "route53",
-					args: []interface{}{"", "", "", "us-west-2", "", "", false, util.RecursiveNameservers},
+					args: []interface{}{"", "", "", "us-west-2", "", []string(nil), "", false, util.RecursiveNameservers},
 				},
 			},
 		},
@@ -598,7 +602,7 @@ func TestRoute53AssumeRole(t *testing.T) {
 			result{
 				expectedCall: &fakeDNSProviderCall{
 					name: "route53",
-					args: []interface{}{"", "", "", "us-west-2", "my-role", "", true, util.RecursiveNameservers},
+					args: []interface{}{"", "", "", "us-west-2", "my-role", []string(nil), "", true, util.RecursiveNameservers},
 				},
 			},
 		},
@@ -632,7 +636,7 @@ func TestRoute53AssumeRole(t *testing.T) {
 			result{
 				expectedCall: &fakeDNSProviderCall{
 					name: "route53",
-					args: []interface{}{"", "", "", "us-west-2", "my-other-role", "", false, util.RecursiveNameservers},
+					args: []interface{}{"", "", "", "us-west-2", "my-other-role", []string(nil), "", false, util.RecursiveNameservers},
 				},
 			},
 		},
@@ -655,3 +659,185 @@ func TestRoute53AssumeRole(t *testing.T) {
 		}
 	}
 }
+
+func TestCloudflareCredentialsForZone(t *testing.T) {
+	globalKey := &cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "global"}, Key: "api-key"}
+	globalToken := &cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "global"}, Key: "api-token"}
+	zoneTokens := map[string]cmmeta.SecretKeySelector{
+		"example.com":     {LocalObjectReference: cmmeta.LocalObjectReference{Name: "example-com"}, Key: "api-token"},
+		"sub.example.com": {LocalObjectReference: cmmeta.LocalObjectReference{Name: "sub-example-com"}, Key: "api-token"},
+	}
+
+	tests := map[string]struct {
+		cfg           *cmacme.ACMEIssuerDNS01ProviderCloudflare
+		zone          string
+		expectedRef   *cmmeta.SecretKeySelector
+		expectedToken bool
+		expectedErr   bool
+	}{
+		"should select the per-zone token matching the resolved zone": {
+			cfg:           &cmacme.ACMEIssuerDNS01ProviderCloudflare{APIToken: globalToken, ZoneAPITokens: zoneTokens},
+			zone:          "sub.example.com.",
+			expectedRef:   &cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "sub-example-com"}, Key: "api-token"},
+			expectedToken: true,
+		},
+		"should match zone names case-insensitively": {
+			cfg:           &cmacme.ACMEIssuerDNS01ProviderCloudflare{ZoneAPITokens: zoneTokens},
+			zone:          "Example.COM.",
+			expectedRef:   &cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "example-com"}, Key: "api-token"},
+			expectedToken: true,
+		},
+		"should fall back to the global token if no zone matches": {
+			cfg:           &cmacme.ACMEIssuerDNS01ProviderCloudflare{APIToken: globalToken, ZoneAPITokens: zoneTokens},
+			zone:          "example.org.",
+			expectedRef:   globalToken,
+			expectedToken: true,
+		},
+		"should fall back to the global key if no zone matches": {
+			cfg:         &cmacme.ACMEIssuerDNS01ProviderCloudflare{APIKey: globalKey, ZoneAPITokens: zoneTokens},
+			zone:        "example.org.",
+			expectedRef: globalKey,
+		},
+		"should use the global token if the zone is not known": {
+			cfg:           &cmacme.ACMEIssuerDNS01ProviderCloudflare{APIToken: globalToken},
+			expectedRef:   globalToken,
+			expectedToken: true,
+		},
+		"should error if no zone matches and there is no global credential": {
+			cfg:         &cmacme.ACMEIssuerDNS01ProviderCloudflare{ZoneAPITokens: zoneTokens},
+			zone:        "example.org.",
+			expectedErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ref, isToken, err := cloudflareCredentialsForZone(test.cfg, test.zone)
+			if (err != nil) != test.expectedErr {
+				t.Fatalf("expected error %t, got %v", test.expectedErr, err)
+			}
+			if !reflect.DeepEqual(ref, test.expectedRef) {
+				t.Errorf("expected secret reference %+v, got %+v", test.expectedRef, ref)
+			}
+			if isToken != test.expectedToken {
+				t.Errorf("expected API token %t, got %t", test.expectedToken, isToken)
+			}
+		})
+	}
+}
+
+func TestCheckPropagationCheckTimeout(t *testing.T) {
+	now := time.Now()
+
+	defer func(f func(ctx context.Context, fqdn, value string, nameservers []string, useAuthoritative bool, quorum int) (bool, error)) {
+		util.PreCheckDNS = f
+	}(util.PreCheckDNS)
+	util.PreCheckDNS = func(ctx context.Context, fqdn, value string, nameservers []string, useAuthoritative bool, quorum int) (bool, error) {
+		return false, nil
+	}
+
+	tests := map[string]struct {
+		globalTimeout time.Duration
+		solverTimeout *metav1.Duration
+		// age is the time since the challenge was presented.
+		age time.Duration
+		// createdAge is the time since the challenge was created, if it
+		// differs from age.
+		createdAge time.Duration
+		// presentedTimeUnset is true if the time the challenge was presented
+		// was not recorded.
+		presentedTimeUnset bool
+
+		expectTimeout bool
+	}{
+		"should keep waiting if no timeout is configured": {
+			age: time.Hour,
+		},
+		"should keep waiting if the global timeout has not elapsed": {
+			globalTimeout: 10 * time.Minute,
+			age:           5 * time.Minute,
+		},
+		"should time out once the global timeout has elapsed": {
+			globalTimeout: 2 * time.Minute,
+			age:           5 * time.Minute,
+			expectTimeout: true,
+		},
+		"should keep waiting for a slow provider whose timeout is higher than the global timeout": {
+			globalTimeout: 2 * time.Minute,
+			solverTimeout: &metav1.Duration{Duration: 10 * time.Minute},
+			age:           5 * time.Minute,
+		},
+		"should time out once the solver timeout has elapsed": {
+			globalTimeout: 2 * time.Minute,
+			solverTimeout: &metav1.Duration{Duration: 10 * time.Minute},
+			age:           15 * time.Minute,
+			expectTimeout: true,
+		},
+		"should time out if the solver timeout is lower than the global timeout": {
+			globalTimeout: 10 * time.Minute,
+			solverTimeout: &metav1.Duration{Duration: time.Minute},
+			age:           5 * time.Minute,
+			expectTimeout: true,
+		},
+		"should keep waiting if the solver disables the timeout": {
+			globalTimeout: 2 * time.Minute,
+			solverTimeout: &metav1.Duration{},
+			age:           time.Hour,
+		},
+		"should measure the timeout from when the challenge was presented rather than created": {
+			globalTimeout: 10 * time.Minute,
+			age:           5 * time.Minute,
+			createdAge:    time.Hour,
+		},
+		"should measure the timeout from when the challenge was created if the presented time was not recorded": {
+			globalTimeout:      10 * time.Minute,
+			createdAge:         time.Hour,
+			presentedTimeUnset: true,
+			expectTimeout:      true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := &Solver{
+				Context: &controller.Context{
+					ContextOptions: controller.ContextOptions{
+						Clock: fakeclock.NewFakeClock(now),
+						ACMEOptions: controller.ACMEOptions{
+							DNS01CheckTimeout: test.globalTimeout,
+						},
+					},
+				},
+			}
+			createdAge := test.createdAge
+			if createdAge == 0 {
+				createdAge = test.age
+			}
+			ch := &cmacme.Challenge{
+				ObjectMeta: metav1.ObjectMeta{
+					CreationTimestamp: metav1.NewTime(now.Add(-createdAge)),
+				},
+				Spec: cmacme.ChallengeSpec{
+					DNSName: "example.com",
+					Solver: cmacme.ACMEChallengeSolver{
+						DNS01: &cmacme.ACMEChallengeSolverDNS01{
+							PropagationCheckTimeout: test.solverTimeout,
+						},
+					},
+				},
+			}
+
+			if !test.presentedTimeUnset {
+				ch.Status.PresentedTime = ptr.To(metav1.NewTime(now.Add(-test.age)))
+			}
+
+			err := s.Check(context.Background(), newIssuer(), ch)
+			if err == nil {
+				t.Fatal("expected an error as the record has not propagated")
+			}
+			if timedOut := errors.Is(err, ErrPropagationCheckTimeout); timedOut != test.expectTimeout {
+				t.Errorf("expected timeout=%t, got error: %v", test.expectTimeout, err)
+			}
+		})
+	}
+}
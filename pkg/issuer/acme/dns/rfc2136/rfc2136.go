@@ -37,9 +37,17 @@ var supportedAlgorithms = map[string]string{
 	"HMACMD5":    dns.HmacMD5,
 	"HMACSHA1":   dns.HmacSHA1,
 	"HMACSHA256": dns.HmacSHA256,
+	"HMACSHA384": dns.HmacSHA384,
 	"HMACSHA512": dns.HmacSHA512,
 }
 
+// normalizeTSIGAlgorithm maps both the names used by cert-manager (e.g.
+// HMACSHA512) and the algorithm names defined in RFC 8945 (e.g. hmac-sha512.)
+// to the keys of supportedAlgorithms.
+func normalizeTSIGAlgorithm(algorithm string) string {
+	return strings.ToUpper(strings.ReplaceAll(strings.TrimSuffix(algorithm, "."), "-", ""))
+}
+
 // DNSProvider is an implementation of the acme.ChallengeProvider interface that
 // uses dynamic DNS updates (RFC 2136) to create TXT records on a nameserver.
 type DNSProvider struct {
@@ -72,7 +80,7 @@ func NewDNSProviderCredentials(nameserver, tsigAlgorithm, tsigKeyName, tsigSecre
 	if tsigAlgorithm == "" {
 		tsigAlgorithm = dns.HmacMD5
 	} else {
-		if value, ok := supportedAlgorithms[strings.ToUpper(tsigAlgorithm)]; ok {
+		if value, ok := supportedAlgorithms[normalizeTSIGAlgorithm(tsigAlgorithm)]; ok {
 			tsigAlgorithm = value
 		} else {
 			return nil, fmt.Errorf("algorithm '%v' is not supported", tsigAlgorithm)
@@ -0,0 +1,206 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rfc2136
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	logtesting "github.com/go-logr/logr/testing"
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	testserver "github.com/cert-manager/cert-manager/test/acme/server"
+)
+
+const (
+	testZone        = "example.com."
+	testTsigKeyName = "example.com."
+	testTsigSecret  = "IwBTJx9wrDp4Y1RyC3H0gA=="
+)
+
+func TestNewDNSProviderCredentialsTSIGAlgorithm(t *testing.T) {
+	tests := map[string]struct {
+		algorithm string
+		want      string
+		wantErr   bool
+	}{
+		"defaults to HMACMD5": {
+			algorithm: "",
+			want:      dns.HmacMD5,
+		},
+		"HMACSHA384": {
+			algorithm: "HMACSHA384",
+			want:      dns.HmacSHA384,
+		},
+		"HMACSHA512": {
+			algorithm: "HMACSHA512",
+			want:      dns.HmacSHA512,
+		},
+		"case-insensitive": {
+			algorithm: "HmacSha512",
+			want:      dns.HmacSHA512,
+		},
+		"RFC 8945 algorithm name": {
+			algorithm: "hmac-sha512",
+			want:      dns.HmacSHA512,
+		},
+		"fully qualified RFC 8945 algorithm name": {
+			algorithm: "hmac-sha384.",
+			want:      dns.HmacSHA384,
+		},
+		"unsupported algorithm": {
+			algorithm: "HAMMOCK",
+			wantErr:   true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			provider, err := NewDNSProviderCredentials("127.0.0.1:53", test.algorithm, testTsigKeyName, testTsigSecret)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.want, provider.TSIGAlgorithm())
+		})
+	}
+}
+
+func TestPresentTSIGAlgorithm(t *testing.T) {
+	ctx := logf.NewContext(context.TODO(), logtesting.NewTestLogger(t), t.Name())
+	server := &testserver.BasicServer{
+		Zones:         []string{testZone},
+		Handler:       dns.HandlerFunc((&sha512OnlyHandler{t: t}).ServeDNS),
+		EnableTSIG:    true,
+		TSIGZone:      testZone,
+		TSIGKeyName:   testTsigKeyName,
+		TSIGKeySecret: testTsigSecret,
+	}
+	require.NoError(t, server.Run(ctx))
+	defer func() {
+		require.NoError(t, server.Shutdown())
+	}()
+
+	tests := map[string]struct {
+		algorithm string
+		wantErr   string
+	}{
+		"HMACSHA512 is accepted": {
+			algorithm: "HMACSHA512",
+		},
+		"hmac-sha512 is accepted": {
+			algorithm: "hmac-sha512",
+		},
+		"HMACSHA384 is rejected": {
+			algorithm: "HMACSHA384",
+			wantErr:   "DNS update failed. Server replied: NOTAUTH",
+		},
+		"HMACSHA256 is rejected": {
+			algorithm: "HMACSHA256",
+			wantErr:   "DNS update failed. Server replied: NOTAUTH",
+		},
+		"default HMACMD5 is rejected": {
+			algorithm: "",
+			wantErr:   "DNS update failed. Server replied: NOTAUTH",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			provider, err := NewDNSProviderCredentials(server.ListenAddr(), test.algorithm, testTsigKeyName, testTsigSecret)
+			require.NoError(t, err)
+
+			err = provider.Present("www.example.com", "_acme-challenge.www.example.com.", testZone, "123d==")
+			if test.wantErr != "" {
+				assert.EqualError(t, err, test.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestPresentCleanUpMultipleValues(t *testing.T) {
+	ctx := logf.NewContext(context.TODO(), logtesting.NewTestLogger(t), t.Name())
+	server := &testserver.BasicServer{
+		Zones: []string{testZone},
+	}
+	require.NoError(t, server.Run(ctx))
+	defer func() {
+		require.NoError(t, server.Shutdown())
+	}()
+
+	provider, err := NewDNSProviderCredentials(server.ListenAddr(), "", "", "")
+	require.NoError(t, err)
+
+	// Two challenges for different domains which are both CNAME delegated
+	// to the same record.
+	fqdn := "_acme-challenge.delegated.example.com."
+	var wg sync.WaitGroup
+	for _, value := range []string{"value-a", "value-b"} {
+		wg.Add(1)
+		go func(value string) {
+			defer wg.Done()
+			assert.NoError(t, provider.Present("", fqdn, testZone, value))
+		}(value)
+	}
+	wg.Wait()
+	assert.ElementsMatch(t, []string{"value-a", "value-b"}, lookupTXT(t, server.ListenAddr(), fqdn))
+
+	require.NoError(t, provider.CleanUp("", fqdn, testZone, "value-a"))
+	assert.ElementsMatch(t, []string{"value-b"}, lookupTXT(t, server.ListenAddr(), fqdn))
+
+	require.NoError(t, provider.CleanUp("", fqdn, testZone, "value-b"))
+	assert.Empty(t, lookupTXT(t, server.ListenAddr(), fqdn))
+}
+
+func lookupTXT(t *testing.T, nameserver, fqdn string) []string {
+	m := new(dns.Msg)
+	m.SetQuestion(fqdn, dns.TypeTXT)
+	r, err := dns.Exchange(m, nameserver)
+	require.NoError(t, err)
+
+	var values []string
+	for _, rr := range r.Answer {
+		if txt, ok := rr.(*dns.TXT); ok {
+			values = append(values, txt.Txt...)
+		}
+	}
+	return values
+}
+
+// sha512OnlyHandler is a DNS handler which, like a BIND server configured
+// with an hmac-sha512 key, only accepts updates signed using HMACSHA512.
+type sha512OnlyHandler struct {
+	t *testing.T
+}
+
+func (h *sha512OnlyHandler) ServeDNS(w dns.ResponseWriter, req *dns.Msg) {
+	m := new(dns.Msg)
+	tsig := req.IsTsig()
+	if tsig == nil || tsig.Algorithm != dns.HmacSHA512 || w.TsigStatus() != nil {
+		m.SetRcode(req, dns.RcodeNotAuth)
+	} else {
+		m.SetReply(req)
+		m.SetTsig(tsig.Hdr.Name, dns.HmacSHA512, 300, time.Now().Unix())
+	}
+	assert.NoError(h.t, w.WriteMsg(m))
+}
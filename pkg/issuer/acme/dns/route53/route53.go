@@ -25,6 +25,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/route53"
 	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
 	"github.com/aws/smithy-go/middleware"
 	"github.com/go-logr/logr"
 
@@ -52,6 +53,7 @@ type sessionProvider struct {
 	Ambient          bool
 	Region           string
 	Role             string
+	RoleChain        []string
 	WebIdentityToken string
 	StsProvider      func(aws.Config) StsClient
 	log              logr.Logger
@@ -63,9 +65,21 @@ type StsClient interface {
 	AssumeRoleWithWebIdentity(ctx context.Context, params *sts.AssumeRoleWithWebIdentityInput, optFns ...func(*sts.Options)) (*sts.AssumeRoleWithWebIdentityOutput, error)
 }
 
+// roles returns the ordered list of roles to assume: Role, if set, followed by
+// the RoleChain.
+func (d *sessionProvider) roles() []string {
+	var roles []string
+	if d.Role != "" {
+		roles = append(roles, d.Role)
+	}
+	return append(roles, d.RoleChain...)
+}
+
 func (d *sessionProvider) GetSession(ctx context.Context) (aws.Config, error) {
+	roles := d.roles()
+
 	switch {
-	case d.Role == "" && d.WebIdentityToken != "":
+	case len(roles) == 0 && d.WebIdentityToken != "":
 		return aws.Config{}, fmt.Errorf("unable to construct route53 provider: role must be set when web identity token is set")
 	case d.AccessKeyID == "" && d.SecretAccessKey == "":
 		if !d.Ambient && d.WebIdentityToken == "" {
@@ -80,7 +94,7 @@ func (d *sessionProvider) GetSession(ctx context.Context) (aws.Config, error) {
 
 	var optFns []func(*config.LoadOptions) error
 	switch {
-	case d.Role != "" && d.WebIdentityToken != "":
+	case len(roles) > 0 && d.WebIdentityToken != "":
 		d.log.V(logf.DebugLevel).Info("using assume role with web identity")
 		optFns = append(optFns, config.WithRegion(d.Region))
 	case useAmbientCredentials:
@@ -98,41 +112,44 @@ func (d *sessionProvider) GetSession(ctx context.Context) (aws.Config, error) {
 		return aws.Config{}, fmt.Errorf("unable to create aws config: %s", err)
 	}
 
-	if d.Role != "" && d.WebIdentityToken == "" {
-		d.log.V(logf.DebugLevel).WithValues("role", d.Role).Info("assuming role")
+	// Assume each role in turn, using the credentials obtained from the
+	// previous one. Only the first role may be assumed using a web identity.
+	for i, role := range roles {
 		stsSvc := d.StsProvider(cfg)
-		result, err := stsSvc.AssumeRole(ctx, &sts.AssumeRoleInput{
-			RoleArn:         aws.String(d.Role),
-			RoleSessionName: aws.String("cert-manager"),
-		})
-		if err != nil {
-			return aws.Config{}, fmt.Errorf("unable to assume role: %s", err)
-		}
 
-		cfg.Credentials = credentials.NewStaticCredentialsProvider(
-			*result.Credentials.AccessKeyId,
-			*result.Credentials.SecretAccessKey,
-			*result.Credentials.SessionToken,
-		)
-	}
-
-	if d.Role != "" && d.WebIdentityToken != "" {
-		d.log.V(logf.DebugLevel).WithValues("role", d.Role).Info("assuming role with web identity")
-
-		stsSvc := d.StsProvider(cfg)
-		result, err := stsSvc.AssumeRoleWithWebIdentity(ctx, &sts.AssumeRoleWithWebIdentityInput{
-			RoleArn:          aws.String(d.Role),
-			RoleSessionName:  aws.String("cert-manager"),
-			WebIdentityToken: aws.String(d.WebIdentityToken),
-		})
-		if err != nil {
-			return aws.Config{}, fmt.Errorf("unable to assume role with web identity: %s", err)
+		var creds *ststypes.Credentials
+		if i == 0 && d.WebIdentityToken != "" {
+			d.log.V(logf.DebugLevel).WithValues("role", role).Info("assuming role with web identity")
+
+			result, err := stsSvc.AssumeRoleWithWebIdentity(ctx, &sts.AssumeRoleWithWebIdentityInput{
+				RoleArn:          aws.String(role),
+				RoleSessionName:  aws.String("cert-manager"),
+				WebIdentityToken: aws.String(d.WebIdentityToken),
+			})
+			if err != nil {
+				return aws.Config{}, fmt.Errorf("unable to assume role with web identity: %s", err)
+			}
+			creds = result.Credentials
+		} else {
+			d.log.V(logf.DebugLevel).WithValues("role", role).Info("assuming role")
+
+			result, err := stsSvc.AssumeRole(ctx, &sts.AssumeRoleInput{
+				RoleArn:         aws.String(role),
+				RoleSessionName: aws.String("cert-manager"),
+			})
+			if err != nil {
+				if i > 0 {
+					return aws.Config{}, fmt.Errorf("unable to assume role %q in role chain: %s", role, err)
+				}
+				return aws.Config{}, fmt.Errorf("unable to assume role: %s", err)
+			}
+			creds = result.Credentials
 		}
 
 		cfg.Credentials = credentials.NewStaticCredentialsProvider(
-			*result.Credentials.AccessKeyId,
-			*result.Credentials.SecretAccessKey,
-			*result.Credentials.SessionToken,
+			*creds.AccessKeyId,
+			*creds.SecretAccessKey,
+			*creds.SessionToken,
 		)
 	}
 
@@ -150,13 +167,14 @@ func (d *sessionProvider) GetSession(ctx context.Context) (aws.Config, error) {
 	return cfg, nil
 }
 
-func newSessionProvider(accessKeyID, secretAccessKey, region, role string, webIdentityToken string, ambient bool, userAgent string) *sessionProvider {
+func newSessionProvider(accessKeyID, secretAccessKey, region, role string, roleChain []string, webIdentityToken string, ambient bool, userAgent string) *sessionProvider {
 	return &sessionProvider{
 		AccessKeyID:      accessKeyID,
 		SecretAccessKey:  secretAccessKey,
 		Ambient:          ambient,
 		Region:           region,
 		Role:             role,
+		RoleChain:        roleChain,
 		WebIdentityToken: webIdentityToken,
 		StsProvider:      defaultSTSProvider,
 		log:              logf.Log.WithName("route53-session-provider"),
@@ -173,12 +191,14 @@ func defaultSTSProvider(cfg aws.Config) StsClient {
 // unset and the 'ambient' option is set, credentials from the environment.
 func NewDNSProvider(
 	ctx context.Context,
-	accessKeyID, secretAccessKey, hostedZoneID, region, role, webIdentityToken string,
+	accessKeyID, secretAccessKey, hostedZoneID, region, role string,
+	roleChain []string,
+	webIdentityToken string,
 	ambient bool,
 	dns01Nameservers []string,
 	userAgent string,
 ) (*DNSProvider, error) {
-	provider := newSessionProvider(accessKeyID, secretAccessKey, region, role, webIdentityToken, ambient, userAgent)
+	provider := newSessionProvider(accessKeyID, secretAccessKey, region, role, roleChain, webIdentityToken, ambient, userAgent)
 
 	cfg, err := provider.GetSession(ctx)
 	if err != nil {
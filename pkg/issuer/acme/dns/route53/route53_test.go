@@ -57,7 +57,7 @@ func TestAmbientCredentialsFromEnv(t *testing.T) {
 	t.Setenv("AWS_SECRET_ACCESS_KEY", "123")
 	t.Setenv("AWS_REGION", "us-east-1")
 
-	provider, err := NewDNSProvider(context.TODO(), "", "", "", "", "", "", true, util.RecursiveNameservers, "cert-manager-test")
+	provider, err := NewDNSProvider(context.TODO(), "", "", "", "", "", nil, "", true, util.RecursiveNameservers, "cert-manager-test")
 	assert.NoError(t, err, "Expected no error constructing DNSProvider")
 
 	_, err = provider.client.Options().Credentials.Retrieve(context.TODO())
@@ -71,14 +71,14 @@ func TestNoCredentialsFromEnv(t *testing.T) {
 	t.Setenv("AWS_SECRET_ACCESS_KEY", "123")
 	t.Setenv("AWS_REGION", "us-east-1")
 
-	_, err := NewDNSProvider(context.TODO(), "", "", "", "", "", "", false, util.RecursiveNameservers, "cert-manager-test")
+	_, err := NewDNSProvider(context.TODO(), "", "", "", "", "", nil, "", false, util.RecursiveNameservers, "cert-manager-test")
 	assert.Error(t, err, "Expected error constructing DNSProvider with no credentials and not ambient")
 }
 
 func TestAmbientRegionFromEnv(t *testing.T) {
 	t.Setenv("AWS_REGION", "us-east-1")
 
-	provider, err := NewDNSProvider(context.TODO(), "", "", "", "", "", "", true, util.RecursiveNameservers, "cert-manager-test")
+	provider, err := NewDNSProvider(context.TODO(), "", "", "", "", "", nil, "", true, util.RecursiveNameservers, "cert-manager-test")
 	assert.NoError(t, err, "Expected no error constructing DNSProvider")
 
 	assert.Equal(t, "us-east-1", provider.client.Options().Region, "Expected Region to be set from environment")
@@ -87,7 +87,7 @@ func TestAmbientRegionFromEnv(t *testing.T) {
 func TestNoRegionFromEnv(t *testing.T) {
 	t.Setenv("AWS_REGION", "us-east-1")
 
-	provider, err := NewDNSProvider(context.TODO(), "marx", "swordfish", "", "", "", "", false, util.RecursiveNameservers, "cert-manager-test")
+	provider, err := NewDNSProvider(context.TODO(), "marx", "swordfish", "", "", "", nil, "", false, util.RecursiveNameservers, "cert-manager-test")
 	assert.NoError(t, err, "Expected no error constructing DNSProvider")
 
 	assert.Equal(t, "", provider.client.Options().Region, "Expected Region to not be set from environment")
@@ -271,6 +271,107 @@ func TestAssumeRole(t *testing.T) {
 	}
 }
 
+func TestAssumeRoleChain(t *testing.T) {
+	credsFor := func(role string) *ststypes.Credentials {
+		return &ststypes.Credentials{
+			AccessKeyId:     aws.String(role + "-key"),
+			SecretAccessKey: aws.String(role + "-secret"),
+			SessionToken:    aws.String(role + "-token"),
+		}
+	}
+
+	tests := map[string]struct {
+		role             string
+		roleChain        []string
+		webIdentityToken string
+		failRole         string
+		expAssumed       []string
+		// expCallerKeys are the access keys of the credentials each STS client
+		// is constructed with, in order.
+		expCallerKeys []string
+		expErr        bool
+	}{
+		"should assume each role in the chain using the previous role's credentials": {
+			role:          "role-a",
+			roleChain:     []string{"role-b", "role-c"},
+			expAssumed:    []string{"role-a", "role-b", "role-c"},
+			expCallerKeys: []string{"key", "role-a-key", "role-b-key"},
+		},
+		"should assume the role chain directly when no role is set": {
+			roleChain:     []string{"role-b"},
+			expAssumed:    []string{"role-b"},
+			expCallerKeys: []string{"key"},
+		},
+		"should use web identity for the first role only": {
+			role:             "role-a",
+			roleChain:        []string{"role-b"},
+			webIdentityToken: "token",
+			expAssumed:       []string{"web:role-a", "role-b"},
+			expCallerKeys:    []string{"", "role-a-key"},
+		},
+		"should fail if assuming a role in the chain fails": {
+			role:       "role-a",
+			roleChain:  []string{"role-b", "role-c"},
+			failRole:   "role-b",
+			expAssumed: []string{"role-a", "role-b"},
+			expErr:     true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var assumed, callerKeys []string
+			mock := &mockSTS{
+				AssumeRoleFn: func(ctx context.Context, params *sts.AssumeRoleInput, optFns ...func(*sts.Options)) (*sts.AssumeRoleOutput, error) {
+					assumed = append(assumed, *params.RoleArn)
+					if *params.RoleArn == test.failRole {
+						return nil, fmt.Errorf("error assuming mock role")
+					}
+					return &sts.AssumeRoleOutput{Credentials: credsFor(*params.RoleArn)}, nil
+				},
+				AssumeRoleWithWebIdentityFn: func(ctx context.Context, params *sts.AssumeRoleWithWebIdentityInput, optFns ...func(*sts.Options)) (*sts.AssumeRoleWithWebIdentityOutput, error) {
+					assumed = append(assumed, "web:"+*params.RoleArn)
+					return &sts.AssumeRoleWithWebIdentityOutput{Credentials: credsFor(*params.RoleArn)}, nil
+				},
+			}
+
+			key, secret := "key", "secret"
+			if test.webIdentityToken != "" {
+				key, secret = "", ""
+			}
+			provider := makeMockSessionProvider(func(cfg aws.Config) StsClient {
+				// the first client of a web identity chain uses the default
+				// credential chain, which is not retrieved here
+				var accessKeyID string
+				if test.webIdentityToken == "" || len(callerKeys) > 0 {
+					creds, err := cfg.Credentials.Retrieve(context.TODO())
+					assert.NoError(t, err)
+					accessKeyID = creds.AccessKeyID
+				}
+				callerKeys = append(callerKeys, accessKeyID)
+				return mock
+			}, key, secret, "eu-central-1", test.role, test.webIdentityToken, false)
+			provider.RoleChain = test.roleChain
+
+			cfg, err := provider.GetSession(context.TODO())
+			assert.Equal(t, test.expAssumed, assumed)
+			if test.expErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expCallerKeys, callerKeys)
+
+			lastRole := test.expAssumed[len(test.expAssumed)-1]
+			sessCreds, err := cfg.Credentials.Retrieve(context.TODO())
+			assert.NoError(t, err)
+			assert.Equal(t, *credsFor(lastRole).AccessKeyId, sessCreds.AccessKeyID)
+			assert.Equal(t, *credsFor(lastRole).SecretAccessKey, sessCreds.SecretAccessKey)
+			assert.Equal(t, *credsFor(lastRole).SessionToken, sessCreds.SessionToken)
+		})
+	}
+}
+
 type mockSTS struct {
 	AssumeRoleFn                func(ctx context.Context, params *sts.AssumeRoleInput, optFns ...func(*sts.Options)) (*sts.AssumeRoleOutput, error)
 	AssumeRoleWithWebIdentityFn func(ctx context.Context, params *sts.AssumeRoleWithWebIdentityInput, optFns ...func(*sts.Options)) (*sts.AssumeRoleWithWebIdentityOutput, error)
@@ -11,6 +11,7 @@ package util
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net"
@@ -25,7 +26,7 @@ import (
 )
 
 type preCheckDNSFunc func(ctx context.Context, fqdn, value string, nameservers []string,
-	useAuthoritative bool) (bool, error)
+	useAuthoritative bool, quorum int) (bool, error)
 type dnsQueryFunc func(ctx context.Context, fqdn string, rtype uint16, nameservers []string, recursive bool) (in *dns.Msg, err error)
 
 var (
@@ -105,8 +106,11 @@ func followCNAMEs(ctx context.Context, fqdn string, nameservers []string, fqdnCh
 }
 
 // checkDNSPropagation checks if the expected TXT record has been propagated to all authoritative nameservers.
+// If useAuthoritative is false, the given recursive nameservers are queried
+// instead, and quorum (if greater than zero) is the number of them that must
+// agree the record is present.
 func checkDNSPropagation(ctx context.Context, fqdn, value string, nameservers []string,
-	useAuthoritative bool) (bool, error) {
+	useAuthoritative bool, quorum int) (bool, error) {
 
 	var err error
 	fqdn, err = followCNAMEs(ctx, fqdn, nameservers)
@@ -115,6 +119,9 @@ func checkDNSPropagation(ctx context.Context, fqdn, value string, nameservers []
 	}
 
 	if !useAuthoritative {
+		if quorum > 0 {
+			return checkNssQuorum(ctx, fqdn, value, nameservers, quorum)
+		}
 		return checkAuthoritativeNss(ctx, fqdn, value, nameservers)
 	}
 
@@ -143,17 +150,7 @@ func checkAuthoritativeNss(ctx context.Context, fqdn, value string, nameservers
 		}
 
 		logf.V(logf.DebugLevel).Infof("Looking up TXT records for %q", fqdn)
-		var found bool
-		for _, rr := range r.Answer {
-			if txt, ok := rr.(*dns.TXT); ok {
-				if strings.Join(txt.Txt, "") == value {
-					found = true
-					break
-				}
-			}
-		}
-
-		if !found {
+		if !txtRecordsContain(r, value) {
 			return false, nil
 		}
 	}
@@ -161,6 +158,54 @@ func checkAuthoritativeNss(ctx context.Context, fqdn, value string, nameservers
 	return true, nil
 }
 
+// checkNssQuorum queries each of the given nameservers for the expected TXT
+// record, and returns true once at least quorum of them have answered with it.
+// Nameservers which fail to answer are counted as not having the record.
+func checkNssQuorum(ctx context.Context, fqdn, value string, nameservers []string, quorum int) (bool, error) {
+	var found int
+	var errs []error
+	for _, ns := range nameservers {
+		r, err := dnsQuery(ctx, fqdn, dns.TypeTXT, []string{ns}, true)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("NS %s: %w", ns, err))
+			continue
+		}
+
+		// NXDomain response is not really an error, just waiting for propagation to happen
+		if !(r.Rcode == dns.RcodeSuccess || r.Rcode == dns.RcodeNameError) {
+			errs = append(errs, fmt.Errorf("NS %s returned %s for %s", ns, dns.RcodeToString[r.Rcode], fqdn))
+			continue
+		}
+
+		if txtRecordsContain(r, value) {
+			found++
+		}
+	}
+
+	logf.V(logf.DebugLevel).Infof("Found TXT record for %q on %d of %d nameservers, quorum is %d", fqdn, found, len(nameservers), quorum)
+	if found >= quorum {
+		return true, nil
+	}
+	// only surface query errors if they prevented the quorum from being reached
+	if len(nameservers)-len(errs) < quorum {
+		return false, errors.Join(errs...)
+	}
+	return false, nil
+}
+
+// txtRecordsContain returns true if the given DNS message contains a TXT
+// record with the given value.
+func txtRecordsContain(r *dns.Msg, value string) bool {
+	for _, rr := range r.Answer {
+		if txt, ok := rr.(*dns.TXT); ok {
+			if strings.Join(txt.Txt, "") == value {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // DNSQuery will query a nameserver, iterating through the supplied servers as it retries
 // The nameserver should include a port, to facilitate testing where we talk to a mock dns server.
 func DNSQuery(ctx context.Context, fqdn string, rtype uint16, nameservers []string, recursive bool) (in *dns.Msg, err error) {
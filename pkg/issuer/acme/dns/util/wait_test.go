@@ -173,14 +173,14 @@ func TestMatchCAA(t *testing.T) {
 }
 
 func TestPreCheckDNSOverHTTPSNoAuthoritative(t *testing.T) {
-	ok, err := PreCheckDNS(context.TODO(), "google.com.", "v=spf1 include:_spf.google.com ~all", []string{"https://1.1.1.1/dns-query"}, false)
+	ok, err := PreCheckDNS(context.TODO(), "google.com.", "v=spf1 include:_spf.google.com ~all", []string{"https://1.1.1.1/dns-query"}, false, 0)
 	if err != nil || !ok {
 		t.Errorf("preCheckDNS failed for acme-staging.api.letsencrypt.org: %s", err.Error())
 	}
 }
 
 func TestPreCheckDNSOverHTTPS(t *testing.T) {
-	ok, err := PreCheckDNS(context.TODO(), "google.com.", "v=spf1 include:_spf.google.com ~all", []string{"https://8.8.8.8/dns-query"}, true)
+	ok, err := PreCheckDNS(context.TODO(), "google.com.", "v=spf1 include:_spf.google.com ~all", []string{"https://8.8.8.8/dns-query"}, true, 0)
 	if err != nil || !ok {
 		t.Errorf("preCheckDNS failed for acme-staging.api.letsencrypt.org: %s", err.Error())
 	}
@@ -188,7 +188,7 @@ func TestPreCheckDNSOverHTTPS(t *testing.T) {
 
 func TestPreCheckDNS(t *testing.T) {
 	// TODO: find a better TXT record to use in tests
-	ok, err := PreCheckDNS(context.TODO(), "google.com.", "v=spf1 include:_spf.google.com ~all", []string{"8.8.8.8:53"}, true)
+	ok, err := PreCheckDNS(context.TODO(), "google.com.", "v=spf1 include:_spf.google.com ~all", []string{"8.8.8.8:53"}, true, 0)
 	if err != nil || !ok {
 		t.Errorf("preCheckDNS failed for acme-staging.api.letsencrypt.org: %s", err.Error())
 	}
@@ -196,7 +196,7 @@ func TestPreCheckDNS(t *testing.T) {
 
 func TestPreCheckDNSNonAuthoritative(t *testing.T) {
 	// TODO: find a better TXT record to use in tests
-	ok, err := PreCheckDNS(context.TODO(), "google.com.", "v=spf1 include:_spf.google.com ~all", []string{"1.1.1.1:53"}, false)
+	ok, err := PreCheckDNS(context.TODO(), "google.com.", "v=spf1 include:_spf.google.com ~all", []string{"1.1.1.1:53"}, false, 0)
 	if err != nil || !ok {
 		t.Errorf("preCheckDNS failed for acme-staging.api.letsencrypt.org: %s", err.Error())
 	}
@@ -416,3 +416,84 @@ func Test_followCNAMEs(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckDNSPropagationQuorum(t *testing.T) {
+	const (
+		fqdn  = "_acme-challenge.example.com."
+		value = "token"
+	)
+	// each mocked nameserver answers differently, simulating split-horizon DNS
+	dnsQuery = func(ctx context.Context, fqdn string, rtype uint16, nameservers []string, recursive bool) (*dns.Msg, error) {
+		msg := &dns.Msg{}
+		msg.Rcode = dns.RcodeSuccess
+		if rtype != dns.TypeTXT {
+			return msg, nil
+		}
+		switch nameservers[0] {
+		case "present-1:53", "present-2:53":
+			msg.Answer = []dns.RR{&dns.TXT{Hdr: dns.RR_Header{Name: fqdn}, Txt: []string{value}}}
+		case "stale:53":
+			msg.Answer = []dns.RR{&dns.TXT{Hdr: dns.RR_Header{Name: fqdn}, Txt: []string{"old-token"}}}
+		case "nxdomain:53":
+			msg.Rcode = dns.RcodeNameError
+		case "servfail:53":
+			msg.Rcode = dns.RcodeServerFailure
+		case "error:53":
+			return nil, fmt.Errorf("connection refused")
+		}
+		return msg, nil
+	}
+	defer func() {
+		// restore the mock
+		dnsQuery = DNSQuery
+	}()
+
+	tests := map[string]struct {
+		nameservers []string
+		quorum      int
+		ok          bool
+		err         string
+	}{
+		"quorum reached when enough nameservers agree": {
+			nameservers: []string{"present-1:53", "stale:53", "present-2:53"},
+			quorum:      2,
+			ok:          true,
+		},
+		"quorum not reached when nameservers disagree": {
+			nameservers: []string{"present-1:53", "stale:53", "nxdomain:53"},
+			quorum:      2,
+			ok:          false,
+		},
+		"failing nameservers do not prevent quorum": {
+			nameservers: []string{"present-1:53", "error:53", "present-2:53", "servfail:53"},
+			quorum:      2,
+			ok:          true,
+		},
+		"errors are returned when too many nameservers fail to reach quorum": {
+			nameservers: []string{"present-1:53", "error:53", "servfail:53"},
+			quorum:      2,
+			ok:          false,
+			err:         "NS error:53: connection refused",
+		},
+		"quorum equal to the number of nameservers requires all to agree": {
+			nameservers: []string{"present-1:53", "present-2:53", "stale:53"},
+			quorum:      3,
+			ok:          false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ok, err := checkDNSPropagation(context.TODO(), fqdn, value, test.nameservers, false, test.quorum)
+			if ok != test.ok {
+				t.Errorf("got ok=%t; want %t", ok, test.ok)
+			}
+			if test.err == "" && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if test.err != "" && (err == nil || !strings.Contains(err.Error(), test.err)) {
+				t.Errorf("expected error containing %q; got %v", test.err, err)
+			}
+		})
+	}
+}
@@ -21,11 +21,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
-	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/client-go/rest"
 
 	"github.com/cert-manager/cert-manager/pkg/acme/webhook/apis/acme/v1alpha1"
@@ -42,76 +44,94 @@ func (r *Webhook) Name() string {
 	return "webhook"
 }
 
-// Present creates a TXT record using the specified parameters
-func (r *Webhook) Present(ch *v1alpha1.ChallengeRequest) error {
-	cl, pl, solverName, err := r.buildPayload(ch, v1alpha1.ChallengeActionPresent)
-	if err != nil {
-		return err
-	}
-
-	result := cl.Post().Resource(solverName).Body(pl).Do(context.TODO())
-	// we will check this error after parsing the response
-	resErr := result.Error()
+// Error is returned when a webhook solver reports that it failed to perform
+// an action. It preserves the status returned by the webhook so that its
+// details surface in the status of the Challenge.
+type Error struct {
+	// SolverName is the name of the webhook solver
+	SolverName string
+	// Action is the action which failed
+	Action v1alpha1.ChallengeAction
+	// Status is the status returned by the webhook
+	Status metav1.Status
+}
 
-	// TODO: handle metav1.Status response type and print better error messages
-	var respPayload v1alpha1.ChallengePayload
-	if err := result.Into(&respPayload); err != nil {
-		return utilerrors.NewAggregate([]error{resErr, err})
+func (e *Error) Error() string {
+	msg := e.Status.Message
+	if msg == "" {
+		msg = "no error message provided"
 	}
-
-	if respPayload.Response.Success && resErr == nil {
-		logf.Log.V(logf.DebugLevel).Info("Present call succeeded")
-		return nil
+	var details []string
+	if e.Status.Reason != "" {
+		details = append(details, fmt.Sprintf("reason: %s", e.Status.Reason))
 	}
-
-	if respPayload.Response.Result == nil {
-		return utilerrors.NewAggregate([]error{
-			resErr,
-			fmt.Errorf("invalid payload response, did not succeed but no result provided"),
-		})
+	if e.Status.Code != 0 {
+		details = append(details, fmt.Sprintf("code: %d", e.Status.Code))
 	}
-
-	if respPayload.Response.Result.Message != "" {
-		return errors.New(respPayload.Response.Result.Message)
+	if len(details) > 0 {
+		msg = fmt.Sprintf("%s (%s)", msg, strings.Join(details, ", "))
 	}
+	return fmt.Sprintf("webhook solver %q failed to %s challenge: %s", e.SolverName, strings.ToLower(string(e.Action)), msg)
+}
 
-	return resErr
+// Present creates a TXT record using the specified parameters
+func (r *Webhook) Present(ch *v1alpha1.ChallengeRequest) error {
+	return r.call(ch, v1alpha1.ChallengeActionPresent)
 }
 
 // CleanUp removes the TXT record matching the specified parameters
 func (r *Webhook) CleanUp(ch *v1alpha1.ChallengeRequest) error {
-	cl, pl, solverName, err := r.buildPayload(ch, v1alpha1.ChallengeActionCleanUp)
+	return r.call(ch, v1alpha1.ChallengeActionCleanUp)
+}
+
+// call POSTs a ChallengePayload for the given action to the webhook solver,
+// waiting at most for the timeout configured on the solver.
+func (r *Webhook) call(ch *v1alpha1.ChallengeRequest, action v1alpha1.ChallengeAction) error {
+	cl, pl, cfg, err := r.buildPayload(ch, action)
 	if err != nil {
 		return err
 	}
 
-	result := cl.Post().Resource(solverName).Body(pl).Do(context.TODO())
-	// we will check this error after parsing the response
-	resErr := result.Error()
+	ctx := context.Background()
+	if cfg.Timeout != nil && cfg.Timeout.Duration > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cfg.Timeout.Duration)
+		defer cancel()
+	}
+
+	result := cl.Post().Resource(cfg.SolverName).Body(pl).Do(ctx)
+	if err := result.Error(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return fmt.Errorf("webhook solver %q did not %s challenge within %s: %w", cfg.SolverName, strings.ToLower(string(action)), cfg.Timeout.Duration, err)
+		}
+		// The webhook apiserver rejected the request, e.g. because the
+		// solver is not registered or it returned an error status.
+		var statusErr apierrors.APIStatus
+		if errors.As(err, &statusErr) {
+			return &Error{SolverName: cfg.SolverName, Action: action, Status: statusErr.Status()}
+		}
+		return fmt.Errorf("webhook solver %q failed to %s challenge: %w", cfg.SolverName, strings.ToLower(string(action)), err)
+	}
 
-	// TODO: handle metav1.Status response type and print better error messages
 	var respPayload v1alpha1.ChallengePayload
 	if err := result.Into(&respPayload); err != nil {
-		return utilerrors.NewAggregate([]error{resErr, err})
+		return fmt.Errorf("error decoding response from webhook solver %q: %w", cfg.SolverName, err)
 	}
 
-	if respPayload.Response.Success && resErr == nil {
-		logf.Log.V(logf.DebugLevel).Info("CleanUp call succeeded")
-		return nil
+	if respPayload.Response == nil {
+		return fmt.Errorf("invalid payload response from webhook solver %q, no response provided", cfg.SolverName)
 	}
 
-	if respPayload.Response.Result == nil {
-		return utilerrors.NewAggregate([]error{
-			resErr,
-			fmt.Errorf("invalid payload response, did not succeed but no result provided"),
-		})
+	if respPayload.Response.Success {
+		logf.Log.V(logf.DebugLevel).Info("webhook call succeeded", "action", action)
+		return nil
 	}
 
-	if respPayload.Response.Result.Message != "" {
-		return errors.New(respPayload.Response.Result.Message)
+	if respPayload.Response.Result == nil {
+		return fmt.Errorf("invalid payload response from webhook solver %q, did not succeed but no result provided", cfg.SolverName)
 	}
 
-	return resErr
+	return &Error{SolverName: cfg.SolverName, Action: action, Status: *respPayload.Response.Result}
 }
 
 func (r *Webhook) Initialize(kubeClientConfig *rest.Config, stopCh <-chan struct{}) error {
@@ -130,20 +150,20 @@ func (r *Webhook) Initialize(kubeClientConfig *rest.Config, stopCh <-chan struct
 	return nil
 }
 
-func (r *Webhook) buildPayload(ch *v1alpha1.ChallengeRequest, action v1alpha1.ChallengeAction) (*rest.RESTClient, *v1alpha1.ChallengePayload, string, error) {
+func (r *Webhook) buildPayload(ch *v1alpha1.ChallengeRequest, action v1alpha1.ChallengeAction) (*rest.RESTClient, *v1alpha1.ChallengePayload, *cmacme.ACMEIssuerDNS01ProviderWebhook, error) {
 	// create a copy just to be certain we don't modify something unexpectedly
 	req := ch.DeepCopy()
 
 	// extract the complete solver config, including groupName and solverName
 	cfg, err := loadConfig(*req.Config)
 	if err != nil {
-		return nil, nil, "", err
+		return nil, nil, nil, err
 	}
 
 	// obtain a REST client that can be used to communicate with the webhook
 	cl, err := r.restClientForGroup(cfg.GroupName)
 	if err != nil {
-		return nil, nil, "", err
+		return nil, nil, nil, err
 	}
 
 	// build the ChallengePayload resource
@@ -157,7 +177,7 @@ func (r *Webhook) buildPayload(ch *v1alpha1.ChallengeRequest, action v1alpha1.Ch
 	// only the 'config' field and submit that to the webhook.
 	pl.Request.Config = cfg.Config
 
-	return cl, pl, cfg.SolverName, nil
+	return cl, pl, cfg, nil
 }
 
 func loadConfig(cfgJSON apiextensionsv1.JSON) (*cmacme.ACMEIssuerDNS01ProviderWebhook, error) {
@@ -0,0 +1,155 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+
+	"github.com/cert-manager/cert-manager/pkg/acme/webhook/apis/acme/v1alpha1"
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+)
+
+func TestWebhookCall(t *testing.T) {
+	respondWith := func(code int, body interface{}) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(code)
+			assert.NoError(t, json.NewEncoder(w).Encode(body))
+		}
+	}
+	payload := func(resp *v1alpha1.ChallengeResponse) *v1alpha1.ChallengePayload {
+		return &v1alpha1.ChallengePayload{
+			TypeMeta: metav1.TypeMeta{APIVersion: "acme.example.com/v1alpha1", Kind: "ChallengePayload"},
+			Response: resp,
+		}
+	}
+
+	tests := map[string]struct {
+		handler http.HandlerFunc
+		hang    bool
+		timeout *metav1.Duration
+
+		expectedErr       string
+		expectedErrStatus *metav1.Status
+	}{
+		"succeeds if the webhook reports success": {
+			handler: respondWith(http.StatusOK, payload(&v1alpha1.ChallengeResponse{Success: true})),
+		},
+		"returns the details of the error reported by the webhook": {
+			handler: respondWith(http.StatusOK, payload(&v1alpha1.ChallengeResponse{
+				Success: false,
+				Result: &metav1.Status{
+					Message: `zone "example.com" not found`,
+					Reason:  metav1.StatusReasonNotFound,
+					Code:    http.StatusNotFound,
+				},
+			})),
+			expectedErr: `webhook solver "example" failed to present challenge: zone "example.com" not found (reason: NotFound, code: 404)`,
+			expectedErrStatus: &metav1.Status{
+				Message: `zone "example.com" not found`,
+				Reason:  metav1.StatusReasonNotFound,
+				Code:    http.StatusNotFound,
+			},
+		},
+		"returns the details of an error status returned by the webhook apiserver": {
+			handler: respondWith(http.StatusInternalServerError, &metav1.Status{
+				TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Status"},
+				Status:   metav1.StatusFailure,
+				Message:  "DNS API rate limit exceeded",
+				Reason:   metav1.StatusReasonInternalError,
+				Code:     http.StatusInternalServerError,
+			}),
+			expectedErr: `webhook solver "example" failed to present challenge: DNS API rate limit exceeded (reason: InternalError, code: 500)`,
+			expectedErrStatus: &metav1.Status{
+				Status:  metav1.StatusFailure,
+				Message: "DNS API rate limit exceeded",
+				Reason:  metav1.StatusReasonInternalError,
+				Code:    http.StatusInternalServerError,
+			},
+		},
+		"fails if the webhook reports failure without a result": {
+			handler:     respondWith(http.StatusOK, payload(&v1alpha1.ChallengeResponse{Success: false})),
+			expectedErr: `invalid payload response from webhook solver "example", did not succeed but no result provided`,
+		},
+		"fails if the webhook does not return a response": {
+			handler:     respondWith(http.StatusOK, payload(nil)),
+			expectedErr: `invalid payload response from webhook solver "example", no response provided`,
+		},
+		"times out if the webhook hangs": {
+			hang:        true,
+			timeout:     &metav1.Duration{Duration: 100 * time.Millisecond},
+			expectedErr: `webhook solver "example" did not present challenge within 100ms`,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var requestPath string
+			stop := make(chan struct{})
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requestPath = r.URL.Path
+				if test.hang {
+					<-stop
+					return
+				}
+				test.handler(w, r)
+			}))
+			defer server.Close()
+			defer close(stop)
+
+			wh := &Webhook{}
+			require.NoError(t, wh.Initialize(&rest.Config{Host: server.URL}, nil))
+
+			cfg, err := json.Marshal(&cmacme.ACMEIssuerDNS01ProviderWebhook{
+				GroupName:  "acme.example.com",
+				SolverName: "example",
+				Timeout:    test.timeout,
+			})
+			require.NoError(t, err)
+
+			err = wh.Present(&v1alpha1.ChallengeRequest{
+				DNSName: "example.com",
+				Config:  &apiextensionsv1.JSON{Raw: cfg},
+			})
+			assert.Equal(t, "/apis/acme.example.com/v1alpha1/example", requestPath)
+			if test.expectedErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.expectedErr)
+
+			var whErr *Error
+			if test.expectedErrStatus != nil {
+				require.True(t, errors.As(err, &whErr), "expected a webhook Error but got %T", err)
+				assert.Equal(t, *test.expectedErrStatus, whErr.Status)
+			} else {
+				assert.False(t, errors.As(err, &whErr), "expected an error other than a webhook Error")
+			}
+		})
+	}
+}
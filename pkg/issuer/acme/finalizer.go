@@ -0,0 +1,234 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acme
+
+import (
+	"context"
+	"crypto/rsa"
+	stderrors "errors"
+	"fmt"
+	"time"
+
+	acmeapi "golang.org/x/crypto/acme"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/cert-manager/cert-manager/pkg/acme"
+	"github.com/cert-manager/cert-manager/pkg/acme/accounts"
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/issuer"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/util/errors"
+)
+
+// Functions for deactivating the ACME account of an issuer when it is
+// deleted. If deactivateAccountOnDelete is set, the issuers controllers add
+// the deactivate-account finalizer to the Issuer or ClusterIssuer. When it is
+// deleted, the ACME account is deactivated with the ACME server using the
+// private key stored in the issuer's Secret before the finalizer is removed.
+
+const (
+	reasonAccountDeactivated         = "ACMEAccountDeactivated"
+	reasonAccountDeactivationSkipped = "ACMEAccountDeactivationSkipped"
+
+	messageAccountDeactivated = "The ACME account was deactivated with the ACME server as the issuer is being deleted"
+
+	// maxAccountDeactivationRetryPeriod is how long after the issuer was
+	// deleted deactivation of the ACME account is retried for if the ACME
+	// server cannot be reached or fails, before giving up.
+	maxAccountDeactivationRetryPeriod = time.Hour
+)
+
+var _ issuer.Finalizer = &Acme{}
+
+// Finalizer returns the deactivate-account finalizer, which is required if
+// the issuer has deactivateAccountOnDelete set.
+func (a *Acme) Finalizer() (string, bool) {
+	return cmacme.ACMEDeactivateAccountFinalizer, a.issuer.GetSpec().ACME.DeactivateAccountOnDelete
+}
+
+// Finalize deactivates the issuer's ACME account with the ACME server. An
+// error is only returned if deactivation should be retried. If the account
+// cannot be deactivated because its private key is missing or invalid, or
+// because the ACME server rejects the request, for example because the
+// account has already been deactivated, a warning event is recorded and nil
+// is returned so that deletion of the issuer is never blocked forever. The
+// same happens if the CA bundle Secret is missing or invalid, or if the ACME
+// server still fails maxAccountDeactivationRetryPeriod after the issuer was
+// deleted.
+func (a *Acme) Finalize(ctx context.Context) error {
+	log := logf.FromContext(ctx, "finalizer")
+
+	if !a.issuer.GetSpec().ACME.DeactivateAccountOnDelete {
+		log.V(logf.DebugLevel).Info("issuer no longer requires account deactivation on delete, skipping")
+		return nil
+	}
+
+	ns := a.issuer.GetObjectMeta().Namespace
+	if ns == "" {
+		ns = a.clusterResourceNamespace
+	}
+
+	// Deactivation cannot be undone, so the account is left alone while any
+	// other issuer still uses it.
+	other, err := a.otherIssuerUsingAccount()
+	if err != nil {
+		return err
+	}
+	if other != nil {
+		a.recorder.Eventf(a.issuer, corev1.EventTypeWarning, reasonAccountDeactivationSkipped,
+			"Not deactivating ACME account as it is also used by %s %q", issuerKind(other), other.GetObjectMeta().Name)
+		a.accountRegistry.RemoveClient(string(a.issuer.GetUID()))
+		return nil
+	}
+
+	privateKeySelector := acme.PrivateKeySelector(a.issuer.GetSpec().ACME.PrivateKey)
+	pk, err := a.keyFromSecret(ctx, ns, privateKeySelector.Name, privateKeySelector.Key)
+	switch {
+	case apierrors.IsNotFound(err):
+		a.recorder.Event(a.issuer, corev1.EventTypeWarning, reasonAccountDeactivationSkipped, "Not deactivating ACME account as the account private key Secret does not exist, it may have been deleted before the issuer")
+		return nil
+	case errors.IsInvalidData(err):
+		a.recorder.Eventf(a.issuer, corev1.EventTypeWarning, reasonAccountDeactivationSkipped, "Not deactivating ACME account as the account private key is invalid: %v", err)
+		return nil
+	case err != nil:
+		return err
+	}
+	rsaPk, ok := pk.(*rsa.PrivateKey)
+	if !ok {
+		a.recorder.Event(a.issuer, corev1.EventTypeWarning, reasonAccountDeactivationSkipped, "Not deactivating ACME account as the account private key is not of type RSA")
+		return nil
+	}
+
+	acmeConfig := *a.issuer.GetSpec().ACME
+	if acmeConfig.CABundleSecretRef != nil {
+		caBundle, err := a.getCABundle(ctx, ns)
+		switch {
+		case apierrors.IsNotFound(err):
+			a.recorder.Event(a.issuer, corev1.EventTypeWarning, reasonAccountDeactivationSkipped, "Not deactivating ACME account as the CA bundle Secret does not exist, it may have been deleted before the issuer")
+			a.accountRegistry.RemoveClient(string(a.issuer.GetUID()))
+			return nil
+		case errors.IsInvalidData(err):
+			a.recorder.Eventf(a.issuer, corev1.EventTypeWarning, reasonAccountDeactivationSkipped, "Not deactivating ACME account as the CA bundle is invalid: %v", err)
+			a.accountRegistry.RemoveClient(string(a.issuer.GetUID()))
+			return nil
+		case err != nil:
+			return err
+		}
+		acmeConfig.CABundle = caBundle
+	}
+	cl := a.clientBuilder(accounts.BuildHTTPClientForIssuer(a.metrics, acmeConfig), acmeConfig, rsaPk, a.userAgent)
+
+	err = cl.DeactivateReg(ctx)
+	var acmeErr *acmeapi.Error
+	switch {
+	case err == nil:
+		a.recorder.Event(a.issuer, corev1.EventTypeNormal, reasonAccountDeactivated, messageAccountDeactivated)
+	case stderrors.Is(err, acmeapi.ErrNoAccount):
+		// The ACME server does not know of an account for this key, which
+		// is also the case if the account has already been deactivated.
+		log.V(logf.DebugLevel).Info("ACME account does not exist or has already been deactivated")
+	case stderrors.As(err, &acmeErr) && acmeErr.StatusCode >= 400 && acmeErr.StatusCode < 500:
+		a.recorder.Eventf(a.issuer, corev1.EventTypeWarning, reasonAccountDeactivationSkipped, "Not deactivating ACME account as the ACME server rejected the request: %v", err)
+	case a.deactivationRetryPeriodExceeded():
+		a.recorder.Eventf(a.issuer, corev1.EventTypeWarning, reasonAccountDeactivationSkipped, "Not deactivating ACME account as the ACME server still fails %s after the issuer was deleted: %v", maxAccountDeactivationRetryPeriod, err)
+	default:
+		return fmt.Errorf("error deactivating ACME account: %w", err)
+	}
+
+	a.accountRegistry.RemoveClient(string(a.issuer.GetUID()))
+	return nil
+}
+
+// deactivationRetryPeriodExceeded returns true if the issuer was deleted more
+// than maxAccountDeactivationRetryPeriod ago.
+func (a *Acme) deactivationRetryPeriodExceeded() bool {
+	deleted := a.issuer.GetObjectMeta().DeletionTimestamp
+	if deleted == nil {
+		return false
+	}
+	return a.clock.Since(deleted.Time) > maxAccountDeactivationRetryPeriod
+}
+
+// otherIssuerUsingAccount returns another ACME Issuer or ClusterIssuer which
+// uses the same ACME account as the issuer being finalized, either because it
+// references the same private key Secret or because it is registered with the
+// same account URI. Nil is returned if there is no such issuer.
+func (a *Acme) otherIssuerUsingAccount() (cmapi.GenericIssuer, error) {
+	var candidates []cmapi.GenericIssuer
+	issuers, err := a.issuerLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, iss := range issuers {
+		candidates = append(candidates, iss)
+	}
+	if a.clusterIssuerLister != nil {
+		clusterIssuers, err := a.clusterIssuerLister.List(labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		for _, iss := range clusterIssuers {
+			candidates = append(candidates, iss)
+		}
+	}
+
+	keyNamespace, keyName := a.privateKeySecret(a.issuer)
+	accountURI := acmeAccountURI(a.issuer)
+	for _, other := range candidates {
+		if other.GetUID() == a.issuer.GetUID() || other.GetSpec().ACME == nil {
+			continue
+		}
+		if ns, name := a.privateKeySecret(other); ns == keyNamespace && name == keyName {
+			return other, nil
+		}
+		if accountURI != "" && acmeAccountURI(other) == accountURI {
+			return other, nil
+		}
+	}
+	return nil, nil
+}
+
+// acmeAccountURI returns the URI of the ACME account the given issuer is
+// registered with, if any. Unlike ACMEStatus, it never modifies the issuer,
+// which may be shared with the informer cache.
+func acmeAccountURI(iss cmapi.GenericIssuer) string {
+	if status := iss.GetStatus().ACME; status != nil {
+		return status.URI
+	}
+	return ""
+}
+
+// privateKeySecret returns the namespace and name of the Secret holding the
+// ACME account private key of the given issuer.
+func (a *Acme) privateKeySecret(iss cmapi.GenericIssuer) (string, string) {
+	ns := iss.GetObjectMeta().Namespace
+	if ns == "" {
+		ns = a.clusterResourceNamespace
+	}
+	return ns, acme.PrivateKeySelector(iss.GetSpec().ACME.PrivateKey).Name
+}
+
+// issuerKind returns the kind of the given issuer.
+func issuerKind(iss cmapi.GenericIssuer) string {
+	if _, ok := iss.(*cmapi.ClusterIssuer); ok {
+		return cmapi.ClusterIssuerKind
+	}
+	return cmapi.IssuerKind
+}
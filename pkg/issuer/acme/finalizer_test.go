@@ -0,0 +1,364 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acme
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	acmeapi "golang.org/x/crypto/acme"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	fakeregistry "github.com/cert-manager/cert-manager/pkg/acme/accounts/test"
+	acmecl "github.com/cert-manager/cert-manager/pkg/acme/client"
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
+	controllertest "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/cert-manager/cert-manager/test/unit/coreclients"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestAcme_Finalizer(t *testing.T) {
+	for _, required := range []bool{true, false} {
+		a := Acme{
+			issuer: gen.Issuer("test", gen.SetIssuerACME(cmacme.ACMEIssuer{DeactivateAccountOnDelete: required})),
+		}
+		name, gotRequired := a.Finalizer()
+		if name != cmacme.ACMEDeactivateAccountFinalizer {
+			t.Errorf("expected finalizer %q, got %q", cmacme.ACMEDeactivateAccountFinalizer, name)
+		}
+		if gotRequired != required {
+			t.Errorf("expected finalizer required to be %v, got %v", required, gotRequired)
+		}
+	}
+}
+
+func TestAcme_Finalize(t *testing.T) {
+	rsaKey, err := pki.GenerateRSAPrivateKey(2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	unauthorizedErr := &acmeapi.Error{
+		StatusCode:  401,
+		ProblemType: "urn:ietf:params:acme:error:unauthorized",
+		Detail:      "Account is not valid, has status \"deactivated\"",
+	}
+	serverErr := &acmeapi.Error{StatusCode: 500, Detail: "internal error"}
+	now := time.Now()
+	caBundleSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "ca", Namespace: "testns"},
+		Data:       map[string][]byte{cmmeta.TLSCAKey: []byte("ca bundle")},
+	}
+
+	const accountURI = "https://acme.example.com/acct/1"
+	setUID := func(uid types.UID) gen.IssuerModifier {
+		return func(iss cmapi.GenericIssuer) {
+			iss.GetObjectMeta().UID = uid
+		}
+	}
+
+	tests := map[string]struct {
+		deactivateAccountOnDelete bool
+		issuers                   []*cmapi.Issuer
+		clusterIssuers            []*cmapi.ClusterIssuer
+		kfsKey                    crypto.Signer
+		kfsErr                    error
+		deactivateErr             error
+		caBundleSecret            *corev1.Secret
+		caBundleGetErr            error
+		deletedAgo                time.Duration
+
+		expectDeactivate   bool
+		expectRemoveClient bool
+		expectErr          bool
+		expectedEvents     []string
+	}{
+		"deactivates the ACME account": {
+			deactivateAccountOnDelete: true,
+			kfsKey:                    rsaKey,
+			expectDeactivate:          true,
+			expectRemoveClient:        true,
+			expectedEvents: []string{
+				fmt.Sprintf("%s %s %s", corev1.EventTypeNormal, reasonAccountDeactivated, messageAccountDeactivated),
+			},
+		},
+		"deactivates the ACME account if other issuers use different accounts": {
+			deactivateAccountOnDelete: true,
+			issuers: []*cmapi.Issuer{
+				gen.Issuer("other", gen.SetIssuerNamespace("otherns"), setUID("other-uid"),
+					gen.SetIssuerACMEPrivKeyRef("key"), gen.SetIssuerACMEAccountURL("https://acme.example.com/acct/2")),
+			},
+			clusterIssuers: []*cmapi.ClusterIssuer{
+				gen.ClusterIssuer("other", setUID("other-cluster-uid"), gen.SetIssuerACMEPrivKeyRef("key")),
+			},
+			kfsKey:             rsaKey,
+			expectDeactivate:   true,
+			expectRemoveClient: true,
+			expectedEvents: []string{
+				fmt.Sprintf("%s %s %s", corev1.EventTypeNormal, reasonAccountDeactivated, messageAccountDeactivated),
+			},
+		},
+		"skips deactivation if another Issuer uses the same private key Secret": {
+			deactivateAccountOnDelete: true,
+			issuers: []*cmapi.Issuer{
+				gen.Issuer("other", gen.SetIssuerNamespace("testns"), setUID("other-uid"), gen.SetIssuerACMEPrivKeyRef("key")),
+			},
+			kfsKey:             rsaKey,
+			expectRemoveClient: true,
+			expectedEvents: []string{
+				fmt.Sprintf("%s %s Not deactivating ACME account as it is also used by Issuer \"other\"", corev1.EventTypeWarning, reasonAccountDeactivationSkipped),
+			},
+		},
+		"skips deactivation if a ClusterIssuer is registered with the same account URI": {
+			deactivateAccountOnDelete: true,
+			clusterIssuers: []*cmapi.ClusterIssuer{
+				gen.ClusterIssuer("other", setUID("other-uid"), gen.SetIssuerACMEPrivKeyRef("otherkey"), gen.SetIssuerACMEAccountURL(accountURI)),
+			},
+			kfsKey:             rsaKey,
+			expectRemoveClient: true,
+			expectedEvents: []string{
+				fmt.Sprintf("%s %s Not deactivating ACME account as it is also used by ClusterIssuer \"other\"", corev1.EventTypeWarning, reasonAccountDeactivationSkipped),
+			},
+		},
+		"skips deactivation if another Issuer is registered with the same account URI": {
+			deactivateAccountOnDelete: true,
+			issuers: []*cmapi.Issuer{
+				gen.Issuer("other", gen.SetIssuerNamespace("otherns"), setUID("other-uid"),
+					gen.SetIssuerACMEPrivKeyRef("otherkey"), gen.SetIssuerACMEAccountURL(accountURI)),
+			},
+			kfsKey:             rsaKey,
+			expectRemoveClient: true,
+			expectedEvents: []string{
+				fmt.Sprintf("%s %s Not deactivating ACME account as it is also used by Issuer \"other\"", corev1.EventTypeWarning, reasonAccountDeactivationSkipped),
+			},
+		},
+		"does nothing if deactivateAccountOnDelete is not set": {
+			kfsKey: rsaKey,
+		},
+		"succeeds if the account does not exist or has already been deactivated": {
+			deactivateAccountOnDelete: true,
+			kfsKey:                    rsaKey,
+			deactivateErr:             acmeapi.ErrNoAccount,
+			expectDeactivate:          true,
+			expectRemoveClient:        true,
+		},
+		"succeeds if the ACME server rejects the request": {
+			deactivateAccountOnDelete: true,
+			kfsKey:                    rsaKey,
+			deactivateErr:             unauthorizedErr,
+			expectDeactivate:          true,
+			expectRemoveClient:        true,
+			expectedEvents: []string{
+				fmt.Sprintf("%s %s Not deactivating ACME account as the ACME server rejected the request: %v", corev1.EventTypeWarning, reasonAccountDeactivationSkipped, unauthorizedErr),
+			},
+		},
+		"returns an error to retry if the ACME server fails": {
+			deactivateAccountOnDelete: true,
+			kfsKey:                    rsaKey,
+			deactivateErr:             serverErr,
+			expectDeactivate:          true,
+			expectErr:                 true,
+		},
+		"returns an error to retry if the ACME server fails shortly after the issuer was deleted": {
+			deactivateAccountOnDelete: true,
+			kfsKey:                    rsaKey,
+			deactivateErr:             serverErr,
+			deletedAgo:                maxAccountDeactivationRetryPeriod - time.Minute,
+			expectDeactivate:          true,
+			expectErr:                 true,
+		},
+		"gives up if the ACME server still fails long after the issuer was deleted": {
+			deactivateAccountOnDelete: true,
+			kfsKey:                    rsaKey,
+			deactivateErr:             serverErr,
+			deletedAgo:                maxAccountDeactivationRetryPeriod + time.Minute,
+			expectDeactivate:          true,
+			expectRemoveClient:        true,
+			expectedEvents: []string{
+				fmt.Sprintf("%s %s Not deactivating ACME account as the ACME server still fails 1h0m0s after the issuer was deleted: %v", corev1.EventTypeWarning, reasonAccountDeactivationSkipped, serverErr),
+			},
+		},
+		"deactivates the ACME account using the CA bundle Secret": {
+			deactivateAccountOnDelete: true,
+			kfsKey:                    rsaKey,
+			caBundleSecret:            caBundleSecret,
+			expectDeactivate:          true,
+			expectRemoveClient:        true,
+			expectedEvents: []string{
+				fmt.Sprintf("%s %s %s", corev1.EventTypeNormal, reasonAccountDeactivated, messageAccountDeactivated),
+			},
+		},
+		"skips deactivation if the CA bundle Secret does not exist": {
+			deactivateAccountOnDelete: true,
+			kfsKey:                    rsaKey,
+			caBundleGetErr:            apierrors.NewNotFound(corev1.Resource("secrets"), "ca"),
+			expectRemoveClient:        true,
+			expectedEvents: []string{
+				fmt.Sprintf("%s %s Not deactivating ACME account as the CA bundle Secret does not exist, it may have been deleted before the issuer", corev1.EventTypeWarning, reasonAccountDeactivationSkipped),
+			},
+		},
+		"skips deactivation if the CA bundle Secret has no CA bundle": {
+			deactivateAccountOnDelete: true,
+			kfsKey:                    rsaKey,
+			caBundleSecret:            &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "ca", Namespace: "testns"}},
+			expectRemoveClient:        true,
+			expectedEvents: []string{
+				fmt.Sprintf("%s %s Not deactivating ACME account as the CA bundle is invalid: no data for \"ca.crt\" in secret 'testns/ca'", corev1.EventTypeWarning, reasonAccountDeactivationSkipped),
+			},
+		},
+		"returns an error to retry if the CA bundle Secret cannot be read": {
+			deactivateAccountOnDelete: true,
+			kfsKey:                    rsaKey,
+			caBundleGetErr:            errors.New("some error"),
+			expectErr:                 true,
+		},
+		"skips deactivation if the account private key does not exist": {
+			deactivateAccountOnDelete: true,
+			kfsErr:                    apierrors.NewNotFound(corev1.Resource("secrets"), "key"),
+			expectedEvents: []string{
+				fmt.Sprintf("%s %s Not deactivating ACME account as the account private key Secret does not exist, it may have been deleted before the issuer", corev1.EventTypeWarning, reasonAccountDeactivationSkipped),
+			},
+		},
+		"skips deactivation if the account private key is not an RSA key": {
+			deactivateAccountOnDelete: true,
+			kfsKey:                    mustGenerateEDCSAKey(t),
+			expectedEvents: []string{
+				fmt.Sprintf("%s %s Not deactivating ACME account as the account private key is not of type RSA", corev1.EventTypeWarning, reasonAccountDeactivationSkipped),
+			},
+		},
+		"returns an error to retry if the account private key cannot be read": {
+			deactivateAccountOnDelete: true,
+			kfsErr:                    errors.New("some error"),
+			expectErr:                 true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			deactivateCalled := false
+			cl := &acmecl.FakeACME{
+				FakeDeactivateReg: func(context.Context) error {
+					deactivateCalled = true
+					return test.deactivateErr
+				},
+			}
+			removeClientCalled := false
+			ar := &fakeregistry.FakeRegistry{
+				RemoveClientFunc: func(string) {
+					removeClientCalled = true
+				},
+			}
+			kfsWasCalled := false
+			recorder := new(controllertest.FakeRecorder)
+			issuerIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+			for _, iss := range test.issuers {
+				if err := issuerIndexer.Add(iss); err != nil {
+					t.Fatal(err)
+				}
+			}
+			clusterIssuerIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			for _, iss := range test.clusterIssuers {
+				if err := clusterIssuerIndexer.Add(iss); err != nil {
+					t.Fatal(err)
+				}
+			}
+			// The listed issuers are shared with the informer cache, so they
+			// must never be modified.
+			var cached []runtime.Object
+			for _, iss := range test.issuers {
+				cached = append(cached, iss.DeepCopy())
+			}
+			for _, iss := range test.clusterIssuers {
+				cached = append(cached, iss.DeepCopy())
+			}
+			acmeIssuer := cmacme.ACMEIssuer{
+				Server:                    "https://acme.example.com",
+				PrivateKey:                cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "key"}},
+				DeactivateAccountOnDelete: test.deactivateAccountOnDelete,
+			}
+			if test.caBundleSecret != nil || test.caBundleGetErr != nil {
+				acmeIssuer.CABundleSecretRef = &cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "ca"}}
+			}
+			iss := gen.Issuer("test",
+				gen.SetIssuerNamespace("testns"),
+				setUID("test-uid"),
+				gen.SetIssuerACME(acmeIssuer),
+				gen.SetIssuerACMEAccountURL(accountURI),
+			)
+			if test.deletedAgo != 0 {
+				deleted := metav1.NewTime(now.Add(-test.deletedAgo))
+				iss.DeletionTimestamp = &deleted
+			}
+			a := Acme{
+				issuer: iss,
+				secretsClient: coreclients.NewFakeSecretsGetterFrom(
+					coreclients.NewFakeSecretsGetter(),
+					coreclients.SetFakeSecretsGetterGet(test.caBundleSecret, test.caBundleGetErr),
+				),
+				issuerLister:             cmlisters.NewIssuerLister(issuerIndexer),
+				clusterIssuerLister:      cmlisters.NewClusterIssuerLister(clusterIssuerIndexer),
+				clusterResourceNamespace: "cert-manager",
+				accountRegistry:          ar,
+				keyFromSecret:            keyFromSecretMockBuilder(&kfsWasCalled, test.kfsKey, test.kfsErr),
+				clientBuilder:            clientBuilderMock(cl),
+				recorder:                 recorder,
+				clock:                    fakeclock.NewFakeClock(now),
+			}
+
+			err := a.Finalize(context.Background())
+			if (err != nil) != test.expectErr {
+				t.Errorf("expected error %v, got %v", test.expectErr, err)
+			}
+			if deactivateCalled != test.expectDeactivate {
+				t.Errorf("expected DeactivateReg to be called: %v, was called: %v", test.expectDeactivate, deactivateCalled)
+			}
+			if removeClientCalled != test.expectRemoveClient {
+				t.Errorf("expected RemoveClient to be called: %v, was called: %v", test.expectRemoveClient, removeClientCalled)
+			}
+			if !slices.Equal(test.expectedEvents, recorder.Events) {
+				t.Errorf("expected events:\n%+#v\ngot:%+#v", test.expectedEvents, recorder.Events)
+			}
+			var listed []runtime.Object
+			for _, iss := range test.issuers {
+				listed = append(listed, iss)
+			}
+			for _, iss := range test.clusterIssuers {
+				listed = append(listed, iss)
+			}
+			for i, obj := range listed {
+				if !apiequality.Semantic.DeepEqual(cached[i], obj) {
+					t.Errorf("cached issuer was modified: %+#v", obj)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/utils/ptr"
+
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/internal/solverpod"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+// solverDeploymentConfig returns the Deployment configuration of the HTTP01
+// solver for the given challenge, or nil if the solver should be run as a
+// bare Pod.
+func solverDeploymentConfig(ch *cmacme.Challenge) *cmacme.ACMEChallengeSolverHTTP01Deployment {
+	if ch.Spec.Solver.HTTP01 == nil {
+		return nil
+	}
+	return ch.Spec.Solver.HTTP01.Deployment
+}
+
+func deploymentReplicas(cfg *cmacme.ACMEChallengeSolverHTTP01Deployment) int32 {
+	if cfg == nil || cfg.Replicas == nil {
+		return 1
+	}
+	return *cfg.Replicas
+}
+
+func (s *Solver) ensureDeployment(ctx context.Context, ch *cmacme.Challenge) error {
+	log := logf.FromContext(ctx).WithName("ensureDeployment")
+
+	log.V(logf.DebugLevel).Info("checking for existing HTTP01 solver deployments")
+	existingDeployments, err := s.getDeploymentsForChallenge(ctx, ch)
+	if err != nil {
+		return err
+	}
+	if len(existingDeployments) == 1 {
+		log := logf.WithRelatedResource(log, existingDeployments[0])
+		log.V(logf.DebugLevel).Info("found one existing HTTP01 solver deployment")
+		return s.updateDeploymentReplicas(ctx, ch, existingDeployments[0])
+	}
+	if len(existingDeployments) > 1 {
+		log.V(logf.InfoLevel).Info("multiple challenge solver deployments found for challenge. cleaning up all existing deployments.")
+		err := s.cleanupDeployments(ctx, ch)
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("multiple existing challenge solver deployments found and cleaned up. retrying challenge sync")
+	}
+
+	log.V(logf.InfoLevel).Info("creating HTTP01 challenge solver deployment")
+
+	_, err = s.createDeployment(ctx, ch)
+	return err
+}
+
+// updateDeploymentReplicas ensures an existing solver deployment runs the
+// number of replicas configured on the challenge's solver.
+func (s *Solver) updateDeploymentReplicas(ctx context.Context, ch *cmacme.Challenge, meta *metav1.PartialObjectMetadata) error {
+	log := logf.FromContext(ctx).WithName("updateDeploymentReplicas")
+
+	deployment, err := s.Client.AppsV1().Deployments(meta.Namespace).Get(ctx, meta.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	replicas := deploymentReplicas(solverDeploymentConfig(ch))
+	if deployment.Spec.Replicas != nil && *deployment.Spec.Replicas == replicas {
+		return nil
+	}
+
+	log.V(logf.DebugLevel).Info("updating HTTP01 solver deployment replicas", "replicas", replicas)
+	deployment = deployment.DeepCopy()
+	deployment.Spec.Replicas = ptr.To(replicas)
+	_, err = s.Client.AppsV1().Deployments(deployment.Namespace).Update(ctx, deployment, metav1.UpdateOptions{})
+	return err
+}
+
+// getDeploymentsForChallenge returns a list of deployments that were created
+// to solve the given challenge
+func (s *Solver) getDeploymentsForChallenge(ctx context.Context, ch *cmacme.Challenge) ([]*metav1.PartialObjectMetadata, error) {
+	return solverpod.ResourcesForChallenge(ctx, s.deploymentLister, ch, podLabels(ch))
+}
+
+func (s *Solver) cleanupDeployments(ctx context.Context, ch *cmacme.Challenge) error {
+	log := logf.FromContext(ctx, "cleanupDeployments")
+
+	// the deployment lister is only set up when the solver is built by the
+	// challenges controller.
+	if s.deploymentLister == nil {
+		return nil
+	}
+
+	deployments, err := s.getDeploymentsForChallenge(ctx, ch)
+	if err != nil {
+		return fmt.Errorf("error retrieving deployments for cleanup: %w", err)
+	}
+	var errs []error
+	for _, deployment := range deployments {
+		log := logf.WithRelatedResource(log, deployment)
+		log.V(logf.DebugLevel).Info("deleting deployment resource")
+
+		err := s.Client.AppsV1().Deployments(deployment.Namespace).Delete(ctx, deployment.Name, metav1.DeleteOptions{})
+		if err != nil {
+			log.V(logf.WarnLevel).Info("failed to delete deployment resource", "error", err)
+			errs = append(errs, fmt.Errorf("error deleting deployment: %w", err))
+			continue
+		}
+		log.V(logf.InfoLevel).Info("successfully deleted deployment resource")
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// createDeployment will create a challenge solving deployment for the given
+// certificate, domain, token and key.
+func (s *Solver) createDeployment(ctx context.Context, ch *cmacme.Challenge) (*appsv1.Deployment, error) {
+	return s.Client.AppsV1().Deployments(ch.Namespace).Create(
+		ctx,
+		s.buildDeployment(ch),
+		metav1.CreateOptions{})
+}
+
+// buildDeployment will build a challenge solving deployment for the given
+// certificate, domain, token and key. The pod template is the same pod that
+// would otherwise be created directly by buildPod. It will not create it in
+// the API server.
+func (s *Solver) buildDeployment(ch *cmacme.Challenge) *appsv1.Deployment {
+	pod := s.buildPod(ch)
+	// Deployments only support a restart policy of Always.
+	pod.Spec.RestartPolicy = corev1.RestartPolicyAlways
+
+	podLabels := podLabels(ch)
+
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName:    pod.GenerateName,
+			Namespace:       ch.Namespace,
+			Labels:          podLabels,
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(ch, challengeGvk)},
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To(deploymentReplicas(solverDeploymentConfig(ch))),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: podLabels,
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      pod.Labels,
+					Annotations: pod.Annotations,
+				},
+				Spec: pod.Spec,
+			},
+		},
+	}
+}
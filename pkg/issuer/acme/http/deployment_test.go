@@ -0,0 +1,259 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	"github.com/cert-manager/cert-manager/pkg/controller"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+)
+
+func TestEnsureDeployment(t *testing.T) {
+	type testT struct {
+		builder     *testpkg.Builder
+		chal        *cmacme.Challenge
+		expectedErr bool
+	}
+	var (
+		testNamespace = "foo"
+		deploymentGVR = appsv1.SchemeGroupVersion.WithResource("deployments")
+		chal          = &cmacme.Challenge{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testNamespace,
+			},
+			Spec: cmacme.ChallengeSpec{
+				DNSName: "example.com",
+				Token:   "token",
+				Key:     "key",
+				Solver: cmacme.ACMEChallengeSolver{
+					HTTP01: &cmacme.ACMEChallengeSolverHTTP01{
+						Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{},
+						Deployment: &cmacme.ACMEChallengeSolverHTTP01Deployment{
+							Replicas: ptr.To(int32(2)),
+						},
+					},
+				},
+			},
+		}
+		solver = &Solver{
+			Context: &controller.Context{
+				ContextOptions: controller.ContextOptions{
+					ACMEOptions: controller.ACMEOptions{
+						ACMEHTTP01SolverRunAsNonRoot: true,
+					},
+				},
+			},
+		}
+		deployment = solver.buildDeployment(chal)
+		// an existing deployment which was created with a different number
+		// of replicas than is now configured on the solver
+		existingDeployment = func(d appsv1.Deployment) *appsv1.Deployment {
+			d.Name = "existing"
+			d.Spec.Replicas = ptr.To(int32(1))
+			return &d
+		}(*deployment)
+		existingDeploymentMeta = &metav1.PartialObjectMetadata{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+			},
+			ObjectMeta: existingDeployment.ObjectMeta,
+		}
+		updatedDeployment = func(d appsv1.Deployment) *appsv1.Deployment {
+			d.Spec.Replicas = ptr.To(int32(2))
+			return &d
+		}(*existingDeployment)
+	)
+	tests := map[string]testT{
+		"should create a new deployment if one does not exist": {
+			builder: &testpkg.Builder{
+				PartialMetadataObjects: []runtime.Object{},
+				ExpectedActions:        []testpkg.Action{testpkg.NewAction(coretesting.NewCreateAction(deploymentGVR, testNamespace, deployment))},
+			},
+			chal: chal,
+		},
+		"should adopt an existing deployment and update its replicas": {
+			builder: &testpkg.Builder{
+				PartialMetadataObjects: []runtime.Object{existingDeploymentMeta},
+				KubeObjects:            []runtime.Object{existingDeployment},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewGetAction(deploymentGVR, testNamespace, "existing")),
+					testpkg.NewAction(coretesting.NewUpdateAction(deploymentGVR, testNamespace, updatedDeployment)),
+				},
+			},
+			chal: chal,
+		},
+		"should adopt an existing deployment with the expected replicas without updating it": {
+			builder: &testpkg.Builder{
+				PartialMetadataObjects: []runtime.Object{existingDeploymentMeta},
+				KubeObjects:            []runtime.Object{updatedDeployment},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewGetAction(deploymentGVR, testNamespace, "existing")),
+				},
+			},
+			chal: chal,
+		},
+		"should clean up if multiple deployments exist": {
+			builder: &testpkg.Builder{
+				PartialMetadataObjects: []runtime.Object{existingDeploymentMeta, func(d metav1.PartialObjectMetadata) *metav1.PartialObjectMetadata { d.Name = "foobar"; return &d }(*existingDeploymentMeta)},
+				KubeObjects:            []runtime.Object{existingDeployment, func(d appsv1.Deployment) *appsv1.Deployment { d.Name = "foobar"; return &d }(*existingDeployment)},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewDeleteAction(deploymentGVR, testNamespace, "foobar")),
+					testpkg.NewAction(coretesting.NewDeleteAction(deploymentGVR, testNamespace, "existing")),
+				},
+			},
+			chal:        chal,
+			expectedErr: true,
+		},
+	}
+	for name, scenario := range tests {
+		t.Run(name, func(t *testing.T) {
+			scenario.builder.T = t
+			scenario.builder.InitWithRESTConfig()
+			s := &Solver{
+				Context:          scenario.builder.Context,
+				deploymentLister: scenario.builder.HTTP01ResourceMetadataInformersFactory.ForResource(deploymentGVR).Lister(),
+			}
+			s.Context.ACMEOptions = controller.ACMEOptions{
+				ACMEHTTP01SolverRunAsNonRoot: true,
+			}
+			scenario.builder.Start()
+			defer scenario.builder.Stop()
+			err := s.ensureDeployment(context.Background(), scenario.chal)
+			if err != nil != scenario.expectedErr {
+				t.Fatalf("unexpected error: wants err: %t, got err %v", scenario.expectedErr, err)
+			}
+			scenario.builder.CheckAndFinish()
+		})
+	}
+}
+
+func TestCleanupDeployments(t *testing.T) {
+	const testNamespace = "foo"
+	deploymentGVR := appsv1.SchemeGroupVersion.WithResource("deployments")
+	chal := &cmacme.Challenge{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+		},
+		Spec: cmacme.ChallengeSpec{
+			DNSName: "example.com",
+			Token:   "token",
+			Key:     "key",
+			Solver: cmacme.ACMEChallengeSolver{
+				HTTP01: &cmacme.ACMEChallengeSolverHTTP01{
+					Ingress:    &cmacme.ACMEChallengeSolverHTTP01Ingress{},
+					Deployment: &cmacme.ACMEChallengeSolverHTTP01Deployment{},
+				},
+			},
+		},
+	}
+	deploymentMeta := &metav1.PartialObjectMetadata{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "apps/v1",
+			Kind:       "Deployment",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "solver",
+			Namespace:       testNamespace,
+			Labels:          podLabels(chal),
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(chal, challengeGvk)},
+		},
+	}
+	// a deployment with matching labels which is not owned by the challenge
+	// should be left alone
+	unownedDeploymentMeta := &metav1.PartialObjectMetadata{
+		TypeMeta: deploymentMeta.TypeMeta,
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "unowned",
+			Namespace: testNamespace,
+			Labels:    podLabels(chal),
+		},
+	}
+
+	builder := &testpkg.Builder{
+		T:                      t,
+		PartialMetadataObjects: []runtime.Object{deploymentMeta, unownedDeploymentMeta},
+		KubeObjects: []runtime.Object{
+			&appsv1.Deployment{ObjectMeta: deploymentMeta.ObjectMeta},
+			&appsv1.Deployment{ObjectMeta: unownedDeploymentMeta.ObjectMeta},
+		},
+		ExpectedActions: []testpkg.Action{
+			testpkg.NewAction(coretesting.NewDeleteAction(deploymentGVR, testNamespace, "solver")),
+		},
+	}
+	builder.InitWithRESTConfig()
+	s := &Solver{
+		Context:          builder.Context,
+		deploymentLister: builder.HTTP01ResourceMetadataInformersFactory.ForResource(deploymentGVR).Lister(),
+	}
+	builder.Start()
+	defer builder.Stop()
+
+	if err := s.cleanupDeployments(context.Background(), chal); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	builder.CheckAndFinish()
+}
+
+func TestBuildDeployment(t *testing.T) {
+	chal := &cmacme.Challenge{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "foo",
+		},
+		Spec: cmacme.ChallengeSpec{
+			DNSName: "example.com",
+			Token:   "token",
+			Key:     "key",
+			Solver: cmacme.ACMEChallengeSolver{
+				HTTP01: &cmacme.ACMEChallengeSolverHTTP01{
+					Ingress:    &cmacme.ACMEChallengeSolverHTTP01Ingress{},
+					Deployment: &cmacme.ACMEChallengeSolverHTTP01Deployment{},
+				},
+			},
+		},
+	}
+	s := &Solver{
+		Context: &controller.Context{},
+	}
+
+	deployment := s.buildDeployment(chal)
+
+	if *deployment.Spec.Replicas != 1 {
+		t.Errorf("expected replicas to default to 1, got %d", *deployment.Spec.Replicas)
+	}
+	if deployment.Spec.Template.Spec.RestartPolicy != corev1.RestartPolicyAlways {
+		t.Errorf("expected restart policy %q, got %q", corev1.RestartPolicyAlways, deployment.Spec.Template.Spec.RestartPolicy)
+	}
+	if !metav1.IsControlledBy(deployment, chal) {
+		t.Errorf("expected deployment to be controlled by the challenge")
+	}
+	for k, v := range podLabels(chal) {
+		if deployment.Spec.Selector.MatchLabels[k] != v || deployment.Spec.Template.Labels[k] != v {
+			t.Errorf("expected selector and template to carry label %s=%s", k, v)
+		}
+	}
+}
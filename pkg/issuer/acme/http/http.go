@@ -27,6 +27,7 @@ import (
 	"strings"
 	"time"
 
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	networkingv1listers "k8s.io/client-go/listers/networking/v1"
@@ -64,10 +65,11 @@ var (
 type Solver struct {
 	*controller.Context
 
-	podLister       cache.GenericLister
-	serviceLister   cache.GenericLister
-	ingressLister   networkingv1listers.IngressLister
-	httpRouteLister gwapilisters.HTTPRouteLister
+	podLister        cache.GenericLister
+	serviceLister    cache.GenericLister
+	deploymentLister cache.GenericLister
+	ingressLister    networkingv1listers.IngressLister
+	httpRouteLister  gwapilisters.HTTPRouteLister
 
 	testReachability reachabilityTest
 	requiredPasses   int
@@ -81,6 +83,7 @@ func NewSolver(ctx *controller.Context) (*Solver, error) {
 		Context:          ctx,
 		podLister:        ctx.HTTP01ResourceMetadataInformersFactory.ForResource(corev1.SchemeGroupVersion.WithResource("pods")).Lister(),
 		serviceLister:    ctx.HTTP01ResourceMetadataInformersFactory.ForResource(corev1.SchemeGroupVersion.WithResource("services")).Lister(),
+		deploymentLister: ctx.HTTP01ResourceMetadataInformersFactory.ForResource(appsv1.SchemeGroupVersion.WithResource("deployments")).Lister(),
 		ingressLister:    ctx.KubeSharedInformerFactory.Ingresses().Lister(),
 		httpRouteLister:  ctx.GWShared.Gateway().V1().HTTPRoutes().Lister(),
 		testReachability: testReachability,
@@ -113,7 +116,12 @@ func (s *Solver) Present(ctx context.Context, issuer v1.GenericIssuer, ch *cmacm
 	log := logf.FromContext(ctx).WithName(loggerName)
 	ctx = logf.NewContext(ctx, log)
 
-	podErr := s.ensurePod(ctx, ch)
+	var podErr error
+	if solverDeploymentConfig(ch) != nil {
+		podErr = s.ensureDeployment(ctx, ch)
+	} else {
+		podErr = s.ensurePod(ctx, ch)
+	}
 	svcName, svcErr := s.ensureService(ctx, ch)
 	if svcErr != nil {
 		return utilerrors.NewAggregate([]error{podErr, svcErr})
@@ -183,13 +191,15 @@ func (s *Solver) Check(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.
 	return nil
 }
 
-// CleanUp will ensure the created service, ingress and pod are clean/deleted of any
-// cert-manager created data.
+// CleanUp will ensure the created service, ingress, pod and deployment are
+// clean/deleted of any cert-manager created data.
 func (s *Solver) CleanUp(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.Challenge) error {
 	var errs []error
 	errs = append(errs, s.cleanupPods(ctx, ch))
+	errs = append(errs, s.cleanupDeployments(ctx, ch))
 	errs = append(errs, s.cleanupServices(ctx, ch))
 	errs = append(errs, s.cleanupIngresses(ctx, ch))
+	errs = append(errs, s.cleanupGatewayHTTPRoutes(ctx, ch))
 	return utilerrors.NewAggregate(errs)
 }
 
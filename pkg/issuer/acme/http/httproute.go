@@ -23,6 +23,7 @@ import (
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/client-go/util/retry"
 	"k8s.io/utils/ptr"
 	gwapi "sigs.k8s.io/gateway-api/apis/v1"
@@ -117,7 +118,7 @@ func (s *Solver) checkAndUpdateGatewayHTTPRoute(ctx context.Context, ch *cmacme.
 	for k, v := range ch.Spec.Solver.HTTP01.GatewayHTTPRoute.Labels {
 		expectedLabels[k] = v
 	}
-	actualLabels := ch.Labels
+	actualLabels := httpRoute.Labels
 	if reflect.DeepEqual(expectedSpec, actualSpec) && reflect.DeepEqual(expectedLabels, actualLabels) {
 		return httpRoute, nil
 	}
@@ -143,6 +144,39 @@ func (s *Solver) checkAndUpdateGatewayHTTPRoute(ctx context.Context, ch *cmacme.
 	return ret, nil
 }
 
+// cleanupGatewayHTTPRoutes deletes the HTTPRoutes that were created to solve
+// the given challenge.
+func (s *Solver) cleanupGatewayHTTPRoutes(ctx context.Context, ch *cmacme.Challenge) error {
+	log := logf.FromContext(ctx, "cleanupGatewayHTTPRoutes")
+
+	if ch.Spec.Solver.HTTP01.GatewayHTTPRoute == nil {
+		return nil
+	}
+
+	httpRoutes, err := s.httpRouteLister.HTTPRoutes(ch.Namespace).List(labels.Set(podLabels(ch)).AsSelector())
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, httpRoute := range httpRoutes {
+		log := logf.WithRelatedResource(log, httpRoute)
+		if !metav1.IsControlledBy(httpRoute, ch) {
+			log.V(logf.DebugLevel).Info("skipping HTTPRoute which is not owned by the challenge")
+			continue
+		}
+
+		log.V(logf.DebugLevel).Info("deleting HTTPRoute resource")
+		err := s.GWClient.GatewayV1().HTTPRoutes(httpRoute.Namespace).Delete(ctx, httpRoute.Name, metav1.DeleteOptions{})
+		if err != nil {
+			log.V(logf.WarnLevel).Info("failed to delete HTTPRoute resource", "error", err)
+			errs = append(errs, err)
+			continue
+		}
+		log.V(logf.DebugLevel).Info("successfully deleted HTTPRoute resource")
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
 func generateHTTPRouteSpec(ch *cmacme.Challenge, svcName string) gwapi.HTTPRouteSpec {
 	return gwapi.HTTPRouteSpec{
 		CommonRouteSpec: gwapi.CommonRouteSpec{
@@ -0,0 +1,194 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/ptr"
+	gwapi "sigs.k8s.io/gateway-api/apis/v1"
+
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+)
+
+func gatewayHTTPRouteChallenge(parentRefs ...gwapi.ParentReference) *cmacme.Challenge {
+	return &cmacme.Challenge{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: defaultTestNamespace,
+			UID:       "challenge-uid",
+		},
+		Spec: cmacme.ChallengeSpec{
+			DNSName: "example.com",
+			Token:   "token",
+			Solver: cmacme.ACMEChallengeSolver{
+				HTTP01: &cmacme.ACMEChallengeSolverHTTP01{
+					GatewayHTTPRoute: &cmacme.ACMEChallengeSolverHTTP01GatewayHTTPRoute{
+						Labels:     map[string]string{"gateway": "public"},
+						ParentRefs: parentRefs,
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestGenerateHTTPRouteSpec(t *testing.T) {
+	parentRef := gwapi.ParentReference{
+		Name:        "public",
+		Namespace:   ptr.To(gwapi.Namespace("gateways")),
+		SectionName: ptr.To(gwapi.SectionName("http")),
+	}
+	ch := gatewayHTTPRouteChallenge(parentRef)
+
+	spec := generateHTTPRouteSpec(ch, "fakeservice")
+
+	assert.Equal(t, []gwapi.ParentReference{parentRef}, spec.ParentRefs)
+	assert.Equal(t, []gwapi.Hostname{"example.com"}, spec.Hostnames)
+	require.Len(t, spec.Rules, 1)
+	require.Len(t, spec.Rules[0].Matches, 1)
+	assert.Equal(t, &gwapi.HTTPPathMatch{
+		Type:  ptr.To(gwapi.PathMatchExact),
+		Value: ptr.To("/.well-known/acme-challenge/token"),
+	}, spec.Rules[0].Matches[0].Path)
+	require.Len(t, spec.Rules[0].BackendRefs, 1)
+	assert.Equal(t, gwapi.BackendObjectReference{
+		Kind:      ptr.To(gwapi.Kind("Service")),
+		Name:      "fakeservice",
+		Namespace: ptr.To(gwapi.Namespace(defaultTestNamespace)),
+		Port:      ptr.To(gwapi.PortNumber(acmeSolverListenPort)),
+	}, spec.Rules[0].BackendRefs[0].BackendObjectReference)
+}
+
+func TestEnsureGatewayHTTPRoute(t *testing.T) {
+	parentRef := gwapi.ParentReference{Name: "public"}
+
+	tests := map[string]solverFixture{
+		"should create an HTTPRoute attached to the configured parent": {
+			Challenge: gatewayHTTPRouteChallenge(parentRef),
+			CheckFn: func(t *testing.T, s *solverFixture, args ...interface{}) {
+				httpRoutes, err := s.Solver.httpRouteLister.List(labels.Everything())
+				require.NoError(t, err)
+				require.Len(t, httpRoutes, 1)
+
+				httpRoute := httpRoutes[0]
+				assert.Equal(t, "public", httpRoute.Labels["gateway"])
+				assert.True(t, metav1.IsControlledBy(httpRoute, s.Challenge))
+				assert.Equal(t, generateHTTPRouteSpec(s.Challenge, "fakeservice"), httpRoute.Spec)
+			},
+		},
+		"should not update an HTTPRoute which is up to date": {
+			Challenge: gatewayHTTPRouteChallenge(parentRef),
+			PreFn: func(t *testing.T, s *solverFixture) {
+				if _, err := s.Solver.createGatewayHTTPRoute(context.TODO(), s.Challenge, "fakeservice"); err != nil {
+					t.Errorf("error preparing test: %v", err)
+				}
+				s.Builder.Sync()
+				s.Builder.FakeGWClient().ClearActions()
+			},
+			CheckFn: func(t *testing.T, s *solverFixture, args ...interface{}) {
+				for _, action := range s.Builder.FakeGWClient().Actions() {
+					assert.NotEqual(t, "update", action.GetVerb(), "expected HTTPRoute not to be updated")
+				}
+			},
+		},
+		"should update an HTTPRoute whose parentRefs are out of date": {
+			Challenge: gatewayHTTPRouteChallenge(parentRef),
+			PreFn: func(t *testing.T, s *solverFixture) {
+				oldChallenge := gatewayHTTPRouteChallenge(gwapi.ParentReference{Name: "internal"})
+				if _, err := s.Solver.createGatewayHTTPRoute(context.TODO(), oldChallenge, "fakeservice"); err != nil {
+					t.Errorf("error preparing test: %v", err)
+				}
+			},
+			CheckFn: func(t *testing.T, s *solverFixture, args ...interface{}) {
+				httpRoutes, err := s.Solver.httpRouteLister.List(labels.Everything())
+				require.NoError(t, err)
+				require.Len(t, httpRoutes, 1)
+				assert.Equal(t, []gwapi.ParentReference{parentRef}, httpRoutes[0].Spec.ParentRefs)
+			},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.Setup(t)
+			resp, err := test.Solver.ensureGatewayHTTPRoute(context.TODO(), test.Challenge, "fakeservice")
+			if err != nil && !test.Err {
+				t.Errorf("Expected function to not error, but got: %v", err)
+			}
+			if err == nil && test.Err {
+				t.Errorf("Expected function to get an error, but got: %v", err)
+			}
+			test.Finish(t, resp, err)
+		})
+	}
+}
+
+func TestCleanupGatewayHTTPRoutes(t *testing.T) {
+	tests := map[string]solverFixture{
+		"should delete the HTTPRoute created for the challenge": {
+			Challenge: gatewayHTTPRouteChallenge(gwapi.ParentReference{Name: "public"}),
+			PreFn: func(t *testing.T, s *solverFixture) {
+				if _, err := s.Solver.createGatewayHTTPRoute(context.TODO(), s.Challenge, "fakeservice"); err != nil {
+					t.Errorf("error preparing test: %v", err)
+				}
+			},
+			CheckFn: func(t *testing.T, s *solverFixture, args ...interface{}) {
+				httpRoutes, err := s.Solver.httpRouteLister.List(labels.Everything())
+				require.NoError(t, err)
+				assert.Empty(t, httpRoutes)
+			},
+		},
+		"should not delete HTTPRoutes which are not owned by the challenge": {
+			Challenge: gatewayHTTPRouteChallenge(gwapi.ParentReference{Name: "public"}),
+			PreFn: func(t *testing.T, s *solverFixture) {
+				httpRoute := &gwapi.HTTPRoute{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "unowned",
+						Namespace: defaultTestNamespace,
+						Labels:    podLabels(s.Challenge),
+					},
+				}
+				if _, err := s.GWClient.GatewayV1().HTTPRoutes(defaultTestNamespace).Create(context.TODO(), httpRoute, metav1.CreateOptions{}); err != nil {
+					t.Errorf("error preparing test: %v", err)
+				}
+			},
+			CheckFn: func(t *testing.T, s *solverFixture, args ...interface{}) {
+				httpRoutes, err := s.Solver.httpRouteLister.List(labels.Everything())
+				require.NoError(t, err)
+				assert.Len(t, httpRoutes, 1)
+			},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.Setup(t)
+			err := test.Solver.cleanupGatewayHTTPRoutes(context.TODO(), test.Challenge)
+			if err != nil && !test.Err {
+				t.Errorf("Expected function to not error, but got: %v", err)
+			}
+			if err == nil && test.Err {
+				t.Errorf("Expected function to get an error, but got: %v", err)
+			}
+			test.Finish(t, err)
+		})
+	}
+}
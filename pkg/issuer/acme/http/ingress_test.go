@@ -497,6 +497,40 @@ func TestEnsureIngress(t *testing.T) {
 				assert.Equal(t, strPtr("nginx"), ingress.Spec.IngressClassName)
 			}),
 		},
+		"ingressClassName and ingress template annotations of the solver are both passed to the ingress": {
+			Challenge: &cmacme.Challenge{Spec: cmacme.ChallengeSpec{Solver: cmacme.ACMEChallengeSolver{HTTP01: &cmacme.ACMEChallengeSolverHTTP01{
+				Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{
+					IngressClassName: strPtr("haproxy"),
+					IngressTemplate: &cmacme.ACMEChallengeSolverHTTP01IngressTemplate{
+						ACMEChallengeSolverHTTP01IngressObjectMeta: cmacme.ACMEChallengeSolverHTTP01IngressObjectMeta{
+							Annotations: map[string]string{"haproxy.org/ssl-redirect": "false"},
+						},
+					},
+				}}}},
+			},
+			CheckFn: checkOneIngress(func(t *testing.T, ingress *networkingv1.Ingress) {
+				assert.Equal(t, strPtr("haproxy"), ingress.Spec.IngressClassName)
+				assert.Equal(t, "false", ingress.Annotations["haproxy.org/ssl-redirect"])
+				assert.Empty(t, ingress.Annotations["kubernetes.io/ingress.class"])
+			}),
+		},
+		"class and ingress template annotations of the solver are both passed to the ingress": {
+			Challenge: &cmacme.Challenge{Spec: cmacme.ChallengeSpec{Solver: cmacme.ACMEChallengeSolver{HTTP01: &cmacme.ACMEChallengeSolverHTTP01{
+				Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{
+					Class: strPtr("traefik"),
+					IngressTemplate: &cmacme.ACMEChallengeSolverHTTP01IngressTemplate{
+						ACMEChallengeSolverHTTP01IngressObjectMeta: cmacme.ACMEChallengeSolverHTTP01IngressObjectMeta{
+							Annotations: map[string]string{"traefik.ingress.kubernetes.io/router.entrypoints": "web"},
+						},
+					},
+				}}}},
+			},
+			CheckFn: checkOneIngress(func(t *testing.T, ingress *networkingv1.Ingress) {
+				assert.Equal(t, "traefik", ingress.Annotations["kubernetes.io/ingress.class"])
+				assert.Equal(t, "web", ingress.Annotations["traefik.ingress.kubernetes.io/router.entrypoints"])
+				assert.Empty(t, ingress.Spec.IngressClassName)
+			}),
+		},
 	}
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
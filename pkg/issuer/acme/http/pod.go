@@ -23,12 +23,11 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/labels"
-	"k8s.io/apimachinery/pkg/selection"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/utils/ptr"
 
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/internal/solverpod"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 )
 
@@ -77,38 +76,7 @@ func (s *Solver) ensurePod(ctx context.Context, ch *cmacme.Challenge) error {
 // getPodsForChallenge returns a list of pods that were created to solve
 // the given challenge
 func (s *Solver) getPodsForChallenge(ctx context.Context, ch *cmacme.Challenge) ([]*metav1.PartialObjectMetadata, error) {
-	log := logf.FromContext(ctx)
-
-	podLabels := podLabels(ch)
-	orderSelector := labels.NewSelector()
-	for key, val := range podLabels {
-		req, err := labels.NewRequirement(key, selection.Equals, []string{val})
-		if err != nil {
-			return nil, err
-		}
-		orderSelector = orderSelector.Add(*req)
-	}
-
-	podMetadataList, err := s.podLister.ByNamespace(ch.Namespace).List(orderSelector)
-	if err != nil {
-		return nil, err
-	}
-
-	var relevantPods []*metav1.PartialObjectMetadata
-	for _, pod := range podMetadataList {
-		p, ok := pod.(*metav1.PartialObjectMetadata)
-		if !ok {
-			return nil, fmt.Errorf("internal error: cannot cast PartialMetadata: %+#v", pod)
-		}
-		if !metav1.IsControlledBy(p, ch) {
-			logf.WithRelatedResource(log, p).Info("found existing solver pod for this challenge resource, however " +
-				"it does not have an appropriate OwnerReference referencing this challenge. Skipping it altogether.")
-			continue
-		}
-		relevantPods = append(relevantPods, p)
-	}
-
-	return relevantPods, nil
+	return solverpod.ResourcesForChallenge(ctx, s.podLister, ch, podLabels(ch))
 }
 
 func (s *Solver) cleanupPods(ctx context.Context, ch *cmacme.Challenge) error {
@@ -120,8 +88,8 @@ func (s *Solver) cleanupPods(ctx context.Context, ch *cmacme.Challenge) error {
 	}
 	var errs []error
 	for _, pod := range pods {
-		log := logf.WithRelatedResource(log, pod).V(logf.DebugLevel)
-		log.V(logf.InfoLevel).Info("deleting pod resource")
+		log := logf.WithRelatedResource(log, pod)
+		log.V(logf.DebugLevel).Info("deleting pod resource")
 
 		err := s.Client.CoreV1().Pods(pod.Namespace).Delete(ctx, pod.Name, metav1.DeleteOptions{})
 		if err != nil {
@@ -152,7 +120,7 @@ func (s *Solver) buildPod(ch *cmacme.Challenge) *corev1.Pod {
 	// Override defaults if they have changed in the pod template.
 	if ch.Spec.Solver.HTTP01 != nil {
 		if ch.Spec.Solver.HTTP01.Ingress != nil {
-			pod = s.mergePodObjectMetaWithPodTemplate(pod,
+			pod = solverpod.MergePodTemplate(pod,
 				ch.Spec.Solver.HTTP01.Ingress.PodTemplate)
 		}
 	}
@@ -191,12 +159,7 @@ func (s *Solver) buildDefaultPod(ch *cmacme.Challenge) *corev1.Pod {
 			},
 			RestartPolicy:      corev1.RestartPolicyOnFailure,
 			EnableServiceLinks: ptr.To(false),
-			SecurityContext: &corev1.PodSecurityContext{
-				RunAsNonRoot: ptr.To(s.ACMEOptions.ACMEHTTP01SolverRunAsNonRoot),
-				SeccompProfile: &corev1.SeccompProfile{
-					Type: corev1.SeccompProfileTypeRuntimeDefault,
-				},
-			},
+			SecurityContext:    solverpod.PodSecurityContext(s.ACMEOptions),
 			Containers: []corev1.Container{
 				{
 					Name:            "acmesolver",
@@ -225,72 +188,9 @@ func (s *Solver) buildDefaultPod(ch *cmacme.Challenge) *corev1.Pod {
 							ContainerPort: acmeSolverListenPort,
 						},
 					},
-					SecurityContext: &corev1.SecurityContext{
-						ReadOnlyRootFilesystem:   ptr.To(true),
-						AllowPrivilegeEscalation: ptr.To(false),
-						Capabilities: &corev1.Capabilities{
-							Drop: []corev1.Capability{"ALL"},
-						},
-					},
+					SecurityContext: solverpod.SecurityContext(s.ACMEOptions),
 				},
 			},
 		},
 	}
 }
-
-// Merge object meta from the pod template. Fall back to default values.
-func (s *Solver) mergePodObjectMetaWithPodTemplate(pod *corev1.Pod, podTempl *cmacme.ACMEChallengeSolverHTTP01IngressPodTemplate) *corev1.Pod {
-	if podTempl == nil {
-		return pod
-	}
-
-	if pod.Labels == nil {
-		pod.Labels = make(map[string]string)
-	}
-
-	for k, v := range podTempl.Labels {
-		pod.Labels[k] = v
-	}
-
-	if pod.Annotations == nil {
-		pod.Annotations = make(map[string]string)
-	}
-
-	for k, v := range podTempl.Annotations {
-		pod.Annotations[k] = v
-	}
-
-	if pod.Spec.NodeSelector == nil {
-		pod.Spec.NodeSelector = make(map[string]string)
-	}
-
-	for k, v := range podTempl.Spec.NodeSelector {
-		pod.Spec.NodeSelector[k] = v
-	}
-
-	if pod.Spec.Tolerations == nil {
-		pod.Spec.Tolerations = []corev1.Toleration{}
-	}
-
-	pod.Spec.Tolerations = append(pod.Spec.Tolerations, podTempl.Spec.Tolerations...)
-
-	if podTempl.Spec.Affinity != nil {
-		pod.Spec.Affinity = podTempl.Spec.Affinity
-	}
-
-	if podTempl.Spec.PriorityClassName != "" {
-		pod.Spec.PriorityClassName = podTempl.Spec.PriorityClassName
-	}
-
-	if podTempl.Spec.ServiceAccountName != "" {
-		pod.Spec.ServiceAccountName = podTempl.Spec.ServiceAccountName
-	}
-
-	if pod.Spec.ImagePullSecrets == nil {
-		pod.Spec.ImagePullSecrets = []corev1.LocalObjectReference{}
-	}
-
-	pod.Spec.ImagePullSecrets = append(pod.Spec.ImagePullSecrets, podTempl.Spec.ImagePullSecrets...)
-
-	return pod
-}
@@ -425,3 +425,98 @@ func TestMergePodObjectMetaWithPodTemplate(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildDefaultPodSecurityContext(t *testing.T) {
+	ch := &cmacme.Challenge{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+		Spec: cmacme.ChallengeSpec{
+			DNSName: "example.com",
+			Token:   "token",
+			Key:     "key",
+		},
+	}
+
+	tests := map[string]struct {
+		acmeOptions   controller.ACMEOptions
+		expPodContext *corev1.PodSecurityContext
+		expContext    *corev1.SecurityContext
+	}{
+		"if no security contexts are configured, use the restricted defaults": {
+			acmeOptions: controller.ACMEOptions{
+				ACMEHTTP01SolverRunAsNonRoot: true,
+			},
+			expPodContext: &corev1.PodSecurityContext{
+				RunAsNonRoot: ptr.To(true),
+				SeccompProfile: &corev1.SeccompProfile{
+					Type: corev1.SeccompProfileTypeRuntimeDefault,
+				},
+			},
+			expContext: &corev1.SecurityContext{
+				ReadOnlyRootFilesystem:   ptr.To(true),
+				AllowPrivilegeEscalation: ptr.To(false),
+				Capabilities: &corev1.Capabilities{
+					Drop: []corev1.Capability{"ALL"},
+				},
+			},
+		},
+		"if security contexts are configured, they should replace the defaults": {
+			acmeOptions: controller.ACMEOptions{
+				ACMEHTTP01SolverRunAsNonRoot: true,
+				HTTP01SolverPodSecurityContext: &corev1.PodSecurityContext{
+					RunAsNonRoot: ptr.To(true),
+					RunAsUser:    ptr.To(int64(1000)),
+					FSGroup:      ptr.To(int64(2000)),
+					SeccompProfile: &corev1.SeccompProfile{
+						Type:             corev1.SeccompProfileTypeLocalhost,
+						LocalhostProfile: ptr.To("profiles/solver.json"),
+					},
+				},
+				HTTP01SolverSecurityContext: &corev1.SecurityContext{
+					RunAsNonRoot:             ptr.To(true),
+					ReadOnlyRootFilesystem:   ptr.To(true),
+					AllowPrivilegeEscalation: ptr.To(false),
+					Capabilities: &corev1.Capabilities{
+						Drop: []corev1.Capability{"ALL"},
+						Add:  []corev1.Capability{"NET_BIND_SERVICE"},
+					},
+				},
+			},
+			expPodContext: &corev1.PodSecurityContext{
+				RunAsNonRoot: ptr.To(true),
+				RunAsUser:    ptr.To(int64(1000)),
+				FSGroup:      ptr.To(int64(2000)),
+				SeccompProfile: &corev1.SeccompProfile{
+					Type:             corev1.SeccompProfileTypeLocalhost,
+					LocalhostProfile: ptr.To("profiles/solver.json"),
+				},
+			},
+			expContext: &corev1.SecurityContext{
+				RunAsNonRoot:             ptr.To(true),
+				ReadOnlyRootFilesystem:   ptr.To(true),
+				AllowPrivilegeEscalation: ptr.To(false),
+				Capabilities: &corev1.Capabilities{
+					Drop: []corev1.Capability{"ALL"},
+					Add:  []corev1.Capability{"NET_BIND_SERVICE"},
+				},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := &Solver{
+				Context: &controller.Context{
+					ContextOptions: controller.ContextOptions{
+						ACMEOptions: test.acmeOptions,
+					},
+				},
+			}
+
+			pod := s.buildDefaultPod(ch)
+
+			assert.Equal(t, test.expPodContext, pod.Spec.SecurityContext)
+			assert.Len(t, pod.Spec.Containers, 1)
+			assert.Equal(t, test.expContext, pod.Spec.Containers[0].SecurityContext)
+		})
+	}
+}
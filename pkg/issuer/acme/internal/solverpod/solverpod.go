@@ -0,0 +1,163 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package solverpod contains the parts of the challenge solver pods and their
+// supporting resources which are shared by the HTTP01 and TLSALPN01 solvers.
+package solverpod
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	"github.com/cert-manager/cert-manager/pkg/controller"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+// ResourcesForChallenge returns the metadata of the resources in the given
+// lister which have the given labels and are controlled by the challenge.
+func ResourcesForChallenge(ctx context.Context, lister cache.GenericLister, ch *cmacme.Challenge, resourceLabels map[string]string) ([]*metav1.PartialObjectMetadata, error) {
+	log := logf.FromContext(ctx)
+
+	selector := labels.NewSelector()
+	for key, val := range resourceLabels {
+		req, err := labels.NewRequirement(key, selection.Equals, []string{val})
+		if err != nil {
+			return nil, err
+		}
+		selector = selector.Add(*req)
+	}
+
+	objs, err := lister.ByNamespace(ch.Namespace).List(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	var relevant []*metav1.PartialObjectMetadata
+	for _, obj := range objs {
+		m, ok := obj.(*metav1.PartialObjectMetadata)
+		if !ok {
+			return nil, fmt.Errorf("internal error: cannot cast PartialMetadata: %+#v", obj)
+		}
+		if !metav1.IsControlledBy(m, ch) {
+			// Pods run by a solver Deployment are controlled by its
+			// ReplicaSet and are seen here on every sync, so this is only
+			// logged at debug level.
+			logf.WithRelatedResource(log, m).V(logf.DebugLevel).Info("found existing solver resource for this challenge resource, however " +
+				"it does not have an appropriate OwnerReference referencing this challenge. Skipping it altogether.")
+			continue
+		}
+		relevant = append(relevant, m)
+	}
+
+	return relevant, nil
+}
+
+// PodSecurityContext returns the pod security context configured for solver
+// pods, falling back to a profile suitable for the "restricted" Pod Security
+// Standard.
+func PodSecurityContext(opts controller.ACMEOptions) *corev1.PodSecurityContext {
+	if opts.HTTP01SolverPodSecurityContext != nil {
+		return opts.HTTP01SolverPodSecurityContext.DeepCopy()
+	}
+	return &corev1.PodSecurityContext{
+		RunAsNonRoot: ptr.To(opts.ACMEHTTP01SolverRunAsNonRoot),
+		SeccompProfile: &corev1.SeccompProfile{
+			Type: corev1.SeccompProfileTypeRuntimeDefault,
+		},
+	}
+}
+
+// SecurityContext returns the container security context configured for the
+// solver container, falling back to a profile suitable for the "restricted"
+// Pod Security Standard.
+func SecurityContext(opts controller.ACMEOptions) *corev1.SecurityContext {
+	if opts.HTTP01SolverSecurityContext != nil {
+		return opts.HTTP01SolverSecurityContext.DeepCopy()
+	}
+	return &corev1.SecurityContext{
+		ReadOnlyRootFilesystem:   ptr.To(true),
+		AllowPrivilegeEscalation: ptr.To(false),
+		Capabilities: &corev1.Capabilities{
+			Drop: []corev1.Capability{"ALL"},
+		},
+	}
+}
+
+// MergePodTemplate merges the supported fields of the pod template into the
+// pod. Fields which are not set in the template keep their default values.
+func MergePodTemplate(pod *corev1.Pod, podTempl *cmacme.ACMEChallengeSolverHTTP01IngressPodTemplate) *corev1.Pod {
+	if podTempl == nil {
+		return pod
+	}
+
+	if pod.Labels == nil {
+		pod.Labels = make(map[string]string)
+	}
+
+	for k, v := range podTempl.Labels {
+		pod.Labels[k] = v
+	}
+
+	if pod.Annotations == nil {
+		pod.Annotations = make(map[string]string)
+	}
+
+	for k, v := range podTempl.Annotations {
+		pod.Annotations[k] = v
+	}
+
+	if pod.Spec.NodeSelector == nil {
+		pod.Spec.NodeSelector = make(map[string]string)
+	}
+
+	for k, v := range podTempl.Spec.NodeSelector {
+		pod.Spec.NodeSelector[k] = v
+	}
+
+	if pod.Spec.Tolerations == nil {
+		pod.Spec.Tolerations = []corev1.Toleration{}
+	}
+
+	pod.Spec.Tolerations = append(pod.Spec.Tolerations, podTempl.Spec.Tolerations...)
+
+	if podTempl.Spec.Affinity != nil {
+		pod.Spec.Affinity = podTempl.Spec.Affinity
+	}
+
+	if podTempl.Spec.PriorityClassName != "" {
+		pod.Spec.PriorityClassName = podTempl.Spec.PriorityClassName
+	}
+
+	if podTempl.Spec.ServiceAccountName != "" {
+		pod.Spec.ServiceAccountName = podTempl.Spec.ServiceAccountName
+	}
+
+	if pod.Spec.ImagePullSecrets == nil {
+		pod.Spec.ImagePullSecrets = []corev1.LocalObjectReference{}
+	}
+
+	pod.Spec.ImagePullSecrets = append(pod.Spec.ImagePullSecrets, podTempl.Spec.ImagePullSecrets...)
+
+	return pod
+}
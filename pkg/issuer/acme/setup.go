@@ -18,10 +18,12 @@ package acme
 
 import (
 	"context"
+	"crypto/hmac"
 	"crypto/rsa"
 	"crypto/sha256"
 	"crypto/x509"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"strings"
@@ -30,6 +32,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/cert-manager/cert-manager/pkg/acme"
 	"github.com/cert-manager/cert-manager/pkg/acme/accounts"
@@ -51,20 +54,28 @@ const (
 
 	successAccountRegistered = "ACMEAccountRegistered"
 	successAccountVerified   = "ACMEAccountVerified"
+	successEABKeyChanged     = "ACMEExternalAccountBindingKeyChanged"
+	warningEABKeyNotRebound  = "ACMEExternalAccountBindingKeyNotRebound"
 
 	messageAccountRegistrationFailed     = "Failed to register ACME account: "
 	messageAccountVerificationFailed     = "Failed to verify ACME account: "
 	messageAccountUpdateFailed           = "Failed to update ACME account:"
 	messageAccountRegistered             = "The ACME account was registered with the ACME server"
 	messageAccountVerified               = "The ACME account was verified with the ACME server"
+	messageEABKeyChanged                 = "The External Account Binding key changed and a new ACME account bound to it was registered with the ACME server"
+	messageEABKeyNotRebound              = "The External Account Binding key changed, but the ACME account is still bound to the previous key as 'disableAccountKeyGeneration' is set to true. Replace the account private key Secret to register a new account with the new key"
+	messageFailedToStoreAccountKey       = "Failed to store the new ACME account private key: "
 	messageNoSecretKeyGenerationDisabled = "the ACME issuer config has 'disableAccountKeyGeneration' set to true, but the secret was not found: "
 	messageInvalidPrivateKey             = "Account private key is invalid: "
+	messageAccountPreRegistered          = "The pre-registered ACME account was verified with the ACME server"
 
 	messageTemplateUpdateToV2              = "Your ACME server URL is set to a v1 endpoint (%s). You should update the spec.acme.server field to %q"
 	messageTemplateNotRSA                  = "ACME private key in %q is not of type RSA"
 	messageTemplateFailedToParseURL        = "Failed to parse existing ACME server URI %q: %v"
 	messageTemplateFailedToParseAccountURL = "Failed to parse existing ACME account URI %q: %v"
 	messageTemplateFailedToGetEABKey       = "failed to get External Account Binding key from secret: %v"
+	messageTemplateFailedToGetCABundle     = "failed to get CA bundle from secret: %w"
+	messageTemplateAccountURIMismatch      = "the account private key belongs to ACME account %q, not to the configured account URI %q"
 )
 
 // Setup will verify an existing ACME registration, or create one if not
@@ -160,9 +171,22 @@ func (a *Acme) Setup(ctx context.Context) error {
 	// this function.
 	a.accountRegistry.RemoveClient(string(a.issuer.GetUID()))
 
-	httpClient := accounts.BuildHTTPClientWithCABundle(a.metrics, a.issuer.GetSpec().ACME.SkipTLSVerify, a.issuer.GetSpec().ACME.CABundle)
+	// Resolve the CA bundle referenced by the issuer, so that the same
+	// bundle is used to build the HTTP client and to key the cached client.
+	acmeConfig := *a.issuer.GetSpec().ACME
+	if acmeConfig.CABundleSecretRef != nil {
+		caBundle, err := a.getCABundle(ctx, ns)
+		if err != nil {
+			reason = errorAccountVerificationFailed
+			msg = messageAccountVerificationFailed + err.Error()
+			return err
+		}
+		acmeConfig.CABundle = caBundle
+	}
+
+	httpClient := accounts.BuildHTTPClientForIssuer(a.metrics, acmeConfig)
 
-	cl := a.clientBuilder(httpClient, *a.issuer.GetSpec().ACME, rsaPk, a.userAgent)
+	cl := a.clientBuilder(httpClient, acmeConfig, rsaPk, a.userAgent)
 
 	// TODO: perform a complex check to determine whether we need to verify
 	// the existing registration with the ACME server.
@@ -191,6 +215,37 @@ func (a *Acme) Setup(ctx context.Context) error {
 		// absorb errors as retrying will not help resolve this error
 		return nil
 	}
+	// Read the External Account Binding key, if one is configured, so that a
+	// rotated key can be detected before deciding whether the existing
+	// registration may be reused. Errors are only surfaced if the account
+	// needs to be registered.
+	var eabAccount *acmeapi.ExternalAccountBinding
+	var eabErr error
+	if eabObj := a.issuer.GetSpec().ACME.ExternalAccountBinding; eabObj != nil {
+		var eabKey []byte
+		eabKey, eabErr = a.getEABKey(ctx, ns)
+		if eabErr == nil {
+			eabAccount = &acmeapi.ExternalAccountBinding{
+				KID: eabObj.KeyID,
+				Key: eabKey,
+			}
+		}
+	}
+
+	// The External Account Binding key has been rotated if it differs from
+	// the key that the account was last registered with. Issuers registered
+	// before the key hash was recorded have no previous key to compare with.
+	// The hash is keyed with the account key, so a replaced account key is
+	// not a rotation: a new account is registered with it anyway.
+	// Pre-registered accounts are never registered by cert-manager, so the
+	// External Account Binding key is not used for them.
+	lastEABKeyHash := a.issuer.GetStatus().ACMEStatus().LastEABKeyHash
+	eabKeyRotated := eabAccount != nil &&
+		isPKChecksumSame &&
+		a.issuer.GetSpec().ACME.AccountURI == "" &&
+		lastEABKeyHash != "" &&
+		lastEABKeyHash != eabKeyHash(eabAccount, rsaPk)
+
 	hasReadyCondition := apiutil.IssuerHasCondition(a.issuer, v1.IssuerCondition{
 		Type:   v1.IssuerConditionReady,
 		Status: cmmeta.ConditionTrue,
@@ -204,7 +259,9 @@ func (a *Acme) Setup(ctx context.Context) error {
 		a.issuer.GetStatus().ACMEStatus().URI != "" &&
 		parsedAccountURL.Host == parsedServerURL.Host &&
 		a.issuer.GetStatus().ACMEStatus().LastRegisteredEmail == a.issuer.GetSpec().ACME.Email &&
-		isPKChecksumSame {
+		(a.issuer.GetSpec().ACME.AccountURI == "" || a.issuer.GetSpec().ACME.AccountURI == a.issuer.GetStatus().ACMEStatus().URI) &&
+		isPKChecksumSame &&
+		!eabKeyRotated {
 		log.V(logf.InfoLevel).Info("skipping re-verifying ACME account as cached registration " +
 			"details look sufficient")
 
@@ -216,8 +273,14 @@ func (a *Acme) Setup(ctx context.Context) error {
 		msg = messageAccountRegistered
 		status = cmmeta.ConditionTrue
 
+		// record the External Account Binding key of accounts registered
+		// before the key hash was tracked, so that later rotations are detected
+		if eabAccount != nil && lastEABKeyHash == "" {
+			a.issuer.GetStatus().ACMEStatus().LastEABKeyHash = eabKeyHash(eabAccount, rsaPk)
+		}
+
 		// ensure the cached client in the account registry is up to date
-		a.accountRegistry.AddClient(httpClient, string(a.issuer.GetUID()), *a.issuer.GetSpec().ACME, rsaPk, a.userAgent)
+		a.accountRegistry.AddClient(httpClient, string(a.issuer.GetUID()), acmeConfig, rsaPk, a.userAgent)
 		return nil
 	}
 
@@ -227,36 +290,71 @@ func (a *Acme) Setup(ctx context.Context) error {
 		a.issuer.GetStatus().ACMEStatus().URI = ""
 	}
 
-	var eabAccount *acmeapi.ExternalAccountBinding
-	if eabObj := a.issuer.GetSpec().ACME.ExternalAccountBinding; eabObj != nil {
-		eabKey, err := a.getEABKey(ctx, ns)
-		switch {
-		// Do not re-try if we fail to get the MAC key as it does not exist at the reference.
-		case apierrors.IsNotFound(err), errors.IsInvalidData(err):
-			log.Error(err, "failed to verify ACME account")
-			reason = errorAccountRegistrationFailed
-			msg = messageAccountRegistrationFailed + err.Error()
-			a.recorder.Event(a.issuer, corev1.EventTypeWarning,
-				errorAccountRegistrationFailed,
-				msg)
-			return nil
+	switch {
+	// Do not re-try if we fail to get the MAC key as it does not exist at the reference.
+	case apierrors.IsNotFound(eabErr), errors.IsInvalidData(eabErr):
+		log.Error(eabErr, "failed to verify ACME account")
+		reason = errorAccountRegistrationFailed
+		msg = messageAccountRegistrationFailed + eabErr.Error()
+		a.recorder.Event(a.issuer, corev1.EventTypeWarning,
+			errorAccountRegistrationFailed,
+			msg)
+		return nil
+
+	case eabErr != nil:
+		reason = errorAccountRegistrationFailed
+		msg = messageAccountRegistrationFailed + eabErr.Error()
+		return fmt.Errorf(msg)
+	}
 
-		case err != nil:
+	// ACME servers return the existing account when an account is registered
+	// again with the same account key, and ignore the External Account
+	// Binding sent with the request (RFC 8555 section 7.3.1). There is no
+	// ACME request which binds a new External Account Binding key to an
+	// existing account, so a rotated key is bound by registering a new
+	// account with a newly generated account key. If account key generation
+	// is disabled, the existing account stays bound to the previous key.
+	rebindEAB := eabKeyRotated && !a.issuer.GetSpec().ACME.DisableAccountKeyGeneration
+	if rebindEAB {
+		log.V(logf.InfoLevel).Info("External Account Binding key has changed, registering a new ACME account")
+		rsaPk, err = pki.GenerateRSAPrivateKey(pki.MinRSAKeySize)
+		if err != nil {
 			reason = errorAccountRegistrationFailed
 			msg = messageAccountRegistrationFailed + err.Error()
-			return fmt.Errorf(msg)
-		}
-
-		// set the external account binding
-		eabAccount = &acmeapi.ExternalAccountBinding{
-			KID: eabObj.KeyID,
-			Key: eabKey,
+			return err
 		}
+		cl = a.clientBuilder(httpClient, acmeConfig, rsaPk, a.userAgent)
+	} else if eabKeyRotated {
+		log.V(logf.InfoLevel).Info("External Account Binding key has changed, but account key generation is disabled so the ACME account stays bound to the previous key")
 	}
 
-	// register an ACME account or retrieve it if it already exists.
-	account, err := a.registerAccount(ctx, cl, eabAccount)
-	if err != nil {
+	// register an ACME account or retrieve it if it already exists, unless
+	// the account has been registered out-of-band.
+	var account *acmeapi.Account
+	if accountURI := a.issuer.GetSpec().ACME.AccountURI; accountURI != "" {
+		// The account has been registered out-of-band, so rather than
+		// registering one, verify that the private key belongs to it.
+		account, err = verifyPreRegisteredAccount(ctx, cl, accountURI)
+		if err != nil {
+			reason = errorAccountVerificationFailed
+			msg = messageAccountVerificationFailed + err.Error()
+			log.Error(err, "failed to verify the pre-registered ACME account")
+			a.recorder.Event(a.issuer, corev1.EventTypeWarning, errorAccountVerificationFailed, msg)
+
+			// Do not retry if the private key does not belong to the account,
+			// as this requires the Issuer or Secret to be updated.
+			if errors.IsInvalidData(err) {
+				return nil
+			}
+
+			acmeErr, ok := err.(*acmeapi.Error)
+			if ok && acmeErr.StatusCode >= 400 && acmeErr.StatusCode < 500 {
+				return nil
+			}
+
+			return err
+		}
+	} else if account, err = a.registerAccount(ctx, cl, eabAccount); err != nil {
 		// TODO: this error could be from an account registration or an attempt
 		// to retrieve an existing account- perhaps we should log different
 		// messages in those two scenarios.
@@ -283,6 +381,17 @@ func (a *Acme) Setup(ctx context.Context) error {
 		return err
 	}
 
+	// Only replace the stored account key once the new account has been
+	// registered, so that a failed registration keeps the previous account.
+	if rebindEAB {
+		if err := a.updateAccountPrivateKey(ctx, privateKeySelector, ns, rsaPk); err != nil {
+			reason = errorAccountRegistrationFailed
+			msg = messageFailedToStoreAccountKey + err.Error()
+			log.Error(err, "failed to store the new ACME account private key")
+			return err
+		}
+	}
+
 	// if we got an account successfully, we must check if the registered
 	// email is the same as in the issuer spec
 	specEmail := a.issuer.GetSpec().ACME.Email
@@ -316,14 +425,34 @@ func (a *Acme) Setup(ctx context.Context) error {
 	status = cmmeta.ConditionTrue
 	reason = successAccountRegistered
 	msg = messageAccountRegistered
+	if a.issuer.GetSpec().ACME.AccountURI != "" {
+		reason = successAccountVerified
+		msg = messageAccountPreRegistered
+	}
 	privateKeyBytes := x509.MarshalPKCS1PrivateKey(rsaPk)
 	checksum := sha256.Sum256(privateKeyBytes)
 	checksumString := base64.StdEncoding.EncodeToString(checksum[:])
 	a.issuer.GetStatus().ACMEStatus().URI = account.URI
 	a.issuer.GetStatus().ACMEStatus().LastRegisteredEmail = registeredEmail
 	a.issuer.GetStatus().ACMEStatus().LastPrivateKeyHash = checksumString
+	switch {
+	case eabAccount == nil:
+		a.issuer.GetStatus().ACMEStatus().LastEABKeyHash = ""
+	case eabKeyRotated && !rebindEAB:
+		// The account is still bound to the previous key, so keep its hash
+		// and keep reporting that the new key is not in use.
+		msg = messageEABKeyNotRebound
+		a.recorder.Event(a.issuer, corev1.EventTypeWarning, warningEABKeyNotRebound, msg)
+	default:
+		a.issuer.GetStatus().ACMEStatus().LastEABKeyHash = eabKeyHash(eabAccount, rsaPk)
+	}
+	if rebindEAB {
+		reason = successEABKeyChanged
+		msg = messageEABKeyChanged
+		a.recorder.Event(a.issuer, corev1.EventTypeNormal, successEABKeyChanged, msg)
+	}
 	// ensure the cached client in the account registry is up to date
-	a.accountRegistry.AddClient(httpClient, string(a.issuer.GetUID()), *a.issuer.GetSpec().ACME, rsaPk, a.userAgent)
+	a.accountRegistry.AddClient(httpClient, string(a.issuer.GetUID()), acmeConfig, rsaPk, a.userAgent)
 
 	return nil
 }
@@ -391,9 +520,42 @@ func (a *Acme) registerAccount(ctx context.Context, cl client.Interface, eabAcco
 	return acc, nil
 }
 
+// verifyPreRegisteredAccount retrieves the ACME account belonging to the
+// client's private key and verifies that it is the account with the given URI.
+// An InvalidData error is returned if no account is registered with the key,
+// or if the key belongs to a different account.
+func verifyPreRegisteredAccount(ctx context.Context, cl client.Interface, accountURI string) (*acmeapi.Account, error) {
+	acc, err := cl.GetReg(ctx, accountURI)
+	if err == acmeapi.ErrNoAccount {
+		return nil, errors.NewInvalidData("no ACME account is registered with the account private key")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if acc.URI != accountURI {
+		return nil, errors.NewInvalidData(messageTemplateAccountURIMismatch, acc.URI, accountURI)
+	}
+
+	return acc, nil
+}
+
+// eabKeyHash returns an HMAC of the key ID and key of an External Account
+// Binding, keyed with the ACME account private key, used to detect when the
+// External Account Binding key is changed. The HMAC is stored in the issuer's
+// status, so it must not allow the External Account Binding key to be guessed
+// by anyone who can read the issuer.
+func eabKeyHash(eab *acmeapi.ExternalAccountBinding, accountKey *rsa.PrivateKey) string {
+	h := hmac.New(sha256.New, x509.MarshalPKCS1PrivateKey(accountKey))
+	h.Write([]byte(eab.KID))
+	h.Write([]byte{0})
+	h.Write(eab.Key)
+	return base64.StdEncoding.EncodeToString(h.Sum(nil))
+}
+
 func (a *Acme) getEABKey(ctx context.Context, ns string) ([]byte, error) {
 	eab := a.issuer.GetSpec().ACME.ExternalAccountBinding.Key
-	sec, err := a.secretsClient.Secrets(ns).Get(ctx, eab.Name, metav1.GetOptions{})
+	sec, err := a.secretsLister.Secrets(ns).Get(eab.Name)
 	// Surface IsNotFound API error to not cause re-sync
 	if apierrors.IsNotFound(err) {
 		return nil, err
@@ -420,6 +582,28 @@ func (a *Acme) getEABKey(ctx context.Context, ns string) ([]byte, error) {
 	return keyData, nil
 }
 
+// getCABundle returns the CA bundle stored in the Secret referenced by the
+// issuer's caBundleSecretRef. If no key is specified, it defaults to `ca.crt`.
+func (a *Acme) getCABundle(ctx context.Context, ns string) ([]byte, error) {
+	ref := a.issuer.GetSpec().ACME.CABundleSecretRef
+	sec, err := a.secretsClient.Secrets(ns).Get(ctx, ref.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf(messageTemplateFailedToGetCABundle, err)
+	}
+
+	key := ref.Key
+	if key == "" {
+		key = cmmeta.TLSCAKey
+	}
+
+	caBundle, ok := sec.Data[key]
+	if !ok {
+		return nil, errors.NewInvalidData("no data for %q in secret '%s/%s'", key, ns, ref.Name)
+	}
+
+	return caBundle, nil
+}
+
 // createAccountPrivateKey will generate a new RSA private key, and create it
 // as a secret resource in the apiserver.
 func (a *Acme) createAccountPrivateKey(ctx context.Context, sel cmmeta.SecretKeySelector, ns string) (*rsa.PrivateKey, error) {
@@ -446,6 +630,21 @@ func (a *Acme) createAccountPrivateKey(ctx context.Context, sel cmmeta.SecretKey
 	return accountPrivKey, err
 }
 
+// updateAccountPrivateKey replaces the ACME account private key stored in the
+// given Secret.
+func (a *Acme) updateAccountPrivateKey(ctx context.Context, sel cmmeta.SecretKeySelector, ns string, pk *rsa.PrivateKey) error {
+	sel = acme.PrivateKeySelector(sel)
+	patch, err := json.Marshal(map[string]map[string][]byte{
+		"data": {sel.Key: pki.EncodePKCS1PrivateKey(pk)},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = a.secretsClient.Secrets(ns).Patch(ctx, sel.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	return err
+}
+
 var (
 	acmev1Staging = "https://acme-staging.api.letsencrypt.org/directory"
 	acmev1Prod    = "https://acme-v01.api.letsencrypt.org/directory"
@@ -17,6 +17,7 @@ limitations under the License.
 package acme
 
 import (
+	"bytes"
 	"context"
 	"crypto"
 	"crypto/rsa"
@@ -46,6 +47,7 @@ import (
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
 	"github.com/cert-manager/cert-manager/test/unit/coreclients"
 	"github.com/cert-manager/cert-manager/test/unit/gen"
+	testlisters "github.com/cert-manager/cert-manager/test/unit/listers"
 )
 
 func TestAcme_Setup(t *testing.T) {
@@ -98,6 +100,16 @@ func TestAcme_Setup(t *testing.T) {
 		// This is the decoded EAB key that we send to the ACME server.
 		// TODO: could the newline cause any issues?
 		eabKey = "dGVzdAo=\n"
+		// eabKeyHashValue is the HMAC of the EAB key ID and key in eabSecret,
+		// keyed with the ACME account key.
+		eabKeyHashValue = eabKeyHash(&acmeapi.ExternalAccountBinding{KID: someString, Key: []byte(eabKey)}, rsaPrivKey.(*rsa.PrivateKey))
+
+		// readyEABIssuer is an issuer whose ACME account, registered with an
+		// External Account Binding, has previously been verified.
+		readyEABIssuer = gen.IssuerFrom(baseIssuer,
+			gen.SetIssuerACMEEAB(someString, someString),
+			gen.SetIssuerACMEAccountURL(acmev2Prod+"/acct/1"),
+			gen.AddIssuerCondition(*readyTrueCondition))
 	)
 
 	tests := map[string]struct {
@@ -130,6 +142,8 @@ func TestAcme_Setup(t *testing.T) {
 		// ACME account key created by createAccountPrivateKey.
 		acmePrivKey *rsa.PrivateKey
 
+		// Secret and error returned when getting the EAB or CA bundle
+		// Secret.
 		eabSecret       *corev1.Secret
 		eabSecretGetErr error
 
@@ -138,7 +152,20 @@ func TestAcme_Setup(t *testing.T) {
 		// expected issuer conditions after Setup has been called.
 		expectedConditions []cmapi.IssuerCondition
 		expectedEvents     []string
-		wantsErr           bool
+		// expected LastEABKeyHash on the issuer's status after Setup has
+		// been called.
+		expectedLastEABKeyHash string
+		// expected account URI on the issuer's status after Setup has been
+		// called.
+		expectedAccountURI string
+		// Whether a new account key is expected to be generated and stored
+		// in the account private key Secret.
+		expectNewAccountKey bool
+		// Error returned when storing a new ACME account key.
+		acmePrivKeySecretPatchErr error
+		// expected CA bundle in the issuer config passed to AddClient.
+		expectedCABundle []byte
+		wantsErr         bool
 	}{
 		"LetsEncrypt ACME v1 prod URL specified, return early": {
 			issuer: gen.IssuerFrom(baseIssuer,
@@ -267,6 +294,123 @@ func TestAcme_Setup(t *testing.T) {
 			removeClientShouldBeCalled: true,
 			addClientShouldBeCalled:    true,
 		},
+		"CA bundle Secret reference specified, the CA bundle is used for the ACME client": {
+			issuer: gen.IssuerFrom(baseIssuer,
+				gen.SetIssuerACMEAccountURL(acmev2Prod),
+				gen.SetIssuerACMEEmail(someEmail),
+				gen.SetIssuerACMELastRegisteredEmail(someEmail),
+				gen.SetIssuerACMELastPrivateKeyHash(someString),
+				gen.SetIssuerACMECABundleSecretRef(someString, ""),
+				gen.AddIssuerCondition(
+					*gen.IssuerConditionFrom(readyTrueCondition,
+						gen.SetIssuerConditionStatus(cmmeta.ConditionTrue)))),
+			eabSecret: gen.Secret(someString,
+				gen.SetSecretData(map[string][]byte{cmmeta.TLSCAKey: []byte("ca-bundle")})),
+			expectedConditions: []cmapi.IssuerCondition{
+				*gen.IssuerConditionFrom(readyTrueCondition,
+					gen.SetIssuerConditionStatus(cmmeta.ConditionTrue),
+					gen.SetIssuerConditionMessage(messageAccountRegistered),
+					gen.SetIssuerConditionReason(successAccountRegistered)),
+			},
+			kfsKey:                     rsaPrivKey,
+			removeClientShouldBeCalled: true,
+			addClientShouldBeCalled:    true,
+			expectedCABundle:           []byte("ca-bundle"),
+		},
+		"CA bundle Secret reference specified, but the corresponding secret is not found": {
+			issuer: gen.IssuerFrom(baseIssuer,
+				gen.SetIssuerACMECABundleSecretRef(someString, "")),
+			kfsKey:                     rsaPrivKey,
+			removeClientShouldBeCalled: true,
+			eabSecretGetErr:            notFoundErr,
+			expectedConditions: []cmapi.IssuerCondition{
+				*gen.IssuerConditionFrom(readyFalseCondition,
+					gen.SetIssuerConditionReason(errorAccountVerificationFailed),
+					gen.SetIssuerConditionMessage(messageAccountVerificationFailed+fmt.Errorf(messageTemplateFailedToGetCABundle, notFoundErr).Error())),
+			},
+			wantsErr: true,
+		},
+		"ACME account is pre-registered, account is verified without registering": {
+			issuer: gen.IssuerFrom(baseIssuer,
+				gen.SetIssuerACMEDisableAccountKeyGeneration(true),
+				gen.SetIssuerACMEPreRegisteredAccountURI(acmev2Prod+"/acct/1")),
+			kfsKey:    rsaPrivKey,
+			getRegAcc: &acmeapi.Account{URI: acmev2Prod + "/acct/1"},
+			expectedConditions: []cmapi.IssuerCondition{
+				*gen.IssuerConditionFrom(readyTrueCondition,
+					gen.SetIssuerConditionReason(successAccountVerified),
+					gen.SetIssuerConditionMessage(messageAccountPreRegistered)),
+			},
+			expectedAccountURI:         acmev2Prod + "/acct/1",
+			removeClientShouldBeCalled: true,
+			addClientShouldBeCalled:    true,
+		},
+		"ACME account is pre-registered, but the private key belongs to a different account": {
+			issuer: gen.IssuerFrom(baseIssuer,
+				gen.SetIssuerACMEDisableAccountKeyGeneration(true),
+				gen.SetIssuerACMEPreRegisteredAccountURI(acmev2Prod+"/acct/1")),
+			kfsKey:    rsaPrivKey,
+			getRegAcc: &acmeapi.Account{URI: acmev2Prod + "/acct/2"},
+			expectedConditions: []cmapi.IssuerCondition{
+				*gen.IssuerConditionFrom(readyFalseCondition,
+					gen.SetIssuerConditionReason(errorAccountVerificationFailed),
+					gen.SetIssuerConditionMessage(messageAccountVerificationFailed+fmt.Sprintf(messageTemplateAccountURIMismatch, acmev2Prod+"/acct/2", acmev2Prod+"/acct/1"))),
+			},
+			expectedEvents: []string{
+				fmt.Sprintf("%s %s %s", corev1.EventTypeWarning, errorAccountVerificationFailed, messageAccountVerificationFailed+fmt.Sprintf(messageTemplateAccountURIMismatch, acmev2Prod+"/acct/2", acmev2Prod+"/acct/1")),
+			},
+			removeClientShouldBeCalled: true,
+		},
+		"ACME account is pre-registered, but no account is registered with the private key": {
+			issuer: gen.IssuerFrom(baseIssuer,
+				gen.SetIssuerACMEDisableAccountKeyGeneration(true),
+				gen.SetIssuerACMEPreRegisteredAccountURI(acmev2Prod+"/acct/1")),
+			kfsKey:    rsaPrivKey,
+			getRegErr: acmeapi.ErrNoAccount,
+			expectedConditions: []cmapi.IssuerCondition{
+				*gen.IssuerConditionFrom(readyFalseCondition,
+					gen.SetIssuerConditionReason(errorAccountVerificationFailed),
+					gen.SetIssuerConditionMessage(messageAccountVerificationFailed+"no ACME account is registered with the account private key")),
+			},
+			expectedEvents: []string{
+				fmt.Sprintf("%s %s %s", corev1.EventTypeWarning, errorAccountVerificationFailed, messageAccountVerificationFailed+"no ACME account is registered with the account private key"),
+			},
+			removeClientShouldBeCalled: true,
+		},
+		"ACME account is pre-registered, retrieving the account fails with an unknown error": {
+			issuer: gen.IssuerFrom(baseIssuer,
+				gen.SetIssuerACMEDisableAccountKeyGeneration(true),
+				gen.SetIssuerACMEPreRegisteredAccountURI(acmev2Prod+"/acct/1")),
+			kfsKey:    rsaPrivKey,
+			getRegErr: someErr,
+			expectedConditions: []cmapi.IssuerCondition{
+				*gen.IssuerConditionFrom(readyFalseCondition,
+					gen.SetIssuerConditionReason(errorAccountVerificationFailed),
+					gen.SetIssuerConditionMessage(messageAccountVerificationFailed+someErr.Error())),
+			},
+			expectedEvents: []string{
+				fmt.Sprintf("%s %s %s", corev1.EventTypeWarning, errorAccountVerificationFailed, messageAccountVerificationFailed+someErr.Error()),
+			},
+			removeClientShouldBeCalled: true,
+			wantsErr:                   true,
+		},
+		"ACME account is pre-registered and ready, but the account URI has changed": {
+			issuer: gen.IssuerFrom(baseIssuer,
+				gen.SetIssuerACMEDisableAccountKeyGeneration(true),
+				gen.SetIssuerACMEPreRegisteredAccountURI(acmev2Prod+"/acct/2"),
+				gen.SetIssuerACMEAccountURL(acmev2Prod+"/acct/1"),
+				gen.AddIssuerCondition(*readyTrueCondition)),
+			kfsKey:    rsaPrivKey,
+			getRegAcc: &acmeapi.Account{URI: acmev2Prod + "/acct/2"},
+			expectedConditions: []cmapi.IssuerCondition{
+				*gen.IssuerConditionFrom(readyTrueCondition,
+					gen.SetIssuerConditionReason(successAccountVerified),
+					gen.SetIssuerConditionMessage(messageAccountPreRegistered)),
+			},
+			expectedAccountURI:         acmev2Prod + "/acct/2",
+			removeClientShouldBeCalled: true,
+			addClientShouldBeCalled:    true,
+		},
 		"EAB for issuer specified, but the corresponding secret is not found": {
 			issuer: gen.IssuerFrom(baseIssuer,
 				gen.SetIssuerACMEEAB(someString, someString)),
@@ -365,6 +509,90 @@ func TestAcme_Setup(t *testing.T) {
 					gen.SetIssuerConditionMessage(messageAccountRegistered)),
 			},
 		},
+		"ACME account with EAB is not re-registered if the EAB key has not changed": {
+			issuer: gen.IssuerFrom(readyEABIssuer,
+				gen.SetIssuerACMELastEABKeyHash(eabKeyHashValue)),
+			kfsKey:                     rsaPrivKey,
+			removeClientShouldBeCalled: true,
+			addClientShouldBeCalled:    true,
+			eabSecret:                  eabSecret,
+			expectedConditions:         []cmapi.IssuerCondition{*readyTrueCondition},
+			expectedLastEABKeyHash:     eabKeyHashValue,
+		},
+		"ACME account with EAB registered before the EAB key was tracked records the EAB key without re-registering": {
+			issuer:                     gen.IssuerFrom(readyEABIssuer),
+			kfsKey:                     rsaPrivKey,
+			removeClientShouldBeCalled: true,
+			addClientShouldBeCalled:    true,
+			eabSecret:                  eabSecret,
+			expectedConditions:         []cmapi.IssuerCondition{*readyTrueCondition},
+			expectedLastEABKeyHash:     eabKeyHashValue,
+		},
+		"ACME account is registered with a new account key if the EAB key has changed": {
+			issuer: gen.IssuerFrom(readyEABIssuer,
+				gen.SetIssuerACMELastEABKeyHash("old-eab-key-hash")),
+			kfsKey:                     rsaPrivKey,
+			removeClientShouldBeCalled: true,
+			addClientShouldBeCalled:    true,
+			eabSecret:                  eabSecret,
+			expectedRegisteredAcc: &acmeapi.Account{ExternalAccountBinding: &acmeapi.ExternalAccountBinding{
+				KID: someString,
+				Key: []byte(eabKey),
+			}},
+			expectedConditions: []cmapi.IssuerCondition{
+				*gen.IssuerConditionFrom(readyTrueCondition,
+					gen.SetIssuerConditionReason(successEABKeyChanged),
+					gen.SetIssuerConditionMessage(messageEABKeyChanged)),
+			},
+			expectedEvents: []string{
+				fmt.Sprintf("%s %s %s", corev1.EventTypeNormal, successEABKeyChanged, messageEABKeyChanged),
+			},
+			expectNewAccountKey: true,
+		},
+		"EAB key has changed, but the new account key fails to be stored": {
+			issuer: gen.IssuerFrom(readyEABIssuer,
+				gen.SetIssuerACMELastEABKeyHash("old-eab-key-hash")),
+			kfsKey:                     rsaPrivKey,
+			removeClientShouldBeCalled: true,
+			eabSecret:                  eabSecret,
+			acmePrivKeySecretPatchErr:  someErr,
+			expectedRegisteredAcc: &acmeapi.Account{ExternalAccountBinding: &acmeapi.ExternalAccountBinding{
+				KID: someString,
+				Key: []byte(eabKey),
+			}},
+			expectedConditions: []cmapi.IssuerCondition{
+				*gen.IssuerConditionFrom(readyTrueCondition,
+					gen.SetIssuerConditionStatus(cmmeta.ConditionFalse),
+					gen.SetIssuerConditionReason(errorAccountRegistrationFailed),
+					gen.SetIssuerConditionMessage(messageFailedToStoreAccountKey+someErr.Error())),
+			},
+			expectedLastEABKeyHash: "old-eab-key-hash",
+			wantsErr:               true,
+		},
+		"ACME account stays bound to the previous EAB key if the EAB key has changed and account key generation is disabled": {
+			issuer: gen.IssuerFrom(readyEABIssuer,
+				gen.SetIssuerACMEDisableAccountKeyGeneration(true),
+				gen.SetIssuerACMELastEABKeyHash("old-eab-key-hash")),
+			kfsKey:                     rsaPrivKey,
+			removeClientShouldBeCalled: true,
+			addClientShouldBeCalled:    true,
+			eabSecret:                  eabSecret,
+			registerErr:                acmeapi.ErrAccountAlreadyExists,
+			getRegAcc:                  &acmeapi.Account{URI: acmev2Prod + "/acct/1"},
+			expectedRegisteredAcc: &acmeapi.Account{ExternalAccountBinding: &acmeapi.ExternalAccountBinding{
+				KID: someString,
+				Key: []byte(eabKey),
+			}},
+			expectedConditions: []cmapi.IssuerCondition{
+				*gen.IssuerConditionFrom(readyTrueCondition,
+					gen.SetIssuerConditionMessage(messageEABKeyNotRebound)),
+			},
+			expectedEvents: []string{
+				fmt.Sprintf("%s %s %s", corev1.EventTypeWarning, warningEABKeyNotRebound, messageEABKeyNotRebound),
+			},
+			expectedLastEABKeyHash: "old-eab-key-hash",
+			expectedAccountURI:     acmev2Prod + "/acct/1",
+		},
 		"ACME account with legacy EAB key algorithm set and with an email is registered successfully": {
 			issuer: gen.IssuerFrom(baseIssuer,
 				gen.SetIssuerACMEEmail(someEmail),
@@ -512,10 +740,10 @@ func TestAcme_Setup(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 
 			// Secrets client that will be called from the Setup function to
-			// create new secrets and get EAB secret.
+			// create new secrets and get the CA bundle secret.
 			// TODO: this secretsClient fake is really hacky. It relies on the
 			// fact that the Setup function currently only uses secretsClient to
-			// create account private key secret and to retrieve the EAB secret.
+			// create account private key secret and to retrieve the CA bundle secret.
 			// We should refactor the Setup function and test this in a better way.
 			secretsClient := coreclients.NewFakeSecretsGetterFrom(
 				coreclients.NewFakeSecretsGetter(),
@@ -523,7 +751,12 @@ func TestAcme_Setup(t *testing.T) {
 					test.acmePrivKeySecretCreateErr),
 				coreclients.SetFakeSecretsGetterGet(test.eabSecret,
 					test.eabSecretGetErr),
+				coreclients.SetFakeSecretsGetterPatch(nil,
+					test.acmePrivKeySecretPatchErr),
 			)
+			// Secrets lister used to get the EAB secret.
+			secretsLister := testlisters.NewFakeSecretLister(
+				testlisters.SetFakeSecretNamespaceListerGet(test.eabSecret, test.eabSecretGetErr))
 
 			// Set up a mock keyFromSecret.
 			kfsWasCalled := false
@@ -532,12 +765,16 @@ func TestAcme_Setup(t *testing.T) {
 			// Mock ACME accounts registry.
 			removeClientWasCalled := false
 			addClientWasCalled := false
+			var gotCABundle []byte
+			var gotPrivKey *rsa.PrivateKey
 			ar := &fakeregistry.FakeRegistry{
 				RemoveClientFunc: func(string) {
 					removeClientWasCalled = true
 				},
-				AddClientFunc: func(string, cmacme.ACMEIssuer, *rsa.PrivateKey, string) {
+				AddClientFunc: func(_ string, config cmacme.ACMEIssuer, privateKey *rsa.PrivateKey, _ string) {
 					addClientWasCalled = true
+					gotCABundle = config.CABundle
+					gotPrivKey = privateKey
 				},
 				IsKeyCheckSumCachedFunc: func(lastPrivateKeyHash string, privateKey *rsa.PrivateKey) bool {
 					return true
@@ -564,6 +801,7 @@ func TestAcme_Setup(t *testing.T) {
 			a := Acme{
 				issuer:          test.issuer,
 				secretsClient:   secretsClient,
+				secretsLister:   secretsLister,
 				accountRegistry: ar,
 				keyFromSecret:   kfs,
 				clientBuilder:   clientBuilderMock(&cl),
@@ -594,6 +832,11 @@ func TestAcme_Setup(t *testing.T) {
 					addClientWasCalled)
 			}
 
+			// Verify that the resolved CA bundle was used for the cached client.
+			if !bytes.Equal(gotCABundle, test.expectedCABundle) {
+				t.Errorf("Expected CA bundle passed to AddClient %q, got %q", test.expectedCABundle, gotCABundle)
+			}
+
 			// Verify that the expected account value was passed when the
 			// account was registered.
 			if !reflect.DeepEqual(gotAcc, test.expectedRegisteredAcc) {
@@ -610,6 +853,33 @@ func TestAcme_Setup(t *testing.T) {
 					test.expectedConditions, gotConditions)
 			}
 
+			// Verify that the EAB key used to register the account was recorded.
+			if test.expectedLastEABKeyHash != "" {
+				if got := a.issuer.GetStatus().ACMEStatus().LastEABKeyHash; got != test.expectedLastEABKeyHash {
+					t.Errorf("Expected issuer's LastEABKeyHash %q, got %q", test.expectedLastEABKeyHash, got)
+				}
+			}
+
+			// Verify that a newly generated account key is used for the
+			// cached client and that the EAB key was recorded against it.
+			if test.expectNewAccountKey {
+				if gotPrivKey == nil || gotPrivKey.Equal(test.kfsKey) {
+					t.Errorf("Expected a new account key to be passed to AddClient")
+				} else {
+					wantHash := eabKeyHash(&acmeapi.ExternalAccountBinding{KID: someString, Key: []byte(eabKey)}, gotPrivKey)
+					if got := a.issuer.GetStatus().ACMEStatus().LastEABKeyHash; got != wantHash {
+						t.Errorf("Expected issuer's LastEABKeyHash %q, got %q", wantHash, got)
+					}
+				}
+			}
+
+			// Verify that the verified account URI was recorded.
+			if test.expectedAccountURI != "" {
+				if got := a.issuer.GetStatus().ACMEStatus().URI; got != test.expectedAccountURI {
+					t.Errorf("Expected issuer's account URI %q, got %q", test.expectedAccountURI, got)
+				}
+			}
+
 			// Verify that the expected events were recorded.
 			if !slices.Equal(test.expectedEvents, recorder.Events) {
 				t.Errorf("Expected events:\n%+#v\ngot:%+#v",
@@ -0,0 +1,153 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tlsalpn
+
+import (
+	"context"
+	"fmt"
+	"hash/adler32"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/utils/ptr"
+
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/internal/solverpod"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+// podLabels returns the labels of the solver pod and service for the given
+// challenge. The domain and token labels are shared with the HTTP01 solver,
+// so that these resources are also watched by the controller's metadata
+// informers.
+func podLabels(ch *cmacme.Challenge) map[string]string {
+	return map[string]string{
+		cmacme.DomainLabelKey:                        fmt.Sprintf("%d", adler32.Checksum([]byte(ch.Spec.DNSName))),
+		cmacme.TokenLabelKey:                         fmt.Sprintf("%d", adler32.Checksum([]byte(ch.Spec.Token))),
+		cmacme.TLSALPN01SolverIdentificationLabelKey: "true",
+	}
+}
+
+func (s *Solver) ensurePod(ctx context.Context, ch *cmacme.Challenge) error {
+	log := logf.FromContext(ctx).WithName("ensurePod")
+
+	log.V(logf.DebugLevel).Info("checking for existing TLSALPN01 solver pods")
+	existingPods, err := solverpod.ResourcesForChallenge(ctx, s.podLister, ch, podLabels(ch))
+	if err != nil {
+		return err
+	}
+	if len(existingPods) == 1 {
+		logf.WithRelatedResource(log, existingPods[0]).Info("found one existing TLSALPN01 solver pod")
+		return nil
+	}
+	if len(existingPods) > 1 {
+		log.V(logf.InfoLevel).Info("multiple challenge solver pods found for challenge. cleaning up all existing pods.")
+		if err := s.cleanupPods(ctx, ch); err != nil {
+			return err
+		}
+		return fmt.Errorf("multiple existing challenge solver pods found and cleaned up. retrying challenge sync")
+	}
+
+	log.V(logf.InfoLevel).Info("creating TLSALPN01 challenge solver pod")
+	_, err = s.Client.CoreV1().Pods(ch.Namespace).Create(ctx, s.buildPod(ch), metav1.CreateOptions{})
+	return err
+}
+
+func (s *Solver) cleanupPods(ctx context.Context, ch *cmacme.Challenge) error {
+	log := logf.FromContext(ctx, "cleanupPods")
+
+	pods, err := solverpod.ResourcesForChallenge(ctx, s.podLister, ch, podLabels(ch))
+	if err != nil {
+		return fmt.Errorf("error retrieving pods for cleanup: %w", err)
+	}
+	var errs []error
+	for _, pod := range pods {
+		log := logf.WithRelatedResource(log, pod)
+		log.V(logf.InfoLevel).Info("deleting pod resource")
+
+		if err := s.Client.CoreV1().Pods(pod.Namespace).Delete(ctx, pod.Name, metav1.DeleteOptions{}); err != nil {
+			log.V(logf.WarnLevel).Info("failed to delete pod resource", "error", err)
+			errs = append(errs, fmt.Errorf("error deleting pod: %w", err))
+			continue
+		}
+		log.V(logf.InfoLevel).Info("successfully deleted pod resource")
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// buildPod will build a challenge solving pod for the given challenge. It
+// runs the same acmesolver image as the HTTP01 solver, with the same
+// resources and security context. It will not create it in the API server.
+func (s *Solver) buildPod(ch *cmacme.Challenge) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "cm-acme-tls-alpn-solver-",
+			Namespace:    ch.Namespace,
+			Labels:       podLabels(ch),
+			Annotations: map[string]string{
+				"sidecar.istio.io/inject":                        "false",
+				"cluster-autoscaler.kubernetes.io/safe-to-evict": "true",
+			},
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(ch, challengeGvk)},
+		},
+		Spec: corev1.PodSpec{
+			// The solver process does not need access to the Kubernetes API
+			// server, so we turn off automounting of the ServiceAccount token.
+			AutomountServiceAccountToken: ptr.To(false),
+			NodeSelector: map[string]string{
+				"kubernetes.io/os": "linux",
+			},
+			RestartPolicy:      corev1.RestartPolicyOnFailure,
+			EnableServiceLinks: ptr.To(false),
+			SecurityContext:    solverpod.PodSecurityContext(s.ACMEOptions),
+			Containers: []corev1.Container{
+				{
+					Name:            "acmesolver",
+					Image:           s.ACMEOptions.HTTP01SolverImage,
+					ImagePullPolicy: corev1.PullIfNotPresent,
+					Args: []string{
+						"--challenge-type=tls-alpn-01",
+						fmt.Sprintf("--listen-port=%d", acmeSolverListenPort),
+						fmt.Sprintf("--domain=%s", ch.Spec.DNSName),
+						fmt.Sprintf("--key=%s", ch.Spec.Key),
+					},
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU:    s.ACMEOptions.HTTP01SolverResourceRequestCPU,
+							corev1.ResourceMemory: s.ACMEOptions.HTTP01SolverResourceRequestMemory,
+						},
+						Limits: corev1.ResourceList{
+							corev1.ResourceCPU:    s.ACMEOptions.HTTP01SolverResourceLimitsCPU,
+							corev1.ResourceMemory: s.ACMEOptions.HTTP01SolverResourceLimitsMemory,
+						},
+					},
+					Ports: []corev1.ContainerPort{
+						{
+							Name:          "https",
+							ContainerPort: acmeSolverListenPort,
+						},
+					},
+					SecurityContext: solverpod.SecurityContext(s.ACMEOptions),
+				},
+			},
+		},
+	}
+
+	return solverpod.MergePodTemplate(pod, ch.Spec.Solver.TLSALPN01.PodTemplate)
+}
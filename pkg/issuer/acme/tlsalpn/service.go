@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tlsalpn
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/internal/solverpod"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+// ensureService ensures that a Service exists for the given Challenge.
+func (s *Solver) ensureService(ctx context.Context, ch *cmacme.Challenge) error {
+	log := logf.FromContext(ctx).WithName("ensureService")
+
+	log.V(logf.DebugLevel).Info("checking for existing TLSALPN01 solver services for challenge")
+	existingServices, err := solverpod.ResourcesForChallenge(ctx, s.serviceLister, ch, podLabels(ch))
+	if err != nil {
+		return err
+	}
+	if len(existingServices) == 1 {
+		logf.WithRelatedResource(log, existingServices[0]).Info("found one existing TLSALPN01 solver Service for challenge resource")
+		return nil
+	}
+	if len(existingServices) > 1 {
+		log.V(logf.DebugLevel).Info("multiple challenge solver services found for challenge. cleaning up all existing services.")
+		if err := s.cleanupServices(ctx, ch); err != nil {
+			return err
+		}
+		return fmt.Errorf("multiple existing challenge solver services found and cleaned up. retrying challenge sync")
+	}
+
+	log.V(logf.DebugLevel).Info("creating TLSALPN01 challenge solver service")
+	_, err = s.Client.CoreV1().Services(ch.Namespace).Create(ctx, buildService(ch), metav1.CreateOptions{})
+	return err
+}
+
+// buildService builds the Service selecting the solver pod of the given
+// challenge. It exposes the pod on the port the ACME server connects to.
+func buildService(ch *cmacme.Challenge) *corev1.Service {
+	podLabels := podLabels(ch)
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName:    "cm-acme-tls-alpn-solver-",
+			Namespace:       ch.Namespace,
+			Labels:          podLabels,
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(ch, challengeGvk)},
+		},
+		Spec: corev1.ServiceSpec{
+			Type: corev1.ServiceTypeNodePort,
+			Ports: []corev1.ServicePort{
+				{
+					Name:       "https",
+					Port:       challengePort,
+					TargetPort: intstr.FromInt(acmeSolverListenPort),
+				},
+			},
+			Selector: podLabels,
+		},
+	}
+
+	if serviceType := ch.Spec.Solver.TLSALPN01.ServiceType; serviceType != "" {
+		service.Spec.Type = serviceType
+	}
+
+	return service
+}
+
+func (s *Solver) cleanupServices(ctx context.Context, ch *cmacme.Challenge) error {
+	log := logf.FromContext(ctx, "cleanupServices")
+
+	services, err := solverpod.ResourcesForChallenge(ctx, s.serviceLister, ch, podLabels(ch))
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, service := range services {
+		log := logf.WithRelatedResource(log, service)
+		log.V(logf.DebugLevel).Info("deleting service resource")
+
+		if err := s.Client.CoreV1().Services(service.Namespace).Delete(ctx, service.Name, metav1.DeleteOptions{}); err != nil {
+			log.V(logf.WarnLevel).Info("failed to delete service resource", "error", err)
+			errs = append(errs, err)
+			continue
+		}
+		log.V(logf.DebugLevel).Info("successfully deleted service resource")
+	}
+	return utilerrors.NewAggregate(errs)
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package solver
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/go-logr/logr"
+)
+
+const (
+	// ACMETLS1Protocol is the ALPN protocol negotiated by the ACME server
+	// when validating tls-alpn-01 challenges, as defined in RFC 8737.
+	ACMETLS1Protocol = "acme-tls/1"
+
+	// challengeCertificateValidity is the validity period of the
+	// self-signed challenge certificate.
+	challengeCertificateValidity = 24 * time.Hour
+
+	// See the equivalent constant of the http-01 solver.
+	defaultReadHeaderTimeout = 32 * time.Second
+)
+
+// OIDACMEIdentifier is the object identifier of the acmeIdentifier extension
+// which the tls-alpn-01 challenge certificate must contain.
+var OIDACMEIdentifier = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 1, 31}
+
+// ChallengeCertificate returns a self-signed certificate for the given domain
+// which can be presented to validate a tls-alpn-01 challenge with the given
+// key authorization. As required by RFC 8737, its only subject alternative
+// name is the domain and it contains a critical acmeIdentifier extension
+// whose value is the SHA-256 digest of the key authorization.
+func ChallengeCertificate(domain, keyAuth string) (tls.Certificate, error) {
+	extValue, err := acmeIdentifierValue(keyAuth)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	pk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject:      pkix.Name{CommonName: domain},
+		NotBefore:    now,
+		NotAfter:     now.Add(challengeCertificateValidity),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		DNSNames:     []string{domain},
+		ExtraExtensions: []pkix.Extension{
+			{Id: OIDACMEIdentifier, Critical: true, Value: extValue},
+		},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, pk.Public(), pk)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	return tls.Certificate{
+		Certificate: [][]byte{der},
+		PrivateKey:  pk,
+	}, nil
+}
+
+// VerifyChallengeCertificate checks that cert is a valid tls-alpn-01
+// challenge certificate for the given domain and key authorization.
+func VerifyChallengeCertificate(cert *x509.Certificate, domain, keyAuth string) error {
+	if len(cert.DNSNames) != 1 || cert.DNSNames[0] != domain {
+		return fmt.Errorf("challenge certificate must have exactly one DNS name %q, but has %v", domain, cert.DNSNames)
+	}
+
+	expected, err := acmeIdentifierValue(keyAuth)
+	if err != nil {
+		return err
+	}
+	for _, ext := range cert.Extensions {
+		if !ext.Id.Equal(OIDACMEIdentifier) {
+			continue
+		}
+		if !ext.Critical {
+			return errors.New("acmeIdentifier extension of the challenge certificate is not critical")
+		}
+		if !bytes.Equal(ext.Value, expected) {
+			return errors.New("acmeIdentifier extension of the challenge certificate does not match the key authorization")
+		}
+		return nil
+	}
+	return errors.New("challenge certificate does not contain an acmeIdentifier extension")
+}
+
+// acmeIdentifierValue returns the DER encoded value of the acmeIdentifier
+// extension for the given key authorization.
+func acmeIdentifierValue(keyAuth string) ([]byte, error) {
+	digest := sha256.Sum256([]byte(keyAuth))
+	return asn1.Marshal(digest[:])
+}
+
+type TLSALPN01Solver struct {
+	ListenPort int
+
+	Domain string
+	Key    string
+
+	http.Server
+}
+
+// Listen serves the challenge certificate to TLS clients negotiating the
+// acme-tls/1 protocol for the expected domain. Handshakes offering any other
+// protocol or server name are rejected.
+func (t *TLSALPN01Solver) Listen(log logr.Logger) error {
+	log.Info("starting listener",
+		"expected_domain", t.Domain,
+		"expected_key", t.Key,
+		"listen_port", t.ListenPort,
+	)
+
+	cert, err := ChallengeCertificate(t.Domain, t.Key)
+	if err != nil {
+		return fmt.Errorf("error generating challenge certificate: %w", err)
+	}
+
+	t.Server = http.Server{
+		Addr:    fmt.Sprintf(":%d", t.ListenPort),
+		Handler: http.NotFoundHandler(),
+		TLSConfig: &tls.Config{
+			NextProtos: []string{ACMETLS1Protocol},
+			MinVersion: tls.VersionTLS12,
+			GetCertificate: func(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+				log := log.WithValues("server_name", hello.ServerName)
+				if !slices.Contains(hello.SupportedProtos, ACMETLS1Protocol) {
+					log.Info("client did not offer the acme-tls/1 protocol")
+					return nil, fmt.Errorf("client did not offer the %s protocol", ACMETLS1Protocol)
+				}
+				if hello.ServerName != t.Domain {
+					log.Info("invalid server name", "expected_domain", t.Domain)
+					return nil, fmt.Errorf("unexpected server name %q", hello.ServerName)
+				}
+				log.Info("got successful challenge request, presenting challenge certificate")
+				return &cert, nil
+			},
+		},
+		// The ACME server closes the connection once the handshake is
+		// complete, so there is nothing to serve on acme-tls/1 connections.
+		TLSNextProto: map[string]func(*http.Server, *tls.Conn, http.Handler){
+			ACMETLS1Protocol: func(_ *http.Server, conn *tls.Conn, _ http.Handler) {
+				conn.Close()
+			},
+		},
+		ReadHeaderTimeout: defaultReadHeaderTimeout, // Mitigation for G112: Potential slowloris attack
+	}
+
+	return t.Server.ListenAndServeTLS("", "")
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package solver
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"reflect"
+	"testing"
+)
+
+func TestChallengeCertificate(t *testing.T) {
+	const (
+		domain  = "example.com"
+		keyAuth = "evaGxfADs6pSRb2LAv9IZf17Dt3juxGJ-PCt92wr-oA.9jg46WB3rR_AHD-EBXdN7cBkH1WOu0tA3M9fm21mqTI"
+	)
+
+	tlsCert, err := ChallengeCertificate(domain, keyAuth)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cert, err := x509.ParseCertificate(tlsCert.Certificate[0])
+	if err != nil {
+		t.Fatalf("failed to parse challenge certificate: %v", err)
+	}
+
+	if !reflect.DeepEqual(cert.DNSNames, []string{domain}) {
+		t.Errorf("expected DNS names [%s] but got %v", domain, cert.DNSNames)
+	}
+
+	var found bool
+	for _, ext := range cert.Extensions {
+		if !ext.Id.Equal(asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 1, 31}) {
+			continue
+		}
+		found = true
+		if !ext.Critical {
+			t.Errorf("expected acmeIdentifier extension to be critical")
+		}
+		var digest []byte
+		rest, err := asn1.Unmarshal(ext.Value, &digest)
+		if err != nil || len(rest) != 0 {
+			t.Fatalf("acmeIdentifier extension value is not a DER encoded OCTET STRING: %v", err)
+		}
+		expected := sha256.Sum256([]byte(keyAuth))
+		if !reflect.DeepEqual(digest, expected[:]) {
+			t.Errorf("expected acmeIdentifier digest %x but got %x", expected, digest)
+		}
+	}
+	if !found {
+		t.Errorf("challenge certificate does not contain an acmeIdentifier extension")
+	}
+
+	if err := VerifyChallengeCertificate(cert, domain, keyAuth); err != nil {
+		t.Errorf("expected challenge certificate to verify but got: %v", err)
+	}
+}
+
+func TestVerifyChallengeCertificate(t *testing.T) {
+	tlsCert, err := ChallengeCertificate("example.com", "key-auth")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	challengeCert, err := x509.ParseCertificate(tlsCert.Certificate[0])
+	if err != nil {
+		t.Fatalf("failed to parse challenge certificate: %v", err)
+	}
+	noExtensionCert := &x509.Certificate{DNSNames: []string{"example.com"}}
+
+	tests := map[string]struct {
+		cert    *x509.Certificate
+		domain  string
+		keyAuth string
+		wantErr bool
+	}{
+		"matching domain and key authorization": {
+			cert:    challengeCert,
+			domain:  "example.com",
+			keyAuth: "key-auth",
+		},
+		"different domain": {
+			cert:    challengeCert,
+			domain:  "www.example.com",
+			keyAuth: "key-auth",
+			wantErr: true,
+		},
+		"different key authorization": {
+			cert:    challengeCert,
+			domain:  "example.com",
+			keyAuth: "other-key-auth",
+			wantErr: true,
+		},
+		"no acmeIdentifier extension": {
+			cert:    noExtensionCert,
+			domain:  "example.com",
+			keyAuth: "key-auth",
+			wantErr: true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := VerifyChallengeCertificate(test.cert, test.domain, test.keyAuth)
+			if (err != nil) != test.wantErr {
+				t.Errorf("expected error %t but got: %v", test.wantErr, err)
+			}
+		})
+	}
+}
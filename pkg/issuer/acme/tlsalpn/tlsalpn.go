@@ -0,0 +1,206 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tlsalpn
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/tools/cache"
+
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/tlsalpn/solver"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+const (
+	// TLSALPN01Timeout is the max amount of time to wait for a TLSALPN01
+	// challenge to succeed
+	TLSALPN01Timeout = time.Minute * 15
+	// acmeSolverListenPort is the port acmesolver should listen on
+	acmeSolverListenPort = 8443
+	// challengePort is the port on which the ACME server connects to the
+	// domain being validated, as defined in RFC 8737.
+	challengePort = 443
+
+	loggerName = "tlsalpn01"
+)
+
+var (
+	challengeGvk = cmacme.SchemeGroupVersion.WithKind("Challenge")
+)
+
+// Solver is an implementation of the acme tls-alpn-01 challenge solver
+// protocol. It creates a solver pod presenting the challenge certificate and
+// a Service selecting it. Routing TLS connections for the challenged domain
+// to that Service, for example by SNI on a layer 4 load balancer, is left to
+// the user.
+type Solver struct {
+	*controller.Context
+
+	podLister     cache.GenericLister
+	serviceLister cache.GenericLister
+
+	testReachability reachabilityTest
+	requiredPasses   int
+}
+
+type reachabilityTest func(ctx context.Context, addr, domain, key string, dnsServers []string) error
+
+// NewSolver returns a new ACME TLSALPN01 solver for the given *controller.Context.
+func NewSolver(ctx *controller.Context) (*Solver, error) {
+	return &Solver{
+		Context:          ctx,
+		podLister:        ctx.HTTP01ResourceMetadataInformersFactory.ForResource(corev1.SchemeGroupVersion.WithResource("pods")).Lister(),
+		serviceLister:    ctx.HTTP01ResourceMetadataInformersFactory.ForResource(corev1.SchemeGroupVersion.WithResource("services")).Lister(),
+		testReachability: testReachability,
+		requiredPasses:   5,
+	}, nil
+}
+
+// Present will realise the resources required to solve the given TLSALPN01
+// challenge validation in the apiserver. If those resources already exist, it
+// will return nil (i.e. this function is idempotent).
+func (s *Solver) Present(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.Challenge) error {
+	log := logf.FromContext(ctx).WithName(loggerName)
+	ctx = logf.NewContext(ctx, log)
+
+	if ch.Spec.Solver.TLSALPN01 == nil {
+		return fmt.Errorf("couldn't Present challenge %s/%s: no TLSALPN01 solver was specified", ch.Namespace, ch.Name)
+	}
+
+	podErr := s.ensurePod(ctx, ch)
+	svcErr := s.ensureService(ctx, ch)
+	return utilerrors.NewAggregate([]error{podErr, svcErr})
+}
+
+func (s *Solver) Check(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.Challenge) error {
+	log := logf.FromContext(ctx, loggerName, "selfCheck")
+	ctx = logf.NewContext(ctx, log)
+
+	// Present is idempotent and the state of the system may have changed
+	// since present was called by the controllers (killed pods, drained
+	// nodes). Call present again to be certain.
+	if s.podLister != nil && s.serviceLister != nil {
+		log.V(logf.DebugLevel).Info("calling Present function before running self check to ensure required resources exist")
+		if err := s.Present(ctx, issuer, ch); err != nil {
+			log.V(logf.DebugLevel).Info("failed to call Present function", "error", err)
+			return err
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, TLSALPN01Timeout)
+	defer cancel()
+	addr := net.JoinHostPort(ch.Spec.DNSName, fmt.Sprintf("%d", challengePort))
+	log = log.WithValues("address", addr)
+	ctx = logf.NewContext(ctx, log)
+
+	log.V(logf.DebugLevel).Info("running self check multiple times to ensure challenge has propagated", "required_passes", s.requiredPasses)
+	for i := 0; i < s.requiredPasses; i++ {
+		if err := s.testReachability(ctx, addr, ch.Spec.DNSName, ch.Spec.Key, s.HTTP01SolverNameservers); err != nil {
+			return err
+		}
+		log.V(logf.DebugLevel).Info("reachability test passed, re-checking in 2s time")
+
+		if i != s.requiredPasses-1 {
+			// sleep for 2s between checks
+			time.Sleep(time.Second * 2)
+		}
+	}
+
+	log.V(logf.DebugLevel).Info("self check succeeded")
+
+	return nil
+}
+
+// CleanUp will ensure the created service and pod are clean/deleted of any
+// cert-manager created data.
+func (s *Solver) CleanUp(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.Challenge) error {
+	var errs []error
+	errs = append(errs, s.cleanupPods(ctx, ch))
+	errs = append(errs, s.cleanupServices(ctx, ch))
+	return utilerrors.NewAggregate(errs)
+}
+
+// testReachability will perform a TLS handshake with addr negotiating the
+// acme-tls/1 protocol, in the same way as the ACME server will, and check
+// that the presented certificate is the challenge certificate for domain and
+// key.
+func testReachability(ctx context.Context, addr, domain, key string, dnsServers []string) error {
+	log := logf.FromContext(ctx)
+	log.V(logf.DebugLevel).Info("performing TLSALPN01 reachability check")
+
+	dialer := &net.Dialer{Timeout: 10 * time.Second}
+	if len(dnsServers) != 0 {
+		// we need to increment a counter to iterate through the dns servers as the dialer will not
+		// return an error if the dns server is not responding.
+		counter := 0
+		dialer.Resolver = &net.Resolver{
+			PreferGo: true,
+			Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+				d := net.Dialer{
+					Timeout: 3 * time.Second,
+				}
+				s := dnsServers[counter%len(dnsServers)]
+				counter++
+				return d.DialContext(ctx, network, s)
+			},
+		}
+	}
+
+	tlsDialer := &tls.Dialer{
+		NetDialer: dialer,
+		Config: &tls.Config{
+			ServerName: domain,
+			NextProtos: []string{solver.ACMETLS1Protocol},
+			// The challenge certificate is self-signed, so it is verified
+			// against the key authorization below instead.
+			InsecureSkipVerify: true, // #nosec G402
+		},
+	}
+	conn, err := tlsDialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		log.V(logf.DebugLevel).Info("failed to perform self check TLS handshake", "error", err)
+		return fmt.Errorf("failed to perform self check TLS handshake with '%s': %v", addr, err)
+	}
+	defer conn.Close()
+
+	state := conn.(*tls.Conn).ConnectionState()
+	if state.NegotiatedProtocol != solver.ACMETLS1Protocol {
+		log.V(logf.DebugLevel).Info("server did not negotiate the acme-tls/1 protocol", "protocol", state.NegotiatedProtocol)
+		return fmt.Errorf("server at '%s' did not negotiate the %s protocol", addr, solver.ACMETLS1Protocol)
+	}
+	if len(state.PeerCertificates) == 0 {
+		return errors.New("server did not present a certificate")
+	}
+	if err := solver.VerifyChallengeCertificate(state.PeerCertificates[0], domain, key); err != nil {
+		log.V(logf.DebugLevel).Info("presented certificate is not the expected challenge certificate", "error", err)
+		return fmt.Errorf("server at '%s' presented an invalid challenge certificate: %v", addr, err)
+	}
+
+	log.V(logf.DebugLevel).Info("reachability test succeeded")
+
+	return nil
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tlsalpn
+
+import (
+	"context"
+	"crypto/tls"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	"github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/tlsalpn/solver"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+// startTLSServer starts a TLS listener presenting cert to clients and
+// negotiating the given protocols, and returns its address.
+func startTLSServer(t *testing.T, cert tls.Certificate, protos []string) string {
+	ln, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		NextProtos:   protos,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			_ = conn.(*tls.Conn).Handshake()
+			conn.Close()
+		}
+	}()
+
+	return ln.Addr().String()
+}
+
+func TestReachability(t *testing.T) {
+	challengeCert, err := solver.ChallengeCertificate("example.com", "key-auth")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		protos  []string
+		domain  string
+		key     string
+		wantErr bool
+	}{
+		"server presenting the challenge certificate": {
+			protos: []string{solver.ACMETLS1Protocol},
+			domain: "example.com",
+			key:    "key-auth",
+		},
+		"server presenting a certificate for another key authorization": {
+			protos:  []string{solver.ACMETLS1Protocol},
+			domain:  "example.com",
+			key:     "other-key-auth",
+			wantErr: true,
+		},
+		"server presenting a certificate for another domain": {
+			protos:  []string{solver.ACMETLS1Protocol},
+			domain:  "www.example.com",
+			key:     "key-auth",
+			wantErr: true,
+		},
+		"server not negotiating the acme-tls/1 protocol": {
+			protos:  []string{"http/1.1"},
+			domain:  "example.com",
+			key:     "key-auth",
+			wantErr: true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			addr := startTLSServer(t, challengeCert, test.protos)
+			err := testReachability(context.Background(), addr, test.domain, test.key, nil)
+			if (err != nil) != test.wantErr {
+				t.Errorf("expected error %t but got: %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	var calls []string
+	s := &Solver{
+		Context: &controller.Context{},
+		testReachability: func(_ context.Context, addr, domain, key string, _ []string) error {
+			calls = append(calls, addr+" "+domain+" "+key)
+			return nil
+		},
+		requiredPasses: 1,
+	}
+	ch := gen.Challenge("test",
+		gen.SetChallengeDNSName("example.com"),
+		gen.SetChallengeKey("key-auth"),
+	)
+
+	if err := s.Check(context.Background(), nil, ch); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []string{"example.com:443 example.com key-auth"}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Errorf("expected reachability tests %v but got %v", expected, calls)
+	}
+}
+
+func TestBuildPodAndService(t *testing.T) {
+	ch := gen.Challenge("test",
+		gen.SetChallengeNamespace("default"),
+		gen.SetChallengeDNSName("example.com"),
+		gen.SetChallengeToken("token"),
+		gen.SetChallengeKey("key-auth"),
+	)
+	ch.Spec.Solver = cmacme.ACMEChallengeSolver{
+		TLSALPN01: &cmacme.ACMEChallengeSolverTLSALPN01{
+			ServiceType: corev1.ServiceTypeClusterIP,
+			PodTemplate: &cmacme.ACMEChallengeSolverHTTP01IngressPodTemplate{
+				ACMEChallengeSolverHTTP01IngressPodObjectMeta: cmacme.ACMEChallengeSolverHTTP01IngressPodObjectMeta{
+					Labels: map[string]string{"team": "edge"},
+				},
+				Spec: cmacme.ACMEChallengeSolverHTTP01IngressPodSpec{
+					PriorityClassName: "high",
+				},
+			},
+		},
+	}
+	s := &Solver{Context: &controller.Context{}}
+	s.ACMEOptions.HTTP01SolverImage = "acmesolver:test"
+
+	pod := s.buildPod(ch)
+	expectedArgs := []string{
+		"--challenge-type=tls-alpn-01",
+		"--listen-port=8443",
+		"--domain=example.com",
+		"--key=key-auth",
+	}
+	if args := pod.Spec.Containers[0].Args; !reflect.DeepEqual(args, expectedArgs) {
+		t.Errorf("expected solver args %v but got %v", expectedArgs, args)
+	}
+	if pod.Spec.Containers[0].Image != "acmesolver:test" {
+		t.Errorf("expected solver image acmesolver:test but got %s", pod.Spec.Containers[0].Image)
+	}
+	if pod.Labels["team"] != "edge" || pod.Labels[cmacme.TLSALPN01SolverIdentificationLabelKey] != "true" {
+		t.Errorf("unexpected solver pod labels %v", pod.Labels)
+	}
+	if pod.Spec.PriorityClassName != "high" {
+		t.Errorf("expected priority class from pod template but got %q", pod.Spec.PriorityClassName)
+	}
+
+	svc := buildService(ch)
+	if svc.Spec.Type != corev1.ServiceTypeClusterIP {
+		t.Errorf("expected service type ClusterIP but got %s", svc.Spec.Type)
+	}
+	if port := svc.Spec.Ports[0]; port.Port != 443 || port.TargetPort.IntValue() != 8443 {
+		t.Errorf("expected service to expose port 443 targeting 8443 but got %+v", port)
+	}
+	if !reflect.DeepEqual(svc.Spec.Selector, podLabels(ch)) {
+		t.Errorf("expected service to select the solver pod labels but got %v", svc.Spec.Selector)
+	}
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ca
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	// serialNumberSecretSuffix is appended to the name of the CA secret to
+	// form the name of the Secret holding the sequential serial number counter.
+	serialNumberSecretSuffix = "-serial-number"
+
+	// serialNumberSecretKey is the key in the serial number Secret holding the
+	// last allocated serial number.
+	serialNumberSecretKey = "serial"
+)
+
+// ErrSerialNumbersExhausted is returned when no more sequential serial
+// numbers can be allocated without wrapping around.
+var ErrSerialNumbersExhausted = errors.New("sequential serial numbers exhausted")
+
+// SerialNumberSecretName returns the name of the Secret used to persist the
+// sequential serial number counter for the CA stored in caSecretName.
+func SerialNumberSecretName(caSecretName string) string {
+	return caSecretName + serialNumberSecretSuffix
+}
+
+// NextSequentialSerialNumber allocates the next serial number for the CA
+// stored in the Secret caSecretName. The last allocated serial number is
+// persisted in a separate Secret in the same namespace, which is created on
+// first use. Concurrent allocations are safe: updates are made against the
+// Secret's resourceVersion and retried on conflict, so every caller receives
+// a distinct serial number. ErrSerialNumbersExhausted is returned rather than
+// wrapping around once the counter reaches its maximum value.
+func NextSequentialSerialNumber(ctx context.Context, client corev1client.SecretsGetter, namespace, caSecretName string) (*big.Int, error) {
+	name := SerialNumberSecretName(caSecretName)
+
+	var serial uint64
+	err := retry.OnError(retry.DefaultRetry, func(err error) bool {
+		return apierrors.IsConflict(err) || apierrors.IsAlreadyExists(err)
+	}, func() error {
+		secret, err := client.Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			serial = 1
+			_, err := client.Secrets(namespace).Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+				Data: map[string][]byte{
+					serialNumberSecretKey: []byte(strconv.FormatUint(serial, 10)),
+				},
+			}, metav1.CreateOptions{})
+			return err
+		}
+		if err != nil {
+			return err
+		}
+
+		last, err := strconv.ParseUint(string(secret.Data[serialNumberSecretKey]), 10, 64)
+		if err != nil {
+			return fmt.Errorf("failed to parse serial number counter in secret %s/%s: %w", namespace, name, err)
+		}
+		if last == math.MaxUint64 {
+			return ErrSerialNumbersExhausted
+		}
+		serial = last + 1
+
+		secret = secret.DeepCopy()
+		secret.Data[serialNumberSecretKey] = []byte(strconv.FormatUint(serial, 10))
+		_, err = client.Secrets(namespace).Update(ctx, secret, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return new(big.Int).SetUint64(serial), nil
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ca
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	coretesting "k8s.io/client-go/testing"
+)
+
+func TestNextSequentialSerialNumber(t *testing.T) {
+	secretsGVR := corev1.SchemeGroupVersion.WithResource("secrets")
+
+	counterSecret := func(serial uint64) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "ca-secret-serial-number", Namespace: "default"},
+			Data: map[string][]byte{
+				"serial": []byte(strconv.FormatUint(serial, 10)),
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		existing []runtime.Object
+		// reactor, if set, is prepended to the fake client to simulate
+		// concurrent writers
+		reactor func(client *fake.Clientset) coretesting.ReactionFunc
+
+		expectedSerial  uint64
+		expectedErr     error
+		expectedCounter uint64
+	}{
+		"should create the counter if it does not exist": {
+			expectedSerial:  1,
+			expectedCounter: 1,
+		},
+		"should increment an existing counter": {
+			existing:        []runtime.Object{counterSecret(41)},
+			expectedSerial:  42,
+			expectedCounter: 42,
+		},
+		"should retry and not reuse a serial number allocated by a concurrent update": {
+			existing: []runtime.Object{counterSecret(41)},
+			reactor: func(client *fake.Clientset) coretesting.ReactionFunc {
+				conflicted := false
+				return func(action coretesting.Action) (bool, runtime.Object, error) {
+					if conflicted {
+						return false, nil, nil
+					}
+					conflicted = true
+					// another signer allocates serial 42 before our update lands
+					if err := client.Tracker().Update(secretsGVR, counterSecret(42), "default"); err != nil {
+						t.Fatal(err)
+					}
+					return true, nil, apierrors.NewConflict(schema.GroupResource{Resource: "secrets"}, "ca-secret-serial-number", errors.New("the object has been modified"))
+				}
+			},
+			expectedSerial:  43,
+			expectedCounter: 43,
+		},
+		"should retry if the counter is created concurrently": {
+			reactor: func(client *fake.Clientset) coretesting.ReactionFunc {
+				return func(action coretesting.Action) (bool, runtime.Object, error) {
+					if action.GetVerb() != "create" {
+						return false, nil, nil
+					}
+					// another signer creates the counter before us
+					if err := client.Tracker().Add(counterSecret(1)); err != nil {
+						t.Fatal(err)
+					}
+					return true, nil, apierrors.NewAlreadyExists(schema.GroupResource{Resource: "secrets"}, "ca-secret-serial-number")
+				}
+			},
+			expectedSerial:  2,
+			expectedCounter: 2,
+		},
+		"should refuse to wrap around once the counter is exhausted": {
+			existing:        []runtime.Object{counterSecret(math.MaxUint64)},
+			expectedErr:     ErrSerialNumbersExhausted,
+			expectedCounter: math.MaxUint64,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := fake.NewSimpleClientset(test.existing...)
+			if test.reactor != nil {
+				reactor := test.reactor(client)
+				client.PrependReactor("*", "secrets", func(action coretesting.Action) (bool, runtime.Object, error) {
+					if action.GetVerb() != "update" && action.GetVerb() != "create" {
+						return false, nil, nil
+					}
+					return reactor(action)
+				})
+			}
+
+			serial, err := NextSequentialSerialNumber(context.Background(), client.CoreV1(), "default", "ca-secret")
+			if !errors.Is(err, test.expectedErr) {
+				t.Fatalf("expected error %v, got %v", test.expectedErr, err)
+			}
+			if err == nil && serial.Uint64() != test.expectedSerial {
+				t.Errorf("expected serial number %d, got %s", test.expectedSerial, serial)
+			}
+
+			secret, err := client.CoreV1().Secrets("default").Get(context.Background(), "ca-secret-serial-number", metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := string(secret.Data["serial"]); got != strconv.FormatUint(test.expectedCounter, 10) {
+				t.Errorf("expected persisted counter %d, got %s", test.expectedCounter, got)
+			}
+		})
+	}
+}
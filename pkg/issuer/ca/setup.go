@@ -18,6 +18,10 @@ package ca
 
 import (
 	"context"
+	"crypto"
+	"crypto/x509"
+	"fmt"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 
@@ -26,17 +30,28 @@ import (
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 	"github.com/cert-manager/cert-manager/pkg/util/kube"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
 )
 
 const (
 	errorGetKeyPair     = "ErrGetKeyPair"
 	errorInvalidKeyPair = "ErrInvalidKeyPair"
 
+	errorCAExpired       = "CAExpired"
+	errorCANotYetValid   = "CANotYetValid"
+	errorNotCA           = "NotCA"
+	errorMissingKeyUsage = "MissingKeyUsage"
+	errorKeyMismatch     = "KeyMismatch"
+
+	errorInvalidSignatureAlgorithm = "InvalidSignatureAlgorithm"
+
 	successKeyPairVerified = "KeyPairVerified"
+	successValidateOnly    = "ValidateOnly"
 
 	messageErrorGetKeyPair = "Error getting keypair for CA issuer: "
 
 	messageKeyPairVerified = "Signing CA verified"
+	messageValidateOnly    = "Signing CA verified; the issuer is in validate-only mode and will not issue certificates"
 )
 
 // Setup verifies signing CA.
@@ -51,8 +66,9 @@ func (c *CA) Setup(ctx context.Context) error {
 		apiutil.SetIssuerCondition(c.issuer, c.issuer.GetGeneration(), v1.IssuerConditionReady, cmmeta.ConditionFalse, errorGetKeyPair, s)
 		return err
 	}
+	c.Metrics.UpdateIssuerCAExpiry(c.issuer, cert.NotAfter)
 
-	_, err = kube.SecretTLSKey(ctx, c.secretsLister, c.resourceNamespace, c.issuer.GetSpec().CA.SecretName)
+	key, err := kube.SecretTLSKey(ctx, c.secretsLister, c.resourceNamespace, c.issuer.GetSpec().CA.SecretName)
 	if err != nil {
 		log.Error(err, "error getting signing CA private key")
 		s := messageErrorGetKeyPair + err.Error()
@@ -62,6 +78,21 @@ func (c *CA) Setup(ctx context.Context) error {
 	}
 
 	log = logf.WithRelatedResourceName(log, c.issuer.GetSpec().CA.SecretName, c.resourceNamespace, "Secret")
+	if c.issuer.GetSpec().CA.ValidateOnly {
+		if reason, err := c.validateKeyPair(cert, key); err != nil {
+			s := messageErrorGetKeyPair + err.Error()
+			log.Error(err, "signing CA failed validation")
+			c.Recorder.Event(c.issuer, corev1.EventTypeWarning, reason, s)
+			apiutil.SetIssuerCondition(c.issuer, c.issuer.GetGeneration(), v1.IssuerConditionReady, cmmeta.ConditionFalse, reason, s)
+			return nil
+		}
+
+		log.V(logf.DebugLevel).Info("signing CA verified in validate-only mode")
+		c.Recorder.Event(c.issuer, corev1.EventTypeNormal, successKeyPairVerified, messageKeyPairVerified)
+		apiutil.SetIssuerCondition(c.issuer, c.issuer.GetGeneration(), v1.IssuerConditionReady, cmmeta.ConditionFalse, successValidateOnly, messageValidateOnly)
+		return nil
+	}
+
 	if !cert.IsCA {
 		s := messageErrorGetKeyPair + "certificate is not a CA"
 		log.Error(nil, "signing certificate is not a CA")
@@ -71,9 +102,46 @@ func (c *CA) Setup(ctx context.Context) error {
 		return nil
 	}
 
+	if name := c.issuer.GetSpec().CA.SignatureAlgorithm; name != "" {
+		if _, err := signatureAlgorithmForKey(name, key); err != nil {
+			s := messageErrorGetKeyPair + err.Error()
+			log.Error(err, "signing CA private key cannot produce the configured signature algorithm")
+			c.Recorder.Event(c.issuer, corev1.EventTypeWarning, errorInvalidSignatureAlgorithm, s)
+			apiutil.SetIssuerCondition(c.issuer, c.issuer.GetGeneration(), v1.IssuerConditionReady, cmmeta.ConditionFalse, errorInvalidSignatureAlgorithm, s)
+			return nil
+		}
+	}
+
 	log.V(logf.DebugLevel).Info("signing CA verified")
 	c.Recorder.Event(c.issuer, corev1.EventTypeNormal, successKeyPairVerified, messageKeyPairVerified)
 	apiutil.SetIssuerCondition(c.issuer, c.issuer.GetGeneration(), v1.IssuerConditionReady, cmmeta.ConditionTrue, successKeyPairVerified, messageKeyPairVerified)
 
 	return nil
 }
+
+// validateKeyPair checks that the given CA certificate and private key form a
+// valid signing pair. If they do not, it returns the reason to report on the
+// issuer's Ready condition along with an error describing the problem.
+func (c *CA) validateKeyPair(cert *x509.Certificate, key crypto.Signer) (string, error) {
+	now := c.Clock.Now()
+	if now.After(cert.NotAfter) {
+		return errorCAExpired, fmt.Errorf("certificate expired at %s", cert.NotAfter.UTC().Format(time.RFC3339))
+	}
+	if now.Before(cert.NotBefore) {
+		return errorCANotYetValid, fmt.Errorf("certificate is not valid before %s", cert.NotBefore.UTC().Format(time.RFC3339))
+	}
+	if !cert.IsCA {
+		return errorNotCA, fmt.Errorf("certificate is not a CA")
+	}
+	if cert.KeyUsage&x509.KeyUsageCertSign == 0 {
+		return errorMissingKeyUsage, fmt.Errorf("certificate does not have the cert sign key usage")
+	}
+	matches, err := pki.PublicKeyMatchesCertificate(key.Public(), cert)
+	if err != nil {
+		return errorKeyMismatch, fmt.Errorf("failed to compare private key with certificate: %w", err)
+	}
+	if !matches {
+		return errorKeyMismatch, fmt.Errorf("private key does not match the certificate's public key")
+	}
+	return "", nil
+}
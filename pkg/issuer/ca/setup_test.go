@@ -0,0 +1,294 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ca
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/pkg/controller"
+	controllertest "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/pkg/metrics"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+	testlisters "github.com/cert-manager/cert-manager/test/unit/listers"
+)
+
+func TestCA_Setup(t *testing.T) {
+	now := time.Now()
+
+	caKey := mustGenerateKey(t)
+	otherKey := mustGenerateKey(t)
+
+	validCA := &x509.Certificate{
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+	}
+
+	tests := map[string]struct {
+		validateOnly       bool
+		signatureAlgorithm string
+		cert               *x509.Certificate
+		key                crypto.Signer
+
+		expectedReason string
+		expectedStatus cmmeta.ConditionStatus
+	}{
+		"should mark the issuer as ready if the CA is valid": {
+			cert:           validCA,
+			key:            caKey,
+			expectedReason: successKeyPairVerified,
+			expectedStatus: cmmeta.ConditionTrue,
+		},
+		"should not check the CA's expiry if validateOnly is not set": {
+			cert: func(c x509.Certificate) *x509.Certificate {
+				c.NotAfter = now.Add(-time.Minute)
+				return &c
+			}(*validCA),
+			key:            caKey,
+			expectedReason: successKeyPairVerified,
+			expectedStatus: cmmeta.ConditionTrue,
+		},
+		"should mark the issuer as ready if the CA key can produce the signature algorithm": {
+			signatureAlgorithm: "ECDSAWithSHA384",
+			cert:               validCA,
+			key:                caKey,
+			expectedReason:     successKeyPairVerified,
+			expectedStatus:     cmmeta.ConditionTrue,
+		},
+		"should report a signature algorithm which the CA key cannot produce": {
+			signatureAlgorithm: "SHA384WithRSA",
+			cert:               validCA,
+			key:                caKey,
+			expectedReason:     errorInvalidSignatureAlgorithm,
+			expectedStatus:     cmmeta.ConditionFalse,
+		},
+		"should report the CA as verified but not ready in validateOnly mode": {
+			validateOnly:   true,
+			cert:           validCA,
+			key:            caKey,
+			expectedReason: successValidateOnly,
+			expectedStatus: cmmeta.ConditionFalse,
+		},
+		"should report an expired CA in validateOnly mode": {
+			validateOnly: true,
+			cert: func(c x509.Certificate) *x509.Certificate {
+				c.NotAfter = now.Add(-time.Minute)
+				return &c
+			}(*validCA),
+			key:            caKey,
+			expectedReason: errorCAExpired,
+			expectedStatus: cmmeta.ConditionFalse,
+		},
+		"should report a CA which is not yet valid in validateOnly mode": {
+			validateOnly: true,
+			cert: func(c x509.Certificate) *x509.Certificate {
+				c.NotBefore = now.Add(time.Minute)
+				return &c
+			}(*validCA),
+			key:            caKey,
+			expectedReason: errorCANotYetValid,
+			expectedStatus: cmmeta.ConditionFalse,
+		},
+		"should report a certificate which is not a CA in validateOnly mode": {
+			validateOnly: true,
+			cert: func(c x509.Certificate) *x509.Certificate {
+				c.IsCA = false
+				return &c
+			}(*validCA),
+			key:            caKey,
+			expectedReason: errorNotCA,
+			expectedStatus: cmmeta.ConditionFalse,
+		},
+		"should report a CA without the cert sign key usage in validateOnly mode": {
+			validateOnly: true,
+			cert: func(c x509.Certificate) *x509.Certificate {
+				c.KeyUsage = x509.KeyUsageDigitalSignature
+				return &c
+			}(*validCA),
+			key:            caKey,
+			expectedReason: errorMissingKeyUsage,
+			expectedStatus: cmmeta.ConditionFalse,
+		},
+		"should report a private key which does not match the CA in validateOnly mode": {
+			validateOnly:   true,
+			cert:           validCA,
+			key:            otherKey,
+			expectedReason: errorKeyMismatch,
+			expectedStatus: cmmeta.ConditionFalse,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "ca-secret", Namespace: "default"},
+				Data: map[string][]byte{
+					corev1.TLSCertKey:       mustSelfSign(t, test.cert, caKey),
+					corev1.TLSPrivateKeyKey: mustEncodeKey(t, test.key),
+				},
+			}
+
+			issuer := gen.Issuer("ca-issuer",
+				gen.SetIssuerNamespace("default"),
+				gen.SetIssuerCA(v1.CAIssuer{
+					SecretName:         "ca-secret",
+					ValidateOnly:       test.validateOnly,
+					SignatureAlgorithm: test.signatureAlgorithm,
+				}),
+			)
+
+			c := &CA{
+				Context: &controller.Context{
+					Recorder: new(controllertest.FakeRecorder),
+					ContextOptions: controller.ContextOptions{
+						Clock:   fakeclock.NewFakeClock(now),
+						Metrics: metrics.New(logr.Discard(), clock.RealClock{}),
+					},
+				},
+				issuer:            issuer,
+				secretsLister:     testlisters.NewFakeSecretLister(testlisters.SetFakeSecretNamespaceListerGet(secret, nil)),
+				resourceNamespace: "default",
+			}
+
+			if err := c.Setup(context.Background()); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			conditions := issuer.GetStatus().Conditions
+			if len(conditions) != 1 {
+				t.Fatalf("expected a single condition, got %+v", conditions)
+			}
+			if conditions[0].Reason != test.expectedReason || conditions[0].Status != test.expectedStatus {
+				t.Errorf("expected Ready condition %s with reason %q, got %s with reason %q (%s)",
+					test.expectedStatus, test.expectedReason, conditions[0].Status, conditions[0].Reason, conditions[0].Message)
+			}
+		})
+	}
+}
+
+func TestCA_SetupCAExpiryMetric(t *testing.T) {
+	now := time.Now()
+	notAfter := now.Add(90 * 24 * time.Hour).Truncate(time.Second)
+
+	caKey := mustGenerateKey(t)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "ca-secret", Namespace: "default"},
+		Data: map[string][]byte{
+			corev1.TLSCertKey: mustSelfSign(t, &x509.Certificate{
+				NotBefore:             now.Add(-time.Hour),
+				NotAfter:              notAfter,
+				IsCA:                  true,
+				BasicConstraintsValid: true,
+				KeyUsage:              x509.KeyUsageCertSign,
+			}, caKey),
+			corev1.TLSPrivateKeyKey: mustEncodeKey(t, caKey),
+		},
+	}
+
+	m := metrics.New(logr.Discard(), clock.RealClock{})
+	c := &CA{
+		Context: &controller.Context{
+			Recorder: new(controllertest.FakeRecorder),
+			ContextOptions: controller.ContextOptions{
+				Clock:   fakeclock.NewFakeClock(now),
+				Metrics: m,
+			},
+		},
+		issuer: gen.Issuer("ca-issuer",
+			gen.SetIssuerNamespace("default"),
+			gen.SetIssuerCA(v1.CAIssuer{SecretName: "ca-secret"}),
+		),
+		secretsLister:     testlisters.NewFakeSecretLister(testlisters.SetFakeSecretNamespaceListerGet(secret, nil)),
+		resourceNamespace: "default",
+	}
+
+	if err := c.Setup(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := testutil.GatherAndCompare(m.Gatherer(), strings.NewReader(fmt.Sprintf(`
+	# HELP certmanager_issuer_ca_expiration_timestamp_seconds The date after which the CA certificate of the issuer expires. Expressed as a Unix Epoch Time.
+	# TYPE certmanager_issuer_ca_expiration_timestamp_seconds gauge
+	certmanager_issuer_ca_expiration_timestamp_seconds{kind="Issuer",name="ca-issuer",namespace="default"} %s
+`, strconv.FormatFloat(float64(notAfter.Unix()), 'g', -1, 64))),
+		"certmanager_issuer_ca_expiration_timestamp_seconds",
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+}
+
+func mustGenerateKey(t *testing.T) crypto.Signer {
+	t.Helper()
+	key, err := pki.GenerateECPrivateKey(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func mustEncodeKey(t *testing.T, key crypto.Signer) []byte {
+	t.Helper()
+	keyPEM, err := pki.EncodePKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return keyPEM
+}
+
+// mustSelfSign signs the given template with signer, using the public key of
+// signer as the certificate's public key, and returns the PEM encoded result.
+func mustSelfSign(t *testing.T, template *x509.Certificate, signer crypto.Signer) []byte {
+	t.Helper()
+	tmpl := *template
+	tmpl.SerialNumber = big.NewInt(1)
+	tmpl.Subject = pkix.Name{CommonName: "test-ca"}
+	der, err := x509.CreateCertificate(rand.Reader, &tmpl, &tmpl, signer.Public(), signer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPEM, err := pki.EncodeX509(cert)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return certPEM
+}
@@ -0,0 +1,238 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ca
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"fmt"
+	"slices"
+	"time"
+
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+// oidExtensionOCSPNoCheck is the OID of the id-pkix-ocsp-nocheck extension,
+// see https://www.rfc-editor.org/rfc/rfc6960#section-4.2.2.2.1.
+var oidExtensionOCSPNoCheck = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 48, 1, 5}
+
+// CAExpiryMargin is subtracted from the CA certificate's NotAfter when
+// clamping the expiry of a signed certificate, so that the signed certificate
+// never outlives its issuer.
+const CAExpiryMargin = time.Minute
+
+// ApplyCAExpiryPolicy enforces the issuer's CAExpiryPolicy on the given
+// certificate template. It returns true if the template's NotAfter was
+// clamped, and an error if the template must not be signed.
+func ApplyCAExpiryPolicy(spec *v1.CAIssuer, caCert *x509.Certificate, template *x509.Certificate) (bool, error) {
+	if !template.NotAfter.After(caCert.NotAfter) {
+		return false, nil
+	}
+
+	switch spec.CAExpiryPolicy {
+	case v1.CAExpiryPolicyReject:
+		return false, fmt.Errorf("requested NotAfter %s is after the CA certificate's NotAfter %s",
+			template.NotAfter.UTC().Format(time.RFC3339), caCert.NotAfter.UTC().Format(time.RFC3339))
+
+	case v1.CAExpiryPolicyClamp:
+		notAfter := caCert.NotAfter.Add(-CAExpiryMargin)
+		if !notAfter.After(template.NotBefore) {
+			return false, fmt.Errorf("the CA certificate expires at %s, which is too soon to issue a certificate",
+				caCert.NotAfter.UTC().Format(time.RFC3339))
+		}
+		template.NotAfter = notAfter
+		return true, nil
+
+	default:
+		return false, nil
+	}
+}
+
+// RenderCRLDistributionPoints renders the CRL distribution point templates
+// configured on a CA issuer for the certificate described by template, see
+// pki.RenderCRLDistributionPoint. The templates are validated by the webhook
+// when the issuer is created or updated, so an error is only returned for
+// issuers which were stored before the validation was added.
+func RenderCRLDistributionPoints(templates []string, issuerName string, template *x509.Certificate) ([]string, error) {
+	if len(templates) == 0 {
+		return nil, nil
+	}
+
+	var serial string
+	if template.SerialNumber != nil {
+		serial = template.SerialNumber.Text(16)
+	}
+
+	rendered := make([]string, 0, len(templates))
+	for _, tmpl := range templates {
+		crlURL, err := pki.RenderCRLDistributionPoint(tmpl, serial, issuerName)
+		if err != nil {
+			return nil, fmt.Errorf("CRL distribution point %q %w", tmpl, err)
+		}
+		rendered = append(rendered, crlURL)
+	}
+
+	return rendered, nil
+}
+
+// CopyAllowedExtraExtensions copies the extensions of the PEM encoded
+// certificate signing request whose OIDs are in allowed into the ExtraExtensions
+// of template. Extensions which are already present on the template are not
+// copied again. An error is returned if an extension which cert-manager
+// computes itself, such as basicConstraints or subjectAltName, is allowed,
+// since copying it would override the value cert-manager has validated.
+func CopyAllowedExtraExtensions(allowed []string, csrPEM []byte, template *x509.Certificate) error {
+	if len(allowed) == 0 {
+		return nil
+	}
+
+	allowedOIDs := make(map[string]struct{}, len(allowed))
+	for _, oidString := range allowed {
+		oid, err := pki.ParseObjectIdentifier(oidString)
+		if err != nil {
+			return fmt.Errorf("invalid allowed extension OID %q: %w", oidString, err)
+		}
+		if pki.IsManagedExtension(oid) {
+			return fmt.Errorf("extension %q is managed by cert-manager and cannot be copied from the certificate request", oidString)
+		}
+		allowedOIDs[oid.String()] = struct{}{}
+	}
+
+	csr, err := pki.DecodeX509CertificateRequestBytes(csrPEM)
+	if err != nil {
+		return err
+	}
+
+	present := make(map[string]struct{}, len(template.ExtraExtensions))
+	for _, ext := range template.ExtraExtensions {
+		present[ext.Id.String()] = struct{}{}
+	}
+
+	for _, ext := range csr.Extensions {
+		id := ext.Id.String()
+		if _, ok := allowedOIDs[id]; !ok {
+			continue
+		}
+		if _, ok := present[id]; ok {
+			continue
+		}
+		template.ExtraExtensions = append(template.ExtraExtensions, ext)
+		present[id] = struct{}{}
+	}
+
+	return nil
+}
+
+// ApplySignatureAlgorithm sets the signature algorithm of the given
+// certificate template to the issuer's SignatureAlgorithm, if one is
+// configured. An error is returned if the signature algorithm is not known or
+// cannot be produced by the CA's private key.
+func ApplySignatureAlgorithm(spec *v1.CAIssuer, caKey crypto.Signer, template *x509.Certificate) error {
+	if spec.SignatureAlgorithm == "" {
+		return nil
+	}
+
+	sigAlgo, err := signatureAlgorithmForKey(spec.SignatureAlgorithm, caKey)
+	if err != nil {
+		return err
+	}
+
+	template.SignatureAlgorithm = sigAlgo
+	return nil
+}
+
+// signatureAlgorithmForKey returns the x509 signature algorithm with the
+// given name, or an error if it is not known or cannot be produced by the
+// given key.
+func signatureAlgorithmForKey(name string, key crypto.Signer) (x509.SignatureAlgorithm, error) {
+	sigAlgo, pubKeyAlgo, ok := pki.SignatureAlgorithmByName(name)
+	if !ok {
+		return x509.UnknownSignatureAlgorithm, fmt.Errorf("unsupported signature algorithm %q", name)
+	}
+
+	var keyAlgo x509.PublicKeyAlgorithm
+	switch key.Public().(type) {
+	case *rsa.PublicKey:
+		keyAlgo = x509.RSA
+	case *ecdsa.PublicKey:
+		keyAlgo = x509.ECDSA
+	case ed25519.PublicKey:
+		keyAlgo = x509.Ed25519
+	}
+	if keyAlgo != pubKeyAlgo {
+		return x509.UnknownSignatureAlgorithm, fmt.Errorf("signature algorithm %q requires a %s CA private key, but the CA private key is of type %T", name, pubKeyAlgo, key.Public())
+	}
+
+	return sigAlgo, nil
+}
+
+// ApplyAuthorityKeyIdentifier sets the authority key identifier extension of
+// the given certificate template to the issuer's AuthorityKeyIdentifier, if
+// one is configured. Otherwise the template is left untouched, and the
+// authority key identifier is computed from the CA certificate when signing.
+func ApplyAuthorityKeyIdentifier(spec *v1.CAIssuer, template *x509.Certificate) error {
+	if spec.AuthorityKeyIdentifier == "" {
+		return nil
+	}
+
+	keyID, err := pki.ParseKeyIdentifier(spec.AuthorityKeyIdentifier)
+	if err != nil {
+		return fmt.Errorf("invalid authority key identifier %q: %w", spec.AuthorityKeyIdentifier, err)
+	}
+
+	// crypto/x509 always uses the parent's subject key identifier if it has
+	// one, so the extension is set explicitly, which takes precedence.
+	ext, err := pki.MarshalAuthorityKeyIdentifier(keyID)
+	if err != nil {
+		return err
+	}
+
+	var extensions []pkix.Extension
+	for _, e := range template.ExtraExtensions {
+		if !e.Id.Equal(pki.OIDExtensionAuthorityKeyId) {
+			extensions = append(extensions, e)
+		}
+	}
+	template.ExtraExtensions = append(extensions, ext)
+	template.AuthorityKeyId = keyID
+	return nil
+}
+
+// ApplyOCSPNoCheck adds the id-pkix-ocsp-nocheck extension to the given
+// certificate template if it has the OCSP signing extended key usage, so that
+// clients do not check the revocation status of delegated OCSP responders.
+func ApplyOCSPNoCheck(template *x509.Certificate) {
+	if !slices.Contains(template.ExtKeyUsage, x509.ExtKeyUsageOCSPSigning) {
+		return
+	}
+	for _, ext := range template.ExtraExtensions {
+		if ext.Id.Equal(oidExtensionOCSPNoCheck) {
+			return
+		}
+	}
+
+	template.ExtraExtensions = append(template.ExtraExtensions, pkix.Extension{
+		Id:    oidExtensionOCSPNoCheck,
+		Value: asn1.NullBytes,
+	})
+}
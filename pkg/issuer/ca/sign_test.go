@@ -0,0 +1,464 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ca
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+func TestRenderCRLDistributionPoints(t *testing.T) {
+	template := &x509.Certificate{SerialNumber: big.NewInt(0xabc123)}
+
+	tests := map[string]struct {
+		templates []string
+		expected  []string
+		expectErr bool
+	}{
+		"should return nil if no distribution points are configured": {},
+		"should leave static distribution points untouched": {
+			templates: []string{"http://crl.example.com/ca.crl"},
+			expected:  []string{"http://crl.example.com/ca.crl"},
+		},
+		"should substitute the serial number and issuer name": {
+			templates: []string{"http://crl.example.com/${issuerName}/${serial}.crl", "ldap:///cn=${issuerName}"},
+			expected:  []string{"http://crl.example.com/my-ca/abc123.crl", "ldap:///cn=my-ca"},
+		},
+		"should error on unknown variables": {
+			templates: []string{"http://crl.example.com/${namespace}.crl"},
+			expectErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := RenderCRLDistributionPoints(test.templates, "my-ca", template)
+			if (err != nil) != test.expectErr {
+				t.Fatalf("expected error=%t, got %v", test.expectErr, err)
+			}
+			if !reflect.DeepEqual(got, test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestCopyAllowedExtraExtensions(t *testing.T) {
+	allowedExt := pkix.Extension{Id: asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 99999, 1}, Value: []byte{0x04, 0x01, 0x01}}
+	otherExt := pkix.Extension{Id: asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 99999, 2}, Value: []byte{0x04, 0x01, 0x02}}
+
+	key := mustGenerateKey(t)
+	csrDER, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:         pkix.Name{CommonName: "device"},
+		ExtraExtensions: []pkix.Extension{allowedExt, otherExt},
+	}, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	csrPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDER})
+
+	caExt, err := pki.MarshalBasicConstraints(true, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	smugglingCSRDER, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:         pkix.Name{CommonName: "device"},
+		DNSNames:        []string{"unapproved.example.com"},
+		ExtraExtensions: []pkix.Extension{caExt, allowedExt},
+	}, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	smugglingCSRPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: smugglingCSRDER})
+
+	tests := map[string]struct {
+		allowed   []string
+		csrPEM    []byte
+		expected  []string
+		expectErr bool
+	}{
+		"should drop all extensions if none are allowed": {},
+		"should copy allowed extensions and drop all others": {
+			allowed:  []string{"1.3.6.1.4.1.99999.1"},
+			expected: []string{"1.3.6.1.4.1.99999.1"},
+		},
+		"should ignore allowed extensions which are not in the request": {
+			allowed:  []string{"1.3.6.1.4.1.99999.1", "1.3.6.1.4.1.99999.3"},
+			expected: []string{"1.3.6.1.4.1.99999.1"},
+		},
+		"should refuse to copy a basicConstraints extension from the request": {
+			allowed:   []string{"1.3.6.1.4.1.99999.1", "2.5.29.19"},
+			csrPEM:    smugglingCSRPEM,
+			expectErr: true,
+		},
+		"should refuse to copy a subjectAltName extension from the request": {
+			allowed:   []string{"2.5.29.17"},
+			csrPEM:    smugglingCSRPEM,
+			expectErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			template := &x509.Certificate{
+				SerialNumber: big.NewInt(1),
+				Subject:      pkix.Name{CommonName: "device"},
+				NotBefore:    time.Now(),
+				NotAfter:     time.Now().Add(time.Hour),
+				PublicKey:    key.Public(),
+			}
+			request := csrPEM
+			if test.csrPEM != nil {
+				request = test.csrPEM
+			}
+			err := CopyAllowedExtraExtensions(test.allowed, request, template)
+			if (err != nil) != test.expectErr {
+				t.Fatalf("expected error=%t, got %v", test.expectErr, err)
+			}
+			if test.expectErr {
+				if len(template.ExtraExtensions) > 0 {
+					t.Errorf("expected no extensions to be copied, got %v", template.ExtraExtensions)
+				}
+				return
+			}
+
+			// sign the template to ensure the extensions end up in the
+			// issued certificate
+			_, cert, err := pki.SignCertificate(template, template, key.Public(), key)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for _, ext := range cert.Extensions {
+				if ext.Id.Equal(allowedExt.Id) || ext.Id.Equal(otherExt.Id) {
+					got = append(got, ext.Id.String())
+				}
+			}
+			if !reflect.DeepEqual(got, test.expected) {
+				t.Errorf("expected extensions %v in the signed certificate, got %v", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestApplySignatureAlgorithm(t *testing.T) {
+	rsaKey, err := pki.GenerateRSAPrivateKey(2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := pki.GenerateECPrivateKey(pki.ECCurve384)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		signatureAlgorithm string
+		caKey              crypto.Signer
+		expected           x509.SignatureAlgorithm
+		expectErr          bool
+	}{
+		"should derive the signature algorithm from an RSA key if not set": {
+			caKey:    rsaKey,
+			expected: x509.SHA256WithRSA,
+		},
+		"should sign with SHA384WithRSA": {
+			signatureAlgorithm: "SHA384WithRSA",
+			caKey:              rsaKey,
+			expected:           x509.SHA384WithRSA,
+		},
+		"should sign with SHA512WithRSAPSS": {
+			signatureAlgorithm: "SHA512WithRSAPSS",
+			caKey:              rsaKey,
+			expected:           x509.SHA512WithRSAPSS,
+		},
+		"should sign with ECDSAWithSHA384": {
+			signatureAlgorithm: "ECDSAWithSHA384",
+			caKey:              ecKey,
+			expected:           x509.ECDSAWithSHA384,
+		},
+		"should error if the signature algorithm does not match the CA key type": {
+			signatureAlgorithm: "ECDSAWithSHA384",
+			caKey:              rsaKey,
+			expectErr:          true,
+		},
+		"should error on unknown signature algorithms": {
+			signatureAlgorithm: "SHA1WithRSA",
+			caKey:              rsaKey,
+			expectErr:          true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			caTemplate := &x509.Certificate{
+				SerialNumber:          big.NewInt(1),
+				Subject:               pkix.Name{CommonName: "ca"},
+				NotBefore:             time.Now(),
+				NotAfter:              time.Now().Add(time.Hour),
+				IsCA:                  true,
+				BasicConstraintsValid: true,
+				KeyUsage:              x509.KeyUsageCertSign,
+			}
+			_, caCert, err := pki.SignCertificate(caTemplate, caTemplate, test.caKey.Public(), test.caKey)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			leafKey, err := pki.GenerateECPrivateKey(pki.ECCurve256)
+			if err != nil {
+				t.Fatal(err)
+			}
+			template := &x509.Certificate{
+				SerialNumber: big.NewInt(2),
+				Subject:      pkix.Name{CommonName: "leaf"},
+				NotBefore:    time.Now(),
+				NotAfter:     time.Now().Add(time.Hour),
+				PublicKey:    leafKey.Public(),
+			}
+
+			err = ApplySignatureAlgorithm(&v1.CAIssuer{SignatureAlgorithm: test.signatureAlgorithm}, test.caKey, template)
+			if test.expectErr != (err != nil) {
+				t.Fatalf("expected error %t but got: %v", test.expectErr, err)
+			}
+			if err != nil {
+				return
+			}
+
+			bundle, err := pki.SignCSRTemplate([]*x509.Certificate{caCert}, test.caKey, template)
+			if err != nil {
+				t.Fatal(err)
+			}
+			cert, err := pki.DecodeX509CertificateBytes(bundle.ChainPEM)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if cert.SignatureAlgorithm != test.expected {
+				t.Errorf("expected issued certificate to be signed with %s but got %s", test.expected, cert.SignatureAlgorithm)
+			}
+		})
+	}
+}
+
+func TestApplyAuthorityKeyIdentifier(t *testing.T) {
+	caKey, err := pki.GenerateECPrivateKey(pki.ECCurve256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caSubjectKeyID := []byte{0x01, 0x02, 0x03, 0x04}
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ca"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		SubjectKeyId:          caSubjectKeyID,
+	}
+	_, caCert, err := pki.SignCertificate(caTemplate, caTemplate, caKey.Public(), caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		authorityKeyIdentifier string
+		extraExtensions        []pkix.Extension
+		expected               []byte
+		expectErr              bool
+	}{
+		"should use the CA's subject key identifier if not set": {
+			expected: caSubjectKeyID,
+		},
+		"should use the configured authority key identifier": {
+			authorityKeyIdentifier: "0a0b0c0d0e0f",
+			expected:               []byte{0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f},
+		},
+		"should accept colon separated bytes": {
+			authorityKeyIdentifier: "AA:BB:CC",
+			expected:               []byte{0xaa, 0xbb, 0xcc},
+		},
+		"should replace an authority key identifier copied from the request": {
+			authorityKeyIdentifier: "0a0b",
+			extraExtensions: []pkix.Extension{
+				{Id: pki.OIDExtensionAuthorityKeyId, Value: mustMarshalAuthorityKeyIdentifier(t, []byte{0xff})},
+			},
+			expected: []byte{0x0a, 0x0b},
+		},
+		"should error on an invalid authority key identifier": {
+			authorityKeyIdentifier: "not-hex",
+			expectErr:              true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			leafKey, err := pki.GenerateECPrivateKey(pki.ECCurve256)
+			if err != nil {
+				t.Fatal(err)
+			}
+			template := &x509.Certificate{
+				SerialNumber:    big.NewInt(2),
+				Subject:         pkix.Name{CommonName: "leaf"},
+				NotBefore:       time.Now(),
+				NotAfter:        time.Now().Add(time.Hour),
+				PublicKey:       leafKey.Public(),
+				ExtraExtensions: test.extraExtensions,
+			}
+
+			err = ApplyAuthorityKeyIdentifier(&v1.CAIssuer{AuthorityKeyIdentifier: test.authorityKeyIdentifier}, template)
+			if test.expectErr != (err != nil) {
+				t.Fatalf("expected error %t but got: %v", test.expectErr, err)
+			}
+			if err != nil {
+				return
+			}
+
+			bundle, err := pki.SignCSRTemplate([]*x509.Certificate{caCert}, caKey, template)
+			if err != nil {
+				t.Fatal(err)
+			}
+			cert, err := pki.DecodeX509CertificateBytes(bundle.ChainPEM)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var akiExtensions []pkix.Extension
+			for _, ext := range cert.Extensions {
+				if ext.Id.Equal(pki.OIDExtensionAuthorityKeyId) {
+					akiExtensions = append(akiExtensions, ext)
+				}
+			}
+			if len(akiExtensions) != 1 {
+				t.Fatalf("expected a single authority key identifier extension, got %d", len(akiExtensions))
+			}
+			keyID, err := pki.UnmarshalAuthorityKeyIdentifier(akiExtensions[0].Value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(keyID, test.expected) {
+				t.Errorf("expected authority key identifier %x but got %x", test.expected, keyID)
+			}
+		})
+	}
+}
+
+func mustMarshalAuthorityKeyIdentifier(t *testing.T, keyID []byte) []byte {
+	t.Helper()
+	ext, err := pki.MarshalAuthorityKeyIdentifier(keyID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ext.Value
+}
+
+func TestApplyOCSPNoCheck(t *testing.T) {
+	caKey, err := pki.GenerateECPrivateKey(pki.ECCurve256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ca"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	_, caCert, err := pki.SignCertificate(caTemplate, caTemplate, caKey.Public(), caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		extKeyUsage     []x509.ExtKeyUsage
+		expectedNoCheck bool
+	}{
+		"should add the ocsp nocheck extension to OCSP signing certificates": {
+			extKeyUsage:     []x509.ExtKeyUsage{x509.ExtKeyUsageOCSPSigning},
+			expectedNoCheck: true,
+		},
+		"should not add the ocsp nocheck extension to other certificates": {
+			extKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			leafKey, err := pki.GenerateECPrivateKey(pki.ECCurve256)
+			if err != nil {
+				t.Fatal(err)
+			}
+			template := &x509.Certificate{
+				SerialNumber: big.NewInt(2),
+				Subject:      pkix.Name{CommonName: "ocsp-responder"},
+				NotBefore:    time.Now(),
+				NotAfter:     time.Now().Add(time.Hour),
+				PublicKey:    leafKey.Public(),
+				ExtKeyUsage:  test.extKeyUsage,
+			}
+
+			ApplyOCSPNoCheck(template)
+			// Applying twice must not duplicate the extension.
+			ApplyOCSPNoCheck(template)
+
+			bundle, err := pki.SignCSRTemplate([]*x509.Certificate{caCert}, caKey, template)
+			if err != nil {
+				t.Fatal(err)
+			}
+			cert, err := pki.DecodeX509CertificateBytes(bundle.ChainPEM)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var noCheck []pkix.Extension
+			for _, ext := range cert.Extensions {
+				if ext.Id.Equal(oidExtensionOCSPNoCheck) {
+					noCheck = append(noCheck, ext)
+				}
+			}
+			if !test.expectedNoCheck {
+				if len(noCheck) != 0 {
+					t.Errorf("expected no ocsp nocheck extension, got %v", noCheck)
+				}
+				return
+			}
+
+			if len(noCheck) != 1 {
+				t.Fatalf("expected a single ocsp nocheck extension, got %d", len(noCheck))
+			}
+			if !reflect.DeepEqual(noCheck[0].Value, asn1.NullBytes) || noCheck[0].Critical {
+				t.Errorf("expected a non-critical ocsp nocheck extension with a NULL value, got %+v", noCheck[0])
+			}
+			if !reflect.DeepEqual(cert.ExtKeyUsage, []x509.ExtKeyUsage{x509.ExtKeyUsageOCSPSigning}) {
+				t.Errorf("expected the OCSP signing extended key usage, got %v", cert.ExtKeyUsage)
+			}
+		})
+	}
+}
@@ -42,3 +42,26 @@ func (i *Issuer) Setup(ctx context.Context) error {
 func (i *Issuer) Issue(ctx context.Context, crt *cmapi.Certificate) (*issuer.IssueResponse, error) {
 	return i.IssueFunc(ctx, crt)
 }
+
+// FinalizerIssuer is a fake issuer which also implements issuer.Finalizer.
+type FinalizerIssuer struct {
+	Issuer
+
+	FinalizerName     string
+	FinalizerRequired bool
+	FinalizeFunc      func(context.Context) error
+}
+
+var _ issuer.Finalizer = &FinalizerIssuer{}
+
+// Finalizer returns the name of the finalizer used by the issuer, and
+// whether the issuer resource currently requires it.
+func (i *FinalizerIssuer) Finalizer() (string, bool) {
+	return i.FinalizerName, i.FinalizerRequired
+}
+
+// Finalize cleans up state held by the issuer when the issuer resource is
+// being deleted.
+func (i *FinalizerIssuer) Finalize(ctx context.Context) error {
+	return i.FinalizeFunc(ctx)
+}
@@ -27,6 +27,19 @@ type Interface interface {
 	Setup(ctx context.Context) error
 }
 
+// Finalizer is implemented by issuers which need to clean up state held with
+// an external service before the issuer resource is removed.
+type Finalizer interface {
+	// Finalizer returns the name of the finalizer used by the issuer, and
+	// whether the issuer resource currently requires it.
+	Finalizer() (name string, required bool)
+
+	// Finalize is called when the issuer resource is being deleted and has
+	// the issuer's finalizer. The finalizer is removed once Finalize returns
+	// without an error.
+	Finalize(ctx context.Context) error
+}
+
 type IssueResponse struct {
 	// Certificate is the certificate resource that should be stored in the
 	// target secret.
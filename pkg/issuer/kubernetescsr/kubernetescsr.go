@@ -0,0 +1,71 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetescsr
+
+import (
+	"context"
+	"fmt"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/issuer"
+)
+
+const (
+	errorIssuerKindNotSupported = "IssuerKindNotSupported"
+
+	successSignerConfigured = "SignerConfigured"
+)
+
+// KubernetesCSR is an Issuer implementation which signs certificates by
+// creating Kubernetes CertificateSigningRequests for a signer running in the
+// cluster.
+// The signing itself is performed by the CertificateRequest controller.
+type KubernetesCSR struct {
+	*controller.Context
+	issuer v1.GenericIssuer
+}
+
+func NewKubernetesCSR(ctx *controller.Context, issuer v1.GenericIssuer) (issuer.Interface, error) {
+	return &KubernetesCSR{
+		Context: ctx,
+		issuer:  issuer,
+	}, nil
+}
+
+func init() {
+	issuer.RegisterIssuer(apiutil.IssuerKubernetesCSR, NewKubernetesCSR)
+}
+
+// Setup marks the issuer as ready. There is no way to check whether the
+// configured signer exists or will approve requests without submitting a
+// CertificateSigningRequest to it.
+// Namespaced Issuers are never marked as ready, since the
+// CertificateSigningRequests are created with the controller's identity.
+func (k *KubernetesCSR) Setup(ctx context.Context) error {
+	if _, ok := k.issuer.(*v1.ClusterIssuer); !ok {
+		apiutil.SetIssuerCondition(k.issuer, k.issuer.GetGeneration(), v1.IssuerConditionReady, cmmeta.ConditionFalse, errorIssuerKindNotSupported,
+			"The kubernetesCSR issuer type is only supported by ClusterIssuers")
+		return nil
+	}
+
+	msg := fmt.Sprintf("Signing certificates using Kubernetes signer %q", k.issuer.GetSpec().KubernetesCSR.SignerName)
+	apiutil.SetIssuerCondition(k.issuer, k.issuer.GetGeneration(), v1.IssuerConditionReady, cmmeta.ConditionTrue, successSignerConfigured, msg)
+	return nil
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package selfsigned
+
+import (
+	"crypto/x509"
+	"fmt"
+
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+// ParentCertificate returns the certificate which should be passed as the
+// parent when self-signing the given template. If the issuer has an IssuerDN
+// configured, the returned certificate is a copy of the template whose
+// subject is that DN, so that the signed certificate's Issuer differs from its
+// Subject. Otherwise, the template itself is returned.
+func ParentCertificate(spec *v1.SelfSignedIssuer, template *x509.Certificate) (*x509.Certificate, error) {
+	if spec == nil || spec.IssuerDN == "" {
+		return template, nil
+	}
+
+	rdnSequence, err := pki.UnmarshalSubjectStringToRDNSequence(spec.IssuerDN)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse issuerDN %q: %w", spec.IssuerDN, err)
+	}
+
+	rawIssuer, err := pki.MarshalRDNSequenceToRawDERBytes(rdnSequence)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode issuerDN %q: %w", spec.IssuerDN, err)
+	}
+
+	parent := *template
+	parent.RawSubject = rawIssuer
+	return &parent, nil
+}
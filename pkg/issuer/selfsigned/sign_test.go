@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package selfsigned
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+func TestParentCertificate(t *testing.T) {
+	pk, err := pki.GenerateECPrivateKey(pki.ECCurve256)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "leaf"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+		PublicKey:    pk.Public(),
+	}
+
+	tests := map[string]struct {
+		spec           *v1.SelfSignedIssuer
+		expectedIssuer string
+		expectErr      bool
+	}{
+		"no spec uses the subject as the issuer": {
+			spec:           nil,
+			expectedIssuer: "CN=leaf",
+		},
+		"empty issuerDN uses the subject as the issuer": {
+			spec:           &v1.SelfSignedIssuer{},
+			expectedIssuer: "CN=leaf",
+		},
+		"issuerDN is set as the issuer": {
+			spec:           &v1.SelfSignedIssuer{IssuerDN: "CN=Example Root,O=Example Org,C=GB"},
+			expectedIssuer: "CN=Example Root,O=Example Org,C=GB",
+		},
+		"unparseable issuerDN returns an error": {
+			spec:      &v1.SelfSignedIssuer{IssuerDN: "CN=Example Root,O"},
+			expectErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			parent, err := ParentCertificate(test.spec, template)
+			if (err != nil) != test.expectErr {
+				t.Fatalf("unexpected error, exp=%t, got=%v", test.expectErr, err)
+			}
+			if test.expectErr {
+				return
+			}
+
+			_, cert, err := pki.SignCertificate(template, parent, pk.Public(), pk)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := cert.Issuer.String(); got != test.expectedIssuer {
+				t.Errorf("unexpected issuer, exp=%q, got=%q", test.expectedIssuer, got)
+			}
+			if got := cert.Subject.String(); got != "CN=leaf" {
+				t.Errorf("subject should not be modified, got=%q", got)
+			}
+			if err := cert.CheckSignature(cert.SignatureAlgorithm, cert.RawTBSCertificate, cert.Signature); err != nil {
+				t.Errorf("certificate should be signed by its own key: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,250 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signingwebhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/cert-manager/cert-manager/internal/controller/feature"
+	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	cmerrors "github.com/cert-manager/cert-manager/pkg/util/errors"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+const (
+	// requestTimeout is the maximum amount of time a single request to the
+	// signing webhook may take.
+	requestTimeout = 30 * time.Second
+
+	// maxResponseSize limits how much of the signing webhook's response is
+	// read, to protect the controller against misbehaving webhooks.
+	maxResponseSize = 1 << 20
+)
+
+// ErrPublicKeyMismatch is returned by Sign if the signing webhook responds
+// with a certificate for a different public key than the one in the request.
+var ErrPublicKeyMismatch = errors.New("the public key of the signed certificate does not match the certificate signing request")
+
+// SignRequest is the body of the request POSTed to the signing webhook.
+type SignRequest struct {
+	// Request is the PEM-encoded x509 certificate signing request to be
+	// signed.
+	Request []byte `json:"request"`
+
+	// Duration is the requested validity duration of the certificate, e.g.
+	// "2160h0m0s". The signing webhook may choose to issue a certificate
+	// with a different validity.
+	Duration string `json:"duration,omitempty"`
+
+	// IsCA is set if the requested certificate is for a CA.
+	IsCA bool `json:"isCA,omitempty"`
+
+	// Usages is the set of requested key usages.
+	Usages []cmapi.KeyUsage `json:"usages,omitempty"`
+}
+
+// SignResponse is the body of a successful response from the signing webhook.
+type SignResponse struct {
+	// Certificate is the PEM-encoded signed certificate, optionally followed
+	// by the chain of intermediate certificates.
+	Certificate []byte `json:"certificate"`
+
+	// CA is the PEM-encoded CA certificate at the root of the chain, if known.
+	CA []byte `json:"ca,omitempty"`
+}
+
+// StatusError is returned when the signing webhook responds with an
+// unsuccessful HTTP status code.
+type StatusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("signing webhook responded with status %d: %s", e.StatusCode, e.Body)
+}
+
+// Permanent returns true if the signing webhook rejected the request itself,
+// meaning that retrying the same request would fail again.
+func (e *StatusError) Permanent() bool {
+	return e.StatusCode >= 400 && e.StatusCode < 500 &&
+		e.StatusCode != http.StatusRequestTimeout && e.StatusCode != http.StatusTooManyRequests
+}
+
+// Interface is a client for a signing webhook.
+type Interface interface {
+	Sign(ctx context.Context, req *SignRequest) (*SignResponse, error)
+}
+
+// ClientBuilder builds a signing webhook client for the given issuer, reading
+// any referenced Secrets from the given namespace.
+type ClientBuilder func(namespace string, secretsLister internalinformers.SecretLister, issuer cmapi.GenericIssuer) (Interface, error)
+
+// Client implements Interface by POSTing JSON encoded SignRequests to the
+// configured signing webhook over HTTPS.
+type Client struct {
+	url        string
+	httpClient *http.Client
+}
+
+var _ Interface = &Client{}
+
+// New returns a new signing webhook client for the given issuer.
+// The error returned by the Secrets lister is returned as is if a referenced
+// Secret cannot be read, and an InvalidData error is returned if the TLS
+// configuration cannot be parsed.
+func New(namespace string, secretsLister internalinformers.SecretLister, issuer cmapi.GenericIssuer) (Interface, error) {
+	cfg := issuer.GetSpec().SigningWebhook
+	if cfg == nil {
+		return nil, fmt.Errorf("issuer %q does not have a signingWebhook configuration", issuer.GetObjectMeta().Name)
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if len(cfg.CABundle) > 0 {
+		pool, ok := pki.CertPoolFromCABundle(cfg.CABundle, utilfeature.DefaultFeatureGate.Enabled(feature.CABundleIncludeSystemRoots))
+		if !ok {
+			return nil, cmerrors.NewInvalidData("signing webhook caBundle does not contain any valid certificates")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if cfg.ClientCertSecretRef != nil && cfg.ClientKeySecretRef != nil {
+		certPEM, err := secretData(secretsLister, namespace, cfg.ClientCertSecretRef, corev1.TLSCertKey)
+		if err != nil {
+			return nil, err
+		}
+		keyPEM, err := secretData(secretsLister, namespace, cfg.ClientKeySecretRef, corev1.TLSPrivateKeyKey)
+		if err != nil {
+			return nil, err
+		}
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, cmerrors.NewInvalidData("could not parse the client certificate from Secrets '%s/%s'(cert) and '%s/%s'(key): %v",
+				namespace, cfg.ClientCertSecretRef.Name, namespace, cfg.ClientKeySecretRef.Name, err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	return &Client{
+		url: cfg.URL,
+		httpClient: &http.Client{
+			Transport: transport,
+			Timeout:   requestTimeout,
+		},
+	}, nil
+}
+
+// secretData returns the data stored at the key referenced by the given
+// selector, or at defaultKey if the selector does not set a key.
+func secretData(secretsLister internalinformers.SecretLister, namespace string, ref *cmmeta.SecretKeySelector, defaultKey string) ([]byte, error) {
+	secret, err := secretsLister.Secrets(namespace).Get(ref.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	key := defaultKey
+	if ref.Key != "" {
+		key = ref.Key
+	}
+
+	data, ok := secret.Data[key]
+	if !ok {
+		return nil, cmerrors.NewInvalidData("no data for %q in Secret '%s/%s'", key, namespace, ref.Name)
+	}
+	return data, nil
+}
+
+// Sign POSTs the given request to the signing webhook and returns its
+// response.
+// A *StatusError is returned if the webhook responds with a non-200 status,
+// and ErrPublicKeyMismatch if the returned certificate is not for the public
+// key of the certificate signing request.
+func (c *Client) Sign(ctx context.Context, req *SignRequest) (*SignResponse, error) {
+	csr, err := pki.DecodeX509CertificateRequestBytes(req.Request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode certificate signing request: %w", err)
+	}
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode signing request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build signing request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call signing webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read signing webhook response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &StatusError{StatusCode: resp.StatusCode, Body: string(bytes.TrimSpace(respBody))}
+	}
+
+	var signResp SignResponse
+	if err := json.Unmarshal(respBody, &signResp); err != nil {
+		return nil, fmt.Errorf("failed to decode signing webhook response: %w", err)
+	}
+	if len(signResp.Certificate) == 0 {
+		return nil, fmt.Errorf("signing webhook response did not contain a certificate")
+	}
+
+	cert, err := pki.DecodeX509CertificateBytes(signResp.Certificate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode signing webhook certificate: %w", err)
+	}
+
+	matches, err := pki.PublicKeysEqual(cert.PublicKey, csr.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compare the public key of the signed certificate: %w", err)
+	}
+	if !matches {
+		return nil, ErrPublicKeyMismatch
+	}
+
+	return &signResp, nil
+}
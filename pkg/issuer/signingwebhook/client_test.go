@@ -0,0 +1,250 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signingwebhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	cmerrors "github.com/cert-manager/cert-manager/pkg/util/errors"
+	unitcrypto "github.com/cert-manager/cert-manager/test/unit/crypto"
+	"github.com/cert-manager/cert-manager/test/unit/listers"
+)
+
+func signingWebhookIssuer(cfg cmapi.SigningWebhookIssuer) *cmapi.Issuer {
+	return &cmapi.Issuer{
+		ObjectMeta: metav1.ObjectMeta{Name: "signer", Namespace: "default"},
+		Spec: cmapi.IssuerSpec{
+			IssuerConfig: cmapi.IssuerConfig{SigningWebhook: &cfg},
+		},
+	}
+}
+
+func serverCABundle(ts *httptest.Server) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw})
+}
+
+func TestSign(t *testing.T) {
+	bundle := unitcrypto.MustCreateCryptoBundle(t,
+		&cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "example.com"}},
+		clock.RealClock{},
+	)
+	otherBundle := unitcrypto.MustCreateCryptoBundle(t,
+		&cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "example.com"}},
+		clock.RealClock{},
+	)
+
+	tests := map[string]struct {
+		handler         http.HandlerFunc
+		expResp         *SignResponse
+		expErr          bool
+		expPermanentErr bool
+		expMismatchErr  bool
+	}{
+		"the signed certificate is returned": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				var req SignRequest
+				if r.Method != http.MethodPost || json.NewDecoder(r.Body).Decode(&req) != nil {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				if !bytes.Equal(req.Request, bundle.CSRBytes) || req.Duration != "1h0m0s" || !req.IsCA {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				_ = json.NewEncoder(w).Encode(SignResponse{Certificate: bundle.CertBytes, CA: []byte("ca")})
+			},
+			expResp: &SignResponse{Certificate: bundle.CertBytes, CA: []byte("ca")},
+		},
+		"a certificate for a different public key is an error": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_ = json.NewEncoder(w).Encode(SignResponse{Certificate: otherBundle.CertBytes})
+			},
+			expErr:         true,
+			expMismatchErr: true,
+		},
+		"a response with an invalid certificate is an error": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_ = json.NewEncoder(w).Encode(SignResponse{Certificate: []byte("cert")})
+			},
+			expErr: true,
+		},
+		"a rejected request is a permanent error": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, "denied by policy", http.StatusForbidden)
+			},
+			expErr:          true,
+			expPermanentErr: true,
+		},
+		"an unavailable webhook is not a permanent error": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, "offline CA unavailable", http.StatusServiceUnavailable)
+			},
+			expErr: true,
+		},
+		"a response without a certificate is an error": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_ = json.NewEncoder(w).Encode(SignResponse{})
+			},
+			expErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := httptest.NewTLSServer(test.handler)
+			defer ts.Close()
+
+			cl, err := New("default", listers.NewFakeSecretLister(), signingWebhookIssuer(cmapi.SigningWebhookIssuer{
+				URL:      ts.URL,
+				CABundle: serverCABundle(ts),
+			}))
+			require.NoError(t, err)
+
+			resp, err := cl.Sign(context.Background(), &SignRequest{
+				Request:  bundle.CSRBytes,
+				Duration: "1h0m0s",
+				IsCA:     true,
+			})
+			if test.expErr != (err != nil) {
+				t.Fatalf("expected error=%t but got: %v", test.expErr, err)
+			}
+			var statusErr *StatusError
+			isPermanent := errors.As(err, &statusErr) && statusErr.Permanent()
+			assert.Equal(t, test.expPermanentErr, isPermanent)
+			assert.Equal(t, test.expMismatchErr, errors.Is(err, ErrPublicKeyMismatch))
+			assert.Equal(t, test.expResp, resp)
+		})
+	}
+}
+
+func TestSignMutualTLS(t *testing.T) {
+	clientBundle := unitcrypto.MustCreateCryptoBundle(t,
+		&cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "cert-manager"}},
+		clock.RealClock{},
+	)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(clientBundle.Cert)
+
+	ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(SignResponse{Certificate: clientBundle.CertBytes})
+	}))
+	ts.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+	}
+	ts.StartTLS()
+	defer ts.Close()
+
+	clientSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "client-tls", Namespace: "default"},
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       clientBundle.CertBytes,
+			corev1.TLSPrivateKeyKey: clientBundle.PrivateKeyBytes,
+		},
+	}
+
+	t.Run("a client certificate is presented to the webhook", func(t *testing.T) {
+		cl, err := New("default", listers.NewFakeSecretLister(listers.SetFakeSecretNamespaceListerGet(clientSecret, nil)), signingWebhookIssuer(cmapi.SigningWebhookIssuer{
+			URL:                 ts.URL,
+			CABundle:            serverCABundle(ts),
+			ClientCertSecretRef: &cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "client-tls"}},
+			ClientKeySecretRef:  &cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "client-tls"}},
+		}))
+		require.NoError(t, err)
+
+		resp, err := cl.Sign(context.Background(), &SignRequest{Request: clientBundle.CSRBytes})
+		require.NoError(t, err)
+		assert.Equal(t, clientBundle.CertBytes, resp.Certificate)
+	})
+
+	t.Run("the webhook rejects clients without a certificate", func(t *testing.T) {
+		cl, err := New("default", listers.NewFakeSecretLister(), signingWebhookIssuer(cmapi.SigningWebhookIssuer{
+			URL:      ts.URL,
+			CABundle: serverCABundle(ts),
+		}))
+		require.NoError(t, err)
+
+		_, err = cl.Sign(context.Background(), &SignRequest{Request: clientBundle.CSRBytes})
+		assert.Error(t, err)
+	})
+}
+
+func TestNew(t *testing.T) {
+	clientRef := &cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "client-tls"}}
+
+	tests := map[string]struct {
+		cfg           cmapi.SigningWebhookIssuer
+		secretLister  *listers.FakeSecretLister
+		expNotFound   bool
+		expInvalidErr bool
+	}{
+		"invalid caBundle": {
+			cfg: cmapi.SigningWebhookIssuer{
+				URL:      "https://signer.example.com",
+				CABundle: []byte("not a certificate"),
+			},
+			secretLister:  listers.NewFakeSecretLister(),
+			expInvalidErr: true,
+		},
+		"client certificate Secret not found": {
+			cfg: cmapi.SigningWebhookIssuer{
+				URL:                 "https://signer.example.com",
+				ClientCertSecretRef: clientRef,
+				ClientKeySecretRef:  clientRef,
+			},
+			secretLister: listers.NewFakeSecretLister(listers.SetFakeSecretNamespaceListerGet(nil,
+				k8sErrors.NewNotFound(corev1.Resource("secrets"), "client-tls"))),
+			expNotFound: true,
+		},
+		"client certificate Secret without the referenced key": {
+			cfg: cmapi.SigningWebhookIssuer{
+				URL:                 "https://signer.example.com",
+				ClientCertSecretRef: clientRef,
+				ClientKeySecretRef:  clientRef,
+			},
+			secretLister:  listers.NewFakeSecretLister(listers.SetFakeSecretNamespaceListerGet(&corev1.Secret{}, nil)),
+			expInvalidErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := New("default", test.secretLister, signingWebhookIssuer(test.cfg))
+			require.Error(t, err)
+			assert.Equal(t, test.expNotFound, k8sErrors.IsNotFound(err))
+			assert.Equal(t, test.expInvalidErr, cmerrors.IsInvalidData(err))
+		})
+	}
+}
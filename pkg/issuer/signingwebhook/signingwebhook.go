@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signingwebhook
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+
+	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/issuer"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	cmerrors "github.com/cert-manager/cert-manager/pkg/util/errors"
+)
+
+const (
+	errorInitClient = "ErrInitClient"
+
+	successClientConfigured = "ClientConfigured"
+
+	messageErrorInitClient = "Error initializing signing webhook client: "
+
+	messageClientConfigured = "Signing webhook client configured"
+)
+
+// SigningWebhook is an Issuer implementation which delegates the signing of
+// certificates to an external signing webhook, so that cert-manager does not
+// need to hold any CA key material.
+type SigningWebhook struct {
+	*controller.Context
+	issuer        v1.GenericIssuer
+	secretsLister internalinformers.SecretLister
+
+	// Namespace in which to read resources related to this Issuer from.
+	// For Issuers, this will be the namespace of the Issuer.
+	// For ClusterIssuers, this will be the cluster resource namespace.
+	resourceNamespace string
+
+	clientBuilder ClientBuilder
+}
+
+func NewSigningWebhook(ctx *controller.Context, issuer v1.GenericIssuer) (issuer.Interface, error) {
+	return &SigningWebhook{
+		Context:           ctx,
+		issuer:            issuer,
+		secretsLister:     ctx.KubeSharedInformerFactory.Secrets().Lister(),
+		resourceNamespace: ctx.IssuerOptions.ResourceNamespace(issuer),
+		clientBuilder:     New,
+	}, nil
+}
+
+func init() {
+	issuer.RegisterIssuer(apiutil.IssuerSigningWebhook, NewSigningWebhook)
+}
+
+// Setup verifies that a client for the signing webhook can be built from the
+// issuer's configuration, including any referenced client certificate.
+// The signing webhook itself is not contacted, as it may only be reachable
+// while the offline CA behind it is available.
+func (s *SigningWebhook) Setup(ctx context.Context) error {
+	log := logf.FromContext(ctx, "setup")
+
+	if _, err := s.clientBuilder(s.resourceNamespace, s.secretsLister, s.issuer); err != nil {
+		log.Error(err, "error initializing signing webhook client")
+		msg := messageErrorInitClient + err.Error()
+		s.Recorder.Event(s.issuer, corev1.EventTypeWarning, errorInitClient, msg)
+		apiutil.SetIssuerCondition(s.issuer, s.issuer.GetGeneration(), v1.IssuerConditionReady, cmmeta.ConditionFalse, errorInitClient, msg)
+		if cmerrors.IsInvalidData(err) {
+			// Don't retry, wait for the issuer or Secret to be updated
+			return nil
+		}
+		return err
+	}
+
+	log.V(logf.DebugLevel).Info("signing webhook client configured")
+	apiutil.SetIssuerCondition(s.issuer, s.issuer.GetGeneration(), v1.IssuerConditionReady, cmmeta.ConditionTrue, successClientConfigured, messageClientConfigured)
+
+	return nil
+}
@@ -30,9 +30,11 @@ const (
 	successVaultVerified = "VaultVerified"
 	messageVaultVerified = "Vault verified"
 
-	errorVault = "VaultError"
+	errorVault           = "VaultError"
+	errorVaultAuthFailed = "VaultAuthFailed"
 
 	messageVaultClientInitFailed = "Failed to initialize Vault client: "
+	messageVaultAuthFailed       = "Failed to authenticate with Vault: "
 	messageVaultConfigRequired   = "Vault config cannot be empty"
 	messageServerAndPathRequired = "Vault server and path are required fields"
 	messageAuthFieldsRequired    = "Vault tokenSecretRef, appRole, clientCertificate, or kubernetes is required"
@@ -127,6 +129,15 @@ func (v *Vault) Setup(ctx context.Context) error {
 	}
 
 	client, err := vaultinternal.New(ctx, v.resourceNamespace, v.createTokenFn, v.secretsLister, v.issuer)
+	if vaultinternal.IsPermissionDenied(err) {
+		// The credentials, such as an AppRole secret-id, may have expired.
+		// Returning the error causes Setup to be retried, logging in again
+		// with the credentials currently stored in the referenced Secret.
+		s := messageVaultAuthFailed + err.Error()
+		logf.V(logf.WarnLevel).Infof("%s: %s", v.issuer.GetObjectMeta().Name, s)
+		apiutil.SetIssuerCondition(v.issuer, v.issuer.GetGeneration(), v1.IssuerConditionReady, cmmeta.ConditionFalse, errorVaultAuthFailed, s)
+		return err
+	}
 	if err != nil {
 		s := messageVaultClientInitFailed + err.Error()
 		logf.V(logf.WarnLevel).Infof("%s: %s", v.issuer.GetObjectMeta().Name, s)
@@ -491,3 +491,73 @@ func TestVault_Setup(t *testing.T) {
 		})
 	}
 }
+
+func TestVault_SetupAuthFailed(t *testing.T) {
+	// Create a mock Vault HTTP server which rejects the AppRole secret-id,
+	// e.g. because it has been rotated.
+	vaultServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/auth/approle/login" {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"errors":["invalid secret id"]}`))
+		}
+	}))
+	defer vaultServer.Close()
+
+	givenIssuer := &v1.Issuer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-issuer",
+			Namespace: "test-namespace",
+		},
+		Spec: v1.IssuerSpec{
+			IssuerConfig: v1.IssuerConfig{
+				Vault: &v1.VaultIssuer{
+					Path:   "pki_int",
+					Server: vaultServer.URL,
+					Auth: v1.VaultAuth{
+						AppRole: &v1.VaultAppRole{
+							RoleId: "cert-manager",
+							SecretRef: cmmeta.SecretKeySelector{
+								LocalObjectReference: cmmeta.LocalObjectReference{
+									Name: "cert-manager",
+								},
+								Key: "secret-id",
+							},
+							Path: "approle",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	v := &Vault{
+		issuer:            givenIssuer,
+		Context:           &controller.Context{CMClient: cmfake.NewSimpleClientset(givenIssuer)},
+		resourceNamespace: "test-namespace",
+		createTokenFn: func(ns string) vaultinternal.CreateToken {
+			return nil
+		},
+		secretsLister: &testlisters.FakeSecretLister{
+			SecretsFn: func(namespace string) corelisters.SecretNamespaceLister {
+				return &testlisters.FakeSecretNamespaceLister{
+					GetFn: func(name string) (ret *corev1.Secret, err error) {
+						return &corev1.Secret{
+							ObjectMeta: metav1.ObjectMeta{Name: "cert-manager", Namespace: "test-namespace"},
+							Data:       map[string][]byte{"secret-id": []byte("rotated")},
+						}, nil
+					},
+				}
+			},
+		},
+	}
+
+	err := v.Setup(context.Background())
+	assert.True(t, vaultinternal.IsPermissionDenied(err), "expected a permission denied error, got: %v", err)
+
+	require.Len(t, givenIssuer.Status.Conditions, 1)
+	cond := givenIssuer.Status.Conditions[0]
+	assert.Equal(t, v1.IssuerConditionReady, cond.Type)
+	assert.Equal(t, cmmeta.ConditionFalse, cond.Status)
+	assert.Equal(t, errorVaultAuthFailed, cond.Reason)
+	assert.Contains(t, cond.Message, "Failed to authenticate with Vault: ")
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// cloudZoneSeparator separates the application name from the issuing template
+// alias in a Venafi Cloud zone.
+const cloudZoneSeparator = `\`
+
+// CloudZone is a Venafi Cloud zone. Unlike a TPP zone, which is a policy
+// folder, a Venafi Cloud zone identifies an application and the alias of one
+// of the certificate issuing templates assigned to that application.
+type CloudZone struct {
+	// Application is the name of the Venafi Cloud application.
+	Application string
+	// IssuingTemplateAlias is the alias of the certificate issuing template.
+	IssuingTemplateAlias string
+}
+
+// String returns the zone in the format expected by the Venafi Cloud API,
+// i.e. `<application name>\<issuing template alias>`.
+func (z CloudZone) String() string {
+	return z.Application + cloudZoneSeparator + z.IssuingTemplateAlias
+}
+
+// ParseCloudZone parses a Venafi Cloud zone of the form
+// `<application name>\<issuing template alias>`. Whitespace surrounding the
+// application name and issuing template alias is removed.
+func ParseCloudZone(zone string) (CloudZone, error) {
+	if strings.TrimSpace(zone) == "" {
+		return CloudZone{}, errors.New("zone must not be empty")
+	}
+
+	segments := strings.Split(zone, cloudZoneSeparator)
+	if len(segments) != 2 {
+		return CloudZone{}, fmt.Errorf(`zone %q must be of the form "<application name>\<issuing template alias>"`, zone)
+	}
+
+	z := CloudZone{
+		Application:          strings.TrimSpace(segments[0]),
+		IssuingTemplateAlias: strings.TrimSpace(segments[1]),
+	}
+	if z.Application == "" {
+		return CloudZone{}, fmt.Errorf("zone %q does not specify an application name", zone)
+	}
+	if z.IssuingTemplateAlias == "" {
+		return CloudZone{}, fmt.Errorf("zone %q does not specify an issuing template alias", zone)
+	}
+
+	return z, nil
+}
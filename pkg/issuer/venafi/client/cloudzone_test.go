@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"testing"
+)
+
+func TestParseCloudZone(t *testing.T) {
+	tests := map[string]struct {
+		zone    string
+		expZone CloudZone
+		expErr  string
+	}{
+		"a zone with an application and issuing template alias is parsed": {
+			zone:    `my-app\my-template`,
+			expZone: CloudZone{Application: "my-app", IssuingTemplateAlias: "my-template"},
+		},
+		"application names and issuing template aliases may contain spaces": {
+			zone:    `My Application\Default Template`,
+			expZone: CloudZone{Application: "My Application", IssuingTemplateAlias: "Default Template"},
+		},
+		"surrounding whitespace is removed": {
+			zone:    ` my-app \ my-template `,
+			expZone: CloudZone{Application: "my-app", IssuingTemplateAlias: "my-template"},
+		},
+		"an empty zone is rejected": {
+			zone:   " ",
+			expErr: "zone must not be empty",
+		},
+		"a TPP policy folder is rejected": {
+			zone:   `\VED\Policy\Certificates`,
+			expErr: `zone "\\VED\\Policy\\Certificates" must be of the form "<application name>\<issuing template alias>"`,
+		},
+		"a zone without an issuing template alias separator is rejected": {
+			zone:   "my-app/my-template",
+			expErr: `zone "my-app/my-template" must be of the form "<application name>\<issuing template alias>"`,
+		},
+		"a zone without an application name is rejected": {
+			zone:   `\my-template`,
+			expErr: `zone "\\my-template" does not specify an application name`,
+		},
+		"a zone without an issuing template alias is rejected": {
+			zone:   `my-app\`,
+			expErr: `zone "my-app\\" does not specify an issuing template alias`,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			z, err := ParseCloudZone(test.zone)
+			if test.expErr != "" {
+				if err == nil || err.Error() != test.expErr {
+					t.Fatalf("unexpected error, exp=%q got=%v", test.expErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if z != test.expZone {
+				t.Errorf("unexpected zone, exp=%+v got=%+v", test.expZone, z)
+			}
+		})
+	}
+}
+
+func TestCloudZoneString(t *testing.T) {
+	z := CloudZone{Application: "my-app", IssuingTemplateAlias: "my-template"}
+	if got, exp := z.String(), `my-app\my-template`; got != exp {
+		t.Errorf("unexpected zone, exp=%q got=%q", exp, got)
+	}
+}
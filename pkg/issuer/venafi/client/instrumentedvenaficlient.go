@@ -17,6 +17,8 @@ limitations under the License.
 package client
 
 import (
+	"errors"
+	"regexp"
 	"time"
 
 	"github.com/Venafi/vcert/v5/pkg/certificate"
@@ -47,8 +49,7 @@ func (ic instrumentedConnector) ReadZoneConfiguration() (*endpoint.ZoneConfigura
 	start := time.Now()
 	ic.logger.V(logf.TraceLevel).Info("calling ReadZoneConfiguration")
 	config, err := ic.conn.ReadZoneConfiguration()
-	labels := []string{"read_zone_configuration"}
-	ic.metrics.ObserveVenafiRequestDuration(time.Since(start), labels...)
+	observeVenafiOperation(ic.metrics, "read_zone_configuration", start, err)
 	return config, err
 }
 
@@ -56,8 +57,7 @@ func (ic instrumentedConnector) RequestCertificate(req *certificate.Request) (st
 	start := time.Now()
 	ic.logger.V(logf.TraceLevel).Info("calling RequestCertificate")
 	reqID, err := ic.conn.RequestCertificate(req)
-	labels := []string{"request_certificate"}
-	ic.metrics.ObserveVenafiRequestDuration(time.Since(start), labels...)
+	observeVenafiOperation(ic.metrics, "request_certificate", start, err)
 	return reqID, err
 }
 
@@ -65,8 +65,7 @@ func (ic instrumentedConnector) RetrieveCertificate(req *certificate.Request) (*
 	start := time.Now()
 	ic.logger.V(logf.TraceLevel).Info("calling RetrieveCertificate")
 	pemCollection, err := ic.conn.RetrieveCertificate(req)
-	labels := []string{"retrieve_certificate"}
-	ic.metrics.ObserveVenafiRequestDuration(time.Since(start), labels...)
+	observeVenafiOperation(ic.metrics, "retrieve_certificate", start, err)
 	return pemCollection, err
 }
 
@@ -74,8 +73,7 @@ func (ic instrumentedConnector) Ping() error {
 	start := time.Now()
 	ic.logger.V(logf.TraceLevel).Info("calling Ping")
 	err := ic.conn.Ping()
-	labels := []string{"ping"}
-	ic.metrics.ObserveVenafiRequestDuration(time.Since(start), labels...)
+	observeVenafiOperation(ic.metrics, "ping", start, err)
 	return err
 }
 
@@ -83,7 +81,37 @@ func (ic instrumentedConnector) RenewCertificate(req *certificate.RenewalRequest
 	start := time.Now()
 	ic.logger.V(logf.TraceLevel).Info("calling RenewCertificate")
 	reqID, err := ic.conn.RenewCertificate(req)
-	labels := []string{"renew_certificate"}
-	ic.metrics.ObserveVenafiRequestDuration(time.Since(start), labels...)
+	observeVenafiOperation(ic.metrics, "renew_certificate", start, err)
 	return reqID, err
 }
+
+// statusCodeRegexp matches the HTTP status codes included in the errors
+// returned by vcert, e.g. "Unexpected status code on TPP Certificate Request.
+// Status: 500 Internal Server Error". vcert does not return typed errors, so
+// the status code can only be recovered from the error message.
+var statusCodeRegexp = regexp.MustCompile(`(?i)status[^0-9]{0,50}\b([1-5])[0-9]{2}\b`)
+
+// statusClass returns the HTTP status class, e.g. "5xx", of the response
+// which caused the given vcert error, or "unknown" if the error does not
+// include a status code, for example because the request was never sent.
+func statusClass(err error) string {
+	match := statusCodeRegexp.FindStringSubmatch(err.Error())
+	if match == nil {
+		return "unknown"
+	}
+	return match[1] + "xx"
+}
+
+// observeVenafiOperation records the duration of a Venafi client operation
+// which was started at start, and counts the operation as failed if err is
+// not nil. A certificate which is still pending is not counted as a failure.
+func observeVenafiOperation(m *metrics.Metrics, operation string, start time.Time, err error) {
+	duration := time.Since(start)
+	m.ObserveVenafiRequestDuration(duration, operation)
+	m.ObserveVenafiOperationDuration(operation, duration)
+
+	if err == nil || errors.As(err, &endpoint.ErrCertificatePending{}) {
+		return
+	}
+	m.IncrementVenafiRequestErrorCount(operation, statusClass(err))
+}
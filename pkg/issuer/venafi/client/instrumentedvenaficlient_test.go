@@ -0,0 +1,139 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Venafi/vcert/v5/pkg/certificate"
+	"github.com/Venafi/vcert/v5/pkg/endpoint"
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"k8s.io/utils/clock"
+
+	"github.com/cert-manager/cert-manager/pkg/issuer/venafi/client/fake"
+	"github.com/cert-manager/cert-manager/pkg/metrics"
+)
+
+func TestStatusClass(t *testing.T) {
+	tests := map[string]struct {
+		err      error
+		expected string
+	}{
+		"TPP certificate request error": {
+			err:      errors.New("Unexpected status code on TPP Certificate Request.\n Status:\n 500 Internal Server Error. \n Body:\n \n"),
+			expected: "5xx",
+		},
+		"TPP authorize error": {
+			err:      errors.New("unexpected status code on TPP Authorize. Status: 401 Unauthorized"),
+			expected: "4xx",
+		},
+		"Cloud certificate search error": {
+			err:      errors.New("unexpected status code on Venafi Cloud certificate search. Status: 404"),
+			expected: "4xx",
+		},
+		"metadata error": {
+			err:      errors.New("Unexpected http status code while fetching metadata items. 503-503 Service Unavailable"),
+			expected: "5xx",
+		},
+		"wrapped error": {
+			err:      fmt.Errorf("tppClient.Authenticate: %w", errors.New("unexpected status code on TPP Authorize. Status: 403 Forbidden")),
+			expected: "4xx",
+		},
+		"network error without a status code": {
+			err:      errors.New("dial tcp 10.0.0.1:443: connect: connection refused"),
+			expected: "unknown",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := statusClass(test.err); got != test.expected {
+				t.Errorf("expected status class %q, got %q", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestInstrumentedConnector(t *testing.T) {
+	m := metrics.New(logr.Discard(), clock.RealClock{})
+
+	conn := newInstumentedConnector(fake.Connector{
+		PingFunc: func() error {
+			return nil
+		},
+		RequestCertificateFunc: func(*certificate.Request) (string, error) {
+			return "", errors.New("Unexpected status code on TPP Certificate Request.\n Status:\n 500 Internal Server Error. \n Body:\n \n")
+		},
+		RetrieveCertificateFunc: func(*certificate.Request) (*certificate.PEMCollection, error) {
+			return nil, endpoint.ErrCertificatePending{CertificateID: "test", Status: "pending"}
+		},
+	}.Default(), m, logr.Discard())
+
+	if err := conn.Ping(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := conn.Ping(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := conn.RequestCertificate(&certificate.Request{}); err == nil {
+		t.Fatal("expected an error but got none")
+	}
+	if _, err := conn.RetrieveCertificate(&certificate.Request{}); err == nil {
+		t.Fatal("expected an error but got none")
+	}
+
+	if err := testutil.GatherAndCompare(m.Gatherer(), strings.NewReader(`
+	# HELP certmanager_venafi_request_error_count The number of failed operations performed by the Venafi client, by HTTP status class.
+	# TYPE certmanager_venafi_request_error_count counter
+	certmanager_venafi_request_error_count{operation="request_certificate",status_class="5xx"} 1
+`),
+		"certmanager_venafi_request_error_count",
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+
+	counts := map[string]uint64{}
+	families, err := m.Gatherer().Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, family := range families {
+		if family.GetName() != "certmanager_venafi_request_duration_seconds" {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				if label.GetName() == "operation" {
+					counts[label.GetValue()] = metric.GetHistogram().GetSampleCount()
+				}
+			}
+		}
+	}
+	expectedCounts := map[string]uint64{
+		"ping":                 2,
+		"request_certificate":  1,
+		"retrieve_certificate": 1,
+	}
+	if !reflect.DeepEqual(counts, expectedCounts) {
+		t.Errorf("expected request duration sample counts %v, got %v", expectedCounts, counts)
+	}
+}
@@ -21,11 +21,11 @@ import (
 	"errors"
 	"fmt"
 	"strings"
-	"time"
 
 	"github.com/Venafi/vcert/v5/pkg/certificate"
 	"github.com/Venafi/vcert/v5/pkg/venafi/tpp"
 
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	"github.com/cert-manager/cert-manager/pkg/issuer/venafi/client/api"
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
 )
@@ -39,6 +39,16 @@ func (err ErrCustomFieldsType) Error() string {
 	return fmt.Sprintf("certificate request contains an invalid Venafi custom fields type: %q", err.Type)
 }
 
+// ErrCustomFieldsRequired provides a common error structure for required Venafi
+// custom fields which have not been given a value
+type ErrCustomFieldsRequired struct {
+	Names []string
+}
+
+func (err ErrCustomFieldsRequired) Error() string {
+	return fmt.Sprintf("certificate request is missing values for required Venafi custom fields: %s", strings.Join(err.Names, ", "))
+}
+
 var ErrorMissingSubject = errors.New("Certificate requests submitted to Venafi issuers must have the 'commonName' field or at least one other subject field set.")
 
 // This function sends a request to Venafi to for a signed certificate.
@@ -88,7 +98,10 @@ func (v *Venafi) RetrieveCertificate(pickupID string, csrPEM []byte, customField
 	}
 
 	vreq.PickupID = pickupID
-	vreq.Timeout = time.Second * 60
+	vreq.Timeout = v.pickupTimeout
+	if vreq.Timeout == 0 {
+		vreq.Timeout = defaultPickupTimeout
+	}
 
 	// Retrieve the certificate from request
 	pemCollection, err := v.vcertClient.RetrieveCertificate(vreq)
@@ -104,6 +117,14 @@ func (v *Venafi) RetrieveCertificate(pickupID string, csrPEM []byte, customField
 }
 
 func (v *Venafi) buildVReq(csrPEM []byte, customFields []api.CustomField) (*certificate.Request, error) {
+	// Apply the custom fields configured on the issuer before contacting
+	// Venafi, so that requests missing required custom fields are rejected
+	// without being submitted.
+	customFields, err := mergeCustomFields(v.customFields, customFields)
+	if err != nil {
+		return nil, err
+	}
+
 	// Retrieve a copy of the Venafi zone.
 	// This contains default values and policy control info that we can apply
 	// and check against locally.
@@ -159,6 +180,49 @@ func (v *Venafi) buildVReq(csrPEM []byte, customFields []api.CustomField) (*cert
 	return vreq, nil
 }
 
+// mergeCustomFields returns the given custom fields of a request, with the
+// default values of the issuer's custom fields appended for any field the
+// request does not set. An ErrCustomFieldsRequired error is returned if a required
+// custom field has no value.
+func mergeCustomFields(issuerFields []cmapi.VenafiCustomField, customFields []api.CustomField) ([]api.CustomField, error) {
+	if len(issuerFields) == 0 {
+		return customFields, nil
+	}
+
+	values := make(map[string]string, len(customFields))
+	for _, field := range customFields {
+		values[field.Name] = field.Value
+	}
+
+	out := append([]api.CustomField(nil), customFields...)
+	var missing []string
+	for _, field := range issuerFields {
+		if value, ok := values[field.Name]; ok {
+			if value == "" && field.Required {
+				missing = append(missing, field.Name)
+			}
+			continue
+		}
+		if field.Value != "" {
+			out = append(out, api.CustomField{
+				Type:  api.CustomFieldTypePlain,
+				Name:  field.Name,
+				Value: field.Value,
+			})
+			continue
+		}
+		if field.Required {
+			missing = append(missing, field.Name)
+		}
+	}
+
+	if len(missing) > 0 {
+		return nil, ErrCustomFieldsRequired{Names: missing}
+	}
+
+	return out, nil
+}
+
 func convertCustomFieldsToVcert(customFields []api.CustomField) ([]certificate.CustomField, error) {
 	var out []certificate.CustomField
 	if len(customFields) > 0 {
@@ -19,12 +19,16 @@ package client
 import (
 	"crypto"
 	"errors"
+	"fmt"
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/Venafi/vcert/v5/pkg/certificate"
 	"github.com/Venafi/vcert/v5/pkg/endpoint"
 	"github.com/Venafi/vcert/v5/pkg/venafi/fake"
 
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	"github.com/cert-manager/cert-manager/pkg/issuer/venafi/client/api"
 	internalfake "github.com/cert-manager/cert-manager/pkg/issuer/venafi/client/fake"
 	"github.com/cert-manager/cert-manager/pkg/util"
@@ -85,6 +89,24 @@ func generateCSR(t *testing.T, secretKey crypto.Signer, commonName string, dnsNa
 	return csr
 }
 
+// expectCustomFields returns a RequestCertificateFunc which fails unless the
+// request has exactly the given custom fields, in addition to the
+// cert-manager origin field.
+func expectCustomFields(want ...certificate.CustomField) func(*certificate.Request) (string, error) {
+	return func(r *certificate.Request) (string, error) {
+		var got []certificate.CustomField
+		for _, field := range r.CustomFields {
+			if field.Type != certificate.CustomFieldOrigin {
+				got = append(got, field)
+			}
+		}
+		if !reflect.DeepEqual(got, want) {
+			return "", fmt.Errorf("expected custom fields %v but got %v", want, got)
+		}
+		return "test", nil
+	}
+}
+
 func TestVenafi_RequestCertificate(t *testing.T) {
 	privateKey, err := pki.GenerateRSAPrivateKey(2048)
 	if err != nil {
@@ -97,11 +119,12 @@ func TestVenafi_RequestCertificate(t *testing.T) {
 		customFields []api.CustomField
 	}
 	tests := []struct {
-		name         string
-		vcertClient  connector
-		args         args
-		wantPickupID bool
-		wantErr      bool
+		name               string
+		vcertClient        connector
+		issuerCustomFields []cmapi.VenafiCustomField
+		args               args
+		wantPickupID       bool
+		wantErr            bool
 	}{
 		{
 			name: "error if reading the zone configuration fails",
@@ -199,6 +222,56 @@ func TestVenafi_RequestCertificate(t *testing.T) {
 			wantPickupID: true,
 			wantErr:      false,
 		},
+		{
+			name: "include the issuer's custom fields in the request",
+			issuerCustomFields: []cmapi.VenafiCustomField{
+				{Name: "Cost Center", Value: "1234"},
+				{Name: "App ID", Required: true},
+			},
+			args: args{
+				customFields: []api.CustomField{{Name: "App ID", Value: "my-app"}},
+			},
+			vcertClient: internalfake.Connector{
+				RequestCertificateFunc: expectCustomFields(
+					certificate.CustomField{Type: certificate.CustomFieldPlain, Name: "App ID", Value: "my-app"},
+					certificate.CustomField{Type: certificate.CustomFieldPlain, Name: "Cost Center", Value: "1234"},
+				),
+			}.Default(),
+			wantPickupID: true,
+			wantErr:      false,
+		},
+		{
+			name: "custom fields of the request take precedence over the issuer's custom fields",
+			issuerCustomFields: []cmapi.VenafiCustomField{
+				{Name: "Cost Center", Value: "1234"},
+			},
+			args: args{
+				customFields: []api.CustomField{{Name: "Cost Center", Value: "5678"}},
+			},
+			vcertClient: internalfake.Connector{
+				RequestCertificateFunc: expectCustomFields(
+					certificate.CustomField{Type: certificate.CustomFieldPlain, Name: "Cost Center", Value: "5678"},
+				),
+			}.Default(),
+			wantPickupID: true,
+			wantErr:      false,
+		},
+		{
+			name: "error without contacting Venafi if a required custom field is missing",
+			issuerCustomFields: []cmapi.VenafiCustomField{
+				{Name: "App ID", Required: true},
+			},
+			args: args{},
+			vcertClient: internalfake.Connector{
+				ReadZoneConfigurationFunc: func() (*endpoint.ZoneConfiguration, error) {
+					return nil, errors.New("venafi should not be contacted")
+				},
+				RequestCertificateFunc: func(*certificate.Request) (string, error) {
+					return "", errors.New("venafi should not be contacted")
+				},
+			}.Default(),
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -206,7 +279,8 @@ func TestVenafi_RequestCertificate(t *testing.T) {
 				tt.vcertClient = fake.NewConnector(true, nil)
 			}
 			v := &Venafi{
-				vcertClient: tt.vcertClient,
+				vcertClient:  tt.vcertClient,
+				customFields: tt.issuerCustomFields,
 			}
 
 			if tt.args.csrPEM == nil {
@@ -238,11 +312,12 @@ func TestVenafi_RetrieveCertificate(t *testing.T) {
 		customFields []api.CustomField
 	}
 	tests := []struct {
-		name        string
-		vcertClient connector
-		args        args
-		wantErr     bool
-		checkFn     func(*testing.T, []byte, []byte)
+		name          string
+		vcertClient   connector
+		pickupTimeout time.Duration
+		args          args
+		wantErr       bool
+		checkFn       func(*testing.T, []byte, []byte)
 	}{
 		{
 			name: "error if retrieve certificate fails",
@@ -260,6 +335,33 @@ func TestVenafi_RetrieveCertificate(t *testing.T) {
 			wantErr: false,
 			checkFn: checkCertificateIssued,
 		},
+		{
+			name: "wait for the default pickup timeout if none is configured",
+			vcertClient: internalfake.Connector{
+				RetrieveCertificateFunc: func(r *certificate.Request) (*certificate.PEMCollection, error) {
+					if r.Timeout != defaultPickupTimeout {
+						return nil, fmt.Errorf("expected timeout %s but got %s", defaultPickupTimeout, r.Timeout)
+					}
+					return internalfake.Connector{}.Default().RetrieveCertificate(r)
+				},
+			}.Default(),
+			args:    args{},
+			wantErr: false,
+		},
+		{
+			name: "wait for the configured pickup timeout",
+			vcertClient: internalfake.Connector{
+				RetrieveCertificateFunc: func(r *certificate.Request) (*certificate.PEMCollection, error) {
+					if r.Timeout != 90*time.Second {
+						return nil, fmt.Errorf("expected timeout %s but got %s", 90*time.Second, r.Timeout)
+					}
+					return internalfake.Connector{}.Default().RetrieveCertificate(r)
+				},
+			}.Default(),
+			pickupTimeout: 90 * time.Second,
+			args:          args{},
+			wantErr:       false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -267,7 +369,8 @@ func TestVenafi_RetrieveCertificate(t *testing.T) {
 				tt.vcertClient = fake.NewConnector(true, nil)
 			}
 			v := &Venafi{
-				vcertClient: tt.vcertClient,
+				vcertClient:   tt.vcertClient,
+				pickupTimeout: tt.pickupTimeout,
 			}
 
 			if tt.args.csrPEM == nil {
@@ -18,7 +18,6 @@ package client
 
 import (
 	"crypto/tls"
-	"crypto/x509"
 	"fmt"
 	"net"
 	"net/http"
@@ -30,14 +29,18 @@ import (
 	"github.com/Venafi/vcert/v5/pkg/venafi/cloud"
 	"github.com/Venafi/vcert/v5/pkg/venafi/tpp"
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/utils/ptr"
 
+	"github.com/cert-manager/cert-manager/internal/controller/feature"
 	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	"github.com/cert-manager/cert-manager/pkg/issuer/venafi/client/api"
 	"github.com/cert-manager/cert-manager/pkg/metrics"
 	"github.com/cert-manager/cert-manager/pkg/util"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
 )
 
 const (
@@ -46,6 +49,11 @@ const (
 	tppAccessTokenKey = "access-token"
 
 	defaultAPIKeyKey = "api-key"
+
+	// defaultPickupTimeout is how long RetrieveCertificate waits for a
+	// requested certificate to be issued if the issuer does not configure a
+	// pickup timeout.
+	defaultPickupTimeout = 60 * time.Second
 )
 
 type VenafiClientBuilder func(namespace string, secretsLister internalinformers.SecretLister,
@@ -58,6 +66,12 @@ type Interface interface {
 	Ping() error
 	ReadZoneConfiguration() (*endpoint.ZoneConfiguration, error)
 	SetClient(endpoint.Connector)
+}
+
+// CredentialsVerifier is implemented by clients that are able to remotely
+// verify their credentials, rather than only checking that the Venafi server
+// is reachable.
+type CredentialsVerifier interface {
 	VerifyCredentials() error
 }
 
@@ -73,6 +87,16 @@ type Venafi struct {
 	tppClient   *tpp.Connector
 	cloudClient *cloud.Connector
 	config      *vcert.Config
+
+	// pickupTimeout is how long RetrieveCertificate waits for a requested
+	// certificate to be issued before giving up.
+	pickupTimeout time.Duration
+
+	// customFields are the custom fields configured on the issuer, which
+	// are set on every certificate request.
+	customFields []cmapi.VenafiCustomField
+
+	metrics *metrics.Metrics
 }
 
 // connector exposes a subset of the vcert Connector interface to make stubbing
@@ -124,9 +148,32 @@ func New(namespace string, secretsLister internalinformers.SecretLister, issuer
 		cloudClient:   cc,
 		tppClient:     tppc,
 		config:        cfg,
+		pickupTimeout: pickupTimeoutForIssuer(issuer),
+		customFields:  customFieldsForIssuer(issuer),
+		metrics:       metrics,
 	}, nil
 }
 
+// pickupTimeoutForIssuer returns the pickup timeout configured on the TPP
+// issuer, or the default if none is configured.
+func pickupTimeoutForIssuer(iss cmapi.GenericIssuer) time.Duration {
+	tpp := iss.GetSpec().Venafi.TPP
+	if tpp == nil || tpp.PickupTimeout == nil {
+		return defaultPickupTimeout
+	}
+	return tpp.PickupTimeout.Duration
+}
+
+// customFieldsForIssuer returns the custom fields configured on the TPP
+// issuer, if any.
+func customFieldsForIssuer(iss cmapi.GenericIssuer) []cmapi.VenafiCustomField {
+	tpp := iss.GetSpec().Venafi.TPP
+	if tpp == nil {
+		return nil
+	}
+	return tpp.CustomFields
+}
+
 // configForIssuer will convert a cert-manager Venafi issuer into a vcert.Config
 // that can be used to instantiate an API client.
 func configForIssuer(iss cmapi.GenericIssuer, secretsLister internalinformers.SecretLister, namespace string, userAgent string) (*vcert.Config, error) {
@@ -145,6 +192,11 @@ func configForIssuer(iss cmapi.GenericIssuer, secretsLister internalinformers.Se
 			return nil, err
 		}
 
+		clientCertificate, err := clientCertificateForVcertTPP(tpp, secretsLister, namespace)
+		if err != nil {
+			return nil, err
+		}
+
 		username := string(tppSecret.Data[tppUsernameKey])
 		password := string(tppSecret.Data[tppPasswordKey])
 		accessToken := string(tppSecret.Data[tppAccessTokenKey])
@@ -171,11 +223,20 @@ func configForIssuer(iss cmapi.GenericIssuer, secretsLister internalinformers.Se
 			Client: httpClientForVcert(&httpClientForVcertOptions{
 				UserAgent:               ptr.To(userAgent),
 				CABundle:                caBundle,
+				ClientCertificate:       clientCertificate,
 				TLSRenegotiationSupport: ptr.To(tls.RenegotiateOnceAsClient),
 			}),
 		}, nil
 	case venCfg.Cloud != nil:
 		cloud := venCfg.Cloud
+		// Venafi Cloud zones have a different format to TPP zones, so we
+		// check it here to return a clear error rather than a generic API
+		// failure when the zone is first used.
+		zone, err := ParseCloudZone(venCfg.Zone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Venafi Cloud zone: %w", err)
+		}
+
 		cloudSecret, err := secretsLister.Secrets(namespace).Get(cloud.APITokenSecretRef.Name)
 		if err != nil {
 			return nil, err
@@ -190,7 +251,7 @@ func configForIssuer(iss cmapi.GenericIssuer, secretsLister internalinformers.Se
 		return &vcert.Config{
 			ConnectorType: endpoint.ConnectorTypeCloud,
 			BaseUrl:       cloud.URL,
-			Zone:          venCfg.Zone,
+			Zone:          zone.String(),
 			// always enable verbose logging for now
 			LogVerbose: true,
 			Credentials: &endpoint.Authentication{
@@ -214,6 +275,9 @@ type httpClientForVcertOptions struct {
 	// CABundle will override the CA certificates used to verify server
 	// certificates.
 	CABundle []byte
+	// ClientCertificate will be presented to the server if it requests a
+	// client certificate during the TLS handshake.
+	ClientCertificate *tls.Certificate
 	// TLSRenegotiationSupport will override the TLSRenegotiationSupport setting
 	// of the client.
 	TLSRenegotiationSupport *tls.RenegotiationSupport
@@ -266,7 +330,9 @@ type httpClientForVcertOptions struct {
 //     CA trust pool ourselves.
 //  2. And the value of RootCAs MUST be nil unless the user has supplied a
 //     custom CA, because a nil value causes the Go HTTP client to load the
-//     system default root CAs.
+//     system default root CAs. If the user has supplied a custom CA, it
+//     replaces the system default root CAs unless the
+//     CABundleIncludeSystemRoots feature gate is enabled.
 //
 // [1] TLS protocol version support in Microsoft Windows: https://learn.microsoft.com/en-us/windows/win32/secauthn/protocols-in-tls-ssl--schannel-ssp-#tls-protocol-version-support
 // [2] Should I use SSL/TLS renegotiation?: https://security.stackexchange.com/a/24569
@@ -295,10 +361,12 @@ func httpClientForVcert(options *httpClientForVcertOptions) *http.Client {
 		tlsClientConfig = &tls.Config{}
 	}
 	if len(options.CABundle) > 0 {
-		rootCAs := x509.NewCertPool()
-		rootCAs.AppendCertsFromPEM(options.CABundle)
+		rootCAs, _ := pki.CertPoolFromCABundle(options.CABundle, utilfeature.DefaultFeatureGate.Enabled(feature.CABundleIncludeSystemRoots))
 		tlsClientConfig.RootCAs = rootCAs
 	}
+	if options.ClientCertificate != nil {
+		tlsClientConfig.Certificates = []tls.Certificate{*options.ClientCertificate}
+	}
 	transport.TLSClientConfig = tlsClientConfig
 
 	if options.TLSRenegotiationSupport != nil {
@@ -360,6 +428,53 @@ func caBundleForVcertTPP(tpp *cmapi.VenafiTPP, secretsLister internalinformers.S
 	return certBytes, nil
 }
 
+// clientCertificateForVcertTPP returns the client certificate presented to
+// the TPP server when it requires mTLS. If no client certificate is
+// configured, nil is returned.
+// If the `key` of the Secret client certificate or private key is not defined,
+// its value defaults to `tls.crt` or `tls.key` respectively.
+func clientCertificateForVcertTPP(tpp *cmapi.VenafiTPP, secretsLister internalinformers.SecretLister, namespace string) (*tls.Certificate, error) {
+	refCert := tpp.ClientCertSecretRef
+	refPrivateKey := tpp.ClientKeySecretRef
+	if refCert == nil || refPrivateKey == nil {
+		return nil, nil
+	}
+
+	secretCert, err := secretsLister.Secrets(namespace).Get(refCert.Name)
+	if err != nil {
+		return nil, fmt.Errorf("could not access secret '%s/%s': %s", namespace, refCert.Name, err)
+	}
+	secretPrivateKey, err := secretsLister.Secrets(namespace).Get(refPrivateKey.Name)
+	if err != nil {
+		return nil, fmt.Errorf("could not access secret '%s/%s': %s", namespace, refPrivateKey.Name, err)
+	}
+
+	keyCert := corev1.TLSCertKey
+	if refCert.Key != "" {
+		keyCert = refCert.Key
+	}
+
+	keyPrivate := corev1.TLSPrivateKeyKey
+	if refPrivateKey.Key != "" {
+		keyPrivate = refPrivateKey.Key
+	}
+
+	certBytes, ok := secretCert.Data[keyCert]
+	if !ok {
+		return nil, fmt.Errorf("no data for %q in secret '%s/%s'", keyCert, namespace, refCert.Name)
+	}
+	privateKeyBytes, ok := secretPrivateKey.Data[keyPrivate]
+	if !ok {
+		return nil, fmt.Errorf("no data for %q in secret '%s/%s'", keyPrivate, namespace, refPrivateKey.Name)
+	}
+
+	cert, err := tls.X509KeyPair(certBytes, privateKeyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse the TLS client certificate from secrets '%s/%s'(cert) and '%s/%s'(key): %s", namespace, refCert.Name, namespace, refPrivateKey.Name, err)
+	}
+	return &cert, nil
+}
+
 func (v *Venafi) Ping() error {
 	return v.vcertClient.Ping()
 }
@@ -374,6 +489,13 @@ func (v *Venafi) SetClient(client endpoint.Connector) {
 
 // VerifyCredentials will remotely verify the credentials for the client, both for TPP and Cloud
 func (v *Venafi) VerifyCredentials() error {
+	start := time.Now()
+	err := v.verifyCredentials()
+	observeVenafiOperation(v.metrics, "verify_credentials", start, err)
+	return err
+}
+
+func (v *Venafi) verifyCredentials() error {
 	switch {
 	case v.cloudClient != nil:
 		err := v.cloudClient.Authenticate(&endpoint.Authentication{
@@ -17,8 +17,16 @@ limitations under the License.
 package client
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	vcert "github.com/Venafi/vcert/v5"
 	corev1 "k8s.io/api/core/v1"
@@ -27,6 +35,7 @@ import (
 	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
 	"github.com/cert-manager/cert-manager/test/unit/gen"
 	testlisters "github.com/cert-manager/cert-manager/test/unit/listers"
 )
@@ -128,6 +137,7 @@ func generateSecretLister(s *corev1.Secret, err error) internalinformers.SecretL
 
 func TestConfigForIssuerT(t *testing.T) {
 	zone := "test-zone"
+	cloudZone := `test-application\test-template`
 	username := "test-username"
 	password := "test-password"
 	accessToken := "KT2EEVTIjWM/37L78dqJAg=="
@@ -177,14 +187,14 @@ func TestConfigForIssuerT(t *testing.T) {
 
 	cloudIssuer := gen.IssuerFrom(baseIssuer,
 		gen.SetIssuerVenafi(cmapi.VenafiIssuer{
-			Zone:  zone,
+			Zone:  cloudZone,
 			Cloud: &cmapi.VenafiCloud{},
 		}),
 	)
 
 	cloudWithKeyIssuer := gen.IssuerFrom(cloudIssuer,
 		gen.SetIssuerVenafi(cmapi.VenafiIssuer{
-			Zone: zone,
+			Zone: cloudZone,
 			Cloud: &cmapi.VenafiCloud{
 				APITokenSecretRef: cmmeta.SecretKeySelector{
 					Key: customKey,
@@ -298,10 +308,25 @@ func TestConfigForIssuerT(t *testing.T) {
 				if key := cnf.Credentials.APIKey; key != apiKey {
 					t.Errorf("got unexpected API key: %s", key)
 				}
-				checkZone(t, zone, cnf)
+				checkZone(t, cloudZone, cnf)
 			},
 			expectedErr: false,
 		},
+		"if Cloud and the zone does not specify an issuing template, should error": {
+			iss: gen.IssuerFrom(cloudIssuer,
+				gen.SetIssuerVenafi(cmapi.VenafiIssuer{
+					Zone:  zone,
+					Cloud: &cmapi.VenafiCloud{},
+				}),
+			),
+			secretsLister: generateSecretLister(&corev1.Secret{
+				Data: map[string][]byte{
+					defaultAPIKeyKey: []byte(apiKey),
+				},
+			}, nil),
+			CheckFn:     checkNoConfigReturned,
+			expectedErr: true,
+		},
 		"if Cloud and secret with secret key ref, should use API key at default index": {
 			iss: cloudWithKeyIssuer,
 			secretsLister: generateSecretLister(&corev1.Secret{
@@ -313,7 +338,7 @@ func TestConfigForIssuerT(t *testing.T) {
 				if key := cnf.Credentials.APIKey; key != apiKey {
 					t.Errorf("got unexpected API key: %s", key)
 				}
-				checkZone(t, zone, cnf)
+				checkZone(t, cloudZone, cnf)
 			},
 			expectedErr: false,
 		},
@@ -490,3 +515,195 @@ func (c *testConfigForIssuerT) runTppCaTest(t *testing.T) {
 		}
 	}
 }
+
+func TestHTTPClientForVcertTrustsCABundle(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	tests := map[string]struct {
+		caBundle []byte
+		expErr   bool
+	}{
+		"a client without a CA bundle does not trust the server": {
+			expErr: true,
+		},
+		"a client with the server's CA in its CA bundle trusts the server": {
+			caBundle: caBundle,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := httpClientForVcert(&httpClientForVcertOptions{
+				CABundle: test.caBundle,
+			})
+
+			resp, err := client.Get(server.URL)
+			if test.expErr != (err != nil) {
+				t.Fatalf("unexpected error, exp=%t got=%v", test.expErr, err)
+			}
+			if resp != nil {
+				resp.Body.Close()
+			}
+		})
+	}
+}
+
+func TestConfigForIssuerTPPClientCertificate(t *testing.T) {
+	clientKey, err := pki.GenerateECPrivateKey(pki.ECCurve256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientKeyPEM, err := pki.EncodePKCS8PrivateKey(clientKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientCertTemplate := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "cert-manager"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	clientCertPEM, clientCert, err := pki.SignCertificate(clientCertTemplate, clientCertTemplate, clientKey.Public(), clientKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A mock TPP server which requires clients to present a certificate.
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(clientCert)
+	server.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	tppIssuer := gen.Issuer("venafi-issuer",
+		gen.SetIssuerVenafi(cmapi.VenafiIssuer{
+			Zone: zone,
+			TPP: &cmapi.VenafiTPP{
+				URL:      server.URL,
+				CABundle: caBundle,
+			},
+		}),
+	)
+	tppIssuerWithClientCertificate := gen.IssuerFrom(tppIssuer,
+		gen.SetIssuerVenafi(cmapi.VenafiIssuer{
+			Zone: zone,
+			TPP: &cmapi.VenafiTPP{
+				URL:      server.URL,
+				CABundle: caBundle,
+				ClientCertSecretRef: &cmmeta.SecretKeySelector{
+					LocalObjectReference: cmmeta.LocalObjectReference{Name: "client-certificate"},
+				},
+				ClientKeySecretRef: &cmmeta.SecretKeySelector{
+					LocalObjectReference: cmmeta.LocalObjectReference{Name: "client-certificate"},
+				},
+			},
+		}),
+	)
+
+	secret := &corev1.Secret{
+		Data: map[string][]byte{
+			tppUsernameKey:          []byte(username),
+			tppPasswordKey:          []byte(password),
+			corev1.TLSCertKey:       clientCertPEM,
+			corev1.TLSPrivateKeyKey: clientKeyPEM,
+		},
+	}
+
+	tests := map[string]struct {
+		issuer *cmapi.Issuer
+		expErr bool
+	}{
+		"a client without a client certificate is rejected by the server": {
+			issuer: tppIssuer,
+			expErr: true,
+		},
+		"a client with a client certificate is accepted by the server": {
+			issuer: tppIssuerWithClientCertificate,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			cnf, err := configForIssuer(test.issuer, generateSecretLister(secret, nil), "test-namespace", "cert-manager-test")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := cnf.Client.Get(server.URL)
+			if test.expErr != (err != nil) {
+				t.Fatalf("unexpected error, exp=%t got=%v", test.expErr, err)
+			}
+			if resp != nil {
+				resp.Body.Close()
+			}
+		})
+	}
+}
+
+func TestClientCertificateForVcertTPP(t *testing.T) {
+	tpp := &cmapi.VenafiTPP{
+		ClientCertSecretRef: &cmmeta.SecretKeySelector{
+			LocalObjectReference: cmmeta.LocalObjectReference{Name: "client-certificate"},
+		},
+		ClientKeySecretRef: &cmmeta.SecretKeySelector{
+			LocalObjectReference: cmmeta.LocalObjectReference{Name: "client-certificate"},
+		},
+	}
+
+	tests := map[string]struct {
+		tpp       *cmapi.VenafiTPP
+		secret    *corev1.Secret
+		secretErr error
+		expErr    bool
+		expCert   bool
+	}{
+		"if no client certificate is configured, nil is returned": {
+			tpp: &cmapi.VenafiTPP{},
+		},
+		"if getting the secret fails, should error": {
+			tpp:       tpp,
+			secretErr: errors.New("this is an error"),
+			expErr:    true,
+		},
+		"if the secret does not contain the certificate, should error": {
+			tpp: tpp,
+			secret: &corev1.Secret{
+				Data: map[string][]byte{corev1.TLSPrivateKeyKey: []byte("key")},
+			},
+			expErr: true,
+		},
+		"if the secret contains an invalid certificate, should error": {
+			tpp: tpp,
+			secret: &corev1.Secret{
+				Data: map[string][]byte{
+					corev1.TLSCertKey:       []byte("not a certificate"),
+					corev1.TLSPrivateKeyKey: []byte("not a key"),
+				},
+			},
+			expErr: true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			cert, err := clientCertificateForVcertTPP(test.tpp, generateSecretLister(test.secret, test.secretErr), "test-namespace")
+			if test.expErr != (err != nil) {
+				t.Fatalf("unexpected error, exp=%t got=%v", test.expErr, err)
+			}
+			if test.expCert != (cert != nil) {
+				t.Errorf("unexpected client certificate, exp=%t got=%v", test.expCert, cert)
+			}
+		})
+	}
+}
@@ -25,6 +25,7 @@ import (
 	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	venaficlient "github.com/cert-manager/cert-manager/pkg/issuer/venafi/client"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 )
 
@@ -47,9 +48,12 @@ func (v *Venafi) Setup(ctx context.Context) (err error) {
 		return fmt.Errorf("error pinging Venafi API: %v", err)
 	}
 
-	err = client.VerifyCredentials()
-	if err != nil {
-		return fmt.Errorf("client.VerifyCredentials: %v", err)
+	verifier, canVerifyCredentials := client.(venaficlient.CredentialsVerifier)
+	if canVerifyCredentials {
+		err = verifier.VerifyCredentials()
+		if err != nil {
+			return fmt.Errorf("client.VerifyCredentials: %v", err)
+		}
 	}
 
 	// If it does not already have a 'ready' condition, we'll also log an event
@@ -58,7 +62,11 @@ func (v *Venafi) Setup(ctx context.Context) (err error) {
 		Type:   cmapi.IssuerConditionReady,
 		Status: cmmeta.ConditionTrue,
 	}) {
-		v.Recorder.Eventf(v.issuer, corev1.EventTypeNormal, "Ready", "Verified issuer with Venafi server")
+		if canVerifyCredentials {
+			v.Recorder.Eventf(v.issuer, corev1.EventTypeNormal, "Ready", "Verified issuer with Venafi server")
+		} else {
+			v.Recorder.Eventf(v.issuer, corev1.EventTypeNormal, "Ready", "Pinged Venafi server (credentials not verified)")
+		}
 	}
 	v.log.V(logf.DebugLevel).Info("Venafi issuer started")
 	apiutil.SetIssuerCondition(v.issuer, v.issuer.GetGeneration(), cmapi.IssuerConditionReady, cmmeta.ConditionTrue, "Venafi issuer started", "Venafi issuer started")
@@ -22,11 +22,14 @@ import (
 	"fmt"
 	"slices"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
 	controllertest "github.com/cert-manager/cert-manager/pkg/controller/test"
 	"github.com/cert-manager/cert-manager/pkg/issuer/venafi/client"
@@ -62,6 +65,17 @@ func TestSetup(t *testing.T) {
 		}, nil
 	}
 
+	pingOnlyClient := func(string, internalinformers.SecretLister,
+		cmapi.GenericIssuer, *metrics.Metrics, logr.Logger, string) (client.Interface, error) {
+		// Embedding only client.Interface hides the fake's VerifyCredentials
+		// method, simulating a client without credential verification.
+		return struct{ client.Interface }{&internalvenafifake.Venafi{
+			PingFn: func() error {
+				return nil
+			},
+		}}, nil
+	}
+
 	verifyCredentialsClient := func(string, internalinformers.SecretLister, cmapi.GenericIssuer, *metrics.Metrics, logr.Logger, string) (client.Interface, error) {
 		return &internalvenafifake.Venafi{
 			PingFn: func() error {
@@ -84,6 +98,18 @@ func TestSetup(t *testing.T) {
 		}, nil
 	}
 
+	readyIssuer := gen.IssuerFrom(baseIssuer,
+		gen.AddIssuerCondition(cmapi.IssuerCondition{
+			Type:    cmapi.IssuerConditionReady,
+			Status:  cmmeta.ConditionTrue,
+			Reason:  "Venafi issuer started",
+			Message: "Venafi issuer started",
+		}),
+		gen.SetIssuerVenafi(cmapi.VenafiIssuer{
+			CredentialCheckInterval: &metav1.Duration{Duration: time.Hour},
+		}),
+	)
+
 	tests := map[string]testSetupT{
 		"if client builder fails then should error": {
 			clientBuilder: failingClientBuilder,
@@ -120,6 +146,19 @@ func TestSetup(t *testing.T) {
 				"Normal Ready Verified issuer with Venafi server",
 			},
 		},
+		"if client cannot verify credentials then should emit a distinct event": {
+			clientBuilder: pingOnlyClient,
+			iss:           baseIssuer.DeepCopy(),
+			expectedErr:   false,
+			expectedCondition: &cmapi.IssuerCondition{
+				Message: "Venafi issuer started",
+				Reason:  "Venafi issuer started",
+				Status:  "True",
+			},
+			expectedEvents: []string{
+				"Normal Ready Pinged Venafi server (credentials not verified)",
+			},
+		},
 		"verifyCredentials happy path": {
 			clientBuilder: verifyCredentialsClient,
 			iss:           baseIssuer.DeepCopy(),
@@ -134,6 +173,26 @@ func TestSetup(t *testing.T) {
 			},
 		},
 
+		"if credentials are revoked after the issuer became ready we should set condition to False": {
+			clientBuilder: failingVerifyCredentialsClient,
+			iss:           readyIssuer.DeepCopy(),
+			expectedErr:   true,
+			expectedCondition: &cmapi.IssuerCondition{
+				Reason:  "ErrorSetup",
+				Message: "Failed to setup Venafi issuer: client.VerifyCredentials: 401 Unauthorized",
+				Status:  "False",
+			},
+		},
+		"if credentials are still valid when re-verified the ready issuer should not emit an event": {
+			clientBuilder: verifyCredentialsClient,
+			iss:           readyIssuer.DeepCopy(),
+			expectedErr:   false,
+			expectedCondition: &cmapi.IssuerCondition{
+				Message: "Venafi issuer started",
+				Reason:  "Venafi issuer started",
+				Status:  "True",
+			},
+		},
 		"if verifyCredentials returns an error we should set condition to False": {
 			clientBuilder: failingVerifyCredentialsClient,
 			iss:           baseIssuer.DeepCopy(),
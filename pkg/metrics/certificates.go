@@ -17,6 +17,8 @@ limitations under the License.
 package metrics
 
 import (
+	"time"
+
 	"github.com/prometheus/client_golang/prometheus"
 	"k8s.io/client-go/tools/cache"
 
@@ -97,6 +99,20 @@ func (m *Metrics) updateCertificateReadyStatus(crt *cmapi.Certificate, current c
 	}
 }
 
+// ObserveCertificateReadyDuration records the time taken for the given
+// Certificate to become Ready, either for its initial issuance or a renewal.
+func (m *Metrics) ObserveCertificateReadyDuration(crt *cmapi.Certificate, renewal bool, duration time.Duration) {
+	issuance := "initial"
+	if renewal {
+		issuance = "renewal"
+	}
+
+	m.certificateReadyDurationSeconds.With(prometheus.Labels{
+		"issuer_kind": crt.Spec.IssuerRef.Kind,
+		"issuance":    issuance,
+	}).Observe(duration.Seconds())
+}
+
 // RemoveCertificate will delete the Certificate metrics from continuing to be
 // exposed.
 func (m *Metrics) RemoveCertificate(key string) {
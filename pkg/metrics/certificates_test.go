@@ -22,7 +22,9 @@ import (
 	"time"
 
 	logtesting "github.com/go-logr/logr/testing"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/clock"
 
@@ -363,3 +365,47 @@ func TestCertificateCache(t *testing.T) {
 		t.Errorf("unexpected collecting result:\n%s", err)
 	}
 }
+
+func TestObserveCertificateReadyDuration(t *testing.T) {
+	m := New(logtesting.NewTestLogger(t), clock.RealClock{})
+
+	crt := gen.Certificate("test-certificate",
+		gen.SetCertificateNamespace("test-ns"),
+		gen.SetCertificateIssuer(cmmeta.ObjectReference{
+			Name: "test-issuer",
+			Kind: "ClusterIssuer",
+		}),
+	)
+	m.ObserveCertificateReadyDuration(crt, false, 3*time.Second)
+	m.ObserveCertificateReadyDuration(crt, true, 20*time.Second)
+	m.ObserveCertificateReadyDuration(crt, true, 100*time.Second)
+
+	if count := testutil.CollectAndCount(m.certificateReadyDurationSeconds); count != 2 {
+		t.Errorf("expected 2 series, got %d", count)
+	}
+
+	// Compare only the count and sum of each series, rather than every bucket.
+	for _, test := range []struct {
+		issuance     string
+		count        uint64
+		sumInSeconds float64
+	}{
+		{issuance: "initial", count: 1, sumInSeconds: 3},
+		{issuance: "renewal", count: 2, sumInSeconds: 120},
+	} {
+		observer, err := m.certificateReadyDurationSeconds.GetMetricWithLabelValues("ClusterIssuer", test.issuance)
+		if err != nil {
+			t.Fatal(err)
+		}
+		metric := &dto.Metric{}
+		if err := observer.(prometheus.Histogram).Write(metric); err != nil {
+			t.Fatal(err)
+		}
+		if got := metric.GetHistogram().GetSampleCount(); got != test.count {
+			t.Errorf("%s: expected %d observations, got %d", test.issuance, test.count, got)
+		}
+		if got := metric.GetHistogram().GetSampleSum(); got != test.sumInSeconds {
+			t.Errorf("%s: expected observations to sum to %v, got %v", test.issuance, test.sumInSeconds, got)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// UpdateIssuerCAExpiry will update the expiry time of the CA certificate
+// backing the given issuer.
+func (m *Metrics) UpdateIssuerCAExpiry(issuer cmapi.GenericIssuer, notAfter time.Time) {
+	m.issuerCAExpiryTimeSeconds.With(prometheus.Labels{
+		"name":      issuer.GetObjectMeta().Name,
+		"namespace": issuer.GetObjectMeta().Namespace,
+		"kind":      issuerKind(issuer),
+	}).Set(float64(notAfter.Unix()))
+}
+
+// RemoveIssuer will delete the metrics of the issuer with the given name,
+// namespace and kind from continuing to be exposed.
+func (m *Metrics) RemoveIssuer(name, namespace, kind string) {
+	m.issuerCAExpiryTimeSeconds.Delete(prometheus.Labels{
+		"name":      name,
+		"namespace": namespace,
+		"kind":      kind,
+	})
+}
+
+func issuerKind(issuer cmapi.GenericIssuer) string {
+	if _, ok := issuer.(*cmapi.ClusterIssuer); ok {
+		return cmapi.ClusterIssuerKind
+	}
+	return cmapi.IssuerKind
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	logtesting "github.com/go-logr/logr/testing"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"k8s.io/utils/clock"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+const issuerCAExpiryMetadata = `
+	# HELP certmanager_issuer_ca_expiration_timestamp_seconds The date after which the CA certificate of the issuer expires. Expressed as a Unix Epoch Time.
+	# TYPE certmanager_issuer_ca_expiration_timestamp_seconds gauge
+`
+
+func TestIssuerCAExpiry(t *testing.T) {
+	m := New(logtesting.NewTestLogger(t), clock.RealClock{})
+
+	notAfter := time.Unix(2208988800, 0)
+	m.UpdateIssuerCAExpiry(gen.Issuer("test-issuer", gen.SetIssuerNamespace("test-ns")), notAfter)
+	m.UpdateIssuerCAExpiry(gen.ClusterIssuer("test-issuer"), notAfter.Add(time.Hour))
+
+	if err := testutil.CollectAndCompare(m.issuerCAExpiryTimeSeconds,
+		strings.NewReader(issuerCAExpiryMetadata+`
+	certmanager_issuer_ca_expiration_timestamp_seconds{kind="ClusterIssuer",name="test-issuer",namespace=""} 2.2089924e+09
+	certmanager_issuer_ca_expiration_timestamp_seconds{kind="Issuer",name="test-issuer",namespace="test-ns"} 2.2089888e+09
+`),
+		"certmanager_issuer_ca_expiration_timestamp_seconds",
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+
+	m.RemoveIssuer("test-issuer", "test-ns", cmapi.IssuerKind)
+
+	if err := testutil.CollectAndCompare(m.issuerCAExpiryTimeSeconds,
+		strings.NewReader(issuerCAExpiryMetadata+`
+	certmanager_issuer_ca_expiration_timestamp_seconds{kind="ClusterIssuer",name="test-issuer",namespace=""} 2.2089924e+09
+`),
+		"certmanager_issuer_ca_expiration_timestamp_seconds",
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+}
@@ -23,6 +23,7 @@ limitations under the License.
 // acme_client_request_duration_seconds{"scheme", "host", "path", "method", "status"}
 // venafi_client_request_duration_seconds{"scheme", "host", "path", "method", "status"}
 // controller_sync_call_count{"controller"}
+// fips_mode
 package metrics
 
 import (
@@ -36,6 +37,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"k8s.io/utils/clock"
 
+	"github.com/cert-manager/cert-manager/internal/fips"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 )
 
@@ -55,12 +57,17 @@ type Metrics struct {
 
 	clockTimeSeconds                   prometheus.CounterFunc
 	clockTimeSecondsGauge              prometheus.GaugeFunc
+	fipsMode                           prometheus.GaugeFunc
 	certificateExpiryTimeSeconds       *prometheus.GaugeVec
 	certificateRenewalTimeSeconds      *prometheus.GaugeVec
 	certificateReadyStatus             *prometheus.GaugeVec
+	certificateReadyDurationSeconds    *prometheus.HistogramVec
+	issuerCAExpiryTimeSeconds          *prometheus.GaugeVec
 	acmeClientRequestDurationSeconds   *prometheus.SummaryVec
 	acmeClientRequestCount             *prometheus.CounterVec
 	venafiClientRequestDurationSeconds *prometheus.SummaryVec
+	venafiRequestDurationSeconds       *prometheus.HistogramVec
+	venafiRequestErrorCount            *prometheus.CounterVec
 	controllerSyncCallCount            *prometheus.CounterVec
 	controllerSyncErrorCount           *prometheus.CounterVec
 }
@@ -101,6 +108,20 @@ func New(log logr.Logger, c clock.Clock) *Metrics {
 			},
 		)
 
+		fipsMode = prometheus.NewGaugeFunc(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "fips_mode",
+				Help:      "Whether the cryptographic module is operating in FIPS 140 mode (1 if it is, 0 if it is not).",
+			},
+			func() float64 {
+				if fips.Enabled() {
+					return 1
+				}
+				return 0
+			},
+		)
+
 		certificateExpiryTimeSeconds = prometheus.NewGaugeVec(
 			prometheus.GaugeOpts{
 				Namespace: namespace,
@@ -128,6 +149,27 @@ func New(log logr.Logger, c clock.Clock) *Metrics {
 			[]string{"name", "namespace", "condition", "issuer_name", "issuer_kind", "issuer_group"},
 		)
 
+		// certificateReadyDurationSeconds is a Prometheus histogram to collect
+		// the time taken for a certificate to be issued and become Ready.
+		certificateReadyDurationSeconds = prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: namespace,
+				Name:      "certificate_ready_seconds",
+				Help:      "The time taken for a certificate to become ready, measured from the creation of the Certificate for an initial issuance, or from the creation of the CertificateRequest for a renewal.",
+				Buckets:   prometheus.ExponentialBuckets(1, 2, 14),
+			},
+			[]string{"issuer_kind", "issuance"},
+		)
+
+		issuerCAExpiryTimeSeconds = prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "issuer_ca_expiration_timestamp_seconds",
+				Help:      "The date after which the CA certificate of the issuer expires. Expressed as a Unix Epoch Time.",
+			},
+			[]string{"name", "namespace", "kind"},
+		)
+
 		// acmeClientRequestCount is a Prometheus summary to collect the number of
 		// requests made to each endpoint with the ACME client.
 		acmeClientRequestCount = prometheus.NewCounterVec(
@@ -168,6 +210,29 @@ func New(log logr.Logger, c clock.Clock) *Metrics {
 			[]string{"api_call"},
 		)
 
+		// venafiRequestDurationSeconds is a Prometheus histogram to collect the
+		// latencies of operations performed by the Venafi client.
+		venafiRequestDurationSeconds = prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: namespace,
+				Name:      "venafi_request_duration_seconds",
+				Help:      "The latencies in seconds of operations performed by the Venafi client.",
+				Buckets:   prometheus.DefBuckets,
+			},
+			[]string{"operation"},
+		)
+
+		// venafiRequestErrorCount is a Prometheus counter to collect the number
+		// of failed operations performed by the Venafi client.
+		venafiRequestErrorCount = prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "venafi_request_error_count",
+				Help:      "The number of failed operations performed by the Venafi client, by HTTP status class.",
+			},
+			[]string{"operation", "status_class"},
+		)
+
 		controllerSyncCallCount = prometheus.NewCounterVec(
 			prometheus.CounterOpts{
 				Namespace: namespace,
@@ -200,32 +265,48 @@ func New(log logr.Logger, c clock.Clock) *Metrics {
 
 		clockTimeSeconds:                   clockTimeSeconds,
 		clockTimeSecondsGauge:              clockTimeSecondsGauge,
+		fipsMode:                           fipsMode,
 		certificateExpiryTimeSeconds:       certificateExpiryTimeSeconds,
 		certificateRenewalTimeSeconds:      certificateRenewalTimeSeconds,
 		certificateReadyStatus:             certificateReadyStatus,
+		certificateReadyDurationSeconds:    certificateReadyDurationSeconds,
+		issuerCAExpiryTimeSeconds:          issuerCAExpiryTimeSeconds,
 		acmeClientRequestCount:             acmeClientRequestCount,
 		acmeClientRequestDurationSeconds:   acmeClientRequestDurationSeconds,
 		venafiClientRequestDurationSeconds: venafiClientRequestDurationSeconds,
+		venafiRequestDurationSeconds:       venafiRequestDurationSeconds,
+		venafiRequestErrorCount:            venafiRequestErrorCount,
 		controllerSyncCallCount:            controllerSyncCallCount,
 		controllerSyncErrorCount:           controllerSyncErrorCount,
 	}
 
-	return m
-}
-
-// NewServer registers Prometheus metrics and returns a new Prometheus metrics HTTP server.
-func (m *Metrics) NewServer(ln net.Listener) *http.Server {
 	m.registry.MustRegister(m.clockTimeSeconds)
 	m.registry.MustRegister(m.clockTimeSecondsGauge)
+	m.registry.MustRegister(m.fipsMode)
 	m.registry.MustRegister(m.certificateExpiryTimeSeconds)
 	m.registry.MustRegister(m.certificateRenewalTimeSeconds)
 	m.registry.MustRegister(m.certificateReadyStatus)
+	m.registry.MustRegister(m.certificateReadyDurationSeconds)
+	m.registry.MustRegister(m.issuerCAExpiryTimeSeconds)
 	m.registry.MustRegister(m.acmeClientRequestDurationSeconds)
 	m.registry.MustRegister(m.venafiClientRequestDurationSeconds)
+	m.registry.MustRegister(m.venafiRequestDurationSeconds)
+	m.registry.MustRegister(m.venafiRequestErrorCount)
 	m.registry.MustRegister(m.acmeClientRequestCount)
 	m.registry.MustRegister(m.controllerSyncCallCount)
 	m.registry.MustRegister(m.controllerSyncErrorCount)
 
+	return m
+}
+
+// Gatherer returns the registry which the Prometheus metrics are registered
+// with.
+func (m *Metrics) Gatherer() prometheus.Gatherer {
+	return m.registry
+}
+
+// NewServer returns a new Prometheus metrics HTTP server.
+func (m *Metrics) NewServer(ln net.Listener) *http.Server {
 	mux := http.NewServeMux()
 	mux.Handle("/metrics", promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{}))
 
@@ -27,6 +27,8 @@ import (
 	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	fakeclock "k8s.io/utils/clock/testing"
+
+	"github.com/cert-manager/cert-manager/internal/fips"
 )
 
 func Test_clockTimeSeconds(t *testing.T) {
@@ -67,3 +69,20 @@ certmanager_clock_time_seconds_gauge %f
 		})
 	}
 }
+
+func Test_fipsMode(t *testing.T) {
+	m := New(logtesting.NewTestLogger(t), fakeclock.NewFakeClock(time.Now()))
+
+	expected := 0.0
+	if fips.Enabled() {
+		expected = 1
+	}
+
+	assert.NoError(t,
+		testutil.CollectAndCompare(m.fipsMode, strings.NewReader(fmt.Sprintf(`
+# HELP certmanager_fips_mode Whether the cryptographic module is operating in FIPS 140 mode (1 if it is, 0 if it is not).
+# TYPE certmanager_fips_mode gauge
+certmanager_fips_mode %f
+`, expected)), "certmanager_fips_mode"),
+	)
+}
@@ -24,3 +24,15 @@ import (
 func (m *Metrics) ObserveVenafiRequestDuration(duration time.Duration, labels ...string) {
 	m.venafiClientRequestDurationSeconds.WithLabelValues(labels...).Observe(duration.Seconds())
 }
+
+// ObserveVenafiOperationDuration increases bucket counters for the duration of
+// the given Venafi client operation.
+func (m *Metrics) ObserveVenafiOperationDuration(operation string, duration time.Duration) {
+	m.venafiRequestDurationSeconds.WithLabelValues(operation).Observe(duration.Seconds())
+}
+
+// IncrementVenafiRequestErrorCount will increase the count of failed Venafi
+// client operations for the given operation and HTTP status class.
+func (m *Metrics) IncrementVenafiRequestErrorCount(operation, statusClass string) {
+	m.venafiRequestErrorCount.WithLabelValues(operation, statusClass).Inc()
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Copied from x509.go
+var (
+	OIDExtensionAuthorityKeyId = []int{2, 5, 29, 35}
+)
+
+// Copied from x509.go
+type authKeyId struct {
+	Id []byte `asn1:"optional,tag:0"`
+}
+
+// Adapted from x509.go
+func MarshalAuthorityKeyIdentifier(keyID []byte) (pkix.Extension, error) {
+	ext := pkix.Extension{Id: OIDExtensionAuthorityKeyId}
+
+	var err error
+	ext.Value, err = asn1.Marshal(authKeyId{Id: keyID})
+	return ext, err
+}
+
+// Adapted from x509.go
+func UnmarshalAuthorityKeyIdentifier(value []byte) ([]byte, error) {
+	var a authKeyId
+	if rest, err := asn1.Unmarshal(value, &a); err != nil {
+		return nil, err
+	} else if len(rest) != 0 {
+		return nil, errors.New("x509: trailing data after X.509 authority key-id")
+	}
+	return a.Id, nil
+}
+
+// ParseKeyIdentifier decodes a hex encoded key identifier, as found in the
+// subject and authority key identifier extensions. The bytes may optionally be
+// separated by colons, as printed by openssl.
+func ParseKeyIdentifier(s string) ([]byte, error) {
+	keyID, err := hex.DecodeString(strings.ReplaceAll(s, ":", ""))
+	if err != nil {
+		return nil, fmt.Errorf("key identifier must be hex encoded: %w", err)
+	}
+	if len(keyID) == 0 {
+		return nil, errors.New("key identifier must not be empty")
+	}
+	return keyID, nil
+}
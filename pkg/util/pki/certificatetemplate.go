@@ -142,8 +142,13 @@ func (k printKeyUsage) String() string {
 }
 
 // CertificateTemplateFromCSR will create a x509.Certificate for the
-// given *x509.CertificateRequest.
+// given *x509.CertificateRequest. In FIPS builds, an error is returned if
+// the public key of the request is not approved for use in FIPS 140 mode.
 func CertificateTemplateFromCSR(csr *x509.CertificateRequest, validatorMutators ...CertificateTemplateValidatorMutator) (*x509.Certificate, error) {
+	if err := checkFIPSPublicKey(csr.PublicKey); err != nil {
+		return nil, err
+	}
+
 	serialNumber, err := rand.Int(rand.Reader, serialNumberLimit)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate serial number: %s", err.Error())
@@ -230,6 +235,12 @@ func CertificateTemplateFromCSR(csr *x509.CertificateRequest, validatorMutators
 			template.ExtraExtensions = append(template.ExtraExtensions, val)
 		}
 
+		// The TLS feature extension (RFC 7633) has no equivalent field on
+		// the certificate template, so it is copied as-is.
+		if val.Id.Equal(OIDExtensionTLSFeature) {
+			template.ExtraExtensions = append(template.ExtraExtensions, val)
+		}
+
 		return nil
 	}
 
@@ -46,6 +46,11 @@ func TestCertificateTemplateFromCSR(t *testing.T) {
 		}
 	}
 
+	mustStapleExtension, err := MarshalMustStaple()
+	if err != nil {
+		t.Fatal(err)
+	}
+
 	testCases := []struct {
 		name     string
 		csr      *x509.CertificateRequest
@@ -105,6 +110,20 @@ func TestCertificateTemplateFromCSR(t *testing.T) {
 				Version: 3,
 			},
 		},
+		{
+			name: "should copy the TLS feature extension",
+			csr: &x509.CertificateRequest{
+				ExtraExtensions: []pkix.Extension{
+					mustStapleExtension,
+				},
+			},
+			expected: &x509.Certificate{
+				Version: 3,
+				ExtraExtensions: []pkix.Extension{
+					mustStapleExtension,
+				},
+			},
+		},
 		{
 			name: "should copy SANs and not fix critical flag subject is set",
 			csr: &x509.CertificateRequest{
@@ -0,0 +1,39 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/x509"
+)
+
+// CertPoolFromCABundle returns a certificate pool containing the PEM-encoded
+// CAs in caBundle. If includeSystemRoots is true, the pool also contains the
+// system root CAs; if the system roots cannot be loaded, the returned pool
+// contains only the CAs in caBundle.
+// The returned bool is false if no certificates could be parsed from
+// caBundle.
+func CertPoolFromCABundle(caBundle []byte, includeSystemRoots bool) (*x509.CertPool, bool) {
+	pool := x509.NewCertPool()
+	if includeSystemRoots {
+		if systemPool, err := x509.SystemCertPool(); err == nil {
+			pool = systemPool
+		}
+	}
+
+	ok := pool.AppendCertsFromPEM(caBundle)
+	return pool, ok
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/x509"
+	"testing"
+)
+
+func TestCertPoolFromCABundle(t *testing.T) {
+	ca := mustCreateBundle(t, nil, "ca")
+
+	pinned := x509.NewCertPool()
+	pinned.AddCert(ca.cert)
+
+	pool, ok := CertPoolFromCABundle(ca.pem, false)
+	if !ok {
+		t.Fatal("expected caBundle to be parsed")
+	}
+	if !pool.Equal(pinned) {
+		t.Error("expected pool to contain only the CAs in caBundle")
+	}
+
+	systemPool, err := x509.SystemCertPool()
+	if err != nil {
+		t.Skipf("system cert pool unavailable: %v", err)
+	}
+	systemPool.AddCert(ca.cert)
+
+	pool, ok = CertPoolFromCABundle(ca.pem, true)
+	if !ok {
+		t.Fatal("expected caBundle to be parsed")
+	}
+	if !pool.Equal(systemPool) {
+		t.Error("expected pool to contain the system roots and the CAs in caBundle")
+	}
+
+	if _, ok := CertPoolFromCABundle([]byte("not a certificate"), false); ok {
+		t.Error("expected invalid caBundle to be rejected")
+	}
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+)
+
+// crlDistributionPointVariable matches a ${name} variable in a CRL
+// distribution point template. A "$" which is not followed by "{" is not a
+// variable and is copied as is.
+var crlDistributionPointVariable = regexp.MustCompile(`\$\{([^}]*)\}`)
+
+// RenderCRLDistributionPoint renders a single CRL distribution point template
+// of a CA issuer. The variables ${serial} and ${issuerName} are substituted
+// with the given serial and issuerName. An error is returned if the template
+// references an unknown variable or does not render to an absolute URL. An
+// ldap URL may have an empty host, e.g. ldap:///cn=example, in which case the
+// client uses its configured LDAP server.
+func RenderCRLDistributionPoint(tmpl, serial, issuerName string) (string, error) {
+	var unknown []string
+	crlURL := crlDistributionPointVariable.ReplaceAllStringFunc(tmpl, func(variable string) string {
+		switch name := crlDistributionPointVariable.FindStringSubmatch(variable)[1]; name {
+		case "serial":
+			return serial
+		case "issuerName":
+			return issuerName
+		default:
+			unknown = append(unknown, name)
+			return variable
+		}
+	})
+	if len(unknown) > 0 {
+		return "", fmt.Errorf("references unknown variables %q, only ${serial} and ${issuerName} are supported", unknown)
+	}
+
+	u, err := url.Parse(crlURL)
+	if err != nil {
+		return "", fmt.Errorf("rendered to an invalid URL: %w", err)
+	}
+	if !u.IsAbs() || (u.Host == "" && u.Scheme != "ldap") {
+		return "", fmt.Errorf("rendered to %q, which is not an absolute URL", crlURL)
+	}
+
+	return crlURL, nil
+}
+
+// ValidateCRLDistributionPointTemplate checks that a CRL distribution point
+// template of a CA issuer only references known variables and renders to an
+// absolute URL, using example values for the variables.
+func ValidateCRLDistributionPointTemplate(tmpl string) error {
+	_, err := RenderCRLDistributionPoint(tmpl, "1", "example-issuer")
+	return err
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"testing"
+)
+
+func TestRenderCRLDistributionPoint(t *testing.T) {
+	tests := map[string]struct {
+		template  string
+		expected  string
+		expectErr bool
+	}{
+		"should leave static distribution points untouched": {
+			template: "http://crl.example.com/ca.crl",
+			expected: "http://crl.example.com/ca.crl",
+		},
+		"should substitute the serial number and issuer name": {
+			template: "http://crl.example.com/${issuerName}/${serial}.crl",
+			expected: "http://crl.example.com/my-ca/abc123.crl",
+		},
+		"should copy a literal $ which is not a ${...} variable": {
+			template: "http://crl.example.com/$issuerName/a$b/${serial}.crl",
+			expected: "http://crl.example.com/$issuerName/a$b/abc123.crl",
+		},
+		"should allow an ldap URL with an empty host": {
+			template: "ldap:///cn=${issuerName},dc=example,dc=com?certificateRevocationList",
+			expected: "ldap:///cn=my-ca,dc=example,dc=com?certificateRevocationList",
+		},
+		"should error on unknown variables": {
+			template:  "http://crl.example.com/${namespace}.crl",
+			expectErr: true,
+		},
+		"should error if the rendered URL is not absolute": {
+			template:  "${issuerName}.crl",
+			expectErr: true,
+		},
+		"should error if an http URL has an empty host": {
+			template:  "http:///${issuerName}.crl",
+			expectErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := RenderCRLDistributionPoint(test.template, "abc123", "my-ca")
+			if (err != nil) != test.expectErr {
+				t.Fatalf("expected error=%t, got %v", test.expectErr, err)
+			}
+			if got != test.expected {
+				t.Errorf("expected %q, got %q", test.expected, got)
+			}
+		})
+	}
+}
@@ -30,6 +30,7 @@ import (
 	"net"
 	"net/netip"
 	"net/url"
+	"slices"
 
 	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
 	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
@@ -83,6 +84,38 @@ func SubjectForCertificate(crt *v1.Certificate) v1.X509Subject {
 	return *crt.Spec.Subject
 }
 
+// SpecForCertificate returns the spec of the Certificate resource with the DNS
+// names and IP addresses resolved from spec.serviceRef, as recorded in
+// status.serviceDNSNames and status.serviceIPAddresses, appended to
+// spec.dnsNames and spec.ipAddresses. It should be used in place of crt.Spec
+// wherever the requested names are compared or encoded.
+func SpecForCertificate(crt *v1.Certificate) v1.CertificateSpec {
+	spec := crt.Spec
+	if spec.ServiceRef == nil {
+		return spec
+	}
+
+	spec.DNSNames = appendMissing(spec.DNSNames, crt.Status.ServiceDNSNames)
+	spec.IPAddresses = appendMissing(spec.IPAddresses, crt.Status.ServiceIPAddresses)
+	return spec
+}
+
+// appendMissing returns a copy of values with each of extra which is not
+// already present appended.
+func appendMissing(values, extra []string) []string {
+	if len(extra) == 0 {
+		return values
+	}
+
+	out := slices.Clone(values)
+	for _, v := range extra {
+		if !slices.Contains(out, v) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
 var serialNumberLimit = new(big.Int).Lsh(big.NewInt(1), 128)
 
 func KeyUsagesForCertificateOrCertificateRequest(usages []v1.KeyUsage, isCA bool) (ku x509.KeyUsage, eku []x509.ExtKeyUsage, err error) {
@@ -178,7 +211,7 @@ func GenerateCSR(crt *v1.Certificate, optFuncs ...GenerateCSROption) (*x509.Cert
 		subject := SubjectForCertificate(crt)
 
 		commonName = crt.Spec.CommonName
-		rdnSubject = pkix.Name{
+		name := pkix.Name{
 			Country:            subject.Countries,
 			Organization:       subject.Organizations,
 			OrganizationalUnit: subject.OrganizationalUnits,
@@ -188,18 +221,26 @@ func GenerateCSR(crt *v1.Certificate, optFuncs ...GenerateCSROption) (*x509.Cert
 			PostalCode:         subject.PostalCodes,
 			SerialNumber:       subject.SerialNumber,
 			CommonName:         commonName,
-		}.ToRDNSequence()
+		}
+		for _, organizationIdentifier := range subject.OrganizationIdentifiers {
+			name.ExtraNames = append(name.ExtraNames, pkix.AttributeTypeAndValue{
+				Type:  OIDConstants.OrganizationIdentifier,
+				Value: organizationIdentifier,
+			})
+		}
+		rdnSubject = name.ToRDNSequence()
 	}
 
 	// Generate the SANs for the CSR.
-	ipAddresses, err := IPAddressesFromStrings(crt.Spec.IPAddresses)
+	spec := SpecForCertificate(crt)
+	ipAddresses, err := IPAddressesFromStrings(spec.IPAddresses)
 	if err != nil {
 		return nil, err
 	}
 
 	sans := GeneralNames{
 		RFC822Names:                crt.Spec.EmailAddresses,
-		DNSNames:                   crt.Spec.DNSNames,
+		DNSNames:                   spec.DNSNames,
 		UniformResourceIdentifiers: crt.Spec.URIs,
 		IPAddresses:                ipAddresses,
 	}
@@ -286,6 +327,14 @@ func GenerateCSR(crt *v1.Certificate, optFuncs ...GenerateCSROption) (*x509.Cert
 		extraExtensions = append(extraExtensions, basicExtension)
 	}
 
+	if crt.Spec.MustStaple {
+		mustStapleExtension, err := MarshalMustStaple()
+		if err != nil {
+			return nil, err
+		}
+		extraExtensions = append(extraExtensions, mustStapleExtension)
+	}
+
 	if opts.EncodeNameConstraints && crt.Spec.NameConstraints != nil {
 		nameConstraints := &NameConstraints{}
 
@@ -296,7 +345,7 @@ func GenerateCSR(crt *v1.Certificate, optFuncs ...GenerateCSROption) (*x509.Cert
 				return nil, err
 			}
 			nameConstraints.PermittedEmailAddresses = crt.Spec.NameConstraints.Permitted.EmailAddresses
-			nameConstraints.ExcludedURIDomains = crt.Spec.NameConstraints.Permitted.URIDomains
+			nameConstraints.PermittedURIDomains = crt.Spec.NameConstraints.Permitted.URIDomains
 		}
 
 		if crt.Spec.NameConstraints.Excluded != nil {
@@ -442,6 +491,9 @@ func SignatureAlgorithm(crt *v1.Certificate) (x509.PublicKeyAlgorithm, x509.Sign
 	if crt.Spec.PrivateKey != nil {
 		specAlgorithm = crt.Spec.PrivateKey.Algorithm
 	}
+	if err := checkFIPSPrivateKey(crt.Spec.PrivateKey); err != nil {
+		return x509.UnknownPublicKeyAlgorithm, x509.UnknownSignatureAlgorithm, err
+	}
 	switch specAlgorithm {
 	case v1.PrivateKeyAlgorithm(""):
 		// If keyAlgorithm is not specified, we default to rsa with keysize 2048
@@ -465,6 +517,12 @@ func SignatureAlgorithm(crt *v1.Certificate) (x509.PublicKeyAlgorithm, x509.Sign
 	case v1.Ed25519KeyAlgorithm:
 		pubKeyAlgo = x509.Ed25519
 		sigAlgo = x509.PureEd25519
+	case v1.MLDSAKeyAlgorithm:
+		keySize := MLDSA65
+		if crt.Spec.PrivateKey.Size > 0 {
+			keySize = crt.Spec.PrivateKey.Size
+		}
+		return mldsaSignatureAlgorithm(keySize)
 	case v1.ECDSAKeyAlgorithm:
 		pubKeyAlgo = x509.ECDSA
 		switch crt.Spec.PrivateKey.Size {
@@ -22,6 +22,7 @@ import (
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/asn1"
+	"encoding/pem"
 	"fmt"
 	"math/big"
 	"net"
@@ -239,6 +240,58 @@ func TestSignatureAlgorithmForCertificate(t *testing.T) {
 	}
 }
 
+func TestSpecForCertificate(t *testing.T) {
+	serviceDNSNames := []string{"web", "web.testns", "web.testns.svc", "web.testns.svc.cluster.local"}
+	tests := map[string]struct {
+		crt            *cmapi.Certificate
+		expDNSNames    []string
+		expIPAddresses []string
+	}{
+		"no service reference": {
+			crt: &cmapi.Certificate{
+				Spec:   cmapi.CertificateSpec{DNSNames: []string{"example.com"}},
+				Status: cmapi.CertificateStatus{ServiceDNSNames: serviceDNSNames},
+			},
+			expDNSNames: []string{"example.com"},
+		},
+		"service reference not yet resolved": {
+			crt: &cmapi.Certificate{
+				Spec: cmapi.CertificateSpec{DNSNames: []string{"example.com"}, ServiceRef: &cmapi.CertificateServiceReference{Name: "web"}},
+			},
+			expDNSNames: []string{"example.com"},
+		},
+		"service DNS names are appended without duplicates": {
+			crt: &cmapi.Certificate{
+				Spec:   cmapi.CertificateSpec{DNSNames: []string{"example.com", "web.testns.svc"}, ServiceRef: &cmapi.CertificateServiceReference{Name: "web"}},
+				Status: cmapi.CertificateStatus{ServiceDNSNames: serviceDNSNames},
+			},
+			expDNSNames: []string{"example.com", "web.testns.svc", "web", "web.testns", "web.testns.svc.cluster.local"},
+		},
+		"service IP addresses are appended without duplicates": {
+			crt: &cmapi.Certificate{
+				Spec:   cmapi.CertificateSpec{IPAddresses: []string{"10.0.0.1"}, ServiceRef: &cmapi.CertificateServiceReference{Name: "web", IncludeIPAddresses: true}},
+				Status: cmapi.CertificateStatus{ServiceIPAddresses: []string{"10.0.0.1", "192.0.2.10"}},
+			},
+			expIPAddresses: []string{"10.0.0.1", "192.0.2.10"},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			orig := test.crt.DeepCopy()
+			spec := SpecForCertificate(test.crt)
+			if !reflect.DeepEqual(spec.DNSNames, test.expDNSNames) {
+				t.Errorf("unexpected dnsNames, exp=%v got=%v", test.expDNSNames, spec.DNSNames)
+			}
+			if !reflect.DeepEqual(spec.IPAddresses, test.expIPAddresses) {
+				t.Errorf("unexpected ipAddresses, exp=%v got=%v", test.expIPAddresses, spec.IPAddresses)
+			}
+			if !reflect.DeepEqual(orig, test.crt) {
+				t.Errorf("the Certificate should not be modified")
+			}
+		})
+	}
+}
+
 func TestRemoveDuplicates(t *testing.T) {
 	type testT struct {
 		input  []string
@@ -389,6 +442,15 @@ func TestGenerateCSR(t *testing.T) {
 		}
 	}
 
+	nameConstraintsGenerator := func(t *testing.T, nameConstraints *NameConstraints, critical bool) pkix.Extension {
+		extension, err := MarshalNameConstraints(nameConstraints, critical)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return extension
+	}
+
 	literalSubectGenerator := func(t *testing.T, literal string) []byte {
 		rawSubject, err := UnmarshalSubjectStringToRDNSequence(literal)
 		if err != nil {
@@ -435,6 +497,34 @@ func TestGenerateCSR(t *testing.T) {
 				RawSubject: subjectGenerator(t, pkix.Name{}),
 			},
 		},
+		{
+			name: "Generate CSR from certificate with mustStaple",
+			crt:  &cmapi.Certificate{Spec: cmapi.CertificateSpec{DNSNames: []string{"example.org"}, MustStaple: true}},
+			want: &x509.CertificateRequest{
+				Version:            0,
+				SignatureAlgorithm: x509.SHA256WithRSA,
+				PublicKeyAlgorithm: x509.RSA,
+				ExtraExtensions: []pkix.Extension{
+					sansGenerator(
+						t,
+						[]asn1.RawValue{
+							{Tag: nameTypeDNSName, Class: 2, Bytes: []byte("example.org")},
+						},
+						true, // SAN is critical as the Subject is empty
+					),
+					{
+						Id:       OIDExtensionKeyUsage,
+						Value:    asn1DefaultKeyUsage,
+						Critical: true,
+					},
+					{
+						Id:    asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 1, 24},
+						Value: []byte{0x30, 0x03, 0x02, 0x01, 0x05}, // SEQUENCE { INTEGER 5 (status_request) }
+					},
+				},
+				RawSubject: subjectGenerator(t, pkix.Name{}),
+			},
+		},
 		{
 			name: "Generate CSR from certificate with subject and DNS",
 			crt: &cmapi.Certificate{Spec: cmapi.CertificateSpec{
@@ -662,6 +752,37 @@ func TestGenerateCSR(t *testing.T) {
 			crt:     &cmapi.Certificate{Spec: cmapi.CertificateSpec{}},
 			wantErr: true,
 		},
+		{
+			name: "Generate CSR from certificate with organizationIdentifiers and serialNumber",
+			crt: &cmapi.Certificate{Spec: cmapi.CertificateSpec{
+				CommonName: "example.org",
+				Subject: &cmapi.X509Subject{
+					Organizations:           []string{"Example Org"},
+					OrganizationIdentifiers: []string{"VATGB-123456789"},
+					SerialNumber:            "42",
+				},
+			}},
+			want: &x509.CertificateRequest{
+				Version:            0,
+				SignatureAlgorithm: x509.SHA256WithRSA,
+				PublicKeyAlgorithm: x509.RSA,
+				ExtraExtensions: []pkix.Extension{
+					{
+						Id:       OIDExtensionKeyUsage,
+						Value:    asn1DefaultKeyUsage,
+						Critical: true,
+					},
+				},
+				RawSubject: subjectGenerator(t, pkix.Name{
+					CommonName:   "example.org",
+					Organization: []string{"Example Org"},
+					SerialNumber: "42",
+					ExtraNames: []pkix.AttributeTypeAndValue{
+						{Type: OIDConstants.OrganizationIdentifier, Value: "VATGB-123456789"},
+					},
+				}),
+			},
+		},
 		{
 			name: "Generate CSR from certficate with literal subject honouring the exact order",
 			crt:  &cmapi.Certificate{Spec: cmapi.CertificateSpec{LiteralSubject: exampleLiteralSubject}},
@@ -834,6 +955,40 @@ func TestGenerateCSR(t *testing.T) {
 			},
 			nameConstraintsFeatureEnabled: true,
 		},
+		{
+			name: "Generate CSR from certificate with permitted and excluded URI domain NameConstraints",
+			crt: &cmapi.Certificate{Spec: cmapi.CertificateSpec{
+				CommonName: "example.org",
+				IsCA:       true,
+				NameConstraints: &cmapi.NameConstraints{
+					Critical: true,
+					Permitted: &cmapi.NameConstraintItem{
+						URIDomains: []string{"example.org"},
+					},
+					Excluded: &cmapi.NameConstraintItem{
+						URIDomains: []string{"excluded.example.org"},
+					},
+				},
+			}},
+			want: &x509.CertificateRequest{
+				Version:            0,
+				SignatureAlgorithm: x509.SHA256WithRSA,
+				PublicKeyAlgorithm: x509.RSA,
+				ExtraExtensions: []pkix.Extension{
+					{
+						Id:       OIDExtensionKeyUsage,
+						Value:    asn1KeyUsageWithCa,
+						Critical: true,
+					},
+					nameConstraintsGenerator(t, &NameConstraints{
+						PermittedURIDomains: []string{"example.org"},
+						ExcludedURIDomains:  []string{"excluded.example.org"},
+					}, true),
+				},
+				RawSubject: subjectGenerator(t, pkix.Name{CommonName: "example.org"}),
+			},
+			nameConstraintsFeatureEnabled: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -876,6 +1031,74 @@ func TestGenerateCSR(t *testing.T) {
 	}
 }
 
+func TestSignCSRSubjectAttributes(t *testing.T) {
+	pk, err := GenerateECPrivateKey(ECCurve256)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		crt                            *cmapi.Certificate
+		literalCertificateSubject      bool
+		expectedOrganizationIdentifier string
+		expectedSerialNumber           string
+	}{
+		"subject": {
+			crt: &cmapi.Certificate{Spec: cmapi.CertificateSpec{
+				CommonName: "example.org",
+				Subject: &cmapi.X509Subject{
+					OrganizationIdentifiers: []string{"VATGB-123456789"},
+					SerialNumber:            "42",
+				},
+			}},
+			expectedOrganizationIdentifier: "VATGB-123456789",
+			expectedSerialNumber:           "42",
+		},
+		"literalSubject": {
+			crt: &cmapi.Certificate{Spec: cmapi.CertificateSpec{
+				LiteralSubject: "CN=example.org,ORGANIZATIONIDENTIFIER=NTRGB-0123456,SERIALNUMBER=43",
+			}},
+			literalCertificateSubject:      true,
+			expectedOrganizationIdentifier: "NTRGB-0123456",
+			expectedSerialNumber:           "43",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.crt.Spec.PrivateKey = &cmapi.CertificatePrivateKey{Algorithm: cmapi.ECDSAKeyAlgorithm}
+
+			csr, err := GenerateCSR(test.crt, WithUseLiteralSubject(test.literalCertificateSubject))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			csrDER, err := EncodeCSR(csr, pk)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			csrPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDER})
+			template, err := CertificateTemplateFromCSRPEM(csrPEM)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, cert, err := SignCertificate(template, template, pk.Public(), pk)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := OrganizationIdentifiersFromName(cert.Subject); !reflect.DeepEqual(got, []string{test.expectedOrganizationIdentifier}) {
+				t.Errorf("unexpected organizationIdentifiers, exp=%q, got=%q", test.expectedOrganizationIdentifier, got)
+			}
+			if cert.Subject.SerialNumber != test.expectedSerialNumber {
+				t.Errorf("unexpected serialNumber, exp=%q, got=%q", test.expectedSerialNumber, cert.Subject.SerialNumber)
+			}
+		})
+	}
+}
+
 func TestSignCSRTemplate(t *testing.T) {
 	// We want to test the behavior of SignCSRTemplate in various contexts;
 	// for that, we construct a chain of four certificates:
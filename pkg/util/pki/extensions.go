@@ -0,0 +1,52 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"encoding/asn1"
+)
+
+// managedExtensions are the X.509 extensions which cert-manager or
+// crypto/x509 compute from the certificate template when signing. A copy of
+// any of these taken from a certificate signing request would take precedence
+// over the computed value, and so must never be passed through.
+var managedExtensions = []asn1.ObjectIdentifier{
+	{2, 5, 29, 14},                  // subjectKeyIdentifier
+	OIDExtensionKeyUsage,            // keyUsage
+	oidExtensionSubjectAltName,      // subjectAltName
+	OIDExtensionBasicConstraints,    // basicConstraints
+	OIDExtensionNameConstraints,     // nameConstraints
+	{2, 5, 29, 31},                  // cRLDistributionPoints
+	{2, 5, 29, 32},                  // certificatePolicies
+	OIDExtensionAuthorityKeyId,      // authorityKeyIdentifier
+	OIDExtensionExtendedKeyUsage,    // extKeyUsage
+	{1, 3, 6, 1, 5, 5, 7, 1, 1},     // authorityInfoAccess
+	OIDExtensionTLSFeature,          // tlsFeature
+	{1, 3, 6, 1, 5, 5, 7, 48, 1, 5}, // id-pkix-ocsp-nocheck
+}
+
+// IsManagedExtension returns true if the extension with the given OID is
+// computed by cert-manager when signing a certificate, and so must not be
+// copied from a certificate signing request.
+func IsManagedExtension(oid asn1.ObjectIdentifier) bool {
+	for _, managed := range managedExtensions {
+		if oid.Equal(managed) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"fmt"
+
+	"github.com/cert-manager/cert-manager/internal/fips"
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// checkFIPSPrivateKey returns an error if cert-manager is a FIPS build and
+// the given private key parameters are not approved for use in FIPS 140
+// mode. Standard builds allow all supported private key algorithms. RSA keys
+// smaller than MinRSAKeySize are rejected in all builds, so only the
+// algorithm is checked here.
+func checkFIPSPrivateKey(pk *v1.CertificatePrivateKey) error {
+	if !fips.Build || pk == nil {
+		return nil
+	}
+
+	if pk.Algorithm == v1.Ed25519KeyAlgorithm {
+		return fmt.Errorf("the %s private key algorithm is not allowed in FIPS builds of cert-manager", pk.Algorithm)
+	}
+
+	return nil
+}
+
+// checkFIPSPublicKey returns an error if cert-manager is a FIPS build and
+// the given public key, usually that of a certificate signing request, is
+// not approved for use in FIPS 140 mode. Standard builds allow all public
+// keys.
+func checkFIPSPublicKey(pub crypto.PublicKey) error {
+	if !fips.Build {
+		return nil
+	}
+
+	switch pub := pub.(type) {
+	case *rsa.PublicKey:
+		if pub.N.BitLen() < MinRSAKeySize {
+			return fmt.Errorf("rsa key size %d is not allowed in FIPS builds of cert-manager. minimum key size: %d", pub.N.BitLen(), MinRSAKeySize)
+		}
+	case ed25519.PublicKey:
+		return fmt.Errorf("the %s public key algorithm is not allowed in FIPS builds of cert-manager", v1.Ed25519KeyAlgorithm)
+	}
+
+	return nil
+}
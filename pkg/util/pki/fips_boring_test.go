@@ -0,0 +1,138 @@
+//go:build boringcrypto
+
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"testing"
+
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+func TestFIPSBuildRejectsDisallowedPrivateKeys(t *testing.T) {
+	tests := map[string]struct {
+		keyAlgo   v1.PrivateKeyAlgorithm
+		keySize   int
+		expectErr bool
+	}{
+		"Ed25519 is rejected": {
+			keyAlgo:   v1.Ed25519KeyAlgorithm,
+			expectErr: true,
+		},
+		"RSA 2048 is allowed": {
+			keyAlgo: v1.RSAKeyAlgorithm,
+			keySize: 2048,
+		},
+		"ECDSA P-256 is allowed": {
+			keyAlgo: v1.ECDSAKeyAlgorithm,
+			keySize: ECCurve256,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			crt := buildCertificateWithKeyParams(test.keyAlgo, test.keySize)
+
+			_, err := GeneratePrivateKeyForCertificate(crt)
+			if test.expectErr != (err != nil) {
+				t.Errorf("GeneratePrivateKeyForCertificate: expected error %t but got: %v", test.expectErr, err)
+			}
+
+			_, _, err = SignatureAlgorithm(crt)
+			if test.expectErr != (err != nil) {
+				t.Errorf("SignatureAlgorithm: expected error %t but got: %v", test.expectErr, err)
+			}
+		})
+	}
+}
+
+func TestFIPSBuildRejectsExistingEd25519PrivateKey(t *testing.T) {
+	_, pk, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyBytes, err := EncodePKCS8PrivateKey(pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := LoadPrivateKeyForCertificate(buildCertificateWithKeyParams(v1.Ed25519KeyAlgorithm, 0), keyBytes); err == nil {
+		t.Errorf("expected an error loading an Ed25519 private key in a FIPS build")
+	}
+}
+
+func TestFIPSBuildRejectsDisallowedCSRPublicKeys(t *testing.T) {
+	tests := map[string]struct {
+		newKey    func() (crypto.Signer, error)
+		expectErr bool
+	}{
+		"Ed25519 is rejected": {
+			newKey: func() (crypto.Signer, error) {
+				_, pk, err := ed25519.GenerateKey(rand.Reader)
+				return pk, err
+			},
+			expectErr: true,
+		},
+		"RSA below 2048 is rejected": {
+			newKey: func() (crypto.Signer, error) {
+				return rsa.GenerateKey(rand.Reader, 1024)
+			},
+			expectErr: true,
+		},
+		"RSA 2048 is allowed": {
+			newKey: func() (crypto.Signer, error) {
+				return rsa.GenerateKey(rand.Reader, 2048)
+			},
+		},
+		"ECDSA P-256 is allowed": {
+			newKey: func() (crypto.Signer, error) {
+				return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			pk, err := test.newKey()
+			if err != nil {
+				t.Fatal(err)
+			}
+			csrDER, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+				Subject: pkix.Name{CommonName: "example.com"},
+			}, pk)
+			if err != nil {
+				t.Fatal(err)
+			}
+			csrPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDER})
+
+			_, err = CertificateTemplateFromCSRPEM(csrPEM)
+			if test.expectErr != (err != nil) {
+				t.Errorf("CertificateTemplateFromCSRPEM: expected error %t but got: %v", test.expectErr, err)
+			}
+		})
+	}
+}
@@ -45,17 +45,27 @@ const (
 	ECCurve384 = 384
 	// ECCurve521 represents a secp521r1 / NIST P-521 ECDSA key.
 	ECCurve521 = 521
+
+	// MLDSA44 represents an ML-DSA-44 key.
+	MLDSA44 = 44
+	// MLDSA65 represents an ML-DSA-65 key.
+	MLDSA65 = 65
+	// MLDSA87 represents an ML-DSA-87 key.
+	MLDSA87 = 87
 )
 
 // GeneratePrivateKeyForCertificate will generate a private key suitable for
 // the provided cert-manager Certificate resource, taking into account the
 // parameters on the provided resource.
-// The returned key will either be RSA or ECDSA.
+// The returned key will either be RSA, ECDSA, Ed25519 or ML-DSA.
 func GeneratePrivateKeyForCertificate(crt *v1.Certificate) (crypto.Signer, error) {
 	crt = crt.DeepCopy()
 	if crt.Spec.PrivateKey == nil {
 		crt.Spec.PrivateKey = &v1.CertificatePrivateKey{}
 	}
+	if err := checkFIPSPrivateKey(crt.Spec.PrivateKey); err != nil {
+		return nil, err
+	}
 	switch crt.Spec.PrivateKey.Algorithm {
 	case v1.PrivateKeyAlgorithm(""), v1.RSAKeyAlgorithm:
 		keySize := MinRSAKeySize
@@ -75,11 +85,46 @@ func GeneratePrivateKeyForCertificate(crt *v1.Certificate) (crypto.Signer, error
 		return GenerateECPrivateKey(keySize)
 	case v1.Ed25519KeyAlgorithm:
 		return GenerateEd25519PrivateKey()
+	case v1.MLDSAKeyAlgorithm:
+		keySize := MLDSA65
+
+		if crt.Spec.PrivateKey.Size > 0 {
+			keySize = crt.Spec.PrivateKey.Size
+		}
+
+		return generateMLDSAPrivateKey(keySize)
 	default:
 		return nil, fmt.Errorf("unsupported private key algorithm specified: %s", crt.Spec.PrivateKey.Algorithm)
 	}
 }
 
+// LoadPrivateKeyForCertificate will decode the given PEM encoded private key
+// and check that it is suitable for the provided cert-manager Certificate
+// resource. It is used in place of GeneratePrivateKeyForCertificate when the
+// Certificate references an existing private key.
+// An error is returned if the key's algorithm or size does not match the
+// parameters on the provided resource.
+func LoadPrivateKeyForCertificate(crt *v1.Certificate, keyBytes []byte) (crypto.Signer, error) {
+	if err := checkFIPSPrivateKey(crt.Spec.PrivateKey); err != nil {
+		return nil, err
+	}
+
+	pk, err := DecodePrivateKeyBytes(keyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	violations, err := PrivateKeyMatchesSpec(pk, crt.Spec)
+	if err != nil {
+		return nil, err
+	}
+	if len(violations) > 0 {
+		return nil, fmt.Errorf("existing private key does not match the certificate's private key requirements, mismatching fields: %v", violations)
+	}
+
+	return pk, nil
+}
+
 // GenerateRSAPrivateKey will generate a RSA private key of the given size.
 // It places restrictions on the minimum and maximum RSA keysize.
 func GenerateRSAPrivateKey(keySize int) (*rsa.PrivateKey, error) {
@@ -108,7 +153,7 @@ func GenerateECPrivateKey(keySize int) (*ecdsa.PrivateKey, error) {
 	case ECCurve521:
 		ecCurve = elliptic.P521()
 	default:
-		return nil, fmt.Errorf("unsupported ecdsa key size specified: %d", keySize)
+		return nil, fmt.Errorf("unsupported ecdsa key size specified: %d. supported key sizes: %d, %d, %d", keySize, ECCurve256, ECCurve384, ECCurve521)
 	}
 
 	return ecdsa.GenerateKey(ecCurve, rand.Reader)
@@ -135,6 +180,11 @@ func EncodePrivateKey(pk crypto.PrivateKey, keyEncoding v1.PrivateKeyEncoding) (
 		case ed25519.PrivateKey:
 			return EncodePKCS8PrivateKey(k)
 		default:
+			// ML-DSA keys have no PKCS#1 form, so they are always PKCS#8
+			// encoded like Ed25519 keys.
+			if _, ok := mldsaPrivateKeySize(pk); ok {
+				return EncodePKCS8PrivateKey(pk)
+			}
 			return nil, fmt.Errorf("error encoding private key: unknown key type: %T", pk)
 		}
 	case v1.PKCS8:
@@ -184,6 +234,9 @@ func PublicKeyForPrivateKey(pk crypto.PrivateKey) (crypto.PublicKey, error) {
 	case ed25519.PrivateKey:
 		return k.Public(), nil
 	default:
+		if _, ok := mldsaPrivateKeySize(pk); ok {
+			return pk.(crypto.Signer).Public(), nil
+		}
 		return nil, fmt.Errorf("unknown private key type: %T", pk)
 	}
 }
@@ -218,6 +271,10 @@ func PublicKeysEqual(a, b crypto.PublicKey) (bool, error) {
 		return pub.Equal(b), nil
 	case ed25519.PublicKey:
 		return pub.Equal(b), nil
+	case interface{ Equal(crypto.PublicKey) bool }:
+		// Other key types supported by crypto/x509, such as ML-DSA, all
+		// implement Equal.
+		return pub.Equal(b), nil
 	default:
 		return false, fmt.Errorf("unrecognised public key type: %T", a)
 	}
@@ -277,6 +277,111 @@ func signTestCert(key crypto.Signer) *x509.Certificate {
 	return crt
 }
 
+func TestLoadPrivateKeyForCertificate(t *testing.T) {
+	mustEncode := func(pk crypto.Signer) []byte {
+		b, err := EncodePKCS8PrivateKey(pk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+
+	rsaKey, err := GenerateRSAPrivateKey(2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := GenerateECPrivateKey(ECCurve384)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		crt      *v1.Certificate
+		keyBytes []byte
+		key      crypto.Signer
+		err      string
+	}{
+		"should load an RSA key for a certificate with the RSA algorithm": {
+			crt:      buildCertificateWithKeyParams(v1.RSAKeyAlgorithm, 2048),
+			keyBytes: mustEncode(rsaKey),
+			key:      rsaKey,
+		},
+		"should load an RSA key for a certificate with no algorithm set": {
+			crt:      buildCertificateWithKeyParams("", 0),
+			keyBytes: mustEncode(rsaKey),
+			key:      rsaKey,
+		},
+		"should load an ECDSA key for a certificate with the ECDSA algorithm": {
+			crt:      buildCertificateWithKeyParams(v1.ECDSAKeyAlgorithm, ECCurve384),
+			keyBytes: mustEncode(ecKey),
+			key:      ecKey,
+		},
+		"should fail if the key algorithm does not match": {
+			crt:      buildCertificateWithKeyParams(v1.ECDSAKeyAlgorithm, ECCurve384),
+			keyBytes: mustEncode(rsaKey),
+			err:      "existing private key does not match the certificate's private key requirements, mismatching fields: [spec.privateKey.algorithm]",
+		},
+		"should fail if the key size does not match": {
+			crt:      buildCertificateWithKeyParams(v1.ECDSAKeyAlgorithm, ECCurve256),
+			keyBytes: mustEncode(ecKey),
+			err:      "existing private key does not match the certificate's private key requirements, mismatching fields: [spec.privateKey.size]",
+		},
+		"should fail if the key cannot be decoded": {
+			crt:      buildCertificateWithKeyParams(v1.RSAKeyAlgorithm, 2048),
+			keyBytes: []byte("not a key"),
+			err:      "error decoding private key PEM block",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			pk, err := LoadPrivateKeyForCertificate(test.crt, test.keyBytes)
+			if test.err != "" {
+				if err == nil || err.Error() != test.err {
+					t.Fatalf("expected error %q, got: %v", test.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			equal, err := PublicKeysEqual(pk.Public(), test.key.Public())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !equal {
+				t.Fatalf("expected the loaded private key to equal the supplied key")
+			}
+
+			// The CSR built for the certificate must be signed by, and
+			// contain the public key of, the supplied key.
+			template, err := GenerateCSR(test.crt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			csrDER, err := EncodeCSR(template, pk)
+			if err != nil {
+				t.Fatal(err)
+			}
+			csr, err := x509.ParseCertificateRequest(csrDER)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := csr.CheckSignature(); err != nil {
+				t.Fatalf("CSR signature is invalid: %v", err)
+			}
+			matches, err := PublicKeyMatchesCSR(test.key.Public(), csr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !matches {
+				t.Fatalf("expected the CSR to contain the public key of the supplied key")
+			}
+		})
+	}
+}
+
 func TestPublicKeyMatchesCertificate(t *testing.T) {
 	privKey1, err := GenerateRSAPrivateKey(2048)
 	if err != nil {
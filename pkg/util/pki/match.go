@@ -36,7 +36,7 @@ import (
 )
 
 // PrivateKeyMatchesSpec returns an error if the private key bit size
-// doesn't match the provided spec. RSA, Ed25519 and ECDSA are supported.
+// doesn't match the provided spec. RSA, Ed25519, ECDSA and ML-DSA are supported.
 // If any error is returned, a list of violations will also be returned.
 func PrivateKeyMatchesSpec(pk crypto.PrivateKey, spec cmapi.CertificateSpec) ([]string, error) {
 	spec = *spec.DeepCopy()
@@ -50,6 +50,8 @@ func PrivateKeyMatchesSpec(pk crypto.PrivateKey, spec cmapi.CertificateSpec) ([]
 		return ed25519PrivateKeyMatchesSpec(pk)
 	case cmapi.ECDSAKeyAlgorithm:
 		return ecdsaPrivateKeyMatchesSpec(pk, spec)
+	case cmapi.MLDSAKeyAlgorithm:
+		return mldsaPrivateKeyMatchesSpec(pk, spec)
 	default:
 		return nil, fmt.Errorf("unrecognised key algorithm type %q", spec.PrivateKey.Algorithm)
 	}
@@ -106,6 +108,22 @@ func ed25519PrivateKeyMatchesSpec(pk crypto.PrivateKey) ([]string, error) {
 	return nil, nil
 }
 
+func mldsaPrivateKeyMatchesSpec(pk crypto.PrivateKey, spec cmapi.CertificateSpec) ([]string, error) {
+	size, ok := mldsaPrivateKeySize(pk)
+	if !ok {
+		return []string{"spec.privateKey.algorithm"}, nil
+	}
+	// The default ML-DSA parameter set is ML-DSA-65
+	expectedKeySize := MLDSA65
+	if spec.PrivateKey.Size > 0 {
+		expectedKeySize = spec.PrivateKey.Size
+	}
+	if expectedKeySize != size {
+		return []string{"spec.privateKey.size"}, nil
+	}
+	return nil, nil
+}
+
 func ipSlicesMatch(parsedIPs []net.IP, stringIPs []string) bool {
 	parsedStringIPs := make([]net.IP, len(stringIPs))
 
@@ -189,6 +207,9 @@ func RequestMatchesSpec(req *cmapi.CertificateRequest, spec cmapi.CertificateSpe
 		if !util.EqualUnsorted(x509req.Subject.StreetAddress, spec.Subject.StreetAddresses) {
 			violations = append(violations, "spec.subject.streetAddresses")
 		}
+		if !util.EqualUnsorted(OrganizationIdentifiersFromName(x509req.Subject), spec.Subject.OrganizationIdentifiers) {
+			violations = append(violations, "spec.subject.organizationIdentifiers")
+		}
 
 	} else {
 		// we have a LiteralSubject, generate the RDNSequence and encode it to compare
@@ -212,6 +233,13 @@ func RequestMatchesSpec(req *cmapi.CertificateRequest, spec cmapi.CertificateSpe
 	if req.Spec.IsCA != spec.IsCA {
 		violations = append(violations, "spec.isCA")
 	}
+	mustStaple, err := HasMustStaple(x509req.Extensions)
+	if err != nil {
+		return nil, err
+	}
+	if mustStaple != spec.MustStaple {
+		violations = append(violations, "spec.mustStaple")
+	}
 	if !util.EqualKeyUsagesUnsorted(req.Spec.Usages, spec.Usages) {
 		violations = append(violations, "spec.usages")
 	}
@@ -229,43 +257,15 @@ func RequestMatchesSpec(req *cmapi.CertificateRequest, spec cmapi.CertificateSpe
 }
 
 func matchOtherNames(extension []pkix.Extension, specOtherNames []cmapi.OtherName) (bool, error) {
-	x509SANExtension, err := extractSANExtension(extension)
-	if err != nil {
+	if _, err := extractSANExtension(extension); err != nil {
 		return false, nil
 	}
 
-	x509GeneralNames, err := UnmarshalSANs(x509SANExtension.Value)
+	x509OtherNames, err := OtherNamesFromExtensions(extension)
 	if err != nil {
 		return false, err
 	}
 
-	x509OtherNames := make([]cmapi.OtherName, 0, len(x509GeneralNames.OtherNames))
-	for _, otherName := range x509GeneralNames.OtherNames {
-
-		var otherNameInnerValue asn1.RawValue
-		// We have to perform one more level of unwrapping because value is still context specific class
-		// tagged 0
-		_, err := asn1.Unmarshal(otherName.Value.Bytes, &otherNameInnerValue)
-		if err != nil {
-			return false, err
-		}
-
-		uv, err := UnmarshalUniversalValue(otherNameInnerValue)
-		if err != nil {
-			return false, err
-		}
-
-		if uv.Type() != UniversalValueTypeUTF8String {
-			// This means the CertificateRequest's otherName was not an utf8 value
-			return false, fmt.Errorf("otherName is not an utf8 value, got: %v", uv.Type())
-		}
-
-		x509OtherNames = append(x509OtherNames, cmapi.OtherName{
-			OID:       otherName.TypeID.String(),
-			UTF8Value: uv.UTF8String,
-		})
-	}
-
 	if !util.EqualOtherNamesUnsorted(x509OtherNames, specOtherNames) {
 		return false, nil
 	}
@@ -325,6 +325,50 @@ func SecretDataAltNamesMatchSpec(secret *corev1.Secret, spec cmapi.CertificateSp
 	return violations, nil
 }
 
+// OtherNamesFromExtensions returns the otherName SANs from the SAN extension in
+// the given extensions, if present. Only otherNames with UTF8String values are
+// supported.
+func OtherNamesFromExtensions(extensions []pkix.Extension) ([]cmapi.OtherName, error) {
+	x509SANExtension, err := extractSANExtension(extensions)
+	if err != nil {
+		return nil, nil
+	}
+
+	x509GeneralNames, err := UnmarshalSANs(x509SANExtension.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	otherNames := make([]cmapi.OtherName, 0, len(x509GeneralNames.OtherNames))
+	for _, otherName := range x509GeneralNames.OtherNames {
+
+		var otherNameInnerValue asn1.RawValue
+		// We have to perform one more level of unwrapping because value is still context specific class
+		// tagged 0
+		_, err := asn1.Unmarshal(otherName.Value.Bytes, &otherNameInnerValue)
+		if err != nil {
+			return nil, err
+		}
+
+		uv, err := UnmarshalUniversalValue(otherNameInnerValue)
+		if err != nil {
+			return nil, err
+		}
+
+		if uv.Type() != UniversalValueTypeUTF8String {
+			// This means the CertificateRequest's otherName was not an utf8 value
+			return nil, fmt.Errorf("otherName is not an utf8 value, got: %v", uv.Type())
+		}
+
+		otherNames = append(otherNames, cmapi.OtherName{
+			OID:       otherName.TypeID.String(),
+			UTF8Value: uv.UTF8String,
+		})
+	}
+
+	return otherNames, nil
+}
+
 func extractSANExtension(extensions []pkix.Extension) (pkix.Extension, error) {
 	oidExtensionSubjectAltName := []int{2, 5, 29, 17}
 
@@ -271,6 +271,17 @@ func TestRequestMatchesSpecSubject(t *testing.T) {
 			literalSubject: "ST=example,C=US,O=#04024869",
 			x509CSR:        createCSRBlob("ST=example,C=US,O=#04024869"),
 		},
+		{
+			name:    "Matching organizationIdentifiers",
+			subject: &cmapi.X509Subject{OrganizationIdentifiers: []string{"VATGB-123456789"}},
+			x509CSR: createCSRBlob("ORGANIZATIONIDENTIFIER=VATGB-123456789"),
+		},
+		{
+			name:       "Mismatched organizationIdentifiers",
+			subject:    &cmapi.X509Subject{OrganizationIdentifiers: []string{"VATGB-123456789"}},
+			x509CSR:    createCSRBlob("ORGANIZATIONIDENTIFIER=VATGB-987654321"),
+			violations: []string{"spec.subject.organizationIdentifiers"},
+		},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,87 @@
+//go:build go1.27
+
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto"
+	"crypto/mldsa"
+	"crypto/x509"
+	"fmt"
+)
+
+// mldsaParameters returns the ML-DSA parameter set for the given security
+// category, i.e. 44, 65 or 87.
+func mldsaParameters(size int) (mldsa.Parameters, error) {
+	switch size {
+	case MLDSA44:
+		return mldsa.MLDSA44(), nil
+	case MLDSA65:
+		return mldsa.MLDSA65(), nil
+	case MLDSA87:
+		return mldsa.MLDSA87(), nil
+	default:
+		return mldsa.Parameters{}, fmt.Errorf("unsupported ml-dsa key size specified: %d. supported key sizes: %d, %d, %d", size, MLDSA44, MLDSA65, MLDSA87)
+	}
+}
+
+// generateMLDSAPrivateKey will generate an ML-DSA private key using the
+// parameter set for the given size.
+func generateMLDSAPrivateKey(size int) (crypto.Signer, error) {
+	params, err := mldsaParameters(size)
+	if err != nil {
+		return nil, err
+	}
+
+	return mldsa.GenerateKey(params)
+}
+
+// mldsaSignatureAlgorithm returns the x509 public key and signature
+// algorithms for an ML-DSA key of the given size.
+func mldsaSignatureAlgorithm(size int) (x509.PublicKeyAlgorithm, x509.SignatureAlgorithm, error) {
+	switch size {
+	case MLDSA44:
+		return x509.MLDSA, x509.MLDSA44, nil
+	case MLDSA65:
+		return x509.MLDSA, x509.MLDSA65, nil
+	case MLDSA87:
+		return x509.MLDSA, x509.MLDSA87, nil
+	default:
+		return x509.UnknownPublicKeyAlgorithm, x509.UnknownSignatureAlgorithm, fmt.Errorf("unsupported ml-dsa keysize specified: %d", size)
+	}
+}
+
+// mldsaPrivateKeySize returns the size of the given ML-DSA private key, and
+// false if the key is not an ML-DSA key.
+func mldsaPrivateKeySize(pk crypto.PrivateKey) (int, bool) {
+	mldsaPk, ok := pk.(*mldsa.PrivateKey)
+	if !ok {
+		return 0, false
+	}
+
+	switch mldsaPk.PublicKey().Parameters() {
+	case mldsa.MLDSA44():
+		return MLDSA44, true
+	case mldsa.MLDSA65():
+		return MLDSA65, true
+	case mldsa.MLDSA87():
+		return MLDSA87, true
+	default:
+		return 0, true
+	}
+}
@@ -0,0 +1,153 @@
+//go:build go1.27
+
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/mldsa"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+func TestMLDSAPrivateKeyForCertificate(t *testing.T) {
+	tests := map[string]struct {
+		size           int
+		expectedParams mldsa.Parameters
+		expectedSigAlg x509.SignatureAlgorithm
+		expectErr      bool
+	}{
+		"default size": {
+			expectedParams: mldsa.MLDSA65(),
+			expectedSigAlg: x509.MLDSA65,
+		},
+		"ML-DSA-44": {
+			size:           MLDSA44,
+			expectedParams: mldsa.MLDSA44(),
+			expectedSigAlg: x509.MLDSA44,
+		},
+		"ML-DSA-87": {
+			size:           MLDSA87,
+			expectedParams: mldsa.MLDSA87(),
+			expectedSigAlg: x509.MLDSA87,
+		},
+		"unsupported size": {
+			size:      256,
+			expectErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			crt := buildCertificateWithKeyParams(v1.MLDSAKeyAlgorithm, test.size)
+
+			pk, err := GeneratePrivateKeyForCertificate(crt)
+			if test.expectErr {
+				if err == nil {
+					t.Fatalf("expected an error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error generating private key: %v", err)
+			}
+
+			mldsaPk, ok := pk.(*mldsa.PrivateKey)
+			if !ok {
+				t.Fatalf("expected an ML-DSA private key but got %T", pk)
+			}
+			if mldsaPk.PublicKey().Parameters() != test.expectedParams {
+				t.Errorf("expected parameters %s but got %s", test.expectedParams, mldsaPk.PublicKey().Parameters())
+			}
+
+			violations, err := PrivateKeyMatchesSpec(pk, crt.Spec)
+			if err != nil {
+				t.Fatalf("unexpected error matching private key: %v", err)
+			}
+			if len(violations) > 0 {
+				t.Errorf("expected no violations but got %v", violations)
+			}
+
+			// ML-DSA keys are always PKCS#8 encoded, even if PKCS#1 is requested.
+			keyBytes, err := EncodePrivateKey(pk, v1.PKCS1)
+			if err != nil {
+				t.Fatalf("unexpected error encoding private key: %v", err)
+			}
+			decoded, err := DecodePrivateKeyBytes(keyBytes)
+			if err != nil {
+				t.Fatalf("unexpected error decoding private key: %v", err)
+			}
+			if !mldsaPk.Equal(decoded) {
+				t.Errorf("decoded private key does not match the generated private key")
+			}
+
+			pubKeyAlgo, sigAlgo, err := SignatureAlgorithm(crt)
+			if err != nil {
+				t.Fatalf("unexpected error getting signature algorithm: %v", err)
+			}
+			if pubKeyAlgo != x509.MLDSA || sigAlgo != test.expectedSigAlg {
+				t.Errorf("expected %s/%s but got %s/%s", x509.MLDSA, test.expectedSigAlg, pubKeyAlgo, sigAlgo)
+			}
+
+			csrDER, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+				Subject:            pkix.Name{CommonName: crt.Spec.CommonName},
+				SignatureAlgorithm: sigAlgo,
+			}, pk)
+			if err != nil {
+				t.Fatalf("unexpected error creating CSR: %v", err)
+			}
+			csr, err := x509.ParseCertificateRequest(csrDER)
+			if err != nil {
+				t.Fatalf("unexpected error parsing CSR: %v", err)
+			}
+			matches, err := PublicKeyMatchesCSR(pk.Public(), csr)
+			if err != nil {
+				t.Fatalf("unexpected error matching CSR public key: %v", err)
+			}
+			if !matches {
+				t.Errorf("expected CSR public key to match the private key")
+			}
+		})
+	}
+}
+
+func TestMLDSAPrivateKeyMatchesSpec(t *testing.T) {
+	pk, err := generateMLDSAPrivateKey(MLDSA44)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	violations, err := PrivateKeyMatchesSpec(pk, buildCertificateWithKeyParams(v1.MLDSAKeyAlgorithm, MLDSA87).Spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(violations) != 1 || violations[0] != "spec.privateKey.size" {
+		t.Errorf("expected size violation but got %v", violations)
+	}
+
+	violations, err = PrivateKeyMatchesSpec(pk, buildCertificateWithKeyParams(v1.ECDSAKeyAlgorithm, 256).Spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(violations) != 1 || violations[0] != "spec.privateKey.algorithm" {
+		t.Errorf("expected algorithm violation but got %v", violations)
+	}
+}
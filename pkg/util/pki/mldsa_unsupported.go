@@ -0,0 +1,41 @@
+//go:build !go1.27
+
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto"
+	"crypto/x509"
+	"errors"
+)
+
+// errMLDSAUnsupported is returned when cert-manager was built with a Go
+// version which does not support ML-DSA keys.
+var errMLDSAUnsupported = errors.New("ml-dsa private keys are not supported by this build of cert-manager, it must be built with go1.27 or later")
+
+func generateMLDSAPrivateKey(size int) (crypto.Signer, error) {
+	return nil, errMLDSAUnsupported
+}
+
+func mldsaSignatureAlgorithm(size int) (x509.PublicKeyAlgorithm, x509.SignatureAlgorithm, error) {
+	return x509.UnknownPublicKeyAlgorithm, x509.UnknownSignatureAlgorithm, errMLDSAUnsupported
+}
+
+func mldsaPrivateKeySize(pk crypto.PrivateKey) (int, bool) {
+	return 0, false
+}
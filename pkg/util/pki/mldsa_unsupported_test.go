@@ -0,0 +1,47 @@
+//go:build !go1.27
+
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"errors"
+	"testing"
+
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+func TestMLDSAPrivateKeyUnsupported(t *testing.T) {
+	for _, size := range []int{0, MLDSA44, MLDSA65, MLDSA87} {
+		crt := &v1.Certificate{
+			Spec: v1.CertificateSpec{
+				PrivateKey: &v1.CertificatePrivateKey{
+					Algorithm: v1.MLDSAKeyAlgorithm,
+					Size:      size,
+				},
+			},
+		}
+
+		pk, err := GeneratePrivateKeyForCertificate(crt)
+		if !errors.Is(err, errMLDSAUnsupported) {
+			t.Errorf("size %d: expected error %v but got: %v", size, errMLDSAUnsupported, err)
+		}
+		if pk != nil {
+			t.Errorf("size %d: expected no private key to be generated but got: %T", size, pk)
+		}
+	}
+}
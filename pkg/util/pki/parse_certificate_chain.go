@@ -273,3 +273,55 @@ func (c *chainNode) root() *chainNode {
 func isSelfSignedCertificate(cert *x509.Certificate) bool {
 	return cert.CheckSignatureFrom(cert) == nil
 }
+
+// VerifyCertificateChainComplete returns an error if the given certificate
+// chain, which starts with the leaf certificate, does not build to a root.
+// Each certificate in the chain must have been issued and signed by the
+// certificate which follows it. The last certificate in the chain must either
+// be self-signed, be signed by one of the given CA certificates, or verify
+// against the given roots. roots may be nil, in which case the last
+// certificate must be self-signed or signed by one of the CA certificates.
+func VerifyCertificateChainComplete(chain []*x509.Certificate, cas []*x509.Certificate, roots *x509.CertPool) error {
+	if len(chain) == 0 {
+		return errors.NewInvalidData("certificate chain is empty")
+	}
+
+	for i := 0; i < len(chain)-1; i++ {
+		cert, issuer := chain[i], chain[i+1]
+		if !bytes.Equal(cert.RawIssuer, issuer.RawSubject) {
+			return errors.NewInvalidData("certificate %q was not issued by the next certificate in the chain %q",
+				cert.Subject.String(), issuer.Subject.String())
+		}
+		if err := cert.CheckSignatureFrom(issuer); err != nil {
+			return errors.NewInvalidData("certificate %q is not signed by the next certificate in the chain %q: %s",
+				cert.Subject.String(), issuer.Subject.String(), err)
+		}
+	}
+
+	// A self-signed certificate need not be a CA, for example when issued by
+	// the SelfSigned issuer, so check its signature without applying the
+	// constraints of CheckSignatureFrom.
+	last := chain[len(chain)-1]
+	if bytes.Equal(last.RawIssuer, last.RawSubject) &&
+		last.CheckSignature(last.SignatureAlgorithm, last.RawTBSCertificate, last.Signature) == nil {
+		return nil
+	}
+
+	for _, ca := range cas {
+		if bytes.Equal(last.RawIssuer, ca.RawSubject) && last.CheckSignatureFrom(ca) == nil {
+			return nil
+		}
+	}
+
+	if roots != nil {
+		if _, err := last.Verify(x509.VerifyOptions{
+			Roots:     roots,
+			KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+		}); err == nil {
+			return nil
+		}
+	}
+
+	return errors.NewInvalidData("certificate chain is incomplete: the issuer %q of certificate %q is not present in the chain or the CA",
+		last.Issuer.String(), last.Subject.String())
+}
@@ -22,6 +22,7 @@ import (
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"fmt"
+	"math/big"
 	"reflect"
 	"testing"
 	"time"
@@ -277,3 +278,92 @@ func TestParseSingleCertificateChain(t *testing.T) {
 		})
 	}
 }
+
+func TestVerifyCertificateChainComplete(t *testing.T) {
+	root := mustCreateBundle(t, nil, "root")
+	intA := mustCreateBundle(t, root, "intA")
+	intB := mustCreateBundle(t, intA, "intB")
+	leaf := mustCreateBundle(t, intB, "leaf")
+	otherRoot := mustCreateBundle(t, nil, "other-root")
+	// impostor has the same subject as intB but a different key, so leaf's
+	// issuer matches its subject but not its signature.
+	impostor := mustCreateBundle(t, intA, "intB")
+
+	selfSignedLeafKey, err := GenerateECPrivateKey(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	selfSignedLeafTemplate := &x509.Certificate{
+		BasicConstraintsValid: true,
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "self-signed-leaf"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Minute),
+	}
+	_, selfSignedLeaf, err := SignCertificate(selfSignedLeafTemplate, selfSignedLeafTemplate, selfSignedLeafKey.Public(), selfSignedLeafKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	roots := x509.NewCertPool()
+	roots.AddCert(root.cert)
+
+	tests := map[string]struct {
+		chain  []*x509.Certificate
+		cas    []*x509.Certificate
+		roots  *x509.CertPool
+		expErr string
+	}{
+		"a chain ending in a self-signed root is complete": {
+			chain: []*x509.Certificate{leaf.cert, intB.cert, intA.cert, root.cert},
+		},
+		"a self-signed certificate is complete": {
+			chain: []*x509.Certificate{root.cert},
+		},
+		"a self-signed certificate which is not a CA is complete": {
+			chain: []*x509.Certificate{selfSignedLeaf},
+		},
+		"a chain ending in an intermediate signed by the CA is complete": {
+			chain: []*x509.Certificate{leaf.cert, intB.cert, intA.cert},
+			cas:   []*x509.Certificate{otherRoot.cert, root.cert},
+		},
+		"a chain ending in an intermediate signed by a trusted root is complete": {
+			chain: []*x509.Certificate{leaf.cert, intB.cert, intA.cert},
+			roots: roots,
+		},
+		"a leaf without its intermediates is incomplete": {
+			chain:  []*x509.Certificate{leaf.cert},
+			cas:    []*x509.Certificate{root.cert},
+			roots:  roots,
+			expErr: `certificate chain is incomplete: the issuer "CN=intB" of certificate "CN=leaf" is not present in the chain or the CA`,
+		},
+		"a chain missing an intermediate is incomplete": {
+			chain:  []*x509.Certificate{leaf.cert, intA.cert, root.cert},
+			expErr: `certificate "CN=leaf" was not issued by the next certificate in the chain "CN=intA"`,
+		},
+		"a chain ending in an intermediate not signed by the CA is incomplete": {
+			chain:  []*x509.Certificate{leaf.cert, intB.cert, intA.cert},
+			cas:    []*x509.Certificate{otherRoot.cert},
+			expErr: `certificate chain is incomplete: the issuer "CN=root" of certificate "CN=intA" is not present in the chain or the CA`,
+		},
+		"a chain where a certificate's issuer matches the next subject but not its signature is incomplete": {
+			chain:  []*x509.Certificate{leaf.cert, impostor.cert, intA.cert, root.cert},
+			expErr: `certificate "CN=leaf" is not signed by the next certificate in the chain "CN=intB": x509: ECDSA verification failure`,
+		},
+		"an empty chain is incomplete": {
+			expErr: "certificate chain is empty",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := VerifyCertificateChainComplete(test.chain, test.cas, test.roots)
+			if test.expErr == "" && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if test.expErr != "" && (err == nil || err.Error() != test.expErr) {
+				t.Errorf("unexpected error, exp=%q got=%v", test.expErr, err)
+			}
+		})
+	}
+}
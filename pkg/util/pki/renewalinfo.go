@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"errors"
+	"hash/fnv"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// ARICertID returns the identifier of the certificate used to request its
+// ACME Renewal Information, as described in RFC 9773 section 4.1. It is
+// built from the certificate's Authority Key Identifier and serial number.
+func ARICertID(cert *x509.Certificate) (string, error) {
+	if len(cert.AuthorityKeyId) == 0 {
+		return "", errors.New("certificate does not have an authority key identifier")
+	}
+	if cert.SerialNumber == nil {
+		return "", errors.New("certificate does not have a serial number")
+	}
+
+	// The serial number is encoded as the value octets of its DER encoding,
+	// which unlike big.Int.Bytes includes a leading zero byte when the most
+	// significant bit is set.
+	der, err := asn1.Marshal(cert.SerialNumber)
+	if err != nil {
+		return "", err
+	}
+	var serial asn1.RawValue
+	if _, err := asn1.Unmarshal(der, &serial); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(cert.AuthorityKeyId) + "." +
+		base64.RawURLEncoding.EncodeToString(serial.Bytes), nil
+}
+
+// RenewalInfoTime returns the time at which cert should be renewed according
+// to the given ACME Renewal Information. Nil is returned if info is nil, does
+// not apply to cert or does not contain a valid suggested window.
+// The time is chosen within the suggested window using the certificate's ARI
+// identifier, so that renewals of many certificates are spread across the
+// window while the renewal time of each remains stable.
+func RenewalInfoTime(cert *x509.Certificate, info *cmapi.CertificateRenewalInfo) *metav1.Time {
+	if info == nil {
+		return nil
+	}
+	certID, err := ARICertID(cert)
+	if err != nil || certID != info.CertID {
+		return nil
+	}
+
+	start := info.SuggestedWindowStart.Time.Truncate(time.Second)
+	window := info.SuggestedWindowEnd.Time.Truncate(time.Second).Sub(start)
+	if window < 0 {
+		return nil
+	}
+
+	var offset time.Duration
+	if seconds := uint64(window / time.Second); seconds > 0 {
+		h := fnv.New64a()
+		h.Write([]byte(certID))
+		offset = time.Duration(h.Sum64()%seconds) * time.Second
+	}
+
+	// As with RenewalTime, the result is truncated to the nearest second
+	// as it is stored on the Certificate's status.
+	rt := metav1.NewTime(start.Add(offset))
+	return &rt
+}
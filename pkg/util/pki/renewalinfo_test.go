@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/x509"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// ariExampleCert is the certificate used in the example of RFC 9773
+// section 4.1.
+var ariExampleCert = &x509.Certificate{
+	AuthorityKeyId: []byte{0x69, 0x88, 0x5B, 0x6B, 0x87, 0x46, 0x40, 0x41, 0xE1, 0xB3, 0x7B, 0x84, 0x7B, 0xA0, 0xAE, 0x2C, 0xDE, 0x01, 0xC8, 0xD4},
+	SerialNumber:   big.NewInt(0x87654321),
+}
+
+const ariExampleCertID = "aYhba4dGQEHhs3uEe6CuLN4ByNQ.AIdlQyE"
+
+func TestARICertID(t *testing.T) {
+	certID, err := ARICertID(ariExampleCert)
+	assert.NoError(t, err)
+	assert.Equal(t, ariExampleCertID, certID)
+
+	_, err = ARICertID(&x509.Certificate{SerialNumber: big.NewInt(1)})
+	assert.Error(t, err, "expected an error for a certificate without an authority key identifier")
+}
+
+func TestRenewalInfoTime(t *testing.T) {
+	start := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	window := func(certID string, start, end time.Time) *cmapi.CertificateRenewalInfo {
+		return &cmapi.CertificateRenewalInfo{
+			CertID:               certID,
+			SuggestedWindowStart: metav1.NewTime(start),
+			SuggestedWindowEnd:   metav1.NewTime(end),
+		}
+	}
+
+	tests := map[string]struct {
+		info     *cmapi.CertificateRenewalInfo
+		expected *metav1.Time
+	}{
+		"no renewal information": {},
+		"renewal information for a different certificate": {
+			info: window("other", start, start.Add(time.Hour)),
+		},
+		"suggested window ends before it starts": {
+			info: window(ariExampleCertID, start, start.Add(-time.Hour)),
+		},
+		"suggested window of zero length": {
+			info:     window(ariExampleCertID, start, start),
+			expected: &metav1.Time{Time: start},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, RenewalInfoTime(ariExampleCert, test.info))
+		})
+	}
+
+	t.Run("renewal time is stable and within the suggested window", func(t *testing.T) {
+		info := window(ariExampleCertID, start, start.Add(24*time.Hour))
+		rt := RenewalInfoTime(ariExampleCert, info)
+		if assert.NotNil(t, rt) {
+			assert.False(t, rt.Time.Before(start), "renewal time %s is before the window start", rt)
+			assert.False(t, rt.Time.After(start.Add(24*time.Hour)), "renewal time %s is after the window end", rt)
+			assert.Equal(t, rt.Time, rt.Time.Truncate(time.Second))
+			assert.Equal(t, rt, RenewalInfoTime(ariExampleCert, info))
+		}
+	})
+}
@@ -72,6 +72,24 @@ func TestRenewalTime(t *testing.T) {
 			renewBeforePct:      ptr.To(int32(50)),
 			expectedRenewalTime: &metav1.Time{Time: now.Add(time.Hour * 365)},
 		},
+		"one hour cert, spec.renewBeforePercentage is set to renew with 25% of the lifetime remaining": {
+			notBefore:           now,
+			notAfter:            now.Add(time.Hour),
+			renewBeforePct:      ptr.To(int32(25)),
+			expectedRenewalTime: &metav1.Time{Time: now.Add(time.Minute * 45)},
+		},
+		"one day cert, spec.renewBeforePercentage is set to renew with 33% of the lifetime remaining": {
+			notBefore:           now,
+			notAfter:            now.Add(time.Hour * 24),
+			renewBeforePct:      ptr.To(int32(33)),
+			expectedRenewalTime: &metav1.Time{Time: now.Add(time.Hour*16 + time.Minute*4 + time.Second*48)},
+		},
+		"90 day cert, spec.renewBeforePercentage is set to renew with 10% of the lifetime remaining": {
+			notBefore:           now,
+			notAfter:            now.Add(time.Hour * 24 * 90),
+			renewBeforePct:      ptr.To(int32(10)),
+			expectedRenewalTime: &metav1.Time{Time: now.Add(time.Hour * 24 * 81)},
+		},
 		// This test case is here to show the scenario where users set
 		// renewBefore to very slightly less than actual duration. This
 		// will result in cert being renewed 'continuously'.
@@ -115,7 +133,7 @@ func TestRenewBefore(t *testing.T) {
 		},
 		"spec.renewBeforePercentage is valid": {
 			renewBeforePct:      ptr.To(int32(25)),
-			expectedRenewBefore: 135 * time.Minute,
+			expectedRenewBefore: 45 * time.Minute,
 		},
 		"spec.renewBeforePercentage is too large so default is used": {
 			renewBeforePct:      ptr.To(int32(100)),
@@ -0,0 +1,41 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// ParseSerialNumber parses a certificate serial number encoded as
+// hexadecimal, as printed by openssl and other tools. The hexadecimal digits
+// may optionally be separated by colons.
+func ParseSerialNumber(s string) (*big.Int, error) {
+	hex := strings.ReplaceAll(strings.TrimSpace(s), ":", "")
+	serial, ok := new(big.Int).SetString(hex, 16)
+	if !ok || hex == "" || serial.Sign() < 0 {
+		return nil, fmt.Errorf("invalid serial number %q: must be hexadecimal", s)
+	}
+	return serial, nil
+}
+
+// FormatSerialNumber returns the serial number encoded as lowercase
+// hexadecimal, as accepted by ParseSerialNumber.
+func FormatSerialNumber(serial *big.Int) string {
+	return serial.Text(16)
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestParseSerialNumber(t *testing.T) {
+	tests := map[string]struct {
+		in        string
+		expSerial *big.Int
+		expErr    bool
+	}{
+		"hexadecimal": {
+			in:        "1a2b3c",
+			expSerial: big.NewInt(0x1a2b3c),
+		},
+		"uppercase hexadecimal separated by colons": {
+			in:        "1A:2B:3C",
+			expSerial: big.NewInt(0x1a2b3c),
+		},
+		"leading zeros": {
+			in:        "00:1a:2b:3c",
+			expSerial: big.NewInt(0x1a2b3c),
+		},
+		"empty": {
+			in:     "",
+			expErr: true,
+		},
+		"not hexadecimal": {
+			in:     "1a:2g",
+			expErr: true,
+		},
+		"negative": {
+			in:     "-1a",
+			expErr: true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			serial, err := ParseSerialNumber(test.in)
+			if test.expErr != (err != nil) {
+				t.Fatalf("expected error=%t but got: %v", test.expErr, err)
+			}
+			if test.expSerial != nil && serial.Cmp(test.expSerial) != 0 {
+				t.Errorf("expected serial %s but got %s", test.expSerial, serial)
+			}
+			if test.expSerial != nil && FormatSerialNumber(serial) != "1a2b3c" {
+				t.Errorf("unexpected formatted serial %q", FormatSerialNumber(serial))
+			}
+		})
+	}
+}
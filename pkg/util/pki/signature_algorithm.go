@@ -0,0 +1,63 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/x509"
+)
+
+// namedSignatureAlgorithms maps the names of the signature algorithms which
+// can be configured on an issuer to their x509 signature and public key
+// algorithms. The names match the names of the constants in crypto/x509.
+var namedSignatureAlgorithms = []struct {
+	name       string
+	sigAlgo    x509.SignatureAlgorithm
+	pubKeyAlgo x509.PublicKeyAlgorithm
+}{
+	{"SHA256WithRSA", x509.SHA256WithRSA, x509.RSA},
+	{"SHA384WithRSA", x509.SHA384WithRSA, x509.RSA},
+	{"SHA512WithRSA", x509.SHA512WithRSA, x509.RSA},
+	{"SHA256WithRSAPSS", x509.SHA256WithRSAPSS, x509.RSA},
+	{"SHA384WithRSAPSS", x509.SHA384WithRSAPSS, x509.RSA},
+	{"SHA512WithRSAPSS", x509.SHA512WithRSAPSS, x509.RSA},
+	{"ECDSAWithSHA256", x509.ECDSAWithSHA256, x509.ECDSA},
+	{"ECDSAWithSHA384", x509.ECDSAWithSHA384, x509.ECDSA},
+	{"ECDSAWithSHA512", x509.ECDSAWithSHA512, x509.ECDSA},
+	{"PureEd25519", x509.PureEd25519, x509.Ed25519},
+}
+
+// SignatureAlgorithmNames returns the names of the signature algorithms
+// accepted by SignatureAlgorithmByName.
+func SignatureAlgorithmNames() []string {
+	names := make([]string, 0, len(namedSignatureAlgorithms))
+	for _, alg := range namedSignatureAlgorithms {
+		names = append(names, alg.name)
+	}
+	return names
+}
+
+// SignatureAlgorithmByName returns the x509 signature algorithm with the
+// given name, along with the public key algorithm of the keys which can
+// produce it. It returns false if the name is not recognised.
+func SignatureAlgorithmByName(name string) (x509.SignatureAlgorithm, x509.PublicKeyAlgorithm, bool) {
+	for _, alg := range namedSignatureAlgorithms {
+		if alg.name == name {
+			return alg.sigAlgo, alg.pubKeyAlgo, true
+		}
+	}
+	return x509.UnknownSignatureAlgorithm, x509.UnknownPublicKeyAlgorithm, false
+}
@@ -36,6 +36,8 @@ var OIDConstants = struct {
 	StreetAddress      []int
 	DomainComponent    []int
 	UniqueIdentifier   []int
+	// OrganizationIdentifier is defined in X.520 and used by ETSI EN 319 412-1.
+	OrganizationIdentifier []int
 }{
 	Country:            []int{2, 5, 4, 6},
 	Organization:       []int{2, 5, 4, 10},
@@ -47,6 +49,8 @@ var OIDConstants = struct {
 	StreetAddress:      []int{2, 5, 4, 9},
 	DomainComponent:    []int{0, 9, 2342, 19200300, 100, 1, 25},
 	UniqueIdentifier:   []int{0, 9, 2342, 19200300, 100, 1, 1},
+
+	OrganizationIdentifier: []int{2, 5, 4, 97},
 }
 
 // Copied from pkix.attributeTypeNames and inverted. (Sadly it is private.)
@@ -63,6 +67,8 @@ var attributeTypeNames = map[string][]int{
 	"STREET":       OIDConstants.StreetAddress,
 	"DC":           OIDConstants.DomainComponent,
 	"UID":          OIDConstants.UniqueIdentifier,
+
+	"ORGANIZATIONIDENTIFIER": OIDConstants.OrganizationIdentifier,
 }
 
 func UnmarshalSubjectStringToRDNSequence(subject string) (pkix.RDNSequence, error) {
@@ -137,3 +143,18 @@ func ExtractCommonNameFromRDNSequence(rdns pkix.RDNSequence) string {
 
 	return ""
 }
+
+// OrganizationIdentifiersFromName returns the values of all organizationIdentifier
+// attributes found in the given name, in the order in which they appear.
+func OrganizationIdentifiersFromName(name pkix.Name) []string {
+	var organizationIdentifiers []string
+	for _, atv := range name.Names {
+		if !atv.Type.Equal(OIDConstants.OrganizationIdentifier) {
+			continue
+		}
+		if str, ok := atv.Value.(string); ok {
+			organizationIdentifiers = append(organizationIdentifiers, str)
+		}
+	}
+	return organizationIdentifiers
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+)
+
+var (
+	// OIDExtensionTLSFeature is the id-pe-tlsfeature extension, RFC 7633.
+	OIDExtensionTLSFeature = []int{1, 3, 6, 1, 5, 5, 7, 1, 24}
+)
+
+// tlsFeatureStatusRequest is the TLS extension number of status_request,
+// RFC 6066, which is used to request OCSP Must-Staple.
+const tlsFeatureStatusRequest = 5
+
+// MarshalMustStaple returns a TLS feature extension requesting the
+// status_request feature, commonly known as OCSP Must-Staple.
+func MarshalMustStaple() (pkix.Extension, error) {
+	ext := pkix.Extension{Id: OIDExtensionTLSFeature, Critical: false}
+
+	var err error
+	ext.Value, err = asn1.Marshal([]int{tlsFeatureStatusRequest})
+	return ext, err
+}
+
+// UnmarshalTLSFeature parses the value of a TLS feature extension and returns
+// the list of TLS extension numbers it contains.
+func UnmarshalTLSFeature(value []byte) ([]int, error) {
+	var features []int
+	rest, err := asn1.Unmarshal(value, &features)
+	if err != nil {
+		return nil, err
+	} else if len(rest) != 0 {
+		return nil, errors.New("x509: trailing data after X.509 TLS feature")
+	}
+	return features, nil
+}
+
+// HasMustStaple returns true if the given extensions contain a TLS feature
+// extension requesting the status_request feature.
+func HasMustStaple(extensions []pkix.Extension) (bool, error) {
+	for _, ext := range extensions {
+		if !ext.Id.Equal(OIDExtensionTLSFeature) {
+			continue
+		}
+		features, err := UnmarshalTLSFeature(ext.Value)
+		if err != nil {
+			return false, err
+		}
+		for _, feature := range features {
+			if feature == tlsFeatureStatusRequest {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
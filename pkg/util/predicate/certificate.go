@@ -17,6 +17,8 @@ limitations under the License.
 package predicate
 
 import (
+	"slices"
+
 	"k8s.io/apimachinery/pkg/runtime"
 
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
@@ -31,6 +33,58 @@ func CertificateSecretName(name string) Func {
 	}
 }
 
+// CertificateAdditionalOutputSecretName returns a predicate that used to filter
+// Certificates to only those which list the given name in
+// 'spec.additionalOutputSecrets'.
+func CertificateAdditionalOutputSecretName(name string) Func {
+	return func(obj runtime.Object) bool {
+		crt := obj.(*cmapi.Certificate)
+		for _, additional := range crt.Spec.AdditionalOutputSecrets {
+			if additional.Name == name {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// CertificateSecretMirrorNamespace returns a predicate that used to filter
+// Certificates to only those which list the given namespace in
+// 'spec.secretMirrorNamespaces'.
+func CertificateSecretMirrorNamespace(namespace string) Func {
+	return func(obj runtime.Object) bool {
+		crt := obj.(*cmapi.Certificate)
+		return slices.Contains(crt.Spec.SecretMirrorNamespaces, namespace)
+	}
+}
+
+// CertificateServiceRefName returns a predicate that used to filter
+// Certificates to only those whose 'spec.serviceRef' refers to the Service
+// with the given name.
+func CertificateServiceRefName(name string) Func {
+	return func(obj runtime.Object) bool {
+		ref := obj.(*cmapi.Certificate).Spec.ServiceRef
+		return ref != nil && ref.Name == name
+	}
+}
+
+// CertificateKeystorePasswordSecretName returns a predicate that used to filter
+// Certificates to only those which create a JKS or PKCS12 keystore using the
+// password stored in the Secret with the given name.
+func CertificateKeystorePasswordSecretName(name string) Func {
+	return func(obj runtime.Object) bool {
+		crt := obj.(*cmapi.Certificate)
+		keystores := crt.Spec.Keystores
+		if keystores == nil {
+			return false
+		}
+		if keystores.JKS != nil && keystores.JKS.Create && keystores.JKS.PasswordSecretRef.Name == name {
+			return true
+		}
+		return keystores.PKCS12 != nil && keystores.PKCS12.Create && keystores.PKCS12.PasswordSecretRef.Name == name
+	}
+}
+
 // CertificateNextPrivateKeySecretName returns a predicate that used to filter Certificates
 // to only those with the given 'status.nextPrivateKeySecretName'.
 // It is not possible to select Certificates with a 'nil' secret name using
@@ -44,3 +98,20 @@ func CertificateNextPrivateKeySecretName(name string) Func {
 		return *crt.Status.NextPrivateKeySecretName == name
 	}
 }
+
+// CertificateIssuerRef returns a predicate that used to filter Certificates
+// to only those whose 'spec.issuerRef' refers to the cert-manager Issuer or
+// ClusterIssuer with the given kind and name.
+func CertificateIssuerRef(kind, name string) Func {
+	return func(obj runtime.Object) bool {
+		ref := obj.(*cmapi.Certificate).Spec.IssuerRef
+		if ref.Group != "" && ref.Group != cmapi.SchemeGroupVersion.Group {
+			return false
+		}
+		refKind := ref.Kind
+		if refKind == "" {
+			refKind = cmapi.IssuerKind
+		}
+		return refKind == kind && ref.Name == name
+	}
+}
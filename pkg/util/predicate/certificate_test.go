@@ -22,6 +22,7 @@ import (
 	"k8s.io/utils/ptr"
 
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 )
 
 func TestCertificateSecretName(t *testing.T) {
@@ -56,6 +57,156 @@ func TestCertificateSecretName(t *testing.T) {
 	}
 }
 
+func TestCertificateAdditionalOutputSecretName(t *testing.T) {
+	certWithAdditionalOutputSecrets := func(names ...string) *cmapi.Certificate {
+		crt := &cmapi.Certificate{
+			Spec: cmapi.CertificateSpec{SecretName: "primary"},
+		}
+		for _, name := range names {
+			crt.Spec.AdditionalOutputSecrets = append(crt.Spec.AdditionalOutputSecrets, cmapi.CertificateAdditionalOutputSecret{Name: name})
+		}
+		return crt
+	}
+	tests := map[string]struct {
+		secretName string
+		cert       *cmapi.Certificate
+		expected   bool
+	}{
+		"returns true if an additional output secret name matches": {
+			secretName: "abc",
+			cert:       certWithAdditionalOutputSecrets("def", "abc"),
+			expected:   true,
+		},
+		"returns false if no additional output secret name matches": {
+			secretName: "abc",
+			cert:       certWithAdditionalOutputSecrets("abcd"),
+			expected:   false,
+		},
+		"returns false if only the primary secret name matches": {
+			secretName: "primary",
+			cert:       certWithAdditionalOutputSecrets(),
+			expected:   false,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := CertificateAdditionalOutputSecretName(test.secretName)(test.cert)
+			if got != test.expected {
+				t.Errorf("unexpected response: got=%t, exp=%t", got, test.expected)
+			}
+		})
+	}
+}
+
+func TestCertificateSecretMirrorNamespace(t *testing.T) {
+	tests := map[string]struct {
+		namespace string
+		cert      *cmapi.Certificate
+		expected  bool
+	}{
+		"returns true if a secret mirror namespace matches": {
+			namespace: "abc",
+			cert:      &cmapi.Certificate{Spec: cmapi.CertificateSpec{SecretMirrorNamespaces: []string{"def", "abc"}}},
+			expected:  true,
+		},
+		"returns false if no secret mirror namespace matches": {
+			namespace: "abc",
+			cert:      &cmapi.Certificate{Spec: cmapi.CertificateSpec{SecretMirrorNamespaces: []string{"abcd"}}},
+			expected:  false,
+		},
+		"returns false if the Certificate has no secret mirror namespaces": {
+			namespace: "abc",
+			cert:      &cmapi.Certificate{},
+			expected:  false,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := CertificateSecretMirrorNamespace(test.namespace)(test.cert)
+			if got != test.expected {
+				t.Errorf("unexpected response: got=%t, exp=%t", got, test.expected)
+			}
+		})
+	}
+}
+
+func TestCertificateServiceRefName(t *testing.T) {
+	tests := map[string]struct {
+		name     string
+		cert     *cmapi.Certificate
+		expected bool
+	}{
+		"returns true if the referenced Service name matches": {
+			name:     "abc",
+			cert:     &cmapi.Certificate{Spec: cmapi.CertificateSpec{ServiceRef: &cmapi.CertificateServiceReference{Name: "abc"}}},
+			expected: true,
+		},
+		"returns false if the referenced Service name does not match": {
+			name:     "abc",
+			cert:     &cmapi.Certificate{Spec: cmapi.CertificateSpec{ServiceRef: &cmapi.CertificateServiceReference{Name: "abcd"}}},
+			expected: false,
+		},
+		"returns false if the Certificate does not reference a Service": {
+			name:     "abc",
+			cert:     &cmapi.Certificate{},
+			expected: false,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := CertificateServiceRefName(test.name)(test.cert)
+			if got != test.expected {
+				t.Errorf("unexpected response: got=%t, exp=%t", got, test.expected)
+			}
+		})
+	}
+}
+
+func TestCertificateKeystorePasswordSecretName(t *testing.T) {
+	ref := func(name string) cmmeta.SecretKeySelector {
+		return cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: name}, Key: "password"}
+	}
+	tests := map[string]struct {
+		secretName string
+		keystores  *cmapi.CertificateKeystores
+		expected   bool
+	}{
+		"returns false if no keystores are defined": {
+			secretName: "abc",
+			expected:   false,
+		},
+		"returns true if the JKS password secret name matches": {
+			secretName: "abc",
+			keystores:  &cmapi.CertificateKeystores{JKS: &cmapi.JKSKeystore{Create: true, PasswordSecretRef: ref("abc")}},
+			expected:   true,
+		},
+		"returns true if the PKCS12 password secret name matches": {
+			secretName: "abc",
+			keystores:  &cmapi.CertificateKeystores{PKCS12: &cmapi.PKCS12Keystore{Create: true, PasswordSecretRef: ref("abc")}},
+			expected:   true,
+		},
+		"returns false if the password secret name does not match": {
+			secretName: "abc",
+			keystores:  &cmapi.CertificateKeystores{JKS: &cmapi.JKSKeystore{Create: true, PasswordSecretRef: ref("abcd")}},
+			expected:   false,
+		},
+		"returns false if the keystore is not created": {
+			secretName: "abc",
+			keystores:  &cmapi.CertificateKeystores{PKCS12: &cmapi.PKCS12Keystore{Create: false, PasswordSecretRef: ref("abc")}},
+			expected:   false,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			crt := &cmapi.Certificate{Spec: cmapi.CertificateSpec{Keystores: test.keystores}}
+			got := CertificateKeystorePasswordSecretName(test.secretName)(crt)
+			if got != test.expected {
+				t.Errorf("unexpected response: got=%t, exp=%t", got, test.expected)
+			}
+		})
+	}
+}
+
 func TestCertificateNextPrivateKeySecretName(t *testing.T) {
 	certWithSecretName := func(s *string) *cmapi.Certificate {
 		return &cmapi.Certificate{
@@ -92,3 +243,55 @@ func TestCertificateNextPrivateKeySecretName(t *testing.T) {
 		})
 	}
 }
+
+func TestCertificateIssuerRef(t *testing.T) {
+	certWithIssuerRef := func(ref cmmeta.ObjectReference) *cmapi.Certificate {
+		return &cmapi.Certificate{
+			Spec: cmapi.CertificateSpec{IssuerRef: ref},
+		}
+	}
+	tests := map[string]struct {
+		kind, name string
+		cert       *cmapi.Certificate
+		expected   bool
+	}{
+		"returns true if issuer kind and name match": {
+			kind:     cmapi.ClusterIssuerKind,
+			name:     "abc",
+			cert:     certWithIssuerRef(cmmeta.ObjectReference{Name: "abc", Kind: cmapi.ClusterIssuerKind, Group: "cert-manager.io"}),
+			expected: true,
+		},
+		"returns true for an Issuer if the kind is not set": {
+			kind:     cmapi.IssuerKind,
+			name:     "abc",
+			cert:     certWithIssuerRef(cmmeta.ObjectReference{Name: "abc"}),
+			expected: true,
+		},
+		"returns false if the issuer name does not match": {
+			kind:     cmapi.IssuerKind,
+			name:     "abc",
+			cert:     certWithIssuerRef(cmmeta.ObjectReference{Name: "abcd", Kind: cmapi.IssuerKind}),
+			expected: false,
+		},
+		"returns false if the issuer kind does not match": {
+			kind:     cmapi.ClusterIssuerKind,
+			name:     "abc",
+			cert:     certWithIssuerRef(cmmeta.ObjectReference{Name: "abc"}),
+			expected: false,
+		},
+		"returns false for an external issuer": {
+			kind:     cmapi.IssuerKind,
+			name:     "abc",
+			cert:     certWithIssuerRef(cmmeta.ObjectReference{Name: "abc", Kind: cmapi.IssuerKind, Group: "example.com"}),
+			expected: false,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := CertificateIssuerRef(test.kind, test.name)(test.cert)
+			if got != test.expected {
+				t.Errorf("unexpected response: got=%t, exp=%t", got, test.expected)
+			}
+		})
+	}
+}
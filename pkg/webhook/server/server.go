@@ -86,6 +86,10 @@ type Server struct {
 	// MinTLSVersion is the minimum TLS version supported.
 	// Values are from tls package constants (https://golang.org/pkg/crypto/tls/#pkg-constants).
 	MinTLSVersion string
+
+	// Runnables are run alongside the webhook server until it is stopped,
+	// for example to run the informers used by admission plugins.
+	Runnables []manager.Runnable
 }
 
 func (s *Server) Run(ctx context.Context) error {
@@ -139,6 +143,12 @@ func (s *Server) Run(ctx context.Context) error {
 		return err
 	}
 
+	for _, r := range s.Runnables {
+		if err := mgr.Add(r); err != nil {
+			return err
+		}
+	}
+
 	// if a HealthzAddr is provided, start the healthz listener
 	if s.HealthzAddr != nil {
 		healthzListener, err := net.Listen("tcp", fmt.Sprintf(":%d", *s.HealthzAddr))
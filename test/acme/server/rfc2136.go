@@ -18,6 +18,7 @@ package server
 
 import (
 	"fmt"
+	"slices"
 	"sync"
 	"time"
 
@@ -73,14 +74,23 @@ func (b *rfc2136Handler) ServeDNS(w dns.ResponseWriter, req *dns.Msg) {
 		for _, rr := range req.Ns {
 			txt := rr.(*dns.TXT)
 			log := log.WithValues("value", txt.Hdr.Name, "class", dns.ClassToString[rr.Header().Class], "txt", txt.Txt)
-			if rr.Header().Class == dns.ClassNONE {
+			switch rr.Header().Class {
+			case dns.ClassNONE:
+				// RFC 2136 section 2.5.4: delete only the given RR from the RRset
 				log.V(logf.DebugLevel).Info("deleting txt record value due to NONE class")
-				// TODO: can we only delete the named record here somehow?
+				b.txtRecords[txt.Hdr.Name] = removeValues(b.txtRecords[txt.Hdr.Name], txt.Txt)
+				if len(b.txtRecords[txt.Hdr.Name]) == 0 {
+					delete(b.txtRecords, txt.Hdr.Name)
+				}
+			case dns.ClassANY:
+				// RFC 2136 section 2.5.2: delete the whole RRset
+				log.V(logf.DebugLevel).Info("deleting txt record due to ANY class")
 				delete(b.txtRecords, txt.Hdr.Name)
-				continue
+			default:
+				// RFC 2136 section 2.5.1: add to the RRset, ignoring duplicates
+				log.V(logf.DebugLevel).Info("adding TXT record value")
+				b.txtRecords[txt.Hdr.Name] = append(removeValues(b.txtRecords[txt.Hdr.Name], txt.Txt), txt.Txt...)
 			}
-			log.V(logf.DebugLevel).Info("setting TXT record value")
-			b.txtRecords[txt.Hdr.Name] = txt.Txt
 		}
 	}
 
@@ -101,6 +111,17 @@ func (b *rfc2136Handler) ServeDNS(w dns.ResponseWriter, req *dns.Msg) {
 	}
 }
 
+// removeValues returns the values which are not in toRemove.
+func removeValues(values, toRemove []string) []string {
+	var remaining []string
+	for _, v := range values {
+		if !slices.Contains(toRemove, v) {
+			remaining = append(remaining, v)
+		}
+	}
+	return remaining
+}
+
 func (b *rfc2136Handler) zoneForFQDN(s string) string {
 	for _, z := range b.zones {
 		if dns.IsSubDomain(z, s) {
@@ -112,7 +112,7 @@ func allConditions(c ...wait.ConditionWithContextFunc) wait.ConditionWithContext
 
 func (f *fixture) recordHasPropagatedCheck(fqdn, value string) func(ctx context.Context) (bool, error) {
 	return func(ctx context.Context) (bool, error) {
-		return util.PreCheckDNS(ctx, fqdn, value, []string{f.testDNSServer}, *f.useAuthoritative)
+		return util.PreCheckDNS(ctx, fqdn, value, []string{f.testDNSServer}, *f.useAuthoritative, 0)
 	}
 }
 
@@ -43,7 +43,7 @@ type ValidationFunc func(certificate *cmapi.Certificate, secret *corev1.Secret)
 
 // ExpectValidKeysInSecret checks that the secret contains valid keys
 func ExpectValidKeysInSecret(_ *cmapi.Certificate, secret *corev1.Secret) error {
-	validKeys := []string{corev1.TLSPrivateKeyKey, corev1.TLSCertKey, cmmeta.TLSCAKey, cmapi.CertificateOutputFormatDERKey, cmapi.CertificateOutputFormatCombinedPEMKey}
+	validKeys := []string{corev1.TLSPrivateKeyKey, corev1.TLSCertKey, cmmeta.TLSCAKey, cmapi.CertificateOutputFormatDERKey, cmapi.CertificateOutputFormatDERCertificateKey, cmapi.CertificateOutputFormatCombinedPEMKey}
 	nbValidKeys := 0
 	for k := range secret.Data {
 		for _, k2 := range validKeys {
@@ -402,6 +402,14 @@ func ExpectValidAdditionalOutputFormats(certificate *cmapi.Certificate, secret *
 				} else {
 					return fmt.Errorf("expected additional output format DER key %s to be present in secret", cmapi.CertificateOutputFormatDERKey)
 				}
+				if derCert, ok := secret.Data[cmapi.CertificateOutputFormatDERCertificateKey]; ok {
+					block, _ := pem.Decode(secret.Data[corev1.TLSCertKey])
+					if block == nil || !bytes.Equal(derCert, block.Bytes) {
+						return fmt.Errorf("expected additional output Format DER %s to contain the binary formated certificate", cmapi.CertificateOutputFormatDERCertificateKey)
+					}
+				} else {
+					return fmt.Errorf("expected additional output format DER key %s to be present in secret", cmapi.CertificateOutputFormatDERCertificateKey)
+				}
 			case cmapi.CertificateOutputFormatCombinedPEM:
 				if combinedPem, ok := secret.Data[cmapi.CertificateOutputFormatCombinedPEMKey]; ok {
 					privateKey := secret.Data[corev1.TLSPrivateKeyKey]
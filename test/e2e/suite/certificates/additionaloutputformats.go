@@ -147,6 +147,7 @@ var _ = framework.CertManagerDescribe("Certificate AdditionalCertificateOutputFo
 		crtPEM := secret.Data["tls.crt"]
 		pkPEM := secret.Data["tls.key"]
 		block, _ := pem.Decode(pkPEM)
+		crtBlock, _ := pem.Decode(crtPEM)
 
 		By("add Combined PEM to Certificate's Additional Output Formats")
 		err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
@@ -195,6 +196,7 @@ var _ = framework.CertManagerDescribe("Certificate AdditionalCertificateOutputFo
 			"tls.key":          Not(BeEmpty()),
 			"tls-combined.pem": Equal(append(append(pkPEM, '\n'), crtPEM...)),
 			"key.der":          Equal(block.Bytes),
+			"tls.der":          Equal(crtBlock.Bytes),
 		}))
 
 		By("remove Combined PEM from Certificate's Additional Output Formats")
@@ -219,6 +221,7 @@ var _ = framework.CertManagerDescribe("Certificate AdditionalCertificateOutputFo
 			"tls.crt": Not(BeEmpty()),
 			"tls.key": Not(BeEmpty()),
 			"key.der": Equal(block.Bytes),
+			"tls.der": Equal(crtBlock.Bytes),
 		}))
 
 		By("remove DER from Certificate's Additional Output Formats")
@@ -254,12 +257,14 @@ var _ = framework.CertManagerDescribe("Certificate AdditionalCertificateOutputFo
 		crtPEM := secret.Data["tls.crt"]
 		pkPEM := secret.Data["tls.key"]
 		block, _ := pem.Decode(pkPEM)
+		crtBlock, _ := pem.Decode(crtPEM)
 		Expect(secret.Data).To(MatchAllKeys(Keys{
 			"ca.crt":           Not(BeEmpty()),
 			"tls.crt":          Not(BeEmpty()),
 			"tls.key":          Not(BeEmpty()),
 			"tls-combined.pem": Equal(append(append(pkPEM, '\n'), crtPEM...)),
 			"key.der":          Equal(block.Bytes),
+			"tls.der":          Equal(crtBlock.Bytes),
 		}))
 
 		By("changing the values of additional output format keys, should have that value reverted to the correct value")
@@ -278,6 +283,7 @@ var _ = framework.CertManagerDescribe("Certificate AdditionalCertificateOutputFo
 			"tls.key":          Not(BeEmpty()),
 			"tls-combined.pem": Equal(append(append(pkPEM, '\n'), crtPEM...)),
 			"key.der":          Equal(block.Bytes),
+			"tls.der":          Equal(crtBlock.Bytes),
 		}))
 	})
 
@@ -290,12 +296,14 @@ var _ = framework.CertManagerDescribe("Certificate AdditionalCertificateOutputFo
 		crtPEM := secret.Data["tls.crt"]
 		pkPEM := secret.Data["tls.key"]
 		block, _ := pem.Decode(pkPEM)
+		crtBlock, _ := pem.Decode(crtPEM)
 		Expect(secret.Data).To(MatchAllKeys(Keys{
 			"ca.crt":           Not(BeEmpty()),
 			"tls.crt":          Not(BeEmpty()),
 			"tls.key":          Not(BeEmpty()),
 			"tls-combined.pem": Equal(append(append(pkPEM, '\n'), crtPEM...)),
 			"key.der":          Equal(block.Bytes),
+			"tls.der":          Equal(crtBlock.Bytes),
 		}))
 
 		By("renewing Certificate to get new signed certificate and private key")
@@ -321,12 +329,14 @@ var _ = framework.CertManagerDescribe("Certificate AdditionalCertificateOutputFo
 		crtPEM = secret.Data["tls.crt"]
 		pkPEM = secret.Data["tls.key"]
 		block, _ = pem.Decode(pkPEM)
+		crtBlock, _ = pem.Decode(crtPEM)
 		Expect(secret.Data).To(MatchAllKeys(Keys{
 			"ca.crt":           Not(Equal(oldCrtPEM)),
 			"tls.crt":          Not(Equal(oldCrtPEM)),
 			"tls.key":          Not(Equal(oldPKPEM)),
 			"tls-combined.pem": Equal(append(append(pkPEM, '\n'), crtPEM...)),
 			"key.der":          Equal(block.Bytes),
+			"tls.der":          Equal(crtBlock.Bytes),
 		}))
 	})
 
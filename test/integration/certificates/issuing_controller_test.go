@@ -888,6 +888,8 @@ func Test_IssuingController_AdditionalOutputFormats(t *testing.T) {
 
 	block, _ := pem.Decode(pkBytes)
 	pkDER := block.Bytes
+	certBlock, _ := pem.Decode(certPEM)
+	certDER := certBlock.Bytes
 	combinedPEM := append(append(pkBytes, '\n'), certPEM...)
 
 	// Wait for the additional output format values to be observed on the Secret.
@@ -899,7 +901,7 @@ func Test_IssuingController_AdditionalOutputFormats(t *testing.T) {
 		}
 		return reflect.DeepEqual(map[string][]byte{
 			"ca.crt": certPEM, "tls.crt": certPEM, "tls.key": pkBytes,
-			"key.der": pkDER, "tls-combined.pem": combinedPEM,
+			"key.der": pkDER, "tls.der": certDER, "tls-combined.pem": combinedPEM,
 		}, secret.Data), nil
 	})
 	if err != nil {
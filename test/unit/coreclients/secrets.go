@@ -75,6 +75,18 @@ func SetFakeSecretsGetterGet(s *corev1.Secret, err error) FakeSecretsGetterModif
 	}
 }
 
+// SetFakeSecretsGetterPatch is a modifier that can be used to set secret and
+// error that will be returned when
+// FakeSecretsGetter(<namespace>).Patch(<context>,<name>,<type>,<data>,<opts>)
+// is called.
+func SetFakeSecretsGetterPatch(s *corev1.Secret, err error) FakeSecretsGetterModifier {
+	return func(f *FakeSecretsGetter) {
+		f.c.PatchFn = func() (*corev1.Secret, error) {
+			return s, err
+		}
+	}
+}
+
 // SetFakeSecretsGetterApplyFn is a function that can be used to inject code
 // when the FakeSecretsGetter is Applied.
 func SetFakeSecretsGetterApplyFn(fn ApplyFn) FakeSecretsGetterModifier {
@@ -96,6 +96,12 @@ func SetCertificateIsCA(isCA bool) CertificateModifier {
 	}
 }
 
+func SetCertificateChainOrder(order v1.CertificateChainOrder) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Spec.ChainOrder = order
+	}
+}
+
 func SetCertificateKeyAlgorithm(keyAlgorithm v1.PrivateKeyAlgorithm) CertificateModifier {
 	return func(crt *v1.Certificate) {
 		crt.Spec.PrivateKey.Algorithm = keyAlgorithm
@@ -114,6 +120,13 @@ func SetCertificateKeyEncoding(keyEncoding v1.PrivateKeyEncoding) CertificateMod
 	}
 }
 
+func SetCertificateKeyRotationPolicy(policy v1.PrivateKeyRotationPolicy, interval *metav1.Duration) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Spec.PrivateKey.RotationPolicy = policy
+		crt.Spec.PrivateKey.RotationInterval = interval
+	}
+}
+
 func SetCertificateSecretName(secretName string) CertificateModifier {
 	return func(crt *v1.Certificate) {
 		crt.Spec.SecretName = secretName
@@ -130,6 +143,15 @@ func SetCertificateSecretTemplate(annotations, labels map[string]string) Certifi
 	}
 }
 
+func SetCertificateSecretTemplateRenderTemplates(renderTemplates bool) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		if crt.Spec.SecretTemplate == nil {
+			crt.Spec.SecretTemplate = &v1.CertificateSecretTemplate{}
+		}
+		crt.Spec.SecretTemplate.RenderTemplates = renderTemplates
+	}
+}
+
 func SetCertificateDuration(duration *metav1.Duration) CertificateModifier {
 	return func(crt *v1.Certificate) {
 		crt.Spec.Duration = duration
@@ -175,6 +197,24 @@ func SetCertificateIssuanceAttempts(ia *int) CertificateModifier {
 	}
 }
 
+func SetCertificateLastPrivateKeyRotationTime(p metav1.Time) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Status.LastPrivateKeyRotationTime = &p
+	}
+}
+
+func SetCertificateRenewalInfo(info v1.CertificateRenewalInfo) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Status.RenewalInfo = &info
+	}
+}
+
+func SetCertificateLastRevocation(revocation v1.CertificateRevocation) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Status.LastRevocation = &revocation
+	}
+}
+
 func SetCertificateNotAfter(p metav1.Time) CertificateModifier {
 	return func(crt *v1.Certificate) {
 		crt.Status.NotAfter = &p
@@ -291,3 +331,9 @@ func SetCertificateAdditionalOutputFormats(additionalOutputFormats ...v1.Certifi
 		crt.Spec.AdditionalOutputFormats = additionalOutputFormats
 	}
 }
+
+func SetCertificateAdditionalOutputSecrets(additionalOutputSecrets ...v1.CertificateAdditionalOutputSecret) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Spec.AdditionalOutputSecrets = additionalOutputSecrets
+	}
+}
@@ -172,6 +172,25 @@ func SetCertificateRequestFailureTime(p metav1.Time) CertificateRequestModifier
 	}
 }
 
+func SetCertificateRequestDenied(deniedBy string, deniedTime metav1.Time) CertificateRequestModifier {
+	return func(cr *v1.CertificateRequest) {
+		cr.Status.DeniedBy = deniedBy
+		cr.Status.DeniedTime = &deniedTime
+	}
+}
+
+func SetCertificateRequestPickupID(pickupID string) CertificateRequestModifier {
+	return func(cr *v1.CertificateRequest) {
+		cr.Status.PickupID = pickupID
+	}
+}
+
+func AddCertificateRequestIssuanceFailure(failure v1.CertificateRequestIssuanceFailure) CertificateRequestModifier {
+	return func(cr *v1.CertificateRequest) {
+		cr.Status.IssuanceFailures = append(cr.Status.IssuanceFailures, failure)
+	}
+}
+
 func SetCertificateRequestTypeMeta(tm metav1.TypeMeta) CertificateRequestModifier {
 	return func(cr *v1.CertificateRequest) {
 		cr.TypeMeta = tm
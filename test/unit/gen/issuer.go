@@ -168,6 +168,21 @@ func SetIssuerACMESkipTLSVerify(shouldSkip bool) IssuerModifier {
 	}
 }
 
+func SetIssuerACMECABundleSecretRef(secretName, key string) IssuerModifier {
+	return func(iss v1.GenericIssuer) {
+		spec := iss.GetSpec()
+		if spec.ACME == nil {
+			spec.ACME = &cmacme.ACMEIssuer{}
+		}
+		spec.ACME.CABundleSecretRef = &cmmeta.SecretKeySelector{
+			Key: key,
+			LocalObjectReference: cmmeta.LocalObjectReference{
+				Name: secretName,
+			},
+		}
+	}
+}
+
 func SetIssuerACMEDisableAccountKeyGeneration(disabled bool) IssuerModifier {
 	return func(iss v1.GenericIssuer) {
 		spec := iss.GetSpec()
@@ -178,6 +193,16 @@ func SetIssuerACMEDisableAccountKeyGeneration(disabled bool) IssuerModifier {
 	}
 }
 
+func SetIssuerACMEPreRegisteredAccountURI(uri string) IssuerModifier {
+	return func(iss v1.GenericIssuer) {
+		spec := iss.GetSpec()
+		if spec.ACME == nil {
+			spec.ACME = &cmacme.ACMEIssuer{}
+		}
+		spec.ACME.AccountURI = uri
+	}
+}
+
 func SetIssuerACMEEAB(keyID, secretName string) IssuerModifier {
 	return func(iss v1.GenericIssuer) {
 		spec := iss.GetSpec()
@@ -247,6 +272,16 @@ func SetIssuerACMELastPrivateKeyHash(privateKeyHash string) IssuerModifier {
 	}
 }
 
+func SetIssuerACMELastEABKeyHash(eabKeyHash string) IssuerModifier {
+	return func(iss v1.GenericIssuer) {
+		status := iss.GetStatus()
+		if status.ACME == nil {
+			status.ACME = &cmacme.ACMEIssuerStatus{}
+		}
+		status.ACME.LastEABKeyHash = eabKeyHash
+	}
+}
+
 func SetIssuerCA(a v1.CAIssuer) IssuerModifier {
 	return func(iss v1.GenericIssuer) {
 		iss.GetSpec().CA = &a
@@ -439,6 +474,18 @@ func SetIssuerVenafi(a v1.VenafiIssuer) IssuerModifier {
 	}
 }
 
+func SetIssuerKubernetesCSR(a v1.KubernetesCSRIssuer) IssuerModifier {
+	return func(iss v1.GenericIssuer) {
+		iss.GetSpec().KubernetesCSR = &a
+	}
+}
+
+func SetIssuerSigningWebhook(a v1.SigningWebhookIssuer) IssuerModifier {
+	return func(iss v1.GenericIssuer) {
+		iss.GetSpec().SigningWebhook = &a
+	}
+}
+
 func AddIssuerCondition(c v1.IssuerCondition) IssuerModifier {
 	return func(iss v1.GenericIssuer) {
 		iss.GetStatus().Conditions = append(iss.GetStatus().Conditions, c)
@@ -118,6 +118,18 @@ func SetOrderDuration(duration time.Duration) OrderModifier {
 	}
 }
 
+func SetOrderProfile(profile string) OrderModifier {
+	return func(order *cmacme.Order) {
+		order.Spec.Profile = profile
+	}
+}
+
+func SetOrderReplaces(certID string) OrderModifier {
+	return func(order *cmacme.Order) {
+		order.Spec.Replaces = certID
+	}
+}
+
 func SetOrderAnnotations(annotations map[string]string) OrderModifier {
 	return func(order *cmacme.Order) {
 		order.Annotations = annotations
@@ -109,11 +109,7 @@ func SetFakeSecretListerSecret(s func(namespace string) clientcorev1.SecretNames
 func SetFakeSecretNamespaceListerGet(sec *corev1.Secret, err error) FakeSecretListerModifier {
 	return func(f *FakeSecretLister) {
 		f.SecretsFn = func(namespace string) clientcorev1.SecretNamespaceLister {
-			return &FakeSecretNamespaceLister{
-				GetFn: func(name string) (*corev1.Secret, error) {
-					return sec, err
-				},
-			}
+			return NewFakeSecretNamespaceLister().SetFakeSecretNamespaceListerGet(sec, err)
 		}
 	}
 }